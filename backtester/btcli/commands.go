@@ -2,10 +2,15 @@ package main
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
+	"text/tabwriter"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -150,6 +155,155 @@ func executeStrategyFromFile(c *cli.Context) error {
 	return nil
 }
 
+var executeOptimisationFromFileCommand = &cli.Command{
+	Name:      "executeoptimisationfromfile",
+	Usage:     "runs the strategy from a config file with every parameter set from an optimisation file and ranks the results",
+	ArgsUsage: "<path> <optimisationpath>",
+	Action:    executeOptimisationFromFile,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "path",
+			Aliases: []string{"p"},
+			Usage:   "the filepath to a strategy to optimise",
+		},
+		&cli.StringFlag{
+			Name:    "optimisationpath",
+			Aliases: []string{"o"},
+			Usage:   "the filepath to the optimisation parameter ranges",
+		},
+		&cli.StringFlag{
+			Name:    "sortby",
+			Aliases: []string{"s"},
+			Usage:   fmt.Sprintf("re-rank results by '%v', '%v', '%v' or '%v'. Defaults to the optimisation file's metric", config.SharpeRatioMetric, config.SortinoRatioMetric, config.CalmarRatioMetric, config.PNLMetric),
+		},
+		&cli.BoolFlag{
+			Name:  "table",
+			Usage: "if true, outputs results as a table instead of JSON",
+		},
+	},
+}
+
+func executeOptimisationFromFile(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var path string
+	if c.IsSet("path") {
+		path = c.String("path")
+	} else {
+		path = c.Args().First()
+	}
+
+	var optimisationPath string
+	if c.IsSet("optimisationpath") {
+		optimisationPath = c.String("optimisationpath")
+	} else {
+		optimisationPath = c.Args().Get(1)
+	}
+
+	sortBy := strings.ToLower(c.String("sortby"))
+	if sortBy != "" && !config.IsValidOptimisationMetric(sortBy) {
+		return fmt.Errorf("%w '%v'", config.ErrUnknownOptimisationMetric, sortBy)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ExecuteOptimisationFromFile(
+		c.Context,
+		&btrpc.ExecuteOptimisationFromFileRequest{
+			StrategyFilePath:     path,
+			OptimisationFilePath: optimisationPath,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if sortBy != "" && sortBy != result.Metric {
+		err = sortOptimisationResults(result, sortBy)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.Bool("table") {
+		return optimisationTableOutput(result)
+	}
+	jsonOutput(result)
+	return nil
+}
+
+// sortOptimisationResults re-ranks optimisation results by the metric, with
+// errored results ranked last
+func sortOptimisationResults(resp *btrpc.ExecuteOptimisationResponse, metric string) error {
+	values := make(map[*btrpc.OptimisationResult]decimal.Decimal, len(resp.Results))
+	for _, r := range resp.Results {
+		var v string
+		switch metric {
+		case config.SortinoRatioMetric:
+			v = r.SortinoRatio
+		case config.CalmarRatioMetric:
+			v = r.CalmarRatio
+		case config.PNLMetric:
+			v = r.Pnl
+		default:
+			v = r.SharpeRatio
+		}
+		d, err := decimal.NewFromString(v)
+		if err != nil {
+			return err
+		}
+		values[r] = d
+	}
+	sort.SliceStable(resp.Results, func(i, j int) bool {
+		if (resp.Results[i].Error == "") != (resp.Results[j].Error == "") {
+			return resp.Results[i].Error == ""
+		}
+		return values[resp.Results[i]].GreaterThan(values[resp.Results[j]])
+	})
+	for i := range resp.Results {
+		resp.Results[i].Rank = int64(i + 1)
+	}
+	resp.Metric = metric
+	return nil
+}
+
+// optimisationTableOutput prints optimisation results as a table in rank
+// order
+func optimisationTableOutput(resp *btrpc.ExecuteOptimisationResponse) error {
+	fmt.Printf("Strategy: %v, method: %v, ranked by: %v\n", resp.Strategy, resp.Method, resp.Metric)
+	if len(resp.Results) == 0 {
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := []string{"Rank"}
+	for _, p := range resp.Results[0].Parameters {
+		header = append(header, p.Name)
+	}
+	header = append(header, "Sharpe", "Sortino", "Calmar", "PNL", "Orders", "Error")
+	if _, err := fmt.Fprintln(w, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+	for _, r := range resp.Results {
+		row := []string{strconv.FormatInt(r.Rank, 10)}
+		for _, p := range r.Parameters {
+			row = append(row, p.Value)
+		}
+		row = append(row, r.SharpeRatio, r.SortinoRatio, r.CalmarRatio, r.Pnl, strconv.FormatInt(r.TotalOrders, 10), r.Error)
+		if _, err := fmt.Fprintln(w, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
 var listAllTasksCommand = &cli.Command{
 	Name:   "listalltasks",
 	Usage:  "returns a list of all loaded strategy tasks",
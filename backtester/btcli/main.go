@@ -112,6 +112,7 @@ func main() {
 	app.Commands = []*cli.Command{
 		executeStrategyFromFileCommand,
 		executeStrategyFromConfigCommand,
+		executeOptimisationFromFileCommand,
 		listAllTasksCommand,
 		startTaskCommand,
 		startAllTasksCommand,
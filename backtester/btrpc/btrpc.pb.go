@@ -2746,6 +2746,488 @@ func (x *ClearAllTasksResponse) GetRemainingTasks() []*TaskSummary {
 	return nil
 }
 
+type OptimisationParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Minimum string `protobuf:"bytes,2,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	Maximum string `protobuf:"bytes,3,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	Step    string `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`
+}
+
+func (x *OptimisationParameter) Reset() {
+	*x = OptimisationParameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_btrpc_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimisationParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimisationParameter) ProtoMessage() {}
+
+func (x *OptimisationParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_btrpc_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimisationParameter.ProtoReflect.Descriptor instead.
+func (*OptimisationParameter) Descriptor() ([]byte, []int) {
+	return file_btrpc_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *OptimisationParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OptimisationParameter) GetMinimum() string {
+	if x != nil {
+		return x.Minimum
+	}
+	return ""
+}
+
+func (x *OptimisationParameter) GetMaximum() string {
+	if x != nil {
+		return x.Maximum
+	}
+	return ""
+}
+
+func (x *OptimisationParameter) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+type OptimisationSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method               string                   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Metric               string                   `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
+	Iterations           int64                    `protobuf:"varint,3,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Seed                 int64                    `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	MaximumParallelTasks int64                    `protobuf:"varint,5,opt,name=maximum_parallel_tasks,json=maximumParallelTasks,proto3" json:"maximum_parallel_tasks,omitempty"`
+	Parameters           []*OptimisationParameter `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *OptimisationSettings) Reset() {
+	*x = OptimisationSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_btrpc_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimisationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimisationSettings) ProtoMessage() {}
+
+func (x *OptimisationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_btrpc_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimisationSettings.ProtoReflect.Descriptor instead.
+func (*OptimisationSettings) Descriptor() ([]byte, []int) {
+	return file_btrpc_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *OptimisationSettings) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *OptimisationSettings) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *OptimisationSettings) GetIterations() int64 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *OptimisationSettings) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *OptimisationSettings) GetMaximumParallelTasks() int64 {
+	if x != nil {
+		return x.MaximumParallelTasks
+	}
+	return 0
+}
+
+func (x *OptimisationSettings) GetParameters() []*OptimisationParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type ParameterValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ParameterValue) Reset() {
+	*x = ParameterValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_btrpc_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParameterValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterValue) ProtoMessage() {}
+
+func (x *ParameterValue) ProtoReflect() protoreflect.Message {
+	mi := &file_btrpc_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterValue.ProtoReflect.Descriptor instead.
+func (*ParameterValue) Descriptor() ([]byte, []int) {
+	return file_btrpc_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ParameterValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParameterValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type OptimisationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank         int64             `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	TaskId       string            `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Parameters   []*ParameterValue `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	SharpeRatio  string            `protobuf:"bytes,4,opt,name=sharpe_ratio,json=sharpeRatio,proto3" json:"sharpe_ratio,omitempty"`
+	SortinoRatio string            `protobuf:"bytes,5,opt,name=sortino_ratio,json=sortinoRatio,proto3" json:"sortino_ratio,omitempty"`
+	CalmarRatio  string            `protobuf:"bytes,6,opt,name=calmar_ratio,json=calmarRatio,proto3" json:"calmar_ratio,omitempty"`
+	Pnl          string            `protobuf:"bytes,7,opt,name=pnl,proto3" json:"pnl,omitempty"`
+	TotalOrders  int64             `protobuf:"varint,8,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"`
+	Error        string            `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *OptimisationResult) Reset() {
+	*x = OptimisationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_btrpc_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimisationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimisationResult) ProtoMessage() {}
+
+func (x *OptimisationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_btrpc_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimisationResult.ProtoReflect.Descriptor instead.
+func (*OptimisationResult) Descriptor() ([]byte, []int) {
+	return file_btrpc_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *OptimisationResult) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *OptimisationResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *OptimisationResult) GetParameters() []*ParameterValue {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *OptimisationResult) GetSharpeRatio() string {
+	if x != nil {
+		return x.SharpeRatio
+	}
+	return ""
+}
+
+func (x *OptimisationResult) GetSortinoRatio() string {
+	if x != nil {
+		return x.SortinoRatio
+	}
+	return ""
+}
+
+func (x *OptimisationResult) GetCalmarRatio() string {
+	if x != nil {
+		return x.CalmarRatio
+	}
+	return ""
+}
+
+func (x *OptimisationResult) GetPnl() string {
+	if x != nil {
+		return x.Pnl
+	}
+	return ""
+}
+
+func (x *OptimisationResult) GetTotalOrders() int64 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+func (x *OptimisationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ExecuteOptimisationFromFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrategyFilePath     string                `protobuf:"bytes,1,opt,name=strategy_file_path,json=strategyFilePath,proto3" json:"strategy_file_path,omitempty"`
+	OptimisationFilePath string                `protobuf:"bytes,2,opt,name=optimisation_file_path,json=optimisationFilePath,proto3" json:"optimisation_file_path,omitempty"`
+	Optimisation         *OptimisationSettings `protobuf:"bytes,3,opt,name=optimisation,proto3" json:"optimisation,omitempty"`
+}
+
+func (x *ExecuteOptimisationFromFileRequest) Reset() {
+	*x = ExecuteOptimisationFromFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_btrpc_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteOptimisationFromFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteOptimisationFromFileRequest) ProtoMessage() {}
+
+func (x *ExecuteOptimisationFromFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_btrpc_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteOptimisationFromFileRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteOptimisationFromFileRequest) Descriptor() ([]byte, []int) {
+	return file_btrpc_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExecuteOptimisationFromFileRequest) GetStrategyFilePath() string {
+	if x != nil {
+		return x.StrategyFilePath
+	}
+	return ""
+}
+
+func (x *ExecuteOptimisationFromFileRequest) GetOptimisationFilePath() string {
+	if x != nil {
+		return x.OptimisationFilePath
+	}
+	return ""
+}
+
+func (x *ExecuteOptimisationFromFileRequest) GetOptimisation() *OptimisationSettings {
+	if x != nil {
+		return x.Optimisation
+	}
+	return nil
+}
+
+type ExecuteOptimisationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strategy    string                `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Nickname    string                `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Method      string                `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Metric      string                `protobuf:"bytes,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	DateStarted string                `protobuf:"bytes,5,opt,name=date_started,json=dateStarted,proto3" json:"date_started,omitempty"`
+	DateEnded   string                `protobuf:"bytes,6,opt,name=date_ended,json=dateEnded,proto3" json:"date_ended,omitempty"`
+	Results     []*OptimisationResult `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ExecuteOptimisationResponse) Reset() {
+	*x = ExecuteOptimisationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_btrpc_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteOptimisationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteOptimisationResponse) ProtoMessage() {}
+
+func (x *ExecuteOptimisationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_btrpc_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteOptimisationResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteOptimisationResponse) Descriptor() ([]byte, []int) {
+	return file_btrpc_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExecuteOptimisationResponse) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *ExecuteOptimisationResponse) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *ExecuteOptimisationResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ExecuteOptimisationResponse) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *ExecuteOptimisationResponse) GetDateStarted() string {
+	if x != nil {
+		return x.DateStarted
+	}
+	return ""
+}
+
+func (x *ExecuteOptimisationResponse) GetDateEnded() string {
+	if x != nil {
+		return x.DateEnded
+	}
+	return ""
+}
+
+func (x *ExecuteOptimisationResponse) GetResults() []*OptimisationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 var File_btrpc_proto protoreflect.FileDescriptor
 
 var file_btrpc_proto_rawDesc = []byte{
@@ -3161,71 +3643,155 @@ var file_btrpc_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x73, 0x6b,
 	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e,
 	0x54, 0x61, 0x73, 0x6b, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0e, 0x72, 0x65, 0x6d,
-	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x32, 0xbe, 0x07, 0x0a, 0x11,
-	0x42, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x85, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x2e,
-	0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b, 0x2f, 0x76,
-	0x31, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x66, 0x72, 0x6f, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x19, 0x45, 0x78,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x73, 0x0a, 0x15, 0x4f,
+	0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x22, 0xee, 0x01, 0x0a, 0x14, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x16, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65,
+	0x6c, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x3a, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xae, 0x02,
+	0x0a, 0x12, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72,
+	0x70, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x68, 0x61, 0x72, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x6f, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x6f, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6d, 0x61, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6d, 0x61, 0x72, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6e, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x70, 0x6e, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc9,
+	0x01, 0x0a, 0x22, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x16, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0c, 0x6f, 0x70, 0x74,
+	0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0c, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfc, 0x01, 0x0a, 0x1b, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x70,
+	0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x32, 0xd6, 0x08, 0x0a, 0x11, 0x42, 0x61,
+	0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x85, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x62, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x66,
+	0x72, 0x6f, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x19, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78,
 	0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x72, 0x6f,
-	0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46,
-	0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x66, 0x72, 0x6f,
-	0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x61, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x55, 0x0a, 0x09, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x17, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x0f, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x74, 0x61, 0x73,
-	0x6b, 0x12, 0x65, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73,
-	0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x6c, 0x6c,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x61, 0x6c, 0x6c, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x51, 0x0a, 0x08, 0x53, 0x74, 0x6f, 0x70,
-	0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f,
-	0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62,
-	0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x0c, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x61, 0x0a, 0x0c, 0x53,
-	0x74, 0x6f, 0x70, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x74, 0x6f, 0x70, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x10, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x61, 0x6c, 0x6c, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x55,
-	0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x17, 0x2e, 0x62, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x65,
-	0x61, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x65, 0x61,
-	0x72, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x65, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c,
-	0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43,
-	0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x65, 0x61,
-	0x72, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x6c, 0x65, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x3a, 0x5a, 0x38,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x72, 0x61, 0x73,
-	0x68, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x67, 0x6f, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x6f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x74, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x2f, 0x62, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x66, 0x72, 0x6f, 0x6d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x61, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x55, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x17, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
+	0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x74, 0x61, 0x73, 0x6b, 0x12,
+	0x65, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x12, 0x1b, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x6c,
+	0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x61, 0x6c,
+	0x6c, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x51, 0x0a, 0x08, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x16, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x0c, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x74, 0x6f, 0x70, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x61, 0x0a, 0x0c, 0x53, 0x74, 0x6f,
+	0x70, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x6f, 0x70, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x74, 0x6f, 0x70, 0x61, 0x6c, 0x6c, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x55, 0x0a, 0x09,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x17, 0x2e, 0x62, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0f, 0x2a, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x74,
+	0x61, 0x73, 0x6b, 0x12, 0x65, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41,
+	0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x61, 0x6c, 0x6c, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x1b, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x2e, 0x62, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6d, 0x66, 0x69,
+	0x6c, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x74, 0x68, 0x72, 0x61, 0x73, 0x68, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x67,
+	0x6f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x2f, 0x62, 0x61,
+	0x63, 0x6b, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3240,51 +3806,57 @@ func file_btrpc_proto_rawDescGZIP() []byte {
 	return file_btrpc_proto_rawDescData
 }
 
-var file_btrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_btrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_btrpc_proto_goTypes = []interface{}{
-	(*StrategySettings)(nil),                 // 0: btrpc.StrategySettings
-	(*CustomSettings)(nil),                   // 1: btrpc.CustomSettings
-	(*ExchangeLevelFunding)(nil),             // 2: btrpc.ExchangeLevelFunding
-	(*FundingSettings)(nil),                  // 3: btrpc.FundingSettings
-	(*PurchaseSide)(nil),                     // 4: btrpc.PurchaseSide
-	(*SpotDetails)(nil),                      // 5: btrpc.SpotDetails
-	(*FuturesDetails)(nil),                   // 6: btrpc.FuturesDetails
-	(*CurrencySettings)(nil),                 // 7: btrpc.CurrencySettings
-	(*ApiData)(nil),                          // 8: btrpc.ApiData
-	(*DbConfig)(nil),                         // 9: btrpc.DbConfig
-	(*DbData)(nil),                           // 10: btrpc.DbData
-	(*CsvData)(nil),                          // 11: btrpc.CsvData
-	(*DatabaseConnectionDetails)(nil),        // 12: btrpc.DatabaseConnectionDetails
-	(*DatabaseConfig)(nil),                   // 13: btrpc.DatabaseConfig
-	(*DatabaseData)(nil),                     // 14: btrpc.DatabaseData
-	(*CSVData)(nil),                          // 15: btrpc.CSVData
-	(*LiveData)(nil),                         // 16: btrpc.LiveData
-	(*Credentials)(nil),                      // 17: btrpc.Credentials
-	(*ExchangeCredentials)(nil),              // 18: btrpc.ExchangeCredentials
-	(*DataSettings)(nil),                     // 19: btrpc.DataSettings
-	(*Leverage)(nil),                         // 20: btrpc.Leverage
-	(*PortfolioSettings)(nil),                // 21: btrpc.PortfolioSettings
-	(*StatisticSettings)(nil),                // 22: btrpc.StatisticSettings
-	(*Config)(nil),                           // 23: btrpc.Config
-	(*TaskSummary)(nil),                      // 24: btrpc.TaskSummary
-	(*ExecuteStrategyFromFileRequest)(nil),   // 25: btrpc.ExecuteStrategyFromFileRequest
-	(*ExecuteStrategyResponse)(nil),          // 26: btrpc.ExecuteStrategyResponse
-	(*ExecuteStrategyFromConfigRequest)(nil), // 27: btrpc.ExecuteStrategyFromConfigRequest
-	(*ListAllTasksRequest)(nil),              // 28: btrpc.ListAllTasksRequest
-	(*ListAllTasksResponse)(nil),             // 29: btrpc.ListAllTasksResponse
-	(*StopTaskRequest)(nil),                  // 30: btrpc.StopTaskRequest
-	(*StopTaskResponse)(nil),                 // 31: btrpc.StopTaskResponse
-	(*StartTaskRequest)(nil),                 // 32: btrpc.StartTaskRequest
-	(*StartTaskResponse)(nil),                // 33: btrpc.StartTaskResponse
-	(*StartAllTasksRequest)(nil),             // 34: btrpc.StartAllTasksRequest
-	(*StartAllTasksResponse)(nil),            // 35: btrpc.StartAllTasksResponse
-	(*StopAllTasksRequest)(nil),              // 36: btrpc.StopAllTasksRequest
-	(*StopAllTasksResponse)(nil),             // 37: btrpc.StopAllTasksResponse
-	(*ClearTaskRequest)(nil),                 // 38: btrpc.ClearTaskRequest
-	(*ClearTaskResponse)(nil),                // 39: btrpc.ClearTaskResponse
-	(*ClearAllTasksRequest)(nil),             // 40: btrpc.ClearAllTasksRequest
-	(*ClearAllTasksResponse)(nil),            // 41: btrpc.ClearAllTasksResponse
-	(*timestamppb.Timestamp)(nil),            // 42: google.protobuf.Timestamp
+	(*StrategySettings)(nil),                   // 0: btrpc.StrategySettings
+	(*CustomSettings)(nil),                     // 1: btrpc.CustomSettings
+	(*ExchangeLevelFunding)(nil),               // 2: btrpc.ExchangeLevelFunding
+	(*FundingSettings)(nil),                    // 3: btrpc.FundingSettings
+	(*PurchaseSide)(nil),                       // 4: btrpc.PurchaseSide
+	(*SpotDetails)(nil),                        // 5: btrpc.SpotDetails
+	(*FuturesDetails)(nil),                     // 6: btrpc.FuturesDetails
+	(*CurrencySettings)(nil),                   // 7: btrpc.CurrencySettings
+	(*ApiData)(nil),                            // 8: btrpc.ApiData
+	(*DbConfig)(nil),                           // 9: btrpc.DbConfig
+	(*DbData)(nil),                             // 10: btrpc.DbData
+	(*CsvData)(nil),                            // 11: btrpc.CsvData
+	(*DatabaseConnectionDetails)(nil),          // 12: btrpc.DatabaseConnectionDetails
+	(*DatabaseConfig)(nil),                     // 13: btrpc.DatabaseConfig
+	(*DatabaseData)(nil),                       // 14: btrpc.DatabaseData
+	(*CSVData)(nil),                            // 15: btrpc.CSVData
+	(*LiveData)(nil),                           // 16: btrpc.LiveData
+	(*Credentials)(nil),                        // 17: btrpc.Credentials
+	(*ExchangeCredentials)(nil),                // 18: btrpc.ExchangeCredentials
+	(*DataSettings)(nil),                       // 19: btrpc.DataSettings
+	(*Leverage)(nil),                           // 20: btrpc.Leverage
+	(*PortfolioSettings)(nil),                  // 21: btrpc.PortfolioSettings
+	(*StatisticSettings)(nil),                  // 22: btrpc.StatisticSettings
+	(*Config)(nil),                             // 23: btrpc.Config
+	(*TaskSummary)(nil),                        // 24: btrpc.TaskSummary
+	(*ExecuteStrategyFromFileRequest)(nil),     // 25: btrpc.ExecuteStrategyFromFileRequest
+	(*ExecuteStrategyResponse)(nil),            // 26: btrpc.ExecuteStrategyResponse
+	(*ExecuteStrategyFromConfigRequest)(nil),   // 27: btrpc.ExecuteStrategyFromConfigRequest
+	(*ListAllTasksRequest)(nil),                // 28: btrpc.ListAllTasksRequest
+	(*ListAllTasksResponse)(nil),               // 29: btrpc.ListAllTasksResponse
+	(*StopTaskRequest)(nil),                    // 30: btrpc.StopTaskRequest
+	(*StopTaskResponse)(nil),                   // 31: btrpc.StopTaskResponse
+	(*StartTaskRequest)(nil),                   // 32: btrpc.StartTaskRequest
+	(*StartTaskResponse)(nil),                  // 33: btrpc.StartTaskResponse
+	(*StartAllTasksRequest)(nil),               // 34: btrpc.StartAllTasksRequest
+	(*StartAllTasksResponse)(nil),              // 35: btrpc.StartAllTasksResponse
+	(*StopAllTasksRequest)(nil),                // 36: btrpc.StopAllTasksRequest
+	(*StopAllTasksResponse)(nil),               // 37: btrpc.StopAllTasksResponse
+	(*ClearTaskRequest)(nil),                   // 38: btrpc.ClearTaskRequest
+	(*ClearTaskResponse)(nil),                  // 39: btrpc.ClearTaskResponse
+	(*ClearAllTasksRequest)(nil),               // 40: btrpc.ClearAllTasksRequest
+	(*ClearAllTasksResponse)(nil),              // 41: btrpc.ClearAllTasksResponse
+	(*OptimisationParameter)(nil),              // 42: btrpc.OptimisationParameter
+	(*OptimisationSettings)(nil),               // 43: btrpc.OptimisationSettings
+	(*ParameterValue)(nil),                     // 44: btrpc.ParameterValue
+	(*OptimisationResult)(nil),                 // 45: btrpc.OptimisationResult
+	(*ExecuteOptimisationFromFileRequest)(nil), // 46: btrpc.ExecuteOptimisationFromFileRequest
+	(*ExecuteOptimisationResponse)(nil),        // 47: btrpc.ExecuteOptimisationResponse
+	(*timestamppb.Timestamp)(nil),              // 48: google.protobuf.Timestamp
 }
 var file_btrpc_proto_depIdxs = []int32{
 	1,  // 0: btrpc.StrategySettings.custom_settings:type_name -> btrpc.CustomSettings
@@ -3294,14 +3866,14 @@ var file_btrpc_proto_depIdxs = []int32{
 	4,  // 4: btrpc.CurrencySettings.sell_side:type_name -> btrpc.PurchaseSide
 	5,  // 5: btrpc.CurrencySettings.spot_details:type_name -> btrpc.SpotDetails
 	6,  // 6: btrpc.CurrencySettings.futures_details:type_name -> btrpc.FuturesDetails
-	42, // 7: btrpc.ApiData.start_date:type_name -> google.protobuf.Timestamp
-	42, // 8: btrpc.ApiData.end_date:type_name -> google.protobuf.Timestamp
-	42, // 9: btrpc.DbData.start_date:type_name -> google.protobuf.Timestamp
-	42, // 10: btrpc.DbData.end_date:type_name -> google.protobuf.Timestamp
+	48, // 7: btrpc.ApiData.start_date:type_name -> google.protobuf.Timestamp
+	48, // 8: btrpc.ApiData.end_date:type_name -> google.protobuf.Timestamp
+	48, // 9: btrpc.DbData.start_date:type_name -> google.protobuf.Timestamp
+	48, // 10: btrpc.DbData.end_date:type_name -> google.protobuf.Timestamp
 	9,  // 11: btrpc.DbData.config:type_name -> btrpc.DbConfig
 	12, // 12: btrpc.DatabaseConfig.config:type_name -> btrpc.DatabaseConnectionDetails
-	42, // 13: btrpc.DatabaseData.start_date:type_name -> google.protobuf.Timestamp
-	42, // 14: btrpc.DatabaseData.end_date:type_name -> google.protobuf.Timestamp
+	48, // 13: btrpc.DatabaseData.start_date:type_name -> google.protobuf.Timestamp
+	48, // 14: btrpc.DatabaseData.end_date:type_name -> google.protobuf.Timestamp
 	13, // 15: btrpc.DatabaseData.config:type_name -> btrpc.DatabaseConfig
 	17, // 16: btrpc.LiveData.credentials:type_name -> btrpc.Credentials
 	18, // 17: btrpc.Credentials.keys:type_name -> btrpc.ExchangeCredentials
@@ -3318,8 +3890,8 @@ var file_btrpc_proto_depIdxs = []int32{
 	19, // 28: btrpc.Config.data_settings:type_name -> btrpc.DataSettings
 	21, // 29: btrpc.Config.portfolio_settings:type_name -> btrpc.PortfolioSettings
 	22, // 30: btrpc.Config.statistic_settings:type_name -> btrpc.StatisticSettings
-	42, // 31: btrpc.ExecuteStrategyFromFileRequest.start_time_override:type_name -> google.protobuf.Timestamp
-	42, // 32: btrpc.ExecuteStrategyFromFileRequest.end_time_override:type_name -> google.protobuf.Timestamp
+	48, // 31: btrpc.ExecuteStrategyFromFileRequest.start_time_override:type_name -> google.protobuf.Timestamp
+	48, // 32: btrpc.ExecuteStrategyFromFileRequest.end_time_override:type_name -> google.protobuf.Timestamp
 	24, // 33: btrpc.ExecuteStrategyResponse.task:type_name -> btrpc.TaskSummary
 	23, // 34: btrpc.ExecuteStrategyFromConfigRequest.config:type_name -> btrpc.Config
 	24, // 35: btrpc.ListAllTasksResponse.tasks:type_name -> btrpc.TaskSummary
@@ -3328,29 +3900,35 @@ var file_btrpc_proto_depIdxs = []int32{
 	24, // 38: btrpc.ClearTaskResponse.cleared_task:type_name -> btrpc.TaskSummary
 	24, // 39: btrpc.ClearAllTasksResponse.cleared_tasks:type_name -> btrpc.TaskSummary
 	24, // 40: btrpc.ClearAllTasksResponse.remaining_tasks:type_name -> btrpc.TaskSummary
-	25, // 41: btrpc.BacktesterService.ExecuteStrategyFromFile:input_type -> btrpc.ExecuteStrategyFromFileRequest
-	27, // 42: btrpc.BacktesterService.ExecuteStrategyFromConfig:input_type -> btrpc.ExecuteStrategyFromConfigRequest
-	28, // 43: btrpc.BacktesterService.ListAllTasks:input_type -> btrpc.ListAllTasksRequest
-	32, // 44: btrpc.BacktesterService.StartTask:input_type -> btrpc.StartTaskRequest
-	34, // 45: btrpc.BacktesterService.StartAllTasks:input_type -> btrpc.StartAllTasksRequest
-	30, // 46: btrpc.BacktesterService.StopTask:input_type -> btrpc.StopTaskRequest
-	36, // 47: btrpc.BacktesterService.StopAllTasks:input_type -> btrpc.StopAllTasksRequest
-	38, // 48: btrpc.BacktesterService.ClearTask:input_type -> btrpc.ClearTaskRequest
-	40, // 49: btrpc.BacktesterService.ClearAllTasks:input_type -> btrpc.ClearAllTasksRequest
-	26, // 50: btrpc.BacktesterService.ExecuteStrategyFromFile:output_type -> btrpc.ExecuteStrategyResponse
-	26, // 51: btrpc.BacktesterService.ExecuteStrategyFromConfig:output_type -> btrpc.ExecuteStrategyResponse
-	29, // 52: btrpc.BacktesterService.ListAllTasks:output_type -> btrpc.ListAllTasksResponse
-	33, // 53: btrpc.BacktesterService.StartTask:output_type -> btrpc.StartTaskResponse
-	35, // 54: btrpc.BacktesterService.StartAllTasks:output_type -> btrpc.StartAllTasksResponse
-	31, // 55: btrpc.BacktesterService.StopTask:output_type -> btrpc.StopTaskResponse
-	37, // 56: btrpc.BacktesterService.StopAllTasks:output_type -> btrpc.StopAllTasksResponse
-	39, // 57: btrpc.BacktesterService.ClearTask:output_type -> btrpc.ClearTaskResponse
-	41, // 58: btrpc.BacktesterService.ClearAllTasks:output_type -> btrpc.ClearAllTasksResponse
-	50, // [50:59] is the sub-list for method output_type
-	41, // [41:50] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+	42, // 41: btrpc.OptimisationSettings.parameters:type_name -> btrpc.OptimisationParameter
+	44, // 42: btrpc.OptimisationResult.parameters:type_name -> btrpc.ParameterValue
+	43, // 43: btrpc.ExecuteOptimisationFromFileRequest.optimisation:type_name -> btrpc.OptimisationSettings
+	45, // 44: btrpc.ExecuteOptimisationResponse.results:type_name -> btrpc.OptimisationResult
+	25, // 45: btrpc.BacktesterService.ExecuteStrategyFromFile:input_type -> btrpc.ExecuteStrategyFromFileRequest
+	27, // 46: btrpc.BacktesterService.ExecuteStrategyFromConfig:input_type -> btrpc.ExecuteStrategyFromConfigRequest
+	28, // 47: btrpc.BacktesterService.ListAllTasks:input_type -> btrpc.ListAllTasksRequest
+	32, // 48: btrpc.BacktesterService.StartTask:input_type -> btrpc.StartTaskRequest
+	34, // 49: btrpc.BacktesterService.StartAllTasks:input_type -> btrpc.StartAllTasksRequest
+	30, // 50: btrpc.BacktesterService.StopTask:input_type -> btrpc.StopTaskRequest
+	36, // 51: btrpc.BacktesterService.StopAllTasks:input_type -> btrpc.StopAllTasksRequest
+	38, // 52: btrpc.BacktesterService.ClearTask:input_type -> btrpc.ClearTaskRequest
+	40, // 53: btrpc.BacktesterService.ClearAllTasks:input_type -> btrpc.ClearAllTasksRequest
+	46, // 54: btrpc.BacktesterService.ExecuteOptimisationFromFile:input_type -> btrpc.ExecuteOptimisationFromFileRequest
+	26, // 55: btrpc.BacktesterService.ExecuteStrategyFromFile:output_type -> btrpc.ExecuteStrategyResponse
+	26, // 56: btrpc.BacktesterService.ExecuteStrategyFromConfig:output_type -> btrpc.ExecuteStrategyResponse
+	29, // 57: btrpc.BacktesterService.ListAllTasks:output_type -> btrpc.ListAllTasksResponse
+	33, // 58: btrpc.BacktesterService.StartTask:output_type -> btrpc.StartTaskResponse
+	35, // 59: btrpc.BacktesterService.StartAllTasks:output_type -> btrpc.StartAllTasksResponse
+	31, // 60: btrpc.BacktesterService.StopTask:output_type -> btrpc.StopTaskResponse
+	37, // 61: btrpc.BacktesterService.StopAllTasks:output_type -> btrpc.StopAllTasksResponse
+	39, // 62: btrpc.BacktesterService.ClearTask:output_type -> btrpc.ClearTaskResponse
+	41, // 63: btrpc.BacktesterService.ClearAllTasks:output_type -> btrpc.ClearAllTasksResponse
+	47, // 64: btrpc.BacktesterService.ExecuteOptimisationFromFile:output_type -> btrpc.ExecuteOptimisationResponse
+	55, // [55:65] is the sub-list for method output_type
+	45, // [45:55] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_btrpc_proto_init() }
@@ -3863,6 +4441,78 @@ func file_btrpc_proto_init() {
 				return nil
 			}
 		}
+		file_btrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimisationParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_btrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimisationSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_btrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParameterValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_btrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimisationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_btrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteOptimisationFromFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_btrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteOptimisationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3870,7 +4520,7 @@ func file_btrpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_btrpc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   42,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,6 +31,7 @@ type BacktesterServiceClient interface {
 	StopAllTasks(ctx context.Context, in *StopAllTasksRequest, opts ...grpc.CallOption) (*StopAllTasksResponse, error)
 	ClearTask(ctx context.Context, in *ClearTaskRequest, opts ...grpc.CallOption) (*ClearTaskResponse, error)
 	ClearAllTasks(ctx context.Context, in *ClearAllTasksRequest, opts ...grpc.CallOption) (*ClearAllTasksResponse, error)
+	ExecuteOptimisationFromFile(ctx context.Context, in *ExecuteOptimisationFromFileRequest, opts ...grpc.CallOption) (*ExecuteOptimisationResponse, error)
 }
 
 type backtesterServiceClient struct {
@@ -122,6 +123,15 @@ func (c *backtesterServiceClient) ClearAllTasks(ctx context.Context, in *ClearAl
 	return out, nil
 }
 
+func (c *backtesterServiceClient) ExecuteOptimisationFromFile(ctx context.Context, in *ExecuteOptimisationFromFileRequest, opts ...grpc.CallOption) (*ExecuteOptimisationResponse, error) {
+	out := new(ExecuteOptimisationResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/ExecuteOptimisationFromFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BacktesterServiceServer is the server API for BacktesterService service.
 // All implementations must embed UnimplementedBacktesterServiceServer
 // for forward compatibility
@@ -135,6 +145,7 @@ type BacktesterServiceServer interface {
 	StopAllTasks(context.Context, *StopAllTasksRequest) (*StopAllTasksResponse, error)
 	ClearTask(context.Context, *ClearTaskRequest) (*ClearTaskResponse, error)
 	ClearAllTasks(context.Context, *ClearAllTasksRequest) (*ClearAllTasksResponse, error)
+	ExecuteOptimisationFromFile(context.Context, *ExecuteOptimisationFromFileRequest) (*ExecuteOptimisationResponse, error)
 	mustEmbedUnimplementedBacktesterServiceServer()
 }
 
@@ -169,6 +180,9 @@ func (UnimplementedBacktesterServiceServer) ClearTask(context.Context, *ClearTas
 func (UnimplementedBacktesterServiceServer) ClearAllTasks(context.Context, *ClearAllTasksRequest) (*ClearAllTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearAllTasks not implemented")
 }
+func (UnimplementedBacktesterServiceServer) ExecuteOptimisationFromFile(context.Context, *ExecuteOptimisationFromFileRequest) (*ExecuteOptimisationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteOptimisationFromFile not implemented")
+}
 func (UnimplementedBacktesterServiceServer) mustEmbedUnimplementedBacktesterServiceServer() {}
 
 // UnsafeBacktesterServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -344,6 +358,24 @@ func _BacktesterService_ClearAllTasks_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BacktesterService_ExecuteOptimisationFromFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteOptimisationFromFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).ExecuteOptimisationFromFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/ExecuteOptimisationFromFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).ExecuteOptimisationFromFile(ctx, req.(*ExecuteOptimisationFromFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BacktesterService_ServiceDesc is the grpc.ServiceDesc for BacktesterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -387,6 +419,10 @@ var BacktesterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearAllTasks",
 			Handler:    _BacktesterService_ClearAllTasks_Handler,
 		},
+		{
+			MethodName: "ExecuteOptimisationFromFile",
+			Handler:    _BacktesterService_ExecuteOptimisationFromFile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "btrpc.proto",
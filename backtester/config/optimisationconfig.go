@@ -0,0 +1,256 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+// ReadOptimisationFromFile will take optimisation settings from a path
+func ReadOptimisationFromFile(path string) (*Optimisation, error) {
+	if !file.Exists(path) {
+		return nil, fmt.Errorf("%w %v", common.ErrFileNotFound, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *Optimisation
+	err = json.Unmarshal(data, &resp)
+	return resp, err
+}
+
+// IsValidOptimisationMetric checks whether optimisation results can be ranked
+// by the metric
+func IsValidOptimisationMetric(metric string) bool {
+	switch strings.ToLower(metric) {
+	case SharpeRatioMetric, SortinoRatioMetric, CalmarRatioMetric, PNLMetric:
+		return true
+	}
+	return false
+}
+
+// Validate checks optimisation settings and sets defaults for any unset
+// method or metric
+func (o *Optimisation) Validate() error {
+	if o == nil {
+		return fmt.Errorf("%w optimisation", gctcommon.ErrNilPointer)
+	}
+	o.Method = strings.ToLower(o.Method)
+	if o.Method == "" {
+		o.Method = GridSearch
+	}
+	if o.Method != GridSearch && o.Method != RandomSearch {
+		return fmt.Errorf("%w '%v'", errUnknownOptimisationMethod, o.Method)
+	}
+	o.Metric = strings.ToLower(o.Metric)
+	if o.Metric == "" {
+		o.Metric = SharpeRatioMetric
+	}
+	if !IsValidOptimisationMetric(o.Metric) {
+		return fmt.Errorf("%w '%v'", ErrUnknownOptimisationMetric, o.Metric)
+	}
+	if o.MaximumParallelTasks < 0 {
+		return errNegativeParallelTasks
+	}
+	if len(o.Parameters) == 0 {
+		return errNoOptimisationParameters
+	}
+	combinations := int64(1)
+	seen := make(map[string]bool, len(o.Parameters))
+	for i := range o.Parameters {
+		p := &o.Parameters[i]
+		p.Name = strings.ToLower(strings.TrimSpace(p.Name))
+		if seen[p.Name] {
+			return fmt.Errorf("%w '%v'", errDuplicateOptimisationParam, p.Name)
+		}
+		seen[p.Name] = true
+		if err := validateParameterName(p.Name); err != nil {
+			return err
+		}
+		if p.Minimum.GreaterThan(p.Maximum) {
+			return fmt.Errorf("%w %v minimum '%v' exceeds maximum '%v'", errInvalidParameterRange, p.Name, p.Minimum, p.Maximum)
+		}
+		if p.Step.IsNegative() {
+			return fmt.Errorf("%w %v step '%v' cannot be negative", errInvalidParameterRange, p.Name, p.Step)
+		}
+		if o.Method != GridSearch {
+			continue
+		}
+		if p.Step.IsZero() && !p.Minimum.Equal(p.Maximum) {
+			return fmt.Errorf("%w %v grid search requires a step", errInvalidParameterRange, p.Name)
+		}
+		combinations *= int64(len(p.values()))
+		if combinations > MaximumOptimisationTasks {
+			return fmt.Errorf("%w, maximum %v", errTooManyParameterSets, MaximumOptimisationTasks)
+		}
+	}
+	if o.Method == RandomSearch {
+		if o.Iterations <= 0 {
+			return errInvalidIterations
+		}
+		if o.Iterations > MaximumOptimisationTasks {
+			return fmt.Errorf("%w, maximum %v", errTooManyParameterSets, MaximumOptimisationTasks)
+		}
+	}
+	return nil
+}
+
+// GenerateParameterSets returns every set of parameter values to run. Grid
+// search returns every combination of values, random search returns the
+// configured number of iterations of random values within each range
+func (o *Optimisation) GenerateParameterSets() ([][]ParameterValue, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	if o.Method == RandomSearch {
+		seed := o.Seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		r := rand.New(rand.NewSource(seed)) //nolint:gosec // repeatable parameter sampling, no need for crypto/rand
+		sets := make([][]ParameterValue, o.Iterations)
+		for i := range sets {
+			sets[i] = make([]ParameterValue, len(o.Parameters))
+			for j := range o.Parameters {
+				sets[i][j] = ParameterValue{
+					Name:  o.Parameters[j].Name,
+					Value: o.Parameters[j].randomValue(r),
+				}
+			}
+		}
+		return sets, nil
+	}
+	sets := [][]ParameterValue{{}}
+	for i := range o.Parameters {
+		values := o.Parameters[i].values()
+		next := make([][]ParameterValue, 0, len(sets)*len(values))
+		for j := range sets {
+			for k := range values {
+				set := make([]ParameterValue, len(sets[j]), len(o.Parameters))
+				copy(set, sets[j])
+				next = append(next, append(set, ParameterValue{
+					Name:  o.Parameters[i].Name,
+					Value: values[k],
+				}))
+			}
+		}
+		sets = next
+	}
+	return sets, nil
+}
+
+// values returns every value from the minimum to the maximum by step
+func (p *OptimisationParameter) values() []decimal.Decimal {
+	if p.Step.IsZero() {
+		return []decimal.Decimal{p.Minimum}
+	}
+	var resp []decimal.Decimal
+	for v := p.Minimum; v.LessThanOrEqual(p.Maximum); v = v.Add(p.Step) {
+		resp = append(resp, v)
+	}
+	return resp
+}
+
+// randomValue returns a random value within the range, rounded to the step
+// when one is set
+func (p *OptimisationParameter) randomValue(r *rand.Rand) decimal.Decimal {
+	v := p.Minimum.Add(p.Maximum.Sub(p.Minimum).Mul(decimal.NewFromFloat(r.Float64())))
+	if p.Step.IsPositive() {
+		v = p.Minimum.Add(v.Sub(p.Minimum).Div(p.Step).Floor().Mul(p.Step))
+	}
+	return v
+}
+
+// validateParameterName ensures a parameter can be applied to a strategy
+// config
+func validateParameterName(name string) error {
+	switch {
+	case strings.HasPrefix(name, CustomSettingsParameterPrefix):
+		if name == CustomSettingsParameterPrefix {
+			return fmt.Errorf("%w '%v' missing custom setting key", errUnknownOptimisationParameter, name)
+		}
+		return nil
+	case strings.HasPrefix(name, PortfolioSettingsParameterPrefix):
+		_, err := (&PortfolioSettings{}).parameter(name)
+		return err
+	}
+	return fmt.Errorf("%w '%v'", errUnknownOptimisationParameter, name)
+}
+
+// ApplyParameters sets each parameter value on the strategy config
+func (c *Config) ApplyParameters(params []ParameterValue) error {
+	if c == nil {
+		return fmt.Errorf("%w config", gctcommon.ErrNilPointer)
+	}
+	for i := range params {
+		name := strings.ToLower(params[i].Name)
+		switch {
+		case strings.HasPrefix(name, CustomSettingsParameterPrefix) && name != CustomSettingsParameterPrefix:
+			if c.StrategySettings.CustomSettings == nil {
+				c.StrategySettings.CustomSettings = make(map[string]interface{})
+			}
+			// custom settings are decoded from JSON so strategies expect numbers as float64
+			c.StrategySettings.CustomSettings[strings.TrimPrefix(name, CustomSettingsParameterPrefix)] = params[i].Value.InexactFloat64()
+		case strings.HasPrefix(name, PortfolioSettingsParameterPrefix):
+			v, err := c.PortfolioSettings.parameter(name)
+			if err != nil {
+				return err
+			}
+			*v = params[i].Value
+		default:
+			return fmt.Errorf("%w '%v'", errUnknownOptimisationParameter, params[i].Name)
+		}
+	}
+	return nil
+}
+
+// Copy returns a deep copy of the strategy config so it can be modified and
+// used to setup a backtesting task without affecting the original
+func (c *Config) Copy() (*Config, error) {
+	if c == nil {
+		return nil, fmt.Errorf("%w config", gctcommon.ErrNilPointer)
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var resp *Config
+	return resp, json.Unmarshal(data, &resp)
+}
+
+// parameter returns the portfolio setting referenced by an optimisation
+// parameter name
+func (p *PortfolioSettings) parameter(name string) (*decimal.Decimal, error) {
+	switch strings.TrimPrefix(name, PortfolioSettingsParameterPrefix) {
+	case "leverage.maximum-orders-with-leverage-ratio":
+		return &p.Leverage.MaximumOrdersWithLeverageRatio, nil
+	case "leverage.maximum-leverage-rate":
+		return &p.Leverage.MaximumOrderLeverageRate, nil
+	case "leverage.maximum-collateral-leverage-rate":
+		return &p.Leverage.MaximumCollateralLeverageRate, nil
+	case "buy-side.minimum-size":
+		return &p.BuySide.MinimumSize, nil
+	case "buy-side.maximum-size":
+		return &p.BuySide.MaximumSize, nil
+	case "buy-side.maximum-total":
+		return &p.BuySide.MaximumTotal, nil
+	case "sell-side.minimum-size":
+		return &p.SellSide.MinimumSize, nil
+	case "sell-side.maximum-size":
+		return &p.SellSide.MaximumSize, nil
+	case "sell-side.maximum-total":
+		return &p.SellSide.MaximumTotal, nil
+	}
+	return nil, fmt.Errorf("%w '%v'", errUnknownOptimisationParameter, name)
+}
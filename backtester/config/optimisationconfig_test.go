@@ -0,0 +1,315 @@
+package config
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+)
+
+func TestReadOptimisationFromFile(t *testing.T) {
+	t.Parallel()
+	_, err := ReadOptimisationFromFile("test")
+	if !errors.Is(err, common.ErrFileNotFound) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrFileNotFound)
+	}
+
+	o, err := ReadOptimisationFromFile(filepath.Join("strategyexamples", "rsi-api-candles.opt"))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	err = o.Validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if len(o.Parameters) != 3 {
+		t.Errorf("received: %v, expected: %v", len(o.Parameters), 3)
+	}
+}
+
+func TestIsValidOptimisationMetric(t *testing.T) {
+	t.Parallel()
+	for _, m := range []string{SharpeRatioMetric, SortinoRatioMetric, CalmarRatioMetric, PNLMetric, "SHARPE"} {
+		if !IsValidOptimisationMetric(m) {
+			t.Errorf("received: %v, expected: %v for %v", false, true, m)
+		}
+	}
+	if IsValidOptimisationMetric("omega") {
+		t.Errorf("received: %v, expected: %v", true, false)
+	}
+}
+
+func TestOptimisationValidate(t *testing.T) {
+	t.Parallel()
+	var o *Optimisation
+	err := o.Validate()
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received: %v, expected: %v", err, gctcommon.ErrNilPointer)
+	}
+
+	o = &Optimisation{}
+	err = o.Validate()
+	if !errors.Is(err, errNoOptimisationParameters) {
+		t.Errorf("received: %v, expected: %v", err, errNoOptimisationParameters)
+	}
+	if o.Method != GridSearch {
+		t.Errorf("received: %v, expected: %v", o.Method, GridSearch)
+	}
+	if o.Metric != SharpeRatioMetric {
+		t.Errorf("received: %v, expected: %v", o.Metric, SharpeRatioMetric)
+	}
+
+	o.Method = "annealing"
+	err = o.Validate()
+	if !errors.Is(err, errUnknownOptimisationMethod) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownOptimisationMethod)
+	}
+
+	o.Method = "GRID"
+	o.Metric = "omega"
+	err = o.Validate()
+	if !errors.Is(err, ErrUnknownOptimisationMetric) {
+		t.Errorf("received: %v, expected: %v", err, ErrUnknownOptimisationMetric)
+	}
+
+	o.Metric = PNLMetric
+	o.MaximumParallelTasks = -1
+	err = o.Validate()
+	if !errors.Is(err, errNegativeParallelTasks) {
+		t.Errorf("received: %v, expected: %v", err, errNegativeParallelTasks)
+	}
+
+	o.MaximumParallelTasks = 0
+	o.Parameters = []OptimisationParameter{{Name: "strategy-settings.name"}}
+	err = o.Validate()
+	if !errors.Is(err, errUnknownOptimisationParameter) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownOptimisationParameter)
+	}
+
+	o.Parameters = []OptimisationParameter{{Name: CustomSettingsParameterPrefix}}
+	err = o.Validate()
+	if !errors.Is(err, errUnknownOptimisationParameter) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownOptimisationParameter)
+	}
+
+	o.Parameters = []OptimisationParameter{{Name: "portfolio-settings.buy-side.fake"}}
+	err = o.Validate()
+	if !errors.Is(err, errUnknownOptimisationParameter) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownOptimisationParameter)
+	}
+
+	o.Parameters = []OptimisationParameter{
+		{Name: "custom-settings.rsi-period"},
+		{Name: " Custom-Settings.RSI-Period "},
+	}
+	err = o.Validate()
+	if !errors.Is(err, errDuplicateOptimisationParam) {
+		t.Errorf("received: %v, expected: %v", err, errDuplicateOptimisationParam)
+	}
+
+	o.Parameters = []OptimisationParameter{{
+		Name:    "custom-settings.rsi-period",
+		Minimum: decimal.NewFromInt(2),
+		Maximum: decimal.NewFromInt(1),
+	}}
+	err = o.Validate()
+	if !errors.Is(err, errInvalidParameterRange) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidParameterRange)
+	}
+
+	o.Parameters[0].Maximum = decimal.NewFromInt(10)
+	o.Parameters[0].Step = decimal.NewFromInt(-1)
+	err = o.Validate()
+	if !errors.Is(err, errInvalidParameterRange) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidParameterRange)
+	}
+
+	o.Parameters[0].Step = decimal.Zero
+	err = o.Validate()
+	if !errors.Is(err, errInvalidParameterRange) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidParameterRange)
+	}
+
+	o.Parameters[0].Step = decimal.NewFromInt(1)
+	err = o.Validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+
+	o.Parameters = append(o.Parameters, OptimisationParameter{
+		Name:    "portfolio-settings.buy-side.maximum-size",
+		Minimum: decimal.NewFromInt(1),
+		Maximum: decimal.NewFromInt(MaximumOptimisationTasks),
+		Step:    decimal.NewFromInt(1),
+	})
+	err = o.Validate()
+	if !errors.Is(err, errTooManyParameterSets) {
+		t.Errorf("received: %v, expected: %v", err, errTooManyParameterSets)
+	}
+
+	o.Method = RandomSearch
+	err = o.Validate()
+	if !errors.Is(err, errInvalidIterations) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidIterations)
+	}
+
+	o.Iterations = MaximumOptimisationTasks + 1
+	err = o.Validate()
+	if !errors.Is(err, errTooManyParameterSets) {
+		t.Errorf("received: %v, expected: %v", err, errTooManyParameterSets)
+	}
+
+	o.Iterations = 50
+	err = o.Validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
+func TestGenerateParameterSets(t *testing.T) {
+	t.Parallel()
+	o := &Optimisation{}
+	_, err := o.GenerateParameterSets()
+	if !errors.Is(err, errNoOptimisationParameters) {
+		t.Errorf("received: %v, expected: %v", err, errNoOptimisationParameters)
+	}
+
+	o.Parameters = []OptimisationParameter{
+		{
+			Name:    "custom-settings.rsi-high",
+			Minimum: decimal.NewFromInt(70),
+			Maximum: decimal.NewFromInt(80),
+			Step:    decimal.NewFromInt(5),
+		},
+		{
+			Name:    "custom-settings.rsi-low",
+			Minimum: decimal.NewFromInt(20),
+			Maximum: decimal.NewFromInt(30),
+			Step:    decimal.NewFromInt(10),
+		},
+		{
+			Name:    "portfolio-settings.buy-side.maximum-size",
+			Minimum: decimal.NewFromInt(1),
+			Maximum: decimal.NewFromInt(1),
+		},
+	}
+	sets, err := o.GenerateParameterSets()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(sets) != 6 {
+		t.Fatalf("received: %v, expected: %v", len(sets), 6)
+	}
+	seen := make(map[string]bool)
+	for i := range sets {
+		if len(sets[i]) != 3 {
+			t.Fatalf("received: %v, expected: %v", len(sets[i]), 3)
+		}
+		k := sets[i][0].Value.String() + "-" + sets[i][1].Value.String()
+		if seen[k] {
+			t.Errorf("received duplicate parameter set %v", k)
+		}
+		seen[k] = true
+		if !sets[i][2].Value.Equal(decimal.NewFromInt(1)) {
+			t.Errorf("received: %v, expected: %v", sets[i][2].Value, 1)
+		}
+	}
+
+	o.Method = RandomSearch
+	o.Iterations = 20
+	o.Seed = 1337
+	o.Parameters[0].Step = decimal.NewFromFloat(0.5)
+	sets, err = o.GenerateParameterSets()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(sets) != 20 {
+		t.Fatalf("received: %v, expected: %v", len(sets), 20)
+	}
+	repeat, err := o.GenerateParameterSets()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	for i := range sets {
+		for j := range sets[i] {
+			if !sets[i][j].Value.Equal(repeat[i][j].Value) {
+				t.Errorf("received: %v, expected: %v", repeat[i][j].Value, sets[i][j].Value)
+			}
+			p := o.Parameters[j]
+			if sets[i][j].Value.LessThan(p.Minimum) || sets[i][j].Value.GreaterThan(p.Maximum) {
+				t.Errorf("received: %v, expected value between %v and %v", sets[i][j].Value, p.Minimum, p.Maximum)
+			}
+			if p.Step.IsPositive() && !sets[i][j].Value.Sub(p.Minimum).Mod(p.Step).IsZero() {
+				t.Errorf("received: %v, expected a multiple of step %v", sets[i][j].Value, p.Step)
+			}
+		}
+	}
+}
+
+func TestApplyParameters(t *testing.T) {
+	t.Parallel()
+	var c *Config
+	err := c.ApplyParameters(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received: %v, expected: %v", err, gctcommon.ErrNilPointer)
+	}
+
+	c = &Config{}
+	err = c.ApplyParameters([]ParameterValue{{Name: "strategy-settings.name"}})
+	if !errors.Is(err, errUnknownOptimisationParameter) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownOptimisationParameter)
+	}
+
+	err = c.ApplyParameters([]ParameterValue{{Name: "portfolio-settings.fake"}})
+	if !errors.Is(err, errUnknownOptimisationParameter) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownOptimisationParameter)
+	}
+
+	err = c.ApplyParameters([]ParameterValue{
+		{Name: "custom-settings.rsi-period", Value: decimal.NewFromInt(14)},
+		{Name: "portfolio-settings.leverage.maximum-leverage-rate", Value: decimal.NewFromInt(5)},
+		{Name: "portfolio-settings.sell-side.maximum-total", Value: decimal.NewFromInt(1337)},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if c.StrategySettings.CustomSettings["rsi-period"] != float64(14) {
+		t.Errorf("received: %v, expected: %v", c.StrategySettings.CustomSettings["rsi-period"], 14)
+	}
+	if !c.PortfolioSettings.Leverage.MaximumOrderLeverageRate.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received: %v, expected: %v", c.PortfolioSettings.Leverage.MaximumOrderLeverageRate, 5)
+	}
+	if !c.PortfolioSettings.SellSide.MaximumTotal.Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received: %v, expected: %v", c.PortfolioSettings.SellSide.MaximumTotal, 1337)
+	}
+}
+
+func TestConfigCopy(t *testing.T) {
+	t.Parallel()
+	var c *Config
+	_, err := c.Copy()
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received: %v, expected: %v", err, gctcommon.ErrNilPointer)
+	}
+
+	c = &Config{
+		Nickname: "copy",
+		StrategySettings: StrategySettings{
+			CustomSettings: map[string]interface{}{"rsi-period": float64(14)},
+		},
+	}
+	cp, err := c.Copy()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if cp.Nickname != c.Nickname {
+		t.Errorf("received: %v, expected: %v", cp.Nickname, c.Nickname)
+	}
+	cp.StrategySettings.CustomSettings["rsi-period"] = float64(20)
+	if c.StrategySettings.CustomSettings["rsi-period"] != float64(14) {
+		t.Errorf("received: %v, expected: %v", c.StrategySettings.CustomSettings["rsi-period"], 14)
+	}
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// Optimisation search methods
+const (
+	GridSearch   = "grid"
+	RandomSearch = "random"
+)
+
+// Optimisation metrics used to rank results
+const (
+	SharpeRatioMetric  = "sharpe"
+	SortinoRatioMetric = "sortino"
+	CalmarRatioMetric  = "calmar"
+	PNLMetric          = "pnl"
+)
+
+// Optimisation parameter name prefixes
+const (
+	CustomSettingsParameterPrefix    = "custom-settings."
+	PortfolioSettingsParameterPrefix = "portfolio-settings."
+)
+
+// MaximumOptimisationTasks limits the amount of parameter sets a single
+// optimisation can generate, as every set is a separate backtesting task
+const MaximumOptimisationTasks = 10000
+
+var (
+	errNoOptimisationParameters     = errors.New("no optimisation parameters set")
+	errUnknownOptimisationMethod    = errors.New("unknown optimisation method")
+	errUnknownOptimisationParameter = errors.New("unknown optimisation parameter")
+	errDuplicateOptimisationParam   = errors.New("duplicate optimisation parameter")
+	errInvalidParameterRange        = errors.New("invalid optimisation parameter range")
+	errInvalidIterations            = errors.New("random search requires iterations greater than zero")
+	errTooManyParameterSets         = errors.New("optimisation generates too many parameter sets")
+	errNegativeParallelTasks        = errors.New("maximum parallel tasks cannot be negative")
+
+	// ErrUnknownOptimisationMetric is returned when a metric cannot be used
+	// to rank optimisation results
+	ErrUnknownOptimisationMetric = errors.New("unknown optimisation metric")
+)
+
+// Optimisation defines the parameter ranges searched when optimising a
+// strategy config, along with how to search and rank them
+type Optimisation struct {
+	// Method is either 'grid', which runs every combination of parameter
+	// values, or 'random', which runs a number of random combinations
+	Method string `json:"method"`
+	// Metric ranks results by 'sharpe', 'sortino', 'calmar' or 'pnl'
+	Metric string `json:"metric"`
+	// Iterations is the number of parameter sets generated by random search
+	Iterations int64 `json:"iterations,omitempty"`
+	// Seed allows random search to be repeated. Zero uses the current time
+	Seed int64 `json:"seed,omitempty"`
+	// MaximumParallelTasks limits how many tasks run at once. Zero uses the
+	// number of CPUs available
+	MaximumParallelTasks int64                   `json:"maximum-parallel-tasks,omitempty"`
+	Parameters           []OptimisationParameter `json:"parameters"`
+}
+
+// OptimisationParameter is a strategy config value to search and the range
+// of values to search it over. Names are prefixed with 'custom-settings.'
+// for strategy custom settings or 'portfolio-settings.' followed by the
+// portfolio setting path, eg 'portfolio-settings.buy-side.maximum-size'
+type OptimisationParameter struct {
+	Name    string          `json:"name"`
+	Minimum decimal.Decimal `json:"minimum"`
+	Maximum decimal.Decimal `json:"maximum"`
+	// Step is the increment between values. It is required for grid search
+	// and rounds random values when set
+	Step decimal.Decimal `json:"step"`
+}
+
+// ParameterValue is a single optimisation parameter value applied to a
+// strategy config
+type ParameterValue struct {
+	Name  string          `json:"name"`
+	Value decimal.Decimal `json:"value"`
+}
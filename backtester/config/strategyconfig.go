@@ -9,6 +9,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
@@ -50,6 +51,14 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = c.validateStatisticSettings()
+	if err != nil {
+		return err
+	}
+	err = c.validateTickMode()
+	if err != nil {
+		return err
+	}
 	return c.validateMinMaxes()
 }
 
@@ -133,6 +142,50 @@ func (c *Config) validateStrategySettings() error {
 	return fmt.Errorf("strategty %v %w", c.StrategySettings.Name, base.ErrStrategyNotFound)
 }
 
+// validateStatisticSettings ensures monte carlo analysis can be run against
+// the USD totals of a completed run
+func (c *Config) validateStatisticSettings() error {
+	mc := c.StatisticSettings.MonteCarlo
+	if mc == nil {
+		return nil
+	}
+	if c.StrategySettings.DisableUSDTracking {
+		return fmt.Errorf("%w, monte carlo analysis requires USD tracking", errFeatureIncompatible)
+	}
+	if mc.Method != statistics.MonteCarloBootstrap && mc.Method != statistics.MonteCarloShuffle {
+		return fmt.Errorf("%w, method '%v' must be '%v' or '%v'", errInvalidMonteCarloSettings, mc.Method, statistics.MonteCarloBootstrap, statistics.MonteCarloShuffle)
+	}
+	if mc.Simulations <= 0 || mc.Simulations > statistics.MaximumMonteCarloSimulations {
+		return fmt.Errorf("%w, simulations must be greater than zero and no more than %v", errInvalidMonteCarloSettings, statistics.MaximumMonteCarloSimulations)
+	}
+	return nil
+}
+
+// validateTickMode ensures tick mode is only used with historical trade data
+// for a single currency. Trades rarely align with USD tracking candles, so
+// USD tracking must be disabled
+func (c *Config) validateTickMode() error {
+	if !c.DataSettings.TickMode {
+		return nil
+	}
+	if c.DataSettings.DataType != common.TradeStr {
+		return fmt.Errorf("%w, data type must be '%v'", errInvalidTickModeSettings, common.TradeStr)
+	}
+	if c.DataSettings.LiveData != nil || c.DataSettings.OrderbookData != nil {
+		return fmt.Errorf("%w, only api, csv and database data is supported", errInvalidTickModeSettings)
+	}
+	if len(c.CurrencySettings) != 1 {
+		return fmt.Errorf("%w, only one currency setting is supported", errInvalidTickModeSettings)
+	}
+	if c.StrategySettings.SimultaneousSignalProcessing {
+		return fmt.Errorf("%w, simultaneous signal processing is not supported", errInvalidTickModeSettings)
+	}
+	if !c.StrategySettings.DisableUSDTracking {
+		return fmt.Errorf("%w, tick mode requires USD tracking to be disabled", errFeatureIncompatible)
+	}
+	return nil
+}
+
 // validateDate checks whether someone has set a date poorly in their config
 func (c *Config) validateDate() error {
 	if c.DataSettings.DatabaseData != nil {
@@ -212,6 +265,9 @@ func (c *Config) validateCurrencySettings() error {
 			c.CurrencySettings[i].MinimumSlippagePercent.GreaterThan(c.CurrencySettings[i].MaximumSlippagePercent) {
 			return errBadSlippageRates
 		}
+		if err := c.CurrencySettings[i].FeeSchedule.validate(); err != nil {
+			return err
+		}
 		c.CurrencySettings[i].ExchangeName = strings.ToLower(c.CurrencySettings[i].ExchangeName)
 	}
 	if hasSlippage && hasFutures {
@@ -220,6 +276,32 @@ func (c *Config) validateCurrencySettings() error {
 	return nil
 }
 
+// validate checks that fee tiers are in ascending order of minimum volume and
+// that rates are valid
+func (f *FeeSchedule) validate() error {
+	if f == nil {
+		return nil
+	}
+	if f.VolumeWindow < 0 || f.InitialVolume.IsNegative() {
+		return fmt.Errorf("%w: volume window and initial volume must not be negative", errBadFeeSchedule)
+	}
+	if f.TokenDiscountRate.IsNegative() || f.TokenDiscountRate.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w: token discount rate must be between zero and one", errBadFeeSchedule)
+	}
+	for i := range f.Tiers {
+		if i == 0 && !f.Tiers[i].MinimumVolume.IsZero() {
+			return fmt.Errorf("%w: first tier must have a minimum volume of zero", errBadFeeSchedule)
+		}
+		if i > 0 && f.Tiers[i].MinimumVolume.LessThanOrEqual(f.Tiers[i-1].MinimumVolume) {
+			return fmt.Errorf("%w: tiers must be in ascending order of minimum volume", errBadFeeSchedule)
+		}
+		if f.Tiers[i].TakerFee.IsNegative() || f.Tiers[i].MakerFee.GreaterThan(f.Tiers[i].TakerFee) {
+			return fmt.Errorf("%w: tier %v maker fee must not exceed a non-negative taker fee", errBadFeeSchedule, i)
+		}
+	}
+	return nil
+}
+
 // PrintSetting prints relevant settings to the console for easy reading
 func (c *Config) PrintSetting() {
 	log.Infoln(common.Config, common.CMDColours.H1+"------------------Backtester Settings------------------------"+common.CMDColours.Default)
@@ -288,6 +370,14 @@ func (c *Config) PrintSetting() {
 				log.Infof(common.Config, "Maker fee: %v", c.CurrencySettings[i].MakerFee.Round(8))
 			}
 		}
+		if c.CurrencySettings[i].FeeSchedule != nil {
+			if len(c.CurrencySettings[i].FeeSchedule.Tiers) == 0 {
+				log.Infof(common.Config, "Fee schedule: Using Exchange's fee schedule")
+			} else {
+				log.Infof(common.Config, "Fee schedule: %+v", c.CurrencySettings[i].FeeSchedule.Tiers)
+			}
+			log.Infof(common.Config, "Fee schedule initial volume: %v", c.CurrencySettings[i].FeeSchedule.InitialVolume.Round(8))
+		}
 		log.Infof(common.Config, "Minimum slippage percent: %v", c.CurrencySettings[i].MinimumSlippagePercent.Round(8))
 		log.Infof(common.Config, "Maximum slippage percent: %v", c.CurrencySettings[i].MaximumSlippagePercent.Round(8))
 		log.Infof(common.Config, "Buy rules: %+v", c.CurrencySettings[i].BuySide)
@@ -326,6 +416,14 @@ func (c *Config) PrintSetting() {
 		log.Infof(common.Config, "Interval: %v", c.DataSettings.Interval)
 		log.Infof(common.Config, "CSV file: %v", c.DataSettings.CSVData.FullPath)
 	}
+	if c.DataSettings.TickMode {
+		log.Infof(common.Config, "Tick mode: %v", c.DataSettings.TickMode)
+	}
+	if c.DataSettings.OrderbookData != nil {
+		log.Infoln(common.Config, common.CMDColours.H2+"------------------Orderbook Settings-------------------------"+common.CMDColours.Default)
+		log.Infof(common.Config, "Interval: %v", c.DataSettings.Interval)
+		log.Infof(common.Config, "Orderbook path: %v", c.DataSettings.OrderbookData.Path)
+	}
 	if c.DataSettings.DatabaseData != nil {
 		log.Infoln(common.Config, common.CMDColours.H2+"------------------Database Settings--------------------------"+common.CMDColours.Default)
 		log.Infof(common.Config, "Data type: %v", c.DataSettings.DataType)
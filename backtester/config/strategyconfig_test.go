@@ -10,8 +10,10 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/top2bottom2"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/triangulararbitrage"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -106,6 +108,83 @@ func TestValidateDate(t *testing.T) {
 	}
 }
 
+func TestValidateStatisticSettings(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	err := c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.StatisticSettings.MonteCarlo = &MonteCarloSettings{}
+	c.StrategySettings.DisableUSDTracking = true
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received: %v, expected: %v", err, errFeatureIncompatible)
+	}
+	c.StrategySettings.DisableUSDTracking = false
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidMonteCarloSettings)
+	}
+	c.StatisticSettings.MonteCarlo.Method = statistics.MonteCarloBootstrap
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidMonteCarloSettings)
+	}
+	c.StatisticSettings.MonteCarlo.Simulations = statistics.MaximumMonteCarloSimulations + 1
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidMonteCarloSettings)
+	}
+	c.StatisticSettings.MonteCarlo.Simulations = 1000
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
+func TestValidateTickMode(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	err := c.validateTickMode()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.DataSettings.TickMode = true
+	c.DataSettings.DataType = common.CandleStr
+	err = c.validateTickMode()
+	if !errors.Is(err, errInvalidTickModeSettings) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidTickModeSettings)
+	}
+	c.DataSettings.DataType = common.TradeStr
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateTickMode()
+	if !errors.Is(err, errInvalidTickModeSettings) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidTickModeSettings)
+	}
+	c.DataSettings.LiveData = nil
+	err = c.validateTickMode()
+	if !errors.Is(err, errInvalidTickModeSettings) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidTickModeSettings)
+	}
+	c.CurrencySettings = []CurrencySettings{{}}
+	c.StrategySettings.SimultaneousSignalProcessing = true
+	err = c.validateTickMode()
+	if !errors.Is(err, errInvalidTickModeSettings) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidTickModeSettings)
+	}
+	c.StrategySettings.SimultaneousSignalProcessing = false
+	err = c.validateTickMode()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received: %v, expected: %v", err, errFeatureIncompatible)
+	}
+	c.StrategySettings.DisableUSDTracking = true
+	err = c.validateTickMode()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
 func TestValidateCurrencySettings(t *testing.T) {
 	t.Parallel()
 	c := Config{}
@@ -210,6 +289,76 @@ func TestValidateCurrencySettings(t *testing.T) {
 	}
 }
 
+func TestValidateFeeSchedule(t *testing.T) {
+	t.Parallel()
+	var f *FeeSchedule
+	err := f.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	f = &FeeSchedule{}
+	err = f.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	f.InitialVolume = decimal.NewFromInt(-1)
+	err = f.validate()
+	if !errors.Is(err, errBadFeeSchedule) {
+		t.Errorf("received: %v, expected: %v", err, errBadFeeSchedule)
+	}
+	f.InitialVolume = decimal.Zero
+	f.TokenDiscountRate = decimal.NewFromInt(1)
+	err = f.validate()
+	if !errors.Is(err, errBadFeeSchedule) {
+		t.Errorf("received: %v, expected: %v", err, errBadFeeSchedule)
+	}
+	f.TokenDiscountRate = decimal.NewFromFloat(0.25)
+	f.Tiers = []FeeTier{
+		{MinimumVolume: decimal.NewFromInt(1)},
+	}
+	err = f.validate()
+	if !errors.Is(err, errBadFeeSchedule) {
+		t.Errorf("received: %v, expected: %v", err, errBadFeeSchedule)
+	}
+	f.Tiers = []FeeTier{
+		{TakerFee: decimal.NewFromFloat(0.002)},
+		{TakerFee: decimal.NewFromFloat(0.001)},
+	}
+	err = f.validate()
+	if !errors.Is(err, errBadFeeSchedule) {
+		t.Errorf("received: %v, expected: %v", err, errBadFeeSchedule)
+	}
+	f.Tiers[1].MinimumVolume = decimal.NewFromInt(1000)
+	f.Tiers[1].MakerFee = decimal.NewFromFloat(0.01)
+	err = f.validate()
+	if !errors.Is(err, errBadFeeSchedule) {
+		t.Errorf("received: %v, expected: %v", err, errBadFeeSchedule)
+	}
+	f.Tiers[1].MakerFee = decimal.NewFromFloat(-0.0001)
+	err = f.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+
+	leet := decimal.NewFromInt(1337)
+	c := Config{
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: "lol",
+				Asset:        asset.Spot,
+				Base:         currency.BTC,
+				Quote:        currency.USDT,
+				SpotDetails:  &SpotDetails{InitialQuoteFunds: &leet},
+				FeeSchedule:  &FeeSchedule{InitialVolume: decimal.NewFromInt(-1)},
+			},
+		},
+	}
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errBadFeeSchedule) {
+		t.Errorf("received: %v, expected: %v", err, errBadFeeSchedule)
+	}
+}
+
 func TestValidateMinMaxes(t *testing.T) {
 	t.Parallel()
 	c := &Config{}
@@ -1323,6 +1472,108 @@ func TestGenerateConfigForTop2Bottom2(t *testing.T) {
 	}
 }
 
+func TestGenerateConfigForTriangularArbitrage(t *testing.T) {
+	if !saveConfig {
+		t.Skip("saveConfig false, skipping")
+	}
+	cfg := Config{
+		Nickname: "ExampleStrategyTriangularArbitrage",
+		Goal:     "To demonstrate trading triangular cycles between the pairs of a single exchange using exchange level funding and simultaneous processing of data signals",
+		StrategySettings: StrategySettings{
+			Name:                         triangulararbitrage.Name,
+			SimultaneousSignalProcessing: true,
+			CustomSettings: map[string]interface{}{
+				"fee-rate":                    0.001,
+				"minimum-profit-basis-points": 5,
+			},
+		},
+		FundingSettings: FundingSettings{
+			UseExchangeLevelFunding: true,
+			ExchangeLevelFunding: []ExchangeLevelFunding{
+				{
+					ExchangeName: mainExchange,
+					Asset:        asset.Spot,
+					Currency:     mainCurrencyPair.Base,
+					InitialFunds: decimal.NewFromFloat(0.5),
+				},
+				{
+					ExchangeName: mainExchange,
+					Asset:        asset.Spot,
+					Currency:     currency.ETH,
+					InitialFunds: decimal.NewFromInt(5),
+				},
+				{
+					ExchangeName: mainExchange,
+					Asset:        asset.Spot,
+					Currency:     mainCurrencyPair.Quote,
+					InitialFunds: decimal.NewFromInt(10000),
+				},
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				BuySide:      minMax,
+				SellSide:     minMax,
+				MakerFee:     &makerFee,
+				TakerFee:     &takerFee,
+			},
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         currency.ETH,
+				Quote:        mainCurrencyPair.Quote,
+				BuySide:      minMax,
+				SellSide:     minMax,
+				MakerFee:     &makerFee,
+				TakerFee:     &takerFee,
+			},
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         currency.ETH,
+				Quote:        mainCurrencyPair.Base,
+				BuySide:      minMax,
+				SellSide:     minMax,
+				MakerFee:     &makerFee,
+				TakerFee:     &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneHour,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate: startDate,
+				EndDate:   endDate,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "triangular-arbitrage-api-candles.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func TestGenerateBinanceCashAndCarryStrategy(t *testing.T) {
 	if !saveConfig {
 		t.Skip("saveConfig false, skipping")
@@ -26,6 +26,9 @@ var (
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
 	errPerpetualsUnsupported            = errors.New("perpetual futures not yet supported")
 	errFeatureIncompatible              = errors.New("feature is not compatible")
+	errBadFeeSchedule                   = errors.New("invalid fee schedule in currency settings, please check your config")
+	errInvalidMonteCarloSettings        = errors.New("invalid monte carlo settings, please check your config")
+	errInvalidTickModeSettings          = errors.New("invalid tick mode settings, please check your config")
 )
 
 // Config defines what is in an individual strategy config
@@ -50,6 +53,10 @@ type DataSettings struct {
 	DatabaseData            *DatabaseData  `json:"database-data,omitempty"`
 	LiveData                *LiveData      `json:"live-data,omitempty"`
 	CSVData                 *CSVData       `json:"csv-data,omitempty"`
+	OrderbookData           *OrderbookData `json:"orderbook-data,omitempty"`
+	// TickMode streams every trade as a data event instead of aggregating
+	// trades into candles. The interval is only used for reporting
+	TickMode bool `json:"tick-mode,omitempty"`
 }
 
 // FundingSettings contains funding details for individual currencies
@@ -89,7 +96,20 @@ type ExchangeLevelFunding struct {
 // StatisticSettings adjusts ratios where
 // proper data is currently lacking
 type StatisticSettings struct {
-	RiskFreeRate decimal.Decimal `json:"risk-free-rate"`
+	RiskFreeRate decimal.Decimal     `json:"risk-free-rate"`
+	MonteCarlo   *MonteCarloSettings `json:"monte-carlo,omitempty"`
+}
+
+// MonteCarloSettings enables resampling the per-candle returns of a completed
+// run to show how much its results depend on the order returns occurred in
+type MonteCarloSettings struct {
+	// Method is either bootstrap, which samples returns with replacement, or
+	// shuffle, which reorders the actual returns
+	Method string `json:"method"`
+	// Simulations is the number of resampled return sequences
+	Simulations int64 `json:"simulations"`
+	// Seed allows simulations to be repeated. Zero uses the current time
+	Seed int64 `json:"seed,omitempty"`
 }
 
 // PortfolioSettings act as a global protector for strategies
@@ -153,6 +173,35 @@ type CurrencySettings struct {
 	CanUseExchangeLimits          bool `json:"use-exchange-order-limits"`
 	ShowExchangeOrderLimitWarning bool `json:"-"`
 	UseExchangePNLCalculation     bool `json:"use-exchange-pnl-calculation"`
+
+	// FeeSchedule simulates the fee tier changing as trade volume
+	// accumulates during the run. It takes precedence over fee overrides
+	FeeSchedule *FeeSchedule `json:"fee-schedule,omitempty"`
+}
+
+// FeeSchedule defines volume based fee tiers used to simulate an exchange
+// account's fee tier over a run. When no tiers are set the exchange's fee
+// schedule is used
+type FeeSchedule struct {
+	Tiers []FeeTier `json:"tiers,omitempty"`
+	// VolumeWindow is the trailing period volume is accumulated over and
+	// defaults to thirty days
+	VolumeWindow time.Duration `json:"volume-window,omitempty"`
+	// InitialVolume is the trailing volume traded before the run starts
+	InitialVolume decimal.Decimal `json:"initial-volume"`
+	// TokenDiscountRate reduces fees as if paid in an exchange token,
+	// overriding the exchange's token discount when set
+	TokenDiscountRate decimal.Decimal `json:"token-discount-rate"`
+	PayFeesWithToken  bool            `json:"pay-fees-with-token"`
+}
+
+// FeeTier is a fee level which applies once trailing volume in the quote
+// currency reaches the minimum volume. A negative maker fee is a rebate
+type FeeTier struct {
+	Name          string          `json:"name"`
+	MinimumVolume decimal.Decimal `json:"minimum-volume"`
+	MakerFee      decimal.Decimal `json:"maker-fee"`
+	TakerFee      decimal.Decimal `json:"taker-fee"`
 }
 
 // SpotDetails contains funding information that cannot be shared with another
@@ -179,6 +228,13 @@ type CSVData struct {
 	FullPath string `json:"full-path"`
 }
 
+// OrderbookData defines all fields to configure recorded orderbook based data.
+// Candles are built from the replayed mid price and orders are filled against
+// the replayed orderbook depth
+type OrderbookData struct {
+	Path string `json:"path"`
+}
+
 // DatabaseData defines all fields to configure database based data
 type DatabaseData struct {
 	StartDate        time.Time       `json:"start-date"`
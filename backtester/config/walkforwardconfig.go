@@ -0,0 +1,91 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// ReadWalkForwardFromFile will take walk-forward settings from a path
+func ReadWalkForwardFromFile(path string) (*WalkForward, error) {
+	if !file.Exists(path) {
+		return nil, fmt.Errorf("%w %v", common.ErrFileNotFound, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *WalkForward
+	err = json.Unmarshal(data, &resp)
+	return resp, err
+}
+
+// Validate checks walk-forward settings against the strategy config's candle
+// interval. Windows must be a multiple of the interval so that every window
+// starts on a candle
+func (w *WalkForward) Validate(interval gctkline.Interval) error {
+	if w == nil {
+		return fmt.Errorf("%w walk-forward", gctcommon.ErrNilPointer)
+	}
+	if w.InSampleWindow <= 0 || w.OutOfSampleWindow <= 0 {
+		return fmt.Errorf("%w, in-sample and out-of-sample windows must be greater than zero", errInvalidWalkForwardWindow)
+	}
+	if interval > 0 &&
+		(w.InSampleWindow%interval.Duration() != 0 || w.OutOfSampleWindow%interval.Duration() != 0) {
+		return fmt.Errorf("%w, windows must be a multiple of the candle interval %v", errInvalidWalkForwardWindow, interval)
+	}
+	if err := w.Optimisation.Validate(); err != nil {
+		return err
+	}
+	for i := range w.Optimisation.Parameters {
+		if !strings.HasPrefix(w.Optimisation.Parameters[i].Name, CustomSettingsParameterPrefix) {
+			return fmt.Errorf("%w '%v'", errWalkForwardParameter, w.Optimisation.Parameters[i].Name)
+		}
+	}
+	return nil
+}
+
+// Windows splits the data range into in-sample and out-of-sample windows.
+// Each out-of-sample window immediately follows its in-sample window and the
+// final out-of-sample window is shortened to end with the data range
+func (w *WalkForward) Windows(start, end time.Time) ([]WalkForwardWindow, error) {
+	if w == nil {
+		return nil, fmt.Errorf("%w walk-forward", gctcommon.ErrNilPointer)
+	}
+	if w.InSampleWindow <= 0 || w.OutOfSampleWindow <= 0 {
+		return nil, fmt.Errorf("%w, in-sample and out-of-sample windows must be greater than zero", errInvalidWalkForwardWindow)
+	}
+	var resp []WalkForwardWindow
+	for inSampleStart := start; ; inSampleStart = inSampleStart.Add(w.OutOfSampleWindow) {
+		window := WalkForwardWindow{
+			InSampleStart: inSampleStart,
+			InSampleEnd:   inSampleStart.Add(w.InSampleWindow),
+		}
+		if w.Anchored {
+			window.InSampleStart = start
+			window.InSampleEnd = start.Add(w.InSampleWindow + w.OutOfSampleWindow*time.Duration(len(resp)))
+		}
+		window.OutOfSampleStart = window.InSampleEnd
+		if !window.OutOfSampleStart.Before(end) {
+			break
+		}
+		window.OutOfSampleEnd = window.OutOfSampleStart.Add(w.OutOfSampleWindow)
+		if window.OutOfSampleEnd.After(end) {
+			window.OutOfSampleEnd = end
+		}
+		resp = append(resp, window)
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w %v to %v", errNoWalkForwardWindows, start, end)
+	}
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestReadWalkForwardFromFile(t *testing.T) {
+	t.Parallel()
+	_, err := ReadWalkForwardFromFile("test")
+	if !errors.Is(err, common.ErrFileNotFound) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrFileNotFound)
+	}
+
+	w, err := ReadWalkForwardFromFile(filepath.Join("strategyexamples", "rsi-api-candles.wf"))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	err = w.Validate(gctkline.ThreeHour)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
+func TestWalkForwardValidate(t *testing.T) {
+	t.Parallel()
+	var w *WalkForward
+	err := w.Validate(gctkline.OneHour)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received: %v, expected: %v", err, gctcommon.ErrNilPointer)
+	}
+
+	w = &WalkForward{}
+	err = w.Validate(gctkline.OneHour)
+	if !errors.Is(err, errInvalidWalkForwardWindow) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidWalkForwardWindow)
+	}
+
+	w.InSampleWindow = time.Hour * 24
+	w.OutOfSampleWindow = time.Hour * 12
+	err = w.Validate(gctkline.FiveDay)
+	if !errors.Is(err, errInvalidWalkForwardWindow) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidWalkForwardWindow)
+	}
+
+	err = w.Validate(gctkline.OneHour)
+	if !errors.Is(err, errNoOptimisationParameters) {
+		t.Errorf("received: %v, expected: %v", err, errNoOptimisationParameters)
+	}
+
+	w.Optimisation.Parameters = []OptimisationParameter{{
+		Name:    "portfolio-settings.buy-side.maximum-size",
+		Minimum: decimal.NewFromInt(1),
+		Maximum: decimal.NewFromInt(2),
+		Step:    decimal.NewFromInt(1),
+	}}
+	err = w.Validate(gctkline.OneHour)
+	if !errors.Is(err, errWalkForwardParameter) {
+		t.Errorf("received: %v, expected: %v", err, errWalkForwardParameter)
+	}
+
+	w.Optimisation.Parameters[0].Name = "custom-settings.rsi-period"
+	err = w.Validate(gctkline.OneHour)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
+func TestWalkForwardWindows(t *testing.T) {
+	t.Parallel()
+	var w *WalkForward
+	_, err := w.Windows(time.Time{}, time.Time{})
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received: %v, expected: %v", err, gctcommon.ErrNilPointer)
+	}
+
+	w = &WalkForward{}
+	_, err = w.Windows(time.Time{}, time.Time{})
+	if !errors.Is(err, errInvalidWalkForwardWindow) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidWalkForwardWindow)
+	}
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := time.Hour * 24
+	w.InSampleWindow = day * 10
+	w.OutOfSampleWindow = day * 5
+	_, err = w.Windows(start, start.Add(day*10))
+	if !errors.Is(err, errNoWalkForwardWindows) {
+		t.Errorf("received: %v, expected: %v", err, errNoWalkForwardWindows)
+	}
+
+	windows, err := w.Windows(start, start.Add(day*22))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(windows) != 3 {
+		t.Fatalf("received: %v, expected: %v", len(windows), 3)
+	}
+	if !windows[1].InSampleStart.Equal(start.Add(day * 5)) {
+		t.Errorf("received: %v, expected: %v", windows[1].InSampleStart, start.Add(day*5))
+	}
+	for i := range windows {
+		if !windows[i].OutOfSampleStart.Equal(windows[i].InSampleEnd) {
+			t.Errorf("received: %v, expected: %v", windows[i].OutOfSampleStart, windows[i].InSampleEnd)
+		}
+		if i > 0 && !windows[i].OutOfSampleStart.Equal(windows[i-1].OutOfSampleEnd) {
+			t.Errorf("received: %v, expected: %v", windows[i].OutOfSampleStart, windows[i-1].OutOfSampleEnd)
+		}
+	}
+	if !windows[2].OutOfSampleEnd.Equal(start.Add(day * 22)) {
+		t.Errorf("received: %v, expected: %v", windows[2].OutOfSampleEnd, start.Add(day*22))
+	}
+
+	w.Anchored = true
+	windows, err = w.Windows(start, start.Add(day*22))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(windows) != 3 {
+		t.Fatalf("received: %v, expected: %v", len(windows), 3)
+	}
+	for i := range windows {
+		if !windows[i].InSampleStart.Equal(start) {
+			t.Errorf("received: %v, expected: %v", windows[i].InSampleStart, start)
+		}
+	}
+	if !windows[2].InSampleEnd.Equal(start.Add(day * 20)) {
+		t.Errorf("received: %v, expected: %v", windows[2].InSampleEnd, start.Add(day*20))
+	}
+}
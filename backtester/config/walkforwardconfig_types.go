@@ -0,0 +1,36 @@
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidWalkForwardWindow = errors.New("invalid walk-forward window")
+	errWalkForwardParameter     = errors.New("walk-forward can only search strategy custom settings as portfolio settings cannot change during a run")
+	errNoWalkForwardWindows     = errors.New("data range is too short for a single in-sample and out-of-sample window")
+)
+
+// WalkForward defines how a strategy config's data range is split into
+// rolling in-sample windows, which are optimised, and the out-of-sample
+// windows that follow them, which are run with the best in-sample parameters
+type WalkForward struct {
+	// InSampleWindow is the duration of data used to optimise parameters
+	InSampleWindow time.Duration `json:"in-sample-window"`
+	// OutOfSampleWindow is the duration of data run with the best in-sample
+	// parameters. Windows roll forward by this duration
+	OutOfSampleWindow time.Duration `json:"out-of-sample-window"`
+	// Anchored keeps every in-sample window starting at the start of the data
+	// so each in-sample window grows rather than rolls
+	Anchored     bool         `json:"anchored"`
+	Optimisation Optimisation `json:"optimisation"`
+}
+
+// WalkForwardWindow is a single in-sample and out-of-sample window pair. End
+// times are exclusive
+type WalkForwardWindow struct {
+	InSampleStart    time.Time `json:"in-sample-start"`
+	InSampleEnd      time.Time `json:"in-sample-end"`
+	OutOfSampleStart time.Time `json:"out-of-sample-start"`
+	OutOfSampleEnd   time.Time `json:"out-of-sample-end"`
+}
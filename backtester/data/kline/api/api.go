@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -31,13 +32,9 @@ func LoadData(ctx context.Context, dataType int64, startDate, endDate time.Time,
 		}
 	case common.DataTrade:
 		var trades []trade.Data
-		trades, err = exch.GetHistoricTrades(ctx,
-			fPair,
-			a,
-			startDate,
-			endDate)
+		trades, err = LoadTrades(ctx, startDate, endDate, exch, fPair, a)
 		if err != nil {
-			return nil, fmt.Errorf("could not retrieve trade data for %v %v %v, %v", exch.GetName(), a, fPair, err)
+			return nil, err
 		}
 
 		candles, err = trade.ConvertTradesToCandles(kline.Interval(interval), trades...)
@@ -50,3 +47,20 @@ func LoadData(ctx context.Context, dataType int64, startDate, endDate time.Time,
 	candles.Exchange = strings.ToLower(candles.Exchange)
 	return candles, nil
 }
+
+// LoadTrades retrieves trades from a GoCryptoTrader exchange wrapper without
+// converting them into candles
+func LoadTrades(ctx context.Context, startDate, endDate time.Time, exch exchange.IBotExchange, fPair currency.Pair, a asset.Item) ([]trade.Data, error) {
+	if exch == nil {
+		return nil, fmt.Errorf("%w exchange", gctcommon.ErrNilPointer)
+	}
+	trades, err := exch.GetHistoricTrades(ctx,
+		fPair,
+		a,
+		startDate,
+		endDate)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve trade data for %v %v %v, %v", exch.GetName(), a, fPair, err)
+	}
+	return trades, nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
@@ -85,3 +86,11 @@ func TestLoadTrades(t *testing.T) {
 		t.Error("expected candles")
 	}
 }
+
+func TestLoadTradesNilExchange(t *testing.T) {
+	t.Parallel()
+	_, err := LoadTrades(context.Background(), time.Time{}, time.Time{}, nil, currency.EMPTYPAIR, asset.Spot)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received: %v, expected: %v", err, gctcommon.ErrNilPointer)
+	}
+}
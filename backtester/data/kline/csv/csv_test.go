@@ -34,7 +34,7 @@ func TestLoadDataTrades(t *testing.T) {
 	exch := testExchange
 	a := asset.Spot
 	p := currency.NewPair(currency.BTC, currency.USDT)
-	_, err := LoadData(
+	resp, err := LoadData(
 		common.DataTrade,
 		filepath.Join("..", "..", "..", "..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv"),
 		exch,
@@ -43,7 +43,10 @@ func TestLoadDataTrades(t *testing.T) {
 		a,
 		false)
 	if !errors.Is(err, nil) {
-		t.Errorf("received: %v, expected: %v", err, nil)
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(resp.Trades) == 0 {
+		t.Error("expected trades to be retained for tick mode")
 	}
 }
 
@@ -62,6 +62,7 @@ func LoadData(startDate, endDate time.Time, interval time.Duration, exchangeName
 			return nil, fmt.Errorf("could not retrieve database trade data for %v %v %v, %v", exchangeName, a, fPair, err)
 		}
 		resp.Item = klineItem
+		resp.Trades = trades
 	default:
 		if isUSDTrackingPair {
 			return nil, fmt.Errorf("%w for %v %v %v. Please add USD pair data to your CSV or set `disable-usd-tracking` to `true` in your config", errNoUSDData, exchangeName, a, fPair)
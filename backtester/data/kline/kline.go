@@ -2,6 +2,7 @@ package kline
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -47,6 +48,9 @@ func (d *DataFromKline) HasDataAtTime(t time.Time) (bool, error) {
 
 // Load sets the candle data to the stream for processing
 func (d *DataFromKline) Load() error {
+	if d.Trades != nil {
+		return d.loadTrades()
+	}
 	if d.Item == nil || len(d.Item.Candles) == 0 {
 		return errNoCandleData
 	}
@@ -76,6 +80,42 @@ func (d *DataFromKline) Load() error {
 	return d.SetStream(klineData)
 }
 
+// loadTrades sets each trade to the stream as its own event in time order.
+// A trade has a single price, so its open, high, low and close are equal and
+// its volume is the traded amount
+func (d *DataFromKline) loadTrades() error {
+	if len(d.Trades) == 0 {
+		return errNoTradeData
+	}
+	if d.Item == nil {
+		return fmt.Errorf("%w kline item", gctcommon.ErrNilPointer)
+	}
+	sort.SliceStable(d.Trades, func(i, j int) bool {
+		return d.Trades[i].Timestamp.Before(d.Trades[j].Timestamp)
+	})
+	tradeData := make([]data.Event, len(d.Trades))
+	for i := range d.Trades {
+		price := decimal.NewFromFloat(d.Trades[i].Price)
+		tradeData[i] = &kline.Kline{
+			Base: &event.Base{
+				Offset:         int64(i + 1),
+				Exchange:       d.Item.Exchange,
+				Time:           d.Trades[i].Timestamp.UTC(),
+				Interval:       d.Item.Interval,
+				CurrencyPair:   d.Item.Pair,
+				AssetType:      d.Item.Asset,
+				UnderlyingPair: d.Item.UnderlyingPair,
+			},
+			Open:   price,
+			High:   price,
+			Low:    price,
+			Close:  price,
+			Volume: decimal.NewFromFloat(d.Trades[i].Amount),
+		}
+	}
+	return d.SetStream(tradeData)
+}
+
 // AppendResults adds a candle item to the data stream and sorts it to ensure it is all in order
 func (d *DataFromKline) AppendResults(ki *gctkline.Item) error {
 	if ki == nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 const testExchange = "binance"
@@ -54,6 +55,50 @@ func TestLoad(t *testing.T) {
 	}
 }
 
+func TestLoadTrades(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := DataFromKline{
+		Base:   &data.Base{},
+		Trades: []trade.Data{},
+	}
+	err := d.Load()
+	if !errors.Is(err, errNoTradeData) {
+		t.Errorf("received: %v, expected: %v", err, errNoTradeData)
+	}
+	d.Trades = []trade.Data{
+		{Timestamp: tt.Add(time.Second), Price: 1338, Amount: 2},
+		{Timestamp: tt, Price: 1337, Amount: 1},
+	}
+	err = d.Load()
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received: %v, expected: %v", err, gctcommon.ErrNilPointer)
+	}
+	d.Item = &gctkline.Item{
+		Exchange: testExchange,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: gctkline.OneMin,
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	s, err := d.GetStream()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(s) != 2 {
+		t.Fatalf("received: %v, expected: %v", len(s), 2)
+	}
+	if !s[0].GetTime().Equal(tt) || !s[0].GetClosePrice().Equal(decimal.NewFromInt(1337)) || !s[0].GetVolume().Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v %v %v, expected trades ordered by time", s[0].GetTime(), s[0].GetClosePrice(), s[0].GetVolume())
+	}
+	if s[1].GetOffset() != 2 || !s[1].GetHighPrice().Equal(decimal.NewFromInt(1338)) {
+		t.Errorf("received: %v %v, expected: %v %v", s[1].GetOffset(), s[1].GetHighPrice(), 2, 1338)
+	}
+}
+
 func TestHasDataAtTime(t *testing.T) {
 	t.Parallel()
 	dStart := time.Date(2020, 1, 0, 0, 0, 0, 0, time.UTC)
@@ -5,9 +5,13 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
-var errNoCandleData = errors.New("no candle data provided")
+var (
+	errNoCandleData = errors.New("no candle data provided")
+	errNoTradeData  = errors.New("no trade data provided")
+)
 
 // DataFromKline is a struct which implements the data.Streamer interface
 // It holds candle data for a specified range with helper functions
@@ -15,4 +19,7 @@ type DataFromKline struct {
 	*data.Base
 	Item        *gctkline.Item
 	RangeHolder *gctkline.IntervalRangeHolder
+	// Trades are streamed as individual data events when set, Item then only
+	// holds the candles used for reporting
+	Trades []trade.Data
 }
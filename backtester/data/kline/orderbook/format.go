@@ -0,0 +1,272 @@
+package orderbook
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Writer encodes records into the gzip compressed recorded orderbook format.
+// A file consists of a magic string, version and header followed by records.
+// Each record holds its type, unix nano time and update ID as varints, then
+// either snapshot options or an update action, then bid and ask counts
+// followed by each level's price and amount as float64s and ID as a varint
+type Writer struct {
+	gz  *gzip.Writer
+	buf []byte
+}
+
+// Reader decodes records written by a Writer
+type Reader struct {
+	gz     *gzip.Reader
+	r      *bufio.Reader
+	header Header
+}
+
+// NewWriter writes the file header to w and returns a Writer for records
+func NewWriter(w io.Writer, h *Header) (*Writer, error) {
+	if w == nil {
+		return nil, fmt.Errorf("%w writer", gctcommon.ErrNilPointer)
+	}
+	if h == nil {
+		return nil, fmt.Errorf("%w header", gctcommon.ErrNilPointer)
+	}
+	gz := gzip.NewWriter(w)
+	buf := append([]byte{}, fileMagic...)
+	buf = append(buf, formatVersion)
+	buf = appendString(buf, h.Exchange)
+	buf = appendString(buf, h.Asset.String())
+	buf = appendString(buf, h.Pair.Base.String())
+	buf = appendString(buf, h.Pair.Quote.String())
+	if _, err := gz.Write(buf); err != nil {
+		return nil, err
+	}
+	return &Writer{gz: gz, buf: buf[:0]}, nil
+}
+
+// Write encodes a single record
+func (w *Writer) Write(r *Record) error {
+	if r == nil {
+		return fmt.Errorf("%w record", gctcommon.ErrNilPointer)
+	}
+	if r.Type < Snapshot || r.Type > UpdateByID {
+		return fmt.Errorf("%w %v", errInvalidRecordType, r.Type)
+	}
+	buf := append(w.buf[:0], byte(r.Type))
+	buf = binary.AppendVarint(buf, r.Time.UnixNano())
+	buf = binary.AppendVarint(buf, r.UpdateID)
+	if r.Type == Snapshot {
+		var flags uint8
+		if r.PriceDuplication {
+			flags |= priceDuplicationFlag
+		}
+		if r.IDAlignment {
+			flags |= idAlignmentFlag
+		}
+		if r.IsFundingRate {
+			flags |= fundingRateFlag
+		}
+		buf = append(buf, flags)
+		buf = binary.AppendUvarint(buf, uint64(r.MaxDepth))
+	} else {
+		buf = append(buf, byte(r.Action))
+	}
+	buf = appendLevels(buf, r.Bids)
+	buf = appendLevels(buf, r.Asks)
+	w.buf = buf
+	_, err := w.gz.Write(buf)
+	return err
+}
+
+// Flush writes any buffered records to the underlying writer
+func (w *Writer) Flush() error {
+	return w.gz.Flush()
+}
+
+// Close flushes and finalises the gzip stream. It does not close the
+// underlying writer
+func (w *Writer) Close() error {
+	return w.gz.Close()
+}
+
+// NewReader reads and validates the file header from r
+func NewReader(r io.Reader) (*Reader, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w reader", gctcommon.ErrNilPointer)
+	}
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w %v", errInvalidFile, err)
+	}
+	br := bufio.NewReader(gz)
+	magic := make([]byte, len(fileMagic)+1)
+	if _, err = io.ReadFull(br, magic); err != nil {
+		return nil, fmt.Errorf("%w %v", errInvalidFile, err)
+	}
+	if string(magic[:len(fileMagic)]) != string(fileMagic) {
+		return nil, errInvalidFile
+	}
+	if magic[len(fileMagic)] != formatVersion {
+		return nil, fmt.Errorf("%w %v", errUnsupportedVersion, magic[len(fileMagic)])
+	}
+	fields := make([]string, 4)
+	for i := range fields {
+		fields[i], err = readString(br)
+		if err != nil {
+			return nil, fmt.Errorf("%w %v", errInvalidFile, err)
+		}
+	}
+	a, err := asset.New(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w %v", errInvalidFile, err)
+	}
+	return &Reader{
+		gz: gz,
+		r:  br,
+		header: Header{
+			Exchange: fields[0],
+			Asset:    a,
+			Pair:     currency.NewPair(currency.NewCode(fields[2]), currency.NewCode(fields[3])),
+		},
+	}, nil
+}
+
+// Header returns the file header
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Read decodes the next record. It returns io.EOF when no records remain
+func (r *Reader) Read() (*Record, error) {
+	recordType, err := r.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	rec := &Record{Type: RecordType(recordType)}
+	if rec.Type < Snapshot || rec.Type > UpdateByID {
+		return nil, fmt.Errorf("%w %v", errInvalidRecordType, recordType)
+	}
+	nanos, err := binary.ReadVarint(r.r)
+	if err != nil {
+		return nil, corrupt(err)
+	}
+	rec.Time = time.Unix(0, nanos).UTC()
+	rec.UpdateID, err = binary.ReadVarint(r.r)
+	if err != nil {
+		return nil, corrupt(err)
+	}
+	if rec.Type == Snapshot {
+		var flags uint8
+		flags, err = r.r.ReadByte()
+		if err != nil {
+			return nil, corrupt(err)
+		}
+		rec.PriceDuplication = flags&priceDuplicationFlag != 0
+		rec.IDAlignment = flags&idAlignmentFlag != 0
+		rec.IsFundingRate = flags&fundingRateFlag != 0
+		var maxDepth uint64
+		maxDepth, err = binary.ReadUvarint(r.r)
+		if err != nil {
+			return nil, corrupt(err)
+		}
+		if maxDepth > maxLevels {
+			return nil, fmt.Errorf("%w max depth %v", errCorruptRecord, maxDepth)
+		}
+		rec.MaxDepth = int(maxDepth)
+	} else {
+		var action uint8
+		action, err = r.r.ReadByte()
+		if err != nil {
+			return nil, corrupt(err)
+		}
+		rec.Action = gctorderbook.Action(action)
+	}
+	if rec.Bids, err = r.readLevels(); err != nil {
+		return nil, err
+	}
+	if rec.Asks, err = r.readLevels(); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// Close closes the gzip stream. It does not close the underlying reader
+func (r *Reader) Close() error {
+	return r.gz.Close()
+}
+
+func (r *Reader) readLevels() (gctorderbook.Items, error) {
+	count, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, corrupt(err)
+	}
+	if count > maxLevels {
+		return nil, fmt.Errorf("%w level count %v", errCorruptRecord, count)
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	levels := make(gctorderbook.Items, count)
+	var b [16]byte
+	for i := range levels {
+		if _, err = io.ReadFull(r.r, b[:]); err != nil {
+			return nil, corrupt(err)
+		}
+		levels[i].Price = math.Float64frombits(binary.LittleEndian.Uint64(b[:8]))
+		levels[i].Amount = math.Float64frombits(binary.LittleEndian.Uint64(b[8:]))
+		levels[i].ID, err = binary.ReadVarint(r.r)
+		if err != nil {
+			return nil, corrupt(err)
+		}
+	}
+	return levels, nil
+}
+
+// corrupt converts an unexpected end of a record into a corrupt record error
+// so that a truncated file is not mistaken for a complete one
+func corrupt(err error) error {
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return fmt.Errorf("%w %v", errCorruptRecord, err)
+}
+
+func appendLevels(buf []byte, levels gctorderbook.Items) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(levels)))
+	for i := range levels {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(levels[i].Price))
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(levels[i].Amount))
+		buf = binary.AppendVarint(buf, levels[i].ID)
+	}
+	return buf
+}
+
+func appendString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+func readString(r *bufio.Reader) (string, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	if length > maxHeaderString {
+		return "", fmt.Errorf("header string length %v exceeds %v", length, maxHeaderString)
+	}
+	s := make([]byte, length)
+	if _, err = io.ReadFull(r, s); err != nil {
+		return "", err
+	}
+	return string(s), nil
+}
@@ -0,0 +1,129 @@
+package orderbook
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// LoadData loads every recording for the exchange, asset and pair in path and
+// converts the replayed mid price into candles
+func LoadData(path, exchangeName string, interval time.Duration, fPair currency.Pair, a asset.Item) (*kline.DataFromKline, error) {
+	replay, err := LoadReplay(path, exchangeName, interval, fPair, a)
+	if err != nil {
+		return nil, err
+	}
+	candles, err := replay.candles()
+	if err != nil {
+		return nil, fmt.Errorf("could not replay orderbook data for %v %v %v, %w", exchangeName, a, fPair, err)
+	}
+	if len(candles) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v", errNoMidPriceCandles, exchangeName, a, fPair)
+	}
+	resp := kline.NewDataFromKline()
+	resp.Item = &gctkline.Item{
+		Exchange: strings.ToLower(exchangeName),
+		Pair:     fPair,
+		Asset:    a,
+		Interval: gctkline.Interval(interval),
+		Candles:  candles,
+	}
+	return resp, nil
+}
+
+// LoadReplay loads every recording for the exchange, asset and pair in path
+// into a single replay. Files are ordered by their recording start time
+func LoadReplay(path, exchangeName string, interval time.Duration, fPair currency.Pair, a asset.Item) (*Replay, error) {
+	if path == "" {
+		return nil, errPathUnset
+	}
+	if exchangeName == "" {
+		return nil, errExchangeNameUnset
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	prefix := fileNamePrefix(exchangeName, a, fPair)
+	var names []string
+	for i := range entries {
+		if !entries[i].IsDir() && isRecordedFile(entries[i].Name(), prefix) {
+			names = append(names, entries[i].Name())
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%w in %v for %v %v %v", errNoRecordedData, path, exchangeName, a, fPair)
+	}
+	sort.Strings(names)
+
+	h := &Header{
+		Exchange: strings.ToLower(exchangeName),
+		Asset:    a,
+		Pair:     fPair,
+	}
+	var records []Record
+	for i := range names {
+		records, err = readFile(filepath.Join(path, names[i]), h, records)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// recordings can overlap when a recorder is restarted, so records are
+	// ordered by time while keeping the order of identical times
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Time.Before(records[j].Time)
+	})
+	return NewReplay(h, records, interval)
+}
+
+// readFile appends a recording's records after ensuring it belongs to the
+// expected book
+func readFile(path string, h *Header, records []Record) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			log.Errorln(common.Data, closeErr)
+		}
+	}()
+	r, err := NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("%v %w", path, err)
+	}
+	fh := r.Header()
+	if !strings.EqualFold(fh.Exchange, h.Exchange) || fh.Asset != h.Asset || !fh.Pair.Equal(h.Pair) {
+		return nil, fmt.Errorf("%v %w, received %v %v %v expected %v %v %v", path, errHeaderMismatch, fh.Exchange, fh.Asset, fh.Pair, h.Exchange, h.Asset, h.Pair)
+	}
+	for {
+		var rec *Record
+		rec, err = r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, errCorruptRecord) {
+				// a recorder which was not closed leaves a truncated final
+				// record, the records before it are still usable
+				log.Warnf(common.Data, "%v truncated after %v records: %v", path, len(records), err)
+				return records, nil
+			}
+			return nil, fmt.Errorf("%v %w", path, err)
+		}
+		records = append(records, *rec)
+	}
+	return records, r.Close()
+}
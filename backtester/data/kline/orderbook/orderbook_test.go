@@ -0,0 +1,293 @@
+package orderbook
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const testExchange = "binance"
+
+var (
+	testPair  = currency.NewPair(currency.BTC, currency.USDT)
+	testStart = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// recordTestBook records a snapshot with a mid price of 100 followed by
+// updates which move the mid price to 101 in the second minute and 103 in the
+// fourth minute
+func recordTestBook(t *testing.T, path string) {
+	t.Helper()
+	r, err := NewRecorder(testExchange, path)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// updates without a snapshot cannot be replayed
+	err = r.RecordUpdate(&gctorderbook.Update{
+		UpdateTime: testStart,
+		Asset:      asset.Spot,
+		Pair:       testPair,
+		Bids:       gctorderbook.Items{{Price: 1, Amount: 1}},
+	}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = r.RecordSnapshot(&gctorderbook.Base{
+		Exchange:         testExchange,
+		Pair:             testPair,
+		Asset:            asset.Spot,
+		LastUpdated:      testStart.Add(time.Second),
+		LastUpdateID:     1,
+		PriceDuplication: true,
+		Bids:             gctorderbook.Items{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		Asks:             gctorderbook.Items{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	updates := []*gctorderbook.Update{
+		{
+			UpdateID:   2,
+			UpdateTime: testStart.Add(time.Minute + time.Second),
+			Bids:       gctorderbook.Items{{Price: 100, Amount: 1}},
+			Asks:       gctorderbook.Items{{Price: 101, Amount: 0}},
+		},
+		{
+			UpdateID:   3,
+			UpdateTime: testStart.Add(time.Minute * 3),
+			Bids:       gctorderbook.Items{{Price: 101, Amount: 1}},
+			Asks:       gctorderbook.Items{{Price: 102, Amount: 0}, {Price: 105, Amount: 1}},
+		},
+	}
+	for i := range updates {
+		updates[i].Asset = asset.Spot
+		updates[i].Pair = testPair
+		err = r.RecordUpdate(updates[i], false)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	err = r.Close()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = r.RecordSnapshot(&gctorderbook.Base{})
+	if !errors.Is(err, errRecorderClosed) {
+		t.Errorf("received '%v' expected '%v'", err, errRecorderClosed)
+	}
+}
+
+func TestWriterReader(t *testing.T) {
+	t.Parallel()
+	_, err := NewWriter(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	var buf bytes.Buffer
+	h := &Header{Exchange: testExchange, Asset: asset.Spot, Pair: testPair}
+	w, err := NewWriter(&buf, h)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = w.Write(&Record{})
+	if !errors.Is(err, errInvalidRecordType) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRecordType)
+	}
+	records := []Record{
+		{
+			Type:          Snapshot,
+			Time:          testStart,
+			UpdateID:      -1,
+			IDAlignment:   true,
+			IsFundingRate: true,
+			MaxDepth:      25,
+			Bids:          gctorderbook.Items{{Price: 1.5, Amount: 0.1, ID: 1337}},
+		},
+		{
+			Type:   UpdateByID,
+			Time:   testStart.Add(time.Nanosecond),
+			Action: gctorderbook.Delete,
+			Asks:   gctorderbook.Items{{ID: 1}, {ID: 2}},
+		},
+	}
+	for i := range records {
+		err = w.Write(&records[i])
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	err = w.Close()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	_, err = NewReader(bytes.NewReader([]byte("not an orderbook")))
+	if !errors.Is(err, errInvalidFile) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidFile)
+	}
+	r, err := NewReader(bytes.NewReader(buf.Bytes()))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if rh := r.Header(); rh.Exchange != h.Exchange || rh.Asset != h.Asset || !rh.Pair.Equal(h.Pair) {
+		t.Errorf("received '%v' expected '%v'", rh, h)
+	}
+	for i := range records {
+		var rec *Record
+		rec, err = r.Read()
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		if rec.Type != records[i].Type ||
+			!rec.Time.Equal(records[i].Time) ||
+			rec.UpdateID != records[i].UpdateID ||
+			rec.Action != records[i].Action ||
+			rec.IDAlignment != records[i].IDAlignment ||
+			rec.IsFundingRate != records[i].IsFundingRate ||
+			rec.PriceDuplication != records[i].PriceDuplication ||
+			rec.MaxDepth != records[i].MaxDepth ||
+			len(rec.Bids) != len(records[i].Bids) ||
+			len(rec.Asks) != len(records[i].Asks) {
+			t.Fatalf("received '%+v' expected '%+v'", rec, records[i])
+		}
+		for j := range rec.Bids {
+			if rec.Bids[j] != records[i].Bids[j] {
+				t.Errorf("received '%v' expected '%v'", rec.Bids[j], records[i].Bids[j])
+			}
+		}
+		for j := range rec.Asks {
+			if rec.Asks[j] != records[i].Asks[j] {
+				t.Errorf("received '%v' expected '%v'", rec.Asks[j], records[i].Asks[j])
+			}
+		}
+	}
+	_, err = r.Read()
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("received '%v' expected '%v'", err, io.EOF)
+	}
+}
+
+func TestNewRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := NewRecorder("", "")
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeNameUnset)
+	}
+	_, err = NewRecorder(testExchange, "")
+	if !errors.Is(err, errPathUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errPathUnset)
+	}
+	_, err = NewRecorder(testExchange, filepath.Join(t.TempDir(), "nested"))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	var r *Recorder
+	err = r.Attach(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+}
+
+func TestReplayOrderbookAt(t *testing.T) {
+	t.Parallel()
+	path := t.TempDir()
+	recordTestBook(t, path)
+
+	_, err := LoadReplay(path, testExchange, time.Minute, testPair, asset.Futures)
+	if !errors.Is(err, errNoRecordedData) {
+		t.Errorf("received '%v' expected '%v'", err, errNoRecordedData)
+	}
+	replay, err := LoadReplay(path, testExchange, time.Minute, testPair, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	_, err = replay.OrderbookAt(testStart.Add(-time.Minute))
+	if !errors.Is(err, errNoOrderbookAtTime) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOrderbookAtTime)
+	}
+	book, err := replay.OrderbookAt(testStart.Add(time.Minute))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(book.Bids) != 3 || book.Bids[0].Price != 100 || book.Asks[0].Price != 102 {
+		t.Errorf("received bids '%v' asks '%v' expected best bid 100 and best ask 102", book.Bids, book.Asks)
+	}
+	// moving back in time replays from the start
+	book, err = replay.OrderbookAt(testStart)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if book.Bids[0].Price != 99 || book.Asks[0].Price != 101 || !book.PriceDuplication {
+		t.Errorf("received bids '%v' asks '%v' expected best bid 99 and best ask 101", book.Bids, book.Asks)
+	}
+}
+
+func TestLoadData(t *testing.T) {
+	t.Parallel()
+	path := t.TempDir()
+	_, err := LoadData("", testExchange, time.Minute, testPair, asset.Spot)
+	if !errors.Is(err, errPathUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errPathUnset)
+	}
+	recordTestBook(t, path)
+	resp, err := LoadData(path, testExchange, time.Minute, testPair, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expected := []struct {
+		open, high, low, close float64
+	}{
+		{100, 100, 100, 100},
+		{101, 101, 101, 101},
+		// no records, the prior close is carried forward
+		{101, 101, 101, 101},
+		{103, 103, 103, 103},
+	}
+	if len(resp.Item.Candles) != len(expected) {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Item.Candles), len(expected))
+	}
+	for i := range expected {
+		c := resp.Item.Candles[i]
+		if !c.Time.Equal(testStart.Add(time.Minute*time.Duration(i))) ||
+			c.Open != expected[i].open || c.High != expected[i].high || c.Low != expected[i].low || c.Close != expected[i].close {
+			t.Errorf("received '%+v' expected '%+v'", c, expected[i])
+		}
+	}
+}
+
+func TestLoadReplayTruncated(t *testing.T) {
+	t.Parallel()
+	path := t.TempDir()
+	recordTestBook(t, path)
+	entries, err := os.ReadDir(path)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(entries), 1)
+	}
+	fp := filepath.Join(path, entries[0].Name())
+	b, err := os.ReadFile(fp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// a recording copied while still open only has its flushed records
+	err = os.WriteFile(fp, b[:len(b)-10], 0o600)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = LoadReplay(path, testExchange, time.Minute, testPair, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
@@ -0,0 +1,110 @@
+package orderbook
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// FileExtension is the extension of recorded orderbook files
+const FileExtension = ".gctob"
+
+const (
+	formatVersion uint8 = 1
+	// maxLevels guards against corrupt files allocating huge books
+	maxLevels = 1 << 20
+	// maxHeaderString guards against corrupt files allocating huge strings
+	maxHeaderString = 1 << 10
+)
+
+// snapshot option flags
+const (
+	priceDuplicationFlag uint8 = 1 << iota
+	idAlignmentFlag
+	fundingRateFlag
+)
+
+var fileMagic = []byte("GCTOB")
+
+// RecordType defines how a record is applied to a book during replay
+type RecordType uint8
+
+// Record types
+const (
+	// Snapshot replaces the entire book
+	Snapshot RecordType = iota + 1
+	// UpdateByPrice amends, inserts or deletes levels by price
+	UpdateByPrice
+	// UpdateByID applies the record's action to levels matched by ID
+	UpdateByID
+)
+
+var (
+	errInvalidFile         = errors.New("invalid recorded orderbook file")
+	errUnsupportedVersion  = errors.New("unsupported recorded orderbook file version")
+	errCorruptRecord       = errors.New("corrupt recorded orderbook record")
+	errInvalidRecordType   = errors.New("invalid recorded orderbook record type")
+	errHeaderMismatch      = errors.New("recorded orderbook header does not match")
+	errNoRecordedData      = errors.New("no recorded orderbook data found")
+	errNoOrderbookAtTime   = errors.New("no orderbook snapshot recorded before time")
+	errIntervalUnset       = errors.New("interval unset")
+	errPathUnset           = errors.New("orderbook path unset")
+	errExchangeNameUnset   = errors.New("exchange name unset")
+	errExchangeMismatch    = errors.New("recorder exchange does not match")
+	errRecorderClosed      = errors.New("recorder closed")
+	errNoMidPriceCandles   = errors.New("recorded orderbooks did not produce any mid price candles")
+	errWebsocketNotEnabled = errors.New("websocket not supported")
+)
+
+// Header describes the book that every record in a file belongs to
+type Header struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+}
+
+// Record is a single recorded snapshot or incremental update
+type Record struct {
+	Type     RecordType
+	Time     time.Time
+	UpdateID int64
+	// Action is only used by UpdateByID records
+	Action gctorderbook.Action
+	Bids   gctorderbook.Items
+	Asks   gctorderbook.Items
+	// The following are only stored for snapshots, so that the replayed depth
+	// processes updates the same way as the live buffer
+	PriceDuplication bool
+	IDAlignment      bool
+	IsFundingRate    bool
+	MaxDepth         int
+}
+
+// Recorder writes websocket orderbook snapshots and updates for an exchange
+// to disk, one file per asset and pair
+type Recorder struct {
+	exchange string
+	path     string
+	m        sync.Mutex
+	files    map[key.PairAsset]*recordFile
+	closed   bool
+}
+
+// Replay applies recorded records to an orderbook depth, allowing the book to
+// be retrieved as it was at any point within the recording
+type Replay struct {
+	header   Header
+	interval time.Duration
+	records  []Record
+	m        sync.Mutex
+	depth    *gctorderbook.Depth
+	next     int
+	synced   bool
+	// appliedUntil is the exclusive end of the records applied to depth
+	appliedUntil time.Time
+}
@@ -0,0 +1,188 @@
+package orderbook
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// recordFile is an open recording for a single asset and pair
+type recordFile struct {
+	file   *os.File
+	writer *Writer
+}
+
+// NewRecorder returns a recorder which writes orderbooks for the exchange to
+// files in path. The directory is created if it does not exist
+func NewRecorder(exchangeName, path string) (*Recorder, error) {
+	if exchangeName == "" {
+		return nil, errExchangeNameUnset
+	}
+	if path == "" {
+		return nil, errPathUnset
+	}
+	err := os.MkdirAll(path, file.DefaultPermissionOctal)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{
+		exchange: strings.ToLower(exchangeName),
+		path:     path,
+		files:    make(map[key.PairAsset]*recordFile),
+	}, nil
+}
+
+// Attach sets the recorder on the exchange websocket orderbook buffer so that
+// every snapshot and applied update is recorded
+func (r *Recorder) Attach(exch gctexchange.IBotExchange) error {
+	if r == nil {
+		return fmt.Errorf("%w recorder", gctcommon.ErrNilPointer)
+	}
+	if exch == nil {
+		return fmt.Errorf("%w exchange", gctcommon.ErrNilPointer)
+	}
+	if !strings.EqualFold(exch.GetName(), r.exchange) {
+		return fmt.Errorf("%w, received '%v' expected '%v'", errExchangeMismatch, exch.GetName(), r.exchange)
+	}
+	if !exch.SupportsWebsocket() {
+		return fmt.Errorf("%v %w", exch.GetName(), errWebsocketNotEnabled)
+	}
+	ws, err := exch.GetWebsocket()
+	if err != nil {
+		return err
+	}
+	ws.Orderbook.SetRecorder(r)
+	return nil
+}
+
+// RecordSnapshot records a snapshot, starting a new file for the asset and
+// pair if it is the first snapshot received
+func (r *Recorder) RecordSnapshot(book *gctorderbook.Base) error {
+	if book == nil {
+		return fmt.Errorf("%w orderbook", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.closed {
+		return errRecorderClosed
+	}
+	k := key.PairAsset{Base: book.Pair.Base.Item, Quote: book.Pair.Quote.Item, Asset: book.Asset}
+	rf, ok := r.files[k]
+	if !ok {
+		var err error
+		rf, err = r.createFile(book.Asset, book.Pair)
+		if err != nil {
+			return err
+		}
+		r.files[k] = rf
+	}
+	err := rf.writer.Write(&Record{
+		Type:             Snapshot,
+		Time:             book.LastUpdated,
+		UpdateID:         book.LastUpdateID,
+		Bids:             book.Bids,
+		Asks:             book.Asks,
+		PriceDuplication: book.PriceDuplication,
+		IDAlignment:      book.IDAlignment,
+		IsFundingRate:    book.IsFundingRate,
+		MaxDepth:         book.MaxDepth,
+	})
+	if err != nil {
+		return err
+	}
+	// snapshots are infrequent, flushing here limits what is lost on a crash
+	return rf.writer.Flush()
+}
+
+// RecordUpdate records an applied update. Updates received before the first
+// snapshot of their asset and pair cannot be replayed and are ignored
+func (r *Recorder) RecordUpdate(u *gctorderbook.Update, updateByID bool) error {
+	if u == nil {
+		return fmt.Errorf("%w orderbook update", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.closed {
+		return errRecorderClosed
+	}
+	rf, ok := r.files[key.PairAsset{Base: u.Pair.Base.Item, Quote: u.Pair.Quote.Item, Asset: u.Asset}]
+	if !ok {
+		return nil
+	}
+	rec := &Record{
+		Type:     UpdateByPrice,
+		Time:     u.UpdateTime,
+		UpdateID: u.UpdateID,
+		Bids:     u.Bids,
+		Asks:     u.Asks,
+	}
+	if updateByID {
+		rec.Type = UpdateByID
+		rec.Action = u.Action
+	}
+	if rec.Time.IsZero() {
+		rec.Time = time.Now()
+	}
+	return rf.writer.Write(rec)
+}
+
+// Close finalises all open files. Nothing is recorded after closing
+func (r *Recorder) Close() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.closed = true
+	var errs error
+	for k, rf := range r.files {
+		if err := rf.writer.Close(); err != nil {
+			errs = gctcommon.AppendError(errs, err)
+		}
+		if err := rf.file.Close(); err != nil {
+			errs = gctcommon.AppendError(errs, err)
+		}
+		delete(r.files, k)
+	}
+	return errs
+}
+
+func (r *Recorder) createFile(a asset.Item, pair currency.Pair) (*recordFile, error) {
+	name := fileNamePrefix(r.exchange, a, pair) + strconv.FormatInt(time.Now().UnixNano(), 10) + FileExtension
+	f, err := os.OpenFile(filepath.Join(r.path, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, file.DefaultPermissionOctal)
+	if err != nil {
+		return nil, err
+	}
+	w, err := NewWriter(f, &Header{Exchange: r.exchange, Asset: a, Pair: pair})
+	if err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.Errorln(common.Data, closeErr)
+		}
+		return nil, err
+	}
+	return &recordFile{file: f, writer: w}, nil
+}
+
+// fileNamePrefix returns the start of every recorded file name for an
+// exchange, asset and pair. The file name ends with the recording start time
+func fileNamePrefix(exchangeName string, a asset.Item, pair currency.Pair) string {
+	symbol := strings.ToUpper(pair.Base.String() + "-" + pair.Quote.String())
+	symbol = strings.NewReplacer("/", "-", "\\", "-", "_", "-").Replace(symbol)
+	return strings.ToLower(exchangeName) + "_" + a.String() + "_" + symbol + "_"
+}
+
+// isRecordedFile reports whether a directory entry name is a recording with
+// the supplied prefix
+func isRecordedFile(name, prefix string) bool {
+	return strings.HasPrefix(name, prefix) && strings.HasSuffix(name, FileExtension)
+}
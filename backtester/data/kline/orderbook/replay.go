@@ -0,0 +1,175 @@
+package orderbook
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// NewReplay returns a replay of time ordered records. Candle times passed to
+// OrderbookAt are offset by the interval so that the book is retrieved as it
+// was at the close of the candle
+func NewReplay(h *Header, records []Record, interval time.Duration) (*Replay, error) {
+	if h == nil {
+		return nil, fmt.Errorf("%w header", gctcommon.ErrNilPointer)
+	}
+	if interval <= 0 {
+		return nil, errIntervalUnset
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v", errNoRecordedData, h.Exchange, h.Asset, h.Pair)
+	}
+	r := &Replay{
+		header:   *h,
+		interval: interval,
+		records:  records,
+	}
+	if err := r.reset(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// OrderbookAt returns a copy of the orderbook with every record before the
+// close of the candle starting at t applied. Requesting an earlier time than
+// the last request replays the records from the beginning
+func (r *Replay) OrderbookAt(t time.Time) (*gctorderbook.Base, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w replay", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	target := t.Add(r.interval)
+	if target.Before(r.appliedUntil) {
+		if err := r.reset(); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.applyUntil(target); err != nil {
+		return nil, err
+	}
+	if !r.synced {
+		return nil, fmt.Errorf("%w %v for %v %v %v", errNoOrderbookAtTime, target, r.header.Exchange, r.header.Asset, r.header.Pair)
+	}
+	return r.depth.Retrieve()
+}
+
+// candles builds candles from the mid price of the book after each record.
+// An orderbook remains valid until it changes, so intervals without records
+// carry the previous close forward
+func (r *Replay) candles() ([]gctkline.Candle, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if err := r.reset(); err != nil {
+		return nil, err
+	}
+	var resp []gctkline.Candle
+	for r.next < len(r.records) {
+		rec := &r.records[r.next]
+		if err := r.apply(rec); err != nil {
+			return nil, err
+		}
+		r.next++
+		if !r.synced {
+			continue
+		}
+		mid, err := r.depth.GetMidPrice()
+		if err != nil {
+			// a side of the book can be temporarily empty
+			continue
+		}
+		candleTime := rec.Time.Truncate(r.interval)
+		if len(resp) > 0 {
+			last := &resp[len(resp)-1]
+			if last.Time.Equal(candleTime) {
+				last.High = max(last.High, mid)
+				last.Low = min(last.Low, mid)
+				last.Close = mid
+				continue
+			}
+			previousClose := last.Close
+			for fill := last.Time.Add(r.interval); fill.Before(candleTime); fill = fill.Add(r.interval) {
+				resp = append(resp, gctkline.Candle{Time: fill, Open: previousClose, High: previousClose, Low: previousClose, Close: previousClose})
+			}
+		}
+		resp = append(resp, gctkline.Candle{Time: candleTime, Open: mid, High: mid, Low: mid, Close: mid})
+	}
+	r.appliedUntil = r.records[len(r.records)-1].Time.Add(time.Nanosecond)
+	return resp, nil
+}
+
+// reset discards the replayed book so records can be applied from the start
+func (r *Replay) reset() error {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	r.depth = gctorderbook.NewDepth(id)
+	r.next = 0
+	r.synced = false
+	r.appliedUntil = time.Time{}
+	return nil
+}
+
+// applyUntil applies all unapplied records before target
+func (r *Replay) applyUntil(target time.Time) error {
+	for r.next < len(r.records) && r.records[r.next].Time.Before(target) {
+		if err := r.apply(&r.records[r.next]); err != nil {
+			return err
+		}
+		r.next++
+	}
+	r.appliedUntil = target
+	return nil
+}
+
+// apply applies a single record to the depth. Updates before the first
+// snapshot have nothing to apply to and are skipped
+func (r *Replay) apply(rec *Record) error {
+	if rec.Type == Snapshot {
+		r.depth.AssignOptions(&gctorderbook.Base{
+			Exchange:         r.header.Exchange,
+			Pair:             r.header.Pair,
+			Asset:            r.header.Asset,
+			LastUpdated:      rec.Time,
+			LastUpdateID:     rec.UpdateID,
+			PriceDuplication: rec.PriceDuplication,
+			IsFundingRate:    rec.IsFundingRate,
+			IDAlignment:      rec.IDAlignment,
+			MaxDepth:         rec.MaxDepth,
+		})
+		r.synced = true
+		return r.depth.LoadSnapshot(rec.Bids, rec.Asks, rec.UpdateID, rec.Time, false)
+	}
+	if !r.synced {
+		return nil
+	}
+	u := &gctorderbook.Update{
+		UpdateID:   rec.UpdateID,
+		UpdateTime: rec.Time,
+		Asset:      r.header.Asset,
+		Action:     rec.Action,
+		Bids:       rec.Bids,
+		Asks:       rec.Asks,
+		Pair:       r.header.Pair,
+	}
+	if rec.Type == UpdateByPrice {
+		return r.depth.UpdateBidAskByPrice(u)
+	}
+	switch rec.Action {
+	case gctorderbook.Amend:
+		return r.depth.UpdateBidAskByID(u)
+	case gctorderbook.Delete:
+		return r.depth.DeleteBidAskByID(u, true)
+	case gctorderbook.Insert:
+		return r.depth.InsertBidAskByID(u)
+	case gctorderbook.UpdateInsert:
+		return r.depth.UpdateInsertByID(u)
+	default:
+		return fmt.Errorf("%w action %v at %v", errCorruptRecord, rec.Action, rec.Time)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
@@ -263,6 +264,10 @@ func (bt *BackTest) handleEvent(ev common.Event) error {
 
 	switch eType := ev.(type) {
 	case kline.Event:
+		err = bt.applyScheduledParameters(ev.GetTime())
+		if err != nil {
+			return err
+		}
 		// using kline.Event as signal.Event also matches data.Event
 		if bt.Strategy.UsingSimultaneousProcessing() {
 			err = bt.processSimultaneousDataEvents()
@@ -304,6 +309,10 @@ func (bt *BackTest) processSingleDataEvent(ev data.Event, funds funding.IFundRel
 	if err != nil {
 		return err
 	}
+	if bt.tickMode {
+		// orders only rest in tick mode, where each data event is a trade
+		bt.matchRestingOrders(ev, funds)
+	}
 	d, err := bt.DataHolder.GetDataForCurrency(ev)
 	if err != nil {
 		return err
@@ -326,6 +335,26 @@ func (bt *BackTest) processSingleDataEvent(ev data.Event, funds funding.IFundRel
 	return nil
 }
 
+// matchRestingOrders fills the resting orders crossed by the trade in the data
+// event and queues their fills
+func (bt *BackTest) matchRestingOrders(ev data.Event, funds funding.IFundReleaser) {
+	fills, err := bt.Exchange.MatchRestingOrders(ev, bt.orderManager, funds)
+	if err != nil {
+		log.Errorf(common.Backtester, "MatchRestingOrders %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	for i := range fills {
+		if i == 0 {
+			// statistics hold one fill per offset, every fill is still
+			// recorded by the portfolio
+			err = bt.Statistic.SetEventForOffset(fills[i])
+			if err != nil {
+				log.Errorf(common.Backtester, "SetEventForOffset %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+			}
+		}
+		bt.EventQueue.AppendEvent(fills[i])
+	}
+}
+
 // processSimultaneousDataEvents determines what signal events are generated and appended
 // to the event queue. It will pass all currency events to the strategy to determine what
 // currencies to act upon
@@ -497,6 +526,10 @@ func (bt *BackTest) processOrderEvent(ev order.Event, funds funding.IFundRelease
 		return err
 	}
 	f, err := bt.Exchange.ExecuteOrder(ev, d, bt.orderManager, funds)
+	if errors.Is(err, exchange.ErrOrderResting) {
+		// the order will be filled by a later trade
+		return nil
+	}
 	if err != nil {
 		if f == nil {
 			log.Errorf(common.Backtester, "ExecuteOrder fill event should always be returned, please fix, %v", err)
@@ -625,7 +658,11 @@ func (bt *BackTest) Stop() error {
 	if !bt.hasProcessedAnEvent {
 		return nil
 	}
-	err := bt.Statistic.CalculateAllResults()
+	err := bt.cancelRestingOrders()
+	if err != nil {
+		log.Errorf(common.Backtester, "Could not cancel resting orders on stop: %s", err)
+	}
+	err = bt.Statistic.CalculateAllResults()
 	if err != nil {
 		return err
 	}
@@ -636,6 +673,60 @@ func (bt *BackTest) Stop() error {
 	return nil
 }
 
+// cancelRestingOrders cancels orders which are still resting when the run
+// ends and updates funding and holdings with the released funds
+func (bt *BackTest) cancelRestingOrders() error {
+	cancelled, err := bt.Exchange.CancelAllRestingOrders(bt.Funding)
+	if len(cancelled) == 0 {
+		return err
+	}
+	var errs error
+	if err != nil {
+		errs = gctcommon.AppendError(errs, err)
+	}
+	for i := range cancelled {
+		log.Infof(common.Backtester, "Cancelled resting %v %v order of %v %v %v %v at end of run",
+			cancelled[i].GetOrderType(), cancelled[i].GetDirection(), cancelled[i].GetAmount(),
+			cancelled[i].GetExchange(), cancelled[i].GetAssetType(), cancelled[i].Pair())
+		var d data.Handler
+		d, err = bt.DataHolder.GetDataForCurrency(cancelled[i])
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		var latest data.Event
+		latest, err = d.Latest()
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		err = bt.Funding.CreateSnapshot(latest.GetTime())
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		var funds funding.IFundingPair
+		funds, err = bt.Funding.GetFundingForEvent(latest)
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		err = bt.Portfolio.SetHoldingsForEvent(funds.FundReader(), latest)
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		var h *holdings.Holding
+		h, err = bt.Portfolio.ViewHoldingAtTimePeriod(latest)
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		errs = gctcommon.AppendError(errs, bt.Statistic.AddHoldingsForTime(h))
+	}
+	return errs
+}
+
 func (bt *BackTest) triggerLiquidationsForExchange(ev data.Event, pnl *portfolio.PNLSummary) error {
 	if ev == nil {
 		return common.ErrNilEvent
@@ -16,6 +16,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
@@ -48,6 +49,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binanceus"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 const testExchange = "binanceus"
@@ -247,6 +249,124 @@ func TestLoadDataCSV(t *testing.T) {
 	}
 }
 
+func TestLoadDataOrderbook(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{
+		Reports: &report.Data{},
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	path := t.TempDir()
+	rec, err := orderbook.NewRecorder(testExchange, path)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	tt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = rec.RecordSnapshot(&gctorderbook.Base{
+		Exchange:    testExchange,
+		Pair:        cp,
+		Asset:       asset.Spot,
+		LastUpdated: tt,
+		Bids:        gctorderbook.Items{{Price: 99, Amount: 1}},
+		Asks:        gctorderbook.Items{{Price: 101, Amount: 1}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = rec.RecordUpdate(&gctorderbook.Update{
+		UpdateTime: tt.Add(time.Minute * 2),
+		Pair:       cp,
+		Asset:      asset.Spot,
+		Bids:       gctorderbook.Items{{Price: 100, Amount: 1}},
+	}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = rec.Close()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	cfg := &config.Config{
+		DataSettings: config.DataSettings{
+			DataType: common.CandleStr,
+			Interval: gctkline.OneMin,
+			OrderbookData: &config.OrderbookData{
+				Path: path,
+			},
+			CSVData: &config.CSVData{},
+		},
+	}
+	em := engine.ExchangeManager{}
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false)
+	if !errors.Is(err, errAmbiguousDataSource) {
+		t.Errorf("received '%v' expected '%v'", err, errAmbiguousDataSource)
+	}
+
+	cfg.DataSettings.CSVData = nil
+	resp, err := bt.loadData(cfg, exch, cp, asset.Spot, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Item.Candles) != 3 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Item.Candles), 3)
+	}
+}
+
+func TestLoadDataTickMode(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{
+		Reports: &report.Data{},
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	cfg := &config.Config{
+		DataSettings: config.DataSettings{
+			DataType: common.TradeStr,
+			Interval: gctkline.OneMin,
+			CSVData: &config.CSVData{
+				FullPath: filepath.Join("..", "..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv"),
+			},
+		},
+	}
+	em := engine.ExchangeManager{}
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	resp, err := bt.loadData(cfg, exch, cp, asset.Spot, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Trades != nil {
+		t.Error("expected trades to be discarded once aggregated into candles")
+	}
+	candles, err := resp.GetStream()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	cfg.DataSettings.TickMode = true
+	resp, err = bt.loadData(cfg, exch, cp, asset.Spot, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	ticks, err := resp.GetStream()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(ticks) != len(resp.Trades) {
+		t.Errorf("received '%v' expected '%v'", len(ticks), len(resp.Trades))
+	}
+	if len(ticks) <= len(candles) {
+		t.Errorf("received '%v' expected more events than the '%v' candles", len(ticks), len(candles))
+	}
+}
+
 func TestLoadDataDatabase(t *testing.T) {
 	t.Parallel()
 	bt := BackTest{
@@ -1607,6 +1727,67 @@ func TestGetFees(t *testing.T) {
 	}
 }
 
+func TestGetFeeSchedule(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := getFeeSchedule(context.Background(), nil, cp, asset.Spot, &config.FeeSchedule{})
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	f := &binance.Binance{}
+	f.SetDefaults()
+	_, err = getFeeSchedule(context.Background(), f, cp, asset.Spot, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	schedule, err := getFeeSchedule(context.Background(), f, cp, asset.Spot, &config.FeeSchedule{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(schedule.Tiers) == 0 || schedule.TokenDiscount == nil {
+		t.Error("expected exchange fee tiers and token discount")
+	}
+
+	schedule, err = getFeeSchedule(context.Background(), f, currency.NewPair(currency.ETH, currency.BTC), asset.Spot, &config.FeeSchedule{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !schedule.VolumeCurrency.Equal(currency.USDT) {
+		t.Errorf("received '%v' expected '%v'", schedule.VolumeCurrency, currency.USDT)
+	}
+
+	_, err = getFeeSchedule(context.Background(), f, cp, asset.Futures, &config.FeeSchedule{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+
+	schedule, err = getFeeSchedule(context.Background(), f, cp, asset.Futures, &config.FeeSchedule{
+		Tiers: []config.FeeTier{
+			{Name: "one", TakerFee: decimal.NewFromFloat(0.002)},
+			{Name: "two", MinimumVolume: decimal.NewFromInt(1000), TakerFee: decimal.NewFromFloat(0.001)},
+		},
+		TokenDiscountRate: decimal.NewFromFloat(0.5),
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(schedule.Tiers) != 2 || schedule.Tiers[1].Taker != 0.001 {
+		t.Errorf("received '%+v' expected configured tiers", schedule.Tiers)
+	}
+	if schedule.TokenDiscount == nil || schedule.TokenDiscount.Rate != 0.5 {
+		t.Errorf("received '%+v' expected token discount rate 0.5", schedule.TokenDiscount)
+	}
+
+	_, err = getFeeSchedule(context.Background(), f, cp, asset.Spot, &config.FeeSchedule{
+		Tiers: []config.FeeTier{{Name: "one", MinimumVolume: decimal.NewFromInt(1)}},
+	})
+	if err == nil {
+		t.Error("expected an error for a first tier with a minimum volume")
+	}
+}
+
 func TestGenerateSummary(t *testing.T) {
 	t.Parallel()
 	bt := &BackTest{
@@ -1909,6 +2090,7 @@ func TestProcessSingleDataEvent(t *testing.T) {
 		Strategy:   &fakeStrat{},
 		Portfolio:  &fakeFolio{},
 		Statistic:  &fakeStats{},
+		Exchange:   &exchange.Exchange{},
 		Reports:    &fakeReport{},
 		Funding:    &fakeFunding{},
 		DataHolder: &data.HandlerHolder{},
@@ -2000,4 +2182,33 @@ func TestProcessSingleDataEvent(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Errorf("received '%v' expected '%v'", err, nil)
 	}
+
+	// resting orders are only matched against trades in tick mode
+	exch := &fakeExchange{}
+	bt.Exchange = exch
+	bt.EventQueue = &eventholder.Holder{}
+	err = bt.processSingleDataEvent(ev, collateral)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if exch.matched != 0 {
+		t.Errorf("received '%v' expected '%v'", exch.matched, 0)
+	}
+	bt.tickMode = true
+	err = bt.processSingleDataEvent(ev, collateral)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if exch.matched != 1 {
+		t.Errorf("received '%v' expected '%v'", exch.matched, 1)
+	}
+	var fills int
+	for _, e := range bt.EventQueue.(*eventholder.Holder).Queue {
+		if _, ok := e.(fill.Event); ok {
+			fills++
+		}
+	}
+	if fills != 1 {
+		t.Errorf("received '%v' expected '%v'", fills, 1)
+	}
 }
@@ -33,6 +33,7 @@ type BackTest struct {
 	m                        sync.Mutex
 	wg                       sync.WaitGroup
 	verbose                  bool
+	tickMode                 bool
 	hasProcessedAnEvent      bool
 	hasShutdown              bool
 	shutdown                 chan struct{}
@@ -50,6 +51,10 @@ type BackTest struct {
 	orderManager             *engine.OrderManager
 	databaseManager          *engine.DatabaseConnectionManager
 	hasProcessedDataAtOffset map[int64]bool
+	dataCache                *dataCache
+	dataWindow               *dataWindow
+	parameterSchedule        []scheduledParameters
+	nextScheduledParameters  int
 }
 
 // TaskSummary holds details of a BackTest
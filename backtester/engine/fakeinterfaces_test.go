@@ -18,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
@@ -120,6 +121,17 @@ func (f fakeReport) SetKlineData(*gctkline.Item) error {
 
 func (f fakeReport) UseDarkMode(bool) {}
 
+// fakeExchange records the data events resting orders are matched against
+type fakeExchange struct {
+	exchange.ExecutionHandler
+	matched int
+}
+
+func (f *fakeExchange) MatchRestingOrders(ev data.Event, _ *engine.OrderManager, _ funding.IFundReleaser) ([]fill.Event, error) {
+	f.matched++
+	return []fill.Event{&fill.Fill{Base: ev.GetBase()}}, nil
+}
+
 type fakeStats struct{}
 
 func (f *fakeStats) SetStrategyName(string) {
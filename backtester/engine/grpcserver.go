@@ -16,6 +16,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
@@ -37,6 +38,7 @@ import (
 var (
 	errBadPort             = errors.New("received bad port")
 	errCannotHandleRequest = errors.New("cannot handle request")
+	errNoOptimisation      = errors.New("no optimisation file path or settings provided")
 )
 
 // GRPCServer struct
@@ -807,3 +809,115 @@ func (s *GRPCServer) ClearAllTasks(_ context.Context, _ *btrpc.ClearAllTasksRequ
 		RemainingTasks: remainingResponse,
 	}, nil
 }
+
+// ExecuteOptimisationFromFile runs a strategy config from file with every
+// parameter set from the optimisation file or settings, ranking the results
+func (s *GRPCServer) ExecuteOptimisationFromFile(_ context.Context, request *btrpc.ExecuteOptimisationFromFileRequest) (*btrpc.ExecuteOptimisationResponse, error) {
+	if s.config == nil {
+		return nil, fmt.Errorf("%w server config", gctcommon.ErrNilPointer)
+	}
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if request == nil {
+		return nil, fmt.Errorf("%w request", gctcommon.ErrNilPointer)
+	}
+	var optimisation *config.Optimisation
+	var err error
+	switch {
+	case request.OptimisationFilePath != "":
+		optimisation, err = config.ReadOptimisationFromFile(request.OptimisationFilePath)
+	case request.Optimisation != nil:
+		optimisation, err = convertOptimisationSettings(request.Optimisation)
+	default:
+		err = errNoOptimisation
+	}
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := config.ReadStrategyConfigFromFile(request.StrategyFilePath)
+	if err != nil {
+		return nil, err
+	}
+	results, err := OptimiseStrategy(s.manager, cfg, optimisation, s.config)
+	if err != nil {
+		return nil, err
+	}
+	if s.config.Report.GenerateReport && s.config.Report.OutputPath != "" {
+		var path string
+		path, err = results.Save(s.config.Report.OutputPath)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof(common.Backtester, "Optimisation results saved to %v", path)
+	}
+
+	resp := &btrpc.ExecuteOptimisationResponse{
+		Strategy:    results.Strategy,
+		Nickname:    results.Nickname,
+		Method:      results.Method,
+		Metric:      results.Metric,
+		DateStarted: results.DateStarted.Format(gctcommon.SimpleTimeFormatWithTimezone),
+		DateEnded:   results.DateEnded.Format(gctcommon.SimpleTimeFormatWithTimezone),
+		Results:     make([]*btrpc.OptimisationResult, len(results.Results)),
+	}
+	for i := range results.Results {
+		r := &results.Results[i]
+		params := make([]*btrpc.ParameterValue, len(r.Parameters))
+		for j := range r.Parameters {
+			params[j] = &btrpc.ParameterValue{
+				Name:  r.Parameters[j].Name,
+				Value: r.Parameters[j].Value.String(),
+			}
+		}
+		resp.Results[i] = &btrpc.OptimisationResult{
+			Rank:         int64(r.Rank),
+			TaskId:       r.TaskID.String(),
+			Parameters:   params,
+			SharpeRatio:  r.SharpeRatio.String(),
+			SortinoRatio: r.SortinoRatio.String(),
+			CalmarRatio:  r.CalmarRatio.String(),
+			Pnl:          r.PNL.String(),
+			TotalOrders:  r.TotalOrders,
+			Error:        r.Error,
+		}
+	}
+	return resp, nil
+}
+
+// convertOptimisationSettings converts RPC optimisation settings into an
+// optimisation config
+func convertOptimisationSettings(settings *btrpc.OptimisationSettings) (*config.Optimisation, error) {
+	resp := &config.Optimisation{
+		Method:               settings.Method,
+		Metric:               settings.Metric,
+		Iterations:           settings.Iterations,
+		Seed:                 settings.Seed,
+		MaximumParallelTasks: settings.MaximumParallelTasks,
+		Parameters:           make([]config.OptimisationParameter, len(settings.Parameters)),
+	}
+	for i := range settings.Parameters {
+		minimum, err := decimal.NewFromString(settings.Parameters[i].Minimum)
+		if err != nil {
+			return nil, err
+		}
+		maximum, err := decimal.NewFromString(settings.Parameters[i].Maximum)
+		if err != nil {
+			return nil, err
+		}
+		var step decimal.Decimal
+		if settings.Parameters[i].Step != "" {
+			step, err = decimal.NewFromString(settings.Parameters[i].Step)
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp.Parameters[i] = config.OptimisationParameter{
+			Name:    settings.Parameters[i].Name,
+			Minimum: minimum,
+			Maximum: maximum,
+			Step:    step,
+		}
+	}
+	return resp, nil
+}
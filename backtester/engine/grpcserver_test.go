@@ -666,3 +666,82 @@ func TestGRPCClearAllTasks(t *testing.T) {
 		t.Fatalf("received '%v' expecting '%v'", len(s.manager.tasks), 0)
 	}
 }
+
+func TestExecuteOptimisationFromFile(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{}
+	_, err := s.ExecuteOptimisationFromFile(context.Background(), nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expecting '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	s.config, err = config.GenerateDefaultConfig()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expecting '%v'", err, nil)
+	}
+	_, err = s.ExecuteOptimisationFromFile(context.Background(), nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expecting '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	s.manager = NewTaskManager()
+	_, err = s.ExecuteOptimisationFromFile(context.Background(), nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expecting '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	_, err = s.ExecuteOptimisationFromFile(context.Background(), &btrpc.ExecuteOptimisationFromFileRequest{})
+	if !errors.Is(err, errNoOptimisation) {
+		t.Errorf("received '%v' expecting '%v'", err, errNoOptimisation)
+	}
+
+	_, err = s.ExecuteOptimisationFromFile(context.Background(), &btrpc.ExecuteOptimisationFromFileRequest{
+		OptimisationFilePath: "test",
+	})
+	if !errors.Is(err, common.ErrFileNotFound) {
+		t.Errorf("received '%v' expecting '%v'", err, common.ErrFileNotFound)
+	}
+
+	_, err = s.ExecuteOptimisationFromFile(context.Background(), &btrpc.ExecuteOptimisationFromFileRequest{
+		Optimisation: &btrpc.OptimisationSettings{
+			Parameters: []*btrpc.OptimisationParameter{{Name: "custom-settings.rsi-period", Minimum: "1", Maximum: "10"}},
+		},
+	})
+	if !errors.Is(err, common.ErrFileNotFound) {
+		t.Errorf("received '%v' expecting '%v'", err, common.ErrFileNotFound)
+	}
+
+	_, err = s.ExecuteOptimisationFromFile(context.Background(), &btrpc.ExecuteOptimisationFromFileRequest{
+		StrategyFilePath:     filepath.Join("..", "config", "strategyexamples", "dca-candles-live.strat"),
+		OptimisationFilePath: filepath.Join("..", "config", "strategyexamples", "rsi-api-candles.opt"),
+	})
+	if !errors.Is(err, errLiveOptimisation) {
+		t.Errorf("received '%v' expecting '%v'", err, errLiveOptimisation)
+	}
+}
+
+func TestConvertOptimisationSettings(t *testing.T) {
+	t.Parallel()
+	_, err := convertOptimisationSettings(&btrpc.OptimisationSettings{
+		Parameters: []*btrpc.OptimisationParameter{{Name: "custom-settings.rsi-period", Minimum: "one"}},
+	})
+	if err == nil {
+		t.Error("expected error for invalid minimum")
+	}
+
+	o, err := convertOptimisationSettings(&btrpc.OptimisationSettings{
+		Method:     config.RandomSearch,
+		Iterations: 10,
+		Parameters: []*btrpc.OptimisationParameter{{Name: "custom-settings.rsi-period", Minimum: "1", Maximum: "10"}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expecting '%v'", err, nil)
+	}
+	if !o.Parameters[0].Step.IsZero() {
+		t.Errorf("received '%v' expecting '%v'", o.Parameters[0].Step, 0)
+	}
+	err = o.Validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expecting '%v'", err, nil)
+	}
+}
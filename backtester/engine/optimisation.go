@@ -0,0 +1,451 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// newTaskBacktester returns the backtester each optimisation and walk-forward
+// task is set up with
+var newTaskBacktester = NewBacktester
+
+// OptimiseStrategy runs the strategy config once for every parameter set
+// generated from the optimisation settings. Each run is added to the task
+// manager and executed in parallel, with candle data retrieved once and shared
+// between runs. Results are ranked by the optimisation metric
+func OptimiseStrategy(manager *TaskManager, strategyCfg *config.Config, optimisation *config.Optimisation, backtesterCfg *config.BacktesterConfig) (*OptimisationResults, error) {
+	if manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if strategyCfg == nil {
+		return nil, fmt.Errorf("%w strategy config", gctcommon.ErrNilPointer)
+	}
+	if backtesterCfg == nil {
+		return nil, fmt.Errorf("%w backtester config", gctcommon.ErrNilPointer)
+	}
+	if strategyCfg.DataSettings.LiveData != nil {
+		return nil, errLiveOptimisation
+	}
+	if err := strategyCfg.Validate(); err != nil {
+		return nil, err
+	}
+	return optimise(manager, strategyCfg, optimisation, backtesterCfg, newDataCache(), nil)
+}
+
+// optimise runs every parameter set against the cached data, restricted to
+// the data window when one is set
+func optimise(manager *TaskManager, strategyCfg *config.Config, optimisation *config.Optimisation, backtesterCfg *config.BacktesterConfig, cache *dataCache, window *dataWindow) (*OptimisationResults, error) {
+	sets, err := optimisation.GenerateParameterSets()
+	if err != nil {
+		return nil, err
+	}
+	parallel := int(optimisation.MaximumParallelTasks)
+	if parallel == 0 {
+		parallel = runtime.NumCPU()
+	}
+	log.Infof(common.Backtester, "Optimising %v with %v parameter sets using %v search", strategyCfg.StrategySettings.Name, len(sets), optimisation.Method)
+
+	resp := &OptimisationResults{
+		Strategy:    strategyCfg.StrategySettings.Name,
+		Nickname:    strategyCfg.Nickname,
+		Method:      optimisation.Method,
+		DateStarted: time.Now(),
+		Results:     make([]OptimisationResult, len(sets)),
+	}
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	for i := range sets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			resp.Results[i] = runOptimisationTask(manager, strategyCfg, sets[i], cache, window, backtesterCfg.Verbose)
+		}(i)
+	}
+	wg.Wait()
+	resp.DateEnded = time.Now()
+	return resp, resp.SortBy(optimisation.Metric)
+}
+
+// runOptimisationTask sets up, runs and clears a backtesting task for a
+// parameter set, returning its metrics or the reason it failed
+func runOptimisationTask(manager *TaskManager, strategyCfg *config.Config, params []config.ParameterValue, cache *dataCache, window *dataWindow, verbose bool) OptimisationResult {
+	result := OptimisationResult{Parameters: params}
+	cfg, err := strategyCfg.Copy()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	err = cfg.ApplyParameters(params)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	window.setConfigDates(cfg)
+	err = cfg.Validate()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	bt, err := newTaskBacktester()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	bt.dataCache = cache
+	bt.dataWindow = window
+	// reports are not generated for each parameter set
+	err = bt.SetupFromConfig(cfg, "", "", verbose)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	err = manager.AddTask(bt)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	// the task ID is assigned when the task is added
+	result.TaskID = bt.MetaData.ID
+	defer func() {
+		if clearErr := manager.ClearTask(result.TaskID); clearErr != nil {
+			log.Errorf(common.Backtester, "Could not clear optimisation task %v: %v", result.TaskID, clearErr)
+		}
+	}()
+	err = manager.RunTask(result.TaskID)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	stats, ok := bt.Statistic.(*statistics.Statistic)
+	if !ok {
+		result.Error = fmt.Sprintf("%v %T", errUnexpectedStatsHandler, bt.Statistic)
+		return result
+	}
+	err = result.setMetrics(stats)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// setMetrics populates the result metrics from the statistics of a completed
+// task. USD totals are used when tracked, otherwise ratios are averaged and
+// PNL summed across each currency
+func (o *OptimisationResult) setMetrics(s *statistics.Statistic) error {
+	if s == nil {
+		return fmt.Errorf("%w statistics", gctcommon.ErrNilPointer)
+	}
+	o.TotalOrders = s.TotalOrders
+	if s.FundingStatistics != nil && s.FundingStatistics.TotalUSDStatistics != nil {
+		usd := s.FundingStatistics.TotalUSDStatistics
+		if usd.ArithmeticRatios != nil {
+			o.SharpeRatio = usd.ArithmeticRatios.SharpeRatio
+			o.SortinoRatio = usd.ArithmeticRatios.SortinoRatio
+			o.CalmarRatio = usd.ArithmeticRatios.CalmarRatio
+		}
+		if s.FundingStatistics.Report != nil {
+			o.PNL = s.FundingStatistics.Report.FinalFunds.Sub(s.FundingStatistics.Report.InitialFunds)
+		}
+		return nil
+	}
+	if len(s.ExchangeAssetPairStatistics) == 0 {
+		return errNoOptimisationResults
+	}
+	var ratios int64
+	for _, c := range s.ExchangeAssetPairStatistics {
+		if c.Asset.IsFutures() {
+			o.PNL = o.PNL.Add(c.RealisedPNL).Add(c.UnrealisedPNL)
+		} else {
+			o.PNL = o.PNL.Add(c.FinalHoldings.TotalValue.Sub(c.FinalHoldings.TotalInitialValue))
+		}
+		if c.ArithmeticRatios == nil {
+			continue
+		}
+		o.SharpeRatio = o.SharpeRatio.Add(c.ArithmeticRatios.SharpeRatio)
+		o.SortinoRatio = o.SortinoRatio.Add(c.ArithmeticRatios.SortinoRatio)
+		o.CalmarRatio = o.CalmarRatio.Add(c.ArithmeticRatios.CalmarRatio)
+		ratios++
+	}
+	if ratios > 0 {
+		count := decimal.NewFromInt(ratios)
+		o.SharpeRatio = o.SharpeRatio.Div(count)
+		o.SortinoRatio = o.SortinoRatio.Div(count)
+		o.CalmarRatio = o.CalmarRatio.Div(count)
+	}
+	return nil
+}
+
+// metric returns the value of the result used to rank it
+func (o *OptimisationResult) metric(metric string) decimal.Decimal {
+	switch metric {
+	case config.SortinoRatioMetric:
+		return o.SortinoRatio
+	case config.CalmarRatioMetric:
+		return o.CalmarRatio
+	case config.PNLMetric:
+		return o.PNL
+	default:
+		return o.SharpeRatio
+	}
+}
+
+// SortBy ranks results by the metric from best to worst. Results that errored
+// are ranked last
+func (o *OptimisationResults) SortBy(metric string) error {
+	if o == nil {
+		return fmt.Errorf("%w optimisation results", gctcommon.ErrNilPointer)
+	}
+	metric = strings.ToLower(metric)
+	if !config.IsValidOptimisationMetric(metric) {
+		return fmt.Errorf("%w '%v'", config.ErrUnknownOptimisationMetric, metric)
+	}
+	sort.SliceStable(o.Results, func(i, j int) bool {
+		if (o.Results[i].Error == "") != (o.Results[j].Error == "") {
+			return o.Results[i].Error == ""
+		}
+		return o.Results[i].metric(metric).GreaterThan(o.Results[j].metric(metric))
+	})
+	for i := range o.Results {
+		o.Results[i].Rank = i + 1
+	}
+	o.Metric = metric
+	return nil
+}
+
+// WriteTable writes the results as a table in rank order
+func (o *OptimisationResults) WriteTable(w io.Writer) error {
+	if o == nil {
+		return fmt.Errorf("%w optimisation results", gctcommon.ErrNilPointer)
+	}
+	if len(o.Results) == 0 {
+		return errNoOptimisationResults
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	header := []string{"Rank"}
+	for i := range o.Results[0].Parameters {
+		header = append(header, o.Results[0].Parameters[i].Name)
+	}
+	header = append(header, "Sharpe", "Sortino", "Calmar", "PNL", "Orders", "Error")
+	if _, err := fmt.Fprintln(tw, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+	for i := range o.Results {
+		row := []string{fmt.Sprintf("%d", o.Results[i].Rank)}
+		for j := range o.Results[i].Parameters {
+			row = append(row, o.Results[i].Parameters[j].Value.String())
+		}
+		row = append(row,
+			o.Results[i].SharpeRatio.Round(4).String(),
+			o.Results[i].SortinoRatio.Round(4).String(),
+			o.Results[i].CalmarRatio.Round(4).String(),
+			o.Results[i].PNL.Round(8).String(),
+			fmt.Sprintf("%d", o.Results[i].TotalOrders),
+			o.Results[i].Error)
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// Save writes the results as JSON to the output path, returning the path of
+// the file written
+func (o *OptimisationResults) Save(outputPath string) (string, error) {
+	if o == nil {
+		return "", fmt.Errorf("%w optimisation results", gctcommon.ErrNilPointer)
+	}
+	return saveResults(o, outputPath, o.Nickname, o.Strategy, "optimisation", o.DateStarted)
+}
+
+// saveResults writes results as JSON to a file named after the strategy, the
+// kind of results and when they were started
+func saveResults(results interface{}, outputPath, nickname, strategy, kind string, started time.Time) (string, error) {
+	fn := nickname
+	if fn != "" {
+		fn += "-"
+	}
+	fn += strategy + "-" + kind + "-" + started.Format("2006-01-02-15-04-05")
+	fileName, err := common.GenerateFileName(fn, "json")
+	if err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(results, "", " ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(outputPath, fileName)
+	return path, os.WriteFile(path, data, file.DefaultPermissionOctal)
+}
+
+// newDataCache returns an empty data cache
+func newDataCache() *dataCache {
+	return &dataCache{
+		entries: make(map[key.ExchangePairAsset]*cachedData),
+	}
+}
+
+// load returns candle data for the exchange, asset and pair. The loader is
+// only called for data which has not been loaded before, otherwise a copy of
+// the cached data is returned and fromCache is true
+func (d *dataCache) load(k key.ExchangePairAsset, loader func() (*kline.DataFromKline, error)) (resp *kline.DataFromKline, fromCache bool, err error) {
+	if d == nil {
+		return nil, false, fmt.Errorf("%w data cache", gctcommon.ErrNilPointer)
+	}
+	if loader == nil {
+		return nil, false, fmt.Errorf("%w data loader", gctcommon.ErrNilPointer)
+	}
+	d.m.Lock()
+	c, ok := d.entries[k]
+	if !ok {
+		c = &cachedData{}
+		d.entries[k] = c
+	}
+	d.m.Unlock()
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.item == nil {
+		resp, err = loader()
+		if err != nil {
+			return nil, false, err
+		}
+		if resp == nil || resp.Item == nil {
+			return nil, false, errNilData
+		}
+		c.item = copyKlineItem(resp.Item)
+		c.ranges = resp.RangeHolder
+		return resp, false, nil
+	}
+	resp = kline.NewDataFromKline()
+	resp.Item = copyKlineItem(c.item)
+	// the range holder is not modified once data is loaded so it is shared
+	resp.RangeHolder = c.ranges
+	return resp, true, resp.Load()
+}
+
+// loadWindow returns a copy of cached candle data for the exchange, asset and
+// pair that falls within the data window
+func (d *dataCache) loadWindow(k key.ExchangePairAsset, window *dataWindow) (*kline.DataFromKline, error) {
+	if d == nil {
+		return nil, fmt.Errorf("%w data cache", gctcommon.ErrNilPointer)
+	}
+	if window == nil {
+		return nil, fmt.Errorf("%w data window", gctcommon.ErrNilPointer)
+	}
+	d.m.Lock()
+	c, ok := d.entries[k]
+	d.m.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %v %v %v-%v", errDataNotCached, k.Exchange, k.Asset, k.Base, k.Quote)
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.item == nil {
+		return nil, fmt.Errorf("%w %v %v %v-%v", errDataNotCached, k.Exchange, k.Asset, k.Base, k.Quote)
+	}
+	item := *c.item
+	item.Candles = make([]gctkline.Candle, 0, len(c.item.Candles))
+	for i := range c.item.Candles {
+		if c.item.Candles[i].Time.Before(window.start) || !c.item.Candles[i].Time.Before(window.end) {
+			continue
+		}
+		item.Candles = append(item.Candles, c.item.Candles[i])
+	}
+	if len(item.Candles) == 0 {
+		return nil, fmt.Errorf("%w %v %v %v-%v between %v and %v", errNilData, k.Exchange, k.Asset, k.Base, k.Quote, window.start, window.end)
+	}
+	ranges, err := gctkline.CalculateCandleDateRanges(window.start, window.end, item.Interval, 0)
+	if err != nil {
+		return nil, err
+	}
+	err = ranges.SetHasDataFromCandles(item.Candles)
+	if err != nil {
+		return nil, err
+	}
+	resp := kline.NewDataFromKline()
+	resp.Item = &item
+	resp.RangeHolder = ranges
+	return resp, resp.Load()
+}
+
+// dataRange returns the period that every cached item has data for
+func (d *dataCache) dataRange() (start, end time.Time, err error) {
+	if d == nil {
+		return start, end, fmt.Errorf("%w data cache", gctcommon.ErrNilPointer)
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	for k, c := range d.entries {
+		c.m.Lock()
+		ranges := c.ranges
+		c.m.Unlock()
+		if ranges == nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w %v %v %v-%v", errDataNotCached, k.Exchange, k.Asset, k.Base, k.Quote)
+		}
+		if start.IsZero() || ranges.Start.Time.After(start) {
+			start = ranges.Start.Time
+		}
+		if end.IsZero() || ranges.End.Time.Before(end) {
+			end = ranges.End.Time
+		}
+	}
+	if len(d.entries) == 0 {
+		return time.Time{}, time.Time{}, errDataNotCached
+	}
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, errNoCommonDataRange
+	}
+	return start, end, nil
+}
+
+// setConfigDates restricts API and database data settings to the window so
+// the strategy config reflects the data the task runs over
+func (w *dataWindow) setConfigDates(cfg *config.Config) {
+	if w == nil || cfg == nil {
+		return
+	}
+	if cfg.DataSettings.APIData != nil {
+		cfg.DataSettings.APIData.StartDate = w.start
+		cfg.DataSettings.APIData.EndDate = w.end
+		cfg.DataSettings.APIData.InclusiveEndDate = false
+	}
+	if cfg.DataSettings.DatabaseData != nil {
+		cfg.DataSettings.DatabaseData.StartDate = w.start
+		cfg.DataSettings.DatabaseData.EndDate = w.end
+		cfg.DataSettings.DatabaseData.InclusiveEndDate = false
+	}
+}
+
+// copyKlineItem returns a copy of the kline item which can be modified
+// without affecting the original
+func copyKlineItem(k *gctkline.Item) *gctkline.Item {
+	resp := *k
+	resp.Candles = make([]gctkline.Candle, len(k.Candles))
+	copy(resp.Candles, k.Candles)
+	return &resp
+}
@@ -0,0 +1,492 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestOptimiseStrategy(t *testing.T) {
+	t.Parallel()
+	_, err := OptimiseStrategy(nil, nil, nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	m := NewTaskManager()
+	_, err = OptimiseStrategy(m, nil, nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	cfg := &config.Config{}
+	_, err = OptimiseStrategy(m, cfg, nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	cfg.DataSettings.LiveData = &config.LiveData{}
+	_, err = OptimiseStrategy(m, cfg, &config.Optimisation{}, &config.BacktesterConfig{})
+	if !errors.Is(err, errLiveOptimisation) {
+		t.Errorf("received '%v' expected '%v'", err, errLiveOptimisation)
+	}
+}
+
+func TestOptimiseStrategyCSV(t *testing.T) {
+	setOfflineTaskBacktester(t)
+	cfg := rsiCSVStrategyConfig(t)
+	opt := &config.Optimisation{
+		Method:               config.GridSearch,
+		Metric:               config.PNLMetric,
+		MaximumParallelTasks: 2,
+		Parameters: []config.OptimisationParameter{
+			{Name: "custom-settings.rsi-low", Minimum: decimal.NewFromInt(20), Maximum: decimal.NewFromInt(40), Step: decimal.NewFromInt(10)},
+			{Name: "custom-settings.rsi-high", Minimum: decimal.NewFromInt(60), Maximum: decimal.NewFromInt(80), Step: decimal.NewFromInt(10)},
+		},
+	}
+	cache := newDataCache()
+	resp, err := optimise(NewTaskManager(), cfg, opt, &config.BacktesterConfig{}, cache, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Results) != 9 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Results), 9)
+	}
+	for i := range resp.Results {
+		if resp.Results[i].Error != "" {
+			t.Errorf("received '%v' expected '%v'", resp.Results[i].Error, "")
+		}
+		if resp.Results[i].Rank != i+1 {
+			t.Errorf("received '%v' expected '%v'", resp.Results[i].Rank, i+1)
+		}
+		if i > 0 && resp.Results[i].PNL.GreaterThan(resp.Results[i-1].PNL) {
+			t.Errorf("received '%v' expected PNL no greater than '%v'", resp.Results[i].PNL, resp.Results[i-1].PNL)
+		}
+	}
+	best := resp.Results[0]
+	expected := []config.ParameterValue{
+		{Name: "custom-settings.rsi-low", Value: decimal.NewFromInt(30)},
+		{Name: "custom-settings.rsi-high", Value: decimal.NewFromInt(60)},
+	}
+	if len(best.Parameters) != len(expected) {
+		t.Fatalf("received '%v' expected '%v'", len(best.Parameters), len(expected))
+	}
+	for i := range expected {
+		if best.Parameters[i].Name != expected[i].Name || !best.Parameters[i].Value.Equal(expected[i].Value) {
+			t.Errorf("received '%v' expected '%v'", best.Parameters[i], expected[i])
+		}
+	}
+	if best.TotalOrders == 0 || !best.PNL.IsPositive() {
+		t.Errorf("received '%v' orders and '%v' PNL expected orders and a profit", best.TotalOrders, best.PNL)
+	}
+
+	if len(cache.entries) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(cache.entries), 1)
+	}
+	// every task must share the cached candles, so a second run succeeds
+	// without the CSV file being read again
+	cfg.DataSettings.CSVData.FullPath = filepath.Join(t.TempDir(), "missing.csv")
+	again, err := optimise(NewTaskManager(), cfg, opt, &config.BacktesterConfig{}, cache, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	for i := range again.Results {
+		if again.Results[i].Error != "" {
+			t.Errorf("received '%v' expected '%v'", again.Results[i].Error, "")
+		}
+		if !again.Results[i].PNL.Equal(resp.Results[i].PNL) {
+			t.Errorf("received '%v' expected '%v'", again.Results[i].PNL, resp.Results[i].PNL)
+		}
+	}
+}
+
+// setOfflineTaskBacktester makes optimisation tasks use a backtester with
+// binance already set up, so no exchange details are fetched over the network
+func setOfflineTaskBacktester(t *testing.T) {
+	t.Helper()
+	newTaskBacktester = func() (*BackTest, error) {
+		bt, err := NewBacktester()
+		if err != nil {
+			return nil, err
+		}
+		exch, err := bt.exchangeManager.NewExchangeByName("binance")
+		if err != nil {
+			return nil, err
+		}
+		exch.SetDefaults()
+		exch.GetBase().CurrencyPairs.Pairs[asset.Spot].AssetEnabled = convert.BoolPtr(true)
+		return bt, bt.exchangeManager.Add(exch)
+	}
+	t.Cleanup(func() {
+		newTaskBacktester = NewBacktester
+	})
+}
+
+// rsiCSVStrategyConfig returns an RSI strategy config which loads daily
+// candles from the CSV testdata
+func rsiCSVStrategyConfig(t *testing.T) *config.Config {
+	t.Helper()
+	cfg, err := config.ReadStrategyConfigFromFile(filepath.Join("..", "config", "strategyexamples", "dca-csv-candles.strat"))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	cfg.DataSettings.CSVData.FullPath = filepath.Join("..", "..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cfg.StrategySettings.Name = "rsi"
+	cfg.StrategySettings.CustomSettings = map[string]interface{}{
+		"rsi-high":   70.0,
+		"rsi-low":    30.0,
+		"rsi-period": 14.0,
+	}
+	return cfg
+}
+
+func TestRunTask(t *testing.T) {
+	t.Parallel()
+	var m *TaskManager
+	err := m.RunTask(uuid.Nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	m = NewTaskManager()
+	id, err := uuid.NewV4()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.RunTask(id)
+	if !errors.Is(err, errTaskNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errTaskNotFound)
+	}
+
+	bt := &BackTest{
+		Strategy:   &fakeStrat{},
+		EventQueue: &eventholder.Holder{},
+		DataHolder: &data.HandlerHolder{},
+		Statistic:  &fakeStats{},
+		Reports:    &fakeReport{},
+		shutdown:   make(chan struct{}),
+	}
+	err = m.AddTask(bt)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.RunTask(bt.MetaData.ID)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !bt.MetaData.Closed {
+		t.Errorf("received '%v' expected '%v'", bt.MetaData.Closed, true)
+	}
+}
+
+func TestOptimisationResultSetMetrics(t *testing.T) {
+	t.Parallel()
+	r := &OptimisationResult{}
+	err := r.setMetrics(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	err = r.setMetrics(&statistics.Statistic{})
+	if !errors.Is(err, errNoOptimisationResults) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOptimisationResults)
+	}
+
+	r = &OptimisationResult{}
+	err = r.setMetrics(&statistics.Statistic{
+		TotalOrders: 5,
+		FundingStatistics: &statistics.FundingStatistics{
+			Report: &funding.Report{
+				InitialFunds: decimal.NewFromInt(1000),
+				FinalFunds:   decimal.NewFromInt(1100),
+			},
+			TotalUSDStatistics: &statistics.TotalFundingStatistics{
+				ArithmeticRatios: &statistics.Ratios{
+					SharpeRatio:  decimal.NewFromInt(1),
+					SortinoRatio: decimal.NewFromInt(2),
+					CalmarRatio:  decimal.NewFromInt(3),
+				},
+			},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if r.TotalOrders != 5 {
+		t.Errorf("received '%v' expected '%v'", r.TotalOrders, 5)
+	}
+	if !r.PNL.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", r.PNL, 100)
+	}
+	if !r.SortinoRatio.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", r.SortinoRatio, 2)
+	}
+
+	r = &OptimisationResult{}
+	spot := &statistics.CurrencyPairStatistic{
+		Asset: asset.Spot,
+		ArithmeticRatios: &statistics.Ratios{
+			SharpeRatio: decimal.NewFromInt(1),
+		},
+	}
+	spot.FinalHoldings.TotalInitialValue = decimal.NewFromInt(100)
+	spot.FinalHoldings.TotalValue = decimal.NewFromInt(150)
+	futures := &statistics.CurrencyPairStatistic{
+		Asset:         asset.Futures,
+		RealisedPNL:   decimal.NewFromInt(10),
+		UnrealisedPNL: decimal.NewFromInt(-5),
+		ArithmeticRatios: &statistics.Ratios{
+			SharpeRatio: decimal.NewFromInt(3),
+		},
+	}
+	err = r.setMetrics(&statistics.Statistic{
+		ExchangeAssetPairStatistics: map[key.ExchangePairAsset]*statistics.CurrencyPairStatistic{
+			{Base: currency.BTC.Item, Quote: currency.USDT.Item, Asset: asset.Spot}:    spot,
+			{Base: currency.BTC.Item, Quote: currency.USDT.Item, Asset: asset.Futures}: futures,
+			{Base: currency.ETH.Item, Quote: currency.USDT.Item, Asset: asset.Spot}:    {Asset: asset.Spot},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !r.PNL.Equal(decimal.NewFromInt(55)) {
+		t.Errorf("received '%v' expected '%v'", r.PNL, 55)
+	}
+	if !r.SharpeRatio.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", r.SharpeRatio, 2)
+	}
+}
+
+func TestOptimisationResultsSortBy(t *testing.T) {
+	t.Parallel()
+	var o *OptimisationResults
+	err := o.SortBy(config.SharpeRatioMetric)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	o = &OptimisationResults{
+		Results: []OptimisationResult{
+			{SharpeRatio: decimal.NewFromInt(5), PNL: decimal.NewFromInt(1)},
+			{SharpeRatio: decimal.NewFromInt(10), Error: "bad"},
+			{SharpeRatio: decimal.NewFromInt(1), PNL: decimal.NewFromInt(20)},
+			{SharpeRatio: decimal.NewFromInt(7), PNL: decimal.NewFromInt(-3)},
+		},
+	}
+	err = o.SortBy("omega")
+	if !errors.Is(err, config.ErrUnknownOptimisationMetric) {
+		t.Errorf("received '%v' expected '%v'", err, config.ErrUnknownOptimisationMetric)
+	}
+
+	err = o.SortBy("SHARPE")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expected := []int64{7, 5, 1, 10}
+	for i := range o.Results {
+		if !o.Results[i].SharpeRatio.Equal(decimal.NewFromInt(expected[i])) {
+			t.Errorf("received '%v' expected '%v'", o.Results[i].SharpeRatio, expected[i])
+		}
+		if o.Results[i].Rank != i+1 {
+			t.Errorf("received '%v' expected '%v'", o.Results[i].Rank, i+1)
+		}
+	}
+
+	err = o.SortBy(config.PNLMetric)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !o.Results[0].PNL.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%v' expected '%v'", o.Results[0].PNL, 20)
+	}
+	if o.Results[3].Error == "" {
+		t.Error("expected errored result to be ranked last")
+	}
+	if o.Metric != config.PNLMetric {
+		t.Errorf("received '%v' expected '%v'", o.Metric, config.PNLMetric)
+	}
+}
+
+func TestOptimisationResultsWriteTable(t *testing.T) {
+	t.Parallel()
+	var o *OptimisationResults
+	err := o.WriteTable(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	o = &OptimisationResults{}
+	err = o.WriteTable(nil)
+	if !errors.Is(err, errNoOptimisationResults) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOptimisationResults)
+	}
+
+	o.Results = []OptimisationResult{
+		{
+			Rank:        1,
+			Parameters:  []config.ParameterValue{{Name: "custom-settings.rsi-period", Value: decimal.NewFromInt(14)}},
+			SharpeRatio: decimal.NewFromFloat(1.23456),
+		},
+	}
+	var b bytes.Buffer
+	err = o.WriteTable(&b)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(lines), 2)
+	}
+	if !strings.Contains(lines[0], "custom-settings.rsi-period") {
+		t.Errorf("received '%v' expected header to contain parameter name", lines[0])
+	}
+	if !strings.Contains(lines[1], "1.2346") {
+		t.Errorf("received '%v' expected row to contain rounded sharpe ratio", lines[1])
+	}
+}
+
+func TestOptimisationResultsSave(t *testing.T) {
+	t.Parallel()
+	var o *OptimisationResults
+	_, err := o.Save("")
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	o = &OptimisationResults{
+		Strategy:    "rsi",
+		Nickname:    "test",
+		DateStarted: time.Now(),
+		Results:     []OptimisationResult{{Rank: 1, PNL: decimal.NewFromInt(1337)}},
+	}
+	path, err := o.Save(t.TempDir())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	b, err := os.ReadFile(path)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	var resp OptimisationResults
+	err = json.Unmarshal(b, &resp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Results) != 1 || !resp.Results[0].PNL.Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", resp.Results, o.Results)
+	}
+}
+
+func TestDataCacheLoad(t *testing.T) {
+	t.Parallel()
+	var d *dataCache
+	_, _, err := d.load(key.ExchangePairAsset{}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	d = newDataCache()
+	_, _, err = d.load(key.ExchangePairAsset{}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	k := key.ExchangePairAsset{
+		Exchange: testExchange,
+		Base:     currency.BTC.Item,
+		Quote:    currency.USDT.Item,
+		Asset:    asset.Spot,
+	}
+	_, _, err = d.load(k, func() (*kline.DataFromKline, error) { return nil, nil })
+	if !errors.Is(err, errNilData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilData)
+	}
+
+	tt := time.Now().Truncate(time.Hour)
+	var calls int
+	var m sync.Mutex
+	loader := func() (*kline.DataFromKline, error) {
+		m.Lock()
+		calls++
+		m.Unlock()
+		resp := kline.NewDataFromKline()
+		resp.Item = &gctkline.Item{
+			Exchange: testExchange,
+			Pair:     currency.NewPair(currency.BTC, currency.USDT),
+			Asset:    asset.Spot,
+			Interval: gctkline.OneHour,
+			Candles: []gctkline.Candle{
+				{Time: tt, Open: 1, High: 2, Low: 1, Close: 2, Volume: 1},
+				{Time: tt.Add(time.Hour), Open: 2, High: 3, Low: 2, Close: 3, Volume: 1},
+			},
+		}
+		return resp, nil
+	}
+	first, fromCache, err := d.load(k, loader)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if fromCache {
+		t.Errorf("received '%v' expected '%v'", fromCache, false)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, cached, loadErr := d.load(k, loader)
+			if !errors.Is(loadErr, nil) {
+				t.Errorf("received '%v' expected '%v'", loadErr, nil)
+				return
+			}
+			if !cached {
+				t.Errorf("received '%v' expected '%v'", cached, true)
+			}
+			if len(resp.Item.Candles) != 2 {
+				t.Errorf("received '%v' expected '%v'", len(resp.Item.Candles), 2)
+			}
+			events, listErr := resp.List()
+			if !errors.Is(listErr, nil) {
+				t.Errorf("received '%v' expected '%v'", listErr, nil)
+			}
+			if len(events) != 2 {
+				t.Errorf("received '%v' expected '%v'", len(events), 2)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("received '%v' expected '%v'", calls, 1)
+	}
+
+	first.Item.Candles[0].Close = 1337
+	resp, _, err := d.load(k, loader)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Item.Candles[0].Close != 2 {
+		t.Errorf("received '%v' expected '%v'", resp.Item.Candles[0].Close, 2)
+	}
+}
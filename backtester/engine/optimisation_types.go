@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var (
+	errLiveOptimisation       = errors.New("optimisation is only supported with historical data")
+	errNoOptimisationResults  = errors.New("no optimisation results")
+	errUnexpectedStatsHandler = errors.New("unexpected statistics handler")
+	errDataNotCached          = errors.New("data must be cached before it can be windowed")
+	errNoCommonDataRange      = errors.New("cached data has no common date range")
+)
+
+// OptimisationResults holds the ranked results of running a strategy config
+// with every optimisation parameter set
+type OptimisationResults struct {
+	Strategy    string               `json:"strategy"`
+	Nickname    string               `json:"nickname"`
+	Method      string               `json:"method"`
+	Metric      string               `json:"metric"`
+	DateStarted time.Time            `json:"date-started"`
+	DateEnded   time.Time            `json:"date-ended"`
+	Results     []OptimisationResult `json:"results"`
+}
+
+// OptimisationResult is the outcome of a single backtesting task run with a
+// parameter set. Results that errored are ranked last
+type OptimisationResult struct {
+	Rank         int                     `json:"rank"`
+	TaskID       uuid.UUID               `json:"task-id"`
+	Parameters   []config.ParameterValue `json:"parameters"`
+	SharpeRatio  decimal.Decimal         `json:"sharpe-ratio"`
+	SortinoRatio decimal.Decimal         `json:"sortino-ratio"`
+	CalmarRatio  decimal.Decimal         `json:"calmar-ratio"`
+	PNL          decimal.Decimal         `json:"pnl"`
+	TotalOrders  int64                   `json:"total-orders"`
+	Error        string                  `json:"error,omitempty"`
+}
+
+// dataCache holds candle data loaded by the first backtesting task of an
+// optimisation so that every other task reuses it rather than retrieving the
+// same data again
+type dataCache struct {
+	m       sync.Mutex
+	entries map[key.ExchangePairAsset]*cachedData
+}
+
+// cachedData is the candle data for a single exchange, asset and pair
+type cachedData struct {
+	m      sync.Mutex
+	item   *gctkline.Item
+	ranges *gctkline.IntervalRangeHolder
+}
+
+// dataWindow restricts the cached data a backtesting task runs over. The end
+// time is exclusive
+type dataWindow struct {
+	start time.Time
+	end   time.Time
+}
@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
@@ -17,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/api"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/database"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
@@ -40,8 +42,10 @@ import (
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/feeschedule"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -85,6 +89,7 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 	}
 
 	bt.verbose = verbose
+	bt.tickMode = cfg.DataSettings.TickMode
 	bt.DataHolder = data.NewHandlerHolder()
 	reports := &report.Data{
 		Config:       cfg,
@@ -402,6 +407,13 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 		CandleInterval:              cfg.DataSettings.Interval,
 		FundManager:                 bt.Funding,
 	}
+	if cfg.StatisticSettings.MonteCarlo != nil {
+		stats.MonteCarloSettings = &statistics.MonteCarloSettings{
+			Method:      cfg.StatisticSettings.MonteCarlo.Method,
+			Simulations: cfg.StatisticSettings.MonteCarlo.Simulations,
+			Seed:        cfg.StatisticSettings.MonteCarlo.Seed,
+		}
+	}
 	bt.Statistic = stats
 	reports.Statistics = stats
 
@@ -485,6 +497,10 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 	log.Infoln(common.Setup, "Setting exchange settings...")
 
 	resp := &exchange.Exchange{}
+	// fee tiers are earned on an exchange's combined volume, so every pair
+	// on an exchange and asset shares one tracker
+	feeTrackers := make(map[string]*feeschedule.Tracker)
+	feeVolumeCurrencies := make(map[string]currency.Code)
 	for i := range cfg.CurrencySettings {
 		exch, pair, a, err := bt.loadExchangePairAssetBase(
 			cfg.CurrencySettings[i].ExchangeName,
@@ -496,7 +512,7 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 		}
 
 		exchangeName := strings.ToLower(exch.GetName())
-		klineData, err := bt.loadData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair)
+		klineData, err := bt.loadCachedData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair)
 		if err != nil {
 			return nil, err
 		}
@@ -542,6 +558,36 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 			}
 		}
 
+		var feeSchedule *feeschedule.Schedule
+		var feeTracker *feeschedule.Tracker
+		if cfg.CurrencySettings[i].FeeSchedule != nil {
+			feeSchedule, err = getFeeSchedule(context.TODO(), exch, pair, a, cfg.CurrencySettings[i].FeeSchedule)
+			if err != nil {
+				return nil, err
+			}
+			trackerKey := strings.ToLower(exch.GetName()) + a.String()
+			if code, ok := feeVolumeCurrencies[trackerKey]; ok {
+				feeSchedule.VolumeCurrency = code
+			} else {
+				feeVolumeCurrencies[trackerKey] = feeSchedule.VolumeCurrency
+			}
+			feeTracker = feeTrackers[trackerKey]
+			if feeTracker == nil {
+				feeTracker = feeschedule.NewTracker(feeSchedule.Window())
+				feeTrackers[trackerKey] = feeTracker
+			}
+			if cfg.CurrencySettings[i].FeeSchedule.InitialVolume.IsPositive() {
+				start := time.Now()
+				if klineData.Item != nil && len(klineData.Item.Candles) > 0 {
+					start = klineData.Item.Candles[0].Time
+				}
+				err = feeTracker.Add(start, cfg.CurrencySettings[i].FeeSchedule.InitialVolume.InexactFloat64())
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
 		if cfg.CurrencySettings[i].MaximumSlippagePercent.LessThan(decimal.Zero) {
 			log.Warnf(common.Setup, "Invalid maximum slippage percent '%v'. Slippage percent is defined as a number, eg '100.00', defaulting to '%v'",
 				cfg.CurrencySettings[i].MaximumSlippagePercent,
@@ -605,6 +651,15 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 				}
 			}
 		}
+		var replay exchange.OrderbookReplayer
+		if cfg.DataSettings.OrderbookData != nil {
+			// each run receives its own replay as the replayed book is
+			// advanced as orders are filled
+			replay, err = orderbook.LoadReplay(cfg.DataSettings.OrderbookData.Path, exch.GetName(), cfg.DataSettings.Interval.Duration(), pair, a)
+			if err != nil {
+				return nil, err
+			}
+		}
 		var lev exchange.Leverage
 		if cfg.CurrencySettings[i].FuturesDetails != nil {
 			lev = exchange.Leverage{
@@ -629,6 +684,11 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 			SkipCandleVolumeFitting:   cfg.CurrencySettings[i].SkipCandleVolumeFitting,
 			CanUseExchangeLimits:      cfg.CurrencySettings[i].CanUseExchangeLimits,
 			UseExchangePNLCalculation: cfg.CurrencySettings[i].UseExchangePNLCalculation,
+			FeeSchedule:               feeSchedule,
+			FeeTracker:                feeTracker,
+			UseFeeToken:               cfg.CurrencySettings[i].FeeSchedule != nil && cfg.CurrencySettings[i].FeeSchedule.PayFeesWithToken,
+			Orderbook:                 replay,
+			TickMode:                  cfg.DataSettings.TickMode,
 		})
 	}
 
@@ -686,6 +746,61 @@ func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.
 	return decimal.NewFromFloat(fMakerFee), decimal.NewFromFloat(fTakerFee), nil
 }
 
+// getFeeSchedule builds a fee schedule from the currency settings, using the
+// exchange's fee schedule when no tiers are configured
+func getFeeSchedule(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, settings *config.FeeSchedule) (*feeschedule.Schedule, error) {
+	if exch == nil {
+		return nil, fmt.Errorf("exchange %w", gctcommon.ErrNilPointer)
+	}
+	if settings == nil {
+		return nil, fmt.Errorf("fee schedule %w", gctcommon.ErrNilPointer)
+	}
+	schedule := &feeschedule.Schedule{
+		Exchange:       exch.GetName(),
+		Asset:          a,
+		VolumeCurrency: fPair.Quote,
+		VolumeWindow:   settings.VolumeWindow,
+	}
+	if len(settings.Tiers) == 0 {
+		exchSchedule, err := exch.GetFeeSchedule(ctx, a)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve %v fee schedule: %w", exch.GetName(), err)
+		}
+		schedule.Tiers = exchSchedule.Tiers
+		if !exchSchedule.VolumeCurrency.IsEmpty() {
+			schedule.VolumeCurrency = exchSchedule.VolumeCurrency
+		}
+		schedule.TokenDiscount = exchSchedule.TokenDiscount
+		if settings.VolumeWindow == 0 {
+			schedule.VolumeWindow = exchSchedule.VolumeWindow
+		}
+	} else {
+		schedule.Tiers = make([]feeschedule.Tier, len(settings.Tiers))
+		for i := range settings.Tiers {
+			schedule.Tiers[i] = feeschedule.Tier{
+				Name:          settings.Tiers[i].Name,
+				MinimumVolume: settings.Tiers[i].MinimumVolume.InexactFloat64(),
+				Maker:         settings.Tiers[i].MakerFee.InexactFloat64(),
+				Taker:         settings.Tiers[i].TakerFee.InexactFloat64(),
+			}
+		}
+	}
+	if settings.TokenDiscountRate.IsPositive() {
+		code := currency.EMPTYCODE
+		if schedule.TokenDiscount != nil {
+			code = schedule.TokenDiscount.Currency
+		}
+		if code.IsEmpty() {
+			code = fPair.Quote
+		}
+		schedule.TokenDiscount = &feeschedule.TokenDiscount{
+			Currency: code,
+			Rate:     settings.TokenDiscountRate.InexactFloat64(),
+		}
+	}
+	return schedule, schedule.Validate()
+}
+
 // loadData will create kline data from the sources defined in start config files. It can exist from databases, csv or API endpoints
 // it can also be generated from trade data which will be converted into kline data
 func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
@@ -696,7 +811,8 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	if cfg.DataSettings.DatabaseData == nil &&
 		cfg.DataSettings.LiveData == nil &&
 		cfg.DataSettings.APIData == nil &&
-		cfg.DataSettings.CSVData == nil {
+		cfg.DataSettings.CSVData == nil &&
+		cfg.DataSettings.OrderbookData == nil {
 		return nil, errNoDataSource
 	}
 	if (cfg.DataSettings.APIData != nil && cfg.DataSettings.DatabaseData != nil) ||
@@ -704,7 +820,11 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		(cfg.DataSettings.APIData != nil && cfg.DataSettings.CSVData != nil) ||
 		(cfg.DataSettings.DatabaseData != nil && cfg.DataSettings.LiveData != nil) ||
 		(cfg.DataSettings.CSVData != nil && cfg.DataSettings.LiveData != nil) ||
-		(cfg.DataSettings.CSVData != nil && cfg.DataSettings.DatabaseData != nil) {
+		(cfg.DataSettings.CSVData != nil && cfg.DataSettings.DatabaseData != nil) ||
+		(cfg.DataSettings.OrderbookData != nil && cfg.DataSettings.APIData != nil) ||
+		(cfg.DataSettings.OrderbookData != nil && cfg.DataSettings.DatabaseData != nil) ||
+		(cfg.DataSettings.OrderbookData != nil && cfg.DataSettings.LiveData != nil) ||
+		(cfg.DataSettings.OrderbookData != nil && cfg.DataSettings.CSVData != nil) {
 		return nil, errAmbiguousDataSource
 	}
 
@@ -766,6 +886,32 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if len(summary) > 0 {
 			log.Warnf(common.Setup, "%v", summary)
 		}
+	case cfg.DataSettings.OrderbookData != nil:
+		if cfg.DataSettings.Interval <= 0 {
+			return nil, errIntervalUnset
+		}
+		resp, err = orderbook.LoadData(
+			cfg.DataSettings.OrderbookData.Path,
+			exch.GetName(),
+			cfg.DataSettings.Interval.Duration(),
+			fPair,
+			a)
+		if err != nil {
+			return nil, fmt.Errorf("%v. Please check your orderbook recordings", err)
+		}
+		resp.RangeHolder, err = gctkline.CalculateCandleDateRanges(
+			resp.Item.Candles[0].Time,
+			resp.Item.Candles[len(resp.Item.Candles)-1].Time.Add(cfg.DataSettings.Interval.Duration()),
+			cfg.DataSettings.Interval,
+			0,
+		)
+		if err != nil {
+			return nil, err
+		}
+		err = resp.RangeHolder.SetHasDataFromCandles(resp.Item.Candles)
+		if err != nil {
+			return nil, err
+		}
 	case cfg.DataSettings.DatabaseData != nil:
 		if cfg.DataSettings.DatabaseData.InclusiveEndDate {
 			cfg.DataSettings.DatabaseData.EndDate = cfg.DataSettings.DatabaseData.EndDate.Add(cfg.DataSettings.Interval.Duration())
@@ -862,6 +1008,10 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	}
 
 	resp.Item.UnderlyingPair = underlyingPair
+	if !cfg.DataSettings.TickMode {
+		// trades have been aggregated into candles and are no longer needed
+		resp.Trades = nil
+	}
 	err = resp.Load()
 	if err != nil {
 		return nil, err
@@ -873,6 +1023,38 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	return resp, nil
 }
 
+// loadCachedData loads data through the optimisation data cache when one is
+// set, so that data already loaded by another task is not retrieved again.
+// Tasks with a data window only receive the cached data within the window
+func (bt *BackTest) loadCachedData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
+	if bt.dataCache == nil || cfg.DataSettings.LiveData != nil {
+		return bt.loadData(cfg, exch, fPair, a, isUSDTrackingPair)
+	}
+	if exch == nil {
+		return nil, engine.ErrExchangeNotFound
+	}
+	k := key.ExchangePairAsset{
+		Exchange: strings.ToLower(exch.GetName()),
+		Base:     fPair.Base.Item,
+		Quote:    fPair.Quote.Item,
+		Asset:    a,
+	}
+	if bt.dataWindow != nil {
+		resp, err := bt.dataCache.loadWindow(k, bt.dataWindow)
+		if err != nil {
+			return nil, err
+		}
+		return resp, bt.Reports.SetKlineData(resp.Item)
+	}
+	resp, fromCache, err := bt.dataCache.load(k, func() (*kline.DataFromKline, error) {
+		return bt.loadData(cfg, exch, fPair, a, isUSDTrackingPair)
+	})
+	if err != nil || !fromCache {
+		return resp, err
+	}
+	return resp, bt.Reports.SetKlineData(resp.Item)
+}
+
 func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a asset.Item, dataType int64, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
 	if cfg == nil || cfg.DataSettings.DatabaseData == nil {
 		return nil, errors.New("nil config data received")
@@ -906,16 +1088,35 @@ func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair curren
 		return nil, err
 	}
 
-	candles, err := api.LoadData(context.TODO(),
-		dataType,
-		dates.Start.Time,
-		dates.End.Time,
-		cfg.DataSettings.Interval.Duration(),
-		exch,
-		fPair,
-		a)
-	if err != nil {
-		return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
+	var candles *gctkline.Item
+	var trades []trade.Data
+	if cfg.DataSettings.TickMode && dataType == common.DataTrade {
+		trades, err = api.LoadTrades(context.TODO(),
+			dates.Start.Time,
+			dates.End.Time,
+			exch,
+			fPair,
+			a)
+		if err != nil {
+			return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
+		}
+		candles, err = trade.ConvertTradesToCandles(cfg.DataSettings.Interval, trades...)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert trade data to candles for %v %v %v, %v", exch.GetName(), a, fPair, err)
+		}
+		candles.Exchange = strings.ToLower(candles.Exchange)
+	} else {
+		candles, err = api.LoadData(context.TODO(),
+			dataType,
+			dates.Start.Time,
+			dates.End.Time,
+			cfg.DataSettings.Interval.Duration(),
+			exch,
+			fPair,
+			a)
+		if err != nil {
+			return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
+		}
 	}
 
 	err = dates.SetHasDataFromCandles(candles.Candles)
@@ -931,6 +1132,7 @@ func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair curren
 		Base:        &data.Base{},
 		Item:        candles,
 		RangeHolder: dates,
+		Trades:      trades,
 	}, nil
 }
 
@@ -149,6 +149,28 @@ func (r *TaskManager) StartTask(id uuid.UUID) error {
 	return fmt.Errorf("%s %w", id, errTaskNotFound)
 }
 
+// RunTask executes a strategy task and waits for it to complete. Live tasks
+// cannot be waited on and are not supported
+func (r *TaskManager) RunTask(id uuid.UUID) error {
+	if r == nil {
+		return fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	var task *BackTest
+	for i := range r.tasks {
+		if r.tasks[i].MatchesID(id) {
+			task = r.tasks[i]
+			break
+		}
+	}
+	r.m.Unlock()
+	if task == nil {
+		return fmt.Errorf("%s %w", id, errTaskNotFound)
+	}
+	// the lock is not held while the task runs so other tasks can be managed
+	return task.ExecuteStrategy(true)
+}
+
 // StartAllTasks executes all strategies
 func (r *TaskManager) StartAllTasks() ([]uuid.UUID, error) {
 	if r == nil {
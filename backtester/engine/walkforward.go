@@ -0,0 +1,265 @@
+package engine
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// WalkForward splits the strategy config's data range into in-sample and
+// out-of-sample windows. Parameters are optimised over each in-sample window
+// and the best are applied to the out-of-sample window that follows. Every
+// out-of-sample window is run as one continuous task, with parameters changing
+// as each window starts, so that the out-of-sample results are stitched into a
+// single set of statistics and a single report
+func WalkForward(manager *TaskManager, strategyCfg *config.Config, walkForward *config.WalkForward, backtesterCfg *config.BacktesterConfig) (*WalkForwardResults, error) {
+	if manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if strategyCfg == nil {
+		return nil, fmt.Errorf("%w strategy config", gctcommon.ErrNilPointer)
+	}
+	if backtesterCfg == nil {
+		return nil, fmt.Errorf("%w backtester config", gctcommon.ErrNilPointer)
+	}
+	if strategyCfg.DataSettings.LiveData != nil {
+		return nil, errLiveWalkForward
+	}
+	if err := walkForward.Validate(strategyCfg.DataSettings.Interval); err != nil {
+		return nil, err
+	}
+	if err := strategyCfg.Validate(); err != nil {
+		return nil, err
+	}
+	cache := newDataCache()
+	if err := prefetchData(strategyCfg, cache, backtesterCfg.Verbose); err != nil {
+		return nil, err
+	}
+	start, end, err := cache.dataRange()
+	if err != nil {
+		return nil, err
+	}
+	windows, err := walkForward.Windows(start, end)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof(common.Backtester, "Walking forward %v over %v windows from %v to %v", strategyCfg.StrategySettings.Name, len(windows), start, end)
+
+	resp := &WalkForwardResults{
+		Strategy:    strategyCfg.StrategySettings.Name,
+		Nickname:    strategyCfg.Nickname,
+		Method:      walkForward.Optimisation.Method,
+		Metric:      walkForward.Optimisation.Metric,
+		Anchored:    walkForward.Anchored,
+		DateStarted: time.Now(),
+		Windows:     make([]WalkForwardWindowResult, len(windows)),
+	}
+	var params []config.ParameterValue
+	for i := range windows {
+		result := WalkForwardWindowResult{Window: windows[i]}
+		var inSample *OptimisationResults
+		inSample, err = optimise(manager, strategyCfg, &walkForward.Optimisation, backtesterCfg, cache, &dataWindow{
+			start: windows[i].InSampleStart,
+			end:   windows[i].InSampleEnd,
+		})
+		switch {
+		case err != nil:
+			result.Error = err.Error()
+		case len(inSample.Results) == 0 || inSample.Results[0].Error != "":
+			result.Error = errNoValidInSampleResult.Error()
+		default:
+			result.InSample = inSample.Results[0]
+			params = result.InSample.Parameters
+		}
+		if result.Error != "" {
+			log.Warnf(common.Backtester, "Walk-forward window %v: %v, carrying forward previous parameters", i+1, result.Error)
+		}
+		result.Parameters = params
+		resp.Windows[i] = result
+	}
+
+	bt, err := runOutOfSample(manager, strategyCfg, resp.Windows, cache, backtesterCfg)
+	if err != nil {
+		return nil, err
+	}
+	resp.OutOfSample.TaskID = bt.MetaData.ID
+	stats, ok := bt.Statistic.(*statistics.Statistic)
+	if !ok {
+		return nil, fmt.Errorf("%w %T", errUnexpectedStatsHandler, bt.Statistic)
+	}
+	err = resp.OutOfSample.setMetrics(stats)
+	if err != nil {
+		return nil, err
+	}
+	resp.OutOfSample.Rank = 1
+	resp.DateEnded = time.Now()
+	return resp, nil
+}
+
+// prefetchData sets up a task with the full strategy config to load all of
+// its data into the cache, so the data range is known and windowed tasks can
+// share it
+func prefetchData(strategyCfg *config.Config, cache *dataCache, verbose bool) error {
+	cfg, err := strategyCfg.Copy()
+	if err != nil {
+		return err
+	}
+	bt, err := newTaskBacktester()
+	if err != nil {
+		return err
+	}
+	bt.dataCache = cache
+	return bt.SetupFromConfig(cfg, "", "", verbose)
+}
+
+// runOutOfSample runs every out-of-sample window as a single task, applying
+// each window's parameters as its data is reached. The task remains in the
+// task manager and generates a report when enabled
+func runOutOfSample(manager *TaskManager, strategyCfg *config.Config, windows []WalkForwardWindowResult, cache *dataCache, backtesterCfg *config.BacktesterConfig) (*BackTest, error) {
+	if len(windows) == 0 {
+		return nil, errNoOptimisationResults
+	}
+	schedule := make([]scheduledParameters, len(windows))
+	for i := range windows {
+		withParams, err := strategyCfg.Copy()
+		if err != nil {
+			return nil, err
+		}
+		err = withParams.ApplyParameters(windows[i].Parameters)
+		if err != nil {
+			return nil, err
+		}
+		schedule[i] = scheduledParameters{
+			start:          windows[i].Window.OutOfSampleStart,
+			customSettings: withParams.StrategySettings.CustomSettings,
+		}
+	}
+	cfg, err := strategyCfg.Copy()
+	if err != nil {
+		return nil, err
+	}
+	cfg.StrategySettings.CustomSettings = schedule[0].customSettings
+	window := &dataWindow{
+		start: windows[0].Window.OutOfSampleStart,
+		end:   windows[len(windows)-1].Window.OutOfSampleEnd,
+	}
+	window.setConfigDates(cfg)
+	err = cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	bt, err := newTaskBacktester()
+	if err != nil {
+		return nil, err
+	}
+	bt.dataCache = cache
+	bt.dataWindow = window
+	bt.parameterSchedule = schedule
+	var templatePath, outputPath string
+	if backtesterCfg.Report.GenerateReport {
+		templatePath = backtesterCfg.Report.TemplatePath
+		outputPath = backtesterCfg.Report.OutputPath
+	}
+	err = bt.SetupFromConfig(cfg, templatePath, outputPath, backtesterCfg.Verbose)
+	if err != nil {
+		return nil, err
+	}
+	err = manager.AddTask(bt)
+	if err != nil {
+		return nil, err
+	}
+	return bt, manager.RunTask(bt.MetaData.ID)
+}
+
+// applyScheduledParameters sets the strategy custom settings of every
+// schedule entry that has started by the event time
+func (bt *BackTest) applyScheduledParameters(t time.Time) error {
+	for bt.nextScheduledParameters < len(bt.parameterSchedule) &&
+		!t.Before(bt.parameterSchedule[bt.nextScheduledParameters].start) {
+		p := bt.parameterSchedule[bt.nextScheduledParameters]
+		bt.nextScheduledParameters++
+		if len(p.customSettings) == 0 {
+			continue
+		}
+		err := bt.Strategy.SetCustomSettings(p.customSettings)
+		if err != nil {
+			return err
+		}
+		log.Infof(common.Backtester, "Applied walk-forward parameters from %v: %v", p.start, p.customSettings)
+	}
+	return nil
+}
+
+// WriteTable writes each window's selected parameters as a table, followed
+// by the stitched out-of-sample results
+func (w *WalkForwardResults) WriteTable(wr io.Writer) error {
+	if w == nil {
+		return fmt.Errorf("%w walk-forward results", gctcommon.ErrNilPointer)
+	}
+	if len(w.Windows) == 0 {
+		return errNoOptimisationResults
+	}
+	var names []string
+	for i := range w.Windows {
+		if len(w.Windows[i].Parameters) == 0 {
+			continue
+		}
+		for j := range w.Windows[i].Parameters {
+			names = append(names, w.Windows[i].Parameters[j].Name)
+		}
+		break
+	}
+	tw := tabwriter.NewWriter(wr, 0, 0, 2, ' ', 0)
+	header := []string{"Window", "In-Sample Start", "Out-Of-Sample Start", "Out-Of-Sample End"}
+	header = append(header, names...)
+	header = append(header, "In-Sample "+w.Metric, "Error")
+	if _, err := fmt.Fprintln(tw, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+	for i := range w.Windows {
+		row := []string{
+			fmt.Sprintf("%d", i+1),
+			w.Windows[i].Window.InSampleStart.Format(time.DateTime),
+			w.Windows[i].Window.OutOfSampleStart.Format(time.DateTime),
+			w.Windows[i].Window.OutOfSampleEnd.Format(time.DateTime),
+		}
+		for j := range names {
+			if j < len(w.Windows[i].Parameters) {
+				row = append(row, w.Windows[i].Parameters[j].Value.String())
+			} else {
+				row = append(row, "-")
+			}
+		}
+		row = append(row, w.Windows[i].InSample.metric(w.Metric).Round(4).String(), w.Windows[i].Error)
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(wr, "Out-of-sample Sharpe: %v Sortino: %v Calmar: %v PNL: %v Orders: %v\n",
+		w.OutOfSample.SharpeRatio.Round(4),
+		w.OutOfSample.SortinoRatio.Round(4),
+		w.OutOfSample.CalmarRatio.Round(4),
+		w.OutOfSample.PNL.Round(8),
+		w.OutOfSample.TotalOrders)
+	return err
+}
+
+// Save writes the results as JSON to the output path, returning the path of
+// the file written
+func (w *WalkForwardResults) Save(outputPath string) (string, error) {
+	if w == nil {
+		return "", fmt.Errorf("%w walk-forward results", gctcommon.ErrNilPointer)
+	}
+	return saveResults(w, outputPath, w.Nickname, w.Strategy, "walkforward", w.DateStarted)
+}
@@ -0,0 +1,348 @@
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/rsi"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestWalkForward(t *testing.T) {
+	t.Parallel()
+	_, err := WalkForward(nil, nil, nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	m := NewTaskManager()
+	cfg := &config.Config{}
+	_, err = WalkForward(m, cfg, nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	btCfg := &config.BacktesterConfig{}
+	_, err = WalkForward(m, cfg, nil, btCfg)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	cfg.DataSettings.LiveData = &config.LiveData{}
+	_, err = WalkForward(m, cfg, &config.WalkForward{}, btCfg)
+	if !errors.Is(err, errLiveWalkForward) {
+		t.Errorf("received '%v' expected '%v'", err, errLiveWalkForward)
+	}
+}
+
+func TestWalkForwardCSV(t *testing.T) {
+	setOfflineTaskBacktester(t)
+	cfg := rsiCSVStrategyConfig(t)
+	wf := &config.WalkForward{
+		InSampleWindow:    gctkline.OneDay.Duration() * 120,
+		OutOfSampleWindow: gctkline.OneDay.Duration() * 60,
+		Optimisation: config.Optimisation{
+			Method:               config.GridSearch,
+			Metric:               config.PNLMetric,
+			MaximumParallelTasks: 2,
+			Parameters: []config.OptimisationParameter{
+				{Name: "custom-settings.rsi-low", Minimum: decimal.NewFromInt(20), Maximum: decimal.NewFromInt(40), Step: decimal.NewFromInt(10)},
+				{Name: "custom-settings.rsi-high", Minimum: decimal.NewFromInt(60), Maximum: decimal.NewFromInt(80), Step: decimal.NewFromInt(10)},
+			},
+		},
+	}
+	m := NewTaskManager()
+	resp, err := WalkForward(m, cfg, wf, &config.BacktesterConfig{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2019, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	expected := []struct {
+		window        config.WalkForwardWindow
+		low, high     int64
+		inSampleTrade bool
+	}{
+		{config.WalkForwardWindow{InSampleStart: day(1, 1), InSampleEnd: day(5, 1), OutOfSampleStart: day(5, 1), OutOfSampleEnd: day(6, 30)}, 40, 80, true},
+		// windows without an in-sample trade keep the first parameter set
+		{config.WalkForwardWindow{InSampleStart: day(3, 2), InSampleEnd: day(6, 30), OutOfSampleStart: day(6, 30), OutOfSampleEnd: day(8, 29)}, 20, 60, false},
+		{config.WalkForwardWindow{InSampleStart: day(5, 1), InSampleEnd: day(8, 29), OutOfSampleStart: day(8, 29), OutOfSampleEnd: day(10, 28)}, 20, 60, false},
+		{config.WalkForwardWindow{InSampleStart: day(6, 30), InSampleEnd: day(10, 28), OutOfSampleStart: day(10, 28), OutOfSampleEnd: day(12, 27)}, 30, 70, true},
+		// the final out-of-sample window is cut short by the end of the data
+		{config.WalkForwardWindow{InSampleStart: day(8, 29), InSampleEnd: day(12, 27), OutOfSampleStart: day(12, 27), OutOfSampleEnd: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}, 30, 60, true},
+	}
+	if len(resp.Windows) != len(expected) {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Windows), len(expected))
+	}
+	for i := range expected {
+		w := resp.Windows[i]
+		if w.Window != expected[i].window {
+			t.Errorf("window %v received '%+v' expected '%+v'", i+1, w.Window, expected[i].window)
+		}
+		if w.Error != "" {
+			t.Errorf("window %v received '%v' expected '%v'", i+1, w.Error, "")
+		}
+		if len(w.Parameters) != 2 {
+			t.Fatalf("window %v received '%v' expected '%v'", i+1, len(w.Parameters), 2)
+		}
+		if !w.Parameters[0].Value.Equal(decimal.NewFromInt(expected[i].low)) ||
+			!w.Parameters[1].Value.Equal(decimal.NewFromInt(expected[i].high)) {
+			t.Errorf("window %v received '%v' expected '%v' and '%v'", i+1, w.Parameters, expected[i].low, expected[i].high)
+		}
+		if w.InSample.PNL.IsPositive() != expected[i].inSampleTrade {
+			t.Errorf("window %v received '%v' expected a profit '%v'", i+1, w.InSample.PNL, expected[i].inSampleTrade)
+		}
+	}
+
+	// every out-of-sample window is stitched into the single remaining task
+	if len(m.tasks) != 1 || m.tasks[0].MetaData.ID != resp.OutOfSample.TaskID {
+		t.Fatalf("received '%v' tasks expected the out-of-sample task '%v'", len(m.tasks), resp.OutOfSample.TaskID)
+	}
+	stats, ok := m.tasks[0].Statistic.(*statistics.Statistic)
+	if !ok {
+		t.Fatalf("received '%T' expected '%T'", m.tasks[0].Statistic, stats)
+	}
+	if !stats.StartDate.Equal(day(5, 1)) {
+		t.Errorf("received '%v' expected '%v'", stats.StartDate, day(5, 1))
+	}
+	if !stats.EndDate.Equal(day(12, 31)) {
+		t.Errorf("received '%v' expected '%v'", stats.EndDate, day(12, 31))
+	}
+	for _, c := range stats.ExchangeAssetPairStatistics {
+		if len(c.Events) != 245 {
+			t.Errorf("received '%v' expected '%v'", len(c.Events), 245)
+		}
+	}
+	if resp.OutOfSample.TotalOrders != stats.TotalOrders || resp.OutOfSample.TotalOrders == 0 {
+		t.Errorf("received '%v' expected '%v' orders", resp.OutOfSample.TotalOrders, stats.TotalOrders)
+	}
+	if resp.OutOfSample.Rank != 1 || resp.OutOfSample.PNL.IsZero() {
+		t.Errorf("received rank '%v' PNL '%v' expected a ranked result", resp.OutOfSample.Rank, resp.OutOfSample.PNL)
+	}
+}
+
+func TestApplyScheduledParameters(t *testing.T) {
+	t.Parallel()
+	tt := time.Now().Truncate(time.Hour)
+	bt := &BackTest{
+		Strategy: &rsi.Strategy{},
+		parameterSchedule: []scheduledParameters{
+			{start: tt, customSettings: map[string]interface{}{"rsi-period": float64(10)}},
+			{start: tt.Add(time.Hour)},
+			{start: tt.Add(time.Hour * 2), customSettings: map[string]interface{}{"rsi-period": "bad"}},
+		},
+	}
+	err := bt.applyScheduledParameters(tt.Add(-time.Hour))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if bt.nextScheduledParameters != 0 {
+		t.Errorf("received '%v' expected '%v'", bt.nextScheduledParameters, 0)
+	}
+
+	err = bt.applyScheduledParameters(tt.Add(time.Hour))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if bt.nextScheduledParameters != 2 {
+		t.Errorf("received '%v' expected '%v'", bt.nextScheduledParameters, 2)
+	}
+
+	err = bt.applyScheduledParameters(tt.Add(time.Hour * 2))
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+
+	err = bt.applyScheduledParameters(tt.Add(time.Hour * 3))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestDataCacheLoadWindow(t *testing.T) {
+	t.Parallel()
+	var d *dataCache
+	_, err := d.loadWindow(key.ExchangePairAsset{}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, _, err = d.dataRange()
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	d = newDataCache()
+	_, err = d.loadWindow(key.ExchangePairAsset{}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, _, err = d.dataRange()
+	if !errors.Is(err, errDataNotCached) {
+		t.Errorf("received '%v' expected '%v'", err, errDataNotCached)
+	}
+
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	k := key.ExchangePairAsset{
+		Exchange: testExchange,
+		Base:     currency.BTC.Item,
+		Quote:    currency.USDT.Item,
+		Asset:    asset.Spot,
+	}
+	_, err = d.loadWindow(k, &dataWindow{start: tt, end: tt.Add(time.Hour)})
+	if !errors.Is(err, errDataNotCached) {
+		t.Errorf("received '%v' expected '%v'", err, errDataNotCached)
+	}
+
+	_, _, err = d.load(k, func() (*kline.DataFromKline, error) {
+		resp := kline.NewDataFromKline()
+		resp.Item = &gctkline.Item{
+			Exchange: testExchange,
+			Pair:     currency.NewPair(currency.BTC, currency.USDT),
+			Asset:    asset.Spot,
+			Interval: gctkline.OneHour,
+		}
+		for i := 0; i < 10; i++ {
+			resp.Item.Candles = append(resp.Item.Candles, gctkline.Candle{
+				Time:  tt.Add(time.Hour * time.Duration(i)),
+				Open:  1,
+				High:  1,
+				Low:   1,
+				Close: 1,
+			})
+		}
+		var rangeErr error
+		resp.RangeHolder, rangeErr = gctkline.CalculateCandleDateRanges(tt, tt.Add(time.Hour*10), gctkline.OneHour, 0)
+		return resp, rangeErr
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	start, end, err := d.dataRange()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !start.Equal(tt) || !end.Equal(tt.Add(time.Hour*10)) {
+		t.Errorf("received '%v-%v' expected '%v-%v'", start, end, tt, tt.Add(time.Hour*10))
+	}
+
+	_, err = d.loadWindow(k, &dataWindow{start: tt.Add(time.Hour * 20), end: tt.Add(time.Hour * 30)})
+	if !errors.Is(err, errNilData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilData)
+	}
+
+	resp, err := d.loadWindow(k, &dataWindow{start: tt.Add(time.Hour * 2), end: tt.Add(time.Hour * 5)})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Item.Candles) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Item.Candles), 3)
+	}
+	if !resp.Item.Candles[0].Time.Equal(tt.Add(time.Hour * 2)) {
+		t.Errorf("received '%v' expected '%v'", resp.Item.Candles[0].Time, tt.Add(time.Hour*2))
+	}
+	if !resp.RangeHolder.Start.Time.Equal(tt.Add(time.Hour * 2)) {
+		t.Errorf("received '%v' expected '%v'", resp.RangeHolder.Start.Time, tt.Add(time.Hour*2))
+	}
+}
+
+func TestDataWindowSetConfigDates(t *testing.T) {
+	t.Parallel()
+	var w *dataWindow
+	w.setConfigDates(&config.Config{})
+
+	tt := time.Now().Truncate(time.Hour)
+	w = &dataWindow{start: tt, end: tt.Add(time.Hour)}
+	cfg := &config.Config{
+		DataSettings: config.DataSettings{
+			APIData: &config.APIData{InclusiveEndDate: true},
+		},
+	}
+	w.setConfigDates(cfg)
+	if !cfg.DataSettings.APIData.StartDate.Equal(w.start) {
+		t.Errorf("received '%v' expected '%v'", cfg.DataSettings.APIData.StartDate, w.start)
+	}
+	if !cfg.DataSettings.APIData.EndDate.Equal(w.end) {
+		t.Errorf("received '%v' expected '%v'", cfg.DataSettings.APIData.EndDate, w.end)
+	}
+	if cfg.DataSettings.APIData.InclusiveEndDate {
+		t.Errorf("received '%v' expected '%v'", cfg.DataSettings.APIData.InclusiveEndDate, false)
+	}
+}
+
+func TestWalkForwardResultsWriteTable(t *testing.T) {
+	t.Parallel()
+	var w *WalkForwardResults
+	err := w.WriteTable(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	w = &WalkForwardResults{Metric: config.SharpeRatioMetric}
+	err = w.WriteTable(nil)
+	if !errors.Is(err, errNoOptimisationResults) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOptimisationResults)
+	}
+
+	params := []config.ParameterValue{{Name: "custom-settings.rsi-period", Value: decimal.NewFromInt(14)}}
+	w.Windows = []WalkForwardWindowResult{
+		{Error: errNoValidInSampleResult.Error()},
+		{Parameters: params, InSample: OptimisationResult{SharpeRatio: decimal.NewFromInt(2)}},
+	}
+	w.OutOfSample.PNL = decimal.NewFromInt(1337)
+	var b bytes.Buffer
+	err = w.WriteTable(&b)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(lines), 4)
+	}
+	if !strings.Contains(lines[0], "custom-settings.rsi-period") {
+		t.Errorf("received '%v' expected header to contain parameter name", lines[0])
+	}
+	if !strings.Contains(lines[1], "-") || !strings.Contains(lines[1], errNoValidInSampleResult.Error()) {
+		t.Errorf("received '%v' expected window without parameters", lines[1])
+	}
+	if !strings.Contains(lines[3], "1337") {
+		t.Errorf("received '%v' expected out-of-sample PNL", lines[3])
+	}
+}
+
+func TestWalkForwardResultsSave(t *testing.T) {
+	t.Parallel()
+	var w *WalkForwardResults
+	_, err := w.Save("")
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	w = &WalkForwardResults{Strategy: "rsi", DateStarted: time.Now()}
+	path, err := w.Save(t.TempDir())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !strings.Contains(path, "rsi-walkforward-") {
+		t.Errorf("received '%v' expected walk-forward file name", path)
+	}
+	_, err = os.Stat(path)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
@@ -0,0 +1,47 @@
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+)
+
+var (
+	errLiveWalkForward       = errors.New("walk-forward analysis is only supported with historical data")
+	errNoValidInSampleResult = errors.New("no in-sample parameter set completed without error")
+)
+
+// WalkForwardResults holds the parameters selected for each walk-forward
+// window and the results of running every out-of-sample window as a single
+// continuous backtesting task
+type WalkForwardResults struct {
+	Strategy    string                    `json:"strategy"`
+	Nickname    string                    `json:"nickname"`
+	Method      string                    `json:"method"`
+	Metric      string                    `json:"metric"`
+	Anchored    bool                      `json:"anchored"`
+	DateStarted time.Time                 `json:"date-started"`
+	DateEnded   time.Time                 `json:"date-ended"`
+	Windows     []WalkForwardWindowResult `json:"windows"`
+	// OutOfSample holds the metrics of the stitched out-of-sample task. Its
+	// parameters are unset as they change with each window
+	OutOfSample OptimisationResult `json:"out-of-sample"`
+}
+
+// WalkForwardWindowResult is the best in-sample result for a window and the
+// parameters applied to its out-of-sample window. When no in-sample result is
+// valid, the previous window's parameters are carried forward
+type WalkForwardWindowResult struct {
+	Window     config.WalkForwardWindow `json:"window"`
+	InSample   OptimisationResult       `json:"in-sample"`
+	Parameters []config.ParameterValue  `json:"parameters"`
+	Error      string                   `json:"error,omitempty"`
+}
+
+// scheduledParameters are strategy custom settings applied once data events
+// reach the start time
+type scheduledParameters struct {
+	start          time.Time
+	customSettings map[string]interface{}
+}
@@ -15,10 +15,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 // Reset returns the exchange to initial settings
@@ -27,6 +29,8 @@ func (e *Exchange) Reset() error {
 		return gctcommon.ErrNilPointer
 	}
 	e.CurrencySettings = nil
+	e.restingOrders = nil
+	e.filledOffsets = nil
 	return nil
 }
 
@@ -51,6 +55,17 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 		return f, err
 	}
 	f.Direction = o.GetDirection()
+	rests := o.GetOrderType() != gctorder.Market && o.GetOrderType() != gctorder.UnknownType
+	if !cs.TickMode && rests {
+		// candles cannot show whether an order price was traded through, so
+		// only market orders are filled outside of tick mode
+		return f, rejectOrder(o, f, funds, errTickModeRequired)
+	}
+	if cs.TickMode && (rests || (!o.IsLiquidating() && !o.IsClosingPosition() && e.hasFilledAtOffset(o))) {
+		// a market order raised on a trade which has already filled resting
+		// orders waits for the next trade
+		return f, e.restOrder(o, f, &cs, funds)
+	}
 
 	var price, adjustedPrice,
 		amount, adjustedAmount,
@@ -79,6 +94,12 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 			}
 			return f, nil
 		}
+	} else if cs.Orderbook != nil && o.GetDirection() != gctorder.ClosePosition {
+		price, amount, err = fillFromOrderbook(f, cs.Orderbook, o.GetTime(), price, amount, o.GetOrderPrice())
+		if err != nil {
+			return f, err
+		}
+		adjustedPrice = price
 	} else {
 		slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
 		if cs.SkipCandleVolumeFitting || o.GetAssetType().IsFutures() || o.GetDirection() == gctorder.ClosePosition {
@@ -134,29 +155,20 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 		return f, err
 	}
 
-	fee = calculateExchangeFee(price, amount, cs.TakerFee)
+	fee, err = cs.orderFee(o.GetTime(), price, amount, false)
+	if err != nil {
+		return f, err
+	}
 
-	orderID, err := e.placeOrder(context.TODO(), price, amount, fee, cs.UseRealOrders, cs.CanUseExchangeLimits, f, om)
+	orderID, err := e.placeOrder(context.TODO(), price, amount, fee, cs.UseRealOrders, cs.CanUseExchangeLimits, f, om, gctorder.Market)
 	if err != nil {
 		f.AppendReasonf("could not place order: %v", err)
 		setCannotPurchaseDirection(f)
 		return f, err
 	}
-	ords := om.GetOrdersSnapshot(gctorder.UnknownStatus)
-	for i := range ords {
-		if ords[i].OrderID != orderID {
-			continue
-		}
-		ords[i].Date = o.GetTime()
-		ords[i].LastUpdated = o.GetTime()
-		ords[i].CloseTime = o.GetTime()
-		f.Order = &ords[i]
-		f.PurchasePrice = decimal.NewFromFloat(ords[i].Price)
-		f.Amount = decimal.NewFromFloat(ords[i].Amount)
-		if ords[i].Fee > 0 {
-			f.ExchangeFee = decimal.NewFromFloat(ords[i].Fee)
-		}
-		f.Total = f.PurchasePrice.Mul(f.Amount).Add(f.ExchangeFee)
+	setFillOrder(f, om, orderID, o.GetTime())
+	if cs.TickMode {
+		e.setFilledOffset(o)
 	}
 	if !o.IsLiquidating() {
 		err = allocateFundsPostOrder(f, funds, err, o.GetAmount(), allocatedFunds, amount, price, fee)
@@ -165,7 +177,13 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 		}
 	}
 	if f.Order == nil {
-		return nil, fmt.Errorf("placed order %v not found in order manager", orderID)
+		return nil, fmt.Errorf("%w %v", errPlacedOrderNotFound, orderID)
+	}
+	if cs.FeeSchedule != nil {
+		err = cs.trackFeeVolume(f, o.GetTime(), f.Amount, f.PurchasePrice)
+		if err != nil {
+			return f, err
+		}
 	}
 	f.AppendReason(summarisePosition(f.GetDirection(), f.Amount, f.Amount.Mul(f.PurchasePrice), f.ExchangeFee, f.Order.Pair, f.UnderlyingPair))
 	return f, nil
@@ -337,7 +355,7 @@ func reduceAmountToFitPortfolioLimit(adjustedPrice, amount, sizedPortfolioTotal
 	return amount
 }
 
-func (e *Exchange) placeOrder(ctx context.Context, price, amount, fee decimal.Decimal, useRealOrders, useExchangeLimits bool, f fill.Event, orderManager *engine.OrderManager) (string, error) {
+func (e *Exchange) placeOrder(ctx context.Context, price, amount, fee decimal.Decimal, useRealOrders, useExchangeLimits bool, f fill.Event, orderManager *engine.OrderManager, orderType gctorder.Type) (string, error) {
 	if f == nil {
 		return "", common.ErrNilEvent
 	}
@@ -353,7 +371,7 @@ func (e *Exchange) placeOrder(ctx context.Context, price, amount, fee decimal.De
 		Side:             f.GetDirection(),
 		AssetType:        f.GetAssetType(),
 		Pair:             f.Pair(),
-		Type:             gctorder.Market,
+		Type:             orderType,
 		RetrieveFees:     true,
 		RetrieveFeeDelay: time.Millisecond * 500,
 	}
@@ -381,6 +399,80 @@ func (e *Exchange) placeOrder(ctx context.Context, price, amount, fee decimal.De
 	return resp.OrderID, nil
 }
 
+// fillFromOrderbook walks the recorded orderbook at the order time to find the
+// average price and amount the order would have filled at. Buy orders spend
+// the quote value of the order, so a worse price fills a smaller amount. When
+// the order has a price, the walk stops at levels beyond it
+func fillFromOrderbook(f *fill.Fill, replay OrderbookReplayer, t time.Time, price, amount, orderPrice decimal.Decimal) (fillPrice, fillAmount decimal.Decimal, err error) {
+	book, err := replay.OrderbookAt(t)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	if book == nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w orderbook", gctcommon.ErrNilPointer)
+	}
+	var side gctorder.Side
+	funds := amount
+	switch f.GetDirection() {
+	case gctorder.Buy, gctorder.Bid, gctorder.Long:
+		side = gctorder.Buy
+		funds = amount.Mul(price)
+	case gctorder.Sell, gctorder.Ask, gctorder.Short:
+		side = gctorder.Sell
+	default:
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%v %w", f.GetDirection(), gctorder.ErrSideIsInvalid)
+	}
+	if orderPrice.IsPositive() {
+		book = bookWithinPrice(book, side, orderPrice.InexactFloat64())
+		if (side == gctorder.Buy && len(book.Asks) == 0) || (side == gctorder.Sell && len(book.Bids) == 0) {
+			return decimal.Zero, decimal.Zero, fmt.Errorf("%w %v", errNoLiquidityWithinPrice, orderPrice)
+		}
+	}
+	fillPrice, fillAmount, err = slippage.CalculateSlippageByOrderbook(book, side, funds, decimal.Zero)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	f.VolumeAdjustedPrice = f.ClosePrice
+	if !fillAmount.Equal(amount) {
+		f.AppendReasonf("Order size changed from %v to %v by orderbook depth", amount, fillAmount)
+	}
+	if !fillPrice.Equal(price) {
+		f.AppendReasonf("Price has slipped from %v to %v walking the orderbook", price, fillPrice)
+	}
+	if !price.IsZero() {
+		if side == gctorder.Buy {
+			f.Slippage = price.Sub(fillPrice).Div(price).Mul(decimal.NewFromInt(100))
+		} else {
+			f.Slippage = fillPrice.Sub(price).Div(price).Mul(decimal.NewFromInt(100))
+		}
+	}
+	return fillPrice, fillAmount, nil
+}
+
+// bookWithinPrice returns a copy of an orderbook holding only the levels an
+// order on the side can fill at without crossing the price
+func bookWithinPrice(book *orderbook.Base, side gctorder.Side, price float64) *orderbook.Base {
+	cpy := *book
+	if side == gctorder.Buy {
+		cpy.Asks = nil
+		for i := range book.Asks {
+			if book.Asks[i].Price > price {
+				break
+			}
+			cpy.Asks = append(cpy.Asks, book.Asks[i])
+		}
+		return &cpy
+	}
+	cpy.Bids = nil
+	for i := range book.Bids {
+		if book.Bids[i].Price < price {
+			break
+		}
+		cpy.Bids = append(cpy.Bids, book.Bids[i])
+	}
+	return &cpy
+}
+
 func applySlippageToPrice(direction gctorder.Side, price, slippageRate decimal.Decimal) (decimal.Decimal, error) {
 	var adjustedPrice decimal.Decimal
 	switch direction {
@@ -455,3 +547,428 @@ func ensureOrderFitsWithinHLV(price, amount, high, low, volume decimal.Decimal)
 func calculateExchangeFee(price, amount, fee decimal.Decimal) decimal.Decimal {
 	return fee.Mul(price).Mul(amount)
 }
+
+// orderFee calculates the fee of an order from the fee schedule when set,
+// otherwise from the maker or taker fee
+func (s *Settings) orderFee(at time.Time, price, amount decimal.Decimal, isMaker bool) (decimal.Decimal, error) {
+	if s.FeeSchedule != nil {
+		return s.scheduledFee(at, price, amount, isMaker)
+	}
+	if isMaker {
+		return calculateExchangeFee(price, amount, s.MakerFee), nil
+	}
+	return calculateExchangeFee(price, amount, s.TakerFee), nil
+}
+
+// scheduledFee calculates the fee of an order from the fee tier which
+// applies to the volume traded in the window ending at the time
+func (s *Settings) scheduledFee(at time.Time, price, amount decimal.Decimal, isMaker bool) (decimal.Decimal, error) {
+	rate, err := s.FeeSchedule.Rate(s.FeeTracker.Volume(at), isMaker, s.UseFeeToken)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return calculateExchangeFee(price, amount, decimal.NewFromFloat(rate)), nil
+}
+
+// trackFeeVolume records the volume of a placed order in the fee schedule's
+// volume currency and notes on the fill when it moves the account into a
+// different fee tier
+func (s *Settings) trackFeeVolume(f *fill.Fill, at time.Time, amount, price decimal.Decimal) error {
+	volume, err := s.feeVolume(amount, price)
+	if err != nil {
+		return err
+	}
+	if !volume.IsPositive() {
+		return nil
+	}
+	previous, err := s.FeeTracker.PredictTier(s.FeeSchedule, at)
+	if err != nil {
+		return err
+	}
+	err = s.FeeTracker.Add(at, volume.InexactFloat64())
+	if err != nil {
+		return err
+	}
+	s.FeeTracker.Prune(at)
+	current, err := s.FeeTracker.PredictTier(s.FeeSchedule, at)
+	if err != nil {
+		return err
+	}
+	if current.Name != previous.Name {
+		f.AppendReasonf("Fee tier changed from %v to %v", previous.Name, current.Name)
+	}
+	return nil
+}
+
+// feeVolume converts the amount traded at the price into the fee schedule's
+// volume currency. USD and stablecoins are treated as equivalent
+func (s *Settings) feeVolume(amount, price decimal.Decimal) (decimal.Decimal, error) {
+	code := s.FeeSchedule.VolumeCurrency
+	switch {
+	case code.IsEmpty(), s.Pair.Quote.Equal(code):
+		return amount.Mul(price), nil
+	case s.Pair.Base.Equal(code):
+		return amount, nil
+	case isUSDEquivalent(s.Pair.Quote) && isUSDEquivalent(code):
+		return amount.Mul(price), nil
+	case isUSDEquivalent(s.Pair.Base) && isUSDEquivalent(code):
+		return amount, nil
+	}
+	return decimal.Zero, fmt.Errorf("%w %v to %v", errFeeVolumeConversion, s.Pair, code)
+}
+
+func isUSDEquivalent(c currency.Code) bool {
+	return c.Equal(currency.USD) || c.IsStableCurrency()
+}
+
+// setFillOrder attaches the placed order to the fill and sets the fill's
+// price, amount and fee from it
+func setFillOrder(f *fill.Fill, om *engine.OrderManager, orderID string, t time.Time) {
+	ords := om.GetOrdersSnapshot(gctorder.UnknownStatus)
+	for i := range ords {
+		if ords[i].OrderID != orderID {
+			continue
+		}
+		ords[i].Date = t
+		ords[i].LastUpdated = t
+		ords[i].CloseTime = t
+		f.Order = &ords[i]
+		f.PurchasePrice = decimal.NewFromFloat(ords[i].Price)
+		f.Amount = decimal.NewFromFloat(ords[i].Amount)
+		if ords[i].Fee > 0 {
+			f.ExchangeFee = decimal.NewFromFloat(ords[i].Fee)
+		}
+		f.Total = f.PurchasePrice.Mul(f.Amount).Add(f.ExchangeFee)
+	}
+}
+
+// MatchRestingOrders fills the resting orders crossed by the trade in the
+// data event in the order they were placed until the traded volume is used
+// up, leaving any remainder resting. Limit orders fill at their limit price
+// while stop and deferred market orders fill at the traded price. Orders
+// which have expired are cancelled and their reserved funds released
+func (e *Exchange) MatchRestingOrders(ev data.Event, om *engine.OrderManager, funds funding.IFundReleaser) ([]fill.Event, error) {
+	if ev == nil {
+		return nil, common.ErrNilEvent
+	}
+	if funds == nil {
+		return nil, fmt.Errorf("%w: funding", gctcommon.ErrNilPointer)
+	}
+	k := eventKey(ev)
+	resting := e.restingOrders[k]
+	if len(resting) == 0 {
+		return nil, nil
+	}
+	cs, err := e.GetCurrencySettings(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return nil, err
+	}
+	// a trade without volume is not limited
+	volume := ev.GetVolume()
+	unlimited := !volume.IsPositive()
+	var (
+		fills []fill.Event
+		errs  error
+	)
+	remaining := make([]*restingOrder, 0, len(resting))
+	for _, r := range resting {
+		if r.expired(ev.GetTime()) {
+			errs = gctcommon.AppendError(errs, r.cancel(funds))
+			continue
+		}
+		price, ok := r.match(ev.GetClosePrice())
+		if !ok || (!unlimited && !volume.IsPositive()) {
+			remaining = append(remaining, r)
+			continue
+		}
+		f, done, err := e.fillRestingOrder(ev, r, &cs, price, volume, om, funds)
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+		}
+		if !done {
+			remaining = append(remaining, r)
+		}
+		if f == nil {
+			// nothing was filled, the trade can still fill a later order
+			continue
+		}
+		fills = append(fills, f)
+		volume = volume.Sub(f.Amount)
+	}
+	e.restingOrders[k] = remaining
+	if len(fills) > 0 {
+		e.setFilledOffset(ev)
+	}
+	return fills, errs
+}
+
+// CancelAllRestingOrders cancels every resting order and releases the funds
+// reserved for them. It is called when a run ends so that unfilled orders do
+// not hold funds in the final results
+func (e *Exchange) CancelAllRestingOrders(funds funding.IFundingManager) ([]order.Event, error) {
+	if funds == nil {
+		return nil, fmt.Errorf("%w: funding", gctcommon.ErrNilPointer)
+	}
+	var (
+		cancelled []order.Event
+		errs      error
+	)
+	for k, resting := range e.restingOrders {
+		for _, r := range resting {
+			pair, err := funds.GetFundingForEvent(r.order)
+			if err == nil {
+				err = r.cancel(pair.FundReleaser())
+			}
+			if err != nil {
+				errs = gctcommon.AppendError(errs, err)
+				continue
+			}
+			cancelled = append(cancelled, r.order)
+		}
+		delete(e.restingOrders, k)
+	}
+	return cancelled, errs
+}
+
+// restOrder holds an order with the exchange until a trade fills it. The
+// funds reserved by the portfolio manager remain reserved while it rests
+func (e *Exchange) restOrder(o order.Event, f *fill.Fill, cs *Settings, funds funding.IFundReleaser) error {
+	err := validateRestingOrder(o, cs)
+	if err == nil && !o.GetOrderExpiry().IsZero() && !o.GetOrderExpiry().After(o.GetTime()) {
+		err = fmt.Errorf("%w, received %v", errOrderExpired, o.GetOrderExpiry())
+	}
+	if err == nil {
+		err = verifyOrderWithinLimits(f, o.GetAmount(), cs)
+	}
+	if err != nil {
+		return rejectOrder(o, f, funds, err)
+	}
+	if e.restingOrders == nil {
+		e.restingOrders = make(map[key.ExchangePairAsset][]*restingOrder)
+	}
+	k := eventKey(o)
+	e.restingOrders[k] = append(e.restingOrders[k], &restingOrder{
+		order:  o,
+		amount: o.GetAmount(),
+		funds:  o.GetAllocatedFunds(),
+	})
+	switch {
+	case (o.GetOrderType() == gctorder.Limit || o.GetOrderType() == gctorder.Stop) && !o.GetOrderExpiry().IsZero():
+		f.AppendReasonf("%v %v order of %v resting at %v until %v", o.GetOrderType(), o.GetDirection(), o.GetAmount(), o.GetOrderPrice(), o.GetOrderExpiry())
+	case o.GetOrderType() == gctorder.Limit || o.GetOrderType() == gctorder.Stop:
+		f.AppendReasonf("%v %v order of %v resting at %v", o.GetOrderType(), o.GetDirection(), o.GetAmount(), o.GetOrderPrice())
+	default:
+		f.AppendReasonf("%v %v order of %v waiting for the next trade", o.GetOrderType(), o.GetDirection(), o.GetAmount())
+	}
+	return ErrOrderResting
+}
+
+// rejectOrder records why an order could not be placed or rested and
+// releases the funds reserved for it
+func rejectOrder(o order.Event, f *fill.Fill, funds funding.IFundReleaser, err error) error {
+	f.AppendReasonf("could not rest %v order: %v", o.GetOrderType(), err)
+	f.SetDirection(o.GetDirection())
+	return allocateFundsPostOrder(f, funds, err, o.GetAmount(), o.GetAllocatedFunds(), decimal.Zero, decimal.Zero, decimal.Zero)
+}
+
+func validateRestingOrder(o order.Event, cs *Settings) error {
+	if o.GetAssetType() != asset.Spot {
+		return fmt.Errorf("%w, received %v", errRestingOrderUnsupported, o.GetAssetType())
+	}
+	switch o.GetOrderType() {
+	case gctorder.Market, gctorder.UnknownType:
+	case gctorder.Limit, gctorder.Stop:
+		if !o.GetOrderPrice().IsPositive() {
+			return fmt.Errorf("%w, received %v", errInvalidOrderPrice, o.GetOrderPrice())
+		}
+	default:
+		return fmt.Errorf("%w %v", errUnsupportedOrderType, o.GetOrderType())
+	}
+	switch o.GetDirection() {
+	case gctorder.Buy, gctorder.Bid, gctorder.Sell, gctorder.Ask:
+		return nil
+	default:
+		return fmt.Errorf("%w: %v", errInvalidDirection, o.GetDirection())
+	}
+}
+
+// fillRestingOrder fills as much of a resting order as the trade's remaining
+// volume and the order's reserved funds allow. A volume which is not positive
+// does not limit the fill. It returns whether the order is finished and
+// should no longer rest
+func (e *Exchange) fillRestingOrder(ev data.Event, r *restingOrder, cs *Settings, price, volume decimal.Decimal, om *engine.OrderManager, funds funding.IFundReleaser) (f *fill.Fill, done bool, err error) {
+	o := r.order
+	pr, err := funds.PairReleaser()
+	if err != nil {
+		return nil, false, err
+	}
+	isBuy := o.GetDirection() == gctorder.Buy || o.GetDirection() == gctorder.Bid
+	// a triggered stop order is submitted as a market order
+	isMaker := o.GetOrderType() == gctorder.Limit
+	amount := r.amount
+	if volume.IsPositive() && amount.GreaterThan(volume) {
+		amount = volume
+	}
+	if cs.CanUseExchangeLimits {
+		amount = cs.Limits.ConformToDecimalAmount(amount)
+	}
+	if !amount.IsPositive() {
+		// the trade is too small to fill the order within step limits
+		return nil, false, nil
+	}
+	fee, err := cs.orderFee(ev.GetTime(), price, amount, isMaker)
+	if err != nil {
+		return nil, false, err
+	}
+	if cost := amount.Mul(price).Add(fee); isBuy && cost.GreaterThan(r.funds) {
+		// a stop order can fill above the price it was sized at
+		amount = amount.Mul(r.funds).Div(cost)
+		if cs.CanUseExchangeLimits {
+			amount = cs.Limits.ConformToDecimalAmount(amount)
+		}
+		if !amount.IsPositive() {
+			return nil, true, r.release(pr)
+		}
+		fee, err = cs.orderFee(ev.GetTime(), price, amount, isMaker)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	f = &fill.Fill{
+		Base:                ev.GetBase(),
+		Direction:           o.GetDirection(),
+		Amount:              amount,
+		ClosePrice:          ev.GetClosePrice(),
+		VolumeAdjustedPrice: ev.GetClosePrice(),
+		FillDependentEvent:  o.GetFillDependentEvent(),
+	}
+	if !amount.Equal(r.amount) {
+		f.AppendReasonf("Order size shrunk from %v to %v to fit traded volume and reserved funds, %v remains resting", r.amount, amount, r.amount.Sub(amount))
+	}
+	submitType := gctorder.Market
+	if isMaker {
+		submitType = gctorder.Limit
+	}
+	orderID, err := e.placeOrder(context.TODO(), price, amount, fee, false, cs.CanUseExchangeLimits, f, om, submitType)
+	if err != nil {
+		f.AppendReasonf("could not place order: %v", err)
+		setCannotPurchaseDirection(f)
+		if releaseErr := r.release(pr); releaseErr != nil {
+			f.AppendReason(releaseErr.Error())
+		}
+		return f, true, err
+	}
+	setFillOrder(f, om, orderID, ev.GetTime())
+	if f.Order == nil {
+		return f, true, fmt.Errorf("%w %v", errPlacedOrderNotFound, orderID)
+	}
+	if isBuy {
+		spent := amount.Mul(price).Add(fee)
+		err = pr.Release(spent, decimal.Zero, o.GetDirection())
+		if err != nil {
+			return f, true, err
+		}
+		err = pr.IncreaseAvailable(amount, o.GetDirection())
+		if err != nil {
+			return f, true, err
+		}
+		r.funds = r.funds.Sub(spent)
+	} else {
+		err = pr.Release(amount, decimal.Zero, o.GetDirection())
+		if err != nil {
+			return f, true, err
+		}
+		err = pr.IncreaseAvailable(amount.Mul(price).Sub(fee), o.GetDirection())
+		if err != nil {
+			return f, true, err
+		}
+		r.funds = r.funds.Sub(amount)
+	}
+	r.amount = r.amount.Sub(amount)
+	if cs.FeeSchedule != nil {
+		err = cs.trackFeeVolume(f, ev.GetTime(), f.Amount, f.PurchasePrice)
+		if err != nil {
+			return f, false, err
+		}
+	}
+	f.AppendReasonf("Resting %v %v order filled by trade at %v", o.GetOrderType(), o.GetDirection(), ev.GetClosePrice())
+	f.AppendReason(summarisePosition(f.GetDirection(), f.Amount, f.Amount.Mul(f.PurchasePrice), f.ExchangeFee, f.Order.Pair, f.UnderlyingPair))
+	if r.amount.IsPositive() {
+		return f, false, nil
+	}
+	return f, true, r.release(pr)
+}
+
+// match returns the price a resting order fills at against a trade, or false
+// when the trade does not cross the order's price. A stop order becomes a
+// market order once triggered
+func (r *restingOrder) match(tradePrice decimal.Decimal) (decimal.Decimal, bool) {
+	isBuy := r.order.GetDirection() == gctorder.Buy || r.order.GetDirection() == gctorder.Bid
+	switch r.order.GetOrderType() {
+	case gctorder.Limit:
+		limit := r.order.GetOrderPrice()
+		if (isBuy && tradePrice.LessThanOrEqual(limit)) || (!isBuy && tradePrice.GreaterThanOrEqual(limit)) {
+			return limit, true
+		}
+		return decimal.Zero, false
+	case gctorder.Stop:
+		if !r.triggered {
+			trigger := r.order.GetOrderPrice()
+			r.triggered = (isBuy && tradePrice.GreaterThanOrEqual(trigger)) || (!isBuy && tradePrice.LessThanOrEqual(trigger))
+		}
+		if !r.triggered {
+			return decimal.Zero, false
+		}
+	}
+	return tradePrice, true
+}
+
+// expired returns whether the order's expiry has passed at the time provided
+func (r *restingOrder) expired(t time.Time) bool {
+	expiry := r.order.GetOrderExpiry()
+	return !expiry.IsZero() && t.After(expiry)
+}
+
+// cancel stops the order from resting and releases its reserved funds
+func (r *restingOrder) cancel(funds funding.IFundReleaser) error {
+	pr, err := funds.PairReleaser()
+	if err != nil {
+		return err
+	}
+	return r.release(pr)
+}
+
+// release returns any funds still reserved for the order
+func (r *restingOrder) release(pr funding.IPairReleaser) error {
+	if !r.funds.IsPositive() {
+		return nil
+	}
+	err := pr.Release(r.funds, r.funds, r.order.GetDirection())
+	if err != nil {
+		return err
+	}
+	r.funds = decimal.Zero
+	return nil
+}
+
+func (e *Exchange) hasFilledAtOffset(ev common.Event) bool {
+	offset, ok := e.filledOffsets[eventKey(ev)]
+	return ok && offset == ev.GetOffset()
+}
+
+func (e *Exchange) setFilledOffset(ev common.Event) {
+	if e.filledOffsets == nil {
+		e.filledOffsets = make(map[key.ExchangePairAsset]int64)
+	}
+	e.filledOffsets[eventKey(ev)] = ev.GetOffset()
+}
+
+func eventKey(ev common.Event) key.ExchangePairAsset {
+	return key.ExchangePairAsset{
+		Exchange: ev.GetExchange(),
+		Base:     ev.Pair().Base.Item,
+		Quote:    ev.Pair().Quote.Item,
+		Asset:    ev.GetAssetType(),
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	evkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
@@ -26,8 +27,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binanceus"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/btcmarkets"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/feeschedule"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 const testExchange = "binance"
@@ -182,6 +185,117 @@ func TestCalculateExchangeFee(t *testing.T) {
 	}
 }
 
+func TestScheduledFee(t *testing.T) {
+	t.Parallel()
+	cs := &Settings{
+		Pair: currency.NewPair(currency.BTC, currency.USDT),
+		FeeSchedule: &feeschedule.Schedule{
+			VolumeCurrency: currency.USDT,
+			Tiers: []feeschedule.Tier{
+				{Name: "VIP0", Maker: 0.001, Taker: 0.002},
+				{Name: "VIP1", MinimumVolume: 1000, Maker: 0.0005, Taker: 0.001},
+			},
+			TokenDiscount: &feeschedule.TokenDiscount{Currency: currency.BNB, Rate: 0.5},
+		},
+		FeeTracker: feeschedule.NewTracker(time.Hour),
+	}
+	tt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fee, err := cs.scheduledFee(tt, decimal.NewFromInt(100), decimal.NewFromInt(2), false)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !fee.Equal(decimal.NewFromFloat(0.4)) {
+		t.Errorf("received '%v' expected '%v'", fee, 0.4)
+	}
+	fee, err = cs.scheduledFee(tt, decimal.NewFromInt(100), decimal.NewFromInt(2), true)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !fee.Equal(decimal.NewFromFloat(0.2)) {
+		t.Errorf("received '%v' expected '%v'", fee, 0.2)
+	}
+
+	f := &fill.Fill{Base: &event.Base{}}
+	err = cs.trackFeeVolume(f, tt, decimal.NewFromInt(10), decimal.NewFromInt(100))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !strings.Contains(f.GetConcatReasons(), "Fee tier changed from VIP0 to VIP1") {
+		t.Errorf("received '%v' expected a fee tier change reason", f.GetConcatReasons())
+	}
+	cs.UseFeeToken = true
+	fee, err = cs.scheduledFee(tt, decimal.NewFromInt(100), decimal.NewFromInt(2), false)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !fee.Equal(decimal.NewFromFloat(0.1)) {
+		t.Errorf("received '%v' expected '%v'", fee, 0.1)
+	}
+
+	fee, err = cs.scheduledFee(tt.Add(time.Hour), decimal.NewFromInt(100), decimal.NewFromInt(2), false)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !fee.Equal(decimal.NewFromFloat(0.2)) {
+		t.Errorf("received '%v' expected '%v' once volume leaves the window", fee, 0.2)
+	}
+
+	f = &fill.Fill{Base: &event.Base{}}
+	err = cs.trackFeeVolume(f, tt, decimal.Zero, decimal.NewFromInt(100))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(f.GetReasons()) != 0 {
+		t.Errorf("received '%v' expected no reasons", f.GetReasons())
+	}
+
+	cs.FeeSchedule = nil
+	_, err = cs.scheduledFee(tt, decimal.NewFromInt(100), decimal.NewFromInt(2), false)
+	if !errors.Is(err, feeschedule.ErrNilSchedule) {
+		t.Errorf("received '%v' expected '%v'", err, feeschedule.ErrNilSchedule)
+	}
+}
+
+func TestFeeVolume(t *testing.T) {
+	t.Parallel()
+	cs := &Settings{
+		Pair:        currency.NewPair(currency.BTC, currency.USDT),
+		FeeSchedule: &feeschedule.Schedule{VolumeCurrency: currency.USDT},
+	}
+	amount, price := decimal.NewFromInt(2), decimal.NewFromInt(100)
+	volume, err := cs.feeVolume(amount, price)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !volume.Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received '%v' expected '%v'", volume, 200)
+	}
+
+	cs.Pair = currency.NewPair(currency.BTC, currency.USD)
+	volume, err = cs.feeVolume(amount, price)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !volume.Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received '%v' expected '%v'", volume, 200)
+	}
+
+	cs.Pair = currency.NewPair(currency.USDT, currency.BTC)
+	volume, err = cs.feeVolume(amount, price)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !volume.Equal(amount) {
+		t.Errorf("received '%v' expected '%v'", volume, amount)
+	}
+
+	cs.Pair = currency.NewPair(currency.ETH, currency.BTC)
+	_, err = cs.feeVolume(amount, price)
+	if !errors.Is(err, errFeeVolumeConversion) {
+		t.Errorf("received '%v' expected '%v'", err, errFeeVolumeConversion)
+	}
+}
+
 func TestPlaceOrder(t *testing.T) {
 	t.Parallel()
 	bot := &engine.Engine{}
@@ -208,32 +322,32 @@ func TestPlaceOrder(t *testing.T) {
 		t.Errorf("received: %v, expected: %v", err, nil)
 	}
 	e := Exchange{}
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, nil, nil)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, nil, nil, gctorder.Market)
 	if !errors.Is(err, common.ErrNilEvent) {
 		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
 	}
 	f := &fill.Fill{
 		Base: &event.Base{},
 	}
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager, gctorder.Market)
 	if !errors.Is(err, engine.ErrExchangeNameIsEmpty) {
 		t.Errorf("received: %v, expected: %v", err, engine.ErrExchangeNameIsEmpty)
 	}
 
 	f.Exchange = testExchange
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager, gctorder.Market)
 	if !errors.Is(err, gctorder.ErrPairIsEmpty) {
 		t.Errorf("received: %v, expected: %v", err, gctorder.ErrPairIsEmpty)
 	}
 	f.CurrencyPair = currency.NewPair(currency.BTC, currency.USDT)
 	f.AssetType = asset.Spot
 	f.Direction = gctorder.Buy
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, false, true, f, bot.OrderManager, gctorder.Market)
 	if !errors.Is(err, nil) {
 		t.Errorf("received: %v, expected: %v", err, nil)
 	}
 
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, true, true, f, bot.OrderManager)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.Zero, true, true, f, bot.OrderManager, gctorder.Market)
 	if !errors.Is(err, exchange.ErrCredentialsAreEmpty) {
 		t.Errorf("received: %v but expected: %v", err, exchange.ErrCredentialsAreEmpty)
 	}
@@ -550,6 +664,107 @@ func TestExecuteOrderBuySellSizeLimit(t *testing.T) {
 	}
 }
 
+type fakeReplay struct {
+	book *orderbook.Base
+	err  error
+}
+
+func (f *fakeReplay) OrderbookAt(time.Time) (*orderbook.Base, error) {
+	return f.book, f.err
+}
+
+func TestFillFromOrderbook(t *testing.T) {
+	t.Parallel()
+	replay := &fakeReplay{err: errExceededPortfolioLimit}
+	f := &fill.Fill{Base: &event.Base{}, Direction: gctorder.Buy, ClosePrice: decimal.NewFromInt(100)}
+	_, _, err := fillFromOrderbook(f, replay, time.Now(), decimal.NewFromInt(100), decimal.NewFromInt(2), decimal.Zero)
+	if !errors.Is(err, errExceededPortfolioLimit) {
+		t.Errorf("received '%v' expected '%v'", err, errExceededPortfolioLimit)
+	}
+
+	replay.err = nil
+	replay.book = &orderbook.Base{
+		Pair: currency.NewPair(currency.BTC, currency.USDT),
+		Asks: orderbook.Items{{Price: 100, Amount: 1}, {Price: 200, Amount: 1}},
+		Bids: orderbook.Items{{Price: 100, Amount: 1}, {Price: 50, Amount: 1}},
+	}
+	f.Direction = gctorder.ClosePosition
+	_, _, err = fillFromOrderbook(f, replay, time.Now(), decimal.NewFromInt(100), decimal.NewFromInt(2), decimal.Zero)
+	if !errors.Is(err, gctorder.ErrSideIsInvalid) {
+		t.Errorf("received '%v' expected '%v'", err, gctorder.ErrSideIsInvalid)
+	}
+
+	// 200 quote fills the first ask and half of the second
+	f.Direction = gctorder.Buy
+	price, amount, err := fillFromOrderbook(f, replay, time.Now(), decimal.NewFromInt(100), decimal.NewFromInt(2), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("received: %v, expected: %v", amount, 1.5)
+	}
+	if !price.Mul(amount).Round(8).Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received: %v, expected: %v", price.Mul(amount), 200)
+	}
+	if !f.Slippage.IsNegative() {
+		t.Errorf("received: %v, expected negative slippage", f.Slippage)
+	}
+
+	f = &fill.Fill{Base: &event.Base{}, Direction: gctorder.Sell, ClosePrice: decimal.NewFromInt(100)}
+	price, amount, err = fillFromOrderbook(f, replay, time.Now(), decimal.NewFromInt(100), decimal.NewFromInt(2), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received: %v, expected: %v", amount, 2)
+	}
+	if !price.Equal(decimal.NewFromInt(75)) {
+		t.Errorf("received: %v, expected: %v", price, 75)
+	}
+	if !f.Slippage.Equal(decimal.NewFromInt(-25)) {
+		t.Errorf("received: %v, expected: %v", f.Slippage, -25)
+	}
+
+	// the walk stops at the order price, leaving the rest of the order unfilled
+	f = &fill.Fill{Base: &event.Base{}, Direction: gctorder.Sell, ClosePrice: decimal.NewFromInt(100)}
+	price, amount, err = fillFromOrderbook(f, replay, time.Now(), decimal.NewFromInt(100), decimal.NewFromInt(2), decimal.NewFromInt(90))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v, expected: %v", amount, 1)
+	}
+	if !price.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received: %v, expected: %v", price, 100)
+	}
+
+	f = &fill.Fill{Base: &event.Base{}, Direction: gctorder.Buy, ClosePrice: decimal.NewFromInt(100)}
+	price, amount, err = fillFromOrderbook(f, replay, time.Now(), decimal.NewFromInt(100), decimal.NewFromInt(2), decimal.NewFromInt(150))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v, expected: %v", amount, 1)
+	}
+	if !price.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received: %v, expected: %v", price, 100)
+	}
+
+	_, _, err = fillFromOrderbook(f, replay, time.Now(), decimal.NewFromInt(100), decimal.NewFromInt(2), decimal.NewFromInt(50))
+	if !errors.Is(err, errNoLiquidityWithinPrice) {
+		t.Errorf("received '%v' expected '%v'", err, errNoLiquidityWithinPrice)
+	}
+	if len(replay.book.Asks) != 2 {
+		t.Errorf("received '%v' expected the recorded orderbook to be unchanged", len(replay.book.Asks))
+	}
+
+	replay.book = nil
+	_, _, err = fillFromOrderbook(f, replay, time.Now(), decimal.NewFromInt(100), decimal.NewFromInt(2), decimal.Zero)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+}
+
 func TestApplySlippageToPrice(t *testing.T) {
 	t.Parallel()
 	resp, err := applySlippageToPrice(gctorder.Buy, decimal.NewFromInt(1), decimal.NewFromFloat(0.9))
@@ -773,3 +988,334 @@ func TestAllocateFundsPostOrder(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", err, expectedError)
 	}
 }
+
+func TestMatchRestingOrders(t *testing.T) {
+	t.Parallel()
+	bot := &engine.Engine{}
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	err = em.Add(exch)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, &bot.ServicesWG, &gctconfig.OrderManager{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = bot.OrderManager.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	base, err := funding.CreateItem(testExchange, asset.Spot, cp.Base, decimal.Zero, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	quote, err := funding.CreateItem(testExchange, asset.Spot, cp.Quote, decimal.NewFromInt(1000), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	pair, err := funding.CreatePair(base, quote)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	e := &Exchange{
+		CurrencySettings: []Settings{
+			{
+				Exchange: exch,
+				Pair:     cp,
+				Asset:    asset.Spot,
+				MakerFee: decimal.NewFromFloat(0.001),
+				TakerFee: decimal.NewFromFloat(0.002),
+			},
+		},
+	}
+	tt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	newBase := func(offset int64) *event.Base {
+		return &event.Base{
+			Offset:       offset,
+			Exchange:     testExchange,
+			Time:         tt.Add(time.Duration(offset) * time.Second),
+			Interval:     gctkline.OneHour,
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+		}
+	}
+	newTrade := func(offset int64, price, volume float64) *evkline.Kline {
+		return &evkline.Kline{
+			Base:   newBase(offset),
+			Open:   decimal.NewFromFloat(price),
+			High:   decimal.NewFromFloat(price),
+			Low:    decimal.NewFromFloat(price),
+			Close:  decimal.NewFromFloat(price),
+			Volume: decimal.NewFromFloat(volume),
+		}
+	}
+	newOrder := func(offset int64, side gctorder.Side, orderType gctorder.Type, amount, price, allocated float64) *order.Order {
+		return &order.Order{
+			Base:           newBase(offset),
+			Direction:      side,
+			ClosePrice:     decimal.NewFromInt(100),
+			Amount:         decimal.NewFromFloat(amount),
+			OrderType:      orderType,
+			OrderPrice:     decimal.NewFromFloat(price),
+			AllocatedFunds: decimal.NewFromFloat(allocated),
+		}
+	}
+
+	_, err = e.MatchRestingOrders(nil, bot.OrderManager, pair)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	_, err = e.MatchRestingOrders(newTrade(1, 100, 1), bot.OrderManager, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	// limit orders are rejected and their funds released outside of tick mode
+	err = pair.Reserve(decimal.NewFromFloat(200.2), gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = e.ExecuteOrder(newOrder(1, gctorder.Buy, gctorder.Limit, 2, 100, 200.2), nil, bot.OrderManager, pair)
+	if !errors.Is(err, errTickModeRequired) {
+		t.Errorf("received '%v' expected '%v'", err, errTickModeRequired)
+	}
+	if !pair.QuoteAvailable().Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected '%v'", pair.QuoteAvailable(), 1000)
+	}
+	if len(e.restingOrders) != 0 {
+		t.Errorf("received '%v' expected no resting orders outside of tick mode", len(e.restingOrders))
+	}
+
+	e.CurrencySettings[0].TickMode = true
+	_, err = e.ExecuteOrder(newOrder(1, gctorder.Buy, gctorder.Limit, 2, 0, 200.2), nil, bot.OrderManager, pair)
+	if !errors.Is(err, errInvalidOrderPrice) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidOrderPrice)
+	}
+
+	err = pair.Reserve(decimal.NewFromFloat(200.2), gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = e.ExecuteOrder(newOrder(1, gctorder.Buy, gctorder.Limit, 2, 100, 200.2), nil, bot.OrderManager, pair)
+	if !errors.Is(err, ErrOrderResting) {
+		t.Fatalf("received '%v' expected '%v'", err, ErrOrderResting)
+	}
+
+	fills, err := e.MatchRestingOrders(newTrade(2, 101, 5), bot.OrderManager, pair)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 0 {
+		t.Errorf("received '%v' expected no fill when the trade does not cross", len(fills))
+	}
+	var f fill.Event
+
+	// the fill is limited by the traded volume
+	fills, err = e.MatchRestingOrders(newTrade(3, 99.5, 0.5), bot.OrderManager, pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	f = fills[0]
+	if !f.GetAmount().Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("received '%v' expected '%v'", f.GetAmount(), 0.5)
+	}
+	if !f.GetPurchasePrice().Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", f.GetPurchasePrice(), 100)
+	}
+	if !f.GetExchangeFee().Equal(decimal.NewFromFloat(0.05)) {
+		t.Errorf("received '%v' expected '%v'", f.GetExchangeFee(), 0.05)
+	}
+
+	fills, err = e.MatchRestingOrders(newTrade(4, 99, 10), bot.OrderManager, pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	f = fills[0]
+	if !f.GetAmount().Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("received '%v' expected '%v'", f.GetAmount(), 1.5)
+	}
+	if !pair.BaseAvailable().Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", pair.BaseAvailable(), 2)
+	}
+	if !pair.QuoteAvailable().Equal(decimal.NewFromFloat(799.8)) {
+		t.Errorf("received '%v' expected '%v'", pair.QuoteAvailable(), 799.8)
+	}
+
+	// a market order raised on a trade which filled an order waits for the next trade
+	err = pair.Reserve(decimal.NewFromInt(100), gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = e.ExecuteOrder(newOrder(4, gctorder.Buy, gctorder.Market, 1, 0, 100), nil, bot.OrderManager, pair)
+	if !errors.Is(err, ErrOrderResting) {
+		t.Fatalf("received '%v' expected '%v'", err, ErrOrderResting)
+	}
+	fills, err = e.MatchRestingOrders(newTrade(5, 90, 10), bot.OrderManager, pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	f = fills[0]
+	if !f.GetPurchasePrice().Equal(decimal.NewFromInt(90)) {
+		t.Errorf("received '%v' expected '%v'", f.GetPurchasePrice(), 90)
+	}
+	if !pair.QuoteAvailable().Equal(decimal.NewFromFloat(709.62)) {
+		t.Errorf("received '%v' expected '%v' once unspent funds are released", pair.QuoteAvailable(), 709.62)
+	}
+
+	// stop orders fill at the trade price once triggered
+	err = pair.Reserve(decimal.NewFromInt(1), gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = e.ExecuteOrder(newOrder(5, gctorder.Sell, gctorder.Stop, 1, 95, 1), nil, bot.OrderManager, pair)
+	if !errors.Is(err, ErrOrderResting) {
+		t.Fatalf("received '%v' expected '%v'", err, ErrOrderResting)
+	}
+	fills, err = e.MatchRestingOrders(newTrade(6, 96, 10), bot.OrderManager, pair)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 0 {
+		t.Errorf("received '%v' expected no fill before the stop triggers", len(fills))
+	}
+	fills, err = e.MatchRestingOrders(newTrade(7, 94, 10), bot.OrderManager, pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	f = fills[0]
+	if !f.GetPurchasePrice().Equal(decimal.NewFromInt(94)) {
+		t.Errorf("received '%v' expected '%v'", f.GetPurchasePrice(), 94)
+	}
+	if !pair.BaseAvailable().Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", pair.BaseAvailable(), 2)
+	}
+	if !pair.QuoteAvailable().Equal(decimal.NewFromFloat(803.432)) {
+		t.Errorf("received '%v' expected '%v'", pair.QuoteAvailable(), 803.432)
+	}
+	if len(e.restingOrders[eventKey(f)]) != 0 {
+		t.Errorf("received '%v' expected no resting orders", len(e.restingOrders[eventKey(f)]))
+	}
+
+	// a trade fills every crossed order until its volume is used up
+	for _, price := range []float64{100, 99} {
+		allocated := decimal.NewFromFloat(price).Mul(decimal.NewFromFloat(1.001))
+		err = pair.Reserve(allocated, gctorder.Buy)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		o := newOrder(8, gctorder.Buy, gctorder.Limit, 1, price, 0)
+		o.AllocatedFunds = allocated
+		_, err = e.ExecuteOrder(o, nil, bot.OrderManager, pair)
+		if !errors.Is(err, ErrOrderResting) {
+			t.Fatalf("received '%v' expected '%v'", err, ErrOrderResting)
+		}
+	}
+	fills, err = e.MatchRestingOrders(newTrade(9, 98, 1.5), bot.OrderManager, pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 2)
+	}
+	if !fills[0].GetAmount().Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetAmount(), 1)
+	}
+	if !fills[1].GetAmount().Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("received '%v' expected '%v' once the traded volume is used up", fills[1].GetAmount(), 0.5)
+	}
+	if !pair.BaseAvailable().Equal(decimal.NewFromFloat(3.5)) {
+		t.Errorf("received '%v' expected '%v'", pair.BaseAvailable(), 3.5)
+	}
+	if !pair.QuoteAvailable().Equal(decimal.NewFromFloat(604.233)) {
+		t.Errorf("received '%v' expected '%v'", pair.QuoteAvailable(), 604.233)
+	}
+	if len(e.restingOrders[eventKey(f)]) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(e.restingOrders[eventKey(f)]), 1)
+	}
+
+	// orders cannot rest with an expiry which has already passed
+	err = pair.Reserve(decimal.NewFromInt(1), gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	o := newOrder(9, gctorder.Sell, gctorder.Limit, 1, 200, 1)
+	o.OrderExpiry = o.GetTime()
+	_, err = e.ExecuteOrder(o, nil, bot.OrderManager, pair)
+	if !errors.Is(err, errOrderExpired) {
+		t.Errorf("received '%v' expected '%v'", err, errOrderExpired)
+	}
+	if !pair.BaseAvailable().Equal(decimal.NewFromFloat(3.5)) {
+		t.Errorf("received '%v' expected '%v'", pair.BaseAvailable(), 3.5)
+	}
+
+	// expired orders are cancelled and their funds released
+	err = pair.Reserve(decimal.NewFromInt(1), gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	o.OrderExpiry = o.GetTime().Add(time.Second)
+	_, err = e.ExecuteOrder(o, nil, bot.OrderManager, pair)
+	if !errors.Is(err, ErrOrderResting) {
+		t.Fatalf("received '%v' expected '%v'", err, ErrOrderResting)
+	}
+	fills, err = e.MatchRestingOrders(newTrade(11, 150, 10), bot.OrderManager, pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 0 {
+		t.Errorf("received '%v' expected no fills", len(fills))
+	}
+	if !pair.BaseAvailable().Equal(decimal.NewFromFloat(3.5)) {
+		t.Errorf("received '%v' expected '%v' once the expired order is cancelled", pair.BaseAvailable(), 3.5)
+	}
+	if len(e.restingOrders[eventKey(f)]) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(e.restingOrders[eventKey(f)]), 1)
+	}
+
+	// orders resting at the end of a run are cancelled
+	_, err = e.CancelAllRestingOrders(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	fm, err := funding.SetupFundingManager(&engine.ExchangeManager{}, false, true, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = fm.AddPair(pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	cancelled, err := e.CancelAllRestingOrders(fm)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(cancelled) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(cancelled), 1)
+	}
+	if !pair.QuoteAvailable().Equal(decimal.NewFromFloat(653.7825)) {
+		t.Errorf("received '%v' expected '%v' once reserved funds are released", pair.QuoteAvailable(), 653.7825)
+	}
+	if len(e.restingOrders) != 0 {
+		t.Errorf("received '%v' expected no resting orders", len(e.restingOrders))
+	}
+}
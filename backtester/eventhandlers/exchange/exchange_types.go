@@ -2,17 +2,21 @@ package exchange
 
 import (
 	"errors"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/feeschedule"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 var (
@@ -23,19 +27,55 @@ var (
 	errNilCurrencySettings     = errors.New("received nil currency settings")
 	errInvalidDirection        = errors.New("received invalid order direction")
 	errNoCurrencySettingsFound = errors.New("no currency settings found")
+	errTickModeRequired        = errors.New("limit and stop orders require tick mode")
+	errUnsupportedOrderType    = errors.New("unsupported order type")
+	errInvalidOrderPrice       = errors.New("order price must be greater than zero")
+	errRestingOrderUnsupported = errors.New("resting orders are only supported for spot")
+	errOrderExpired            = errors.New("order expiry is not after the order time")
+	errPlacedOrderNotFound     = errors.New("placed order not found in order manager")
+	errFeeVolumeConversion     = errors.New("cannot convert traded volume into fee schedule volume currency")
+	errNoLiquidityWithinPrice  = errors.New("no orderbook liquidity within order price")
 )
 
+// ErrOrderResting returns when an order is held by the exchange to be filled
+// by a later trade rather than raising a fill event
+var ErrOrderResting = errors.New("order resting")
+
 // ExecutionHandler interface dictates what functions are required to submit an order
 type ExecutionHandler interface {
 	SetExchangeAssetCurrencySettings(asset.Item, currency.Pair, *Settings)
 	GetCurrencySettings(string, asset.Item, currency.Pair) (Settings, error)
 	ExecuteOrder(order.Event, data.Handler, *engine.OrderManager, funding.IFundReleaser) (fill.Event, error)
+	MatchRestingOrders(data.Event, *engine.OrderManager, funding.IFundReleaser) ([]fill.Event, error)
+	CancelAllRestingOrders(funding.IFundingManager) ([]order.Event, error)
 	Reset() error
 }
 
+// OrderbookReplayer returns recorded orderbook depth as it was at the close
+// of the candle starting at the supplied time
+type OrderbookReplayer interface {
+	OrderbookAt(time.Time) (*orderbook.Base, error)
+}
+
 // Exchange contains all the currency settings
 type Exchange struct {
 	CurrencySettings []Settings
+	// restingOrders are limit, stop and deferred market orders waiting for a
+	// trade to fill them in tick mode
+	restingOrders map[key.ExchangePairAsset][]*restingOrder
+	// filledOffsets holds the offset of the latest fill so that a market
+	// order raised on a trade which filled resting orders waits for the next
+	filledOffsets map[key.ExchangePairAsset]int64
+}
+
+// restingOrder tracks the unfilled amount of an order and the funds the
+// portfolio manager reserved for it. Funds are in the quote currency for
+// buys and the base currency for sells
+type restingOrder struct {
+	order     order.Event
+	amount    decimal.Decimal
+	funds     decimal.Decimal
+	triggered bool
 }
 
 // Settings allow the eventhandler to size an order within the limitations set by the config file
@@ -62,6 +102,22 @@ type Settings struct {
 	SkipCandleVolumeFitting bool
 
 	UseExchangePNLCalculation bool
+
+	// FeeSchedule replaces the taker fee with the tier predicted from the
+	// volume recorded in FeeTracker, allowing the fee tier to change over a
+	// run. FeeTracker is shared by every pair on the exchange and asset and
+	// measures volume in the schedule's VolumeCurrency
+	FeeSchedule *feeschedule.Schedule
+	FeeTracker  *feeschedule.Tracker
+	UseFeeToken bool
+
+	// Orderbook fills simulated orders by walking recorded orderbook depth
+	// instead of estimating slippage and fitting orders to the candle
+	Orderbook OrderbookReplayer
+
+	// TickMode allows limit and stop orders to rest with the exchange until
+	// a trade crosses their price
+	TickMode bool
 }
 
 // MinMax are the rules which limit the placement of orders.
@@ -1,9 +1,11 @@
 package slippage
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
@@ -30,15 +32,27 @@ func EstimateSlippagePercentage(maximumSlippageRate, minimumSlippageRate decimal
 }
 
 // CalculateSlippageByOrderbook will analyse a provided orderbook and return the result of attempting to
-// place the order on there
+// place the order on there. Buy orders spend the allocated funds in quote, sell orders sell the allocated
+// funds in base. The price returned is the volume weighted average of the tranches consumed and the
+// amount is in base for both sides
 func CalculateSlippageByOrderbook(ob *orderbook.Base, side gctorder.Side, allocatedFunds, feeRate decimal.Decimal) (price, amount decimal.Decimal, err error) {
+	if ob == nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w orderbook", gctcommon.ErrNilPointer)
+	}
 	var result *orderbook.WhaleBombResult
-	result, err = ob.SimulateOrder(allocatedFunds.InexactFloat64(), side == gctorder.Buy)
+	result, err = ob.SimulateOrder(allocatedFunds.InexactFloat64(), side == gctorder.Buy || side == gctorder.Bid)
 	if err != nil {
 		return
 	}
-	rate := (result.MinimumPrice - result.MaximumPrice) / result.MaximumPrice
-	price = decimal.NewFromFloat(result.MinimumPrice * (rate + 1))
-	amount = decimal.NewFromFloat(result.Amount * (1 - feeRate.InexactFloat64()))
+	var base, quote float64
+	for i := range result.Orders {
+		base += result.Orders[i].Amount
+		quote += result.Orders[i].Price * result.Orders[i].Amount
+	}
+	if base == 0 {
+		return decimal.Zero, decimal.Zero, errNoOrderbookFill
+	}
+	price = decimal.NewFromFloat(quote / base)
+	amount = decimal.NewFromFloat(base * (1 - feeRate.InexactFloat64()))
 	return
 }
@@ -2,13 +2,16 @@ package slippage
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 func TestRandomSlippage(t *testing.T) {
@@ -42,3 +45,62 @@ func TestCalculateSlippageByOrderbook(t *testing.T) {
 		t.Error("order size must be less than funds")
 	}
 }
+
+func TestCalculateSlippageByOrderbookOffline(t *testing.T) {
+	t.Parallel()
+	_, _, err := CalculateSlippageByOrderbook(nil, gctorder.Buy, decimal.NewFromInt(1), decimal.Zero)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	ob := &orderbook.Base{
+		Pair: currency.NewPair(currency.BTC, currency.USD),
+		Asks: orderbook.Items{{Price: 100, Amount: 1}, {Price: 200, Amount: 1}},
+		Bids: orderbook.Items{{Price: 90, Amount: 1}, {Price: 80, Amount: 1}},
+	}
+	// spends 100 on the first ask and 100 on half of the second
+	price, amount, err := CalculateSlippageByOrderbook(ob, gctorder.Buy, decimal.NewFromInt(200), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("received '%v' expected '%v'", amount, 1.5)
+	}
+	if !price.Mul(amount).Round(8).Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received '%v' expected '%v'", price.Mul(amount), 200)
+	}
+
+	price, amount, err = CalculateSlippageByOrderbook(ob, gctorder.Sell, decimal.NewFromFloat(1.5), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("received '%v' expected '%v'", amount, 1.5)
+	}
+	if !price.Round(8).Equal(decimal.NewFromFloat(260).Div(decimal.NewFromFloat(3)).Round(8)) {
+		t.Errorf("received '%v' expected '%v'", price, "86.66666667")
+	}
+
+	// buys are sized by the quote funds allocated, with the fee taken from the
+	// base amount received
+	amountOfFunds := decimal.NewFromInt(150)
+	feeRate := decimal.NewFromFloat(0.03)
+	price, amount, err = CalculateSlippageByOrderbook(ob, gctorder.Buy, amountOfFunds, feeRate)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if price.Mul(amount).Add(price.Mul(amount).Mul(feeRate)).GreaterThan(amountOfFunds) {
+		t.Error("order size must be less than funds")
+	}
+	if !amount.Round(8).Equal(decimal.NewFromFloat(1.25 * 0.97)) {
+		t.Errorf("received '%v' expected '%v'", amount, 1.25*0.97)
+	}
+
+	// sells are sized by the base amount allocated
+	_, amount, err = CalculateSlippageByOrderbook(ob, gctorder.Sell, decimal.NewFromInt(1), feeRate)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Round(8).Equal(decimal.NewFromFloat(0.97)) {
+		t.Errorf("received '%v' expected '%v'", amount, 0.97)
+	}
+}
@@ -1,6 +1,10 @@
 package slippage
 
-import "github.com/shopspring/decimal"
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
 
 // Default slippage rates. It works on a percentage basis
 // 100 means unaffected, 95 would mean 95%
@@ -8,3 +12,5 @@ var (
 	DefaultMaximumSlippagePercent = decimal.NewFromInt(100)
 	DefaultMinimumSlippagePercent = decimal.NewFromInt(100)
 )
+
+var errNoOrderbookFill = errors.New("orderbook simulation did not fill any amount")
@@ -79,7 +79,12 @@ func (p *Portfolio) OnSignal(ev signal.Event, exchangeSettings *exchange.Setting
 		return cannotPurchase(ev, o)
 	}
 
-	o.OrderType = gctorder.Market
+	o.OrderType = ev.GetOrderType()
+	if o.OrderType == gctorder.UnknownType {
+		o.OrderType = gctorder.Market
+	}
+	o.OrderPrice = ev.GetOrderPrice()
+	o.OrderExpiry = ev.GetOrderExpiry()
 	o.BuyLimit = ev.GetBuyLimit()
 	o.SellLimit = ev.GetSellLimit()
 	var sizingFunds decimal.Decimal
@@ -251,6 +256,10 @@ func (p *Portfolio) OnFill(ev fill.Event, funds funding.IFundReleaser) (fill.Eve
 	}
 
 	h, err := lookup.GetHoldingsForTime(ev.GetTime().Add(-ev.GetInterval().Duration()))
+	if errors.Is(err, errNoHoldings) {
+		// in tick mode events are not an interval apart
+		h, err = lookup.GetLatestHoldings()
+	}
 	if err != nil {
 		if !errors.Is(err, errNoHoldings) {
 			return nil, err
@@ -637,6 +646,9 @@ func (p *Portfolio) SetHoldingsForTimestamp(h *holdings.Holding) error {
 		return err
 	}
 	lookup.HoldingsSnapshots[h.Timestamp.UnixNano()] = h
+	if h.Timestamp.UnixNano() > lookup.latestHoldingsTime {
+		lookup.latestHoldingsTime = h.Timestamp.UnixNano()
+	}
 	return nil
 }
 
@@ -704,6 +716,9 @@ func (s *Settings) GetLatestHoldings() (*holdings.Holding, error) {
 	if len(s.HoldingsSnapshots) == 0 {
 		return nil, errNoHoldings
 	}
+	if h, ok := s.HoldingsSnapshots[s.latestHoldingsTime]; ok {
+		return h, nil
+	}
 	var latestTime int64
 	for k := range s.HoldingsSnapshots {
 		if k > latestTime {
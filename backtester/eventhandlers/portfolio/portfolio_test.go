@@ -569,6 +569,22 @@ func TestOnSignal(t *testing.T) {
 	if resp.Amount.IsZero() {
 		t.Error("expected an amount to be sized")
 	}
+	if resp.OrderType != gctorder.Market {
+		t.Errorf("received: %v, expected: %v", resp.OrderType, gctorder.Market)
+	}
+
+	s.Direction = gctorder.Buy
+	s.OrderType = gctorder.Limit
+	s.OrderPrice = decimal.NewFromInt(9)
+	resp, err = p.OnSignal(s, &exchange.Settings{}, funds)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if resp.OrderType != gctorder.Limit || !resp.OrderPrice.Equal(s.OrderPrice) {
+		t.Errorf("received: %v %v, expected: %v %v", resp.OrderType, resp.OrderPrice, gctorder.Limit, s.OrderPrice)
+	}
+	s.OrderType = gctorder.UnknownType
+	s.OrderPrice = decimal.Zero
 
 	bc, err = funding.CreateItem(testExchange, asset.Futures, currency.BTC, leet, decimal.Zero)
 	if err != nil {
@@ -80,6 +80,9 @@ type Settings struct {
 	exchangeName string
 	assetType    asset.Item
 	pair         currency.Pair
+	// latestHoldingsTime avoids scanning every snapshot when there is a
+	// snapshot per trade
+	latestHoldingsTime int64
 
 	BuySideSizing     exchange.MinMax
 	SellSideSizing    exchange.MinMax
@@ -0,0 +1,204 @@
+package statistics
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
+)
+
+// CalculateMonteCarlo resamples the per-candle returns of a run's holding
+// values to build distributions of final PNL, max drawdown and sharpe ratio.
+// Simulated values are calculated with floats as thousands of paths are
+// generated and only their percentiles are reported
+func CalculateMonteCarlo(settings *MonteCarloSettings, holdingValues []ValueAtTime, riskFreeRatePerCandle decimal.Decimal) (*MonteCarloResults, error) {
+	if settings == nil {
+		return nil, fmt.Errorf("%w monte carlo settings", gctcommon.ErrNilPointer)
+	}
+	if settings.Method != MonteCarloBootstrap && settings.Method != MonteCarloShuffle {
+		return nil, fmt.Errorf("%w '%v'", errInvalidMonteCarloMethod, settings.Method)
+	}
+	if settings.Simulations <= 0 {
+		return nil, errInvalidSimulations
+	}
+	if settings.Simulations > MaximumMonteCarloSimulations {
+		return nil, fmt.Errorf("%w, received %v maximum %v", errTooManySimulations, settings.Simulations, MaximumMonteCarloSimulations)
+	}
+	if len(holdingValues) < 3 {
+		return nil, fmt.Errorf("%w, received %v holding values", errNotEnoughReturns, len(holdingValues))
+	}
+	startingValue := holdingValues[0].Value.InexactFloat64()
+	if startingValue <= 0 {
+		return nil, fmt.Errorf("%w, received %v", errInvalidStartingValue, startingValue)
+	}
+
+	returns := make([]float64, len(holdingValues)-1)
+	for i := 1; i < len(holdingValues); i++ {
+		if holdingValues[i-1].Value.IsZero() {
+			continue
+		}
+		returns[i-1] = holdingValues[i].Value.Sub(holdingValues[i-1].Value).Div(holdingValues[i-1].Value).InexactFloat64()
+	}
+	riskFreeRate := riskFreeRatePerCandle.InexactFloat64()
+	actualPNL, actualDrawdown, actualSharpe, err := simulationStatistics(equityCurve(startingValue, returns), returns, riskFreeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := settings.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	r := rand.New(rand.NewSource(seed)) //nolint:gosec // repeatable resampling, no need for crypto/rand
+
+	pnls := make([]float64, settings.Simulations)
+	drawdowns := make([]float64, settings.Simulations)
+	sharpes := make([]float64, settings.Simulations)
+	// simulations are independent, so the first paths are a fair sample for
+	// the equity bands without holding every path in memory
+	paths := make([][]float64, min(settings.Simulations, monteCarloBandPaths))
+	sample := make([]float64, len(returns))
+	var losses int64
+	for i := range pnls {
+		if settings.Method == MonteCarloBootstrap {
+			for j := range sample {
+				sample[j] = returns[r.Intn(len(returns))]
+			}
+		} else {
+			copy(sample, returns)
+			r.Shuffle(len(sample), func(a, b int) {
+				sample[a], sample[b] = sample[b], sample[a]
+			})
+		}
+		curve := equityCurve(startingValue, sample)
+		if i < len(paths) {
+			paths[i] = curve
+		}
+		pnls[i], drawdowns[i], sharpes[i], err = simulationStatistics(curve, sample, riskFreeRate)
+		if err != nil {
+			return nil, err
+		}
+		if pnls[i] < 0 {
+			losses++
+		}
+	}
+
+	resp := &MonteCarloResults{
+		Method:            settings.Method,
+		Simulations:       settings.Simulations,
+		Seed:              seed,
+		FinalPNL:          newMonteCarloDistribution(actualPNL, pnls),
+		MaxDrawdown:       newMonteCarloDistribution(actualDrawdown, drawdowns),
+		SharpeRatio:       newMonteCarloDistribution(actualSharpe, sharpes),
+		ProbabilityOfLoss: decimal.NewFromInt(losses).Div(decimal.NewFromInt(settings.Simulations)).Mul(decimal.NewFromInt(100)),
+		EquityBands:       make([]MonteCarloEquityBand, len(monteCarloPercentiles)),
+	}
+	for i := range monteCarloPercentiles {
+		resp.EquityBands[i] = MonteCarloEquityBand{
+			Percentile: decimal.NewFromFloat(monteCarloPercentiles[i]),
+			Values:     make([]ValueAtTime, len(holdingValues)),
+		}
+	}
+	candleValues := make([]float64, len(paths))
+	for i := range holdingValues {
+		for j := range paths {
+			candleValues[j] = paths[j][i]
+		}
+		sort.Float64s(candleValues)
+		for j := range monteCarloPercentiles {
+			resp.EquityBands[j].Values[i] = ValueAtTime{
+				Time:  holdingValues[i].Time,
+				Value: floatToDecimal(percentile(candleValues, monteCarloPercentiles[j])),
+			}
+		}
+	}
+	return resp, nil
+}
+
+// equityCurve compounds returns onto a starting value. The starting value is
+// included so the curve is one longer than the returns
+func equityCurve(startingValue float64, returns []float64) []float64 {
+	curve := make([]float64, len(returns)+1)
+	curve[0] = startingValue
+	for i := range returns {
+		curve[i+1] = curve[i] * (1 + returns[i])
+	}
+	return curve
+}
+
+// simulationStatistics returns the PNL, max drawdown percentage and arithmetic
+// sharpe ratio of an equity curve. Drawdowns are negative, matching Swing
+func simulationStatistics(curve, returns []float64, riskFreeRatePerCandle float64) (pnl, maxDrawdown, sharpe float64, err error) {
+	highest := curve[0]
+	for i := range curve {
+		if curve[i] > highest {
+			highest = curve[i]
+			continue
+		}
+		if highest <= 0 {
+			continue
+		}
+		if drawdown := (curve[i] - highest) / highest * 100; drawdown < maxDrawdown {
+			maxDrawdown = drawdown
+		}
+	}
+	average, err := gctmath.ArithmeticMean(returns)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	sharpe, err = gctmath.SharpeRatio(returns, riskFreeRatePerCandle, average)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return curve[len(curve)-1] - curve[0], maxDrawdown, sharpe, nil
+}
+
+// newMonteCarloDistribution summarises simulated values. The values are
+// sorted in place
+func newMonteCarloDistribution(actual float64, values []float64) MonteCarloDistribution {
+	sort.Float64s(values)
+	var total float64
+	for i := range values {
+		total += values[i]
+	}
+	resp := MonteCarloDistribution{
+		Actual:      floatToDecimal(actual),
+		Mean:        floatToDecimal(total / float64(len(values))),
+		Percentiles: make([]PercentileValue, len(monteCarloPercentiles)),
+	}
+	for i := range monteCarloPercentiles {
+		resp.Percentiles[i] = PercentileValue{
+			Percentile: decimal.NewFromFloat(monteCarloPercentiles[i]),
+			Value:      floatToDecimal(percentile(values, monteCarloPercentiles[i])),
+		}
+	}
+	return resp
+}
+
+// percentile linearly interpolates between the closest ranks of sorted values
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return sorted[lower]
+	}
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// floatToDecimal guards against simulated values overflowing, as decimal
+// cannot represent NaN or infinity
+func floatToDecimal(f float64) decimal.Decimal {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return decimal.Zero
+	}
+	return decimal.NewFromFloat(f)
+}
@@ -0,0 +1,170 @@
+package statistics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+func monteCarloHoldingValues() []ValueAtTime {
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	changes := []int64{2, -1, 3, -4, 1, 5, -2, -3, 4, 1}
+	resp := []ValueAtTime{{Time: tt, Value: decimal.NewFromInt(1000)}}
+	for i := range changes {
+		prev := resp[len(resp)-1].Value
+		resp = append(resp, ValueAtTime{
+			Time:  tt.Add(time.Hour * time.Duration(i+1)),
+			Value: prev.Add(prev.Mul(decimal.NewFromInt(changes[i])).Div(decimal.NewFromInt(100))),
+		})
+	}
+	return resp
+}
+
+func TestCalculateMonteCarlo(t *testing.T) {
+	t.Parallel()
+	_, err := CalculateMonteCarlo(nil, nil, decimal.Zero)
+	if !errors.Is(err, common.ErrNilPointer) {
+		t.Errorf("received %v expected %v", err, common.ErrNilPointer)
+	}
+
+	settings := &MonteCarloSettings{}
+	_, err = CalculateMonteCarlo(settings, nil, decimal.Zero)
+	if !errors.Is(err, errInvalidMonteCarloMethod) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloMethod)
+	}
+
+	settings.Method = MonteCarloShuffle
+	_, err = CalculateMonteCarlo(settings, nil, decimal.Zero)
+	if !errors.Is(err, errInvalidSimulations) {
+		t.Errorf("received %v expected %v", err, errInvalidSimulations)
+	}
+
+	settings.Simulations = MaximumMonteCarloSimulations + 1
+	_, err = CalculateMonteCarlo(settings, nil, decimal.Zero)
+	if !errors.Is(err, errTooManySimulations) {
+		t.Errorf("received %v expected %v", err, errTooManySimulations)
+	}
+
+	settings.Simulations = 100
+	settings.Seed = 1337
+	_, err = CalculateMonteCarlo(settings, []ValueAtTime{{}, {}}, decimal.Zero)
+	if !errors.Is(err, errNotEnoughReturns) {
+		t.Errorf("received %v expected %v", err, errNotEnoughReturns)
+	}
+
+	_, err = CalculateMonteCarlo(settings, []ValueAtTime{{}, {}, {}}, decimal.Zero)
+	if !errors.Is(err, errInvalidStartingValue) {
+		t.Errorf("received %v expected %v", err, errInvalidStartingValue)
+	}
+
+	values := monteCarloHoldingValues()
+	shuffled, err := CalculateMonteCarlo(settings, values, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if shuffled.Seed != 1337 {
+		t.Errorf("received %v expected %v", shuffled.Seed, 1337)
+	}
+	// shuffling only reorders returns, so every simulation ends in the same place
+	for i := range shuffled.FinalPNL.Percentiles {
+		if !shuffled.FinalPNL.Percentiles[i].Value.Round(8).Equal(shuffled.FinalPNL.Actual.Round(8)) {
+			t.Errorf("received %v expected %v", shuffled.FinalPNL.Percentiles[i].Value, shuffled.FinalPNL.Actual)
+		}
+	}
+	if len(shuffled.EquityBands) != len(monteCarloPercentiles) {
+		t.Fatalf("received %v expected %v", len(shuffled.EquityBands), len(monteCarloPercentiles))
+	}
+	for i := range shuffled.EquityBands {
+		if len(shuffled.EquityBands[i].Values) != len(values) {
+			t.Fatalf("received %v expected %v", len(shuffled.EquityBands[i].Values), len(values))
+		}
+		if !shuffled.EquityBands[i].Values[0].Value.Equal(values[0].Value) {
+			t.Errorf("received %v expected %v", shuffled.EquityBands[i].Values[0].Value, values[0].Value)
+		}
+	}
+	if shuffled.MaxDrawdown.Percentiles[0].Value.GreaterThan(shuffled.MaxDrawdown.Percentiles[len(shuffled.MaxDrawdown.Percentiles)-1].Value) {
+		t.Error("expected percentiles to be in ascending order")
+	}
+
+	settings.Method = MonteCarloBootstrap
+	bootstrapped, err := CalculateMonteCarlo(settings, values, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if bootstrapped.FinalPNL.Percentiles[0].Value.Equal(bootstrapped.FinalPNL.Percentiles[len(bootstrapped.FinalPNL.Percentiles)-1].Value) {
+		t.Error("expected bootstrapped final PNL to vary between simulations")
+	}
+	repeated, err := CalculateMonteCarlo(settings, values, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	for i := range bootstrapped.SharpeRatio.Percentiles {
+		if !bootstrapped.SharpeRatio.Percentiles[i].Value.Equal(repeated.SharpeRatio.Percentiles[i].Value) {
+			t.Errorf("received %v expected %v", repeated.SharpeRatio.Percentiles[i].Value, bootstrapped.SharpeRatio.Percentiles[i].Value)
+		}
+	}
+
+	// equity bands are built from the first paths once there are more
+	// simulations than are held
+	settings.Simulations = monteCarloBandPaths * 2
+	many, err := CalculateMonteCarlo(settings, values, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if many.Simulations != monteCarloBandPaths*2 {
+		t.Errorf("received %v expected %v", many.Simulations, monteCarloBandPaths*2)
+	}
+	settings.Simulations = monteCarloBandPaths
+	held, err := CalculateMonteCarlo(settings, values, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	for i := range many.EquityBands {
+		if len(many.EquityBands[i].Values) != len(values) {
+			t.Fatalf("received %v expected %v", len(many.EquityBands[i].Values), len(values))
+		}
+		for j := range many.EquityBands[i].Values {
+			if !many.EquityBands[i].Values[j].Value.Equal(held.EquityBands[i].Values[j].Value) {
+				t.Errorf("received %v expected %v", many.EquityBands[i].Values[j].Value, held.EquityBands[i].Values[j].Value)
+			}
+		}
+	}
+}
+
+func TestSimulationStatistics(t *testing.T) {
+	t.Parallel()
+	returns := []float64{0.25, -0.5, 1}
+	pnl, drawdown, sharpe, err := simulationStatistics(equityCurve(100, returns), returns, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if pnl != 25 {
+		t.Errorf("received %v expected %v", pnl, 25)
+	}
+	if drawdown != -50 {
+		t.Errorf("received %v expected %v", drawdown, -50)
+	}
+	if sharpe <= 0 {
+		t.Errorf("received %v expected a positive sharpe ratio", sharpe)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	t.Parallel()
+	if p := percentile(nil, 50); p != 0 {
+		t.Errorf("received %v expected %v", p, 0)
+	}
+	values := []float64{1, 2, 3, 4, 5}
+	if p := percentile(values, 50); p != 3 {
+		t.Errorf("received %v expected %v", p, 3)
+	}
+	if p := percentile(values, 5); p != 1.2 {
+		t.Errorf("received %v expected %v", p, 1.2)
+	}
+	if p := percentile(values, 100); p != 5 {
+		t.Errorf("received %v expected %v", p, 5)
+	}
+}
@@ -0,0 +1,82 @@
+package statistics
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// MonteCarloBootstrap samples returns with replacement, so simulations
+	// can end with a different PNL to the actual run
+	MonteCarloBootstrap = "bootstrap"
+	// MonteCarloShuffle reorders the actual returns, so every simulation
+	// ends with the same PNL and only the path taken differs
+	MonteCarloShuffle = "shuffle"
+	// MaximumMonteCarloSimulations limits the simulations of a run, as every
+	// simulated PNL, drawdown and sharpe ratio is held to find percentiles
+	MaximumMonteCarloSimulations = 100000
+	// monteCarloBandPaths limits the simulated equity curves held to build
+	// the equity bands, which otherwise need every path at every candle
+	monteCarloBandPaths = 1000
+)
+
+var (
+	errInvalidMonteCarloMethod = errors.New("invalid monte carlo method")
+	errInvalidSimulations      = errors.New("monte carlo simulations must be greater than zero")
+	errTooManySimulations      = errors.New("too many monte carlo simulations")
+	errNotEnoughReturns        = errors.New("monte carlo requires at least two returns to resample")
+	errInvalidStartingValue    = errors.New("monte carlo requires a positive starting value")
+)
+
+// monteCarloPercentiles are the percentiles reported for each distribution
+// and equity band
+var monteCarloPercentiles = []float64{5, 25, 50, 75, 95}
+
+// MonteCarloSettings defines how the returns of a completed run are resampled
+type MonteCarloSettings struct {
+	Method      string
+	Simulations int64
+	// Seed allows simulations to be repeated. Zero uses the current time
+	Seed int64
+}
+
+// MonteCarloResults holds distributions of simulated results alongside the
+// actual result so that the luck of the actual return ordering can be judged
+type MonteCarloResults struct {
+	Method      string `json:"method"`
+	Simulations int64  `json:"simulations"`
+	// Seed is the seed used, allowing a run with a time based seed to be repeated
+	Seed int64 `json:"seed"`
+	// FinalPNL is the USD value difference between the start and end of each simulation
+	FinalPNL MonteCarloDistribution `json:"final-pnl"`
+	// MaxDrawdown is the largest peak to trough percentage of each simulation
+	MaxDrawdown MonteCarloDistribution `json:"max-drawdown"`
+	// SharpeRatio is the arithmetic sharpe ratio of each simulation
+	SharpeRatio MonteCarloDistribution `json:"sharpe-ratio"`
+	// ProbabilityOfLoss is the percentage of simulations which ended with a loss
+	ProbabilityOfLoss decimal.Decimal `json:"probability-of-loss"`
+	// EquityBands are the simulated USD values at each candle for every
+	// reported percentile. They are built from up to the first 1,000
+	// simulations
+	EquityBands []MonteCarloEquityBand `json:"-"`
+}
+
+// MonteCarloDistribution summarises the simulated values of a single metric
+type MonteCarloDistribution struct {
+	Actual      decimal.Decimal   `json:"actual"`
+	Mean        decimal.Decimal   `json:"mean"`
+	Percentiles []PercentileValue `json:"percentiles"`
+}
+
+// PercentileValue is the value at a percentile of a distribution
+type PercentileValue struct {
+	Percentile decimal.Decimal `json:"percentile"`
+	Value      decimal.Decimal `json:"value"`
+}
+
+// MonteCarloEquityBand is the simulated USD value over time at a percentile
+type MonteCarloEquityBand struct {
+	Percentile decimal.Decimal
+	Values     []ValueAtTime
+}
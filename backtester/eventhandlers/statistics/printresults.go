@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -422,3 +423,32 @@ func (f *FundingStatistics) PrintResults(wasAnyDataMissing bool) error {
 
 	return nil
 }
+
+// PrintResults outputs monte carlo distributions to the command line
+func (m *MonteCarloResults) PrintResults() {
+	if m == nil {
+		return
+	}
+	log.Infoln(common.Statistics, common.CMDColours.H2+"------------------Monte Carlo--------------------------------"+common.CMDColours.Default)
+	sep := "Monte Carlo |\t"
+	log.Infof(common.Statistics, "%s Method: %v", sep, m.Method)
+	log.Infof(common.Statistics, "%s Simulations: %v", sep, convert.IntToHumanFriendlyString(m.Simulations, ","))
+	log.Infof(common.Statistics, "%s Seed: %v", sep, m.Seed)
+	log.Infof(common.Statistics, "%s Probability of loss: %s%%", sep, convert.DecimalToHumanFriendlyString(m.ProbabilityOfLoss, 2, ".", ","))
+	m.FinalPNL.print(sep+" Final PNL", 2)
+	m.MaxDrawdown.print(sep+" Max drawdown %", 2)
+	m.SharpeRatio.print(sep+" Sharpe ratio", 4)
+	log.Infoln(common.Statistics, "")
+}
+
+func (d *MonteCarloDistribution) print(prefix string, decimals int) {
+	percentiles := make([]string, len(d.Percentiles))
+	for i := range d.Percentiles {
+		percentiles[i] = fmt.Sprintf("p%v: %s", d.Percentiles[i].Percentile, convert.DecimalToHumanFriendlyString(d.Percentiles[i].Value, decimals, ".", ","))
+	}
+	log.Infof(common.Statistics, "%s actual: %s mean: %s %s",
+		prefix,
+		convert.DecimalToHumanFriendlyString(d.Actual, decimals, ".", ","),
+		convert.DecimalToHumanFriendlyString(d.Mean, decimals, ".", ","),
+		strings.Join(percentiles, " "))
+}
@@ -47,6 +47,8 @@ func (s *Statistic) Reset() error {
 	s.FundingStatistics = nil
 	s.FundManager = nil
 	s.HasCollateral = false
+	s.MonteCarloSettings = nil
+	s.MonteCarlo = nil
 	return nil
 }
 
@@ -81,7 +83,11 @@ func (s *Statistic) SetEventForOffset(ev common.Event) error {
 		s.ExchangeAssetPairStatistics[mapKey] = stats
 	}
 
-	for i := range stats.Events {
+	// events are added in offset order, so search from the latest
+	for i := len(stats.Events) - 1; i >= 0; i-- {
+		if stats.Events[i].Offset < ev.GetOffset() {
+			break
+		}
 		if stats.Events[i].Offset != ev.GetOffset() {
 			continue
 		}
@@ -275,6 +281,15 @@ func (s *Statistic) CalculateAllResults() error {
 	if err != nil {
 		return err
 	}
+	if s.MonteCarloSettings != nil && s.FundingStatistics.TotalUSDStatistics != nil {
+		riskFreeRatePerCandle := s.RiskFreeRate.Div(decimal.NewFromFloat(s.CandleInterval.IntervalsPerYear()))
+		s.MonteCarlo, err = CalculateMonteCarlo(s.MonteCarloSettings, s.FundingStatistics.TotalUSDStatistics.HoldingValues, riskFreeRatePerCandle)
+		if err != nil {
+			log.Errorln(common.Statistics, err)
+		} else {
+			s.MonteCarlo.PrintResults()
+		}
+	}
 	if currCount > 1 {
 		s.BiggestDrawdown = s.GetTheBiggestDrawdownAcrossCurrencies(finalResults)
 		s.BestMarketMovement = s.GetBestMarketPerformer(finalResults)
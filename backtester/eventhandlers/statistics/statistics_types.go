@@ -57,6 +57,8 @@ type Statistic struct {
 	FundingStatistics           *FundingStatistics                               `json:"funding-statistics"`
 	FundManager                 funding.IFundingManager                          `json:"-"`
 	HasCollateral               bool                                             `json:"has-collateral"`
+	MonteCarloSettings          *MonteCarloSettings                              `json:"-"`
+	MonteCarlo                  *MonteCarloResults                               `json:"monte-carlo,omitempty"`
 }
 
 // FinalResultsHolder holds important stats about a currency's performance
@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/rsi"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/top2bottom2"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/triangulararbitrage"
 	"github.com/thrasher-corp/gocryptotrader/common"
 )
 
@@ -100,5 +101,6 @@ var (
 		new(rsi.Strategy),
 		new(top2bottom2.Strategy),
 		new(binancecashandcarry.Strategy),
+		new(triangulararbitrage.Strategy),
 	}
 )
@@ -0,0 +1,251 @@
+package triangulararbitrage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/triangular"
+)
+
+// Name returns the name of the strategy
+func (s *Strategy) Name() string {
+	return Name
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	return description
+}
+
+// OnSignal handles a data event and returns what action the strategy believes should occur
+// however, a cycle requires the data of three pairs at once
+func (s *Strategy) OnSignal(data.Handler, funding.IFundingTransferer, portfolio.Handler) (signal.Event, error) {
+	return nil, base.ErrSimultaneousProcessingOnly
+}
+
+// SupportsSimultaneousProcessing this strategy only supports simultaneous signal processing
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals groups the data of each exchange asset, finds the
+// triangular cycles formed by its pairs and signals the legs of the most
+// profitable cycles which the available funds can trade
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundingTransferer, _ portfolio.Handler) ([]signal.Event, error) {
+	if len(d) == 0 {
+		return nil, base.ErrNoDataToProcess
+	}
+	if f == nil {
+		return nil, fmt.Errorf("%w missing funding transferer", gctcommon.ErrNilPointer)
+	}
+	if !f.IsUsingExchangeLevelFunding() {
+		return nil, errExchangeLevelFundingRequired
+	}
+	if s.depths == nil {
+		s.depths = make(map[depthKey]*orderbook.Depth)
+	}
+	resp := make([]signal.Event, 0, len(d))
+	markets := make(map[marketKey]*market)
+	var keys []marketKey
+	for i := range d {
+		es, err := s.GetBaseData(d[i])
+		if err != nil {
+			return nil, err
+		}
+		latest, err := d[i].Latest()
+		if err != nil {
+			return nil, err
+		}
+		es.SetPrice(latest.GetClosePrice())
+		es.SetDirection(order.DoNothing)
+		resp = append(resp, &es)
+
+		mk := marketKey{exchange: strings.ToLower(latest.GetExchange()), asset: latest.GetAssetType()}
+		m, ok := markets[mk]
+		if !ok {
+			m = &market{signals: make(map[depthKey]*signal.Signal)}
+			markets[mk] = m
+			keys = append(keys, mk)
+		}
+		m.pairs = append(m.pairs, latest.Pair())
+
+		hasDataAtTime, err := d[i].HasDataAtTime(latest.GetTime())
+		if err != nil {
+			return nil, err
+		}
+		price := latest.GetClosePrice().InexactFloat64()
+		volume := latest.GetVolume().InexactFloat64()
+		if !hasDataAtTime || price <= 0 || volume <= 0 {
+			es.SetDirection(order.MissingData)
+			es.AppendReason(missingDataReason)
+			continue
+		}
+		dk := newDepthKey(mk, latest.Pair())
+		err = s.loadCandleDepth(dk, price, volume, latest)
+		if err != nil {
+			return nil, err
+		}
+		m.signals[dk] = &es
+	}
+	for i := range keys {
+		err := s.evaluateMarket(keys[i], markets[keys[i]], f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// loadCandleDepth sets the depth of a pair to a single level on each side at
+// the candle's close price for its volume
+func (s *Strategy) loadCandleDepth(k depthKey, price, volume float64, ev data.Event) error {
+	depth, ok := s.depths[k]
+	if !ok {
+		depth = orderbook.NewDepth(uuid.Nil)
+		s.depths[k] = depth
+	}
+	return depth.LoadSnapshot(
+		orderbook.Items{{Price: price, Amount: volume}},
+		orderbook.Items{{Price: price, Amount: volume}},
+		0,
+		ev.GetTime(),
+		true)
+}
+
+// evaluateMarket signals the legs of the most profitable cycles of an
+// exchange asset, each pair can only be traded by one cycle per candle
+func (s *Strategy) evaluateMarket(mk marketKey, m *market, f funding.IFundingTransferer) error {
+	graph, err := triangular.NewGraph(m.pairs)
+	if err != nil {
+		for _, sig := range m.signals {
+			sig.AppendReason(noCyclesReason)
+		}
+		return nil //nolint:nilerr // a lack of cycles is reported on each signal
+	}
+	depth := func(p currency.Pair) (*orderbook.Depth, error) {
+		k := newDepthKey(mk, p)
+		if _, ok := m.signals[k]; !ok {
+			return nil, fmt.Errorf("%s %w", p, errMissingDepth)
+		}
+		return s.depths[k], nil
+	}
+	opportunities, err := triangular.Evaluate(graph.Cycles(), depth, &triangular.Params{
+		FeeRate:                  s.feeRate,
+		MinimumProfitBasisPoints: s.minimumProfitBasisPoints,
+	})
+	if err != nil {
+		return err
+	}
+
+	traded := make(map[depthKey]bool)
+opportunities:
+	for i := range opportunities {
+		for j := range opportunities[i].Legs {
+			if traded[newDepthKey(mk, opportunities[i].Legs[j].Pair)] {
+				continue opportunities
+			}
+		}
+		o, err := s.fitToFunds(&opportunities[i], mk, m, depth, f)
+		if err != nil {
+			return err
+		}
+		if o == nil {
+			for j := range opportunities[i].Legs {
+				m.signals[newDepthKey(mk, opportunities[i].Legs[j].Pair)].AppendReasonf("%s: %s", opportunities[i].Cycle, insufficientReason)
+			}
+			continue
+		}
+		for j := range o.Legs {
+			k := newDepthKey(mk, o.Legs[j].Pair)
+			traded[k] = true
+			sig := m.signals[k]
+			sig.SetDirection(o.Legs[j].Side)
+			sig.SetAmount(decimal.NewFromFloat(o.Legs[j].BaseAmount))
+			sig.AppendReasonf("leg %d of %s, expected return of %.2f basis points", j+1, o.Cycle, o.ProfitBasisPoints)
+		}
+	}
+	for k, sig := range m.signals {
+		if !traded[k] {
+			sig.AppendReason(notInCycleReason)
+		}
+	}
+	return nil
+}
+
+// fitToFunds scales an opportunity down to the funds available for each of
+// its legs, leaving room for fees. It returns nil when a leg has no funds
+func (s *Strategy) fitToFunds(o *triangular.Opportunity, mk marketKey, m *market, depth triangular.DepthFunc, f funding.IFundingTransferer) (*triangular.Opportunity, error) {
+	ratio := 1.0
+	for i := range o.Legs {
+		funds, err := f.GetFundingForEvent(m.signals[newDepthKey(mk, o.Legs[i].Pair)])
+		if err != nil {
+			return nil, err
+		}
+		pair, err := funds.FundReader().GetPairReader()
+		if err != nil {
+			return nil, err
+		}
+		available := pair.BaseAvailable()
+		if o.Legs[i].Side == order.Buy {
+			available = pair.QuoteAvailable()
+		}
+		r := available.InexactFloat64() * (1 - s.feeRate) / o.Legs[i].AmountIn
+		if r < ratio {
+			ratio = r
+		}
+	}
+	if ratio <= 0 {
+		return nil, nil
+	}
+	if ratio == 1 {
+		return o, nil
+	}
+	return o.Cycle.Evaluate(depth, s.feeRate, o.StartAmount*ratio)
+}
+
+// newDepthKey returns the key of a pair's candle depth
+func newDepthKey(mk marketKey, p currency.Pair) depthKey {
+	return depthKey{marketKey: mk, base: p.Base.Item, quote: p.Quote.Item}
+}
+
+// SetCustomSettings allows a user to modify the fee rate cycles are evaluated
+// with and the minimum return required to trade them
+func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) error {
+	for k, v := range customSettings {
+		switch k {
+		case feeRateKey:
+			feeRate, ok := v.(float64)
+			if !ok || feeRate < 0 || feeRate >= 1 {
+				return fmt.Errorf("%w provided fee rate value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.feeRate = feeRate
+		case minimumProfitKey:
+			minimumProfit, ok := v.(float64)
+			if !ok || minimumProfit < 0 {
+				return fmt.Errorf("%w provided minimum profit value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.minimumProfitBasisPoints = minimumProfit
+		default:
+			return fmt.Errorf("%w unrecognised custom setting key %v with value %v. Cannot apply", base.ErrInvalidCustomSettings, k, v)
+		}
+	}
+	return nil
+}
+
+// SetDefaults sets the custom settings to their default values
+func (s *Strategy) SetDefaults() {
+	s.feeRate = defaultFeeRate
+	s.minimumProfitBasisPoints = 0
+}
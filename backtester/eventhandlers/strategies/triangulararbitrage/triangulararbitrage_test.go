@@ -0,0 +1,164 @@
+package triangulararbitrage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	eventkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const testExchange = "binance"
+
+var (
+	btcusdt = currency.NewPair(currency.BTC, currency.USDT)
+	ethusdt = currency.NewPair(currency.ETH, currency.USDT)
+	ethbtc  = currency.NewPair(currency.ETH, currency.BTC)
+)
+
+func newTestData(t *testing.T, p currency.Pair, price, volume float64) data.Handler {
+	t.Helper()
+	d := &data.Base{}
+	require.NoError(t, d.SetLive(true), "SetLive must not error")
+	err := d.SetStream([]data.Event{&eventkline.Kline{
+		Base: &event.Base{
+			Offset:       1,
+			Exchange:     testExchange,
+			Time:         time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			Interval:     gctkline.OneDay,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+		},
+		Close:  decimal.NewFromFloat(price),
+		Volume: decimal.NewFromFloat(volume),
+	}})
+	require.NoError(t, err, "SetStream must not error")
+	_, err = d.Next()
+	require.NoError(t, err, "Next must not error")
+	return &kline.DataFromKline{Base: d, Item: &gctkline.Item{}}
+}
+
+func newTestFunding(t *testing.T, exchangeLevel bool, btc, eth, usdt int64) *funding.FundManager {
+	t.Helper()
+	f, err := funding.SetupFundingManager(engine.NewExchangeManager(), exchangeLevel, true, false)
+	require.NoError(t, err, "SetupFundingManager must not error")
+	for c, amount := range map[currency.Code]int64{currency.BTC: btc, currency.ETH: eth, currency.USDT: usdt} {
+		item, err := funding.CreateItem(testExchange, asset.Spot, c, decimal.NewFromInt(amount), decimal.Zero)
+		require.NoError(t, err, "CreateItem must not error")
+		require.NoError(t, f.AddItem(item), "AddItem must not error")
+	}
+	return f
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	assert.Equal(t, Name, s.Name(), "Name should return the strategy name")
+}
+
+func TestDescription(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	assert.Equal(t, description, s.Description(), "Description should return the strategy description")
+}
+
+func TestSupportsSimultaneousProcessing(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	assert.True(t, s.SupportsSimultaneousProcessing(), "SupportsSimultaneousProcessing should return true")
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	_, err := s.OnSignal(nil, nil, nil)
+	assert.ErrorIs(t, err, base.ErrSimultaneousProcessingOnly, "OnSignal should error")
+}
+
+func TestSetCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	require.NoError(t, s.SetCustomSettings(nil), "SetCustomSettings must not error without settings")
+	err := s.SetCustomSettings(map[string]interface{}{feeRateKey: 0.002, minimumProfitKey: 5.0})
+	require.NoError(t, err, "SetCustomSettings must not error")
+	assert.Equal(t, 0.002, s.feeRate, "SetCustomSettings should set the fee rate")
+	assert.Equal(t, 5.0, s.minimumProfitBasisPoints, "SetCustomSettings should set the minimum profit")
+
+	err = s.SetCustomSettings(map[string]interface{}{feeRateKey: "0.002"})
+	assert.ErrorIs(t, err, base.ErrInvalidCustomSettings, "SetCustomSettings should error on an invalid fee rate")
+	err = s.SetCustomSettings(map[string]interface{}{feeRateKey: 1.0})
+	assert.ErrorIs(t, err, base.ErrInvalidCustomSettings, "SetCustomSettings should error on a fee rate out of range")
+	err = s.SetCustomSettings(map[string]interface{}{minimumProfitKey: -1.0})
+	assert.ErrorIs(t, err, base.ErrInvalidCustomSettings, "SetCustomSettings should error on a negative minimum profit")
+	err = s.SetCustomSettings(map[string]interface{}{"lol": 1.0})
+	assert.ErrorIs(t, err, base.ErrInvalidCustomSettings, "SetCustomSettings should error on an unknown key")
+}
+
+func TestSetDefaults(t *testing.T) {
+	t.Parallel()
+	s := Strategy{minimumProfitBasisPoints: 5}
+	s.SetDefaults()
+	assert.Equal(t, defaultFeeRate, s.feeRate, "SetDefaults should set the fee rate")
+	assert.Zero(t, s.minimumProfitBasisPoints, "SetDefaults should reset the minimum profit")
+}
+
+func TestOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	_, err := s.OnSimultaneousSignals(nil, nil, nil)
+	assert.ErrorIs(t, err, base.ErrNoDataToProcess, "OnSimultaneousSignals should error without data")
+
+	// Buying 10 ETH with 1 BTC, selling it for 110 USDT and buying 1.1 BTC
+	// returns 10% before fees
+	d := []data.Handler{
+		newTestData(t, btcusdt, 100, 100),
+		newTestData(t, ethusdt, 11, 100),
+		newTestData(t, ethbtc, 0.1, 100),
+	}
+	_, err = s.OnSimultaneousSignals(d, nil, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer, "OnSimultaneousSignals should error without funding")
+	_, err = s.OnSimultaneousSignals(d, newTestFunding(t, false, 1, 1, 1), nil)
+	assert.ErrorIs(t, err, errExchangeLevelFundingRequired, "OnSimultaneousSignals should error without exchange level funding")
+
+	resp, err := s.OnSimultaneousSignals(d, newTestFunding(t, true, 1, 100, 1000), nil)
+	require.NoError(t, err, "OnSimultaneousSignals must not error")
+	require.Len(t, resp, 3, "OnSimultaneousSignals must return a signal for each pair")
+	assert.Equal(t, order.Buy, resp[0].GetDirection(), "BTC should be bought with USDT")
+	assert.Equal(t, order.Sell, resp[1].GetDirection(), "ETH should be sold for USDT")
+	assert.Equal(t, order.Buy, resp[2].GetDirection(), "ETH should be bought with BTC")
+	// The BTC held limits the cycle to 0.999 BTC after leaving room for fees
+	sig, ok := resp[2].(*signal.Signal)
+	require.True(t, ok, "signal must be a *signal.Signal")
+	assert.InDelta(t, 9.99, sig.GetAmount().InexactFloat64(), 1e-9, "ETH amount should be limited by the BTC held")
+
+	resp, err = s.OnSimultaneousSignals(d, newTestFunding(t, true, 0, 100, 1000), nil)
+	require.NoError(t, err, "OnSimultaneousSignals must not error")
+	for i := range resp {
+		assert.Equal(t, order.DoNothing, resp[i].GetDirection(), "Cycles should not be traded without funds")
+	}
+
+	d[1] = newTestData(t, ethusdt, 11, 0)
+	resp, err = s.OnSimultaneousSignals(d, newTestFunding(t, true, 1, 100, 1000), nil)
+	require.NoError(t, err, "OnSimultaneousSignals must not error")
+	assert.Equal(t, order.MissingData, resp[1].GetDirection(), "Pairs without volume should be missing data")
+	assert.Equal(t, order.DoNothing, resp[0].GetDirection(), "Cycles with missing data should not be traded")
+
+	resp, err = s.OnSimultaneousSignals(d[:2], newTestFunding(t, true, 1, 100, 1000), nil)
+	require.NoError(t, err, "OnSimultaneousSignals must not error")
+	assert.Contains(t, resp[0].GetReasons(), noCyclesReason, "Pairs which do not form cycles should be reported")
+}
@@ -0,0 +1,60 @@
+package triangulararbitrage
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const (
+	// Name is the strategy name
+	Name               = "triangular-arbitrage"
+	feeRateKey         = "fee-rate"
+	minimumProfitKey   = "minimum-profit-basis-points"
+	defaultFeeRate     = 0.001
+	description        = `Triangular arbitrage converts a currency through three pairs on the same exchange, such as BTC to ETH to USDT and back to BTC, and profits when the combined exchange rates return more than was started with after fees. Each candle's close price and volume are treated as the orderbook depth of its pair. All legs of a cycle are executed at once from held inventory`
+	missingDataReason  = "missing data, cannot evaluate triangular cycles"
+	noCyclesReason     = "pairs do not form any triangular cycles"
+	notInCycleReason   = "not part of a profitable triangular cycle"
+	insufficientReason = "insufficient funds to trade triangular cycle"
+)
+
+var (
+	errExchangeLevelFundingRequired = errors.New("triangular arbitrage requires exchange level funding")
+	errMissingDepth                 = errors.New("no candle depth for pair")
+)
+
+// Strategy is an implementation of the Handler interface
+type Strategy struct {
+	base.Strategy
+	feeRate                  float64
+	minimumProfitBasisPoints float64
+	// depths are reused across candles as each orderbook depth runs its own
+	// node cleaner
+	depths map[depthKey]*orderbook.Depth
+}
+
+// marketKey groups the data of pairs which trade on the same exchange and
+// asset, as cycles cannot span markets
+type marketKey struct {
+	exchange string
+	asset    asset.Item
+}
+
+// depthKey identifies the candle depth of a pair
+type depthKey struct {
+	marketKey
+	base  *currency.Item
+	quote *currency.Item
+}
+
+// market holds the pairs of an exchange asset and the signals of those with
+// data at the current candle
+type market struct {
+	pairs   currency.Pairs
+	signals map[depthKey]*signal.Signal
+}
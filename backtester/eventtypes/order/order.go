@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -103,3 +105,18 @@ func (o *Order) IsLiquidating() bool {
 func (o *Order) GetClosePrice() decimal.Decimal {
 	return o.ClosePrice
 }
+
+// GetOrderType returns the order type
+func (o *Order) GetOrderType() order.Type {
+	return o.OrderType
+}
+
+// GetOrderPrice returns the limit or trigger price of the order
+func (o *Order) GetOrderPrice() decimal.Decimal {
+	return o.OrderPrice
+}
+
+// GetOrderExpiry returns when a resting order is cancelled
+func (o *Order) GetOrderExpiry() time.Time {
+	return o.OrderExpiry
+}
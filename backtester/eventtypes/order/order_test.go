@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -169,3 +171,34 @@ func TestIsClosingPosition(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", k.IsClosingPosition(), true)
 	}
 }
+
+func TestGetOrderType(t *testing.T) {
+	t.Parallel()
+	k := Order{
+		OrderType: gctorder.Limit,
+	}
+	if k.GetOrderType() != gctorder.Limit {
+		t.Errorf("received '%v' expected '%v'", k.GetOrderType(), gctorder.Limit)
+	}
+}
+
+func TestGetOrderExpiry(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	k := Order{
+		OrderExpiry: tt,
+	}
+	if !k.GetOrderExpiry().Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", k.GetOrderExpiry(), tt)
+	}
+}
+
+func TestGetOrderPrice(t *testing.T) {
+	t.Parallel()
+	k := Order{
+		OrderPrice: decimal.NewFromInt(1337),
+	}
+	if !k.GetOrderPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", k.GetOrderPrice(), decimal.NewFromInt(1337))
+	}
+}
@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -17,6 +19,8 @@ type Order struct {
 	ClosePrice          decimal.Decimal
 	Amount              decimal.Decimal
 	OrderType           order.Type
+	OrderPrice          decimal.Decimal
+	OrderExpiry         time.Time
 	Leverage            decimal.Decimal
 	AllocatedFunds      decimal.Decimal
 	BuyLimit            decimal.Decimal
@@ -44,4 +48,7 @@ type Event interface {
 	GetFillDependentEvent() signal.Event
 	IsClosingPosition() bool
 	IsLiquidating() bool
+	GetOrderType() order.Type
+	GetOrderPrice() decimal.Decimal
+	GetOrderExpiry() time.Time
 }
@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -114,6 +116,21 @@ func (s *Signal) MatchOrderAmount() bool {
 	return s.MatchesOrderAmount
 }
 
+// GetOrderType returns the order type to place
+func (s *Signal) GetOrderType() order.Type {
+	return s.OrderType
+}
+
+// GetOrderPrice returns the limit or trigger price of the order
+func (s *Signal) GetOrderPrice() decimal.Decimal {
+	return s.OrderPrice
+}
+
+// GetOrderExpiry returns when a resting order is cancelled
+func (s *Signal) GetOrderExpiry() time.Time {
+	return s.OrderExpiry
+}
+
 // ToKline is used to convert a signal event
 // to a data event for the purpose of closing all positions
 // function CloseAllPositions is builds signal data, but
@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -201,3 +203,36 @@ func TestToKline(t *testing.T) {
 		t.Errorf("expected  '%v' received '%v'", "kline event", "signal event")
 	}
 }
+
+func TestGetOrderType(t *testing.T) {
+	t.Parallel()
+	s := &Signal{}
+	if s.GetOrderType() != gctorder.UnknownType {
+		t.Errorf("received '%v' expected '%v'", s.GetOrderType(), gctorder.UnknownType)
+	}
+	s.OrderType = gctorder.Stop
+	if s.GetOrderType() != gctorder.Stop {
+		t.Errorf("received '%v' expected '%v'", s.GetOrderType(), gctorder.Stop)
+	}
+}
+
+func TestGetOrderExpiry(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	s := &Signal{
+		OrderExpiry: tt,
+	}
+	if !s.GetOrderExpiry().Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", s.GetOrderExpiry(), tt)
+	}
+}
+
+func TestGetOrderPrice(t *testing.T) {
+	t.Parallel()
+	s := &Signal{
+		OrderPrice: decimal.NewFromInt(1337),
+	}
+	if !s.GetOrderPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", s.GetOrderPrice(), decimal.NewFromInt(1337))
+	}
+}
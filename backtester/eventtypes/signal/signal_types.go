@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -28,6 +30,9 @@ type Event interface {
 	GetCollateralCurrency() currency.Code
 	SetAmount(decimal.Decimal)
 	MatchOrderAmount() bool
+	GetOrderType() order.Type
+	GetOrderPrice() decimal.Decimal
+	GetOrderExpiry() time.Time
 	IsNil() bool
 }
 
@@ -66,4 +71,16 @@ type Signal struct {
 	// MatchOrderAmount flags to other event handlers
 	// that the order amount must match the set Amount property
 	MatchesOrderAmount bool
+	// OrderType defaults to a market order when unset
+	// limit and stop orders are only supported in tick mode
+	// where they rest with the exchange until a trade crosses
+	// the OrderPrice
+	OrderType order.Type
+	// OrderPrice is the limit price of a limit order
+	// or the trigger price of a stop order
+	OrderPrice decimal.Decimal
+	// OrderExpiry cancels a resting order which has not
+	// filled by this time, returning its reserved funds.
+	// Orders rest until the end of the run when unset
+	OrderExpiry time.Time
 }
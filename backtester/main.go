@@ -20,7 +20,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/signaler"
 )
 
-var singleTaskStrategyPath, templatePath, outputPath, btConfigDir, strategyPluginPath, pprofURL string
+var singleTaskStrategyPath, optimisationPath, walkForwardPath, templatePath, outputPath, btConfigDir, strategyPluginPath, pprofURL string
 var printLogo, generateReport, darkReport, colourOutput, logSubHeader, enablePProf bool
 
 func main() {
@@ -174,6 +174,22 @@ func main() {
 			fmt.Printf("Could not read strategy config. Error: %v\n", err)
 			os.Exit(1)
 		}
+		if optimisationPath != "" {
+			err = optimiseStrategy(cfg, btCfg)
+			if err != nil {
+				fmt.Printf("Could not optimise strategy. Error: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+		if walkForwardPath != "" {
+			err = walkForwardStrategy(cfg, btCfg)
+			if err != nil {
+				fmt.Printf("Could not walk forward strategy. Error: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
 		var bt *backtest.BackTest
 		bt, err = backtest.NewBacktesterFromConfigs(cfg, &config.BacktesterConfig{
 			Report: config.Report{
@@ -283,6 +299,16 @@ func parseFlags(wd string) map[string]bool {
 		"singlerunstrategypath",
 		"",
 		fmt.Sprintf("path to a strategy file. Will execute strategy and exit, instead of creating a GRPC server. Example %v", defaultStrategy))
+	flag.StringVar(
+		&optimisationPath,
+		"optimisationpath",
+		"",
+		fmt.Sprintf("path to an optimisation file. Used with singlerunstrategypath, will run the strategy with every parameter set, print the ranked results and exit. Example %v", filepath.Join(wd, "config", "strategyexamples", "rsi-api-candles.opt")))
+	flag.StringVar(
+		&walkForwardPath,
+		"walkforwardpath",
+		"",
+		fmt.Sprintf("path to a walk-forward file. Used with singlerunstrategypath, will optimise each in-sample window, run the out-of-sample windows with the best parameters, print the results and exit. Example %v", filepath.Join(wd, "config", "strategyexamples", "rsi-api-candles.wf")))
 	flag.StringVar(
 		&btConfigDir,
 		"backtesterconfigpath",
@@ -345,3 +371,59 @@ func parseFlags(wd string) map[string]bool {
 	flag.Visit(func(f *flag.Flag) { flags[f.Name] = true })
 	return flags
 }
+
+// optimiseStrategy runs the strategy with every parameter set from the
+// optimisation file, printing the ranked results and saving them to the
+// report output path
+func optimiseStrategy(cfg *config.Config, btCfg *config.BacktesterConfig) error {
+	optimisation, err := config.ReadOptimisationFromFile(optimisationPath)
+	if err != nil {
+		return err
+	}
+	results, err := backtest.OptimiseStrategy(backtest.NewTaskManager(), cfg, optimisation, btCfg)
+	if err != nil {
+		return err
+	}
+	err = results.WriteTable(os.Stdout)
+	if err != nil {
+		return err
+	}
+	if !generateReport {
+		return nil
+	}
+	path, err := results.Save(btCfg.Report.OutputPath)
+	if err != nil {
+		return err
+	}
+	log.Infof(common.Backtester, "Optimisation results saved to %v", path)
+	return nil
+}
+
+// walkForwardStrategy optimises each in-sample window from the walk-forward
+// file and runs the out-of-sample windows with the best parameters, printing
+// the results and saving them to the report output path
+func walkForwardStrategy(cfg *config.Config, btCfg *config.BacktesterConfig) error {
+	walkForward, err := config.ReadWalkForwardFromFile(walkForwardPath)
+	if err != nil {
+		return err
+	}
+	btCfg.Report.GenerateReport = generateReport
+	btCfg.Report.DarkMode = darkReport
+	results, err := backtest.WalkForward(backtest.NewTaskManager(), cfg, walkForward, btCfg)
+	if err != nil {
+		return err
+	}
+	err = results.WriteTable(os.Stdout)
+	if err != nil {
+		return err
+	}
+	if !generateReport {
+		return nil
+	}
+	path, err := results.Save(btCfg.Report.OutputPath)
+	if err != nil {
+		return err
+	}
+	log.Infof(common.Backtester, "Walk-forward results saved to %v", path)
+	return nil
+}
@@ -186,3 +186,42 @@ func createFuturesSpotDiffChart(items map[key.ExchangePairAsset]*statistics.Curr
 	}
 	return response, nil
 }
+
+// createMonteCarloChart shows the percentile bands of simulated USD values
+// against the actual USD value over time
+func createMonteCarloChart(results *statistics.MonteCarloResults, actual []statistics.ValueAtTime) (*Chart, error) {
+	if results == nil {
+		return nil, fmt.Errorf("%w missing monte carlo results", gctcommon.ErrNilPointer)
+	}
+	response := &Chart{
+		AxisType: "logarithmic",
+	}
+	for i := range results.EquityBands {
+		plots := make([]LinePlot, len(results.EquityBands[i].Values))
+		for j := range results.EquityBands[i].Values {
+			if results.EquityBands[i].Values[j].Value.IsZero() {
+				response.ShowZeroDisclaimer = true
+			}
+			plots[j] = LinePlot{
+				Value:     results.EquityBands[i].Values[j].Value.InexactFloat64(),
+				UnixMilli: results.EquityBands[i].Values[j].Time.UTC().UnixMilli(),
+			}
+		}
+		response.Data = append(response.Data, ChartLine{
+			Name:      fmt.Sprintf("%v percentile", results.EquityBands[i].Percentile),
+			LinePlots: plots,
+		})
+	}
+	plots := make([]LinePlot, len(actual))
+	for i := range actual {
+		plots[i] = LinePlot{
+			Value:     actual[i].Value.InexactFloat64(),
+			UnixMilli: actual[i].Time.UTC().UnixMilli(),
+		}
+	}
+	response.Data = append(response.Data, ChartLine{
+		Name:      "Actual USD value",
+		LinePlots: plots,
+	})
+	return response, nil
+}
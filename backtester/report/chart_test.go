@@ -236,3 +236,38 @@ func TestCreateFuturesSpotDiffChart(t *testing.T) {
 		t.Error("expected data")
 	}
 }
+
+func TestCreateMonteCarloChart(t *testing.T) {
+	t.Parallel()
+	_, err := createMonteCarloChart(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	tt := time.Now()
+	results := &statistics.MonteCarloResults{
+		EquityBands: []statistics.MonteCarloEquityBand{
+			{
+				Percentile: decimal.NewFromInt(5),
+				Values:     []statistics.ValueAtTime{{Time: tt, Value: decimal.NewFromInt(1337)}, {Time: tt.Add(time.Hour)}},
+			},
+			{
+				Percentile: decimal.NewFromInt(95),
+				Values:     []statistics.ValueAtTime{{Time: tt, Value: decimal.NewFromInt(1337)}, {Time: tt.Add(time.Hour), Value: decimal.NewFromInt(1338)}},
+			},
+		},
+	}
+	resp, err := createMonteCarloChart(results, []statistics.ValueAtTime{{Time: tt, Value: decimal.NewFromInt(1337)}})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Data) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Data), 3)
+	}
+	if !resp.ShowZeroDisclaimer {
+		t.Errorf("received '%v' expected '%v'", resp.ShowZeroDisclaimer, true)
+	}
+	if resp.Data[2].Name != "Actual USD value" {
+		t.Errorf("received '%v' expected '%v'", resp.Data[2].Name, "Actual USD value")
+	}
+}
@@ -58,6 +58,12 @@ func (d *Data) GenerateReport() error {
 			if err != nil {
 				return err
 			}
+			if d.Statistics.MonteCarlo != nil {
+				d.MonteCarloChart, err = createMonteCarloChart(d.Statistics.MonteCarlo, d.Statistics.FundingStatistics.TotalUSDStatistics.HoldingValues)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -195,9 +201,13 @@ func (d *Data) enhanceCandles() error {
 					}
 				}
 			}
+			candleEnd := d.OriginalCandles[intVal].Candles[j].Time.Add(d.OriginalCandles[intVal].Interval.Duration())
 			for k := range statsForCandles.FinalOrders.Orders {
+				// in tick mode orders are placed throughout the candle
 				if statsForCandles.FinalOrders.Orders[k].Order == nil ||
-					!statsForCandles.FinalOrders.Orders[k].Order.Date.Equal(d.OriginalCandles[intVal].Candles[j].Time) {
+					statsForCandles.FinalOrders.Orders[k].Order.Date.Before(d.OriginalCandles[intVal].Candles[j].Time) ||
+					(statsForCandles.FinalOrders.Orders[k].Order.Date.After(d.OriginalCandles[intVal].Candles[j].Time) &&
+						!statsForCandles.FinalOrders.Orders[k].Order.Date.Before(candleEnd)) {
 					continue
 				}
 				// an order was placed here, can enhance chart!
@@ -233,6 +233,13 @@ func TestGenerateReport(t *testing.T) {
 				},
 				TotalUSDStatistics: &statistics.TotalFundingStatistics{},
 			},
+			MonteCarlo: &statistics.MonteCarloResults{
+				Method:      statistics.MonteCarloShuffle,
+				Simulations: 1337,
+				FinalPNL: statistics.MonteCarloDistribution{
+					Percentiles: []statistics.PercentileValue{{Percentile: decimal.NewFromInt(50), Value: decimal.NewFromInt(1337)}},
+				},
+			},
 			StrategyName: "testStrat",
 			RiskFreeRate: decimal.NewFromFloat(0.03),
 			ExchangeAssetPairStatistics: map[key.ExchangePairAsset]*statistics.CurrencyPairStatistic{
@@ -42,6 +42,7 @@ type Data struct {
 	HoldingsOverTimeChart *Chart
 	PNLOverTimeChart      *Chart
 	FuturesSpotDiffChart  *Chart
+	MonteCarloChart       *Chart
 	Prettify              PrettyNumbers
 }
 
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/accounting"
+)
+
+var (
+	errNoFillFiles   = errors.New("no fill files provided")
+	errInvalidFormat = errors.New("format must be json or csv")
+)
+
+func main() {
+	var fillFiles, method, fiscalYearStart, reportingCurrency, rates, format, output string
+	flag.StringVar(&fillFiles, "fills", "", "Comma separated list of exported fill files ending in .csv or .json.")
+	flag.StringVar(&method, "method", string(accounting.FIFO), "The lot matching method FIFO, LIFO or HIFO.")
+	flag.StringVar(&fiscalYearStart, "fiscalyearstart", "01-01", "The first day of each fiscal year formatted as MM-DD.")
+	flag.StringVar(&reportingCurrency, "reportingcurrency", "USD", "The currency cost basis, proceeds and gains are measured in.")
+	flag.StringVar(&rates, "rates", "", "Comma separated list of fixed rates formatted as base-quote:rate, used to value quote currencies, fees and open lots in the reporting currency.")
+	flag.StringVar(&format, "format", "json", "The report format json or csv.")
+	flag.StringVar(&output, "output", "", "The file to write the report to, defaults to stdout.")
+	flag.Parse()
+
+	if err := run(fillFiles, method, fiscalYearStart, reportingCurrency, rates, format, output); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(fillFiles, method, fiscalYearStart, reportingCurrency, rates, format, output string) error {
+	if fillFiles == "" {
+		return errNoFillFiles
+	}
+	if format != "json" && format != "csv" {
+		return errInvalidFormat
+	}
+	m, err := accounting.ParseMethod(method)
+	if err != nil {
+		return err
+	}
+	month, day, err := accounting.ParseFiscalYearStart(fiscalYearStart)
+	if err != nil {
+		return err
+	}
+	var rateArgs []string
+	if rates != "" {
+		rateArgs = strings.Split(rates, ",")
+	}
+	staticRates, err := accounting.ParseStaticRates(rateArgs)
+	if err != nil {
+		return err
+	}
+
+	var fills []accounting.Fill
+	for _, path := range strings.Split(fillFiles, ",") {
+		var read []accounting.Fill
+		read, err = readFills(strings.TrimSpace(path))
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		fills = append(fills, read...)
+	}
+
+	report, err := accounting.NewReport(fills, &accounting.Options{
+		Method:               m,
+		FiscalYearStartMonth: month,
+		FiscalYearStartDay:   day,
+		ReportingCurrency:    currency.NewCode(reportingCurrency),
+		Rates:                staticRates.Rate,
+		Prices:               staticRates.Rate,
+	})
+	if err != nil {
+		return err
+	}
+	for i := range report.UnmatchedDisposals {
+		u := &report.UnmatchedDisposals[i]
+		log.Printf("warning: fill %s disposed of %v %s without any held lots, it is reported with no cost basis", u.FillID, u.Amount, u.Currency)
+	}
+
+	var w io.Writer = os.Stdout
+	if output != "" {
+		var f *os.File
+		f, err = os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if format == "csv" {
+		return report.WriteCSV(w)
+	}
+	return report.WriteJSON(w)
+}
+
+// readFills reads a fills file by its extension
+func readFills(path string) ([]accounting.Fill, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return accounting.ReadFillsCSV(f)
+	case ".json":
+		return accounting.ReadFillsJSON(f)
+	default:
+		return nil, fmt.Errorf("unsupported file extension %q", filepath.Ext(path))
+	}
+}
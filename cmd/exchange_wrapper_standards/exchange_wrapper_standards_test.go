@@ -23,6 +23,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -292,6 +293,7 @@ var (
 	positionSummaryRequestParam = reflect.TypeOf((**futures.PositionSummaryRequest)(nil)).Elem()
 	positionsRequestParam       = reflect.TypeOf((**futures.PositionsRequest)(nil)).Elem()
 	latestRateRequest           = reflect.TypeOf((**fundingrate.LatestRateRequest)(nil)).Elem()
+	optionChainRequestParam     = reflect.TypeOf((**options.ChainRequest)(nil)).Elem()
 	pairKeySliceParam           = reflect.TypeOf((*[]key.PairAsset)(nil)).Elem()
 )
 
@@ -529,6 +531,13 @@ func generateMethodArg(ctx context.Context, t *testing.T, argGenerator *MethodAr
 			Pair:                 argGenerator.AssetParams.Pair,
 			IncludePredictedRate: true,
 		})
+	case argGenerator.MethodInputType.AssignableTo(optionChainRequestParam):
+		input = reflect.ValueOf(&options.ChainRequest{
+			Asset: argGenerator.AssetParams.Asset,
+			// Option pairs embed strike and expiry so use the quote most
+			// commonly listed as an underlying
+			Underlying: currency.NewPair(argGenerator.AssetParams.Pair.Base, currency.USD),
+		})
 	default:
 		input = reflect.Zero(argGenerator.MethodInputType)
 	}
@@ -636,6 +645,7 @@ var acceptableErrors = []error{
 	order.ErrCannotValidateAsset,         // Is thrown when attempting to get order limits from an asset that is not yet loaded
 	order.ErrCannotValidateBaseCurrency,  // Is thrown when attempting to get order limits from an base currency that is not yet loaded
 	order.ErrCannotValidateQuoteCurrency, // Is thrown when attempting to get order limits from an quote currency that is not yet loaded
+	options.ErrNotOptionsAsset,           // Is thrown when an options function receives a non-options asset
 }
 
 // warningErrors will t.Log(err) when thrown to diagnose things, but not necessarily suggest
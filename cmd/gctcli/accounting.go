@@ -0,0 +1,291 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/accounting"
+	"github.com/urfave/cli/v2"
+)
+
+var errInvalidAccountingFormat = errors.New("format must be json or csv")
+
+var accountingFillFlags = []cli.Flag{
+	&cli.StringSliceFlag{
+		Name:     "exchange",
+		Aliases:  []string{"e"},
+		Usage:    "an exchange whose order history is included, may be repeated",
+		Required: true,
+	},
+	&cli.StringFlag{
+		Name:    "asset",
+		Aliases: []string{"a"},
+		Usage:   "the asset type of the orders",
+		Value:   "spot",
+	},
+	&cli.StringSliceFlag{
+		Name:    "pair",
+		Aliases: []string{"p"},
+		Usage:   "a currency pair to include e.g. btc-usdt, may be repeated and defaults to all enabled pairs",
+	},
+	&cli.StringFlag{
+		Name:  "start",
+		Usage: "start date, optional. Will filter any fills before this date e.g. " + time.Now().AddDate(-1, 0, 0).Format(time.DateTime),
+	},
+	&cli.StringFlag{
+		Name:  "end",
+		Usage: "end date, optional. Will filter any fills after this date e.g. " + time.Now().Format(time.DateTime),
+	},
+	&cli.StringFlag{
+		Name:    "format",
+		Aliases: []string{"f"},
+		Usage:   "'json' or 'csv'",
+		Value:   "json",
+	},
+	&cli.StringFlag{
+		Name:    "output",
+		Aliases: []string{"o"},
+		Usage:   "the file to write to, defaults to stdout",
+	},
+}
+
+var accountingCommands = &cli.Command{
+	Name:      "accounting",
+	Usage:     "match executed trades against tax lots and report realised and unrealised gains",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "report",
+			Usage: "reports realised gains per fiscal year and the unrealised gains of open lots",
+			Flags: append(append([]cli.Flag{}, accountingFillFlags...),
+				&cli.StringFlag{
+					Name:    "method",
+					Aliases: []string{"m"},
+					Usage:   "the lot matching method 'FIFO', 'LIFO' or 'HIFO'",
+					Value:   string(accounting.FIFO),
+				},
+				&cli.StringFlag{
+					Name:  "fiscalyearstart",
+					Usage: "the first day of each fiscal year formatted as MM-DD e.g. 07-01",
+					Value: "01-01",
+				},
+				&cli.StringFlag{
+					Name:  "reportingcurrency",
+					Usage: "the currency cost basis, proceeds and gains are measured in",
+					Value: currency.USD.String(),
+				},
+				&cli.StringSliceFlag{
+					Name:  "rate",
+					Usage: "a fixed rate formatted as base-quote:rate e.g. usdt-usd:1, used to value quote currencies and fees in the reporting currency and open lots without a ticker, may be repeated",
+				},
+			),
+			Action: getAccountingReport,
+		},
+		{
+			Name:   "exportfills",
+			Usage:  "exports executed trades in the format read by the offline accounting tool",
+			Flags:  accountingFillFlags,
+			Action: exportAccountingFills,
+		},
+	},
+}
+
+// getAccountingFills returns the fills of every requested exchange
+func getAccountingFills(c *cli.Context, client gctrpc.GoCryptoTraderServiceClient) ([]accounting.Fill, error) {
+	req := &gctrpc.GetAccountingFillsRequest{
+		Asset: c.String("asset"),
+		Pairs: c.StringSlice("pair"),
+	}
+	if c.IsSet("start") {
+		s, err := time.ParseInLocation(time.DateTime, c.String("start"), time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time format for start: %v", err)
+		}
+		req.StartDate = s.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if c.IsSet("end") {
+		e, err := time.ParseInLocation(time.DateTime, c.String("end"), time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time format for end: %v", err)
+		}
+		req.EndDate = e.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	var fills []accounting.Fill
+	for _, exch := range c.StringSlice("exchange") {
+		req.Exchange = exch
+		resp, err := client.GetAccountingFills(c.Context, req)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", exch, err)
+		}
+		for i := range resp.Fills {
+			var f accounting.Fill
+			f, err = convertAccountingFill(resp.Fills[i])
+			if err != nil {
+				return nil, fmt.Errorf("%s fill %s: %w", exch, resp.Fills[i].Id, err)
+			}
+			fills = append(fills, f)
+		}
+	}
+	return fills, nil
+}
+
+// convertAccountingFill converts an RPC fill to an accounting fill
+func convertAccountingFill(f *gctrpc.AccountingFill) (accounting.Fill, error) {
+	resp := accounting.Fill{
+		ID:       f.Id,
+		Exchange: f.Exchange,
+		Amount:   f.Amount,
+		Price:    f.Price,
+		Fee:      f.Fee,
+		FeeAsset: currency.NewCode(f.FeeAsset),
+	}
+	if f.Pair != nil {
+		resp.Pair = currency.NewPairWithDelimiter(f.Pair.Base, f.Pair.Quote, f.Pair.Delimiter)
+	}
+	var err error
+	if resp.Asset, err = asset.New(f.Asset); err != nil {
+		return resp, err
+	}
+	if resp.Side, err = order.StringToOrderSide(f.Side); err != nil {
+		return resp, err
+	}
+	if resp.Timestamp, err = time.Parse(common.SimpleTimeFormatWithTimezone, f.Timestamp); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// accountingOutput returns the writer for the output flag
+func accountingOutput(c *cli.Context) (io.Writer, func() error, error) {
+	if !c.IsSet("output") {
+		return os.Stdout, func() error { return nil }, nil
+	}
+	f, err := os.Create(c.String("output"))
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, f.Close, nil
+}
+
+// tickerPrices values currencies with the last price of the first exchange
+// with a ticker for the pair, falling back to fixed rates
+func tickerPrices(c *cli.Context, client gctrpc.GoCryptoTraderServiceClient, rates accounting.StaticRates) accounting.RateFunc {
+	return func(from, to currency.Code, at time.Time) (float64, error) {
+		for _, exch := range c.StringSlice("exchange") {
+			t, err := client.GetTicker(c.Context, &gctrpc.GetTickerRequest{
+				Exchange:  exch,
+				AssetType: c.String("asset"),
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: currency.DashDelimiter,
+					Base:      from.String(),
+					Quote:     to.String(),
+				},
+			})
+			if err == nil && t.Last > 0 {
+				return t.Last, nil
+			}
+		}
+		return rates.Rate(from, to, at)
+	}
+}
+
+func getAccountingReport(c *cli.Context) error {
+	method, err := accounting.ParseMethod(c.String("method"))
+	if err != nil {
+		return err
+	}
+	month, day, err := accounting.ParseFiscalYearStart(c.String("fiscalyearstart"))
+	if err != nil {
+		return err
+	}
+	rates, err := accounting.ParseStaticRates(c.StringSlice("rate"))
+	if err != nil {
+		return err
+	}
+	format := c.String("format")
+	if format != "json" && format != "csv" {
+		return errInvalidAccountingFormat
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	fills, err := getAccountingFills(c, client)
+	if err != nil {
+		return err
+	}
+	report, err := accounting.NewReport(fills, &accounting.Options{
+		Method:               method,
+		FiscalYearStartMonth: month,
+		FiscalYearStartDay:   day,
+		Location:             time.Local,
+		ReportingCurrency:    currency.NewCode(c.String("reportingcurrency")),
+		Rates:                rates.Rate,
+		Prices:               tickerPrices(c, client, rates),
+	})
+	if err != nil {
+		return err
+	}
+	for i := range report.UnmatchedDisposals {
+		u := &report.UnmatchedDisposals[i]
+		fmt.Fprintf(os.Stderr, "warning: fill %s disposed of %v %s without any held lots, it is reported with no cost basis\n", u.FillID, u.Amount, u.Currency)
+	}
+	w, closeOutput, err := accountingOutput(c)
+	if err != nil {
+		return err
+	}
+	if format == "csv" {
+		err = report.WriteCSV(w)
+	} else {
+		err = report.WriteJSON(w)
+	}
+	if err != nil {
+		_ = closeOutput()
+		return err
+	}
+	return closeOutput()
+}
+
+func exportAccountingFills(c *cli.Context) error {
+	format := c.String("format")
+	if format != "json" && format != "csv" {
+		return errInvalidAccountingFormat
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	fills, err := getAccountingFills(c, gctrpc.NewGoCryptoTraderServiceClient(conn))
+	if err != nil {
+		return err
+	}
+	w, closeOutput, err := accountingOutput(c)
+	if err != nil {
+		return err
+	}
+	if format == "csv" {
+		err = accounting.WriteFillsCSV(w, fills)
+	} else {
+		err = accounting.WriteFillsJSON(w, fills)
+	}
+	if err != nil {
+		_ = closeOutput()
+		return err
+	}
+	return closeOutput()
+}
@@ -0,0 +1,306 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errExecutionOrderIDUnset = errors.New("execution order id must be set")
+
+var (
+	executionOrderBaseFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:     "exchange",
+			Aliases:  []string{"e"},
+			Usage:    "the exchange to execute the parent order on",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "asset",
+			Aliases:  []string{"a"},
+			Usage:    "the asset type of the currency pair",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "pair",
+			Aliases:  []string{"p"},
+			Usage:    "the currency pair",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "side",
+			Usage:    "the order side to use (BUY OR SELL)",
+			Required: true,
+		},
+		&cli.Float64Flag{
+			Name:     "amount",
+			Usage:    "the total amount of the parent order",
+			Required: true,
+		},
+	}
+	executionOrderScheduleFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "the time to begin execution, defaults to now. formatted as: " + time.DateTime,
+		},
+		&cli.StringFlag{
+			Name:     "end",
+			Usage:    "the time execution must complete by. formatted as: " + time.DateTime,
+			Required: true,
+		},
+		&cli.Int64Flag{
+			Name:     "slices",
+			Usage:    "the number of child orders to split the parent order into",
+			Required: true,
+		},
+		&cli.Float64Flag{
+			Name:  "limit_price",
+			Usage: "submits child orders as limit orders at this price, otherwise market orders are used",
+		},
+	}
+	executionOrderIDFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the parent order id",
+		},
+	}
+)
+
+var algoExecutionCommands = &cli.Command{
+	Name:      "execution",
+	Usage:     "create and manage TWAP, VWAP and iceberg parent orders which are executed as a series of child orders",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "twap",
+			Usage:  "splits a parent order evenly over time",
+			Flags:  append(append([]cli.Flag{}, executionOrderBaseFlags...), executionOrderScheduleFlags...),
+			Action: createExecutionOrder,
+		},
+		{
+			Name:  "vwap",
+			Usage: "splits a parent order over time in proportion to historic volume traded at each time of day",
+			Flags: append(append(append([]cli.Flag{}, executionOrderBaseFlags...), executionOrderScheduleFlags...),
+				&cli.Int64Flag{
+					Name:  "volume_interval",
+					Usage: "the candle interval in seconds used to build the volume profile, defaults to 3600",
+				},
+				&cli.DurationFlag{
+					Name:  "volume_lookback",
+					Usage: "the period of historic candles used to build the volume profile, defaults to 168h",
+				},
+			),
+			Action: createExecutionOrder,
+		},
+		{
+			Name:  "iceberg",
+			Usage: "shows a visible slice of a parent order, refilling it each time the resting child order fills",
+			Flags: append(append([]cli.Flag{}, executionOrderBaseFlags...),
+				&cli.Float64Flag{
+					Name:     "visible_amount",
+					Usage:    "the amount of each resting child order",
+					Required: true,
+				},
+				&cli.Float64Flag{
+					Name:     "limit_price",
+					Usage:    "the price of each child order",
+					Required: true,
+				},
+			),
+			Action: createExecutionOrder,
+		},
+		{
+			Name:      "pause",
+			Usage:     "stops new child orders being submitted for a parent order",
+			ArgsUsage: "<id>",
+			Flags:     executionOrderIDFlags,
+			Action:    setExecutionOrderStatus,
+		},
+		{
+			Name:      "resume",
+			Usage:     "continues a paused parent order",
+			ArgsUsage: "<id>",
+			Flags:     executionOrderIDFlags,
+			Action:    setExecutionOrderStatus,
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels a parent order along with its resting child orders",
+			ArgsUsage: "<id>",
+			Flags:     executionOrderIDFlags,
+			Action:    setExecutionOrderStatus,
+		},
+		{
+			Name:      "status",
+			Usage:     "returns a parent order's child orders and slippage summary",
+			ArgsUsage: "<id>",
+			Flags:     executionOrderIDFlags,
+			Action:    getExecutionOrder,
+		},
+		{
+			Name:   "list",
+			Usage:  "returns all parent orders",
+			Action: getExecutionOrders,
+		},
+	},
+}
+
+func createExecutionOrder(c *cli.Context) error {
+	if c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	assetType := c.String("asset")
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+	pair := c.String("pair")
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return fmt.Errorf("cannot process pair: %w", err)
+	}
+
+	request := &gctrpc.CreateExecutionOrderRequest{
+		Exchange: c.String("exchange"),
+		Asset:    assetType,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Side:           c.String("side"),
+		Strategy:       c.Command.Name,
+		Amount:         c.Float64("amount"),
+		LimitPrice:     c.Float64("limit_price"),
+		Slices:         c.Int64("slices"),
+		VisibleAmount:  c.Float64("visible_amount"),
+		VolumeInterval: int64(time.Duration(c.Int64("volume_interval")) * time.Second),
+		VolumeLookback: int64(c.Duration("volume_lookback")),
+	}
+	if c.IsSet("start") {
+		var s time.Time
+		s, err = time.ParseInLocation(time.DateTime, c.String("start"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for start: %v", err)
+		}
+		request.StartTime = s.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if c.IsSet("end") {
+		var e time.Time
+		e, err = time.ParseInLocation(time.DateTime, c.String("end"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for end: %v", err)
+		}
+		request.EndTime = e.Format(common.SimpleTimeFormatWithTimezone)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.CreateExecutionOrder(c.Context, request)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func setExecutionOrderStatus(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errExecutionOrderIDUnset
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	request := &gctrpc.ExecutionOrderRequest{Id: id}
+	var result *gctrpc.GenericResponse
+	switch c.Command.Name {
+	case "pause":
+		result, err = client.PauseExecutionOrder(c.Context, request)
+	case "resume":
+		result, err = client.ResumeExecutionOrder(c.Context, request)
+	case "cancel":
+		result, err = client.CancelExecutionOrder(c.Context, request)
+	default:
+		return fmt.Errorf("unable to modify execution order, unrecognised command '%v'", c.Command.Name)
+	}
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getExecutionOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errExecutionOrderIDUnset
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetExecutionOrder(c.Context, &gctrpc.ExecutionOrderRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getExecutionOrders(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetExecutionOrders(c.Context, &gctrpc.GetExecutionOrdersRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -1672,6 +1672,125 @@ func simulateOrder(c *cli.Context) error {
 	return nil
 }
 
+var routeOrderCommand = &cli.Command{
+	Name:      "routeorder",
+	Usage:     "splits an order across exchanges using their consolidated orderbook depth, taker fees and available balances",
+	ArgsUsage: "<pair> <asset> <side> <amount>",
+	Action:    routeOrder,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side to use (BUY OR SELL)",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the amount for the order",
+		},
+		&cli.Float64Flag{
+			Name:  "price",
+			Usage: "excludes liquidity priced worse than this and submits limit orders at this price, otherwise market orders are used",
+		},
+		&cli.StringSliceFlag{
+			Name:  "exchanges",
+			Usage: "limits routing to the supplied exchanges, all enabled exchanges are used when unset",
+		},
+		&cli.BoolFlag{
+			Name:  "dryrun",
+			Usage: "returns the routing plan without submitting any orders",
+		},
+	},
+}
+
+func routeOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().First()
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(2)
+	}
+	if orderSide == "" {
+		return errors.New("side must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RouteOrder(c.Context, &gctrpc.RouteOrderRequest{
+		Exchanges: c.StringSlice("exchanges"),
+		Asset:     assetType,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Side:       orderSide,
+		Amount:     amount,
+		LimitPrice: c.Float64("price"),
+		DryRun:     c.Bool("dryrun"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var cancelOrderCommand = &cli.Command{
 	Name:      "cancelorder",
 	Usage:     "cancel order cancels an exchange order",
@@ -3335,6 +3454,187 @@ func getExchangeTickerStream(c *cli.Context) error {
 	}
 }
 
+var getArbitrageOpportunityStreamCommand = &cli.Command{
+	Name:      "getarbitrageopportunitystream",
+	Usage:     "streams arbitrage opportunities between exchanges net of trading and withdrawal fees",
+	ArgsUsage: "<pair> <asset> <minspread>",
+	Action:    getArbitrageOpportunityStream,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "only streams opportunities for the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "only streams opportunities for the asset type",
+		},
+		&cli.Float64Flag{
+			Name:  "minspread",
+			Usage: "the minimum net spread in basis points of streamed opportunities",
+		},
+		&cli.StringSliceFlag{
+			Name:  "exchanges",
+			Usage: "only streams opportunities between the supplied exchanges",
+		},
+	},
+}
+
+func getArbitrageOpportunityStream(c *cli.Context) error {
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var minSpread float64
+	if c.IsSet("minspread") {
+		minSpread = c.Float64("minspread")
+	} else if c.Args().Get(2) != "" {
+		var err error
+		minSpread, err = strconv.ParseFloat(c.Args().Get(2), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	req := &gctrpc.GetArbitrageOpportunityStreamRequest{
+		Exchanges:                c.StringSlice("exchanges"),
+		Asset:                    assetType,
+		MinimumSpreadBasisPoints: minSpread,
+	}
+	if currencyPair != "" {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		req.Pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetArbitrageOpportunityStream(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
+var getTriangularArbitrageStreamCommand = &cli.Command{
+	Name:      "gettriangulararbitragestream",
+	Usage:     "streams profitable triangular cycles between the enabled pairs of an exchange",
+	ArgsUsage: "<exchange> <asset> <start>",
+	Action:    getTriangularArbitrageStream,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to find cycles on",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the exchange's pairs, defaults to spot",
+		},
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "only streams cycles which start and end in this currency",
+		},
+		&cli.Float64Flag{
+			Name:  "maxamount",
+			Usage: "the maximum amount of the start currency to use",
+		},
+		&cli.Float64Flag{
+			Name:  "minprofit",
+			Usage: "the minimum return in basis points of streamed cycles",
+		},
+	},
+}
+
+func getTriangularArbitrageStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var start string
+	if c.IsSet("start") {
+		start = c.String("start")
+	} else {
+		start = c.Args().Get(2)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetTriangularArbitrageStream(c.Context,
+		&gctrpc.GetTriangularArbitrageStreamRequest{
+			Exchange:                 exchangeName,
+			Asset:                    assetType,
+			StartCurrency:            start,
+			MaximumAmount:            c.Float64("maxamount"),
+			MinimumProfitBasisPoints: c.Float64("minprofit"),
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
 var getAuditEventCommand = &cli.Command{
 	Name:      "getauditevent",
 	Usage:     "gets audit events matching query parameters",
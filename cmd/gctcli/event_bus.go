@@ -0,0 +1,119 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var eventBusCommands = &cli.Command{
+	Name:      "eventbus",
+	Usage:     "consume typed events published across the bot and review event bus lag",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "stream",
+			Usage:     "streams events published to a topic",
+			ArgsUsage: "<topic>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "topic",
+					Usage: "the topic to stream 'ticker', 'orderbook', 'trade', 'fill', 'order', 'account' or 'position'",
+				},
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "only streams events from the supplied exchange",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "only streams events for the supplied asset type",
+				},
+				&cli.StringFlag{
+					Name:  "pair",
+					Usage: "only streams events for the supplied currency pair",
+				},
+				&cli.Int64Flag{
+					Name:  "buffer",
+					Usage: "the number of events buffered before the drop policy applies, defaults to 1000",
+				},
+				&cli.StringFlag{
+					Name:  "droppolicy",
+					Usage: "the events discarded when the buffer is full 'newest' or 'oldest'",
+					Value: "newest",
+				},
+			},
+			Action: getEventStream,
+		},
+		{
+			Name:   "metrics",
+			Usage:  "returns publish counters for each topic and the delivery lag of each subscriber",
+			Action: getEventBusMetrics,
+		},
+	},
+}
+
+func getEventStream(c *cli.Context) error {
+	topic := c.String("topic")
+	if !c.IsSet("topic") {
+		topic = c.Args().First()
+	}
+
+	var pair *gctrpc.CurrencyPair
+	if pairString := c.String("pair"); pairString != "" {
+		if !validPair(pairString) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(pairString, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetEventStream(c.Context, &gctrpc.GetEventStreamRequest{
+		Topic:      topic,
+		Exchange:   c.String("exchange"),
+		Asset:      c.String("asset"),
+		Pair:       pair,
+		BufferSize: c.Int64("buffer"),
+		DropPolicy: c.String("droppolicy"),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
+func getEventBusMetrics(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetEventBusMetrics(c.Context, &gctrpc.GetEventBusMetricsRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var feeCommands = &cli.Command{
+	Name:      "fees",
+	Usage:     "review exchange account fee tiers and estimate order costs",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "schedule",
+			Usage:     "returns an exchange account's fee tiers and the tier predicted from tracked trade volume",
+			ArgsUsage: "<exchange> <asset>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the exchange to get the fee schedule for",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type of the fee schedule",
+					Value:   "spot",
+				},
+			},
+			Action: getFeeSchedule,
+		},
+		{
+			Name:      "estimate",
+			Usage:     "estimates the fee and total cost of an order under the exchange account's fee schedule",
+			ArgsUsage: "<exchange> <pair> <side> <type> <amount> <price>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the exchange the order would be submitted to",
+				},
+				&cli.StringFlag{
+					Name:    "pair",
+					Aliases: []string{"p"},
+					Usage:   "the currency pair e.g. btc-usdt",
+				},
+				&cli.StringFlag{
+					Name:    "side",
+					Aliases: []string{"s"},
+					Usage:   "the order side e.g. buy or sell",
+				},
+				&cli.StringFlag{
+					Name:    "type",
+					Aliases: []string{"t"},
+					Usage:   "the order type e.g. market or limit",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the amount of the base currency",
+				},
+				&cli.Float64Flag{
+					Name:  "price",
+					Usage: "the order price, market orders are priced from the exchange's ticker when unset",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type",
+					Value:   "spot",
+				},
+				&cli.BoolFlag{
+					Name:  "postonly",
+					Usage: "estimate the order as a maker order",
+				},
+			},
+			Action: estimateOrderCost,
+		},
+	},
+}
+
+func getFeeSchedule(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
+		exchangeName = c.Args().First()
+	}
+	assetType := c.String("asset")
+	if !c.IsSet("asset") && c.Args().Get(1) != "" {
+		assetType = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetFeeSchedule(c.Context, &gctrpc.GetFeeScheduleRequest{
+		Exchange: exchangeName,
+		Asset:    assetType,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func estimateOrderCost(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
+		exchangeName = c.Args().First()
+	}
+	pairString := c.String("pair")
+	if !c.IsSet("pair") {
+		pairString = c.Args().Get(1)
+	}
+	p, err := currency.NewPairDelimiter(pairString, pairDelimiter)
+	if err != nil {
+		return err
+	}
+	side := c.String("side")
+	if !c.IsSet("side") {
+		side = c.Args().Get(2)
+	}
+	orderType := c.String("type")
+	if !c.IsSet("type") {
+		orderType = c.Args().Get(3)
+	}
+	amount := c.Float64("amount")
+	if !c.IsSet("amount") && c.Args().Get(4) != "" {
+		amount, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+	price := c.Float64("price")
+	if !c.IsSet("price") && c.Args().Get(5) != "" {
+		price, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.EstimateOrderCost(c.Context, &gctrpc.EstimateOrderCostRequest{
+		Exchange: exchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Side:      side,
+		OrderType: orderType,
+		Amount:    amount,
+		Price:     price,
+		AssetType: c.String("asset"),
+		PostOnly:  c.Bool("postonly"),
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
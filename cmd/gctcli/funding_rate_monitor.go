@@ -0,0 +1,124 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var fundingRateMonitorCommands = &cli.Command{
+	Name:      "fundingmonitor",
+	Usage:     "review perpetual futures funding rates and cash and carry or funding spread opportunities",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "rates",
+			Usage:     "returns the latest funding rates of enabled perpetual futures ordered by annualised rate",
+			ArgsUsage: "<underlying>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "underlying",
+					Usage: "only returns funding rates for perpetuals of the underlying currency e.g. btc",
+				},
+				&cli.StringSliceFlag{
+					Name:  "exchanges",
+					Usage: "only returns funding rates from the supplied exchanges",
+				},
+			},
+			Action: getFundingRateMonitorRates,
+		},
+		{
+			Name:      "stream",
+			Usage:     "streams funding rate opportunities ordered by annualised carry",
+			ArgsUsage: "<underlying> <type> <mincarry>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "underlying",
+					Usage: "only streams opportunities for the underlying currency e.g. btc",
+				},
+				&cli.StringFlag{
+					Name:  "type",
+					Usage: "only streams opportunities of the type 'cash and carry', 'reverse cash and carry' or 'funding spread'",
+				},
+				&cli.Float64Flag{
+					Name:  "mincarry",
+					Usage: "the minimum annualised carry percentage of streamed opportunities",
+				},
+				&cli.StringSliceFlag{
+					Name:  "exchanges",
+					Usage: "only streams opportunities between the supplied exchanges",
+				},
+			},
+			Action: getFundingRateOpportunityStream,
+		},
+	},
+}
+
+func getFundingRateMonitorRates(c *cli.Context) error {
+	underlying := c.String("underlying")
+	if !c.IsSet("underlying") {
+		underlying = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetFundingRateMonitorRates(c.Context, &gctrpc.GetFundingRateMonitorRatesRequest{
+		Exchanges:  c.StringSlice("exchanges"),
+		Underlying: underlying,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getFundingRateOpportunityStream(c *cli.Context) error {
+	underlying := c.String("underlying")
+	if !c.IsSet("underlying") {
+		underlying = c.Args().First()
+	}
+	opportunityType := c.String("type")
+	if !c.IsSet("type") {
+		opportunityType = c.Args().Get(1)
+	}
+	minCarry := c.Float64("mincarry")
+	if !c.IsSet("mincarry") && c.Args().Get(2) != "" {
+		var err error
+		minCarry, err = strconv.ParseFloat(c.Args().Get(2), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetFundingRateOpportunityStream(c.Context, &gctrpc.GetFundingRateOpportunityStreamRequest{
+		Exchanges:              c.StringSlice("exchanges"),
+		Type:                   opportunityType,
+		Underlying:             underlying,
+		MinimumAnnualisedCarry: minCarry,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errGridIDUnset = errors.New("grid id must be set")
+
+var gridIDFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "id",
+		Usage: "the grid id",
+	},
+}
+
+var gridTradingCommands = &cli.Command{
+	Name:      "grid",
+	Usage:     "create and manage grids of limit orders between a lower and upper price",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "create",
+			Usage: "places a ladder of limit orders which are replaced on the opposite side one level away as they fill",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "exchange",
+					Aliases:  []string{"e"},
+					Usage:    "the exchange to place the grid on",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "asset",
+					Aliases:  []string{"a"},
+					Usage:    "the asset type of the currency pair",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "pair",
+					Aliases:  []string{"p"},
+					Usage:    "the currency pair",
+					Required: true,
+				},
+				&cli.Float64Flag{
+					Name:     "lower",
+					Usage:    "the price of the lowest grid level",
+					Required: true,
+				},
+				&cli.Float64Flag{
+					Name:     "upper",
+					Usage:    "the price of the highest grid level",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:     "levels",
+					Usage:    "the number of grid levels including the lower and upper price",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  "spacing",
+					Usage: "how level prices are distributed, 'arithmetic' for an equal price distance or 'geometric' for an equal percentage distance",
+					Value: "arithmetic",
+				},
+				&cli.Float64Flag{
+					Name:     "amount",
+					Usage:    "the base amount of each level's order",
+					Required: true,
+				},
+			},
+			Action: createGrid,
+		},
+		{
+			Name:      "stop",
+			Usage:     "stops a grid and cancels its resting orders",
+			ArgsUsage: "<id>",
+			Flags:     gridIDFlags,
+			Action:    stopGrid,
+		},
+		{
+			Name:      "status",
+			Usage:     "returns a grid's levels and realised profit",
+			ArgsUsage: "<id>",
+			Flags:     gridIDFlags,
+			Action:    getGrid,
+		},
+		{
+			Name:   "list",
+			Usage:  "returns all grids",
+			Action: getGrids,
+		},
+	},
+}
+
+func createGrid(c *cli.Context) error {
+	if c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	assetType := c.String("asset")
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+	pair := c.String("pair")
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return fmt.Errorf("cannot process pair: %w", err)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.CreateGrid(c.Context, &gctrpc.CreateGridRequest{
+		Exchange: c.String("exchange"),
+		Asset:    assetType,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		LowerPrice:     c.Float64("lower"),
+		UpperPrice:     c.Float64("upper"),
+		Levels:         c.Int64("levels"),
+		Spacing:        c.String("spacing"),
+		AmountPerLevel: c.Float64("amount"),
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func stopGrid(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errGridIDUnset
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.StopGrid(c.Context, &gctrpc.GridRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getGrid(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errGridIDUnset
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetGrid(c.Context, &gctrpc.GridRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getGrids(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetGrids(c.Context, &gctrpc.GetGridsRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var liquidationMonitorCommands = &cli.Command{
+	Name:      "liquidationmonitor",
+	Usage:     "review estimated liquidation prices and margin health of open futures positions",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "positions",
+			Usage:     "returns the health of each monitored futures position ordered by margin ratio",
+			ArgsUsage: "<level>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "level",
+					Usage: "only returns positions at or above the risk level 'healthy', 'warning' or 'critical'",
+				},
+				&cli.StringSliceFlag{
+					Name:  "exchanges",
+					Usage: "only returns positions held on the supplied exchanges",
+				},
+			},
+			Action: getLiquidationMonitorPositions,
+		},
+		{
+			Name:      "stream",
+			Usage:     "streams the health of monitored futures positions as they are re-evaluated",
+			ArgsUsage: "<level>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "level",
+					Usage: "only streams positions at or above the risk level 'healthy', 'warning' or 'critical'",
+				},
+				&cli.StringSliceFlag{
+					Name:  "exchanges",
+					Usage: "only streams positions held on the supplied exchanges",
+				},
+			},
+			Action: getLiquidationMonitorStream,
+		},
+	},
+}
+
+func getLiquidationMonitorPositions(c *cli.Context) error {
+	level := c.String("level")
+	if !c.IsSet("level") {
+		level = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetLiquidationMonitorPositions(c.Context, &gctrpc.GetLiquidationMonitorPositionsRequest{
+		Exchanges:    c.StringSlice("exchanges"),
+		MinimumLevel: level,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getLiquidationMonitorStream(c *cli.Context) error {
+	level := c.String("level")
+	if !c.IsSet("level") {
+		level = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetLiquidationMonitorStream(c.Context, &gctrpc.GetLiquidationMonitorStreamRequest{
+		Exchanges:    c.StringSlice("exchanges"),
+		MinimumLevel: level,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
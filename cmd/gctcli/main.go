@@ -186,6 +186,7 @@ func main() {
 		getOrderCommand,
 		submitOrderCommand,
 		simulateOrderCommand,
+		routeOrderCommand,
 		cancelOrderCommand,
 		cancelBatchOrdersCommand,
 		cancelAllOrdersCommand,
@@ -204,6 +205,8 @@ func main() {
 		exchangePairManagerCommand,
 		getTickerStreamCommand,
 		getExchangeTickerStreamCommand,
+		getArbitrageOpportunityStreamCommand,
+		getTriangularArbitrageStreamCommand,
 		getAuditEventCommand,
 		getHistoricCandlesCommand,
 		getHistoricCandlesExtendedCommand,
@@ -218,6 +221,19 @@ func main() {
 		technicalAnalysisCommand,
 		getMarginRatesHistoryCommand,
 		orderbookCommand,
+		algoExecutionCommands,
+		marketMakingCommands,
+		gridTradingCommands,
+		orderHistoryCommands,
+		orderReconciliationCommands,
+		rebalancerCommands,
+		portfolioSnapshotCommands,
+		accountingCommands,
+		feeCommands,
+		fundingRateMonitorCommands,
+		liquidationMonitorCommands,
+		optionCommands,
+		eventBusCommands,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
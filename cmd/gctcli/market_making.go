@@ -0,0 +1,236 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errMarketMakingStrategyIDUnset = errors.New("market making strategy id must be set")
+
+var marketMakingStrategyIDFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "id",
+		Usage: "the market making strategy id",
+	},
+}
+
+var marketMakingCommands = &cli.Command{
+	Name:      "marketmaking",
+	Usage:     "add and manage strategies which continuously quote two-sided markets",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "add",
+			Usage: "starts quoting layers of bids and asks around an inventory skewed fair value",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "exchange",
+					Aliases:  []string{"e"},
+					Usage:    "the exchange to quote on",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "asset",
+					Aliases:  []string{"a"},
+					Usage:    "the asset type of the currency pair",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "pair",
+					Aliases:  []string{"p"},
+					Usage:    "the currency pair",
+					Required: true,
+				},
+				&cli.Float64Flag{
+					Name:     "spread",
+					Usage:    "the distance in basis points between the bid and ask of the first layer",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:  "layers",
+					Usage: "the number of quotes maintained on each side",
+					Value: 1,
+				},
+				&cli.Float64Flag{
+					Name:  "layer_spacing",
+					Usage: "the additional distance in basis points of each layer after the first",
+				},
+				&cli.Float64Flag{
+					Name:     "amount",
+					Usage:    "the base amount quoted by each layer",
+					Required: true,
+				},
+				&cli.Float64Flag{
+					Name:  "target_inventory",
+					Usage: "the base holding quotes are skewed towards",
+				},
+				&cli.Float64Flag{
+					Name:  "max_inventory",
+					Usage: "the distance from the target inventory at which only the side reducing inventory is quoted, 0 disables inventory limits",
+				},
+				&cli.Float64Flag{
+					Name:  "skew",
+					Usage: "the distance in basis points the fair value is moved from the mid price when inventory is at its limit",
+				},
+				&cli.Float64Flag{
+					Name:  "requote_threshold",
+					Usage: "the distance in basis points a quote's price must move before its order is modified",
+				},
+				&cli.BoolFlag{
+					Name:  "post_only",
+					Usage: "submits quotes as post only orders",
+				},
+			},
+			Action: addMarketMakingStrategy,
+		},
+		{
+			Name:      "remove",
+			Usage:     "stops a market making strategy and cancels its quotes",
+			ArgsUsage: "<id>",
+			Flags:     marketMakingStrategyIDFlags,
+			Action:    removeMarketMakingStrategy,
+		},
+		{
+			Name:      "status",
+			Usage:     "returns a market making strategy's quotes, inventory and profit and loss",
+			ArgsUsage: "<id>",
+			Flags:     marketMakingStrategyIDFlags,
+			Action:    getMarketMakingStrategy,
+		},
+		{
+			Name:   "list",
+			Usage:  "returns all market making strategies",
+			Action: getMarketMakingStrategies,
+		},
+	},
+}
+
+func addMarketMakingStrategy(c *cli.Context) error {
+	if c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	assetType := c.String("asset")
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+	pair := c.String("pair")
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return fmt.Errorf("cannot process pair: %w", err)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.AddMarketMakingStrategy(c.Context, &gctrpc.AddMarketMakingStrategyRequest{
+		Exchange: c.String("exchange"),
+		Asset:    assetType,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		SpreadBasisPoints:           c.Float64("spread"),
+		Layers:                      c.Int64("layers"),
+		LayerSpacingBasisPoints:     c.Float64("layer_spacing"),
+		OrderAmount:                 c.Float64("amount"),
+		TargetInventory:             c.Float64("target_inventory"),
+		MaxInventory:                c.Float64("max_inventory"),
+		InventorySkewBasisPoints:    c.Float64("skew"),
+		RequoteThresholdBasisPoints: c.Float64("requote_threshold"),
+		PostOnly:                    c.Bool("post_only"),
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func removeMarketMakingStrategy(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errMarketMakingStrategyIDUnset
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RemoveMarketMakingStrategy(c.Context, &gctrpc.MarketMakingStrategyRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getMarketMakingStrategy(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errMarketMakingStrategyIDUnset
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetMarketMakingStrategy(c.Context, &gctrpc.MarketMakingStrategyRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getMarketMakingStrategies(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetMarketMakingStrategies(c.Context, &gctrpc.GetMarketMakingStrategiesRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var optionCommands = &cli.Command{
+	Name:      "options",
+	Usage:     "review option chains and derive implied volatility and greeks from orderbook prices",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "chain",
+			Usage:     "returns the option instruments listed by an exchange for an underlying",
+			ArgsUsage: "<exchange> <underlying> <expiry>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the exchange to get the option chain from",
+				},
+				&cli.StringFlag{
+					Name:    "underlying",
+					Aliases: []string{"u"},
+					Usage:   "the underlying currency pair e.g. btc-usd",
+				},
+				&cli.StringFlag{
+					Name:  "expiry",
+					Usage: "only returns options expiring on the same day e.g. 2024-06-28 08:00:00 UTC",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type",
+					Value:   "options",
+				},
+			},
+			Action: getOptionChain,
+		},
+		{
+			Name:      "greeks",
+			Usage:     "returns the implied volatility and greeks of an option priced from its orderbook mid",
+			ArgsUsage: "<exchange> <underlying> <pair>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the exchange the option is listed on",
+				},
+				&cli.StringFlag{
+					Name:    "underlying",
+					Aliases: []string{"u"},
+					Usage:   "the underlying currency pair e.g. btc-usd",
+				},
+				&cli.StringFlag{
+					Name:    "pair",
+					Aliases: []string{"p"},
+					Usage:   "the option pair e.g. btc-usd-240628-60000-c",
+				},
+				&cli.Float64Flag{
+					Name:  "underlyingprice",
+					Usage: "the price of the underlying, defaults to the exchange's forward price or else the underlying's spot price",
+				},
+				&cli.Float64Flag{
+					Name:  "riskfreerate",
+					Usage: "the annualised risk free rate e.g. 0.05 for 5%",
+				},
+				&cli.StringFlag{
+					Name:  "model",
+					Usage: "the pricing model 'black-scholes' or 'black-76', defaults to black-76 when a forward price is available",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type",
+					Value:   "options",
+				},
+			},
+			Action: getOptionGreeks,
+		},
+	},
+}
+
+func getOptionChain(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
+		exchangeName = c.Args().First()
+	}
+	underlyingString := c.String("underlying")
+	if !c.IsSet("underlying") {
+		underlyingString = c.Args().Get(1)
+	}
+	if !validPair(underlyingString) {
+		return errInvalidPair
+	}
+	underlying, err := currency.NewPairDelimiter(underlyingString, pairDelimiter)
+	if err != nil {
+		return err
+	}
+	expiry := c.String("expiry")
+	if !c.IsSet("expiry") {
+		expiry = c.Args().Get(2)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetOptionChain(c.Context, &gctrpc.GetOptionChainRequest{
+		Exchange: exchangeName,
+		Asset:    c.String("asset"),
+		Underlying: &gctrpc.CurrencyPair{
+			Delimiter: underlying.Delimiter,
+			Base:      underlying.Base.String(),
+			Quote:     underlying.Quote.String(),
+		},
+		Expiry: expiry,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getOptionGreeks(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
+		exchangeName = c.Args().First()
+	}
+	underlyingString := c.String("underlying")
+	if !c.IsSet("underlying") {
+		underlyingString = c.Args().Get(1)
+	}
+	if !validPair(underlyingString) {
+		return errInvalidPair
+	}
+	underlying, err := currency.NewPairDelimiter(underlyingString, pairDelimiter)
+	if err != nil {
+		return err
+	}
+	pairString := c.String("pair")
+	if !c.IsSet("pair") {
+		pairString = c.Args().Get(2)
+	}
+	if !validPair(pairString) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(pairString, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetOptionGreeks(c.Context, &gctrpc.GetOptionGreeksRequest{
+		Exchange: exchangeName,
+		Asset:    c.String("asset"),
+		Underlying: &gctrpc.CurrencyPair{
+			Delimiter: underlying.Delimiter,
+			Base:      underlying.Base.String(),
+			Quote:     underlying.Quote.String(),
+		},
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		UnderlyingPrice: c.Float64("underlyingprice"),
+		RiskFreeRate:    c.Float64("riskfreerate"),
+		Model:           c.String("model"),
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errInternalOrderIDUnset = errors.New("internal order id must be set")
+
+var orderHistoryCommands = &cli.Command{
+	Name:      "orderhistory",
+	Usage:     "queries orders and their lifecycle events recorded to the database by the order manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "search",
+			Usage: "returns recorded orders, all filters are optional",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the exchange the orders were placed on",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type of the orders",
+				},
+				&cli.StringFlag{
+					Name:    "pair",
+					Aliases: []string{"p"},
+					Usage:   "the currency pair of the orders",
+				},
+				&cli.StringSliceFlag{
+					Name:    "status",
+					Aliases: []string{"s"},
+					Usage:   "order statuses to include, can be set multiple times",
+				},
+				&cli.StringFlag{
+					Name:  "start",
+					Usage: "returns orders created at or after this date",
+				},
+				&cli.StringFlag{
+					Name:  "end",
+					Usage: "returns orders created at or before this date",
+				},
+				&cli.Int64Flag{
+					Name:  "limit",
+					Usage: "the maximum number of orders to return, 0 returns all",
+				},
+			},
+			Action: searchOrderHistory,
+		},
+		{
+			Name:      "audit",
+			Usage:     "returns a recorded order and every lifecycle event recorded for it",
+			ArgsUsage: "<id>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the internal order id",
+				},
+			},
+			Action: getOrderAuditTrail,
+		},
+	},
+}
+
+func searchOrderHistory(c *cli.Context) error {
+	req := &gctrpc.SearchOrderHistoryRequest{
+		Exchange: c.String("exchange"),
+		Statuses: c.StringSlice("status"),
+		Limit:    c.Int64("limit"),
+	}
+	if c.IsSet("asset") {
+		req.Asset = strings.ToLower(c.String("asset"))
+		if !validAsset(req.Asset) {
+			return errInvalidAsset
+		}
+	}
+	if c.IsSet("pair") {
+		pair := c.String("pair")
+		if !validPair(pair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+		if err != nil {
+			return fmt.Errorf("cannot process pair: %w", err)
+		}
+		req.Pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+	if c.IsSet("start") {
+		s, err := time.ParseInLocation(time.DateTime, c.String("start"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for start: %v", err)
+		}
+		req.StartDate = s.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if c.IsSet("end") {
+		e, err := time.ParseInLocation(time.DateTime, c.String("end"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for end: %v", err)
+		}
+		req.EndDate = e.Format(common.SimpleTimeFormatWithTimezone)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.SearchOrderHistory(c.Context, req)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getOrderAuditTrail(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errInternalOrderIDUnset
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetOrderAuditTrail(c.Context, &gctrpc.GetOrderAuditTrailRequest{InternalOrderId: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var orderReconciliationCommands = &cli.Command{
+	Name:      "orderreconciliation",
+	Usage:     "reconciles orders tracked by the order manager against exchange state",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "reconcile",
+			Usage:     "diffs tracked orders against an exchange's active orders and order history, updating changed orders",
+			ArgsUsage: "<exchange>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the exchange to reconcile orders with",
+				},
+			},
+			Action: reconcileOrders,
+		},
+		{
+			Name:      "reports",
+			Usage:     "returns the most recent reconciliation report for each exchange",
+			ArgsUsage: "<exchange>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "optionally limits reports to an exchange",
+				},
+			},
+			Action: getOrderReconciliations,
+		},
+	},
+}
+
+func reconcileOrders(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ReconcileOrders(c.Context, &gctrpc.ReconcileOrdersRequest{Exchange: exchangeName})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getOrderReconciliations(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetOrderReconciliations(c.Context, &gctrpc.GetOrderReconciliationsRequest{Exchange: exchangeName})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var portfolioDateRangeFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "start",
+		Usage: "start date, optional. Will filter any snapshots before this date e.g. " + time.Now().AddDate(0, -1, 0).Format(time.DateTime),
+	},
+	&cli.StringFlag{
+		Name:  "end",
+		Usage: "end date, optional. Will filter any snapshots after this date e.g. " + time.Now().Format(time.DateTime),
+	},
+}
+
+var portfolioSnapshotCommands = &cli.Command{
+	Name:      "portfoliosnapshots",
+	Usage:     "review stored fiat valuations of exchange holdings and offline addresses",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "list",
+			Usage:  "returns the snapshots taken between the start and end dates",
+			Flags:  portfolioDateRangeFlags,
+			Action: getPortfolioSnapshots,
+		},
+		{
+			Name:   "performance",
+			Usage:  "returns the equity curve, drawdown and per currency PnL contributions between the start and end dates",
+			Flags:  portfolioDateRangeFlags,
+			Action: getPortfolioPerformance,
+		},
+	},
+}
+
+// portfolioDateRange converts the local start and end flags to a request
+func portfolioDateRange(c *cli.Context) (*gctrpc.PortfolioDateRangeRequest, error) {
+	var s, e time.Time
+	var err error
+	if c.IsSet("start") {
+		s, err = time.ParseInLocation(time.DateTime, c.String("start"), time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time format for start: %v", err)
+		}
+	}
+	if c.IsSet("end") {
+		e, err = time.ParseInLocation(time.DateTime, c.String("end"), time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time format for end: %v", err)
+		}
+	}
+	if !s.IsZero() && !e.IsZero() && e.Before(s) {
+		return nil, common.ErrStartAfterEnd
+	}
+	r := &gctrpc.PortfolioDateRangeRequest{}
+	if !s.IsZero() {
+		r.StartDate = s.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if !e.IsZero() {
+		r.EndDate = e.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return r, nil
+}
+
+func getPortfolioSnapshots(c *cli.Context) error {
+	r, err := portfolioDateRange(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPortfolioSnapshots(c.Context, r)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getPortfolioPerformance(c *cli.Context) error {
+	r, err := portfolioDateRange(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPortfolioPerformance(c.Context, r)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	errRebalanceIDUnset       = errors.New("rebalance strategy id must be set")
+	errInvalidRebalanceTarget = errors.New("target must be formatted as currency:weight")
+)
+
+var rebalanceIDFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "id",
+		Usage: "the rebalance strategy id",
+	},
+}
+
+var rebalancerCommands = &cli.Command{
+	Name:      "rebalancer",
+	Usage:     "manage strategies which trade holdings across exchanges back towards target weights",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "add",
+			Usage: "values holdings of the target currencies and trades them against the settlement currency when they drift",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:     "exchange",
+					Aliases:  []string{"e"},
+					Usage:    "an exchange whose holdings are included, may be repeated",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type holdings are traded in",
+					Value:   "spot",
+				},
+				&cli.StringFlag{
+					Name:     "settlement",
+					Aliases:  []string{"s"},
+					Usage:    "the currency holdings are valued in and traded against",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:     "target",
+					Aliases:  []string{"t"},
+					Usage:    "a target allocation formatted as currency:weight e.g. btc:0.5, may be repeated and weights must sum to one",
+					Required: true,
+				},
+				&cli.Float64Flag{
+					Name:  "tolerance",
+					Usage: "the absolute difference between a holding's weight and its target weight allowed before rebalancing e.g. 0.05",
+				},
+				&cli.StringFlag{
+					Name:  "trigger",
+					Usage: "'threshold' to check drift every check interval or 'scheduled' to check it every interval, rebalancing when drift exceeds the tolerance",
+					Value: "threshold",
+				},
+				&cli.DurationFlag{
+					Name:  "interval",
+					Usage: "the duration between scheduled drift checks e.g. 24h",
+				},
+			},
+			Action: addRebalanceStrategy,
+		},
+		{
+			Name:      "remove",
+			Usage:     "stops a rebalance strategy",
+			ArgsUsage: "<id>",
+			Flags:     rebalanceIDFlags,
+			Action:    removeRebalanceStrategy,
+		},
+		{
+			Name:      "plan",
+			Usage:     "returns the valuation and trades required to rebalance a strategy without placing them",
+			ArgsUsage: "<id>",
+			Flags:     rebalanceIDFlags,
+			Action:    getRebalancePlan,
+		},
+		{
+			Name:      "execute",
+			Usage:     "immediately trades a strategy's holdings back to their target weights",
+			ArgsUsage: "<id>",
+			Flags:     rebalanceIDFlags,
+			Action:    executeRebalance,
+		},
+		{
+			Name:   "list",
+			Usage:  "returns all rebalance strategies",
+			Action: getRebalanceStrategies,
+		},
+	},
+}
+
+func addRebalanceStrategy(c *cli.Context) error {
+	if c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	assetType := c.String("asset")
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+	targets, err := parseRebalanceTargets(c.StringSlice("target"))
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.AddRebalanceStrategy(c.Context, &gctrpc.AddRebalanceStrategyRequest{
+		Exchanges:          c.StringSlice("exchange"),
+		Asset:              assetType,
+		SettlementCurrency: c.String("settlement"),
+		Targets:            targets,
+		DriftTolerance:     c.Float64("tolerance"),
+		Trigger:            c.String("trigger"),
+		Interval:           int64(c.Duration("interval")),
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+// parseRebalanceTargets converts currency:weight arguments to target
+// allocations
+func parseRebalanceTargets(args []string) ([]*gctrpc.RebalanceTarget, error) {
+	targets := make([]*gctrpc.RebalanceTarget, len(args))
+	for i := range args {
+		code, weight, ok := strings.Cut(args[i], ":")
+		if !ok || code == "" {
+			return nil, fmt.Errorf("%w, received %q", errInvalidRebalanceTarget, args[i])
+		}
+		w, err := strconv.ParseFloat(weight, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w, received %q: %v", errInvalidRebalanceTarget, args[i], err)
+		}
+		targets[i] = &gctrpc.RebalanceTarget{Currency: code, Weight: w}
+	}
+	return targets, nil
+}
+
+// rebalanceID returns the strategy id from the id flag or first argument
+func rebalanceID(c *cli.Context) (string, error) {
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return "", errRebalanceIDUnset
+	}
+	return id, nil
+}
+
+func removeRebalanceStrategy(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	id, err := rebalanceID(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RemoveRebalanceStrategy(c.Context, &gctrpc.RebalanceStrategyRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getRebalancePlan(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	id, err := rebalanceID(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetRebalancePlan(c.Context, &gctrpc.RebalanceStrategyRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func executeRebalance(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	id, err := rebalanceID(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ExecuteRebalance(c.Context, &gctrpc.RebalanceStrategyRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getRebalanceStrategies(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetRebalanceStrategies(c.Context, &gctrpc.GetRebalanceStrategiesRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
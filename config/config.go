@@ -23,6 +23,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -1423,6 +1424,204 @@ func (c *Config) CheckCurrencyStateManager() {
 	}
 }
 
+// CheckPaperTradingConfig ensures the paper trading config is valid, or sets
+// default values
+func (c *Config) CheckPaperTradingConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.PaperTrading.MatchInterval <= 0 {
+		c.PaperTrading.MatchInterval = defaultPaperTradingMatchInterval
+	}
+	for i := len(c.PaperTrading.Balances) - 1; i >= 0; i-- {
+		b := c.PaperTrading.Balances[i]
+		if b.Exchange != "" && b.Asset.IsValid() && !b.Currency.IsEmpty() && b.Amount > 0 {
+			continue
+		}
+		log.Warnf(log.ConfigMgr, "Paper trading balance %d is invalid and has been removed. Exchange: %q Asset: %q Currency: %q Amount: %v\n",
+			i, b.Exchange, b.Asset, b.Currency, b.Amount)
+		c.PaperTrading.Balances = append(c.PaperTrading.Balances[:i], c.PaperTrading.Balances[i+1:]...)
+	}
+}
+
+// CheckRiskManagerConfig ensures the risk manager config is valid, or sets
+// default values
+func (c *Config) CheckRiskManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.RiskManager.CheckInterval <= 0 {
+		c.RiskManager.CheckInterval = DefaultRiskManagerCheckInterval
+	}
+	for i := len(c.RiskManager.Limits) - 1; i >= 0; i-- {
+		l := c.RiskManager.Limits[i]
+		if l.MaxOrderNotional >= 0 &&
+			l.MaxOpenOrders >= 0 &&
+			l.MaxPositionSize >= 0 &&
+			l.MaxDailyLoss >= 0 &&
+			l.PriceBandPercentage >= 0 {
+			continue
+		}
+		log.Warnf(log.ConfigMgr, "Risk manager limit %d for exchange %q asset %q pair %q contains negative values and has been removed\n",
+			i, l.Exchange, l.Asset, l.Pair)
+		c.RiskManager.Limits = append(c.RiskManager.Limits[:i], c.RiskManager.Limits[i+1:]...)
+	}
+}
+
+// CheckAlgoExecutionManagerConfig ensures the algo execution manager config is
+// valid, or sets default values
+func (c *Config) CheckAlgoExecutionManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.AlgoExecutionManager.CheckInterval <= 0 {
+		c.AlgoExecutionManager.CheckInterval = DefaultAlgoExecutionCheckInterval
+	}
+}
+
+// CheckArbitrageScannerConfig ensures the arbitrage scanner config is valid,
+// or sets default values
+func (c *Config) CheckArbitrageScannerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.ArbitrageScanner.CheckInterval <= 0 {
+		c.ArbitrageScanner.CheckInterval = DefaultArbitrageScannerCheckInterval
+	}
+	if c.ArbitrageScanner.AlertCooldown <= 0 {
+		c.ArbitrageScanner.AlertCooldown = DefaultArbitrageAlertCooldown
+	}
+	if c.ArbitrageScanner.AlertThresholdBasisPoints < 0 {
+		log.Warnf(log.ConfigMgr, "Arbitrage scanner alert threshold %v cannot be negative, alerts disabled\n",
+			c.ArbitrageScanner.AlertThresholdBasisPoints)
+		c.ArbitrageScanner.AlertThresholdBasisPoints = 0
+	}
+}
+
+// CheckMarketMakingManagerConfig ensures the market making manager config is
+// valid, or sets default values
+func (c *Config) CheckMarketMakingManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.MarketMakingManager.RefreshInterval <= 0 {
+		c.MarketMakingManager.RefreshInterval = DefaultMarketMakingRefreshInterval
+	}
+}
+
+// CheckGridTradingManagerConfig ensures the grid trading manager config is
+// valid, or sets default values
+func (c *Config) CheckGridTradingManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.GridTradingManager.CheckInterval <= 0 {
+		c.GridTradingManager.CheckInterval = DefaultGridTradingCheckInterval
+	}
+}
+
+// CheckMetricsManagerConfig ensures the metrics manager config is valid, or
+// sets default values
+func (c *Config) CheckMetricsManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.MetricsManager.ListenAddress == "" {
+		c.MetricsManager.ListenAddress = DefaultMetricsListenAddress
+	}
+	if c.MetricsManager.Path == "" {
+		c.MetricsManager.Path = DefaultMetricsPath
+	}
+	if !strings.HasPrefix(c.MetricsManager.Path, "/") {
+		c.MetricsManager.Path = "/" + c.MetricsManager.Path
+	}
+}
+
+// CheckPortfolioRebalancerConfig ensures the portfolio rebalancer config is
+// valid, or sets default values
+func (c *Config) CheckPortfolioRebalancerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.PortfolioRebalancer.CheckInterval <= 0 {
+		c.PortfolioRebalancer.CheckInterval = DefaultRebalancerCheckInterval
+	}
+}
+
+// CheckPortfolioSnapshotConfig ensures the portfolio snapshot manager config
+// is valid, or sets default values
+func (c *Config) CheckPortfolioSnapshotConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.PortfolioSnapshot.SnapshotInterval <= 0 {
+		c.PortfolioSnapshot.SnapshotInterval = DefaultPortfolioSnapshotInterval
+	}
+	if c.PortfolioSnapshot.FiatCurrency.IsEmpty() {
+		c.PortfolioSnapshot.FiatCurrency = currency.USD
+	}
+}
+
+// CheckFundingRateMonitorConfig ensures the funding rate monitor config is
+// valid, or sets default values
+func (c *Config) CheckFundingRateMonitorConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.FundingRateMonitor.CheckInterval <= 0 {
+		c.FundingRateMonitor.CheckInterval = DefaultFundingRateMonitorCheckInterval
+	}
+	if c.FundingRateMonitor.AlertCooldown <= 0 {
+		c.FundingRateMonitor.AlertCooldown = DefaultFundingRateAlertCooldown
+	}
+	if c.FundingRateMonitor.AlertThresholdAnnualisedCarry < 0 {
+		log.Warnf(log.ConfigMgr, "Funding rate monitor alert threshold %v cannot be negative, alerts disabled\n",
+			c.FundingRateMonitor.AlertThresholdAnnualisedCarry)
+		c.FundingRateMonitor.AlertThresholdAnnualisedCarry = 0
+	}
+}
+
+// CheckLiquidationMonitorConfig ensures the liquidation monitor thresholds
+// and auto deleverage settings are valid
+func (c *Config) CheckLiquidationMonitorConfig() {
+	m.Lock()
+	defer m.Unlock()
+	l := &c.LiquidationMonitor
+	if l.CheckInterval <= 0 {
+		l.CheckInterval = DefaultLiquidationMonitorCheckInterval
+	}
+	if l.AlertCooldown <= 0 {
+		l.AlertCooldown = DefaultLiquidationAlertCooldown
+	}
+	if mt, err := margin.StringToMarginType(l.MarginType); err != nil || mt == margin.Unset {
+		if err != nil {
+			log.Warnf(log.ConfigMgr, "Liquidation monitor %v, defaulting to isolated\n", err)
+		}
+		l.MarginType = margin.Isolated.String()
+	}
+	if l.MaintenanceMarginRate <= 0 || l.MaintenanceMarginRate >= 1 {
+		l.MaintenanceMarginRate = DefaultLiquidationMaintenanceMarginRate
+	}
+	if l.WarningMarginRatio <= 0 {
+		l.WarningMarginRatio = DefaultLiquidationWarningMarginRatio
+	}
+	if l.CriticalMarginRatio <= 0 {
+		l.CriticalMarginRatio = DefaultLiquidationCriticalMarginRatio
+	}
+	if l.WarningMarginRatio >= l.CriticalMarginRatio || l.CriticalMarginRatio > 1 {
+		log.Warnf(log.ConfigMgr, "Liquidation monitor warning margin ratio %v must be below critical margin ratio %v which cannot exceed 1, using defaults\n",
+			l.WarningMarginRatio, l.CriticalMarginRatio)
+		l.WarningMarginRatio = DefaultLiquidationWarningMarginRatio
+		l.CriticalMarginRatio = DefaultLiquidationCriticalMarginRatio
+	}
+	if !l.AutoDeleverage.Enabled {
+		return
+	}
+	switch l.AutoDeleverage.Action {
+	case LiquidationActionAddMargin, LiquidationActionReducePosition:
+	default:
+		log.Warnf(log.ConfigMgr, "Liquidation monitor auto deleverage action %q invalid, auto deleverage disabled\n", l.AutoDeleverage.Action)
+		l.AutoDeleverage.Enabled = false
+		return
+	}
+	if l.AutoDeleverage.MarginRatio <= 0 || l.AutoDeleverage.MarginRatio > 1 {
+		l.AutoDeleverage.MarginRatio = l.CriticalMarginRatio
+	}
+	if l.AutoDeleverage.Percentage <= 0 || l.AutoDeleverage.Percentage > 100 {
+		l.AutoDeleverage.Percentage = DefaultLiquidationDeleveragePercentage
+	}
+}
+
 // CheckOrderManagerConfig ensures the order manager is setup correctly
 func (c *Config) CheckOrderManagerConfig() {
 	m.Lock()
@@ -1790,6 +1989,17 @@ func (c *Config) CheckConfig() error {
 	c.CheckDataHistoryMonitorConfig()
 	c.CheckCurrencyStateManager()
 	c.CheckOrderManagerConfig()
+	c.CheckPaperTradingConfig()
+	c.CheckRiskManagerConfig()
+	c.CheckAlgoExecutionManagerConfig()
+	c.CheckArbitrageScannerConfig()
+	c.CheckMarketMakingManagerConfig()
+	c.CheckGridTradingManagerConfig()
+	c.CheckMetricsManagerConfig()
+	c.CheckPortfolioRebalancerConfig()
+	c.CheckPortfolioSnapshotConfig()
+	c.CheckFundingRateMonitorConfig()
+	c.CheckLiquidationMonitorConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
@@ -2342,3 +2342,229 @@ func TestCheckSyncManagerConfig(t *testing.T) {
 		t.Errorf("received %v expected %v", c.SyncManagerConfig.NumWorkers, DefaultSyncerWorkers)
 	}
 }
+
+func TestCheckPaperTradingConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.PaperTrading.Balances = []PaperTradingBalance{
+		{Exchange: bfx, Asset: asset.Spot, Currency: currency.BTC, Amount: 1},
+		{Exchange: bfx, Asset: asset.Spot, Currency: currency.EMPTYCODE, Amount: 1},
+		{Exchange: "", Asset: asset.Spot, Currency: currency.USD, Amount: 1},
+		{Exchange: bfx, Asset: asset.Spot, Currency: currency.USD, Amount: 0},
+	}
+	c.CheckPaperTradingConfig()
+	if c.PaperTrading.MatchInterval != defaultPaperTradingMatchInterval {
+		t.Errorf("received %v expected %v", c.PaperTrading.MatchInterval, defaultPaperTradingMatchInterval)
+	}
+	if len(c.PaperTrading.Balances) != 1 {
+		t.Fatalf("received %v expected %v", len(c.PaperTrading.Balances), 1)
+	}
+	if !c.PaperTrading.Balances[0].Currency.Equal(currency.BTC) {
+		t.Errorf("received %v expected %v", c.PaperTrading.Balances[0].Currency, currency.BTC)
+	}
+}
+
+func TestCheckRiskManagerConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.RiskManager.Limits = []RiskLimit{
+		{Exchange: bfx, MaxOpenOrders: 5},
+		{Exchange: bfx, MaxDailyLoss: -1},
+	}
+	c.CheckRiskManagerConfig()
+	if c.RiskManager.CheckInterval != DefaultRiskManagerCheckInterval {
+		t.Errorf("received %v expected %v", c.RiskManager.CheckInterval, DefaultRiskManagerCheckInterval)
+	}
+	if len(c.RiskManager.Limits) != 1 {
+		t.Fatalf("received %v expected %v", len(c.RiskManager.Limits), 1)
+	}
+	if c.RiskManager.Limits[0].MaxOpenOrders != 5 {
+		t.Errorf("received %v expected %v", c.RiskManager.Limits[0].MaxOpenOrders, 5)
+	}
+}
+
+func TestCheckAlgoExecutionManagerConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.CheckAlgoExecutionManagerConfig()
+	if c.AlgoExecutionManager.CheckInterval != DefaultAlgoExecutionCheckInterval {
+		t.Errorf("received %v expected %v", c.AlgoExecutionManager.CheckInterval, DefaultAlgoExecutionCheckInterval)
+	}
+	c.AlgoExecutionManager.CheckInterval = time.Minute
+	c.CheckAlgoExecutionManagerConfig()
+	if c.AlgoExecutionManager.CheckInterval != time.Minute {
+		t.Errorf("received %v expected %v", c.AlgoExecutionManager.CheckInterval, time.Minute)
+	}
+}
+
+func TestCheckArbitrageScannerConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.ArbitrageScanner.AlertThresholdBasisPoints = -1
+	c.CheckArbitrageScannerConfig()
+	if c.ArbitrageScanner.CheckInterval != DefaultArbitrageScannerCheckInterval {
+		t.Errorf("received %v expected %v", c.ArbitrageScanner.CheckInterval, DefaultArbitrageScannerCheckInterval)
+	}
+	if c.ArbitrageScanner.AlertCooldown != DefaultArbitrageAlertCooldown {
+		t.Errorf("received %v expected %v", c.ArbitrageScanner.AlertCooldown, DefaultArbitrageAlertCooldown)
+	}
+	if c.ArbitrageScanner.AlertThresholdBasisPoints != 0 {
+		t.Errorf("received %v expected %v", c.ArbitrageScanner.AlertThresholdBasisPoints, 0)
+	}
+	c.ArbitrageScanner.CheckInterval = time.Minute
+	c.ArbitrageScanner.AlertThresholdBasisPoints = 25
+	c.CheckArbitrageScannerConfig()
+	if c.ArbitrageScanner.CheckInterval != time.Minute {
+		t.Errorf("received %v expected %v", c.ArbitrageScanner.CheckInterval, time.Minute)
+	}
+	if c.ArbitrageScanner.AlertThresholdBasisPoints != 25 {
+		t.Errorf("received %v expected %v", c.ArbitrageScanner.AlertThresholdBasisPoints, 25)
+	}
+}
+
+func TestCheckMarketMakingManagerConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.CheckMarketMakingManagerConfig()
+	if c.MarketMakingManager.RefreshInterval != DefaultMarketMakingRefreshInterval {
+		t.Errorf("received %v expected %v", c.MarketMakingManager.RefreshInterval, DefaultMarketMakingRefreshInterval)
+	}
+	c.MarketMakingManager.RefreshInterval = time.Minute
+	c.CheckMarketMakingManagerConfig()
+	if c.MarketMakingManager.RefreshInterval != time.Minute {
+		t.Errorf("received %v expected %v", c.MarketMakingManager.RefreshInterval, time.Minute)
+	}
+}
+
+func TestCheckGridTradingManagerConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.CheckGridTradingManagerConfig()
+	if c.GridTradingManager.CheckInterval != DefaultGridTradingCheckInterval {
+		t.Errorf("received %v expected %v", c.GridTradingManager.CheckInterval, DefaultGridTradingCheckInterval)
+	}
+	c.GridTradingManager.CheckInterval = time.Minute
+	c.CheckGridTradingManagerConfig()
+	if c.GridTradingManager.CheckInterval != time.Minute {
+		t.Errorf("received %v expected %v", c.GridTradingManager.CheckInterval, time.Minute)
+	}
+}
+
+func TestCheckMetricsManagerConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.CheckMetricsManagerConfig()
+	if c.MetricsManager.ListenAddress != DefaultMetricsListenAddress {
+		t.Errorf("received %v expected %v", c.MetricsManager.ListenAddress, DefaultMetricsListenAddress)
+	}
+	if c.MetricsManager.Path != DefaultMetricsPath {
+		t.Errorf("received %v expected %v", c.MetricsManager.Path, DefaultMetricsPath)
+	}
+	c.MetricsManager.Path = "prometheus"
+	c.CheckMetricsManagerConfig()
+	if c.MetricsManager.Path != "/prometheus" {
+		t.Errorf("received %v expected %v", c.MetricsManager.Path, "/prometheus")
+	}
+}
+
+func TestCheckPortfolioRebalancerConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.CheckPortfolioRebalancerConfig()
+	if c.PortfolioRebalancer.CheckInterval != DefaultRebalancerCheckInterval {
+		t.Errorf("received %v expected %v", c.PortfolioRebalancer.CheckInterval, DefaultRebalancerCheckInterval)
+	}
+	c.PortfolioRebalancer.CheckInterval = time.Hour
+	c.CheckPortfolioRebalancerConfig()
+	if c.PortfolioRebalancer.CheckInterval != time.Hour {
+		t.Errorf("received %v expected %v", c.PortfolioRebalancer.CheckInterval, time.Hour)
+	}
+}
+
+func TestCheckPortfolioSnapshotConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.CheckPortfolioSnapshotConfig()
+	if c.PortfolioSnapshot.SnapshotInterval != DefaultPortfolioSnapshotInterval {
+		t.Errorf("received %v expected %v", c.PortfolioSnapshot.SnapshotInterval, DefaultPortfolioSnapshotInterval)
+	}
+	if !c.PortfolioSnapshot.FiatCurrency.Equal(currency.USD) {
+		t.Errorf("received %v expected %v", c.PortfolioSnapshot.FiatCurrency, currency.USD)
+	}
+	c.PortfolioSnapshot.SnapshotInterval = time.Minute
+	c.PortfolioSnapshot.FiatCurrency = currency.EUR
+	c.CheckPortfolioSnapshotConfig()
+	if c.PortfolioSnapshot.SnapshotInterval != time.Minute {
+		t.Errorf("received %v expected %v", c.PortfolioSnapshot.SnapshotInterval, time.Minute)
+	}
+	if !c.PortfolioSnapshot.FiatCurrency.Equal(currency.EUR) {
+		t.Errorf("received %v expected %v", c.PortfolioSnapshot.FiatCurrency, currency.EUR)
+	}
+}
+
+func TestCheckFundingRateMonitorConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.FundingRateMonitor.AlertThresholdAnnualisedCarry = -1
+	c.CheckFundingRateMonitorConfig()
+	if c.FundingRateMonitor.CheckInterval != DefaultFundingRateMonitorCheckInterval {
+		t.Errorf("received %v expected %v", c.FundingRateMonitor.CheckInterval, DefaultFundingRateMonitorCheckInterval)
+	}
+	if c.FundingRateMonitor.AlertCooldown != DefaultFundingRateAlertCooldown {
+		t.Errorf("received %v expected %v", c.FundingRateMonitor.AlertCooldown, DefaultFundingRateAlertCooldown)
+	}
+	if c.FundingRateMonitor.AlertThresholdAnnualisedCarry != 0 {
+		t.Errorf("received %v expected %v", c.FundingRateMonitor.AlertThresholdAnnualisedCarry, 0)
+	}
+	c.FundingRateMonitor.CheckInterval = time.Minute
+	c.FundingRateMonitor.AlertThresholdAnnualisedCarry = 20
+	c.CheckFundingRateMonitorConfig()
+	if c.FundingRateMonitor.CheckInterval != time.Minute {
+		t.Errorf("received %v expected %v", c.FundingRateMonitor.CheckInterval, time.Minute)
+	}
+	if c.FundingRateMonitor.AlertThresholdAnnualisedCarry != 20 {
+		t.Errorf("received %v expected %v", c.FundingRateMonitor.AlertThresholdAnnualisedCarry, 20)
+	}
+}
+
+func TestCheckLiquidationMonitorConfig(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	c.LiquidationMonitor.MarginType = "bananas"
+	c.LiquidationMonitor.AutoDeleverage.Enabled = true
+	c.LiquidationMonitor.AutoDeleverage.Action = "sell everything"
+	c.CheckLiquidationMonitorConfig()
+	if c.LiquidationMonitor.CheckInterval != DefaultLiquidationMonitorCheckInterval {
+		t.Errorf("received %v expected %v", c.LiquidationMonitor.CheckInterval, DefaultLiquidationMonitorCheckInterval)
+	}
+	if c.LiquidationMonitor.MarginType != "isolated" {
+		t.Errorf("received %v expected %v", c.LiquidationMonitor.MarginType, "isolated")
+	}
+	if c.LiquidationMonitor.MaintenanceMarginRate != DefaultLiquidationMaintenanceMarginRate {
+		t.Errorf("received %v expected %v", c.LiquidationMonitor.MaintenanceMarginRate, DefaultLiquidationMaintenanceMarginRate)
+	}
+	if c.LiquidationMonitor.WarningMarginRatio != DefaultLiquidationWarningMarginRatio {
+		t.Errorf("received %v expected %v", c.LiquidationMonitor.WarningMarginRatio, DefaultLiquidationWarningMarginRatio)
+	}
+	if c.LiquidationMonitor.AutoDeleverage.Enabled {
+		t.Error("expected auto deleverage to be disabled for an invalid action")
+	}
+
+	c.LiquidationMonitor.WarningMarginRatio = 0.9
+	c.LiquidationMonitor.CriticalMarginRatio = 0.7
+	c.LiquidationMonitor.AutoDeleverage.Enabled = true
+	c.LiquidationMonitor.AutoDeleverage.Action = LiquidationActionReducePosition
+	c.CheckLiquidationMonitorConfig()
+	if c.LiquidationMonitor.CriticalMarginRatio != DefaultLiquidationCriticalMarginRatio {
+		t.Errorf("received %v expected %v", c.LiquidationMonitor.CriticalMarginRatio, DefaultLiquidationCriticalMarginRatio)
+	}
+	if !c.LiquidationMonitor.AutoDeleverage.Enabled {
+		t.Error("expected auto deleverage to remain enabled")
+	}
+	if c.LiquidationMonitor.AutoDeleverage.MarginRatio != DefaultLiquidationCriticalMarginRatio {
+		t.Errorf("received %v expected %v", c.LiquidationMonitor.AutoDeleverage.MarginRatio, DefaultLiquidationCriticalMarginRatio)
+	}
+	if c.LiquidationMonitor.AutoDeleverage.Percentage != DefaultLiquidationDeleveragePercentage {
+		t.Errorf("received %v expected %v", c.LiquidationMonitor.AutoDeleverage.Percentage, DefaultLiquidationDeleveragePercentage)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
@@ -37,8 +38,62 @@ const (
 	DefaultAPIClientID                   = "ClientID"
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
+	defaultPaperTradingMatchInterval     = time.Second
 	defaultMaxJobsPerCycle               = 5
 	DefaultOrderbookPublishPeriod        = time.Second * 10
+	// DefaultRiskManagerCheckInterval is the default duration between risk
+	// manager daily loss checks
+	DefaultRiskManagerCheckInterval = time.Second * 10
+	// DefaultAlgoExecutionCheckInterval is the default duration between algo
+	// execution manager child order schedule and fill checks
+	DefaultAlgoExecutionCheckInterval = time.Second * 5
+	// DefaultArbitrageScannerCheckInterval is the default duration between
+	// arbitrage scanner orderbook subscription and fee refresh checks
+	DefaultArbitrageScannerCheckInterval = time.Second * 30
+	// DefaultArbitrageAlertCooldown is the default minimum duration between
+	// communications alerts for the same arbitrage opportunity
+	DefaultArbitrageAlertCooldown = time.Minute * 5
+	// DefaultMarketMakingRefreshInterval is the default duration between
+	// market making manager requotes
+	DefaultMarketMakingRefreshInterval = time.Second * 5
+	// DefaultGridTradingCheckInterval is the default duration between grid
+	// trading manager order fill checks
+	DefaultGridTradingCheckInterval = time.Second * 5
+	// DefaultMetricsListenAddress is the default address the metrics manager
+	// serves Prometheus metrics on
+	DefaultMetricsListenAddress = "localhost:9054"
+	// DefaultMetricsPath is the default HTTP path metrics are served on
+	DefaultMetricsPath = "/metrics"
+	// DefaultRebalancerCheckInterval is the default duration between
+	// portfolio rebalancer drift checks
+	DefaultRebalancerCheckInterval = time.Minute
+	// DefaultPortfolioSnapshotInterval is the default duration between
+	// portfolio valuation snapshots
+	DefaultPortfolioSnapshotInterval = time.Hour
+	// DefaultFundingRateMonitorCheckInterval is the default duration between
+	// funding rate monitor polls of the latest funding rates
+	DefaultFundingRateMonitorCheckInterval = time.Minute * 5
+	// DefaultFundingRateAlertCooldown is the default minimum duration between
+	// communications alerts for the same funding rate opportunity
+	DefaultFundingRateAlertCooldown = time.Hour
+	// DefaultLiquidationMonitorCheckInterval is the default duration between
+	// liquidation monitor refreshes of open positions and their margin
+	DefaultLiquidationMonitorCheckInterval = time.Minute
+	// DefaultLiquidationMaintenanceMarginRate is the default fraction of a
+	// position's notional value required to be held as maintenance margin
+	DefaultLiquidationMaintenanceMarginRate = 0.005
+	// DefaultLiquidationWarningMarginRatio is the default margin ratio at
+	// which the liquidation monitor sends a warning alert
+	DefaultLiquidationWarningMarginRatio = 0.5
+	// DefaultLiquidationCriticalMarginRatio is the default margin ratio at
+	// which the liquidation monitor sends a critical alert
+	DefaultLiquidationCriticalMarginRatio = 0.8
+	// DefaultLiquidationAlertCooldown is the default minimum duration between
+	// repeated communications alerts for the same position and risk level
+	DefaultLiquidationAlertCooldown = time.Minute * 15
+	// DefaultLiquidationDeleveragePercentage is the default percentage of a
+	// position's margin added, or of its size reduced, when auto deleveraging
+	DefaultLiquidationDeleveragePercentage = 25.0
 	// DefaultSyncerWorkers limits the number of sync workers
 	DefaultSyncerWorkers = 15
 	// DefaultSyncerTimeoutREST the default time to switch from REST to websocket protocols without a response
@@ -90,26 +145,39 @@ var (
 // prestart management of Portfolio, Communications, Webserver and Enabled
 // Exchanges
 type Config struct {
-	Name                 string                    `json:"name"`
-	DataDirectory        string                    `json:"dataDirectory"`
-	EncryptConfig        int                       `json:"encryptConfig"`
-	GlobalHTTPTimeout    time.Duration             `json:"globalHTTPTimeout"`
-	Database             database.Config           `json:"database"`
-	Logging              log.Config                `json:"logging"`
-	SyncManagerConfig    SyncManagerConfig         `json:"syncManager"`
-	ConnectionMonitor    ConnectionMonitorConfig   `json:"connectionMonitor"`
-	OrderManager         OrderManager              `json:"orderManager"`
-	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
-	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
-	Profiler             Profiler                  `json:"profiler"`
-	NTPClient            NTPClientConfig           `json:"ntpclient"`
-	GCTScript            gctscript.Config          `json:"gctscript"`
-	Currency             currency.Config           `json:"currencyConfig"`
-	Communications       base.CommunicationsConfig `json:"communications"`
-	RemoteControl        RemoteControlConfig       `json:"remoteControl"`
-	Portfolio            portfolio.Base            `json:"portfolioAddresses"`
-	Exchanges            []Exchange                `json:"exchanges"`
-	BankAccounts         []banking.Account         `json:"bankAccounts"`
+	Name                    string                    `json:"name"`
+	DataDirectory           string                    `json:"dataDirectory"`
+	EncryptConfig           int                       `json:"encryptConfig"`
+	GlobalHTTPTimeout       time.Duration             `json:"globalHTTPTimeout"`
+	Database                database.Config           `json:"database"`
+	Logging                 log.Config                `json:"logging"`
+	SyncManagerConfig       SyncManagerConfig         `json:"syncManager"`
+	ConnectionMonitor       ConnectionMonitorConfig   `json:"connectionMonitor"`
+	OrderManager            OrderManager              `json:"orderManager"`
+	DataHistoryManager      DataHistoryManager        `json:"dataHistoryManager"`
+	CurrencyStateManager    CurrencyStateManager      `json:"currencyStateManager"`
+	PaperTrading            PaperTrading              `json:"paperTrading"`
+	OrderbookRecording      OrderbookRecording        `json:"orderbookRecording"`
+	RiskManager             RiskManager               `json:"riskManager"`
+	ConditionalOrderManager ConditionalOrderManager   `json:"conditionalOrderManager"`
+	AlgoExecutionManager    AlgoExecutionManager      `json:"algoExecutionManager"`
+	ArbitrageScanner        ArbitrageScanner          `json:"arbitrageScanner"`
+	MarketMakingManager     MarketMakingManager       `json:"marketMakingManager"`
+	GridTradingManager      GridTradingManager        `json:"gridTradingManager"`
+	MetricsManager          MetricsManager            `json:"metricsManager"`
+	PortfolioRebalancer     PortfolioRebalancer       `json:"portfolioRebalancer"`
+	PortfolioSnapshot       PortfolioSnapshot         `json:"portfolioSnapshot"`
+	FundingRateMonitor      FundingRateMonitor        `json:"fundingRateMonitor"`
+	LiquidationMonitor      LiquidationMonitor        `json:"liquidationMonitor"`
+	Profiler                Profiler                  `json:"profiler"`
+	NTPClient               NTPClientConfig           `json:"ntpclient"`
+	GCTScript               gctscript.Config          `json:"gctscript"`
+	Currency                currency.Config           `json:"currencyConfig"`
+	Communications          base.CommunicationsConfig `json:"communications"`
+	RemoteControl           RemoteControlConfig       `json:"remoteControl"`
+	Portfolio               portfolio.Base            `json:"portfolioAddresses"`
+	Exchanges               []Exchange                `json:"exchanges"`
+	BankAccounts            []banking.Account         `json:"bankAccounts"`
 
 	// Deprecated config settings, will be removed at a future date
 	Webserver           *WebserverConfig      `json:"webserver,omitempty"`
@@ -148,6 +216,195 @@ type CurrencyStateManager struct {
 	Delay   time.Duration `json:"delay"`
 }
 
+// PaperTrading defines a set of configuration options for simulating order
+// execution against live orderbook depth
+type PaperTrading struct {
+	Enabled       bool                  `json:"enabled"`
+	MatchInterval time.Duration         `json:"matchInterval"`
+	Balances      []PaperTradingBalance `json:"balances"`
+}
+
+// PaperTradingBalance defines a starting simulated balance for an exchange
+type PaperTradingBalance struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Currency currency.Code `json:"currency"`
+	Amount   float64       `json:"amount"`
+}
+
+// OrderbookRecording defines a set of configuration options for recording
+// websocket orderbooks so they can be replayed by the backtester
+type OrderbookRecording struct {
+	Enabled bool `json:"enabled"`
+	// Path is the directory recordings are written to. Defaults to the
+	// orderbooks directory in the data directory
+	Path string `json:"path"`
+}
+
+// RiskManager defines a set of configuration options for the pre-trade risk
+// manager
+type RiskManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// KillSwitch cancels all orders and halts further order submission
+	// when any limit is breached
+	KillSwitch    bool          `json:"killSwitch"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	Limits        []RiskLimit   `json:"limits"`
+}
+
+// RiskLimit defines the limits applied to orders. An empty exchange, asset or
+// pair applies the limit to all orders for that field. A zero value disables
+// the individual limit.
+type RiskLimit struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+	// MaxOrderNotional is the maximum value of a single order in the quote
+	// currency
+	MaxOrderNotional float64 `json:"maxOrderNotional"`
+	// MaxOpenOrders is the maximum number of active orders
+	MaxOpenOrders int64 `json:"maxOpenOrders"`
+	// MaxPositionSize is the maximum absolute net position per pair in the
+	// base currency
+	MaxPositionSize float64 `json:"maxPositionSize"`
+	// MaxDailyLoss is the maximum loss per UTC day in the quote currency
+	MaxDailyLoss float64 `json:"maxDailyLoss"`
+	// PriceBandPercentage is the maximum deviation of an order price from
+	// the last ticker price
+	PriceBandPercentage float64 `json:"priceBandPercentage"`
+}
+
+// ConditionalOrderManager defines a set of configuration options for the
+// engine side stop, trailing stop, take profit and OCO order service
+type ConditionalOrderManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+}
+
+// AlgoExecutionManager defines a set of configuration options for the TWAP,
+// VWAP and iceberg parent order execution service
+type AlgoExecutionManager struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+}
+
+// PortfolioSnapshot defines a set of configuration options for the portfolio
+// snapshot manager
+type PortfolioSnapshot struct {
+	Enabled          bool          `json:"enabled"`
+	Verbose          bool          `json:"verbose"`
+	SnapshotInterval time.Duration `json:"snapshotInterval"`
+	// FiatCurrency is the currency holdings are valued in
+	FiatCurrency currency.Code `json:"fiatCurrency"`
+}
+
+// FundingRateMonitor defines a set of configuration options for the
+// perpetual futures funding rate monitor
+type FundingRateMonitor struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// MinimumAnnualisedCarry excludes opportunities whose annualised carry
+	// percentage is below it
+	MinimumAnnualisedCarry float64 `json:"minimumAnnualisedCarry"`
+	// AlertThresholdAnnualisedCarry sends a communications event when an
+	// opportunity's annualised carry percentage exceeds it, zero disables
+	// alerts
+	AlertThresholdAnnualisedCarry float64       `json:"alertThresholdAnnualisedCarry"`
+	AlertCooldown                 time.Duration `json:"alertCooldown"`
+}
+
+// Liquidation monitor auto deleverage actions
+const (
+	LiquidationActionAddMargin      = "addmargin"
+	LiquidationActionReducePosition = "reduceposition"
+)
+
+// LiquidationMonitor defines a set of configuration options for the open
+// futures position liquidation monitor
+type LiquidationMonitor struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// CheckInterval is the duration between refreshes of each open position's
+	// leverage, contract details and collateral. Positions are re-evaluated on
+	// every ticker update in between
+	CheckInterval time.Duration `json:"checkInterval"`
+	// MarginType is the margin type positions are held under, either
+	// "isolated" or "cross"
+	MarginType string `json:"marginType"`
+	// MaintenanceMarginRate is the fraction of a position's notional value
+	// which must be held as margin to avoid liquidation
+	MaintenanceMarginRate float64 `json:"maintenanceMarginRate"`
+	// WarningMarginRatio and CriticalMarginRatio are the margin ratios, being
+	// maintenance margin divided by margin balance, at which alerts escalate.
+	// A position is liquidated at a margin ratio of one
+	WarningMarginRatio  float64                   `json:"warningMarginRatio"`
+	CriticalMarginRatio float64                   `json:"criticalMarginRatio"`
+	AlertCooldown       time.Duration             `json:"alertCooldown"`
+	AutoDeleverage      LiquidationAutoDeleverage `json:"autoDeleverage"`
+}
+
+// LiquidationAutoDeleverage defines an optional action the liquidation
+// monitor takes when a position's margin ratio reaches a threshold
+type LiquidationAutoDeleverage struct {
+	Enabled bool `json:"enabled"`
+	// Action is either "addmargin", which allocates more margin to an
+	// isolated position, or "reduceposition", which submits a reduce-only
+	// market order
+	Action string `json:"action"`
+	// MarginRatio triggers the action, defaulting to the critical margin ratio
+	MarginRatio float64 `json:"marginRatio"`
+	// Percentage is the percentage of the position's margin to add, or of its
+	// size to reduce
+	Percentage float64 `json:"percentage"`
+}
+
+// ArbitrageScanner defines a set of configuration options for the cross
+// exchange arbitrage scanner
+type ArbitrageScanner struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// AlertThresholdBasisPoints sends a communications event when an
+	// opportunity's net spread exceeds it, zero disables alerts
+	AlertThresholdBasisPoints float64       `json:"alertThresholdBasisPoints"`
+	AlertCooldown             time.Duration `json:"alertCooldown"`
+}
+
+// MarketMakingManager defines a set of configuration options for the two
+// sided quoting service
+type MarketMakingManager struct {
+	Enabled         bool          `json:"enabled"`
+	Verbose         bool          `json:"verbose"`
+	RefreshInterval time.Duration `json:"refreshInterval"`
+}
+
+// GridTradingManager defines a set of configuration options for the grid
+// trading service
+type GridTradingManager struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+}
+
+// MetricsManager defines a set of configuration options for the Prometheus
+// metrics exporter
+type MetricsManager struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+	Path          string `json:"path"`
+}
+
+// PortfolioRebalancer defines a set of configuration options for the
+// portfolio rebalancer
+type PortfolioRebalancer struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+}
+
 // SyncManagerConfig stores the currency pair synchronization manager config
 type SyncManagerConfig struct {
 	Enabled                 bool                 `json:"enabled"`
@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS conditionalorder
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange varchar(255) NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    order_type varchar NOT NULL,
+    side varchar NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    trigger_price DOUBLE PRECISION NOT NULL,
+    limit_price DOUBLE PRECISION NULL,
+    trailing_distance DOUBLE PRECISION NULL,
+    trailing_percentage DOUBLE PRECISION NULL,
+    watermark_price DOUBLE PRECISION NULL,
+    oco_group varchar NULL,
+    status varchar NOT NULL,
+    order_id varchar NULL,
+    reason TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose Down
+DROP TABLE conditionalorder;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE conditionalorder
+(
+    id text NOT NULL primary key,
+    exchange text NOT NULL,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    order_type text NOT NULL,
+    side text NOT NULL,
+    amount real NOT NULL,
+    trigger_price real NOT NULL,
+    limit_price real NULL,
+    trailing_distance real NULL,
+    trailing_percentage real NULL,
+    watermark_price real NULL,
+    oco_group text NULL,
+    status text NOT NULL,
+    order_id text NULL,
+    reason text NULL,
+    created_at timestamp NOT NULL default CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL default CURRENT_TIMESTAMP,
+    UNIQUE(id) ON CONFLICT REPLACE
+);
+
+-- +goose Down
+DROP TABLE conditionalorder;
@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS grid
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange varchar(255) NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    lower_price DOUBLE PRECISION NOT NULL,
+    upper_price DOUBLE PRECISION NOT NULL,
+    levels integer NOT NULL,
+    spacing varchar NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    status varchar NOT NULL,
+    reason TEXT NULL,
+    realised_profit DOUBLE PRECISION NOT NULL,
+    round_trips integer NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS gridlevel
+(
+    grid_id uuid NOT NULL REFERENCES grid(id),
+    level integer NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    side varchar NULL,
+    order_id varchar NULL,
+    entry_price DOUBLE PRECISION NULL,
+    PRIMARY KEY (grid_id, level)
+);
+-- +goose Down
+DROP TABLE gridlevel;
+DROP TABLE grid;
@@ -0,0 +1,38 @@
+-- +goose Up
+CREATE TABLE grid
+(
+    id text NOT NULL primary key,
+    exchange text NOT NULL,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    lower_price real NOT NULL,
+    upper_price real NOT NULL,
+    levels integer NOT NULL,
+    spacing text NOT NULL,
+    amount real NOT NULL,
+    status text NOT NULL,
+    reason text NULL,
+    realised_profit real NOT NULL,
+    round_trips integer NOT NULL,
+    created_at timestamp NOT NULL default CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL default CURRENT_TIMESTAMP,
+    UNIQUE(id) ON CONFLICT REPLACE
+);
+
+CREATE TABLE gridlevel
+(
+    grid_id text NOT NULL,
+    level integer NOT NULL,
+    price real NOT NULL,
+    side text NULL,
+    order_id text NULL,
+    entry_price real NULL,
+    PRIMARY KEY (grid_id, level),
+    FOREIGN KEY(grid_id) REFERENCES grid(id) ON DELETE RESTRICT,
+    UNIQUE(grid_id, level) ON CONFLICT REPLACE
+);
+
+-- +goose Down
+DROP TABLE gridlevel;
+DROP TABLE grid;
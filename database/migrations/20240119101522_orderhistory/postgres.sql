@@ -0,0 +1,54 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orderdetail
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange varchar(255) NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    order_id varchar NOT NULL,
+    client_order_id varchar NULL,
+    order_type varchar NOT NULL,
+    side varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    average_executed_price DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    cost DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniqueorderdetail
+        unique(exchange, order_id)
+);
+
+CREATE TABLE IF NOT EXISTS orderevent
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    orderdetail_id uuid NOT NULL REFERENCES orderdetail(id),
+    event varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    message TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS ordertrade
+(
+    orderdetail_id uuid NOT NULL REFERENCES orderdetail(id),
+    tid varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    side varchar NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (orderdetail_id, tid)
+);
+-- +goose Down
+DROP TABLE ordertrade;
+DROP TABLE orderevent;
+DROP TABLE orderdetail;
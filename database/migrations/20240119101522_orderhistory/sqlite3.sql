@@ -0,0 +1,57 @@
+-- +goose Up
+CREATE TABLE orderdetail
+(
+    id text NOT NULL primary key,
+    exchange text NOT NULL,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    order_id text NOT NULL,
+    client_order_id text NULL,
+    order_type text NOT NULL,
+    side text NOT NULL,
+    status text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    executed_amount real NOT NULL,
+    remaining_amount real NOT NULL,
+    average_executed_price real NOT NULL,
+    fee real NOT NULL,
+    cost real NOT NULL,
+    created_at timestamp NOT NULL default CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL default CURRENT_TIMESTAMP,
+    UNIQUE(exchange, order_id)
+);
+
+CREATE TABLE orderevent
+(
+    id text NOT NULL primary key,
+    orderdetail_id text NOT NULL,
+    event text NOT NULL,
+    status text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    executed_amount real NOT NULL,
+    message text NULL,
+    created_at timestamp NOT NULL default CURRENT_TIMESTAMP,
+    FOREIGN KEY(orderdetail_id) REFERENCES orderdetail(id) ON DELETE RESTRICT
+);
+
+CREATE TABLE ordertrade
+(
+    orderdetail_id text NOT NULL,
+    tid text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    fee real NOT NULL,
+    side text NULL,
+    timestamp timestamp NOT NULL,
+    PRIMARY KEY (orderdetail_id, tid),
+    FOREIGN KEY(orderdetail_id) REFERENCES orderdetail(id) ON DELETE RESTRICT,
+    UNIQUE(orderdetail_id, tid) ON CONFLICT REPLACE
+);
+
+-- +goose Down
+DROP TABLE ordertrade;
+DROP TABLE orderevent;
+DROP TABLE orderdetail;
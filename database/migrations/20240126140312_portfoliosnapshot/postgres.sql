@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS portfoliosnapshot
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    fiat_currency varchar(30) NOT NULL,
+    total_value DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS portfoliosnapshot_created_at ON portfoliosnapshot (created_at);
+
+CREATE TABLE IF NOT EXISTS portfoliosnapshotholding
+(
+    portfoliosnapshot_id uuid NOT NULL REFERENCES portfoliosnapshot(id) ON DELETE CASCADE,
+    source varchar(255) NOT NULL,
+    asset varchar NOT NULL,
+    currency varchar(30) NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (portfoliosnapshot_id, source, asset, currency)
+);
+-- +goose Down
+DROP TABLE portfoliosnapshotholding;
+DROP TABLE portfoliosnapshot;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE portfoliosnapshot
+(
+    id text NOT NULL primary key,
+    fiat_currency text NOT NULL,
+    total_value real NOT NULL,
+    created_at timestamp NOT NULL default CURRENT_TIMESTAMP
+);
+CREATE INDEX portfoliosnapshot_created_at ON portfoliosnapshot (created_at);
+
+CREATE TABLE portfoliosnapshotholding
+(
+    portfoliosnapshot_id text NOT NULL,
+    source text NOT NULL,
+    asset text NOT NULL,
+    currency text NOT NULL,
+    amount real NOT NULL,
+    price real NOT NULL,
+    value real NOT NULL,
+    PRIMARY KEY (portfoliosnapshot_id, source, asset, currency),
+    FOREIGN KEY(portfoliosnapshot_id) REFERENCES portfoliosnapshot(id) ON DELETE CASCADE,
+    UNIQUE(portfoliosnapshot_id, source, asset, currency) ON CONFLICT REPLACE
+);
+
+-- +goose Down
+DROP TABLE portfoliosnapshotholding;
+DROP TABLE portfoliosnapshot;
@@ -13,82 +13,191 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("Conditionalorders", testConditionalorders)
 	t.Run("Exchanges", testExchanges)
+	t.Run("Grids", testGrids)
+	t.Run("Gridlevels", testGridlevels)
+	t.Run("Orderdetails", testOrderdetails)
+	t.Run("Orderevents", testOrderevents)
+	t.Run("Ordertrades", testOrdertrades)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdings)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshots)
 	t.Run("Scripts", testScripts)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("Conditionalorders", testConditionalordersDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("Grids", testGridsDelete)
+	t.Run("Gridlevels", testGridlevelsDelete)
+	t.Run("Orderdetails", testOrderdetailsDelete)
+	t.Run("Orderevents", testOrdereventsDelete)
+	t.Run("Ordertrades", testOrdertradesDelete)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsDelete)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsDelete)
 	t.Run("Scripts", testScriptsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("Conditionalorders", testConditionalordersQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("Grids", testGridsQueryDeleteAll)
+	t.Run("Gridlevels", testGridlevelsQueryDeleteAll)
+	t.Run("Orderdetails", testOrderdetailsQueryDeleteAll)
+	t.Run("Orderevents", testOrdereventsQueryDeleteAll)
+	t.Run("Ordertrades", testOrdertradesQueryDeleteAll)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsQueryDeleteAll)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("Conditionalorders", testConditionalordersSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("Grids", testGridsSliceDeleteAll)
+	t.Run("Gridlevels", testGridlevelsSliceDeleteAll)
+	t.Run("Orderdetails", testOrderdetailsSliceDeleteAll)
+	t.Run("Orderevents", testOrdereventsSliceDeleteAll)
+	t.Run("Ordertrades", testOrdertradesSliceDeleteAll)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsSliceDeleteAll)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("Conditionalorders", testConditionalordersExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("Grids", testGridsExists)
+	t.Run("Gridlevels", testGridlevelsExists)
+	t.Run("Orderdetails", testOrderdetailsExists)
+	t.Run("Orderevents", testOrdereventsExists)
+	t.Run("Ordertrades", testOrdertradesExists)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsExists)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsExists)
 	t.Run("Scripts", testScriptsExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("Conditionalorders", testConditionalordersFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("Grids", testGridsFind)
+	t.Run("Gridlevels", testGridlevelsFind)
+	t.Run("Orderdetails", testOrderdetailsFind)
+	t.Run("Orderevents", testOrdereventsFind)
+	t.Run("Ordertrades", testOrdertradesFind)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsFind)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsFind)
 	t.Run("Scripts", testScriptsFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("Conditionalorders", testConditionalordersBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("Grids", testGridsBind)
+	t.Run("Gridlevels", testGridlevelsBind)
+	t.Run("Orderdetails", testOrderdetailsBind)
+	t.Run("Orderevents", testOrdereventsBind)
+	t.Run("Ordertrades", testOrdertradesBind)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsBind)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsBind)
 	t.Run("Scripts", testScriptsBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("Conditionalorders", testConditionalordersOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("Grids", testGridsOne)
+	t.Run("Gridlevels", testGridlevelsOne)
+	t.Run("Orderdetails", testOrderdetailsOne)
+	t.Run("Orderevents", testOrdereventsOne)
+	t.Run("Ordertrades", testOrdertradesOne)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsOne)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsOne)
 	t.Run("Scripts", testScriptsOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("Conditionalorders", testConditionalordersAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("Grids", testGridsAll)
+	t.Run("Gridlevels", testGridlevelsAll)
+	t.Run("Orderdetails", testOrderdetailsAll)
+	t.Run("Orderevents", testOrdereventsAll)
+	t.Run("Ordertrades", testOrdertradesAll)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsAll)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsAll)
 	t.Run("Scripts", testScriptsAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("Conditionalorders", testConditionalordersCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("Grids", testGridsCount)
+	t.Run("Gridlevels", testGridlevelsCount)
+	t.Run("Orderdetails", testOrderdetailsCount)
+	t.Run("Orderevents", testOrdereventsCount)
+	t.Run("Ordertrades", testOrdertradesCount)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsCount)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsCount)
 	t.Run("Scripts", testScriptsCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("Conditionalorders", testConditionalordersHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("Grids", testGridsHooks)
+	t.Run("Gridlevels", testGridlevelsHooks)
+	t.Run("Orderdetails", testOrderdetailsHooks)
+	t.Run("Orderevents", testOrdereventsHooks)
+	t.Run("Ordertrades", testOrdertradesHooks)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsHooks)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsHooks)
 	t.Run("Scripts", testScriptsHooks)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("Conditionalorders", testConditionalordersInsert)
+	t.Run("Conditionalorders", testConditionalordersInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("Grids", testGridsInsert)
+	t.Run("Grids", testGridsInsertWhitelist)
+	t.Run("Gridlevels", testGridlevelsInsert)
+	t.Run("Gridlevels", testGridlevelsInsertWhitelist)
+	t.Run("Orderdetails", testOrderdetailsInsert)
+	t.Run("Orderdetails", testOrderdetailsInsertWhitelist)
+	t.Run("Orderevents", testOrdereventsInsert)
+	t.Run("Orderevents", testOrdereventsInsertWhitelist)
+	t.Run("Ordertrades", testOrdertradesInsert)
+	t.Run("Ordertrades", testOrdertradesInsertWhitelist)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsInsert)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsInsertWhitelist)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsInsert)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOne(t *testing.T) {}
+func TestToOne(t *testing.T) {
+	t.Run("GridlevelToGridUsingGrid", testGridlevelToOneGridUsingGrid)
+	t.Run("OrdereventToOrderdetailUsingOrderdetail", testOrdereventToOneOrderdetailUsingOrderdetail)
+	t.Run("OrdertradeToOrderdetailUsingOrderdetail", testOrdertradeToOneOrderdetailUsingOrderdetail)
+	t.Run("PortfoliosnapshotholdingToPortfoliosnapshotUsingPortfoliosnapshot", testPortfoliosnapshotholdingToOnePortfoliosnapshotUsingPortfoliosnapshot)
+}
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
@@ -96,11 +205,21 @@ func TestOneToOne(t *testing.T) {}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToMany(t *testing.T) {}
+func TestToMany(t *testing.T) {
+	t.Run("GridToGridlevels", testGridToManyGridlevels)
+	t.Run("OrderdetailToOrderevents", testOrderdetailToManyOrderevents)
+	t.Run("OrderdetailToOrdertrades", testOrderdetailToManyOrdertrades)
+	t.Run("PortfoliosnapshotToPortfoliosnapshotholdings", testPortfoliosnapshotToManyPortfoliosnapshotholdings)
+}
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneSet(t *testing.T) {}
+func TestToOneSet(t *testing.T) {
+	t.Run("GridlevelToGridUsingGridlevels", testGridlevelToOneSetOpGridUsingGrid)
+	t.Run("OrdereventToOrderdetailUsingOrderevents", testOrdereventToOneSetOpOrderdetailUsingOrderdetail)
+	t.Run("OrdertradeToOrderdetailUsingOrdertrades", testOrdertradeToOneSetOpOrderdetailUsingOrderdetail)
+	t.Run("PortfoliosnapshotholdingToPortfoliosnapshotUsingPortfoliosnapshotholdings", testPortfoliosnapshotholdingToOneSetOpPortfoliosnapshotUsingPortfoliosnapshot)
+}
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
@@ -116,7 +235,12 @@ func TestOneToOneRemove(t *testing.T) {}
 
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyAdd(t *testing.T) {}
+func TestToManyAdd(t *testing.T) {
+	t.Run("GridToGridlevels", testGridToManyAddOpGridlevels)
+	t.Run("OrderdetailToOrderevents", testOrderdetailToManyAddOpOrderevents)
+	t.Run("OrderdetailToOrdertrades", testOrderdetailToManyAddOpOrdertrades)
+	t.Run("PortfoliosnapshotToPortfoliosnapshotholdings", testPortfoliosnapshotToManyAddOpPortfoliosnapshotholdings)
+}
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -128,29 +252,69 @@ func TestToManyRemove(t *testing.T) {}
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("Conditionalorders", testConditionalordersReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("Grids", testGridsReload)
+	t.Run("Gridlevels", testGridlevelsReload)
+	t.Run("Orderdetails", testOrderdetailsReload)
+	t.Run("Orderevents", testOrdereventsReload)
+	t.Run("Ordertrades", testOrdertradesReload)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsReload)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("Conditionalorders", testConditionalordersReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("Grids", testGridsReloadAll)
+	t.Run("Gridlevels", testGridlevelsReloadAll)
+	t.Run("Orderdetails", testOrderdetailsReloadAll)
+	t.Run("Orderevents", testOrdereventsReloadAll)
+	t.Run("Ordertrades", testOrdertradesReloadAll)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsReloadAll)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("Conditionalorders", testConditionalordersSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("Grids", testGridsSelect)
+	t.Run("Gridlevels", testGridlevelsSelect)
+	t.Run("Orderdetails", testOrderdetailsSelect)
+	t.Run("Orderevents", testOrdereventsSelect)
+	t.Run("Ordertrades", testOrdertradesSelect)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsSelect)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsSelect)
 	t.Run("Scripts", testScriptsSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("Conditionalorders", testConditionalordersUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("Grids", testGridsUpdate)
+	t.Run("Gridlevels", testGridlevelsUpdate)
+	t.Run("Orderdetails", testOrderdetailsUpdate)
+	t.Run("Orderevents", testOrdereventsUpdate)
+	t.Run("Ordertrades", testOrdertradesUpdate)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsUpdate)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("Conditionalorders", testConditionalordersSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("Grids", testGridsSliceUpdateAll)
+	t.Run("Gridlevels", testGridlevelsSliceUpdateAll)
+	t.Run("Orderdetails", testOrderdetailsSliceUpdateAll)
+	t.Run("Orderevents", testOrdereventsSliceUpdateAll)
+	t.Run("Ordertrades", testOrdertradesSliceUpdateAll)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsSliceUpdateAll)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 }
@@ -4,29 +4,45 @@
 package postgres
 
 var TableNames = struct {
-	AuditEvent              string
-	Candle                  string
-	Datahistoryjob          string
-	Datahistoryjobrelations string
-	Datahistoryjobresult    string
-	Exchange                string
-	Script                  string
-	ScriptExecution         string
-	Trade                   string
-	WithdrawalCrypto        string
-	WithdrawalFiat          string
-	WithdrawalHistory       string
+	AuditEvent               string
+	Candle                   string
+	Conditionalorder         string
+	Datahistoryjob           string
+	Datahistoryjobrelations  string
+	Datahistoryjobresult     string
+	Exchange                 string
+	Grid                     string
+	Gridlevel                string
+	Orderdetail              string
+	Orderevent               string
+	Ordertrade               string
+	Portfoliosnapshot        string
+	Portfoliosnapshotholding string
+	Script                   string
+	ScriptExecution          string
+	Trade                    string
+	WithdrawalCrypto         string
+	WithdrawalFiat           string
+	WithdrawalHistory        string
 }{
-	AuditEvent:              "audit_event",
-	Candle:                  "candle",
-	Datahistoryjob:          "datahistoryjob",
-	Datahistoryjobrelations: "datahistoryjobrelations",
-	Datahistoryjobresult:    "datahistoryjobresult",
-	Exchange:                "exchange",
-	Script:                  "script",
-	ScriptExecution:         "script_execution",
-	Trade:                   "trade",
-	WithdrawalCrypto:        "withdrawal_crypto",
-	WithdrawalFiat:          "withdrawal_fiat",
-	WithdrawalHistory:       "withdrawal_history",
+	AuditEvent:               "audit_event",
+	Candle:                   "candle",
+	Conditionalorder:         "conditionalorder",
+	Datahistoryjob:           "datahistoryjob",
+	Datahistoryjobrelations:  "datahistoryjobrelations",
+	Datahistoryjobresult:     "datahistoryjobresult",
+	Exchange:                 "exchange",
+	Grid:                     "grid",
+	Gridlevel:                "gridlevel",
+	Orderdetail:              "orderdetail",
+	Orderevent:               "orderevent",
+	Ordertrade:               "ordertrade",
+	Portfoliosnapshot:        "portfoliosnapshot",
+	Portfoliosnapshotholding: "portfoliosnapshotholding",
+	Script:                   "script",
+	ScriptExecution:          "script_execution",
+	Trade:                    "trade",
+	WithdrawalCrypto:         "withdrawal_crypto",
+	WithdrawalFiat:           "withdrawal_fiat",
+	WithdrawalHistory:        "withdrawal_history",
 }
@@ -0,0 +1,973 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// Conditionalorder is an object representing the database table.
+type Conditionalorder struct {
+	ID                 string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Exchange           string       `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	Asset              string       `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Base               string       `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote              string       `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	OrderType          string       `boil:"order_type" json:"order_type" toml:"order_type" yaml:"order_type"`
+	Side               string       `boil:"side" json:"side" toml:"side" yaml:"side"`
+	Amount             float64      `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	TriggerPrice       float64      `boil:"trigger_price" json:"trigger_price" toml:"trigger_price" yaml:"trigger_price"`
+	LimitPrice         null.Float64 `boil:"limit_price" json:"limit_price,omitempty" toml:"limit_price" yaml:"limit_price,omitempty"`
+	TrailingDistance   null.Float64 `boil:"trailing_distance" json:"trailing_distance,omitempty" toml:"trailing_distance" yaml:"trailing_distance,omitempty"`
+	TrailingPercentage null.Float64 `boil:"trailing_percentage" json:"trailing_percentage,omitempty" toml:"trailing_percentage" yaml:"trailing_percentage,omitempty"`
+	WatermarkPrice     null.Float64 `boil:"watermark_price" json:"watermark_price,omitempty" toml:"watermark_price" yaml:"watermark_price,omitempty"`
+	OcoGroup           null.String  `boil:"oco_group" json:"oco_group,omitempty" toml:"oco_group" yaml:"oco_group,omitempty"`
+	Status             string       `boil:"status" json:"status" toml:"status" yaml:"status"`
+	OrderID            null.String  `boil:"order_id" json:"order_id,omitempty" toml:"order_id" yaml:"order_id,omitempty"`
+	Reason             null.String  `boil:"reason" json:"reason,omitempty" toml:"reason" yaml:"reason,omitempty"`
+	CreatedAt          time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          time.Time    `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *conditionalorderR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L conditionalorderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ConditionalorderColumns = struct {
+	ID                 string
+	Exchange           string
+	Asset              string
+	Base               string
+	Quote              string
+	OrderType          string
+	Side               string
+	Amount             string
+	TriggerPrice       string
+	LimitPrice         string
+	TrailingDistance   string
+	TrailingPercentage string
+	WatermarkPrice     string
+	OcoGroup           string
+	Status             string
+	OrderID            string
+	Reason             string
+	CreatedAt          string
+	UpdatedAt          string
+}{
+	ID:                 "id",
+	Exchange:           "exchange",
+	Asset:              "asset",
+	Base:               "base",
+	Quote:              "quote",
+	OrderType:          "order_type",
+	Side:               "side",
+	Amount:             "amount",
+	TriggerPrice:       "trigger_price",
+	LimitPrice:         "limit_price",
+	TrailingDistance:   "trailing_distance",
+	TrailingPercentage: "trailing_percentage",
+	WatermarkPrice:     "watermark_price",
+	OcoGroup:           "oco_group",
+	Status:             "status",
+	OrderID:            "order_id",
+	Reason:             "reason",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var ConditionalorderWhere = struct {
+	ID                 whereHelperstring
+	Exchange           whereHelperstring
+	Asset              whereHelperstring
+	Base               whereHelperstring
+	Quote              whereHelperstring
+	OrderType          whereHelperstring
+	Side               whereHelperstring
+	Amount             whereHelperfloat64
+	TriggerPrice       whereHelperfloat64
+	LimitPrice         whereHelpernull_Float64
+	TrailingDistance   whereHelpernull_Float64
+	TrailingPercentage whereHelpernull_Float64
+	WatermarkPrice     whereHelpernull_Float64
+	OcoGroup           whereHelpernull_String
+	Status             whereHelperstring
+	OrderID            whereHelpernull_String
+	Reason             whereHelpernull_String
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+}{
+	ID:                 whereHelperstring{field: "\"conditionalorder\".\"id\""},
+	Exchange:           whereHelperstring{field: "\"conditionalorder\".\"exchange\""},
+	Asset:              whereHelperstring{field: "\"conditionalorder\".\"asset\""},
+	Base:               whereHelperstring{field: "\"conditionalorder\".\"base\""},
+	Quote:              whereHelperstring{field: "\"conditionalorder\".\"quote\""},
+	OrderType:          whereHelperstring{field: "\"conditionalorder\".\"order_type\""},
+	Side:               whereHelperstring{field: "\"conditionalorder\".\"side\""},
+	Amount:             whereHelperfloat64{field: "\"conditionalorder\".\"amount\""},
+	TriggerPrice:       whereHelperfloat64{field: "\"conditionalorder\".\"trigger_price\""},
+	LimitPrice:         whereHelpernull_Float64{field: "\"conditionalorder\".\"limit_price\""},
+	TrailingDistance:   whereHelpernull_Float64{field: "\"conditionalorder\".\"trailing_distance\""},
+	TrailingPercentage: whereHelpernull_Float64{field: "\"conditionalorder\".\"trailing_percentage\""},
+	WatermarkPrice:     whereHelpernull_Float64{field: "\"conditionalorder\".\"watermark_price\""},
+	OcoGroup:           whereHelpernull_String{field: "\"conditionalorder\".\"oco_group\""},
+	Status:             whereHelperstring{field: "\"conditionalorder\".\"status\""},
+	OrderID:            whereHelpernull_String{field: "\"conditionalorder\".\"order_id\""},
+	Reason:             whereHelpernull_String{field: "\"conditionalorder\".\"reason\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"conditionalorder\".\"created_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"conditionalorder\".\"updated_at\""},
+}
+
+// ConditionalorderRels is where relationship names are stored.
+var ConditionalorderRels = struct {
+}{}
+
+// conditionalorderR is where relationships are stored.
+type conditionalorderR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*conditionalorderR) NewStruct() *conditionalorderR {
+	return &conditionalorderR{}
+}
+
+// conditionalorderL is where Load methods for each relationship are stored.
+type conditionalorderL struct{}
+
+var (
+	conditionalorderAllColumns            = []string{"id", "exchange", "asset", "base", "quote", "order_type", "side", "amount", "trigger_price", "limit_price", "trailing_distance", "trailing_percentage", "watermark_price", "oco_group", "status", "order_id", "reason", "created_at", "updated_at"}
+	conditionalorderColumnsWithoutDefault = []string{"exchange", "asset", "base", "quote", "order_type", "side", "amount", "trigger_price", "limit_price", "trailing_distance", "trailing_percentage", "watermark_price", "oco_group", "status", "order_id", "reason", "created_at", "updated_at"}
+	conditionalorderColumnsWithDefault    = []string{"id"}
+	conditionalorderPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ConditionalorderSlice is an alias for a slice of pointers to Conditionalorder.
+	// This should generally be used opposed to []Conditionalorder.
+	ConditionalorderSlice []*Conditionalorder
+	// ConditionalorderHook is the signature for custom Conditionalorder hook methods
+	ConditionalorderHook func(context.Context, boil.ContextExecutor, *Conditionalorder) error
+
+	conditionalorderQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	conditionalorderType                 = reflect.TypeOf(&Conditionalorder{})
+	conditionalorderMapping              = queries.MakeStructMapping(conditionalorderType)
+	conditionalorderPrimaryKeyMapping, _ = queries.BindMapping(conditionalorderType, conditionalorderMapping, conditionalorderPrimaryKeyColumns)
+	conditionalorderInsertCacheMut       sync.RWMutex
+	conditionalorderInsertCache          = make(map[string]insertCache)
+	conditionalorderUpdateCacheMut       sync.RWMutex
+	conditionalorderUpdateCache          = make(map[string]updateCache)
+	conditionalorderUpsertCacheMut       sync.RWMutex
+	conditionalorderUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var conditionalorderBeforeInsertHooks []ConditionalorderHook
+var conditionalorderBeforeUpdateHooks []ConditionalorderHook
+var conditionalorderBeforeDeleteHooks []ConditionalorderHook
+var conditionalorderBeforeUpsertHooks []ConditionalorderHook
+
+var conditionalorderAfterInsertHooks []ConditionalorderHook
+var conditionalorderAfterSelectHooks []ConditionalorderHook
+var conditionalorderAfterUpdateHooks []ConditionalorderHook
+var conditionalorderAfterDeleteHooks []ConditionalorderHook
+var conditionalorderAfterUpsertHooks []ConditionalorderHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Conditionalorder) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range conditionalorderBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Conditionalorder) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range conditionalorderBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Conditionalorder) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range conditionalorderBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Conditionalorder) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range conditionalorderBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Conditionalorder) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range conditionalorderAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Conditionalorder) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range conditionalorderAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Conditionalorder) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range conditionalorderAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Conditionalorder) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range conditionalorderAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Conditionalorder) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range conditionalorderAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddConditionalorderHook registers your hook function for all future operations.
+func AddConditionalorderHook(hookPoint boil.HookPoint, conditionalorderHook ConditionalorderHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		conditionalorderBeforeInsertHooks = append(conditionalorderBeforeInsertHooks, conditionalorderHook)
+	case boil.BeforeUpdateHook:
+		conditionalorderBeforeUpdateHooks = append(conditionalorderBeforeUpdateHooks, conditionalorderHook)
+	case boil.BeforeDeleteHook:
+		conditionalorderBeforeDeleteHooks = append(conditionalorderBeforeDeleteHooks, conditionalorderHook)
+	case boil.BeforeUpsertHook:
+		conditionalorderBeforeUpsertHooks = append(conditionalorderBeforeUpsertHooks, conditionalorderHook)
+	case boil.AfterInsertHook:
+		conditionalorderAfterInsertHooks = append(conditionalorderAfterInsertHooks, conditionalorderHook)
+	case boil.AfterSelectHook:
+		conditionalorderAfterSelectHooks = append(conditionalorderAfterSelectHooks, conditionalorderHook)
+	case boil.AfterUpdateHook:
+		conditionalorderAfterUpdateHooks = append(conditionalorderAfterUpdateHooks, conditionalorderHook)
+	case boil.AfterDeleteHook:
+		conditionalorderAfterDeleteHooks = append(conditionalorderAfterDeleteHooks, conditionalorderHook)
+	case boil.AfterUpsertHook:
+		conditionalorderAfterUpsertHooks = append(conditionalorderAfterUpsertHooks, conditionalorderHook)
+	}
+}
+
+// One returns a single conditionalorder record from the query.
+func (q conditionalorderQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Conditionalorder, error) {
+	o := &Conditionalorder{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for conditionalorder")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Conditionalorder records from the query.
+func (q conditionalorderQuery) All(ctx context.Context, exec boil.ContextExecutor) (ConditionalorderSlice, error) {
+	var o []*Conditionalorder
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to Conditionalorder slice")
+	}
+
+	if len(conditionalorderAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Conditionalorder records in the query.
+func (q conditionalorderQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count conditionalorder rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q conditionalorderQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if conditionalorder exists")
+	}
+
+	return count > 0, nil
+}
+
+// Conditionalorders retrieves all the records using an executor.
+func Conditionalorders(mods ...qm.QueryMod) conditionalorderQuery {
+	mods = append(mods, qm.From("\"conditionalorder\""))
+	return conditionalorderQuery{NewQuery(mods...)}
+}
+
+// FindConditionalorder retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindConditionalorder(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Conditionalorder, error) {
+	conditionalorderObj := &Conditionalorder{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"conditionalorder\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, conditionalorderObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from conditionalorder")
+	}
+
+	return conditionalorderObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Conditionalorder) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no conditionalorder provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(conditionalorderColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	conditionalorderInsertCacheMut.RLock()
+	cache, cached := conditionalorderInsertCache[key]
+	conditionalorderInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			conditionalorderAllColumns,
+			conditionalorderColumnsWithDefault,
+			conditionalorderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(conditionalorderType, conditionalorderMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(conditionalorderType, conditionalorderMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"conditionalorder\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"conditionalorder\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into conditionalorder")
+	}
+
+	if !cached {
+		conditionalorderInsertCacheMut.Lock()
+		conditionalorderInsertCache[key] = cache
+		conditionalorderInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Conditionalorder.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Conditionalorder) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	conditionalorderUpdateCacheMut.RLock()
+	cache, cached := conditionalorderUpdateCache[key]
+	conditionalorderUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			conditionalorderAllColumns,
+			conditionalorderPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update conditionalorder, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"conditionalorder\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, conditionalorderPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(conditionalorderType, conditionalorderMapping, append(wl, conditionalorderPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update conditionalorder row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for conditionalorder")
+	}
+
+	if !cached {
+		conditionalorderUpdateCacheMut.Lock()
+		conditionalorderUpdateCache[key] = cache
+		conditionalorderUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q conditionalorderQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for conditionalorder")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for conditionalorder")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ConditionalorderSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), conditionalorderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"conditionalorder\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, conditionalorderPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in conditionalorder slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all conditionalorder")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Conditionalorder) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no conditionalorder provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(conditionalorderColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	conditionalorderUpsertCacheMut.RLock()
+	cache, cached := conditionalorderUpsertCache[key]
+	conditionalorderUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			conditionalorderAllColumns,
+			conditionalorderColumnsWithDefault,
+			conditionalorderColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			conditionalorderAllColumns,
+			conditionalorderPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert conditionalorder, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(conditionalorderPrimaryKeyColumns))
+			copy(conflict, conditionalorderPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"conditionalorder\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(conditionalorderType, conditionalorderMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(conditionalorderType, conditionalorderMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert conditionalorder")
+	}
+
+	if !cached {
+		conditionalorderUpsertCacheMut.Lock()
+		conditionalorderUpsertCache[key] = cache
+		conditionalorderUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Conditionalorder record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Conditionalorder) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no Conditionalorder provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), conditionalorderPrimaryKeyMapping)
+	sql := "DELETE FROM \"conditionalorder\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from conditionalorder")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for conditionalorder")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q conditionalorderQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no conditionalorderQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from conditionalorder")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for conditionalorder")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ConditionalorderSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(conditionalorderBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), conditionalorderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"conditionalorder\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, conditionalorderPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from conditionalorder slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for conditionalorder")
+	}
+
+	if len(conditionalorderAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Conditionalorder) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindConditionalorder(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ConditionalorderSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ConditionalorderSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), conditionalorderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"conditionalorder\".* FROM \"conditionalorder\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, conditionalorderPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in ConditionalorderSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ConditionalorderExists checks if the Conditionalorder row exists.
+func ConditionalorderExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"conditionalorder\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if conditionalorder exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testConditionalorders(t *testing.T) {
+	t.Parallel()
+
+	query := Conditionalorders()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testConditionalordersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Conditionalorders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testConditionalordersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Conditionalorders().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Conditionalorders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testConditionalordersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ConditionalorderSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Conditionalorders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testConditionalordersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ConditionalorderExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Conditionalorder exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ConditionalorderExists to return true, but got false.")
+	}
+}
+
+func testConditionalordersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	conditionalorderFound, err := FindConditionalorder(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if conditionalorderFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testConditionalordersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Conditionalorders().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testConditionalordersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Conditionalorders().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testConditionalordersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	conditionalorderOne := &Conditionalorder{}
+	conditionalorderTwo := &Conditionalorder{}
+	if err = randomize.Struct(seed, conditionalorderOne, conditionalorderDBTypes, false, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+	if err = randomize.Struct(seed, conditionalorderTwo, conditionalorderDBTypes, false, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = conditionalorderOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = conditionalorderTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Conditionalorders().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testConditionalordersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	conditionalorderOne := &Conditionalorder{}
+	conditionalorderTwo := &Conditionalorder{}
+	if err = randomize.Struct(seed, conditionalorderOne, conditionalorderDBTypes, false, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+	if err = randomize.Struct(seed, conditionalorderTwo, conditionalorderDBTypes, false, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = conditionalorderOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = conditionalorderTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Conditionalorders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func conditionalorderBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Conditionalorder) error {
+	*o = Conditionalorder{}
+	return nil
+}
+
+func conditionalorderAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Conditionalorder) error {
+	*o = Conditionalorder{}
+	return nil
+}
+
+func conditionalorderAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Conditionalorder) error {
+	*o = Conditionalorder{}
+	return nil
+}
+
+func conditionalorderBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Conditionalorder) error {
+	*o = Conditionalorder{}
+	return nil
+}
+
+func conditionalorderAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Conditionalorder) error {
+	*o = Conditionalorder{}
+	return nil
+}
+
+func conditionalorderBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Conditionalorder) error {
+	*o = Conditionalorder{}
+	return nil
+}
+
+func conditionalorderAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Conditionalorder) error {
+	*o = Conditionalorder{}
+	return nil
+}
+
+func conditionalorderBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Conditionalorder) error {
+	*o = Conditionalorder{}
+	return nil
+}
+
+func conditionalorderAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Conditionalorder) error {
+	*o = Conditionalorder{}
+	return nil
+}
+
+func testConditionalordersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Conditionalorder{}
+	o := &Conditionalorder{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder object: %s", err)
+	}
+
+	AddConditionalorderHook(boil.BeforeInsertHook, conditionalorderBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	conditionalorderBeforeInsertHooks = []ConditionalorderHook{}
+
+	AddConditionalorderHook(boil.AfterInsertHook, conditionalorderAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	conditionalorderAfterInsertHooks = []ConditionalorderHook{}
+
+	AddConditionalorderHook(boil.AfterSelectHook, conditionalorderAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	conditionalorderAfterSelectHooks = []ConditionalorderHook{}
+
+	AddConditionalorderHook(boil.BeforeUpdateHook, conditionalorderBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	conditionalorderBeforeUpdateHooks = []ConditionalorderHook{}
+
+	AddConditionalorderHook(boil.AfterUpdateHook, conditionalorderAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	conditionalorderAfterUpdateHooks = []ConditionalorderHook{}
+
+	AddConditionalorderHook(boil.BeforeDeleteHook, conditionalorderBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	conditionalorderBeforeDeleteHooks = []ConditionalorderHook{}
+
+	AddConditionalorderHook(boil.AfterDeleteHook, conditionalorderAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	conditionalorderAfterDeleteHooks = []ConditionalorderHook{}
+
+	AddConditionalorderHook(boil.BeforeUpsertHook, conditionalorderBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	conditionalorderBeforeUpsertHooks = []ConditionalorderHook{}
+
+	AddConditionalorderHook(boil.AfterUpsertHook, conditionalorderAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	conditionalorderAfterUpsertHooks = []ConditionalorderHook{}
+}
+
+func testConditionalordersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Conditionalorders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testConditionalordersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(conditionalorderColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Conditionalorders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testConditionalordersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testConditionalordersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ConditionalorderSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testConditionalordersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Conditionalorders().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	conditionalorderDBTypes = map[string]string{`ID`: `uuid`, `Exchange`: `character varying`, `Asset`: `character varying`, `Base`: `character varying`, `Quote`: `character varying`, `OrderType`: `character varying`, `Side`: `character varying`, `Amount`: `double precision`, `TriggerPrice`: `double precision`, `LimitPrice`: `double precision`, `TrailingDistance`: `double precision`, `TrailingPercentage`: `double precision`, `WatermarkPrice`: `double precision`, `OcoGroup`: `character varying`, `Status`: `character varying`, `OrderID`: `character varying`, `Reason`: `text`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                       = bytes.MinRead
+)
+
+func testConditionalordersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(conditionalorderPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(conditionalorderAllColumns) == len(conditionalorderPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Conditionalorders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testConditionalordersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(conditionalorderAllColumns) == len(conditionalorderPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Conditionalorder{}
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Conditionalorders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, conditionalorderDBTypes, true, conditionalorderPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(conditionalorderAllColumns, conditionalorderPrimaryKeyColumns) {
+		fields = conditionalorderAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			conditionalorderAllColumns,
+			conditionalorderPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ConditionalorderSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testConditionalordersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(conditionalorderAllColumns) == len(conditionalorderPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Conditionalorder{}
+	if err = randomize.Struct(seed, &o, conditionalorderDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Conditionalorder: %s", err)
+	}
+
+	count, err := Conditionalorders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, conditionalorderDBTypes, false, conditionalorderPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Conditionalorder struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Conditionalorder: %s", err)
+	}
+
+	count, err = Conditionalorders().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
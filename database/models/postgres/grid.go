@@ -0,0 +1,1124 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// Grid is an object representing the database table.
+type Grid struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Exchange       string      `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	Asset          string      `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Base           string      `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote          string      `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	LowerPrice     float64     `boil:"lower_price" json:"lower_price" toml:"lower_price" yaml:"lower_price"`
+	UpperPrice     float64     `boil:"upper_price" json:"upper_price" toml:"upper_price" yaml:"upper_price"`
+	Levels         int         `boil:"levels" json:"levels" toml:"levels" yaml:"levels"`
+	Spacing        string      `boil:"spacing" json:"spacing" toml:"spacing" yaml:"spacing"`
+	Amount         float64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Reason         null.String `boil:"reason" json:"reason,omitempty" toml:"reason" yaml:"reason,omitempty"`
+	RealisedProfit float64     `boil:"realised_profit" json:"realised_profit" toml:"realised_profit" yaml:"realised_profit"`
+	RoundTrips     int         `boil:"round_trips" json:"round_trips" toml:"round_trips" yaml:"round_trips"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *gridR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L gridL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GridColumns = struct {
+	ID             string
+	Exchange       string
+	Asset          string
+	Base           string
+	Quote          string
+	LowerPrice     string
+	UpperPrice     string
+	Levels         string
+	Spacing        string
+	Amount         string
+	Status         string
+	Reason         string
+	RealisedProfit string
+	RoundTrips     string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	Exchange:       "exchange",
+	Asset:          "asset",
+	Base:           "base",
+	Quote:          "quote",
+	LowerPrice:     "lower_price",
+	UpperPrice:     "upper_price",
+	Levels:         "levels",
+	Spacing:        "spacing",
+	Amount:         "amount",
+	Status:         "status",
+	Reason:         "reason",
+	RealisedProfit: "realised_profit",
+	RoundTrips:     "round_trips",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+
+var GridWhere = struct {
+	ID             whereHelperstring
+	Exchange       whereHelperstring
+	Asset          whereHelperstring
+	Base           whereHelperstring
+	Quote          whereHelperstring
+	LowerPrice     whereHelperfloat64
+	UpperPrice     whereHelperfloat64
+	Levels         whereHelperint
+	Spacing        whereHelperstring
+	Amount         whereHelperfloat64
+	Status         whereHelperstring
+	Reason         whereHelpernull_String
+	RealisedProfit whereHelperfloat64
+	RoundTrips     whereHelperint
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"grid\".\"id\""},
+	Exchange:       whereHelperstring{field: "\"grid\".\"exchange\""},
+	Asset:          whereHelperstring{field: "\"grid\".\"asset\""},
+	Base:           whereHelperstring{field: "\"grid\".\"base\""},
+	Quote:          whereHelperstring{field: "\"grid\".\"quote\""},
+	LowerPrice:     whereHelperfloat64{field: "\"grid\".\"lower_price\""},
+	UpperPrice:     whereHelperfloat64{field: "\"grid\".\"upper_price\""},
+	Levels:         whereHelperint{field: "\"grid\".\"levels\""},
+	Spacing:        whereHelperstring{field: "\"grid\".\"spacing\""},
+	Amount:         whereHelperfloat64{field: "\"grid\".\"amount\""},
+	Status:         whereHelperstring{field: "\"grid\".\"status\""},
+	Reason:         whereHelpernull_String{field: "\"grid\".\"reason\""},
+	RealisedProfit: whereHelperfloat64{field: "\"grid\".\"realised_profit\""},
+	RoundTrips:     whereHelperint{field: "\"grid\".\"round_trips\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"grid\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"grid\".\"updated_at\""},
+}
+
+// GridRels is where relationship names are stored.
+var GridRels = struct {
+	Gridlevels string
+}{
+	Gridlevels: "Gridlevels",
+}
+
+// gridR is where relationships are stored.
+type gridR struct {
+	Gridlevels GridlevelSlice
+}
+
+// NewStruct creates a new relationship struct
+func (*gridR) NewStruct() *gridR {
+	return &gridR{}
+}
+
+// gridL is where Load methods for each relationship are stored.
+type gridL struct{}
+
+var (
+	gridAllColumns            = []string{"id", "exchange", "asset", "base", "quote", "lower_price", "upper_price", "levels", "spacing", "amount", "status", "reason", "realised_profit", "round_trips", "created_at", "updated_at"}
+	gridColumnsWithoutDefault = []string{"exchange", "asset", "base", "quote", "lower_price", "upper_price", "levels", "spacing", "amount", "status", "reason", "realised_profit", "round_trips", "created_at", "updated_at"}
+	gridColumnsWithDefault    = []string{"id"}
+	gridPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// GridSlice is an alias for a slice of pointers to Grid.
+	// This should generally be used opposed to []Grid.
+	GridSlice []*Grid
+	// GridHook is the signature for custom Grid hook methods
+	GridHook func(context.Context, boil.ContextExecutor, *Grid) error
+
+	gridQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	gridType                 = reflect.TypeOf(&Grid{})
+	gridMapping              = queries.MakeStructMapping(gridType)
+	gridPrimaryKeyMapping, _ = queries.BindMapping(gridType, gridMapping, gridPrimaryKeyColumns)
+	gridInsertCacheMut       sync.RWMutex
+	gridInsertCache          = make(map[string]insertCache)
+	gridUpdateCacheMut       sync.RWMutex
+	gridUpdateCache          = make(map[string]updateCache)
+	gridUpsertCacheMut       sync.RWMutex
+	gridUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var gridBeforeInsertHooks []GridHook
+var gridBeforeUpdateHooks []GridHook
+var gridBeforeDeleteHooks []GridHook
+var gridBeforeUpsertHooks []GridHook
+
+var gridAfterInsertHooks []GridHook
+var gridAfterSelectHooks []GridHook
+var gridAfterUpdateHooks []GridHook
+var gridAfterDeleteHooks []GridHook
+var gridAfterUpsertHooks []GridHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Grid) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Grid) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Grid) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Grid) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Grid) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Grid) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Grid) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Grid) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Grid) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGridHook registers your hook function for all future operations.
+func AddGridHook(hookPoint boil.HookPoint, gridHook GridHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		gridBeforeInsertHooks = append(gridBeforeInsertHooks, gridHook)
+	case boil.BeforeUpdateHook:
+		gridBeforeUpdateHooks = append(gridBeforeUpdateHooks, gridHook)
+	case boil.BeforeDeleteHook:
+		gridBeforeDeleteHooks = append(gridBeforeDeleteHooks, gridHook)
+	case boil.BeforeUpsertHook:
+		gridBeforeUpsertHooks = append(gridBeforeUpsertHooks, gridHook)
+	case boil.AfterInsertHook:
+		gridAfterInsertHooks = append(gridAfterInsertHooks, gridHook)
+	case boil.AfterSelectHook:
+		gridAfterSelectHooks = append(gridAfterSelectHooks, gridHook)
+	case boil.AfterUpdateHook:
+		gridAfterUpdateHooks = append(gridAfterUpdateHooks, gridHook)
+	case boil.AfterDeleteHook:
+		gridAfterDeleteHooks = append(gridAfterDeleteHooks, gridHook)
+	case boil.AfterUpsertHook:
+		gridAfterUpsertHooks = append(gridAfterUpsertHooks, gridHook)
+	}
+}
+
+// One returns a single grid record from the query.
+func (q gridQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Grid, error) {
+	o := &Grid{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for grid")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Grid records from the query.
+func (q gridQuery) All(ctx context.Context, exec boil.ContextExecutor) (GridSlice, error) {
+	var o []*Grid
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to Grid slice")
+	}
+
+	if len(gridAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Grid records in the query.
+func (q gridQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count grid rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q gridQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if grid exists")
+	}
+
+	return count > 0, nil
+}
+
+// Gridlevels retrieves all the gridlevel's Gridlevels with an executor.
+func (o *Grid) Gridlevels(mods ...qm.QueryMod) gridlevelQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"gridlevel\".\"grid_id\"=?", o.ID),
+	)
+
+	query := Gridlevels(queryMods...)
+	queries.SetFrom(query.Query, "\"gridlevel\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"gridlevel\".*"})
+	}
+
+	return query
+}
+
+// LoadGridlevels allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (gridL) LoadGridlevels(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGrid interface{}, mods queries.Applicator) error {
+	var slice []*Grid
+	var object *Grid
+
+	if singular {
+		object = maybeGrid.(*Grid)
+	} else {
+		slice = *maybeGrid.(*[]*Grid)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &gridR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &gridR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`gridlevel`), qm.WhereIn(`gridlevel.grid_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load gridlevel")
+	}
+
+	var resultSlice []*Gridlevel
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice gridlevel")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on gridlevel")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for gridlevel")
+	}
+
+	if len(gridlevelAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Gridlevels = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &gridlevelR{}
+			}
+			foreign.R.Grid = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GridID {
+				local.R.Gridlevels = append(local.R.Gridlevels, foreign)
+				if foreign.R == nil {
+					foreign.R = &gridlevelR{}
+				}
+				foreign.R.Grid = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddGridlevels adds the given related objects to the existing relationships
+// of the grid, optionally inserting them as new records.
+// Appends related to o.R.Gridlevels.
+// Sets related.R.Grid appropriately.
+func (o *Grid) AddGridlevels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Gridlevel) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GridID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"gridlevel\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"grid_id"}),
+				strmangle.WhereClause("\"", "\"", 2, gridlevelPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.GridID, rel.Level}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GridID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &gridR{
+			Gridlevels: related,
+		}
+	} else {
+		o.R.Gridlevels = append(o.R.Gridlevels, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &gridlevelR{
+				Grid: o,
+			}
+		} else {
+			rel.R.Grid = o
+		}
+	}
+	return nil
+}
+
+// Grids retrieves all the records using an executor.
+func Grids(mods ...qm.QueryMod) gridQuery {
+	mods = append(mods, qm.From("\"grid\""))
+	return gridQuery{NewQuery(mods...)}
+}
+
+// FindGrid retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGrid(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Grid, error) {
+	gridObj := &Grid{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"grid\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, gridObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from grid")
+	}
+
+	return gridObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Grid) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no grid provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gridColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	gridInsertCacheMut.RLock()
+	cache, cached := gridInsertCache[key]
+	gridInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			gridAllColumns,
+			gridColumnsWithDefault,
+			gridColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(gridType, gridMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(gridType, gridMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"grid\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"grid\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into grid")
+	}
+
+	if !cached {
+		gridInsertCacheMut.Lock()
+		gridInsertCache[key] = cache
+		gridInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Grid.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Grid) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	gridUpdateCacheMut.RLock()
+	cache, cached := gridUpdateCache[key]
+	gridUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			gridAllColumns,
+			gridPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update grid, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"grid\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, gridPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(gridType, gridMapping, append(wl, gridPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update grid row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for grid")
+	}
+
+	if !cached {
+		gridUpdateCacheMut.Lock()
+		gridUpdateCache[key] = cache
+		gridUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q gridQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for grid")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for grid")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GridSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gridPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"grid\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, gridPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in grid slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all grid")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Grid) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no grid provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gridColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	gridUpsertCacheMut.RLock()
+	cache, cached := gridUpsertCache[key]
+	gridUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			gridAllColumns,
+			gridColumnsWithDefault,
+			gridColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			gridAllColumns,
+			gridPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert grid, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(gridPrimaryKeyColumns))
+			copy(conflict, gridPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"grid\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(gridType, gridMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(gridType, gridMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert grid")
+	}
+
+	if !cached {
+		gridUpsertCacheMut.Lock()
+		gridUpsertCache[key] = cache
+		gridUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Grid record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Grid) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no Grid provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), gridPrimaryKeyMapping)
+	sql := "DELETE FROM \"grid\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from grid")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for grid")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q gridQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no gridQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from grid")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for grid")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GridSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(gridBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gridPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"grid\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gridPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from grid slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for grid")
+	}
+
+	if len(gridAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Grid) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGrid(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GridSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GridSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gridPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"grid\".* FROM \"grid\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gridPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in GridSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GridExists checks if the Grid row exists.
+func GridExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"grid\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if grid exists")
+	}
+
+	return exists, nil
+}
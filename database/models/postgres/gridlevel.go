@@ -0,0 +1,1051 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// Gridlevel is an object representing the database table.
+type Gridlevel struct {
+	GridID     string       `boil:"grid_id" json:"grid_id" toml:"grid_id" yaml:"grid_id"`
+	Level      int          `boil:"level" json:"level" toml:"level" yaml:"level"`
+	Price      float64      `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Side       null.String  `boil:"side" json:"side,omitempty" toml:"side" yaml:"side,omitempty"`
+	OrderID    null.String  `boil:"order_id" json:"order_id,omitempty" toml:"order_id" yaml:"order_id,omitempty"`
+	EntryPrice null.Float64 `boil:"entry_price" json:"entry_price,omitempty" toml:"entry_price" yaml:"entry_price,omitempty"`
+
+	R *gridlevelR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L gridlevelL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GridlevelColumns = struct {
+	GridID     string
+	Level      string
+	Price      string
+	Side       string
+	OrderID    string
+	EntryPrice string
+}{
+	GridID:     "grid_id",
+	Level:      "level",
+	Price:      "price",
+	Side:       "side",
+	OrderID:    "order_id",
+	EntryPrice: "entry_price",
+}
+
+// Generated where
+
+var GridlevelWhere = struct {
+	GridID     whereHelperstring
+	Level      whereHelperint
+	Price      whereHelperfloat64
+	Side       whereHelpernull_String
+	OrderID    whereHelpernull_String
+	EntryPrice whereHelpernull_Float64
+}{
+	GridID:     whereHelperstring{field: "\"gridlevel\".\"grid_id\""},
+	Level:      whereHelperint{field: "\"gridlevel\".\"level\""},
+	Price:      whereHelperfloat64{field: "\"gridlevel\".\"price\""},
+	Side:       whereHelpernull_String{field: "\"gridlevel\".\"side\""},
+	OrderID:    whereHelpernull_String{field: "\"gridlevel\".\"order_id\""},
+	EntryPrice: whereHelpernull_Float64{field: "\"gridlevel\".\"entry_price\""},
+}
+
+// GridlevelRels is where relationship names are stored.
+var GridlevelRels = struct {
+	Grid string
+}{
+	Grid: "Grid",
+}
+
+// gridlevelR is where relationships are stored.
+type gridlevelR struct {
+	Grid *Grid
+}
+
+// NewStruct creates a new relationship struct
+func (*gridlevelR) NewStruct() *gridlevelR {
+	return &gridlevelR{}
+}
+
+// gridlevelL is where Load methods for each relationship are stored.
+type gridlevelL struct{}
+
+var (
+	gridlevelAllColumns            = []string{"grid_id", "level", "price", "side", "order_id", "entry_price"}
+	gridlevelColumnsWithoutDefault = []string{"grid_id", "level", "price", "side", "order_id", "entry_price"}
+	gridlevelColumnsWithDefault    = []string{}
+	gridlevelPrimaryKeyColumns     = []string{"grid_id", "level"}
+)
+
+type (
+	// GridlevelSlice is an alias for a slice of pointers to Gridlevel.
+	// This should generally be used opposed to []Gridlevel.
+	GridlevelSlice []*Gridlevel
+	// GridlevelHook is the signature for custom Gridlevel hook methods
+	GridlevelHook func(context.Context, boil.ContextExecutor, *Gridlevel) error
+
+	gridlevelQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	gridlevelType                 = reflect.TypeOf(&Gridlevel{})
+	gridlevelMapping              = queries.MakeStructMapping(gridlevelType)
+	gridlevelPrimaryKeyMapping, _ = queries.BindMapping(gridlevelType, gridlevelMapping, gridlevelPrimaryKeyColumns)
+	gridlevelInsertCacheMut       sync.RWMutex
+	gridlevelInsertCache          = make(map[string]insertCache)
+	gridlevelUpdateCacheMut       sync.RWMutex
+	gridlevelUpdateCache          = make(map[string]updateCache)
+	gridlevelUpsertCacheMut       sync.RWMutex
+	gridlevelUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var gridlevelBeforeInsertHooks []GridlevelHook
+var gridlevelBeforeUpdateHooks []GridlevelHook
+var gridlevelBeforeDeleteHooks []GridlevelHook
+var gridlevelBeforeUpsertHooks []GridlevelHook
+
+var gridlevelAfterInsertHooks []GridlevelHook
+var gridlevelAfterSelectHooks []GridlevelHook
+var gridlevelAfterUpdateHooks []GridlevelHook
+var gridlevelAfterDeleteHooks []GridlevelHook
+var gridlevelAfterUpsertHooks []GridlevelHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Gridlevel) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridlevelBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Gridlevel) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridlevelBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Gridlevel) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridlevelBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Gridlevel) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridlevelBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Gridlevel) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridlevelAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Gridlevel) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridlevelAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Gridlevel) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridlevelAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Gridlevel) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridlevelAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Gridlevel) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gridlevelAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGridlevelHook registers your hook function for all future operations.
+func AddGridlevelHook(hookPoint boil.HookPoint, gridlevelHook GridlevelHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		gridlevelBeforeInsertHooks = append(gridlevelBeforeInsertHooks, gridlevelHook)
+	case boil.BeforeUpdateHook:
+		gridlevelBeforeUpdateHooks = append(gridlevelBeforeUpdateHooks, gridlevelHook)
+	case boil.BeforeDeleteHook:
+		gridlevelBeforeDeleteHooks = append(gridlevelBeforeDeleteHooks, gridlevelHook)
+	case boil.BeforeUpsertHook:
+		gridlevelBeforeUpsertHooks = append(gridlevelBeforeUpsertHooks, gridlevelHook)
+	case boil.AfterInsertHook:
+		gridlevelAfterInsertHooks = append(gridlevelAfterInsertHooks, gridlevelHook)
+	case boil.AfterSelectHook:
+		gridlevelAfterSelectHooks = append(gridlevelAfterSelectHooks, gridlevelHook)
+	case boil.AfterUpdateHook:
+		gridlevelAfterUpdateHooks = append(gridlevelAfterUpdateHooks, gridlevelHook)
+	case boil.AfterDeleteHook:
+		gridlevelAfterDeleteHooks = append(gridlevelAfterDeleteHooks, gridlevelHook)
+	case boil.AfterUpsertHook:
+		gridlevelAfterUpsertHooks = append(gridlevelAfterUpsertHooks, gridlevelHook)
+	}
+}
+
+// One returns a single gridlevel record from the query.
+func (q gridlevelQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Gridlevel, error) {
+	o := &Gridlevel{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for gridlevel")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Gridlevel records from the query.
+func (q gridlevelQuery) All(ctx context.Context, exec boil.ContextExecutor) (GridlevelSlice, error) {
+	var o []*Gridlevel
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to Gridlevel slice")
+	}
+
+	if len(gridlevelAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Gridlevel records in the query.
+func (q gridlevelQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count gridlevel rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q gridlevelQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if gridlevel exists")
+	}
+
+	return count > 0, nil
+}
+
+// Grid pointed to by the foreign key.
+func (o *Gridlevel) Grid(mods ...qm.QueryMod) gridQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.GridID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Grids(queryMods...)
+	queries.SetFrom(query.Query, "\"grid\"")
+
+	return query
+}
+
+// LoadGrid allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (gridlevelL) LoadGrid(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGridlevel interface{}, mods queries.Applicator) error {
+	var slice []*Gridlevel
+	var object *Gridlevel
+
+	if singular {
+		object = maybeGridlevel.(*Gridlevel)
+	} else {
+		slice = *maybeGridlevel.(*[]*Gridlevel)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &gridlevelR{}
+		}
+		args = append(args, object.GridID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &gridlevelR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GridID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GridID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`grid`), qm.WhereIn(`grid.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Grid")
+	}
+
+	var resultSlice []*Grid
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Grid")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for grid")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for grid")
+	}
+
+	if len(gridlevelAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Grid = foreign
+		if foreign.R == nil {
+			foreign.R = &gridR{}
+		}
+		foreign.R.Gridlevels = append(foreign.R.Gridlevels, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.GridID == foreign.ID {
+				local.R.Grid = foreign
+				if foreign.R == nil {
+					foreign.R = &gridR{}
+				}
+				foreign.R.Gridlevels = append(foreign.R.Gridlevels, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetGrid of the gridlevel to the related item.
+// Sets o.R.Grid to related.
+// Adds o to related.R.Gridlevels.
+func (o *Gridlevel) SetGrid(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Grid) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"gridlevel\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"grid_id"}),
+		strmangle.WhereClause("\"", "\"", 2, gridlevelPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.GridID, o.Level}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.GridID = related.ID
+	if o.R == nil {
+		o.R = &gridlevelR{
+			Grid: related,
+		}
+	} else {
+		o.R.Grid = related
+	}
+
+	if related.R == nil {
+		related.R = &gridR{
+			Gridlevels: GridlevelSlice{o},
+		}
+	} else {
+		related.R.Gridlevels = append(related.R.Gridlevels, o)
+	}
+
+	return nil
+}
+
+// Gridlevels retrieves all the records using an executor.
+func Gridlevels(mods ...qm.QueryMod) gridlevelQuery {
+	mods = append(mods, qm.From("\"gridlevel\""))
+	return gridlevelQuery{NewQuery(mods...)}
+}
+
+// FindGridlevel retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGridlevel(ctx context.Context, exec boil.ContextExecutor, gridID string, level int, selectCols ...string) (*Gridlevel, error) {
+	gridlevelObj := &Gridlevel{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"gridlevel\" where \"grid_id\"=$1 AND \"level\"=$2", sel,
+	)
+
+	q := queries.Raw(query, gridID, level)
+
+	err := q.Bind(ctx, exec, gridlevelObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from gridlevel")
+	}
+
+	return gridlevelObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Gridlevel) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no gridlevel provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gridlevelColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	gridlevelInsertCacheMut.RLock()
+	cache, cached := gridlevelInsertCache[key]
+	gridlevelInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			gridlevelAllColumns,
+			gridlevelColumnsWithDefault,
+			gridlevelColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(gridlevelType, gridlevelMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(gridlevelType, gridlevelMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"gridlevel\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"gridlevel\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into gridlevel")
+	}
+
+	if !cached {
+		gridlevelInsertCacheMut.Lock()
+		gridlevelInsertCache[key] = cache
+		gridlevelInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Gridlevel.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Gridlevel) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	gridlevelUpdateCacheMut.RLock()
+	cache, cached := gridlevelUpdateCache[key]
+	gridlevelUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			gridlevelAllColumns,
+			gridlevelPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update gridlevel, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"gridlevel\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, gridlevelPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(gridlevelType, gridlevelMapping, append(wl, gridlevelPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update gridlevel row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for gridlevel")
+	}
+
+	if !cached {
+		gridlevelUpdateCacheMut.Lock()
+		gridlevelUpdateCache[key] = cache
+		gridlevelUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q gridlevelQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for gridlevel")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for gridlevel")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GridlevelSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gridlevelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"gridlevel\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, gridlevelPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in gridlevel slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all gridlevel")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Gridlevel) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no gridlevel provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gridlevelColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	gridlevelUpsertCacheMut.RLock()
+	cache, cached := gridlevelUpsertCache[key]
+	gridlevelUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			gridlevelAllColumns,
+			gridlevelColumnsWithDefault,
+			gridlevelColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			gridlevelAllColumns,
+			gridlevelPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert gridlevel, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(gridlevelPrimaryKeyColumns))
+			copy(conflict, gridlevelPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"gridlevel\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(gridlevelType, gridlevelMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(gridlevelType, gridlevelMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert gridlevel")
+	}
+
+	if !cached {
+		gridlevelUpsertCacheMut.Lock()
+		gridlevelUpsertCache[key] = cache
+		gridlevelUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Gridlevel record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Gridlevel) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no Gridlevel provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), gridlevelPrimaryKeyMapping)
+	sql := "DELETE FROM \"gridlevel\" WHERE \"grid_id\"=$1 AND \"level\"=$2"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from gridlevel")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for gridlevel")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q gridlevelQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no gridlevelQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from gridlevel")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for gridlevel")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GridlevelSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(gridlevelBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gridlevelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"gridlevel\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gridlevelPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from gridlevel slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for gridlevel")
+	}
+
+	if len(gridlevelAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Gridlevel) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGridlevel(ctx, exec, o.GridID, o.Level)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GridlevelSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GridlevelSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gridlevelPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"gridlevel\".* FROM \"gridlevel\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gridlevelPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in GridlevelSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GridlevelExists checks if the Gridlevel row exists.
+func GridlevelExists(ctx context.Context, exec boil.ContextExecutor, gridID string, level int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"gridlevel\" where \"grid_id\"=$1 AND \"level\"=$2 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, gridID, level)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, gridID, level)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if gridlevel exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testGridlevels(t *testing.T) {
+	t.Parallel()
+
+	query := Gridlevels()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testGridlevelsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Gridlevels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGridlevelsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Gridlevels().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Gridlevels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGridlevelsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := GridlevelSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Gridlevels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGridlevelsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := GridlevelExists(ctx, tx, o.GridID, o.Level)
+	if err != nil {
+		t.Errorf("Unable to check if Gridlevel exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected GridlevelExists to return true, but got false.")
+	}
+}
+
+func testGridlevelsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	gridlevelFound, err := FindGridlevel(ctx, tx, o.GridID, o.Level)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gridlevelFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testGridlevelsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Gridlevels().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGridlevelsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Gridlevels().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testGridlevelsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	gridlevelOne := &Gridlevel{}
+	gridlevelTwo := &Gridlevel{}
+	if err = randomize.Struct(seed, gridlevelOne, gridlevelDBTypes, false, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+	if err = randomize.Struct(seed, gridlevelTwo, gridlevelDBTypes, false, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = gridlevelOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = gridlevelTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Gridlevels().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testGridlevelsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	gridlevelOne := &Gridlevel{}
+	gridlevelTwo := &Gridlevel{}
+	if err = randomize.Struct(seed, gridlevelOne, gridlevelDBTypes, false, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+	if err = randomize.Struct(seed, gridlevelTwo, gridlevelDBTypes, false, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = gridlevelOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = gridlevelTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Gridlevels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func gridlevelBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Gridlevel) error {
+	*o = Gridlevel{}
+	return nil
+}
+
+func gridlevelAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Gridlevel) error {
+	*o = Gridlevel{}
+	return nil
+}
+
+func gridlevelAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Gridlevel) error {
+	*o = Gridlevel{}
+	return nil
+}
+
+func gridlevelBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Gridlevel) error {
+	*o = Gridlevel{}
+	return nil
+}
+
+func gridlevelAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Gridlevel) error {
+	*o = Gridlevel{}
+	return nil
+}
+
+func gridlevelBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Gridlevel) error {
+	*o = Gridlevel{}
+	return nil
+}
+
+func gridlevelAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Gridlevel) error {
+	*o = Gridlevel{}
+	return nil
+}
+
+func gridlevelBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Gridlevel) error {
+	*o = Gridlevel{}
+	return nil
+}
+
+func gridlevelAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Gridlevel) error {
+	*o = Gridlevel{}
+	return nil
+}
+
+func testGridlevelsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Gridlevel{}
+	o := &Gridlevel{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Gridlevel object: %s", err)
+	}
+
+	AddGridlevelHook(boil.BeforeInsertHook, gridlevelBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	gridlevelBeforeInsertHooks = []GridlevelHook{}
+
+	AddGridlevelHook(boil.AfterInsertHook, gridlevelAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	gridlevelAfterInsertHooks = []GridlevelHook{}
+
+	AddGridlevelHook(boil.AfterSelectHook, gridlevelAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	gridlevelAfterSelectHooks = []GridlevelHook{}
+
+	AddGridlevelHook(boil.BeforeUpdateHook, gridlevelBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	gridlevelBeforeUpdateHooks = []GridlevelHook{}
+
+	AddGridlevelHook(boil.AfterUpdateHook, gridlevelAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	gridlevelAfterUpdateHooks = []GridlevelHook{}
+
+	AddGridlevelHook(boil.BeforeDeleteHook, gridlevelBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	gridlevelBeforeDeleteHooks = []GridlevelHook{}
+
+	AddGridlevelHook(boil.AfterDeleteHook, gridlevelAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	gridlevelAfterDeleteHooks = []GridlevelHook{}
+
+	AddGridlevelHook(boil.BeforeUpsertHook, gridlevelBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	gridlevelBeforeUpsertHooks = []GridlevelHook{}
+
+	AddGridlevelHook(boil.AfterUpsertHook, gridlevelAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	gridlevelAfterUpsertHooks = []GridlevelHook{}
+}
+
+func testGridlevelsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Gridlevels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testGridlevelsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(gridlevelColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Gridlevels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testGridlevelToOneGridUsingGrid(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Gridlevel
+	var foreign Grid
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, gridlevelDBTypes, false, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, gridDBTypes, false, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.GridID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Grid().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := GridlevelSlice{&local}
+	if err = local.L.LoadGrid(ctx, tx, false, (*[]*Gridlevel)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Grid == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Grid = nil
+	if err = local.L.LoadGrid(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Grid == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testGridlevelToOneSetOpGridUsingGrid(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Gridlevel
+	var b, c Grid
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gridlevelDBTypes, false, strmangle.SetComplement(gridlevelPrimaryKeyColumns, gridlevelColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, gridDBTypes, false, strmangle.SetComplement(gridPrimaryKeyColumns, gridColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, gridDBTypes, false, strmangle.SetComplement(gridPrimaryKeyColumns, gridColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Grid{&b, &c} {
+		err = a.SetGrid(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Grid != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Gridlevels[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.GridID != x.ID {
+			t.Error("foreign key was wrong value", a.GridID)
+		}
+
+		if exists, err := GridlevelExists(ctx, tx, a.GridID, a.Level); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testGridlevelsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGridlevelsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := GridlevelSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGridlevelsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Gridlevels().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	gridlevelDBTypes = map[string]string{`GridID`: `uuid`, `Level`: `integer`, `Price`: `double precision`, `Side`: `character varying`, `OrderID`: `character varying`, `EntryPrice`: `double precision`}
+	_                = bytes.MinRead
+)
+
+func testGridlevelsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(gridlevelPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(gridlevelAllColumns) == len(gridlevelPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Gridlevels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testGridlevelsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(gridlevelAllColumns) == len(gridlevelPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Gridlevel{}
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Gridlevels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, gridlevelDBTypes, true, gridlevelPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(gridlevelAllColumns, gridlevelPrimaryKeyColumns) {
+		fields = gridlevelAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			gridlevelAllColumns,
+			gridlevelPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := GridlevelSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testGridlevelsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(gridlevelAllColumns) == len(gridlevelPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Gridlevel{}
+	if err = randomize.Struct(seed, &o, gridlevelDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Gridlevel: %s", err)
+	}
+
+	count, err := Gridlevels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, gridlevelDBTypes, false, gridlevelPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Gridlevel struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Gridlevel: %s", err)
+	}
+
+	count, err = Gridlevels().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
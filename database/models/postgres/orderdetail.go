@@ -0,0 +1,1295 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// Orderdetail is an object representing the database table.
+type Orderdetail struct {
+	ID                   string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Exchange             string      `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	Asset                string      `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Base                 string      `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote                string      `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	OrderID              string      `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
+	ClientOrderID        null.String `boil:"client_order_id" json:"client_order_id,omitempty" toml:"client_order_id" yaml:"client_order_id,omitempty"`
+	OrderType            string      `boil:"order_type" json:"order_type" toml:"order_type" yaml:"order_type"`
+	Side                 string      `boil:"side" json:"side" toml:"side" yaml:"side"`
+	Status               string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Price                float64     `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Amount               float64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	ExecutedAmount       float64     `boil:"executed_amount" json:"executed_amount" toml:"executed_amount" yaml:"executed_amount"`
+	RemainingAmount      float64     `boil:"remaining_amount" json:"remaining_amount" toml:"remaining_amount" yaml:"remaining_amount"`
+	AverageExecutedPrice float64     `boil:"average_executed_price" json:"average_executed_price" toml:"average_executed_price" yaml:"average_executed_price"`
+	Fee                  float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	Cost                 float64     `boil:"cost" json:"cost" toml:"cost" yaml:"cost"`
+	CreatedAt            time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt            time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *orderdetailR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L orderdetailL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrderdetailColumns = struct {
+	ID                   string
+	Exchange             string
+	Asset                string
+	Base                 string
+	Quote                string
+	OrderID              string
+	ClientOrderID        string
+	OrderType            string
+	Side                 string
+	Status               string
+	Price                string
+	Amount               string
+	ExecutedAmount       string
+	RemainingAmount      string
+	AverageExecutedPrice string
+	Fee                  string
+	Cost                 string
+	CreatedAt            string
+	UpdatedAt            string
+}{
+	ID:                   "id",
+	Exchange:             "exchange",
+	Asset:                "asset",
+	Base:                 "base",
+	Quote:                "quote",
+	OrderID:              "order_id",
+	ClientOrderID:        "client_order_id",
+	OrderType:            "order_type",
+	Side:                 "side",
+	Status:               "status",
+	Price:                "price",
+	Amount:               "amount",
+	ExecutedAmount:       "executed_amount",
+	RemainingAmount:      "remaining_amount",
+	AverageExecutedPrice: "average_executed_price",
+	Fee:                  "fee",
+	Cost:                 "cost",
+	CreatedAt:            "created_at",
+	UpdatedAt:            "updated_at",
+}
+
+// Generated where
+
+var OrderdetailWhere = struct {
+	ID                   whereHelperstring
+	Exchange             whereHelperstring
+	Asset                whereHelperstring
+	Base                 whereHelperstring
+	Quote                whereHelperstring
+	OrderID              whereHelperstring
+	ClientOrderID        whereHelpernull_String
+	OrderType            whereHelperstring
+	Side                 whereHelperstring
+	Status               whereHelperstring
+	Price                whereHelperfloat64
+	Amount               whereHelperfloat64
+	ExecutedAmount       whereHelperfloat64
+	RemainingAmount      whereHelperfloat64
+	AverageExecutedPrice whereHelperfloat64
+	Fee                  whereHelperfloat64
+	Cost                 whereHelperfloat64
+	CreatedAt            whereHelpertime_Time
+	UpdatedAt            whereHelpertime_Time
+}{
+	ID:                   whereHelperstring{field: "\"orderdetail\".\"id\""},
+	Exchange:             whereHelperstring{field: "\"orderdetail\".\"exchange\""},
+	Asset:                whereHelperstring{field: "\"orderdetail\".\"asset\""},
+	Base:                 whereHelperstring{field: "\"orderdetail\".\"base\""},
+	Quote:                whereHelperstring{field: "\"orderdetail\".\"quote\""},
+	OrderID:              whereHelperstring{field: "\"orderdetail\".\"order_id\""},
+	ClientOrderID:        whereHelpernull_String{field: "\"orderdetail\".\"client_order_id\""},
+	OrderType:            whereHelperstring{field: "\"orderdetail\".\"order_type\""},
+	Side:                 whereHelperstring{field: "\"orderdetail\".\"side\""},
+	Status:               whereHelperstring{field: "\"orderdetail\".\"status\""},
+	Price:                whereHelperfloat64{field: "\"orderdetail\".\"price\""},
+	Amount:               whereHelperfloat64{field: "\"orderdetail\".\"amount\""},
+	ExecutedAmount:       whereHelperfloat64{field: "\"orderdetail\".\"executed_amount\""},
+	RemainingAmount:      whereHelperfloat64{field: "\"orderdetail\".\"remaining_amount\""},
+	AverageExecutedPrice: whereHelperfloat64{field: "\"orderdetail\".\"average_executed_price\""},
+	Fee:                  whereHelperfloat64{field: "\"orderdetail\".\"fee\""},
+	Cost:                 whereHelperfloat64{field: "\"orderdetail\".\"cost\""},
+	CreatedAt:            whereHelpertime_Time{field: "\"orderdetail\".\"created_at\""},
+	UpdatedAt:            whereHelpertime_Time{field: "\"orderdetail\".\"updated_at\""},
+}
+
+// OrderdetailRels is where relationship names are stored.
+var OrderdetailRels = struct {
+	Orderevents string
+	Ordertrades string
+}{
+	Orderevents: "Orderevents",
+	Ordertrades: "Ordertrades",
+}
+
+// orderdetailR is where relationships are stored.
+type orderdetailR struct {
+	Orderevents OrdereventSlice
+	Ordertrades OrdertradeSlice
+}
+
+// NewStruct creates a new relationship struct
+func (*orderdetailR) NewStruct() *orderdetailR {
+	return &orderdetailR{}
+}
+
+// orderdetailL is where Load methods for each relationship are stored.
+type orderdetailL struct{}
+
+var (
+	orderdetailAllColumns            = []string{"id", "exchange", "asset", "base", "quote", "order_id", "client_order_id", "order_type", "side", "status", "price", "amount", "executed_amount", "remaining_amount", "average_executed_price", "fee", "cost", "created_at", "updated_at"}
+	orderdetailColumnsWithoutDefault = []string{"exchange", "asset", "base", "quote", "order_id", "client_order_id", "order_type", "side", "status", "price", "amount", "executed_amount", "remaining_amount", "average_executed_price", "fee", "cost", "created_at", "updated_at"}
+	orderdetailColumnsWithDefault    = []string{"id"}
+	orderdetailPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// OrderdetailSlice is an alias for a slice of pointers to Orderdetail.
+	// This should generally be used opposed to []Orderdetail.
+	OrderdetailSlice []*Orderdetail
+	// OrderdetailHook is the signature for custom Orderdetail hook methods
+	OrderdetailHook func(context.Context, boil.ContextExecutor, *Orderdetail) error
+
+	orderdetailQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	orderdetailType                 = reflect.TypeOf(&Orderdetail{})
+	orderdetailMapping              = queries.MakeStructMapping(orderdetailType)
+	orderdetailPrimaryKeyMapping, _ = queries.BindMapping(orderdetailType, orderdetailMapping, orderdetailPrimaryKeyColumns)
+	orderdetailInsertCacheMut       sync.RWMutex
+	orderdetailInsertCache          = make(map[string]insertCache)
+	orderdetailUpdateCacheMut       sync.RWMutex
+	orderdetailUpdateCache          = make(map[string]updateCache)
+	orderdetailUpsertCacheMut       sync.RWMutex
+	orderdetailUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var orderdetailBeforeInsertHooks []OrderdetailHook
+var orderdetailBeforeUpdateHooks []OrderdetailHook
+var orderdetailBeforeDeleteHooks []OrderdetailHook
+var orderdetailBeforeUpsertHooks []OrderdetailHook
+
+var orderdetailAfterInsertHooks []OrderdetailHook
+var orderdetailAfterSelectHooks []OrderdetailHook
+var orderdetailAfterUpdateHooks []OrderdetailHook
+var orderdetailAfterDeleteHooks []OrderdetailHook
+var orderdetailAfterUpsertHooks []OrderdetailHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Orderdetail) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderdetailBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Orderdetail) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderdetailBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Orderdetail) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderdetailBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Orderdetail) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderdetailBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Orderdetail) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderdetailAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Orderdetail) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderdetailAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Orderdetail) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderdetailAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Orderdetail) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderdetailAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Orderdetail) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderdetailAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrderdetailHook registers your hook function for all future operations.
+func AddOrderdetailHook(hookPoint boil.HookPoint, orderdetailHook OrderdetailHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		orderdetailBeforeInsertHooks = append(orderdetailBeforeInsertHooks, orderdetailHook)
+	case boil.BeforeUpdateHook:
+		orderdetailBeforeUpdateHooks = append(orderdetailBeforeUpdateHooks, orderdetailHook)
+	case boil.BeforeDeleteHook:
+		orderdetailBeforeDeleteHooks = append(orderdetailBeforeDeleteHooks, orderdetailHook)
+	case boil.BeforeUpsertHook:
+		orderdetailBeforeUpsertHooks = append(orderdetailBeforeUpsertHooks, orderdetailHook)
+	case boil.AfterInsertHook:
+		orderdetailAfterInsertHooks = append(orderdetailAfterInsertHooks, orderdetailHook)
+	case boil.AfterSelectHook:
+		orderdetailAfterSelectHooks = append(orderdetailAfterSelectHooks, orderdetailHook)
+	case boil.AfterUpdateHook:
+		orderdetailAfterUpdateHooks = append(orderdetailAfterUpdateHooks, orderdetailHook)
+	case boil.AfterDeleteHook:
+		orderdetailAfterDeleteHooks = append(orderdetailAfterDeleteHooks, orderdetailHook)
+	case boil.AfterUpsertHook:
+		orderdetailAfterUpsertHooks = append(orderdetailAfterUpsertHooks, orderdetailHook)
+	}
+}
+
+// One returns a single orderdetail record from the query.
+func (q orderdetailQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Orderdetail, error) {
+	o := &Orderdetail{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for orderdetail")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Orderdetail records from the query.
+func (q orderdetailQuery) All(ctx context.Context, exec boil.ContextExecutor) (OrderdetailSlice, error) {
+	var o []*Orderdetail
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to Orderdetail slice")
+	}
+
+	if len(orderdetailAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Orderdetail records in the query.
+func (q orderdetailQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count orderdetail rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q orderdetailQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if orderdetail exists")
+	}
+
+	return count > 0, nil
+}
+
+// Orderevents retrieves all the orderevent's Orderevents with an executor.
+func (o *Orderdetail) Orderevents(mods ...qm.QueryMod) ordereventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"orderevent\".\"orderdetail_id\"=?", o.ID),
+	)
+
+	query := Orderevents(queryMods...)
+	queries.SetFrom(query.Query, "\"orderevent\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"orderevent\".*"})
+	}
+
+	return query
+}
+
+// Ordertrades retrieves all the ordertrade's Ordertrades with an executor.
+func (o *Orderdetail) Ordertrades(mods ...qm.QueryMod) ordertradeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"ordertrade\".\"orderdetail_id\"=?", o.ID),
+	)
+
+	query := Ordertrades(queryMods...)
+	queries.SetFrom(query.Query, "\"ordertrade\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"ordertrade\".*"})
+	}
+
+	return query
+}
+
+// LoadOrderevents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (orderdetailL) LoadOrderevents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrderdetail interface{}, mods queries.Applicator) error {
+	var slice []*Orderdetail
+	var object *Orderdetail
+
+	if singular {
+		object = maybeOrderdetail.(*Orderdetail)
+	} else {
+		slice = *maybeOrderdetail.(*[]*Orderdetail)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderdetailR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderdetailR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`orderevent`), qm.WhereIn(`orderevent.orderdetail_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load orderevent")
+	}
+
+	var resultSlice []*Orderevent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice orderevent")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on orderevent")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for orderevent")
+	}
+
+	if len(ordereventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Orderevents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &ordereventR{}
+			}
+			foreign.R.Orderdetail = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrderdetailID {
+				local.R.Orderevents = append(local.R.Orderevents, foreign)
+				if foreign.R == nil {
+					foreign.R = &ordereventR{}
+				}
+				foreign.R.Orderdetail = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrdertrades allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (orderdetailL) LoadOrdertrades(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrderdetail interface{}, mods queries.Applicator) error {
+	var slice []*Orderdetail
+	var object *Orderdetail
+
+	if singular {
+		object = maybeOrderdetail.(*Orderdetail)
+	} else {
+		slice = *maybeOrderdetail.(*[]*Orderdetail)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &orderdetailR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderdetailR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`ordertrade`), qm.WhereIn(`ordertrade.orderdetail_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ordertrade")
+	}
+
+	var resultSlice []*Ordertrade
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ordertrade")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on ordertrade")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for ordertrade")
+	}
+
+	if len(ordertradeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Ordertrades = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &ordertradeR{}
+			}
+			foreign.R.Orderdetail = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrderdetailID {
+				local.R.Ordertrades = append(local.R.Ordertrades, foreign)
+				if foreign.R == nil {
+					foreign.R = &ordertradeR{}
+				}
+				foreign.R.Orderdetail = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddOrderevents adds the given related objects to the existing relationships
+// of the orderdetail, optionally inserting them as new records.
+// Appends related to o.R.Orderevents.
+// Sets related.R.Orderdetail appropriately.
+func (o *Orderdetail) AddOrderevents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Orderevent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrderdetailID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"orderevent\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"orderdetail_id"}),
+				strmangle.WhereClause("\"", "\"", 2, ordereventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrderdetailID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &orderdetailR{
+			Orderevents: related,
+		}
+	} else {
+		o.R.Orderevents = append(o.R.Orderevents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &ordereventR{
+				Orderdetail: o,
+			}
+		} else {
+			rel.R.Orderdetail = o
+		}
+	}
+	return nil
+}
+
+// AddOrdertrades adds the given related objects to the existing relationships
+// of the orderdetail, optionally inserting them as new records.
+// Appends related to o.R.Ordertrades.
+// Sets related.R.Orderdetail appropriately.
+func (o *Orderdetail) AddOrdertrades(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Ordertrade) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrderdetailID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"ordertrade\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"orderdetail_id"}),
+				strmangle.WhereClause("\"", "\"", 2, ordertradePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.OrderdetailID, rel.Tid}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrderdetailID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &orderdetailR{
+			Ordertrades: related,
+		}
+	} else {
+		o.R.Ordertrades = append(o.R.Ordertrades, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &ordertradeR{
+				Orderdetail: o,
+			}
+		} else {
+			rel.R.Orderdetail = o
+		}
+	}
+	return nil
+}
+
+// Orderdetails retrieves all the records using an executor.
+func Orderdetails(mods ...qm.QueryMod) orderdetailQuery {
+	mods = append(mods, qm.From("\"orderdetail\""))
+	return orderdetailQuery{NewQuery(mods...)}
+}
+
+// FindOrderdetail retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrderdetail(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Orderdetail, error) {
+	orderdetailObj := &Orderdetail{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"orderdetail\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, orderdetailObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from orderdetail")
+	}
+
+	return orderdetailObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Orderdetail) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no orderdetail provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(orderdetailColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	orderdetailInsertCacheMut.RLock()
+	cache, cached := orderdetailInsertCache[key]
+	orderdetailInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			orderdetailAllColumns,
+			orderdetailColumnsWithDefault,
+			orderdetailColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(orderdetailType, orderdetailMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(orderdetailType, orderdetailMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"orderdetail\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"orderdetail\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into orderdetail")
+	}
+
+	if !cached {
+		orderdetailInsertCacheMut.Lock()
+		orderdetailInsertCache[key] = cache
+		orderdetailInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Orderdetail.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Orderdetail) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	orderdetailUpdateCacheMut.RLock()
+	cache, cached := orderdetailUpdateCache[key]
+	orderdetailUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			orderdetailAllColumns,
+			orderdetailPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update orderdetail, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"orderdetail\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, orderdetailPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(orderdetailType, orderdetailMapping, append(wl, orderdetailPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update orderdetail row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for orderdetail")
+	}
+
+	if !cached {
+		orderdetailUpdateCacheMut.Lock()
+		orderdetailUpdateCache[key] = cache
+		orderdetailUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q orderdetailQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for orderdetail")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for orderdetail")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrderdetailSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderdetailPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"orderdetail\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, orderdetailPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in orderdetail slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all orderdetail")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Orderdetail) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no orderdetail provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(orderdetailColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	orderdetailUpsertCacheMut.RLock()
+	cache, cached := orderdetailUpsertCache[key]
+	orderdetailUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			orderdetailAllColumns,
+			orderdetailColumnsWithDefault,
+			orderdetailColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			orderdetailAllColumns,
+			orderdetailPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert orderdetail, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(orderdetailPrimaryKeyColumns))
+			copy(conflict, orderdetailPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"orderdetail\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(orderdetailType, orderdetailMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(orderdetailType, orderdetailMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert orderdetail")
+	}
+
+	if !cached {
+		orderdetailUpsertCacheMut.Lock()
+		orderdetailUpsertCache[key] = cache
+		orderdetailUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Orderdetail record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Orderdetail) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no Orderdetail provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), orderdetailPrimaryKeyMapping)
+	sql := "DELETE FROM \"orderdetail\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from orderdetail")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for orderdetail")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q orderdetailQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no orderdetailQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from orderdetail")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for orderdetail")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrderdetailSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(orderdetailBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderdetailPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"orderdetail\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, orderdetailPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from orderdetail slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for orderdetail")
+	}
+
+	if len(orderdetailAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Orderdetail) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOrderdetail(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrderdetailSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrderdetailSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderdetailPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"orderdetail\".* FROM \"orderdetail\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, orderdetailPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in OrderdetailSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrderdetailExists checks if the Orderdetail row exists.
+func OrderdetailExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"orderdetail\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if orderdetail exists")
+	}
+
+	return exists, nil
+}
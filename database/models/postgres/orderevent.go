@@ -0,0 +1,1066 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// Orderevent is an object representing the database table.
+type Orderevent struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OrderdetailID  string      `boil:"orderdetail_id" json:"orderdetail_id" toml:"orderdetail_id" yaml:"orderdetail_id"`
+	Event          string      `boil:"event" json:"event" toml:"event" yaml:"event"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Price          float64     `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Amount         float64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	ExecutedAmount float64     `boil:"executed_amount" json:"executed_amount" toml:"executed_amount" yaml:"executed_amount"`
+	Message        null.String `boil:"message" json:"message,omitempty" toml:"message" yaml:"message,omitempty"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *ordereventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L ordereventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrdereventColumns = struct {
+	ID             string
+	OrderdetailID  string
+	Event          string
+	Status         string
+	Price          string
+	Amount         string
+	ExecutedAmount string
+	Message        string
+	CreatedAt      string
+}{
+	ID:             "id",
+	OrderdetailID:  "orderdetail_id",
+	Event:          "event",
+	Status:         "status",
+	Price:          "price",
+	Amount:         "amount",
+	ExecutedAmount: "executed_amount",
+	Message:        "message",
+	CreatedAt:      "created_at",
+}
+
+// Generated where
+
+var OrdereventWhere = struct {
+	ID             whereHelperstring
+	OrderdetailID  whereHelperstring
+	Event          whereHelperstring
+	Status         whereHelperstring
+	Price          whereHelperfloat64
+	Amount         whereHelperfloat64
+	ExecutedAmount whereHelperfloat64
+	Message        whereHelpernull_String
+	CreatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"orderevent\".\"id\""},
+	OrderdetailID:  whereHelperstring{field: "\"orderevent\".\"orderdetail_id\""},
+	Event:          whereHelperstring{field: "\"orderevent\".\"event\""},
+	Status:         whereHelperstring{field: "\"orderevent\".\"status\""},
+	Price:          whereHelperfloat64{field: "\"orderevent\".\"price\""},
+	Amount:         whereHelperfloat64{field: "\"orderevent\".\"amount\""},
+	ExecutedAmount: whereHelperfloat64{field: "\"orderevent\".\"executed_amount\""},
+	Message:        whereHelpernull_String{field: "\"orderevent\".\"message\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"orderevent\".\"created_at\""},
+}
+
+// OrdereventRels is where relationship names are stored.
+var OrdereventRels = struct {
+	Orderdetail string
+}{
+	Orderdetail: "Orderdetail",
+}
+
+// ordereventR is where relationships are stored.
+type ordereventR struct {
+	Orderdetail *Orderdetail
+}
+
+// NewStruct creates a new relationship struct
+func (*ordereventR) NewStruct() *ordereventR {
+	return &ordereventR{}
+}
+
+// ordereventL is where Load methods for each relationship are stored.
+type ordereventL struct{}
+
+var (
+	ordereventAllColumns            = []string{"id", "orderdetail_id", "event", "status", "price", "amount", "executed_amount", "message", "created_at"}
+	ordereventColumnsWithoutDefault = []string{"orderdetail_id", "event", "status", "price", "amount", "executed_amount", "message", "created_at"}
+	ordereventColumnsWithDefault    = []string{"id"}
+	ordereventPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// OrdereventSlice is an alias for a slice of pointers to Orderevent.
+	// This should generally be used opposed to []Orderevent.
+	OrdereventSlice []*Orderevent
+	// OrdereventHook is the signature for custom Orderevent hook methods
+	OrdereventHook func(context.Context, boil.ContextExecutor, *Orderevent) error
+
+	ordereventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	ordereventType                 = reflect.TypeOf(&Orderevent{})
+	ordereventMapping              = queries.MakeStructMapping(ordereventType)
+	ordereventPrimaryKeyMapping, _ = queries.BindMapping(ordereventType, ordereventMapping, ordereventPrimaryKeyColumns)
+	ordereventInsertCacheMut       sync.RWMutex
+	ordereventInsertCache          = make(map[string]insertCache)
+	ordereventUpdateCacheMut       sync.RWMutex
+	ordereventUpdateCache          = make(map[string]updateCache)
+	ordereventUpsertCacheMut       sync.RWMutex
+	ordereventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var ordereventBeforeInsertHooks []OrdereventHook
+var ordereventBeforeUpdateHooks []OrdereventHook
+var ordereventBeforeDeleteHooks []OrdereventHook
+var ordereventBeforeUpsertHooks []OrdereventHook
+
+var ordereventAfterInsertHooks []OrdereventHook
+var ordereventAfterSelectHooks []OrdereventHook
+var ordereventAfterUpdateHooks []OrdereventHook
+var ordereventAfterDeleteHooks []OrdereventHook
+var ordereventAfterUpsertHooks []OrdereventHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Orderevent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordereventBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Orderevent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordereventBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Orderevent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordereventBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Orderevent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordereventBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Orderevent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordereventAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Orderevent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordereventAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Orderevent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordereventAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Orderevent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordereventAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Orderevent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordereventAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrdereventHook registers your hook function for all future operations.
+func AddOrdereventHook(hookPoint boil.HookPoint, ordereventHook OrdereventHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		ordereventBeforeInsertHooks = append(ordereventBeforeInsertHooks, ordereventHook)
+	case boil.BeforeUpdateHook:
+		ordereventBeforeUpdateHooks = append(ordereventBeforeUpdateHooks, ordereventHook)
+	case boil.BeforeDeleteHook:
+		ordereventBeforeDeleteHooks = append(ordereventBeforeDeleteHooks, ordereventHook)
+	case boil.BeforeUpsertHook:
+		ordereventBeforeUpsertHooks = append(ordereventBeforeUpsertHooks, ordereventHook)
+	case boil.AfterInsertHook:
+		ordereventAfterInsertHooks = append(ordereventAfterInsertHooks, ordereventHook)
+	case boil.AfterSelectHook:
+		ordereventAfterSelectHooks = append(ordereventAfterSelectHooks, ordereventHook)
+	case boil.AfterUpdateHook:
+		ordereventAfterUpdateHooks = append(ordereventAfterUpdateHooks, ordereventHook)
+	case boil.AfterDeleteHook:
+		ordereventAfterDeleteHooks = append(ordereventAfterDeleteHooks, ordereventHook)
+	case boil.AfterUpsertHook:
+		ordereventAfterUpsertHooks = append(ordereventAfterUpsertHooks, ordereventHook)
+	}
+}
+
+// One returns a single orderevent record from the query.
+func (q ordereventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Orderevent, error) {
+	o := &Orderevent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for orderevent")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Orderevent records from the query.
+func (q ordereventQuery) All(ctx context.Context, exec boil.ContextExecutor) (OrdereventSlice, error) {
+	var o []*Orderevent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to Orderevent slice")
+	}
+
+	if len(ordereventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Orderevent records in the query.
+func (q ordereventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count orderevent rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q ordereventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if orderevent exists")
+	}
+
+	return count > 0, nil
+}
+
+// Orderdetail pointed to by the foreign key.
+func (o *Orderevent) Orderdetail(mods ...qm.QueryMod) orderdetailQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrderdetailID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Orderdetails(queryMods...)
+	queries.SetFrom(query.Query, "\"orderdetail\"")
+
+	return query
+}
+
+// LoadOrderdetail allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (ordereventL) LoadOrderdetail(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrderevent interface{}, mods queries.Applicator) error {
+	var slice []*Orderevent
+	var object *Orderevent
+
+	if singular {
+		object = maybeOrderevent.(*Orderevent)
+	} else {
+		slice = *maybeOrderevent.(*[]*Orderevent)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &ordereventR{}
+		}
+		args = append(args, object.OrderdetailID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ordereventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OrderdetailID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OrderdetailID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`orderdetail`), qm.WhereIn(`orderdetail.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Orderdetail")
+	}
+
+	var resultSlice []*Orderdetail
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Orderdetail")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for orderdetail")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for orderdetail")
+	}
+
+	if len(ordereventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Orderdetail = foreign
+		if foreign.R == nil {
+			foreign.R = &orderdetailR{}
+		}
+		foreign.R.Orderevents = append(foreign.R.Orderevents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrderdetailID == foreign.ID {
+				local.R.Orderdetail = foreign
+				if foreign.R == nil {
+					foreign.R = &orderdetailR{}
+				}
+				foreign.R.Orderevents = append(foreign.R.Orderevents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrderdetail of the orderevent to the related item.
+// Sets o.R.Orderdetail to related.
+// Adds o to related.R.Orderevents.
+func (o *Orderevent) SetOrderdetail(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Orderdetail) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"orderevent\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"orderdetail_id"}),
+		strmangle.WhereClause("\"", "\"", 2, ordereventPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrderdetailID = related.ID
+	if o.R == nil {
+		o.R = &ordereventR{
+			Orderdetail: related,
+		}
+	} else {
+		o.R.Orderdetail = related
+	}
+
+	if related.R == nil {
+		related.R = &orderdetailR{
+			Orderevents: OrdereventSlice{o},
+		}
+	} else {
+		related.R.Orderevents = append(related.R.Orderevents, o)
+	}
+
+	return nil
+}
+
+// Orderevents retrieves all the records using an executor.
+func Orderevents(mods ...qm.QueryMod) ordereventQuery {
+	mods = append(mods, qm.From("\"orderevent\""))
+	return ordereventQuery{NewQuery(mods...)}
+}
+
+// FindOrderevent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrderevent(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Orderevent, error) {
+	ordereventObj := &Orderevent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"orderevent\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, ordereventObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from orderevent")
+	}
+
+	return ordereventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Orderevent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no orderevent provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ordereventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	ordereventInsertCacheMut.RLock()
+	cache, cached := ordereventInsertCache[key]
+	ordereventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			ordereventAllColumns,
+			ordereventColumnsWithDefault,
+			ordereventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(ordereventType, ordereventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(ordereventType, ordereventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"orderevent\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"orderevent\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into orderevent")
+	}
+
+	if !cached {
+		ordereventInsertCacheMut.Lock()
+		ordereventInsertCache[key] = cache
+		ordereventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Orderevent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Orderevent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	ordereventUpdateCacheMut.RLock()
+	cache, cached := ordereventUpdateCache[key]
+	ordereventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			ordereventAllColumns,
+			ordereventPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update orderevent, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"orderevent\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, ordereventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(ordereventType, ordereventMapping, append(wl, ordereventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update orderevent row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for orderevent")
+	}
+
+	if !cached {
+		ordereventUpdateCacheMut.Lock()
+		ordereventUpdateCache[key] = cache
+		ordereventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q ordereventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for orderevent")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for orderevent")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrdereventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ordereventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"orderevent\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, ordereventPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in orderevent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all orderevent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Orderevent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no orderevent provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ordereventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	ordereventUpsertCacheMut.RLock()
+	cache, cached := ordereventUpsertCache[key]
+	ordereventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			ordereventAllColumns,
+			ordereventColumnsWithDefault,
+			ordereventColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			ordereventAllColumns,
+			ordereventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert orderevent, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(ordereventPrimaryKeyColumns))
+			copy(conflict, ordereventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"orderevent\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(ordereventType, ordereventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(ordereventType, ordereventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert orderevent")
+	}
+
+	if !cached {
+		ordereventUpsertCacheMut.Lock()
+		ordereventUpsertCache[key] = cache
+		ordereventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Orderevent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Orderevent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no Orderevent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), ordereventPrimaryKeyMapping)
+	sql := "DELETE FROM \"orderevent\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from orderevent")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for orderevent")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q ordereventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no ordereventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from orderevent")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for orderevent")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrdereventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(ordereventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ordereventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"orderevent\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ordereventPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from orderevent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for orderevent")
+	}
+
+	if len(ordereventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Orderevent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOrderevent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrdereventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrdereventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ordereventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"orderevent\".* FROM \"orderevent\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ordereventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in OrdereventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrdereventExists checks if the Orderevent row exists.
+func OrdereventExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"orderevent\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if orderevent exists")
+	}
+
+	return exists, nil
+}
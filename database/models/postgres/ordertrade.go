@@ -0,0 +1,1056 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// Ordertrade is an object representing the database table.
+type Ordertrade struct {
+	OrderdetailID string      `boil:"orderdetail_id" json:"orderdetail_id" toml:"orderdetail_id" yaml:"orderdetail_id"`
+	Tid           string      `boil:"tid" json:"tid" toml:"tid" yaml:"tid"`
+	Price         float64     `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Amount        float64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Fee           float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	Side          null.String `boil:"side" json:"side,omitempty" toml:"side" yaml:"side,omitempty"`
+	Timestamp     time.Time   `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *ordertradeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L ordertradeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrdertradeColumns = struct {
+	OrderdetailID string
+	Tid           string
+	Price         string
+	Amount        string
+	Fee           string
+	Side          string
+	Timestamp     string
+}{
+	OrderdetailID: "orderdetail_id",
+	Tid:           "tid",
+	Price:         "price",
+	Amount:        "amount",
+	Fee:           "fee",
+	Side:          "side",
+	Timestamp:     "timestamp",
+}
+
+// Generated where
+
+var OrdertradeWhere = struct {
+	OrderdetailID whereHelperstring
+	Tid           whereHelperstring
+	Price         whereHelperfloat64
+	Amount        whereHelperfloat64
+	Fee           whereHelperfloat64
+	Side          whereHelpernull_String
+	Timestamp     whereHelpertime_Time
+}{
+	OrderdetailID: whereHelperstring{field: "\"ordertrade\".\"orderdetail_id\""},
+	Tid:           whereHelperstring{field: "\"ordertrade\".\"tid\""},
+	Price:         whereHelperfloat64{field: "\"ordertrade\".\"price\""},
+	Amount:        whereHelperfloat64{field: "\"ordertrade\".\"amount\""},
+	Fee:           whereHelperfloat64{field: "\"ordertrade\".\"fee\""},
+	Side:          whereHelpernull_String{field: "\"ordertrade\".\"side\""},
+	Timestamp:     whereHelpertime_Time{field: "\"ordertrade\".\"timestamp\""},
+}
+
+// OrdertradeRels is where relationship names are stored.
+var OrdertradeRels = struct {
+	Orderdetail string
+}{
+	Orderdetail: "Orderdetail",
+}
+
+// ordertradeR is where relationships are stored.
+type ordertradeR struct {
+	Orderdetail *Orderdetail
+}
+
+// NewStruct creates a new relationship struct
+func (*ordertradeR) NewStruct() *ordertradeR {
+	return &ordertradeR{}
+}
+
+// ordertradeL is where Load methods for each relationship are stored.
+type ordertradeL struct{}
+
+var (
+	ordertradeAllColumns            = []string{"orderdetail_id", "tid", "price", "amount", "fee", "side", "timestamp"}
+	ordertradeColumnsWithoutDefault = []string{"orderdetail_id", "tid", "price", "amount", "fee", "side", "timestamp"}
+	ordertradeColumnsWithDefault    = []string{}
+	ordertradePrimaryKeyColumns     = []string{"orderdetail_id", "tid"}
+)
+
+type (
+	// OrdertradeSlice is an alias for a slice of pointers to Ordertrade.
+	// This should generally be used opposed to []Ordertrade.
+	OrdertradeSlice []*Ordertrade
+	// OrdertradeHook is the signature for custom Ordertrade hook methods
+	OrdertradeHook func(context.Context, boil.ContextExecutor, *Ordertrade) error
+
+	ordertradeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	ordertradeType                 = reflect.TypeOf(&Ordertrade{})
+	ordertradeMapping              = queries.MakeStructMapping(ordertradeType)
+	ordertradePrimaryKeyMapping, _ = queries.BindMapping(ordertradeType, ordertradeMapping, ordertradePrimaryKeyColumns)
+	ordertradeInsertCacheMut       sync.RWMutex
+	ordertradeInsertCache          = make(map[string]insertCache)
+	ordertradeUpdateCacheMut       sync.RWMutex
+	ordertradeUpdateCache          = make(map[string]updateCache)
+	ordertradeUpsertCacheMut       sync.RWMutex
+	ordertradeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var ordertradeBeforeInsertHooks []OrdertradeHook
+var ordertradeBeforeUpdateHooks []OrdertradeHook
+var ordertradeBeforeDeleteHooks []OrdertradeHook
+var ordertradeBeforeUpsertHooks []OrdertradeHook
+
+var ordertradeAfterInsertHooks []OrdertradeHook
+var ordertradeAfterSelectHooks []OrdertradeHook
+var ordertradeAfterUpdateHooks []OrdertradeHook
+var ordertradeAfterDeleteHooks []OrdertradeHook
+var ordertradeAfterUpsertHooks []OrdertradeHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Ordertrade) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordertradeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Ordertrade) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordertradeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Ordertrade) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordertradeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Ordertrade) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordertradeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Ordertrade) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordertradeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Ordertrade) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordertradeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Ordertrade) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordertradeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Ordertrade) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordertradeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Ordertrade) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range ordertradeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrdertradeHook registers your hook function for all future operations.
+func AddOrdertradeHook(hookPoint boil.HookPoint, ordertradeHook OrdertradeHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		ordertradeBeforeInsertHooks = append(ordertradeBeforeInsertHooks, ordertradeHook)
+	case boil.BeforeUpdateHook:
+		ordertradeBeforeUpdateHooks = append(ordertradeBeforeUpdateHooks, ordertradeHook)
+	case boil.BeforeDeleteHook:
+		ordertradeBeforeDeleteHooks = append(ordertradeBeforeDeleteHooks, ordertradeHook)
+	case boil.BeforeUpsertHook:
+		ordertradeBeforeUpsertHooks = append(ordertradeBeforeUpsertHooks, ordertradeHook)
+	case boil.AfterInsertHook:
+		ordertradeAfterInsertHooks = append(ordertradeAfterInsertHooks, ordertradeHook)
+	case boil.AfterSelectHook:
+		ordertradeAfterSelectHooks = append(ordertradeAfterSelectHooks, ordertradeHook)
+	case boil.AfterUpdateHook:
+		ordertradeAfterUpdateHooks = append(ordertradeAfterUpdateHooks, ordertradeHook)
+	case boil.AfterDeleteHook:
+		ordertradeAfterDeleteHooks = append(ordertradeAfterDeleteHooks, ordertradeHook)
+	case boil.AfterUpsertHook:
+		ordertradeAfterUpsertHooks = append(ordertradeAfterUpsertHooks, ordertradeHook)
+	}
+}
+
+// One returns a single ordertrade record from the query.
+func (q ordertradeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Ordertrade, error) {
+	o := &Ordertrade{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for ordertrade")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Ordertrade records from the query.
+func (q ordertradeQuery) All(ctx context.Context, exec boil.ContextExecutor) (OrdertradeSlice, error) {
+	var o []*Ordertrade
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to Ordertrade slice")
+	}
+
+	if len(ordertradeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Ordertrade records in the query.
+func (q ordertradeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count ordertrade rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q ordertradeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if ordertrade exists")
+	}
+
+	return count > 0, nil
+}
+
+// Orderdetail pointed to by the foreign key.
+func (o *Ordertrade) Orderdetail(mods ...qm.QueryMod) orderdetailQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrderdetailID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Orderdetails(queryMods...)
+	queries.SetFrom(query.Query, "\"orderdetail\"")
+
+	return query
+}
+
+// LoadOrderdetail allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (ordertradeL) LoadOrderdetail(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrdertrade interface{}, mods queries.Applicator) error {
+	var slice []*Ordertrade
+	var object *Ordertrade
+
+	if singular {
+		object = maybeOrdertrade.(*Ordertrade)
+	} else {
+		slice = *maybeOrdertrade.(*[]*Ordertrade)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &ordertradeR{}
+		}
+		args = append(args, object.OrderdetailID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ordertradeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OrderdetailID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OrderdetailID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`orderdetail`), qm.WhereIn(`orderdetail.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Orderdetail")
+	}
+
+	var resultSlice []*Orderdetail
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Orderdetail")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for orderdetail")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for orderdetail")
+	}
+
+	if len(ordertradeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Orderdetail = foreign
+		if foreign.R == nil {
+			foreign.R = &orderdetailR{}
+		}
+		foreign.R.Ordertrades = append(foreign.R.Ordertrades, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrderdetailID == foreign.ID {
+				local.R.Orderdetail = foreign
+				if foreign.R == nil {
+					foreign.R = &orderdetailR{}
+				}
+				foreign.R.Ordertrades = append(foreign.R.Ordertrades, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrderdetail of the ordertrade to the related item.
+// Sets o.R.Orderdetail to related.
+// Adds o to related.R.Ordertrades.
+func (o *Ordertrade) SetOrderdetail(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Orderdetail) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"ordertrade\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"orderdetail_id"}),
+		strmangle.WhereClause("\"", "\"", 2, ordertradePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.OrderdetailID, o.Tid}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrderdetailID = related.ID
+	if o.R == nil {
+		o.R = &ordertradeR{
+			Orderdetail: related,
+		}
+	} else {
+		o.R.Orderdetail = related
+	}
+
+	if related.R == nil {
+		related.R = &orderdetailR{
+			Ordertrades: OrdertradeSlice{o},
+		}
+	} else {
+		related.R.Ordertrades = append(related.R.Ordertrades, o)
+	}
+
+	return nil
+}
+
+// Ordertrades retrieves all the records using an executor.
+func Ordertrades(mods ...qm.QueryMod) ordertradeQuery {
+	mods = append(mods, qm.From("\"ordertrade\""))
+	return ordertradeQuery{NewQuery(mods...)}
+}
+
+// FindOrdertrade retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrdertrade(ctx context.Context, exec boil.ContextExecutor, orderdetailID string, tid string, selectCols ...string) (*Ordertrade, error) {
+	ordertradeObj := &Ordertrade{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"ordertrade\" where \"orderdetail_id\"=$1 AND \"tid\"=$2", sel,
+	)
+
+	q := queries.Raw(query, orderdetailID, tid)
+
+	err := q.Bind(ctx, exec, ordertradeObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from ordertrade")
+	}
+
+	return ordertradeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Ordertrade) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no ordertrade provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ordertradeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	ordertradeInsertCacheMut.RLock()
+	cache, cached := ordertradeInsertCache[key]
+	ordertradeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			ordertradeAllColumns,
+			ordertradeColumnsWithDefault,
+			ordertradeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(ordertradeType, ordertradeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(ordertradeType, ordertradeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"ordertrade\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"ordertrade\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into ordertrade")
+	}
+
+	if !cached {
+		ordertradeInsertCacheMut.Lock()
+		ordertradeInsertCache[key] = cache
+		ordertradeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Ordertrade.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Ordertrade) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	ordertradeUpdateCacheMut.RLock()
+	cache, cached := ordertradeUpdateCache[key]
+	ordertradeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			ordertradeAllColumns,
+			ordertradePrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update ordertrade, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"ordertrade\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, ordertradePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(ordertradeType, ordertradeMapping, append(wl, ordertradePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update ordertrade row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for ordertrade")
+	}
+
+	if !cached {
+		ordertradeUpdateCacheMut.Lock()
+		ordertradeUpdateCache[key] = cache
+		ordertradeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q ordertradeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for ordertrade")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for ordertrade")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrdertradeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ordertradePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"ordertrade\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, ordertradePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in ordertrade slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all ordertrade")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Ordertrade) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no ordertrade provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(ordertradeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	ordertradeUpsertCacheMut.RLock()
+	cache, cached := ordertradeUpsertCache[key]
+	ordertradeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			ordertradeAllColumns,
+			ordertradeColumnsWithDefault,
+			ordertradeColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			ordertradeAllColumns,
+			ordertradePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert ordertrade, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(ordertradePrimaryKeyColumns))
+			copy(conflict, ordertradePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"ordertrade\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(ordertradeType, ordertradeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(ordertradeType, ordertradeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert ordertrade")
+	}
+
+	if !cached {
+		ordertradeUpsertCacheMut.Lock()
+		ordertradeUpsertCache[key] = cache
+		ordertradeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Ordertrade record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Ordertrade) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no Ordertrade provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), ordertradePrimaryKeyMapping)
+	sql := "DELETE FROM \"ordertrade\" WHERE \"orderdetail_id\"=$1 AND \"tid\"=$2"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from ordertrade")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for ordertrade")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q ordertradeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no ordertradeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from ordertrade")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for ordertrade")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrdertradeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(ordertradeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ordertradePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"ordertrade\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ordertradePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from ordertrade slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for ordertrade")
+	}
+
+	if len(ordertradeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Ordertrade) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOrdertrade(ctx, exec, o.OrderdetailID, o.Tid)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrdertradeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrdertradeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), ordertradePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"ordertrade\".* FROM \"ordertrade\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, ordertradePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in OrdertradeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrdertradeExists checks if the Ordertrade row exists.
+func OrdertradeExists(ctx context.Context, exec boil.ContextExecutor, orderdetailID string, tid string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"ordertrade\" where \"orderdetail_id\"=$1 AND \"tid\"=$2 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, orderdetailID, tid)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, orderdetailID, tid)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if ordertrade exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1055 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// Portfoliosnapshotholding is an object representing the database table.
+type Portfoliosnapshotholding struct {
+	PortfoliosnapshotID string  `boil:"portfoliosnapshot_id" json:"portfoliosnapshot_id" toml:"portfoliosnapshot_id" yaml:"portfoliosnapshot_id"`
+	Source              string  `boil:"source" json:"source" toml:"source" yaml:"source"`
+	Asset               string  `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Currency            string  `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Amount              float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Price               float64 `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Value               float64 `boil:"value" json:"value" toml:"value" yaml:"value"`
+
+	R *portfoliosnapshotholdingR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L portfoliosnapshotholdingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PortfoliosnapshotholdingColumns = struct {
+	PortfoliosnapshotID string
+	Source              string
+	Asset               string
+	Currency            string
+	Amount              string
+	Price               string
+	Value               string
+}{
+	PortfoliosnapshotID: "portfoliosnapshot_id",
+	Source:              "source",
+	Asset:               "asset",
+	Currency:            "currency",
+	Amount:              "amount",
+	Price:               "price",
+	Value:               "value",
+}
+
+// Generated where
+
+var PortfoliosnapshotholdingWhere = struct {
+	PortfoliosnapshotID whereHelperstring
+	Source              whereHelperstring
+	Asset               whereHelperstring
+	Currency            whereHelperstring
+	Amount              whereHelperfloat64
+	Price               whereHelperfloat64
+	Value               whereHelperfloat64
+}{
+	PortfoliosnapshotID: whereHelperstring{field: "\"portfoliosnapshotholding\".\"portfoliosnapshot_id\""},
+	Source:              whereHelperstring{field: "\"portfoliosnapshotholding\".\"source\""},
+	Asset:               whereHelperstring{field: "\"portfoliosnapshotholding\".\"asset\""},
+	Currency:            whereHelperstring{field: "\"portfoliosnapshotholding\".\"currency\""},
+	Amount:              whereHelperfloat64{field: "\"portfoliosnapshotholding\".\"amount\""},
+	Price:               whereHelperfloat64{field: "\"portfoliosnapshotholding\".\"price\""},
+	Value:               whereHelperfloat64{field: "\"portfoliosnapshotholding\".\"value\""},
+}
+
+// PortfoliosnapshotholdingRels is where relationship names are stored.
+var PortfoliosnapshotholdingRels = struct {
+	Portfoliosnapshot string
+}{
+	Portfoliosnapshot: "Portfoliosnapshot",
+}
+
+// portfoliosnapshotholdingR is where relationships are stored.
+type portfoliosnapshotholdingR struct {
+	Portfoliosnapshot *Portfoliosnapshot
+}
+
+// NewStruct creates a new relationship struct
+func (*portfoliosnapshotholdingR) NewStruct() *portfoliosnapshotholdingR {
+	return &portfoliosnapshotholdingR{}
+}
+
+// portfoliosnapshotholdingL is where Load methods for each relationship are stored.
+type portfoliosnapshotholdingL struct{}
+
+var (
+	portfoliosnapshotholdingAllColumns            = []string{"portfoliosnapshot_id", "source", "asset", "currency", "amount", "price", "value"}
+	portfoliosnapshotholdingColumnsWithoutDefault = []string{"portfoliosnapshot_id", "source", "asset", "currency", "amount", "price", "value"}
+	portfoliosnapshotholdingColumnsWithDefault    = []string{}
+	portfoliosnapshotholdingPrimaryKeyColumns     = []string{"portfoliosnapshot_id", "source", "asset", "currency"}
+)
+
+type (
+	// PortfoliosnapshotholdingSlice is an alias for a slice of pointers to Portfoliosnapshotholding.
+	// This should generally be used opposed to []Portfoliosnapshotholding.
+	PortfoliosnapshotholdingSlice []*Portfoliosnapshotholding
+	// PortfoliosnapshotholdingHook is the signature for custom Portfoliosnapshotholding hook methods
+	PortfoliosnapshotholdingHook func(context.Context, boil.ContextExecutor, *Portfoliosnapshotholding) error
+
+	portfoliosnapshotholdingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	portfoliosnapshotholdingType                 = reflect.TypeOf(&Portfoliosnapshotholding{})
+	portfoliosnapshotholdingMapping              = queries.MakeStructMapping(portfoliosnapshotholdingType)
+	portfoliosnapshotholdingPrimaryKeyMapping, _ = queries.BindMapping(portfoliosnapshotholdingType, portfoliosnapshotholdingMapping, portfoliosnapshotholdingPrimaryKeyColumns)
+	portfoliosnapshotholdingInsertCacheMut       sync.RWMutex
+	portfoliosnapshotholdingInsertCache          = make(map[string]insertCache)
+	portfoliosnapshotholdingUpdateCacheMut       sync.RWMutex
+	portfoliosnapshotholdingUpdateCache          = make(map[string]updateCache)
+	portfoliosnapshotholdingUpsertCacheMut       sync.RWMutex
+	portfoliosnapshotholdingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var portfoliosnapshotholdingBeforeInsertHooks []PortfoliosnapshotholdingHook
+var portfoliosnapshotholdingBeforeUpdateHooks []PortfoliosnapshotholdingHook
+var portfoliosnapshotholdingBeforeDeleteHooks []PortfoliosnapshotholdingHook
+var portfoliosnapshotholdingBeforeUpsertHooks []PortfoliosnapshotholdingHook
+
+var portfoliosnapshotholdingAfterInsertHooks []PortfoliosnapshotholdingHook
+var portfoliosnapshotholdingAfterSelectHooks []PortfoliosnapshotholdingHook
+var portfoliosnapshotholdingAfterUpdateHooks []PortfoliosnapshotholdingHook
+var portfoliosnapshotholdingAfterDeleteHooks []PortfoliosnapshotholdingHook
+var portfoliosnapshotholdingAfterUpsertHooks []PortfoliosnapshotholdingHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Portfoliosnapshotholding) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotholdingBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Portfoliosnapshotholding) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotholdingBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Portfoliosnapshotholding) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotholdingBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Portfoliosnapshotholding) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotholdingBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Portfoliosnapshotholding) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotholdingAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Portfoliosnapshotholding) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotholdingAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Portfoliosnapshotholding) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotholdingAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Portfoliosnapshotholding) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotholdingAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Portfoliosnapshotholding) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotholdingAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPortfoliosnapshotholdingHook registers your hook function for all future operations.
+func AddPortfoliosnapshotholdingHook(hookPoint boil.HookPoint, portfoliosnapshotholdingHook PortfoliosnapshotholdingHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		portfoliosnapshotholdingBeforeInsertHooks = append(portfoliosnapshotholdingBeforeInsertHooks, portfoliosnapshotholdingHook)
+	case boil.BeforeUpdateHook:
+		portfoliosnapshotholdingBeforeUpdateHooks = append(portfoliosnapshotholdingBeforeUpdateHooks, portfoliosnapshotholdingHook)
+	case boil.BeforeDeleteHook:
+		portfoliosnapshotholdingBeforeDeleteHooks = append(portfoliosnapshotholdingBeforeDeleteHooks, portfoliosnapshotholdingHook)
+	case boil.BeforeUpsertHook:
+		portfoliosnapshotholdingBeforeUpsertHooks = append(portfoliosnapshotholdingBeforeUpsertHooks, portfoliosnapshotholdingHook)
+	case boil.AfterInsertHook:
+		portfoliosnapshotholdingAfterInsertHooks = append(portfoliosnapshotholdingAfterInsertHooks, portfoliosnapshotholdingHook)
+	case boil.AfterSelectHook:
+		portfoliosnapshotholdingAfterSelectHooks = append(portfoliosnapshotholdingAfterSelectHooks, portfoliosnapshotholdingHook)
+	case boil.AfterUpdateHook:
+		portfoliosnapshotholdingAfterUpdateHooks = append(portfoliosnapshotholdingAfterUpdateHooks, portfoliosnapshotholdingHook)
+	case boil.AfterDeleteHook:
+		portfoliosnapshotholdingAfterDeleteHooks = append(portfoliosnapshotholdingAfterDeleteHooks, portfoliosnapshotholdingHook)
+	case boil.AfterUpsertHook:
+		portfoliosnapshotholdingAfterUpsertHooks = append(portfoliosnapshotholdingAfterUpsertHooks, portfoliosnapshotholdingHook)
+	}
+}
+
+// One returns a single portfoliosnapshotholding record from the query.
+func (q portfoliosnapshotholdingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Portfoliosnapshotholding, error) {
+	o := &Portfoliosnapshotholding{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for portfoliosnapshotholding")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Portfoliosnapshotholding records from the query.
+func (q portfoliosnapshotholdingQuery) All(ctx context.Context, exec boil.ContextExecutor) (PortfoliosnapshotholdingSlice, error) {
+	var o []*Portfoliosnapshotholding
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to Portfoliosnapshotholding slice")
+	}
+
+	if len(portfoliosnapshotholdingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Portfoliosnapshotholding records in the query.
+func (q portfoliosnapshotholdingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count portfoliosnapshotholding rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q portfoliosnapshotholdingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if portfoliosnapshotholding exists")
+	}
+
+	return count > 0, nil
+}
+
+// Portfoliosnapshot pointed to by the foreign key.
+func (o *Portfoliosnapshotholding) Portfoliosnapshot(mods ...qm.QueryMod) portfoliosnapshotQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PortfoliosnapshotID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Portfoliosnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"portfoliosnapshot\"")
+
+	return query
+}
+
+// LoadPortfoliosnapshot allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (portfoliosnapshotholdingL) LoadPortfoliosnapshot(ctx context.Context, e boil.ContextExecutor, singular bool, maybePortfoliosnapshotholding interface{}, mods queries.Applicator) error {
+	var slice []*Portfoliosnapshotholding
+	var object *Portfoliosnapshotholding
+
+	if singular {
+		object = maybePortfoliosnapshotholding.(*Portfoliosnapshotholding)
+	} else {
+		slice = *maybePortfoliosnapshotholding.(*[]*Portfoliosnapshotholding)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &portfoliosnapshotholdingR{}
+		}
+		args = append(args, object.PortfoliosnapshotID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &portfoliosnapshotholdingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PortfoliosnapshotID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PortfoliosnapshotID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`portfoliosnapshot`), qm.WhereIn(`portfoliosnapshot.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Portfoliosnapshot")
+	}
+
+	var resultSlice []*Portfoliosnapshot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Portfoliosnapshot")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for portfoliosnapshot")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for portfoliosnapshot")
+	}
+
+	if len(portfoliosnapshotholdingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Portfoliosnapshot = foreign
+		if foreign.R == nil {
+			foreign.R = &portfoliosnapshotR{}
+		}
+		foreign.R.Portfoliosnapshotholdings = append(foreign.R.Portfoliosnapshotholdings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PortfoliosnapshotID == foreign.ID {
+				local.R.Portfoliosnapshot = foreign
+				if foreign.R == nil {
+					foreign.R = &portfoliosnapshotR{}
+				}
+				foreign.R.Portfoliosnapshotholdings = append(foreign.R.Portfoliosnapshotholdings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPortfoliosnapshot of the portfoliosnapshotholding to the related item.
+// Sets o.R.Portfoliosnapshot to related.
+// Adds o to related.R.Portfoliosnapshotholdings.
+func (o *Portfoliosnapshotholding) SetPortfoliosnapshot(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Portfoliosnapshot) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"portfoliosnapshotholding\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"portfoliosnapshot_id"}),
+		strmangle.WhereClause("\"", "\"", 2, portfoliosnapshotholdingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.PortfoliosnapshotID, o.Source, o.Asset, o.Currency}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PortfoliosnapshotID = related.ID
+	if o.R == nil {
+		o.R = &portfoliosnapshotholdingR{
+			Portfoliosnapshot: related,
+		}
+	} else {
+		o.R.Portfoliosnapshot = related
+	}
+
+	if related.R == nil {
+		related.R = &portfoliosnapshotR{
+			Portfoliosnapshotholdings: PortfoliosnapshotholdingSlice{o},
+		}
+	} else {
+		related.R.Portfoliosnapshotholdings = append(related.R.Portfoliosnapshotholdings, o)
+	}
+
+	return nil
+}
+
+// Portfoliosnapshotholdings retrieves all the records using an executor.
+func Portfoliosnapshotholdings(mods ...qm.QueryMod) portfoliosnapshotholdingQuery {
+	mods = append(mods, qm.From("\"portfoliosnapshotholding\""))
+	return portfoliosnapshotholdingQuery{NewQuery(mods...)}
+}
+
+// FindPortfoliosnapshotholding retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPortfoliosnapshotholding(ctx context.Context, exec boil.ContextExecutor, portfoliosnapshotID string, source string, asset string, currency string, selectCols ...string) (*Portfoliosnapshotholding, error) {
+	portfoliosnapshotholdingObj := &Portfoliosnapshotholding{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"portfoliosnapshotholding\" where \"portfoliosnapshot_id\"=$1 AND \"source\"=$2 AND \"asset\"=$3 AND \"currency\"=$4", sel,
+	)
+
+	q := queries.Raw(query, portfoliosnapshotID, source, asset, currency)
+
+	err := q.Bind(ctx, exec, portfoliosnapshotholdingObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from portfoliosnapshotholding")
+	}
+
+	return portfoliosnapshotholdingObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Portfoliosnapshotholding) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no portfoliosnapshotholding provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(portfoliosnapshotholdingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	portfoliosnapshotholdingInsertCacheMut.RLock()
+	cache, cached := portfoliosnapshotholdingInsertCache[key]
+	portfoliosnapshotholdingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			portfoliosnapshotholdingAllColumns,
+			portfoliosnapshotholdingColumnsWithDefault,
+			portfoliosnapshotholdingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(portfoliosnapshotholdingType, portfoliosnapshotholdingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(portfoliosnapshotholdingType, portfoliosnapshotholdingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"portfoliosnapshotholding\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"portfoliosnapshotholding\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into portfoliosnapshotholding")
+	}
+
+	if !cached {
+		portfoliosnapshotholdingInsertCacheMut.Lock()
+		portfoliosnapshotholdingInsertCache[key] = cache
+		portfoliosnapshotholdingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Portfoliosnapshotholding.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Portfoliosnapshotholding) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	portfoliosnapshotholdingUpdateCacheMut.RLock()
+	cache, cached := portfoliosnapshotholdingUpdateCache[key]
+	portfoliosnapshotholdingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			portfoliosnapshotholdingAllColumns,
+			portfoliosnapshotholdingPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update portfoliosnapshotholding, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"portfoliosnapshotholding\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, portfoliosnapshotholdingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(portfoliosnapshotholdingType, portfoliosnapshotholdingMapping, append(wl, portfoliosnapshotholdingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update portfoliosnapshotholding row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for portfoliosnapshotholding")
+	}
+
+	if !cached {
+		portfoliosnapshotholdingUpdateCacheMut.Lock()
+		portfoliosnapshotholdingUpdateCache[key] = cache
+		portfoliosnapshotholdingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q portfoliosnapshotholdingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for portfoliosnapshotholding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for portfoliosnapshotholding")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PortfoliosnapshotholdingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfoliosnapshotholdingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"portfoliosnapshotholding\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, portfoliosnapshotholdingPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in portfoliosnapshotholding slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all portfoliosnapshotholding")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Portfoliosnapshotholding) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no portfoliosnapshotholding provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(portfoliosnapshotholdingColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	portfoliosnapshotholdingUpsertCacheMut.RLock()
+	cache, cached := portfoliosnapshotholdingUpsertCache[key]
+	portfoliosnapshotholdingUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			portfoliosnapshotholdingAllColumns,
+			portfoliosnapshotholdingColumnsWithDefault,
+			portfoliosnapshotholdingColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			portfoliosnapshotholdingAllColumns,
+			portfoliosnapshotholdingPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert portfoliosnapshotholding, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(portfoliosnapshotholdingPrimaryKeyColumns))
+			copy(conflict, portfoliosnapshotholdingPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"portfoliosnapshotholding\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(portfoliosnapshotholdingType, portfoliosnapshotholdingMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(portfoliosnapshotholdingType, portfoliosnapshotholdingMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert portfoliosnapshotholding")
+	}
+
+	if !cached {
+		portfoliosnapshotholdingUpsertCacheMut.Lock()
+		portfoliosnapshotholdingUpsertCache[key] = cache
+		portfoliosnapshotholdingUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Portfoliosnapshotholding record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Portfoliosnapshotholding) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no Portfoliosnapshotholding provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), portfoliosnapshotholdingPrimaryKeyMapping)
+	sql := "DELETE FROM \"portfoliosnapshotholding\" WHERE \"portfoliosnapshot_id\"=$1 AND \"source\"=$2 AND \"asset\"=$3 AND \"currency\"=$4"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from portfoliosnapshotholding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for portfoliosnapshotholding")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q portfoliosnapshotholdingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no portfoliosnapshotholdingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from portfoliosnapshotholding")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for portfoliosnapshotholding")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PortfoliosnapshotholdingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(portfoliosnapshotholdingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfoliosnapshotholdingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"portfoliosnapshotholding\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, portfoliosnapshotholdingPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from portfoliosnapshotholding slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for portfoliosnapshotholding")
+	}
+
+	if len(portfoliosnapshotholdingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Portfoliosnapshotholding) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPortfoliosnapshotholding(ctx, exec, o.PortfoliosnapshotID, o.Source, o.Asset, o.Currency)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PortfoliosnapshotholdingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PortfoliosnapshotholdingSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfoliosnapshotholdingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"portfoliosnapshotholding\".* FROM \"portfoliosnapshotholding\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, portfoliosnapshotholdingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in PortfoliosnapshotholdingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PortfoliosnapshotholdingExists checks if the Portfoliosnapshotholding row exists.
+func PortfoliosnapshotholdingExists(ctx context.Context, exec boil.ContextExecutor, portfoliosnapshotID string, source string, asset string, currency string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"portfoliosnapshotholding\" where \"portfoliosnapshot_id\"=$1 AND \"source\"=$2 AND \"asset\"=$3 AND \"currency\"=$4 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, portfoliosnapshotID, source, asset, currency)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, portfoliosnapshotID, source, asset, currency)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if portfoliosnapshotholding exists")
+	}
+
+	return exists, nil
+}
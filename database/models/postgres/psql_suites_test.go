@@ -6,7 +6,16 @@ package postgres
 import "testing"
 
 func TestUpsert(t *testing.T) {
+
 	t.Run("AuditEvents", testAuditEventsUpsert)
+	t.Run("Conditionalorders", testConditionalordersUpsert)
 	t.Run("Exchanges", testExchangesUpsert)
+	t.Run("Grids", testGridsUpsert)
+	t.Run("Gridlevels", testGridlevelsUpsert)
+	t.Run("Orderdetails", testOrderdetailsUpsert)
+	t.Run("Orderevents", testOrdereventsUpsert)
+	t.Run("Ordertrades", testOrdertradesUpsert)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsUpsert)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsUpsert)
 	t.Run("Scripts", testScriptsUpsert)
 }
@@ -14,9 +14,17 @@ import "testing"
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
 	t.Run("Candles", testCandles)
+	t.Run("Conditionalorders", testConditionalorders)
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
 	t.Run("Exchanges", testExchanges)
+	t.Run("Grids", testGrids)
+	t.Run("Gridlevels", testGridlevels)
+	t.Run("Orderdetails", testOrderdetails)
+	t.Run("Orderevents", testOrderevents)
+	t.Run("Ordertrades", testOrdertrades)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdings)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshots)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
 	t.Run("Trades", testTrades)
@@ -28,9 +36,17 @@ func TestParent(t *testing.T) {
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
 	t.Run("Candles", testCandlesDelete)
+	t.Run("Conditionalorders", testConditionalordersDelete)
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("Grids", testGridsDelete)
+	t.Run("Gridlevels", testGridlevelsDelete)
+	t.Run("Orderdetails", testOrderdetailsDelete)
+	t.Run("Orderevents", testOrdereventsDelete)
+	t.Run("Ordertrades", testOrdertradesDelete)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsDelete)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
 	t.Run("Trades", testTradesDelete)
@@ -42,9 +58,17 @@ func TestDelete(t *testing.T) {
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
 	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("Conditionalorders", testConditionalordersQueryDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("Grids", testGridsQueryDeleteAll)
+	t.Run("Gridlevels", testGridlevelsQueryDeleteAll)
+	t.Run("Orderdetails", testOrderdetailsQueryDeleteAll)
+	t.Run("Orderevents", testOrdereventsQueryDeleteAll)
+	t.Run("Ordertrades", testOrdertradesQueryDeleteAll)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsQueryDeleteAll)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
@@ -56,9 +80,17 @@ func TestQueryDeleteAll(t *testing.T) {
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
 	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("Conditionalorders", testConditionalordersSliceDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("Grids", testGridsSliceDeleteAll)
+	t.Run("Gridlevels", testGridlevelsSliceDeleteAll)
+	t.Run("Orderdetails", testOrderdetailsSliceDeleteAll)
+	t.Run("Orderevents", testOrdereventsSliceDeleteAll)
+	t.Run("Ordertrades", testOrdertradesSliceDeleteAll)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsSliceDeleteAll)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
@@ -70,9 +102,17 @@ func TestSliceDeleteAll(t *testing.T) {
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
 	t.Run("Candles", testCandlesExists)
+	t.Run("Conditionalorders", testConditionalordersExists)
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("Grids", testGridsExists)
+	t.Run("Gridlevels", testGridlevelsExists)
+	t.Run("Orderdetails", testOrderdetailsExists)
+	t.Run("Orderevents", testOrdereventsExists)
+	t.Run("Ordertrades", testOrdertradesExists)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsExists)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
 	t.Run("Trades", testTradesExists)
@@ -84,9 +124,17 @@ func TestExists(t *testing.T) {
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
 	t.Run("Candles", testCandlesFind)
+	t.Run("Conditionalorders", testConditionalordersFind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("Grids", testGridsFind)
+	t.Run("Gridlevels", testGridlevelsFind)
+	t.Run("Orderdetails", testOrderdetailsFind)
+	t.Run("Orderevents", testOrdereventsFind)
+	t.Run("Ordertrades", testOrdertradesFind)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsFind)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
 	t.Run("Trades", testTradesFind)
@@ -98,9 +146,17 @@ func TestFind(t *testing.T) {
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
 	t.Run("Candles", testCandlesBind)
+	t.Run("Conditionalorders", testConditionalordersBind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("Grids", testGridsBind)
+	t.Run("Gridlevels", testGridlevelsBind)
+	t.Run("Orderdetails", testOrderdetailsBind)
+	t.Run("Orderevents", testOrdereventsBind)
+	t.Run("Ordertrades", testOrdertradesBind)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsBind)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
 	t.Run("Trades", testTradesBind)
@@ -112,9 +168,17 @@ func TestBind(t *testing.T) {
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
 	t.Run("Candles", testCandlesOne)
+	t.Run("Conditionalorders", testConditionalordersOne)
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("Grids", testGridsOne)
+	t.Run("Gridlevels", testGridlevelsOne)
+	t.Run("Orderdetails", testOrderdetailsOne)
+	t.Run("Orderevents", testOrdereventsOne)
+	t.Run("Ordertrades", testOrdertradesOne)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsOne)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
 	t.Run("Trades", testTradesOne)
@@ -126,9 +190,17 @@ func TestOne(t *testing.T) {
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
 	t.Run("Candles", testCandlesAll)
+	t.Run("Conditionalorders", testConditionalordersAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("Grids", testGridsAll)
+	t.Run("Gridlevels", testGridlevelsAll)
+	t.Run("Orderdetails", testOrderdetailsAll)
+	t.Run("Orderevents", testOrdereventsAll)
+	t.Run("Ordertrades", testOrdertradesAll)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsAll)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
 	t.Run("Trades", testTradesAll)
@@ -140,9 +212,17 @@ func TestAll(t *testing.T) {
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
 	t.Run("Candles", testCandlesCount)
+	t.Run("Conditionalorders", testConditionalordersCount)
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("Grids", testGridsCount)
+	t.Run("Gridlevels", testGridlevelsCount)
+	t.Run("Orderdetails", testOrderdetailsCount)
+	t.Run("Orderevents", testOrdereventsCount)
+	t.Run("Ordertrades", testOrdertradesCount)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsCount)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
 	t.Run("Trades", testTradesCount)
@@ -154,9 +234,17 @@ func TestCount(t *testing.T) {
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
 	t.Run("Candles", testCandlesHooks)
+	t.Run("Conditionalorders", testConditionalordersHooks)
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("Grids", testGridsHooks)
+	t.Run("Gridlevels", testGridlevelsHooks)
+	t.Run("Orderdetails", testOrderdetailsHooks)
+	t.Run("Orderevents", testOrdereventsHooks)
+	t.Run("Ordertrades", testOrdertradesHooks)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsHooks)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
 	t.Run("Trades", testTradesHooks)
@@ -170,12 +258,28 @@ func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
 	t.Run("Candles", testCandlesInsert)
 	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("Conditionalorders", testConditionalordersInsert)
+	t.Run("Conditionalorders", testConditionalordersInsertWhitelist)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsert)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsertWhitelist)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsert)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("Grids", testGridsInsert)
+	t.Run("Grids", testGridsInsertWhitelist)
+	t.Run("Gridlevels", testGridlevelsInsert)
+	t.Run("Gridlevels", testGridlevelsInsertWhitelist)
+	t.Run("Orderdetails", testOrderdetailsInsert)
+	t.Run("Orderdetails", testOrderdetailsInsertWhitelist)
+	t.Run("Orderevents", testOrdereventsInsert)
+	t.Run("Orderevents", testOrdereventsInsertWhitelist)
+	t.Run("Ordertrades", testOrdertradesInsert)
+	t.Run("Ordertrades", testOrdertradesInsertWhitelist)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsInsert)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsInsertWhitelist)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsInsert)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
@@ -199,6 +303,10 @@ func TestToOne(t *testing.T) {
 	t.Run("DatahistoryjobToExchangeUsingExchangeName", testDatahistoryjobToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchange", testDatahistoryjobToOneExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJob", testDatahistoryjobresultToOneDatahistoryjobUsingJob)
+	t.Run("GridlevelToGridUsingGrid", testGridlevelToOneGridUsingGrid)
+	t.Run("OrdereventToOrderdetailUsingOrderdetail", testOrdereventToOneOrderdetailUsingOrderdetail)
+	t.Run("OrdertradeToOrderdetailUsingOrderdetail", testOrdertradeToOneOrderdetailUsingOrderdetail)
+	t.Run("PortfoliosnapshotholdingToPortfoliosnapshotUsingPortfoliosnapshot", testPortfoliosnapshotholdingToOnePortfoliosnapshotUsingPortfoliosnapshot)
 	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
@@ -224,6 +332,10 @@ func TestToMany(t *testing.T) {
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManySecondaryExchangeDatahistoryjobs)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
+	t.Run("GridToGridlevels", testGridToManyGridlevels)
+	t.Run("OrderdetailToOrderevents", testOrderdetailToManyOrderevents)
+	t.Run("OrderdetailToOrdertrades", testOrderdetailToManyOrdertrades)
+	t.Run("PortfoliosnapshotToPortfoliosnapshotholdings", testPortfoliosnapshotToManyPortfoliosnapshotholdings)
 	t.Run("ScriptToScriptExecutions", testScriptToManyScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyWithdrawalCryptos)
 	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyWithdrawalFiats)
@@ -238,6 +350,10 @@ func TestToOneSet(t *testing.T) {
 	t.Run("DatahistoryjobToExchangeUsingExchangeNameDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchangeDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJobDatahistoryjobresults", testDatahistoryjobresultToOneSetOpDatahistoryjobUsingJob)
+	t.Run("GridlevelToGridUsingGridlevels", testGridlevelToOneSetOpGridUsingGrid)
+	t.Run("OrdereventToOrderdetailUsingOrderevents", testOrdereventToOneSetOpOrderdetailUsingOrderdetail)
+	t.Run("OrdertradeToOrderdetailUsingOrdertrades", testOrdertradeToOneSetOpOrderdetailUsingOrderdetail)
+	t.Run("PortfoliosnapshotholdingToPortfoliosnapshotUsingPortfoliosnapshotholdings", testPortfoliosnapshotholdingToOneSetOpPortfoliosnapshotUsingPortfoliosnapshot)
 	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeNameTrade", testTradeToOneSetOpExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
@@ -275,6 +391,10 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyAddOpExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
+	t.Run("GridToGridlevels", testGridToManyAddOpGridlevels)
+	t.Run("OrderdetailToOrderevents", testOrderdetailToManyAddOpOrderevents)
+	t.Run("OrderdetailToOrdertrades", testOrderdetailToManyAddOpOrdertrades)
+	t.Run("PortfoliosnapshotToPortfoliosnapshotholdings", testPortfoliosnapshotToManyAddOpPortfoliosnapshotholdings)
 	t.Run("ScriptToScriptExecutions", testScriptToManyAddOpScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyAddOpWithdrawalCryptos)
 	t.Run("WithdrawalHistoryToWithdrawalFiats", testWithdrawalHistoryToManyAddOpWithdrawalFiats)
@@ -303,9 +423,17 @@ func TestToManyRemove(t *testing.T) {
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
 	t.Run("Candles", testCandlesReload)
+	t.Run("Conditionalorders", testConditionalordersReload)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("Grids", testGridsReload)
+	t.Run("Gridlevels", testGridlevelsReload)
+	t.Run("Orderdetails", testOrderdetailsReload)
+	t.Run("Orderevents", testOrdereventsReload)
+	t.Run("Ordertrades", testOrdertradesReload)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsReload)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
 	t.Run("Trades", testTradesReload)
@@ -317,9 +445,17 @@ func TestReload(t *testing.T) {
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
 	t.Run("Candles", testCandlesReloadAll)
+	t.Run("Conditionalorders", testConditionalordersReloadAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("Grids", testGridsReloadAll)
+	t.Run("Gridlevels", testGridlevelsReloadAll)
+	t.Run("Orderdetails", testOrderdetailsReloadAll)
+	t.Run("Orderevents", testOrdereventsReloadAll)
+	t.Run("Ordertrades", testOrdertradesReloadAll)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsReloadAll)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
 	t.Run("Trades", testTradesReloadAll)
@@ -331,9 +467,17 @@ func TestReloadAll(t *testing.T) {
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
 	t.Run("Candles", testCandlesSelect)
+	t.Run("Conditionalorders", testConditionalordersSelect)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("Grids", testGridsSelect)
+	t.Run("Gridlevels", testGridlevelsSelect)
+	t.Run("Orderdetails", testOrderdetailsSelect)
+	t.Run("Orderevents", testOrdereventsSelect)
+	t.Run("Ordertrades", testOrdertradesSelect)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsSelect)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
 	t.Run("Trades", testTradesSelect)
@@ -345,9 +489,17 @@ func TestSelect(t *testing.T) {
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
 	t.Run("Candles", testCandlesUpdate)
+	t.Run("Conditionalorders", testConditionalordersUpdate)
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("Grids", testGridsUpdate)
+	t.Run("Gridlevels", testGridlevelsUpdate)
+	t.Run("Orderdetails", testOrderdetailsUpdate)
+	t.Run("Orderevents", testOrdereventsUpdate)
+	t.Run("Ordertrades", testOrdertradesUpdate)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsUpdate)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
 	t.Run("Trades", testTradesUpdate)
@@ -359,9 +511,17 @@ func TestUpdate(t *testing.T) {
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
 	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("Conditionalorders", testConditionalordersSliceUpdateAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("Grids", testGridsSliceUpdateAll)
+	t.Run("Gridlevels", testGridlevelsSliceUpdateAll)
+	t.Run("Orderdetails", testOrderdetailsSliceUpdateAll)
+	t.Run("Orderevents", testOrdereventsSliceUpdateAll)
+	t.Run("Ordertrades", testOrdertradesSliceUpdateAll)
+	t.Run("Portfoliosnapshotholdings", testPortfoliosnapshotholdingsSliceUpdateAll)
+	t.Run("Portfoliosnapshots", testPortfoliosnapshotsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
@@ -0,0 +1,838 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testGrids(t *testing.T) {
+	t.Parallel()
+
+	query := Grids()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testGridsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Grids().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGridsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Grids().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Grids().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGridsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := GridSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Grids().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testGridsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := GridExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Grid exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected GridExists to return true, but got false.")
+	}
+}
+
+func testGridsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	gridFound, err := FindGrid(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if gridFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testGridsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Grids().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGridsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Grids().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testGridsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	gridOne := &Grid{}
+	gridTwo := &Grid{}
+	if err = randomize.Struct(seed, gridOne, gridDBTypes, false, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+	if err = randomize.Struct(seed, gridTwo, gridDBTypes, false, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = gridOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = gridTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Grids().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testGridsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	gridOne := &Grid{}
+	gridTwo := &Grid{}
+	if err = randomize.Struct(seed, gridOne, gridDBTypes, false, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+	if err = randomize.Struct(seed, gridTwo, gridDBTypes, false, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = gridOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = gridTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Grids().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func gridBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Grid) error {
+	*o = Grid{}
+	return nil
+}
+
+func gridAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Grid) error {
+	*o = Grid{}
+	return nil
+}
+
+func gridAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Grid) error {
+	*o = Grid{}
+	return nil
+}
+
+func gridBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Grid) error {
+	*o = Grid{}
+	return nil
+}
+
+func gridAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Grid) error {
+	*o = Grid{}
+	return nil
+}
+
+func gridBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Grid) error {
+	*o = Grid{}
+	return nil
+}
+
+func gridAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Grid) error {
+	*o = Grid{}
+	return nil
+}
+
+func gridBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Grid) error {
+	*o = Grid{}
+	return nil
+}
+
+func gridAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Grid) error {
+	*o = Grid{}
+	return nil
+}
+
+func testGridsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Grid{}
+	o := &Grid{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, gridDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Grid object: %s", err)
+	}
+
+	AddGridHook(boil.BeforeInsertHook, gridBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	gridBeforeInsertHooks = []GridHook{}
+
+	AddGridHook(boil.AfterInsertHook, gridAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	gridAfterInsertHooks = []GridHook{}
+
+	AddGridHook(boil.AfterSelectHook, gridAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	gridAfterSelectHooks = []GridHook{}
+
+	AddGridHook(boil.BeforeUpdateHook, gridBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	gridBeforeUpdateHooks = []GridHook{}
+
+	AddGridHook(boil.AfterUpdateHook, gridAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	gridAfterUpdateHooks = []GridHook{}
+
+	AddGridHook(boil.BeforeDeleteHook, gridBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	gridBeforeDeleteHooks = []GridHook{}
+
+	AddGridHook(boil.AfterDeleteHook, gridAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	gridAfterDeleteHooks = []GridHook{}
+
+	AddGridHook(boil.BeforeUpsertHook, gridBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	gridBeforeUpsertHooks = []GridHook{}
+
+	AddGridHook(boil.AfterUpsertHook, gridAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	gridAfterUpsertHooks = []GridHook{}
+}
+
+func testGridsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Grids().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testGridsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(gridColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Grids().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testGridToManyGridlevels(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Grid
+	var b, c Gridlevel
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, gridlevelDBTypes, false, gridlevelColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, gridlevelDBTypes, false, gridlevelColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.GridID = a.ID
+	c.GridID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Gridlevels().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.GridID == b.GridID {
+			bFound = true
+		}
+		if v.GridID == c.GridID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := GridSlice{&a}
+	if err = a.L.LoadGridlevels(ctx, tx, false, (*[]*Grid)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Gridlevels); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Gridlevels = nil
+	if err = a.L.LoadGridlevels(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Gridlevels); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testGridToManyAddOpGridlevels(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Grid
+	var b, c, d, e Gridlevel
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gridDBTypes, false, strmangle.SetComplement(gridPrimaryKeyColumns, gridColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Gridlevel{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, gridlevelDBTypes, false, strmangle.SetComplement(gridlevelPrimaryKeyColumns, gridlevelColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Gridlevel{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddGridlevels(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.GridID {
+			t.Error("foreign key was wrong value", a.ID, first.GridID)
+		}
+		if a.ID != second.GridID {
+			t.Error("foreign key was wrong value", a.ID, second.GridID)
+		}
+
+		if first.R.Grid != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Grid != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Gridlevels[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Gridlevels[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Gridlevels().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testGridsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGridsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := GridSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testGridsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Grids().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	gridDBTypes = map[string]string{`ID`: `TEXT`, `Exchange`: `TEXT`, `Asset`: `TEXT`, `Base`: `TEXT`, `Quote`: `TEXT`, `LowerPrice`: `REAL`, `UpperPrice`: `REAL`, `Levels`: `INTEGER`, `Spacing`: `TEXT`, `Amount`: `REAL`, `Status`: `TEXT`, `Reason`: `TEXT`, `RealisedProfit`: `REAL`, `RoundTrips`: `INTEGER`, `CreatedAt`: `TIMESTAMP`, `UpdatedAt`: `TIMESTAMP`}
+	_           = bytes.MinRead
+)
+
+func testGridsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(gridPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(gridAllColumns) == len(gridPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Grids().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testGridsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(gridAllColumns) == len(gridPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Grid{}
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Grids().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, gridDBTypes, true, gridPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Grid struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(gridAllColumns, gridPrimaryKeyColumns) {
+		fields = gridAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			gridAllColumns,
+			gridPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := GridSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
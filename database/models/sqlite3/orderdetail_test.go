@@ -0,0 +1,991 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOrderdetails(t *testing.T) {
+	t.Parallel()
+
+	query := Orderdetails()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOrderdetailsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Orderdetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderdetailsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Orderdetails().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Orderdetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderdetailsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderdetailSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Orderdetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderdetailsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OrderdetailExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Orderdetail exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OrderdetailExists to return true, but got false.")
+	}
+}
+
+func testOrderdetailsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	orderdetailFound, err := FindOrderdetail(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if orderdetailFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOrderdetailsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Orderdetails().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderdetailsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Orderdetails().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOrderdetailsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	orderdetailOne := &Orderdetail{}
+	orderdetailTwo := &Orderdetail{}
+	if err = randomize.Struct(seed, orderdetailOne, orderdetailDBTypes, false, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderdetailTwo, orderdetailDBTypes, false, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderdetailOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderdetailTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Orderdetails().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOrderdetailsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	orderdetailOne := &Orderdetail{}
+	orderdetailTwo := &Orderdetail{}
+	if err = randomize.Struct(seed, orderdetailOne, orderdetailDBTypes, false, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderdetailTwo, orderdetailDBTypes, false, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderdetailOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderdetailTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Orderdetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func orderdetailBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Orderdetail) error {
+	*o = Orderdetail{}
+	return nil
+}
+
+func orderdetailAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Orderdetail) error {
+	*o = Orderdetail{}
+	return nil
+}
+
+func orderdetailAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Orderdetail) error {
+	*o = Orderdetail{}
+	return nil
+}
+
+func orderdetailBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Orderdetail) error {
+	*o = Orderdetail{}
+	return nil
+}
+
+func orderdetailAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Orderdetail) error {
+	*o = Orderdetail{}
+	return nil
+}
+
+func orderdetailBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Orderdetail) error {
+	*o = Orderdetail{}
+	return nil
+}
+
+func orderdetailAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Orderdetail) error {
+	*o = Orderdetail{}
+	return nil
+}
+
+func orderdetailBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Orderdetail) error {
+	*o = Orderdetail{}
+	return nil
+}
+
+func orderdetailAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Orderdetail) error {
+	*o = Orderdetail{}
+	return nil
+}
+
+func testOrderdetailsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Orderdetail{}
+	o := &Orderdetail{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Orderdetail object: %s", err)
+	}
+
+	AddOrderdetailHook(boil.BeforeInsertHook, orderdetailBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	orderdetailBeforeInsertHooks = []OrderdetailHook{}
+
+	AddOrderdetailHook(boil.AfterInsertHook, orderdetailAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	orderdetailAfterInsertHooks = []OrderdetailHook{}
+
+	AddOrderdetailHook(boil.AfterSelectHook, orderdetailAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	orderdetailAfterSelectHooks = []OrderdetailHook{}
+
+	AddOrderdetailHook(boil.BeforeUpdateHook, orderdetailBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderdetailBeforeUpdateHooks = []OrderdetailHook{}
+
+	AddOrderdetailHook(boil.AfterUpdateHook, orderdetailAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderdetailAfterUpdateHooks = []OrderdetailHook{}
+
+	AddOrderdetailHook(boil.BeforeDeleteHook, orderdetailBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderdetailBeforeDeleteHooks = []OrderdetailHook{}
+
+	AddOrderdetailHook(boil.AfterDeleteHook, orderdetailAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderdetailAfterDeleteHooks = []OrderdetailHook{}
+
+	AddOrderdetailHook(boil.BeforeUpsertHook, orderdetailBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderdetailBeforeUpsertHooks = []OrderdetailHook{}
+
+	AddOrderdetailHook(boil.AfterUpsertHook, orderdetailAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderdetailAfterUpsertHooks = []OrderdetailHook{}
+}
+
+func testOrderdetailsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Orderdetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderdetailsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(orderdetailColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Orderdetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderdetailToManyOrderevents(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Orderdetail
+	var b, c Orderevent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, ordereventDBTypes, false, ordereventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, ordereventDBTypes, false, ordereventColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OrderdetailID = a.ID
+	c.OrderdetailID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Orderevents().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrderdetailID == b.OrderdetailID {
+			bFound = true
+		}
+		if v.OrderdetailID == c.OrderdetailID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OrderdetailSlice{&a}
+	if err = a.L.LoadOrderevents(ctx, tx, false, (*[]*Orderdetail)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Orderevents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Orderevents = nil
+	if err = a.L.LoadOrderevents(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Orderevents); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOrderdetailToManyOrdertrades(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Orderdetail
+	var b, c Ordertrade
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, ordertradeDBTypes, false, ordertradeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, ordertradeDBTypes, false, ordertradeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OrderdetailID = a.ID
+	c.OrderdetailID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Ordertrades().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OrderdetailID == b.OrderdetailID {
+			bFound = true
+		}
+		if v.OrderdetailID == c.OrderdetailID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OrderdetailSlice{&a}
+	if err = a.L.LoadOrdertrades(ctx, tx, false, (*[]*Orderdetail)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Ordertrades); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Ordertrades = nil
+	if err = a.L.LoadOrdertrades(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Ordertrades); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOrderdetailToManyAddOpOrderevents(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Orderdetail
+	var b, c, d, e Orderevent
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, orderdetailDBTypes, false, strmangle.SetComplement(orderdetailPrimaryKeyColumns, orderdetailColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Orderevent{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, ordereventDBTypes, false, strmangle.SetComplement(ordereventPrimaryKeyColumns, ordereventColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Orderevent{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOrderevents(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.OrderdetailID {
+			t.Error("foreign key was wrong value", a.ID, first.OrderdetailID)
+		}
+		if a.ID != second.OrderdetailID {
+			t.Error("foreign key was wrong value", a.ID, second.OrderdetailID)
+		}
+
+		if first.R.Orderdetail != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Orderdetail != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Orderevents[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Orderevents[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Orderevents().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testOrderdetailToManyAddOpOrdertrades(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Orderdetail
+	var b, c, d, e Ordertrade
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, orderdetailDBTypes, false, strmangle.SetComplement(orderdetailPrimaryKeyColumns, orderdetailColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Ordertrade{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, ordertradeDBTypes, false, strmangle.SetComplement(ordertradePrimaryKeyColumns, ordertradeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Ordertrade{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOrdertrades(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.OrderdetailID {
+			t.Error("foreign key was wrong value", a.ID, first.OrderdetailID)
+		}
+		if a.ID != second.OrderdetailID {
+			t.Error("foreign key was wrong value", a.ID, second.OrderdetailID)
+		}
+
+		if first.R.Orderdetail != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Orderdetail != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Ordertrades[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Ordertrades[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Ordertrades().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testOrderdetailsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderdetailsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderdetailSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderdetailsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Orderdetails().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	orderdetailDBTypes = map[string]string{`ID`: `TEXT`, `Exchange`: `TEXT`, `Asset`: `TEXT`, `Base`: `TEXT`, `Quote`: `TEXT`, `OrderID`: `TEXT`, `ClientOrderID`: `TEXT`, `OrderType`: `TEXT`, `Side`: `TEXT`, `Status`: `TEXT`, `Price`: `REAL`, `Amount`: `REAL`, `ExecutedAmount`: `REAL`, `RemainingAmount`: `REAL`, `AverageExecutedPrice`: `REAL`, `Fee`: `REAL`, `Cost`: `REAL`, `CreatedAt`: `TIMESTAMP`, `UpdatedAt`: `TIMESTAMP`}
+	_                  = bytes.MinRead
+)
+
+func testOrderdetailsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(orderdetailPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(orderdetailAllColumns) == len(orderdetailPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Orderdetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOrderdetailsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(orderdetailAllColumns) == len(orderdetailPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderdetail{}
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Orderdetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderdetailDBTypes, true, orderdetailPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(orderdetailAllColumns, orderdetailPrimaryKeyColumns) {
+		fields = orderdetailAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			orderdetailAllColumns,
+			orderdetailPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OrderdetailSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,793 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOrderevents(t *testing.T) {
+	t.Parallel()
+
+	query := Orderevents()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOrdereventsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Orderevents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrdereventsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Orderevents().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Orderevents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrdereventsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrdereventSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Orderevents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrdereventsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OrdereventExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Orderevent exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OrdereventExists to return true, but got false.")
+	}
+}
+
+func testOrdereventsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	ordereventFound, err := FindOrderevent(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if ordereventFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOrdereventsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Orderevents().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrdereventsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Orderevents().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOrdereventsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	ordereventOne := &Orderevent{}
+	ordereventTwo := &Orderevent{}
+	if err = randomize.Struct(seed, ordereventOne, ordereventDBTypes, false, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, ordereventTwo, ordereventDBTypes, false, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = ordereventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = ordereventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Orderevents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOrdereventsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	ordereventOne := &Orderevent{}
+	ordereventTwo := &Orderevent{}
+	if err = randomize.Struct(seed, ordereventOne, ordereventDBTypes, false, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+	if err = randomize.Struct(seed, ordereventTwo, ordereventDBTypes, false, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = ordereventOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = ordereventTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Orderevents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func ordereventBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Orderevent) error {
+	*o = Orderevent{}
+	return nil
+}
+
+func ordereventAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Orderevent) error {
+	*o = Orderevent{}
+	return nil
+}
+
+func ordereventAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Orderevent) error {
+	*o = Orderevent{}
+	return nil
+}
+
+func ordereventBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Orderevent) error {
+	*o = Orderevent{}
+	return nil
+}
+
+func ordereventAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Orderevent) error {
+	*o = Orderevent{}
+	return nil
+}
+
+func ordereventBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Orderevent) error {
+	*o = Orderevent{}
+	return nil
+}
+
+func ordereventAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Orderevent) error {
+	*o = Orderevent{}
+	return nil
+}
+
+func ordereventBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Orderevent) error {
+	*o = Orderevent{}
+	return nil
+}
+
+func ordereventAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Orderevent) error {
+	*o = Orderevent{}
+	return nil
+}
+
+func testOrdereventsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Orderevent{}
+	o := &Orderevent{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, ordereventDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Orderevent object: %s", err)
+	}
+
+	AddOrdereventHook(boil.BeforeInsertHook, ordereventBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	ordereventBeforeInsertHooks = []OrdereventHook{}
+
+	AddOrdereventHook(boil.AfterInsertHook, ordereventAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	ordereventAfterInsertHooks = []OrdereventHook{}
+
+	AddOrdereventHook(boil.AfterSelectHook, ordereventAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	ordereventAfterSelectHooks = []OrdereventHook{}
+
+	AddOrdereventHook(boil.BeforeUpdateHook, ordereventBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	ordereventBeforeUpdateHooks = []OrdereventHook{}
+
+	AddOrdereventHook(boil.AfterUpdateHook, ordereventAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	ordereventAfterUpdateHooks = []OrdereventHook{}
+
+	AddOrdereventHook(boil.BeforeDeleteHook, ordereventBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	ordereventBeforeDeleteHooks = []OrdereventHook{}
+
+	AddOrdereventHook(boil.AfterDeleteHook, ordereventAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	ordereventAfterDeleteHooks = []OrdereventHook{}
+
+	AddOrdereventHook(boil.BeforeUpsertHook, ordereventBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	ordereventBeforeUpsertHooks = []OrdereventHook{}
+
+	AddOrdereventHook(boil.AfterUpsertHook, ordereventAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	ordereventAfterUpsertHooks = []OrdereventHook{}
+}
+
+func testOrdereventsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Orderevents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrdereventsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(ordereventColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Orderevents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrdereventToOneOrderdetailUsingOrderdetail(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Orderevent
+	var foreign Orderdetail
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, ordereventDBTypes, false, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, orderdetailDBTypes, false, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.OrderdetailID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Orderdetail().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OrdereventSlice{&local}
+	if err = local.L.LoadOrderdetail(ctx, tx, false, (*[]*Orderevent)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Orderdetail == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Orderdetail = nil
+	if err = local.L.LoadOrderdetail(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Orderdetail == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testOrdereventToOneSetOpOrderdetailUsingOrderdetail(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Orderevent
+	var b, c Orderdetail
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, ordereventDBTypes, false, strmangle.SetComplement(ordereventPrimaryKeyColumns, ordereventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, orderdetailDBTypes, false, strmangle.SetComplement(orderdetailPrimaryKeyColumns, orderdetailColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, orderdetailDBTypes, false, strmangle.SetComplement(orderdetailPrimaryKeyColumns, orderdetailColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Orderdetail{&b, &c} {
+		err = a.SetOrderdetail(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Orderdetail != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Orderevents[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.OrderdetailID != x.ID {
+			t.Error("foreign key was wrong value", a.OrderdetailID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OrderdetailID))
+		reflect.Indirect(reflect.ValueOf(&a.OrderdetailID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.OrderdetailID != x.ID {
+			t.Error("foreign key was wrong value", a.OrderdetailID, x.ID)
+		}
+	}
+}
+
+func testOrdereventsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrdereventsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrdereventSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrdereventsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Orderevents().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	ordereventDBTypes = map[string]string{`ID`: `TEXT`, `OrderdetailID`: `TEXT`, `Event`: `TEXT`, `Status`: `TEXT`, `Price`: `REAL`, `Amount`: `REAL`, `ExecutedAmount`: `REAL`, `Message`: `TEXT`, `CreatedAt`: `TIMESTAMP`}
+	_                 = bytes.MinRead
+)
+
+func testOrdereventsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(ordereventPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(ordereventAllColumns) == len(ordereventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Orderevents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOrdereventsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(ordereventAllColumns) == len(ordereventPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Orderevent{}
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Orderevents().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, ordereventDBTypes, true, ordereventPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Orderevent struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(ordereventAllColumns, ordereventPrimaryKeyColumns) {
+		fields = ordereventAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			ordereventAllColumns,
+			ordereventPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OrdereventSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,789 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOrdertrades(t *testing.T) {
+	t.Parallel()
+
+	query := Ordertrades()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOrdertradesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Ordertrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrdertradesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Ordertrades().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Ordertrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrdertradesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrdertradeSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Ordertrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrdertradesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OrdertradeExists(ctx, tx, o.OrderdetailID, o.Tid)
+	if err != nil {
+		t.Errorf("Unable to check if Ordertrade exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OrdertradeExists to return true, but got false.")
+	}
+}
+
+func testOrdertradesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	ordertradeFound, err := FindOrdertrade(ctx, tx, o.OrderdetailID, o.Tid)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if ordertradeFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOrdertradesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Ordertrades().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrdertradesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Ordertrades().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOrdertradesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	ordertradeOne := &Ordertrade{}
+	ordertradeTwo := &Ordertrade{}
+	if err = randomize.Struct(seed, ordertradeOne, ordertradeDBTypes, false, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+	if err = randomize.Struct(seed, ordertradeTwo, ordertradeDBTypes, false, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = ordertradeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = ordertradeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Ordertrades().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOrdertradesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	ordertradeOne := &Ordertrade{}
+	ordertradeTwo := &Ordertrade{}
+	if err = randomize.Struct(seed, ordertradeOne, ordertradeDBTypes, false, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+	if err = randomize.Struct(seed, ordertradeTwo, ordertradeDBTypes, false, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = ordertradeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = ordertradeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Ordertrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func ordertradeBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Ordertrade) error {
+	*o = Ordertrade{}
+	return nil
+}
+
+func ordertradeAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Ordertrade) error {
+	*o = Ordertrade{}
+	return nil
+}
+
+func ordertradeAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Ordertrade) error {
+	*o = Ordertrade{}
+	return nil
+}
+
+func ordertradeBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Ordertrade) error {
+	*o = Ordertrade{}
+	return nil
+}
+
+func ordertradeAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Ordertrade) error {
+	*o = Ordertrade{}
+	return nil
+}
+
+func ordertradeBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Ordertrade) error {
+	*o = Ordertrade{}
+	return nil
+}
+
+func ordertradeAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Ordertrade) error {
+	*o = Ordertrade{}
+	return nil
+}
+
+func ordertradeBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Ordertrade) error {
+	*o = Ordertrade{}
+	return nil
+}
+
+func ordertradeAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Ordertrade) error {
+	*o = Ordertrade{}
+	return nil
+}
+
+func testOrdertradesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Ordertrade{}
+	o := &Ordertrade{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Ordertrade object: %s", err)
+	}
+
+	AddOrdertradeHook(boil.BeforeInsertHook, ordertradeBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	ordertradeBeforeInsertHooks = []OrdertradeHook{}
+
+	AddOrdertradeHook(boil.AfterInsertHook, ordertradeAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	ordertradeAfterInsertHooks = []OrdertradeHook{}
+
+	AddOrdertradeHook(boil.AfterSelectHook, ordertradeAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	ordertradeAfterSelectHooks = []OrdertradeHook{}
+
+	AddOrdertradeHook(boil.BeforeUpdateHook, ordertradeBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	ordertradeBeforeUpdateHooks = []OrdertradeHook{}
+
+	AddOrdertradeHook(boil.AfterUpdateHook, ordertradeAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	ordertradeAfterUpdateHooks = []OrdertradeHook{}
+
+	AddOrdertradeHook(boil.BeforeDeleteHook, ordertradeBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	ordertradeBeforeDeleteHooks = []OrdertradeHook{}
+
+	AddOrdertradeHook(boil.AfterDeleteHook, ordertradeAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	ordertradeAfterDeleteHooks = []OrdertradeHook{}
+
+	AddOrdertradeHook(boil.BeforeUpsertHook, ordertradeBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	ordertradeBeforeUpsertHooks = []OrdertradeHook{}
+
+	AddOrdertradeHook(boil.AfterUpsertHook, ordertradeAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	ordertradeAfterUpsertHooks = []OrdertradeHook{}
+}
+
+func testOrdertradesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Ordertrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrdertradesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(ordertradeColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Ordertrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrdertradeToOneOrderdetailUsingOrderdetail(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Ordertrade
+	var foreign Orderdetail
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, ordertradeDBTypes, false, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, orderdetailDBTypes, false, orderdetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Orderdetail struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.OrderdetailID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Orderdetail().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OrdertradeSlice{&local}
+	if err = local.L.LoadOrderdetail(ctx, tx, false, (*[]*Ordertrade)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Orderdetail == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Orderdetail = nil
+	if err = local.L.LoadOrderdetail(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Orderdetail == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testOrdertradeToOneSetOpOrderdetailUsingOrderdetail(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Ordertrade
+	var b, c Orderdetail
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, ordertradeDBTypes, false, strmangle.SetComplement(ordertradePrimaryKeyColumns, ordertradeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, orderdetailDBTypes, false, strmangle.SetComplement(orderdetailPrimaryKeyColumns, orderdetailColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, orderdetailDBTypes, false, strmangle.SetComplement(orderdetailPrimaryKeyColumns, orderdetailColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Orderdetail{&b, &c} {
+		err = a.SetOrderdetail(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Orderdetail != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Ordertrades[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.OrderdetailID != x.ID {
+			t.Error("foreign key was wrong value", a.OrderdetailID)
+		}
+
+		if exists, err := OrdertradeExists(ctx, tx, a.OrderdetailID, a.Tid); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testOrdertradesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrdertradesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrdertradeSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrdertradesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Ordertrades().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	ordertradeDBTypes = map[string]string{`OrderdetailID`: `TEXT`, `Tid`: `TEXT`, `Price`: `REAL`, `Amount`: `REAL`, `Fee`: `REAL`, `Side`: `TEXT`, `Timestamp`: `TIMESTAMP`}
+	_                 = bytes.MinRead
+)
+
+func testOrdertradesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(ordertradePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(ordertradeAllColumns) == len(ordertradePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Ordertrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOrdertradesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(ordertradeAllColumns) == len(ordertradePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Ordertrade{}
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Ordertrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, ordertradeDBTypes, true, ordertradePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Ordertrade struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(ordertradeAllColumns, ordertradePrimaryKeyColumns) {
+		fields = ordertradeAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			ordertradeAllColumns,
+			ordertradePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OrdertradeSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,948 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// Portfoliosnapshot is an object representing the database table.
+type Portfoliosnapshot struct {
+	ID           string  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FiatCurrency string  `boil:"fiat_currency" json:"fiat_currency" toml:"fiat_currency" yaml:"fiat_currency"`
+	TotalValue   float64 `boil:"total_value" json:"total_value" toml:"total_value" yaml:"total_value"`
+	CreatedAt    string  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *portfoliosnapshotR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L portfoliosnapshotL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PortfoliosnapshotColumns = struct {
+	ID           string
+	FiatCurrency string
+	TotalValue   string
+	CreatedAt    string
+}{
+	ID:           "id",
+	FiatCurrency: "fiat_currency",
+	TotalValue:   "total_value",
+	CreatedAt:    "created_at",
+}
+
+// Generated where
+
+var PortfoliosnapshotWhere = struct {
+	ID           whereHelperstring
+	FiatCurrency whereHelperstring
+	TotalValue   whereHelperfloat64
+	CreatedAt    whereHelperstring
+}{
+	ID:           whereHelperstring{field: "\"portfoliosnapshot\".\"id\""},
+	FiatCurrency: whereHelperstring{field: "\"portfoliosnapshot\".\"fiat_currency\""},
+	TotalValue:   whereHelperfloat64{field: "\"portfoliosnapshot\".\"total_value\""},
+	CreatedAt:    whereHelperstring{field: "\"portfoliosnapshot\".\"created_at\""},
+}
+
+// PortfoliosnapshotRels is where relationship names are stored.
+var PortfoliosnapshotRels = struct {
+	Portfoliosnapshotholdings string
+}{
+	Portfoliosnapshotholdings: "Portfoliosnapshotholdings",
+}
+
+// portfoliosnapshotR is where relationships are stored.
+type portfoliosnapshotR struct {
+	Portfoliosnapshotholdings PortfoliosnapshotholdingSlice
+}
+
+// NewStruct creates a new relationship struct
+func (*portfoliosnapshotR) NewStruct() *portfoliosnapshotR {
+	return &portfoliosnapshotR{}
+}
+
+// portfoliosnapshotL is where Load methods for each relationship are stored.
+type portfoliosnapshotL struct{}
+
+var (
+	portfoliosnapshotAllColumns            = []string{"id", "fiat_currency", "total_value", "created_at"}
+	portfoliosnapshotColumnsWithoutDefault = []string{"id", "fiat_currency", "total_value"}
+	portfoliosnapshotColumnsWithDefault    = []string{"created_at"}
+	portfoliosnapshotPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// PortfoliosnapshotSlice is an alias for a slice of pointers to Portfoliosnapshot.
+	// This should generally be used opposed to []Portfoliosnapshot.
+	PortfoliosnapshotSlice []*Portfoliosnapshot
+	// PortfoliosnapshotHook is the signature for custom Portfoliosnapshot hook methods
+	PortfoliosnapshotHook func(context.Context, boil.ContextExecutor, *Portfoliosnapshot) error
+
+	portfoliosnapshotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	portfoliosnapshotType                 = reflect.TypeOf(&Portfoliosnapshot{})
+	portfoliosnapshotMapping              = queries.MakeStructMapping(portfoliosnapshotType)
+	portfoliosnapshotPrimaryKeyMapping, _ = queries.BindMapping(portfoliosnapshotType, portfoliosnapshotMapping, portfoliosnapshotPrimaryKeyColumns)
+	portfoliosnapshotInsertCacheMut       sync.RWMutex
+	portfoliosnapshotInsertCache          = make(map[string]insertCache)
+	portfoliosnapshotUpdateCacheMut       sync.RWMutex
+	portfoliosnapshotUpdateCache          = make(map[string]updateCache)
+	portfoliosnapshotUpsertCacheMut       sync.RWMutex
+	portfoliosnapshotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var portfoliosnapshotBeforeInsertHooks []PortfoliosnapshotHook
+var portfoliosnapshotBeforeUpdateHooks []PortfoliosnapshotHook
+var portfoliosnapshotBeforeDeleteHooks []PortfoliosnapshotHook
+var portfoliosnapshotBeforeUpsertHooks []PortfoliosnapshotHook
+
+var portfoliosnapshotAfterInsertHooks []PortfoliosnapshotHook
+var portfoliosnapshotAfterSelectHooks []PortfoliosnapshotHook
+var portfoliosnapshotAfterUpdateHooks []PortfoliosnapshotHook
+var portfoliosnapshotAfterDeleteHooks []PortfoliosnapshotHook
+var portfoliosnapshotAfterUpsertHooks []PortfoliosnapshotHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Portfoliosnapshot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Portfoliosnapshot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Portfoliosnapshot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Portfoliosnapshot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Portfoliosnapshot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Portfoliosnapshot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Portfoliosnapshot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Portfoliosnapshot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Portfoliosnapshot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range portfoliosnapshotAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPortfoliosnapshotHook registers your hook function for all future operations.
+func AddPortfoliosnapshotHook(hookPoint boil.HookPoint, portfoliosnapshotHook PortfoliosnapshotHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		portfoliosnapshotBeforeInsertHooks = append(portfoliosnapshotBeforeInsertHooks, portfoliosnapshotHook)
+	case boil.BeforeUpdateHook:
+		portfoliosnapshotBeforeUpdateHooks = append(portfoliosnapshotBeforeUpdateHooks, portfoliosnapshotHook)
+	case boil.BeforeDeleteHook:
+		portfoliosnapshotBeforeDeleteHooks = append(portfoliosnapshotBeforeDeleteHooks, portfoliosnapshotHook)
+	case boil.BeforeUpsertHook:
+		portfoliosnapshotBeforeUpsertHooks = append(portfoliosnapshotBeforeUpsertHooks, portfoliosnapshotHook)
+	case boil.AfterInsertHook:
+		portfoliosnapshotAfterInsertHooks = append(portfoliosnapshotAfterInsertHooks, portfoliosnapshotHook)
+	case boil.AfterSelectHook:
+		portfoliosnapshotAfterSelectHooks = append(portfoliosnapshotAfterSelectHooks, portfoliosnapshotHook)
+	case boil.AfterUpdateHook:
+		portfoliosnapshotAfterUpdateHooks = append(portfoliosnapshotAfterUpdateHooks, portfoliosnapshotHook)
+	case boil.AfterDeleteHook:
+		portfoliosnapshotAfterDeleteHooks = append(portfoliosnapshotAfterDeleteHooks, portfoliosnapshotHook)
+	case boil.AfterUpsertHook:
+		portfoliosnapshotAfterUpsertHooks = append(portfoliosnapshotAfterUpsertHooks, portfoliosnapshotHook)
+	}
+}
+
+// One returns a single portfoliosnapshot record from the query.
+func (q portfoliosnapshotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Portfoliosnapshot, error) {
+	o := &Portfoliosnapshot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for portfoliosnapshot")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Portfoliosnapshot records from the query.
+func (q portfoliosnapshotQuery) All(ctx context.Context, exec boil.ContextExecutor) (PortfoliosnapshotSlice, error) {
+	var o []*Portfoliosnapshot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to Portfoliosnapshot slice")
+	}
+
+	if len(portfoliosnapshotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Portfoliosnapshot records in the query.
+func (q portfoliosnapshotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count portfoliosnapshot rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q portfoliosnapshotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if portfoliosnapshot exists")
+	}
+
+	return count > 0, nil
+}
+
+// Portfoliosnapshotholdings retrieves all the portfoliosnapshotholding's Portfoliosnapshotholdings with an executor.
+func (o *Portfoliosnapshot) Portfoliosnapshotholdings(mods ...qm.QueryMod) portfoliosnapshotholdingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"portfoliosnapshotholding\".\"portfoliosnapshot_id\"=?", o.ID),
+	)
+
+	query := Portfoliosnapshotholdings(queryMods...)
+	queries.SetFrom(query.Query, "\"portfoliosnapshotholding\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"portfoliosnapshotholding\".*"})
+	}
+
+	return query
+}
+
+// LoadPortfoliosnapshotholdings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (portfoliosnapshotL) LoadPortfoliosnapshotholdings(ctx context.Context, e boil.ContextExecutor, singular bool, maybePortfoliosnapshot interface{}, mods queries.Applicator) error {
+	var slice []*Portfoliosnapshot
+	var object *Portfoliosnapshot
+
+	if singular {
+		object = maybePortfoliosnapshot.(*Portfoliosnapshot)
+	} else {
+		slice = *maybePortfoliosnapshot.(*[]*Portfoliosnapshot)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &portfoliosnapshotR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &portfoliosnapshotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`portfoliosnapshotholding`), qm.WhereIn(`portfoliosnapshotholding.portfoliosnapshot_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load portfoliosnapshotholding")
+	}
+
+	var resultSlice []*Portfoliosnapshotholding
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice portfoliosnapshotholding")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on portfoliosnapshotholding")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for portfoliosnapshotholding")
+	}
+
+	if len(portfoliosnapshotholdingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Portfoliosnapshotholdings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &portfoliosnapshotholdingR{}
+			}
+			foreign.R.Portfoliosnapshot = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PortfoliosnapshotID {
+				local.R.Portfoliosnapshotholdings = append(local.R.Portfoliosnapshotholdings, foreign)
+				if foreign.R == nil {
+					foreign.R = &portfoliosnapshotholdingR{}
+				}
+				foreign.R.Portfoliosnapshot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddPortfoliosnapshotholdings adds the given related objects to the existing relationships
+// of the portfoliosnapshot, optionally inserting them as new records.
+// Appends related to o.R.Portfoliosnapshotholdings.
+// Sets related.R.Portfoliosnapshot appropriately.
+func (o *Portfoliosnapshot) AddPortfoliosnapshotholdings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Portfoliosnapshotholding) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PortfoliosnapshotID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"portfoliosnapshotholding\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"portfoliosnapshot_id"}),
+				strmangle.WhereClause("\"", "\"", 0, portfoliosnapshotholdingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PortfoliosnapshotID, rel.Source, rel.Asset, rel.Currency}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PortfoliosnapshotID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &portfoliosnapshotR{
+			Portfoliosnapshotholdings: related,
+		}
+	} else {
+		o.R.Portfoliosnapshotholdings = append(o.R.Portfoliosnapshotholdings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &portfoliosnapshotholdingR{
+				Portfoliosnapshot: o,
+			}
+		} else {
+			rel.R.Portfoliosnapshot = o
+		}
+	}
+	return nil
+}
+
+// Portfoliosnapshots retrieves all the records using an executor.
+func Portfoliosnapshots(mods ...qm.QueryMod) portfoliosnapshotQuery {
+	mods = append(mods, qm.From("\"portfoliosnapshot\""))
+	return portfoliosnapshotQuery{NewQuery(mods...)}
+}
+
+// FindPortfoliosnapshot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPortfoliosnapshot(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Portfoliosnapshot, error) {
+	portfoliosnapshotObj := &Portfoliosnapshot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"portfoliosnapshot\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, portfoliosnapshotObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from portfoliosnapshot")
+	}
+
+	return portfoliosnapshotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Portfoliosnapshot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no portfoliosnapshot provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(portfoliosnapshotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	portfoliosnapshotInsertCacheMut.RLock()
+	cache, cached := portfoliosnapshotInsertCache[key]
+	portfoliosnapshotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			portfoliosnapshotAllColumns,
+			portfoliosnapshotColumnsWithDefault,
+			portfoliosnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(portfoliosnapshotType, portfoliosnapshotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(portfoliosnapshotType, portfoliosnapshotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"portfoliosnapshot\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"portfoliosnapshot\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"portfoliosnapshot\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, portfoliosnapshotPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into portfoliosnapshot")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for portfoliosnapshot")
+	}
+
+CacheNoHooks:
+	if !cached {
+		portfoliosnapshotInsertCacheMut.Lock()
+		portfoliosnapshotInsertCache[key] = cache
+		portfoliosnapshotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Portfoliosnapshot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Portfoliosnapshot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	portfoliosnapshotUpdateCacheMut.RLock()
+	cache, cached := portfoliosnapshotUpdateCache[key]
+	portfoliosnapshotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			portfoliosnapshotAllColumns,
+			portfoliosnapshotPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update portfoliosnapshot, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"portfoliosnapshot\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, portfoliosnapshotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(portfoliosnapshotType, portfoliosnapshotMapping, append(wl, portfoliosnapshotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update portfoliosnapshot row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for portfoliosnapshot")
+	}
+
+	if !cached {
+		portfoliosnapshotUpdateCacheMut.Lock()
+		portfoliosnapshotUpdateCache[key] = cache
+		portfoliosnapshotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q portfoliosnapshotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for portfoliosnapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for portfoliosnapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PortfoliosnapshotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfoliosnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"portfoliosnapshot\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, portfoliosnapshotPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in portfoliosnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all portfoliosnapshot")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single Portfoliosnapshot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Portfoliosnapshot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no Portfoliosnapshot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), portfoliosnapshotPrimaryKeyMapping)
+	sql := "DELETE FROM \"portfoliosnapshot\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from portfoliosnapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for portfoliosnapshot")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q portfoliosnapshotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no portfoliosnapshotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from portfoliosnapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for portfoliosnapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PortfoliosnapshotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(portfoliosnapshotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfoliosnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"portfoliosnapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, portfoliosnapshotPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from portfoliosnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for portfoliosnapshot")
+	}
+
+	if len(portfoliosnapshotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Portfoliosnapshot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPortfoliosnapshot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PortfoliosnapshotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PortfoliosnapshotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), portfoliosnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"portfoliosnapshot\".* FROM \"portfoliosnapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, portfoliosnapshotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in PortfoliosnapshotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PortfoliosnapshotExists checks if the Portfoliosnapshot row exists.
+func PortfoliosnapshotExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"portfoliosnapshot\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if portfoliosnapshot exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,838 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPortfoliosnapshots(t *testing.T) {
+	t.Parallel()
+
+	query := Portfoliosnapshots()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPortfoliosnapshotsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Portfoliosnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfoliosnapshotsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Portfoliosnapshots().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Portfoliosnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfoliosnapshotsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PortfoliosnapshotSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Portfoliosnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfoliosnapshotsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PortfoliosnapshotExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Portfoliosnapshot exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PortfoliosnapshotExists to return true, but got false.")
+	}
+}
+
+func testPortfoliosnapshotsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	portfoliosnapshotFound, err := FindPortfoliosnapshot(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if portfoliosnapshotFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPortfoliosnapshotsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Portfoliosnapshots().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfoliosnapshotsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Portfoliosnapshots().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPortfoliosnapshotsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	portfoliosnapshotOne := &Portfoliosnapshot{}
+	portfoliosnapshotTwo := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, portfoliosnapshotOne, portfoliosnapshotDBTypes, false, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, portfoliosnapshotTwo, portfoliosnapshotDBTypes, false, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = portfoliosnapshotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = portfoliosnapshotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Portfoliosnapshots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPortfoliosnapshotsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	portfoliosnapshotOne := &Portfoliosnapshot{}
+	portfoliosnapshotTwo := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, portfoliosnapshotOne, portfoliosnapshotDBTypes, false, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, portfoliosnapshotTwo, portfoliosnapshotDBTypes, false, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = portfoliosnapshotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = portfoliosnapshotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Portfoliosnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func portfoliosnapshotBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshot) error {
+	*o = Portfoliosnapshot{}
+	return nil
+}
+
+func portfoliosnapshotAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshot) error {
+	*o = Portfoliosnapshot{}
+	return nil
+}
+
+func portfoliosnapshotAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshot) error {
+	*o = Portfoliosnapshot{}
+	return nil
+}
+
+func portfoliosnapshotBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshot) error {
+	*o = Portfoliosnapshot{}
+	return nil
+}
+
+func portfoliosnapshotAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshot) error {
+	*o = Portfoliosnapshot{}
+	return nil
+}
+
+func portfoliosnapshotBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshot) error {
+	*o = Portfoliosnapshot{}
+	return nil
+}
+
+func portfoliosnapshotAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshot) error {
+	*o = Portfoliosnapshot{}
+	return nil
+}
+
+func portfoliosnapshotBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshot) error {
+	*o = Portfoliosnapshot{}
+	return nil
+}
+
+func portfoliosnapshotAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshot) error {
+	*o = Portfoliosnapshot{}
+	return nil
+}
+
+func testPortfoliosnapshotsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Portfoliosnapshot{}
+	o := &Portfoliosnapshot{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot object: %s", err)
+	}
+
+	AddPortfoliosnapshotHook(boil.BeforeInsertHook, portfoliosnapshotBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotBeforeInsertHooks = []PortfoliosnapshotHook{}
+
+	AddPortfoliosnapshotHook(boil.AfterInsertHook, portfoliosnapshotAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotAfterInsertHooks = []PortfoliosnapshotHook{}
+
+	AddPortfoliosnapshotHook(boil.AfterSelectHook, portfoliosnapshotAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotAfterSelectHooks = []PortfoliosnapshotHook{}
+
+	AddPortfoliosnapshotHook(boil.BeforeUpdateHook, portfoliosnapshotBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotBeforeUpdateHooks = []PortfoliosnapshotHook{}
+
+	AddPortfoliosnapshotHook(boil.AfterUpdateHook, portfoliosnapshotAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotAfterUpdateHooks = []PortfoliosnapshotHook{}
+
+	AddPortfoliosnapshotHook(boil.BeforeDeleteHook, portfoliosnapshotBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotBeforeDeleteHooks = []PortfoliosnapshotHook{}
+
+	AddPortfoliosnapshotHook(boil.AfterDeleteHook, portfoliosnapshotAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotAfterDeleteHooks = []PortfoliosnapshotHook{}
+
+	AddPortfoliosnapshotHook(boil.BeforeUpsertHook, portfoliosnapshotBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotBeforeUpsertHooks = []PortfoliosnapshotHook{}
+
+	AddPortfoliosnapshotHook(boil.AfterUpsertHook, portfoliosnapshotAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotAfterUpsertHooks = []PortfoliosnapshotHook{}
+}
+
+func testPortfoliosnapshotsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Portfoliosnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPortfoliosnapshotsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(portfoliosnapshotColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Portfoliosnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPortfoliosnapshotToManyPortfoliosnapshotholdings(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Portfoliosnapshot
+	var b, c Portfoliosnapshotholding
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, portfoliosnapshotholdingDBTypes, false, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, portfoliosnapshotholdingDBTypes, false, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.PortfoliosnapshotID = a.ID
+	c.PortfoliosnapshotID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Portfoliosnapshotholdings().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.PortfoliosnapshotID == b.PortfoliosnapshotID {
+			bFound = true
+		}
+		if v.PortfoliosnapshotID == c.PortfoliosnapshotID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PortfoliosnapshotSlice{&a}
+	if err = a.L.LoadPortfoliosnapshotholdings(ctx, tx, false, (*[]*Portfoliosnapshot)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Portfoliosnapshotholdings); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Portfoliosnapshotholdings = nil
+	if err = a.L.LoadPortfoliosnapshotholdings(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Portfoliosnapshotholdings); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testPortfoliosnapshotToManyAddOpPortfoliosnapshotholdings(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Portfoliosnapshot
+	var b, c, d, e Portfoliosnapshotholding
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, portfoliosnapshotDBTypes, false, strmangle.SetComplement(portfoliosnapshotPrimaryKeyColumns, portfoliosnapshotColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Portfoliosnapshotholding{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, portfoliosnapshotholdingDBTypes, false, strmangle.SetComplement(portfoliosnapshotholdingPrimaryKeyColumns, portfoliosnapshotholdingColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Portfoliosnapshotholding{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPortfoliosnapshotholdings(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.PortfoliosnapshotID {
+			t.Error("foreign key was wrong value", a.ID, first.PortfoliosnapshotID)
+		}
+		if a.ID != second.PortfoliosnapshotID {
+			t.Error("foreign key was wrong value", a.ID, second.PortfoliosnapshotID)
+		}
+
+		if first.R.Portfoliosnapshot != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Portfoliosnapshot != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Portfoliosnapshotholdings[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Portfoliosnapshotholdings[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Portfoliosnapshotholdings().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testPortfoliosnapshotsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfoliosnapshotsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PortfoliosnapshotSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfoliosnapshotsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Portfoliosnapshots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	portfoliosnapshotDBTypes = map[string]string{`ID`: `TEXT`, `FiatCurrency`: `TEXT`, `TotalValue`: `REAL`, `CreatedAt`: `TIMESTAMP`}
+	_                        = bytes.MinRead
+)
+
+func testPortfoliosnapshotsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(portfoliosnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(portfoliosnapshotAllColumns) == len(portfoliosnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Portfoliosnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPortfoliosnapshotsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(portfoliosnapshotAllColumns) == len(portfoliosnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshot{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Portfoliosnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, portfoliosnapshotDBTypes, true, portfoliosnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(portfoliosnapshotAllColumns, portfoliosnapshotPrimaryKeyColumns) {
+		fields = portfoliosnapshotAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			portfoliosnapshotAllColumns,
+			portfoliosnapshotPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PortfoliosnapshotSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,789 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPortfoliosnapshotholdings(t *testing.T) {
+	t.Parallel()
+
+	query := Portfoliosnapshotholdings()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPortfoliosnapshotholdingsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Portfoliosnapshotholdings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfoliosnapshotholdingsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Portfoliosnapshotholdings().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Portfoliosnapshotholdings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfoliosnapshotholdingsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PortfoliosnapshotholdingSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Portfoliosnapshotholdings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPortfoliosnapshotholdingsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PortfoliosnapshotholdingExists(ctx, tx, o.PortfoliosnapshotID, o.Source, o.Asset, o.Currency)
+	if err != nil {
+		t.Errorf("Unable to check if Portfoliosnapshotholding exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PortfoliosnapshotholdingExists to return true, but got false.")
+	}
+}
+
+func testPortfoliosnapshotholdingsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	portfoliosnapshotholdingFound, err := FindPortfoliosnapshotholding(ctx, tx, o.PortfoliosnapshotID, o.Source, o.Asset, o.Currency)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if portfoliosnapshotholdingFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPortfoliosnapshotholdingsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Portfoliosnapshotholdings().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfoliosnapshotholdingsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Portfoliosnapshotholdings().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPortfoliosnapshotholdingsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	portfoliosnapshotholdingOne := &Portfoliosnapshotholding{}
+	portfoliosnapshotholdingTwo := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, portfoliosnapshotholdingOne, portfoliosnapshotholdingDBTypes, false, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+	if err = randomize.Struct(seed, portfoliosnapshotholdingTwo, portfoliosnapshotholdingDBTypes, false, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = portfoliosnapshotholdingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = portfoliosnapshotholdingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Portfoliosnapshotholdings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPortfoliosnapshotholdingsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	portfoliosnapshotholdingOne := &Portfoliosnapshotholding{}
+	portfoliosnapshotholdingTwo := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, portfoliosnapshotholdingOne, portfoliosnapshotholdingDBTypes, false, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+	if err = randomize.Struct(seed, portfoliosnapshotholdingTwo, portfoliosnapshotholdingDBTypes, false, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = portfoliosnapshotholdingOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = portfoliosnapshotholdingTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Portfoliosnapshotholdings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func portfoliosnapshotholdingBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshotholding) error {
+	*o = Portfoliosnapshotholding{}
+	return nil
+}
+
+func portfoliosnapshotholdingAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshotholding) error {
+	*o = Portfoliosnapshotholding{}
+	return nil
+}
+
+func portfoliosnapshotholdingAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshotholding) error {
+	*o = Portfoliosnapshotholding{}
+	return nil
+}
+
+func portfoliosnapshotholdingBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshotholding) error {
+	*o = Portfoliosnapshotholding{}
+	return nil
+}
+
+func portfoliosnapshotholdingAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshotholding) error {
+	*o = Portfoliosnapshotholding{}
+	return nil
+}
+
+func portfoliosnapshotholdingBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshotholding) error {
+	*o = Portfoliosnapshotholding{}
+	return nil
+}
+
+func portfoliosnapshotholdingAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshotholding) error {
+	*o = Portfoliosnapshotholding{}
+	return nil
+}
+
+func portfoliosnapshotholdingBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshotholding) error {
+	*o = Portfoliosnapshotholding{}
+	return nil
+}
+
+func portfoliosnapshotholdingAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Portfoliosnapshotholding) error {
+	*o = Portfoliosnapshotholding{}
+	return nil
+}
+
+func testPortfoliosnapshotholdingsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Portfoliosnapshotholding{}
+	o := &Portfoliosnapshotholding{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding object: %s", err)
+	}
+
+	AddPortfoliosnapshotholdingHook(boil.BeforeInsertHook, portfoliosnapshotholdingBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotholdingBeforeInsertHooks = []PortfoliosnapshotholdingHook{}
+
+	AddPortfoliosnapshotholdingHook(boil.AfterInsertHook, portfoliosnapshotholdingAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotholdingAfterInsertHooks = []PortfoliosnapshotholdingHook{}
+
+	AddPortfoliosnapshotholdingHook(boil.AfterSelectHook, portfoliosnapshotholdingAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotholdingAfterSelectHooks = []PortfoliosnapshotholdingHook{}
+
+	AddPortfoliosnapshotholdingHook(boil.BeforeUpdateHook, portfoliosnapshotholdingBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotholdingBeforeUpdateHooks = []PortfoliosnapshotholdingHook{}
+
+	AddPortfoliosnapshotholdingHook(boil.AfterUpdateHook, portfoliosnapshotholdingAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotholdingAfterUpdateHooks = []PortfoliosnapshotholdingHook{}
+
+	AddPortfoliosnapshotholdingHook(boil.BeforeDeleteHook, portfoliosnapshotholdingBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotholdingBeforeDeleteHooks = []PortfoliosnapshotholdingHook{}
+
+	AddPortfoliosnapshotholdingHook(boil.AfterDeleteHook, portfoliosnapshotholdingAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotholdingAfterDeleteHooks = []PortfoliosnapshotholdingHook{}
+
+	AddPortfoliosnapshotholdingHook(boil.BeforeUpsertHook, portfoliosnapshotholdingBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotholdingBeforeUpsertHooks = []PortfoliosnapshotholdingHook{}
+
+	AddPortfoliosnapshotholdingHook(boil.AfterUpsertHook, portfoliosnapshotholdingAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	portfoliosnapshotholdingAfterUpsertHooks = []PortfoliosnapshotholdingHook{}
+}
+
+func testPortfoliosnapshotholdingsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Portfoliosnapshotholdings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPortfoliosnapshotholdingsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(portfoliosnapshotholdingColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Portfoliosnapshotholdings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPortfoliosnapshotholdingToOnePortfoliosnapshotUsingPortfoliosnapshot(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Portfoliosnapshotholding
+	var foreign Portfoliosnapshot
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, portfoliosnapshotholdingDBTypes, false, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, portfoliosnapshotDBTypes, false, portfoliosnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshot struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PortfoliosnapshotID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Portfoliosnapshot().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PortfoliosnapshotholdingSlice{&local}
+	if err = local.L.LoadPortfoliosnapshot(ctx, tx, false, (*[]*Portfoliosnapshotholding)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Portfoliosnapshot == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Portfoliosnapshot = nil
+	if err = local.L.LoadPortfoliosnapshot(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Portfoliosnapshot == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPortfoliosnapshotholdingToOneSetOpPortfoliosnapshotUsingPortfoliosnapshot(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Portfoliosnapshotholding
+	var b, c Portfoliosnapshot
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, portfoliosnapshotholdingDBTypes, false, strmangle.SetComplement(portfoliosnapshotholdingPrimaryKeyColumns, portfoliosnapshotholdingColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, portfoliosnapshotDBTypes, false, strmangle.SetComplement(portfoliosnapshotPrimaryKeyColumns, portfoliosnapshotColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, portfoliosnapshotDBTypes, false, strmangle.SetComplement(portfoliosnapshotPrimaryKeyColumns, portfoliosnapshotColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Portfoliosnapshot{&b, &c} {
+		err = a.SetPortfoliosnapshot(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Portfoliosnapshot != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Portfoliosnapshotholdings[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PortfoliosnapshotID != x.ID {
+			t.Error("foreign key was wrong value", a.PortfoliosnapshotID)
+		}
+
+		if exists, err := PortfoliosnapshotholdingExists(ctx, tx, a.PortfoliosnapshotID, a.Source, a.Asset, a.Currency); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testPortfoliosnapshotholdingsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfoliosnapshotholdingsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PortfoliosnapshotholdingSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPortfoliosnapshotholdingsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Portfoliosnapshotholdings().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	portfoliosnapshotholdingDBTypes = map[string]string{`PortfoliosnapshotID`: `TEXT`, `Source`: `TEXT`, `Asset`: `TEXT`, `Currency`: `TEXT`, `Amount`: `REAL`, `Price`: `REAL`, `Value`: `REAL`}
+	_                               = bytes.MinRead
+)
+
+func testPortfoliosnapshotholdingsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(portfoliosnapshotholdingPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(portfoliosnapshotholdingAllColumns) == len(portfoliosnapshotholdingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Portfoliosnapshotholdings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPortfoliosnapshotholdingsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(portfoliosnapshotholdingAllColumns) == len(portfoliosnapshotholdingPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Portfoliosnapshotholding{}
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Portfoliosnapshotholdings().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, portfoliosnapshotholdingDBTypes, true, portfoliosnapshotholdingPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Portfoliosnapshotholding struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(portfoliosnapshotholdingAllColumns, portfoliosnapshotholdingPrimaryKeyColumns) {
+		fields = portfoliosnapshotholdingAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			portfoliosnapshotholdingAllColumns,
+			portfoliosnapshotholdingPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PortfoliosnapshotholdingSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
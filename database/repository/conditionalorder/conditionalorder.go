@@ -0,0 +1,275 @@
+package conditionalorder
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates conditional orders in the database
+func (db *DBService) Upsert(orders ...*ConditionalOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSqlite(ctx, tx, orders...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, orders...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByID returns a conditional order by its ID
+func (db *DBService) GetByID(id string) (*ConditionalOrder, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getByIDSQLite(id)
+	case database.DBPostgreSQL:
+		return db.getByIDPostgres(id)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetByStatus returns all conditional orders matching the status
+func (db *DBService) GetByStatus(status string) ([]ConditionalOrder, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getByStatusSQLite(status)
+	case database.DBPostgreSQL:
+		return db.getByStatusPostgres(status)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSqlite(ctx context.Context, tx *sql.Tx, orders ...*ConditionalOrder) error {
+	for i := range orders {
+		if orders[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			orders[i].ID = freshUUID.String()
+		}
+		var tempEvent = sqlite3.Conditionalorder{
+			ID:                 orders[i].ID,
+			Exchange:           strings.ToLower(orders[i].Exchange),
+			Asset:              strings.ToLower(orders[i].Asset),
+			Base:               strings.ToUpper(orders[i].Base),
+			Quote:              strings.ToUpper(orders[i].Quote),
+			OrderType:          orders[i].OrderType,
+			Side:               orders[i].Side,
+			Amount:             orders[i].Amount,
+			TriggerPrice:       orders[i].TriggerPrice,
+			LimitPrice:         null.Float64{Float64: orders[i].LimitPrice, Valid: orders[i].LimitPrice > 0},
+			TrailingDistance:   null.Float64{Float64: orders[i].TrailingDistance, Valid: orders[i].TrailingDistance > 0},
+			TrailingPercentage: null.Float64{Float64: orders[i].TrailingPercentage, Valid: orders[i].TrailingPercentage > 0},
+			WatermarkPrice:     null.Float64{Float64: orders[i].WatermarkPrice, Valid: orders[i].WatermarkPrice > 0},
+			OcoGroup:           null.NewString(orders[i].OCOGroup, orders[i].OCOGroup != ""),
+			Status:             orders[i].Status,
+			OrderID:            null.NewString(orders[i].OrderID, orders[i].OrderID != ""),
+			Reason:             null.NewString(orders[i].Reason, orders[i].Reason != ""),
+			CreatedAt:          orders[i].CreatedDate.UTC().Format(time.RFC3339),
+			UpdatedAt:          orders[i].UpdatedDate.UTC().Format(time.RFC3339),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, orders ...*ConditionalOrder) error {
+	for i := range orders {
+		if orders[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			orders[i].ID = freshUUID.String()
+		}
+		var tempEvent = postgres.Conditionalorder{
+			ID:                 orders[i].ID,
+			Exchange:           strings.ToLower(orders[i].Exchange),
+			Asset:              strings.ToLower(orders[i].Asset),
+			Base:               strings.ToUpper(orders[i].Base),
+			Quote:              strings.ToUpper(orders[i].Quote),
+			OrderType:          orders[i].OrderType,
+			Side:               orders[i].Side,
+			Amount:             orders[i].Amount,
+			TriggerPrice:       orders[i].TriggerPrice,
+			LimitPrice:         null.Float64{Float64: orders[i].LimitPrice, Valid: orders[i].LimitPrice > 0},
+			TrailingDistance:   null.Float64{Float64: orders[i].TrailingDistance, Valid: orders[i].TrailingDistance > 0},
+			TrailingPercentage: null.Float64{Float64: orders[i].TrailingPercentage, Valid: orders[i].TrailingPercentage > 0},
+			WatermarkPrice:     null.Float64{Float64: orders[i].WatermarkPrice, Valid: orders[i].WatermarkPrice > 0},
+			OcoGroup:           null.NewString(orders[i].OCOGroup, orders[i].OCOGroup != ""),
+			Status:             orders[i].Status,
+			OrderID:            null.NewString(orders[i].OrderID, orders[i].OrderID != ""),
+			Reason:             null.NewString(orders[i].Reason, orders[i].Reason != ""),
+			CreatedAt:          orders[i].CreatedDate.UTC(),
+			UpdatedAt:          orders[i].UpdatedDate.UTC(),
+		}
+		err := tempEvent.Upsert(ctx, tx, true, []string{"id"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getByIDSQLite(id string) (*ConditionalOrder, error) {
+	result, err := sqlite3.Conditionalorders(qm.Where("id = ?", id)).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return createSQLiteConditionalOrderResponse(result)
+}
+
+func (db *DBService) getByIDPostgres(id string) (*ConditionalOrder, error) {
+	result, err := postgres.Conditionalorders(qm.Where("id = ?", id)).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return createPostgresConditionalOrderResponse(result), nil
+}
+
+func (db *DBService) getByStatusSQLite(status string) ([]ConditionalOrder, error) {
+	results, err := sqlite3.Conditionalorders(qm.Where("status = ?", status), qm.OrderBy("created_at")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]ConditionalOrder, len(results))
+	for i := range results {
+		var o *ConditionalOrder
+		o, err = createSQLiteConditionalOrderResponse(results[i])
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = *o
+	}
+	return resp, nil
+}
+
+func (db *DBService) getByStatusPostgres(status string) ([]ConditionalOrder, error) {
+	results, err := postgres.Conditionalorders(qm.Where("status = ?", status), qm.OrderBy("created_at")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]ConditionalOrder, len(results))
+	for i := range results {
+		resp[i] = *createPostgresConditionalOrderResponse(results[i])
+	}
+	return resp, nil
+}
+
+func createSQLiteConditionalOrderResponse(result *sqlite3.Conditionalorder) (*ConditionalOrder, error) {
+	created, err := time.Parse(time.RFC3339, result.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := time.Parse(time.RFC3339, result.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &ConditionalOrder{
+		ID:                 result.ID,
+		Exchange:           result.Exchange,
+		Asset:              result.Asset,
+		Base:               result.Base,
+		Quote:              result.Quote,
+		OrderType:          result.OrderType,
+		Side:               result.Side,
+		Amount:             result.Amount,
+		TriggerPrice:       result.TriggerPrice,
+		LimitPrice:         result.LimitPrice.Float64,
+		TrailingDistance:   result.TrailingDistance.Float64,
+		TrailingPercentage: result.TrailingPercentage.Float64,
+		WatermarkPrice:     result.WatermarkPrice.Float64,
+		OCOGroup:           result.OcoGroup.String,
+		Status:             result.Status,
+		OrderID:            result.OrderID.String,
+		Reason:             result.Reason.String,
+		CreatedDate:        created,
+		UpdatedDate:        updated,
+	}, nil
+}
+
+func createPostgresConditionalOrderResponse(result *postgres.Conditionalorder) *ConditionalOrder {
+	return &ConditionalOrder{
+		ID:                 result.ID,
+		Exchange:           result.Exchange,
+		Asset:              result.Asset,
+		Base:               result.Base,
+		Quote:              result.Quote,
+		OrderType:          result.OrderType,
+		Side:               result.Side,
+		Amount:             result.Amount,
+		TriggerPrice:       result.TriggerPrice,
+		LimitPrice:         result.LimitPrice.Float64,
+		TrailingDistance:   result.TrailingDistance.Float64,
+		TrailingPercentage: result.TrailingPercentage.Float64,
+		WatermarkPrice:     result.WatermarkPrice.Float64,
+		OCOGroup:           result.OcoGroup.String,
+		Status:             result.Status,
+		OrderID:            result.OrderID.String,
+		Reason:             result.Reason.String,
+		CreatedDate:        result.CreatedAt,
+		UpdatedDate:        result.UpdatedAt,
+	}
+}
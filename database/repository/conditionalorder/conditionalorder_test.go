@@ -0,0 +1,166 @@
+package conditionalorder
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestConditionalOrder(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			now := time.Now().Truncate(time.Second)
+			stop := &ConditionalOrder{
+				Exchange:     "Binance",
+				Asset:        "spot",
+				Base:         "btc",
+				Quote:        "usdt",
+				OrderType:    "STOP",
+				Side:         "SELL",
+				Amount:       1,
+				TriggerPrice: 100,
+				OCOGroup:     "group",
+				Status:       "ACTIVE",
+				CreatedDate:  now,
+				UpdatedDate:  now,
+			}
+			takeProfit := &ConditionalOrder{
+				Exchange:     "Binance",
+				Asset:        "spot",
+				Base:         "btc",
+				Quote:        "usdt",
+				OrderType:    "TAKE PROFIT",
+				Side:         "SELL",
+				Amount:       1,
+				TriggerPrice: 200,
+				LimitPrice:   199,
+				OCOGroup:     "group",
+				Status:       "ACTIVE",
+				CreatedDate:  now,
+				UpdatedDate:  now,
+			}
+			err = db.Upsert(stop, takeProfit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stop.ID == "" || takeProfit.ID == "" {
+				t.Fatal("expected IDs to be assigned on insert")
+			}
+
+			active, err := db.GetByStatus("ACTIVE")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(active) != 2 {
+				t.Fatalf("expected 2 active orders, received %v", len(active))
+			}
+
+			stop.Status = "TRIGGERED"
+			stop.OrderID = "1337"
+			stop.UpdatedDate = now.Add(time.Minute)
+			takeProfit.Status = "CANCELLED"
+			err = db.Upsert(stop, takeProfit)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			active, err = db.GetByStatus("ACTIVE")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(active) != 0 {
+				t.Errorf("expected no active orders, received %v", len(active))
+			}
+
+			resp, err := db.GetByID(stop.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Status != "TRIGGERED" || resp.OrderID != "1337" {
+				t.Errorf("unexpected order status %v or order ID %v", resp.Status, resp.OrderID)
+			}
+			if resp.Exchange != "binance" || resp.Base != "BTC" {
+				t.Errorf("unexpected exchange %v or base %v", resp.Exchange, resp.Base)
+			}
+			if !resp.UpdatedDate.Equal(now.Add(time.Minute)) {
+				t.Errorf("unexpected updated date %v", resp.UpdatedDate)
+			}
+
+			resp, err = db.GetByID(takeProfit.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.LimitPrice != 199 || resp.OCOGroup != "group" {
+				t.Errorf("unexpected limit price %v or oco group %v", resp.LimitPrice, resp.OCOGroup)
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package conditionalorder
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// ConditionalOrder is a DTO for database data
+type ConditionalOrder struct {
+	ID                 string
+	Exchange           string
+	Asset              string
+	Base               string
+	Quote              string
+	OrderType          string
+	Side               string
+	Amount             float64
+	TriggerPrice       float64
+	LimitPrice         float64
+	TrailingDistance   float64
+	TrailingPercentage float64
+	WatermarkPrice     float64
+	OCOGroup           string
+	Status             string
+	OrderID            string
+	Reason             string
+	CreatedDate        time.Time
+	UpdatedDate        time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using conditional order database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*ConditionalOrder) error
+	GetByID(string) (*ConditionalOrder, error)
+	GetByStatus(string) ([]ConditionalOrder, error)
+}
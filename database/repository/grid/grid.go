@@ -0,0 +1,319 @@
+package grid
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates grids and their levels in the database
+func (db *DBService) Upsert(grids ...*Grid) error {
+	if len(grids) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSqlite(ctx, tx, grids...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, grids...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByID returns a grid and its levels by its ID
+func (db *DBService) GetByID(id string) (*Grid, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getByIDSQLite(id)
+	case database.DBPostgreSQL:
+		return db.getByIDPostgres(id)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetByStatus returns all grids and their levels matching the status
+func (db *DBService) GetByStatus(status string) ([]Grid, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getByStatusSQLite(status)
+	case database.DBPostgreSQL:
+		return db.getByStatusPostgres(status)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSqlite(ctx context.Context, tx *sql.Tx, grids ...*Grid) error {
+	for i := range grids {
+		if grids[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			grids[i].ID = freshUUID.String()
+		}
+		var tempEvent = sqlite3.Grid{
+			ID:             grids[i].ID,
+			Exchange:       strings.ToLower(grids[i].Exchange),
+			Asset:          strings.ToLower(grids[i].Asset),
+			Base:           strings.ToUpper(grids[i].Base),
+			Quote:          strings.ToUpper(grids[i].Quote),
+			LowerPrice:     grids[i].LowerPrice,
+			UpperPrice:     grids[i].UpperPrice,
+			Levels:         grids[i].Levels,
+			Spacing:        grids[i].Spacing,
+			Amount:         grids[i].Amount,
+			Status:         grids[i].Status,
+			Reason:         null.NewString(grids[i].Reason, grids[i].Reason != ""),
+			RealisedProfit: grids[i].RealisedProfit,
+			RoundTrips:     grids[i].RoundTrips,
+			CreatedAt:      grids[i].CreatedDate.UTC().Format(time.RFC3339),
+			UpdatedAt:      grids[i].UpdatedDate.UTC().Format(time.RFC3339),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+		for j := range grids[i].GridLevels {
+			l := &grids[i].GridLevels[j]
+			var tempLevel = sqlite3.Gridlevel{
+				GridID:     grids[i].ID,
+				Level:      l.Level,
+				Price:      l.Price,
+				Side:       null.NewString(l.Side, l.Side != ""),
+				OrderID:    null.NewString(l.OrderID, l.OrderID != ""),
+				EntryPrice: null.Float64{Float64: l.EntryPrice, Valid: l.EntryPrice > 0},
+			}
+			err = tempLevel.Insert(ctx, tx, boil.Infer())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, grids ...*Grid) error {
+	for i := range grids {
+		if grids[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			grids[i].ID = freshUUID.String()
+		}
+		var tempEvent = postgres.Grid{
+			ID:             grids[i].ID,
+			Exchange:       strings.ToLower(grids[i].Exchange),
+			Asset:          strings.ToLower(grids[i].Asset),
+			Base:           strings.ToUpper(grids[i].Base),
+			Quote:          strings.ToUpper(grids[i].Quote),
+			LowerPrice:     grids[i].LowerPrice,
+			UpperPrice:     grids[i].UpperPrice,
+			Levels:         int(grids[i].Levels),
+			Spacing:        grids[i].Spacing,
+			Amount:         grids[i].Amount,
+			Status:         grids[i].Status,
+			Reason:         null.NewString(grids[i].Reason, grids[i].Reason != ""),
+			RealisedProfit: grids[i].RealisedProfit,
+			RoundTrips:     int(grids[i].RoundTrips),
+			CreatedAt:      grids[i].CreatedDate.UTC(),
+			UpdatedAt:      grids[i].UpdatedDate.UTC(),
+		}
+		err := tempEvent.Upsert(ctx, tx, true, []string{"id"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+		for j := range grids[i].GridLevels {
+			l := &grids[i].GridLevels[j]
+			var tempLevel = postgres.Gridlevel{
+				GridID:     grids[i].ID,
+				Level:      int(l.Level),
+				Price:      l.Price,
+				Side:       null.NewString(l.Side, l.Side != ""),
+				OrderID:    null.NewString(l.OrderID, l.OrderID != ""),
+				EntryPrice: null.Float64{Float64: l.EntryPrice, Valid: l.EntryPrice > 0},
+			}
+			err = tempLevel.Upsert(ctx, tx, true, []string{"grid_id", "level"}, boil.Infer(), boil.Infer())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getByIDSQLite(id string) (*Grid, error) {
+	result, err := sqlite3.Grids(qm.Where("id = ?", id), qm.Load(sqlite3.GridRels.Gridlevels, qm.OrderBy("level"))).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return createSQLiteGridResponse(result)
+}
+
+func (db *DBService) getByIDPostgres(id string) (*Grid, error) {
+	result, err := postgres.Grids(qm.Where("id = ?", id), qm.Load(postgres.GridRels.Gridlevels, qm.OrderBy("level"))).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return createPostgresGridResponse(result), nil
+}
+
+func (db *DBService) getByStatusSQLite(status string) ([]Grid, error) {
+	results, err := sqlite3.Grids(qm.Where("status = ?", status), qm.OrderBy("created_at"), qm.Load(sqlite3.GridRels.Gridlevels, qm.OrderBy("level"))).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Grid, len(results))
+	for i := range results {
+		var g *Grid
+		g, err = createSQLiteGridResponse(results[i])
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = *g
+	}
+	return resp, nil
+}
+
+func (db *DBService) getByStatusPostgres(status string) ([]Grid, error) {
+	results, err := postgres.Grids(qm.Where("status = ?", status), qm.OrderBy("created_at"), qm.Load(postgres.GridRels.Gridlevels, qm.OrderBy("level"))).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Grid, len(results))
+	for i := range results {
+		resp[i] = *createPostgresGridResponse(results[i])
+	}
+	return resp, nil
+}
+
+func createSQLiteGridResponse(result *sqlite3.Grid) (*Grid, error) {
+	created, err := time.Parse(time.RFC3339, result.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := time.Parse(time.RFC3339, result.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Grid{
+		ID:             result.ID,
+		Exchange:       result.Exchange,
+		Asset:          result.Asset,
+		Base:           result.Base,
+		Quote:          result.Quote,
+		LowerPrice:     result.LowerPrice,
+		UpperPrice:     result.UpperPrice,
+		Levels:         result.Levels,
+		Spacing:        result.Spacing,
+		Amount:         result.Amount,
+		Status:         result.Status,
+		Reason:         result.Reason.String,
+		RealisedProfit: result.RealisedProfit,
+		RoundTrips:     result.RoundTrips,
+		CreatedDate:    created,
+		UpdatedDate:    updated,
+	}
+	if result.R != nil {
+		resp.GridLevels = make([]Level, len(result.R.Gridlevels))
+		for i := range result.R.Gridlevels {
+			resp.GridLevels[i] = Level{
+				Level:      result.R.Gridlevels[i].Level,
+				Price:      result.R.Gridlevels[i].Price,
+				Side:       result.R.Gridlevels[i].Side.String,
+				OrderID:    result.R.Gridlevels[i].OrderID.String,
+				EntryPrice: result.R.Gridlevels[i].EntryPrice.Float64,
+			}
+		}
+	}
+	return resp, nil
+}
+
+func createPostgresGridResponse(result *postgres.Grid) *Grid {
+	resp := &Grid{
+		ID:             result.ID,
+		Exchange:       result.Exchange,
+		Asset:          result.Asset,
+		Base:           result.Base,
+		Quote:          result.Quote,
+		LowerPrice:     result.LowerPrice,
+		UpperPrice:     result.UpperPrice,
+		Levels:         int64(result.Levels),
+		Spacing:        result.Spacing,
+		Amount:         result.Amount,
+		Status:         result.Status,
+		Reason:         result.Reason.String,
+		RealisedProfit: result.RealisedProfit,
+		RoundTrips:     int64(result.RoundTrips),
+		CreatedDate:    result.CreatedAt,
+		UpdatedDate:    result.UpdatedAt,
+	}
+	if result.R != nil {
+		resp.GridLevels = make([]Level, len(result.R.Gridlevels))
+		for i := range result.R.Gridlevels {
+			resp.GridLevels[i] = Level{
+				Level:      int64(result.R.Gridlevels[i].Level),
+				Price:      result.R.Gridlevels[i].Price,
+				Side:       result.R.Gridlevels[i].Side.String,
+				OrderID:    result.R.Gridlevels[i].OrderID.String,
+				EntryPrice: result.R.Gridlevels[i].EntryPrice.Float64,
+			}
+		}
+	}
+	return resp
+}
@@ -0,0 +1,167 @@
+package grid
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestGrid(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			now := time.Now().Truncate(time.Second)
+			g := &Grid{
+				Exchange:    "Binance",
+				Asset:       "spot",
+				Base:        "btc",
+				Quote:       "usdt",
+				LowerPrice:  90,
+				UpperPrice:  110,
+				Levels:      3,
+				Spacing:     "ARITHMETIC",
+				Amount:      0.1,
+				Status:      "ACTIVE",
+				CreatedDate: now,
+				UpdatedDate: now,
+				GridLevels: []Level{
+					{Level: 0, Price: 90, Side: "BUY", OrderID: "1"},
+					{Level: 1, Price: 100},
+					{Level: 2, Price: 110, Side: "SELL", OrderID: "2"},
+				},
+			}
+			err = db.Upsert(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g.ID == "" {
+				t.Fatal("expected ID to be assigned on insert")
+			}
+
+			active, err := db.GetByStatus("ACTIVE")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(active) != 1 {
+				t.Fatalf("expected 1 active grid, received %v", len(active))
+			}
+			if len(active[0].GridLevels) != 3 {
+				t.Fatalf("expected 3 grid levels, received %v", len(active[0].GridLevels))
+			}
+
+			g.GridLevels[0] = Level{Level: 0, Price: 90}
+			g.GridLevels[1] = Level{Level: 1, Price: 100, Side: "SELL", OrderID: "3", EntryPrice: 90}
+			g.RealisedProfit = 1
+			g.RoundTrips = 1
+			g.Status = "STOPPED"
+			g.Reason = "stopped by user"
+			g.UpdatedDate = now.Add(time.Minute)
+			err = db.Upsert(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			active, err = db.GetByStatus("ACTIVE")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(active) != 0 {
+				t.Errorf("expected no active grids, received %v", len(active))
+			}
+
+			resp, err := db.GetByID(g.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Status != "STOPPED" || resp.Reason != "stopped by user" {
+				t.Errorf("unexpected grid status %v or reason %v", resp.Status, resp.Reason)
+			}
+			if resp.Exchange != "binance" || resp.Base != "BTC" {
+				t.Errorf("unexpected exchange %v or base %v", resp.Exchange, resp.Base)
+			}
+			if resp.RealisedProfit != 1 || resp.RoundTrips != 1 {
+				t.Errorf("unexpected realised profit %v or round trips %v", resp.RealisedProfit, resp.RoundTrips)
+			}
+			if !resp.UpdatedDate.Equal(now.Add(time.Minute)) {
+				t.Errorf("unexpected updated date %v", resp.UpdatedDate)
+			}
+			if len(resp.GridLevels) != 3 {
+				t.Fatalf("expected 3 grid levels, received %v", len(resp.GridLevels))
+			}
+			if resp.GridLevels[0].Side != "" || resp.GridLevels[0].OrderID != "" {
+				t.Errorf("unexpected level side %v or order ID %v", resp.GridLevels[0].Side, resp.GridLevels[0].OrderID)
+			}
+			if resp.GridLevels[1].Side != "SELL" || resp.GridLevels[1].OrderID != "3" || resp.GridLevels[1].EntryPrice != 90 {
+				t.Errorf("unexpected level side %v, order ID %v or entry price %v",
+					resp.GridLevels[1].Side, resp.GridLevels[1].OrderID, resp.GridLevels[1].EntryPrice)
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package grid
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Grid is a DTO for database data
+type Grid struct {
+	ID             string
+	Exchange       string
+	Asset          string
+	Base           string
+	Quote          string
+	LowerPrice     float64
+	UpperPrice     float64
+	Levels         int64
+	Spacing        string
+	Amount         float64
+	Status         string
+	Reason         string
+	RealisedProfit float64
+	RoundTrips     int64
+	CreatedDate    time.Time
+	UpdatedDate    time.Time
+	GridLevels     []Level
+}
+
+// Level is a DTO for a price level of a grid
+type Level struct {
+	Level      int64
+	Price      float64
+	Side       string
+	OrderID    string
+	EntryPrice float64
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using grid database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*Grid) error
+	GetByID(string) (*Grid, error)
+	GetByStatus(string) ([]Grid, error)
+}
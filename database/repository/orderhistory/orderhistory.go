@@ -0,0 +1,558 @@
+package orderhistory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+var errNilOrder = errors.New("nil order received")
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Record inserts or updates an order along with its trades and stores the
+// lifecycle events which caused the change. Orders are matched by ID or by
+// exchange and exchange order ID, when the order already exists its stored ID
+// is used for the order and events
+func (db *DBService) Record(o *Order, events ...*Event) error {
+	if o == nil {
+		return errNilOrder
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Record tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = recordSQLite(ctx, tx, o, events)
+	case database.DBPostgreSQL:
+		err = recordPostgres(ctx, tx, o, events)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByID returns an order and its trades by its ID
+func (db *DBService) GetByID(id string) (*Order, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getByIDSQLite(id)
+	case database.DBPostgreSQL:
+		return db.getByIDPostgres(id)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// Search returns orders and their trades matching the filter ordered by
+// creation date
+func (db *DBService) Search(f *Filter) ([]Order, error) {
+	if f == nil {
+		f = &Filter{}
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.searchSQLite(f)
+	case database.DBPostgreSQL:
+		return db.searchPostgres(f)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetEvents returns the lifecycle events of an order in the order they
+// occurred
+func (db *DBService) GetEvents(id string) ([]Event, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getEventsSQLite(id)
+	case database.DBPostgreSQL:
+		return db.getEventsPostgres(id)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func recordSQLite(ctx context.Context, tx *sql.Tx, o *Order, events []*Event) error {
+	existing, err := sqlite3.Orderdetails(
+		qm.Where("(exchange = ? AND order_id = ?) OR id = ?", strings.ToLower(o.Exchange), o.OrderID, o.ID),
+	).One(ctx, tx)
+	switch {
+	case err == nil:
+		o.ID = existing.ID
+	case errors.Is(err, sql.ErrNoRows):
+		if o.ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			o.ID = freshUUID.String()
+		}
+	default:
+		return err
+	}
+
+	var tempOrder = sqlite3.Orderdetail{
+		ID:                   o.ID,
+		Exchange:             strings.ToLower(o.Exchange),
+		Asset:                strings.ToLower(o.Asset),
+		Base:                 strings.ToUpper(o.Base),
+		Quote:                strings.ToUpper(o.Quote),
+		OrderID:              o.OrderID,
+		ClientOrderID:        null.NewString(o.ClientOrderID, o.ClientOrderID != ""),
+		OrderType:            o.Type,
+		Side:                 o.Side,
+		Status:               o.Status,
+		Price:                o.Price,
+		Amount:               o.Amount,
+		ExecutedAmount:       o.ExecutedAmount,
+		RemainingAmount:      o.RemainingAmount,
+		AverageExecutedPrice: o.AverageExecutedPrice,
+		Fee:                  o.Fee,
+		Cost:                 o.Cost,
+		CreatedAt:            o.CreatedDate.UTC().Format(time.RFC3339),
+		UpdatedAt:            o.UpdatedDate.UTC().Format(time.RFC3339),
+	}
+	if existing != nil {
+		_, err = tempOrder.Update(ctx, tx, boil.Infer())
+	} else {
+		err = tempOrder.Insert(ctx, tx, boil.Infer())
+	}
+	if err != nil {
+		return err
+	}
+
+	for i := range o.Trades {
+		var tempTrade = sqlite3.Ordertrade{
+			OrderdetailID: o.ID,
+			Tid:           tradeID(&o.Trades[i]),
+			Price:         o.Trades[i].Price,
+			Amount:        o.Trades[i].Amount,
+			Fee:           o.Trades[i].Fee,
+			Side:          null.NewString(o.Trades[i].Side, o.Trades[i].Side != ""),
+			Timestamp:     o.Trades[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err = tempTrade.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, e := range events {
+		if e == nil {
+			continue
+		}
+		if err = prepareEvent(o, e); err != nil {
+			return err
+		}
+		var tempEvent = sqlite3.Orderevent{
+			ID:             e.ID,
+			OrderdetailID:  e.OrderID,
+			Event:          e.Event,
+			Status:         e.Status,
+			Price:          e.Price,
+			Amount:         e.Amount,
+			ExecutedAmount: e.ExecutedAmount,
+			Message:        null.NewString(e.Message, e.Message != ""),
+			CreatedAt:      e.CreatedDate.UTC().Format(time.RFC3339),
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func recordPostgres(ctx context.Context, tx *sql.Tx, o *Order, events []*Event) error {
+	existing, err := postgres.Orderdetails(
+		qm.Where("(exchange = ? AND order_id = ?) OR id = ?", strings.ToLower(o.Exchange), o.OrderID, o.ID),
+	).One(ctx, tx)
+	switch {
+	case err == nil:
+		o.ID = existing.ID
+	case errors.Is(err, sql.ErrNoRows):
+		if o.ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			o.ID = freshUUID.String()
+		}
+	default:
+		return err
+	}
+
+	var tempOrder = postgres.Orderdetail{
+		ID:                   o.ID,
+		Exchange:             strings.ToLower(o.Exchange),
+		Asset:                strings.ToLower(o.Asset),
+		Base:                 strings.ToUpper(o.Base),
+		Quote:                strings.ToUpper(o.Quote),
+		OrderID:              o.OrderID,
+		ClientOrderID:        null.NewString(o.ClientOrderID, o.ClientOrderID != ""),
+		OrderType:            o.Type,
+		Side:                 o.Side,
+		Status:               o.Status,
+		Price:                o.Price,
+		Amount:               o.Amount,
+		ExecutedAmount:       o.ExecutedAmount,
+		RemainingAmount:      o.RemainingAmount,
+		AverageExecutedPrice: o.AverageExecutedPrice,
+		Fee:                  o.Fee,
+		Cost:                 o.Cost,
+		CreatedAt:            o.CreatedDate.UTC(),
+		UpdatedAt:            o.UpdatedDate.UTC(),
+	}
+	if existing != nil {
+		_, err = tempOrder.Update(ctx, tx, boil.Infer())
+	} else {
+		err = tempOrder.Insert(ctx, tx, boil.Infer())
+	}
+	if err != nil {
+		return err
+	}
+
+	for i := range o.Trades {
+		var tempTrade = postgres.Ordertrade{
+			OrderdetailID: o.ID,
+			Tid:           tradeID(&o.Trades[i]),
+			Price:         o.Trades[i].Price,
+			Amount:        o.Trades[i].Amount,
+			Fee:           o.Trades[i].Fee,
+			Side:          null.NewString(o.Trades[i].Side, o.Trades[i].Side != ""),
+			Timestamp:     o.Trades[i].Timestamp.UTC(),
+		}
+		err = tempTrade.Upsert(ctx, tx, true, []string{"orderdetail_id", "tid"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, e := range events {
+		if e == nil {
+			continue
+		}
+		if err = prepareEvent(o, e); err != nil {
+			return err
+		}
+		var tempEvent = postgres.Orderevent{
+			ID:             e.ID,
+			OrderdetailID:  e.OrderID,
+			Event:          e.Event,
+			Status:         e.Status,
+			Price:          e.Price,
+			Amount:         e.Amount,
+			ExecutedAmount: e.ExecutedAmount,
+			Message:        null.NewString(e.Message, e.Message != ""),
+			CreatedAt:      e.CreatedDate.UTC(),
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepareEvent links an event to its stored order and assigns it an ID
+func prepareEvent(o *Order, e *Event) error {
+	e.OrderID = o.ID
+	if e.ID != "" {
+		return nil
+	}
+	freshUUID, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	e.ID = freshUUID.String()
+	return nil
+}
+
+// tradeID returns the exchange trade ID or, when the exchange does not
+// provide one, an ID derived from the trade's details so fills are only stored
+// once
+func tradeID(t *Trade) string {
+	if t.TID != "" {
+		return t.TID
+	}
+	return strconv.FormatInt(t.Timestamp.UnixNano(), 10) + "-" +
+		strconv.FormatFloat(t.Price, 'f', -1, 64) + "-" +
+		strconv.FormatFloat(t.Amount, 'f', -1, 64)
+}
+
+func (db *DBService) getByIDSQLite(id string) (*Order, error) {
+	result, err := sqlite3.Orderdetails(qm.Where("id = ?", id), qm.Load(sqlite3.OrderdetailRels.Ordertrades, qm.OrderBy("timestamp"))).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return createSQLiteOrderResponse(result)
+}
+
+func (db *DBService) getByIDPostgres(id string) (*Order, error) {
+	result, err := postgres.Orderdetails(qm.Where("id = ?", id), qm.Load(postgres.OrderdetailRels.Ordertrades, qm.OrderBy("timestamp"))).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return createPostgresOrderResponse(result), nil
+}
+
+// searchMods converts a filter into query modifiers, dates are formatted by
+// the supplied function to match the driver's storage
+func searchMods(f *Filter, formatDate func(time.Time) interface{}) []qm.QueryMod {
+	var mods []qm.QueryMod
+	if f.Exchange != "" {
+		mods = append(mods, qm.Where("exchange = ?", strings.ToLower(f.Exchange)))
+	}
+	if f.Asset != "" {
+		mods = append(mods, qm.Where("asset = ?", strings.ToLower(f.Asset)))
+	}
+	if f.Base != "" {
+		mods = append(mods, qm.Where("base = ?", strings.ToUpper(f.Base)))
+	}
+	if f.Quote != "" {
+		mods = append(mods, qm.Where("quote = ?", strings.ToUpper(f.Quote)))
+	}
+	if len(f.Statuses) > 0 {
+		statuses := make([]interface{}, len(f.Statuses))
+		for i := range f.Statuses {
+			statuses[i] = f.Statuses[i]
+		}
+		mods = append(mods, qm.WhereIn("status in ?", statuses...))
+	}
+	if !f.StartDate.IsZero() {
+		mods = append(mods, qm.Where("created_at >= ?", formatDate(f.StartDate)))
+	}
+	if !f.EndDate.IsZero() {
+		mods = append(mods, qm.Where("created_at <= ?", formatDate(f.EndDate)))
+	}
+	mods = append(mods, qm.OrderBy("created_at"))
+	if f.Limit > 0 {
+		mods = append(mods, qm.Limit(f.Limit))
+	}
+	return mods
+}
+
+func (db *DBService) searchSQLite(f *Filter) ([]Order, error) {
+	mods := searchMods(f, func(t time.Time) interface{} {
+		return t.UTC().Format(time.RFC3339)
+	})
+	mods = append(mods, qm.Load(sqlite3.OrderdetailRels.Ordertrades, qm.OrderBy("timestamp")))
+	results, err := sqlite3.Orderdetails(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Order, len(results))
+	for i := range results {
+		var o *Order
+		o, err = createSQLiteOrderResponse(results[i])
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = *o
+	}
+	return resp, nil
+}
+
+func (db *DBService) searchPostgres(f *Filter) ([]Order, error) {
+	mods := searchMods(f, func(t time.Time) interface{} {
+		return t.UTC()
+	})
+	mods = append(mods, qm.Load(postgres.OrderdetailRels.Ordertrades, qm.OrderBy("timestamp")))
+	results, err := postgres.Orderdetails(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Order, len(results))
+	for i := range results {
+		resp[i] = *createPostgresOrderResponse(results[i])
+	}
+	return resp, nil
+}
+
+func (db *DBService) getEventsSQLite(id string) ([]Event, error) {
+	// Timestamps are stored to the second so rowid preserves insertion order
+	results, err := sqlite3.Orderevents(qm.Where("orderdetail_id = ?", id), qm.OrderBy("created_at, rowid")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Event, len(results))
+	for i := range results {
+		var created time.Time
+		created, err = time.Parse(time.RFC3339, results[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Event{
+			ID:             results[i].ID,
+			OrderID:        results[i].OrderdetailID,
+			Event:          results[i].Event,
+			Status:         results[i].Status,
+			Price:          results[i].Price,
+			Amount:         results[i].Amount,
+			ExecutedAmount: results[i].ExecutedAmount,
+			Message:        results[i].Message.String,
+			CreatedDate:    created,
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getEventsPostgres(id string) ([]Event, error) {
+	results, err := postgres.Orderevents(qm.Where("orderdetail_id = ?", id), qm.OrderBy("created_at")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Event, len(results))
+	for i := range results {
+		resp[i] = Event{
+			ID:             results[i].ID,
+			OrderID:        results[i].OrderdetailID,
+			Event:          results[i].Event,
+			Status:         results[i].Status,
+			Price:          results[i].Price,
+			Amount:         results[i].Amount,
+			ExecutedAmount: results[i].ExecutedAmount,
+			Message:        results[i].Message.String,
+			CreatedDate:    results[i].CreatedAt,
+		}
+	}
+	return resp, nil
+}
+
+func createSQLiteOrderResponse(result *sqlite3.Orderdetail) (*Order, error) {
+	created, err := time.Parse(time.RFC3339, result.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := time.Parse(time.RFC3339, result.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Order{
+		ID:                   result.ID,
+		Exchange:             result.Exchange,
+		Asset:                result.Asset,
+		Base:                 result.Base,
+		Quote:                result.Quote,
+		OrderID:              result.OrderID,
+		ClientOrderID:        result.ClientOrderID.String,
+		Type:                 result.OrderType,
+		Side:                 result.Side,
+		Status:               result.Status,
+		Price:                result.Price,
+		Amount:               result.Amount,
+		ExecutedAmount:       result.ExecutedAmount,
+		RemainingAmount:      result.RemainingAmount,
+		AverageExecutedPrice: result.AverageExecutedPrice,
+		Fee:                  result.Fee,
+		Cost:                 result.Cost,
+		CreatedDate:          created,
+		UpdatedDate:          updated,
+	}
+	if result.R != nil {
+		resp.Trades = make([]Trade, len(result.R.Ordertrades))
+		for i := range result.R.Ordertrades {
+			var ts time.Time
+			ts, err = time.Parse(time.RFC3339, result.R.Ordertrades[i].Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			resp.Trades[i] = Trade{
+				TID:       result.R.Ordertrades[i].Tid,
+				Price:     result.R.Ordertrades[i].Price,
+				Amount:    result.R.Ordertrades[i].Amount,
+				Fee:       result.R.Ordertrades[i].Fee,
+				Side:      result.R.Ordertrades[i].Side.String,
+				Timestamp: ts,
+			}
+		}
+	}
+	return resp, nil
+}
+
+func createPostgresOrderResponse(result *postgres.Orderdetail) *Order {
+	resp := &Order{
+		ID:                   result.ID,
+		Exchange:             result.Exchange,
+		Asset:                result.Asset,
+		Base:                 result.Base,
+		Quote:                result.Quote,
+		OrderID:              result.OrderID,
+		ClientOrderID:        result.ClientOrderID.String,
+		Type:                 result.OrderType,
+		Side:                 result.Side,
+		Status:               result.Status,
+		Price:                result.Price,
+		Amount:               result.Amount,
+		ExecutedAmount:       result.ExecutedAmount,
+		RemainingAmount:      result.RemainingAmount,
+		AverageExecutedPrice: result.AverageExecutedPrice,
+		Fee:                  result.Fee,
+		Cost:                 result.Cost,
+		CreatedDate:          result.CreatedAt,
+		UpdatedDate:          result.UpdatedAt,
+	}
+	if result.R != nil {
+		resp.Trades = make([]Trade, len(result.R.Ordertrades))
+		for i := range result.R.Ordertrades {
+			resp.Trades[i] = Trade{
+				TID:       result.R.Ordertrades[i].Tid,
+				Price:     result.R.Ordertrades[i].Price,
+				Amount:    result.R.Ordertrades[i].Amount,
+				Fee:       result.R.Ordertrades[i].Fee,
+				Side:      result.R.Ordertrades[i].Side.String,
+				Timestamp: result.R.Ordertrades[i].Timestamp,
+			}
+		}
+	}
+	return resp
+}
@@ -0,0 +1,246 @@
+package orderhistory
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestOrderHistory(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.Record(nil)
+			if !errors.Is(err, errNilOrder) {
+				t.Errorf("received '%v' expected '%v'", err, errNilOrder)
+			}
+
+			now := time.Now().Truncate(time.Second)
+			o := &Order{
+				ID:          "a4d7fb5e-3fd5-4ff7-8b5c-6e2b0a0e6c1c",
+				Exchange:    "Binance",
+				Asset:       "spot",
+				Base:        "btc",
+				Quote:       "usdt",
+				OrderID:     "1337",
+				Type:        "LIMIT",
+				Side:        "BUY",
+				Status:      "NEW",
+				Price:       100,
+				Amount:      2,
+				CreatedDate: now,
+				UpdatedDate: now,
+			}
+			err = db.Record(o, &Event{Event: "SUBMITTED", Status: "NEW", Price: 100, Amount: 2, CreatedDate: now})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// A different internal ID for the same exchange order must resolve
+			// to the stored order rather than creating a duplicate
+			o.ID = ""
+			o.Status = "PARTIALLY_FILLED"
+			o.ExecutedAmount = 1
+			o.RemainingAmount = 1
+			o.UpdatedDate = now.Add(time.Minute)
+			o.Trades = []Trade{
+				{Price: 100, Amount: 1, Fee: 0.1, Side: "BUY", Timestamp: now.Add(time.Minute)},
+			}
+			err = db.Record(o, &Event{Event: "FILL", Status: "PARTIALLY_FILLED", Price: 100, Amount: 2, ExecutedAmount: 1, CreatedDate: now.Add(time.Minute)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if o.ID != "a4d7fb5e-3fd5-4ff7-8b5c-6e2b0a0e6c1c" {
+				t.Fatalf("expected stored order ID to be reused, received %v", o.ID)
+			}
+
+			// Recording the same fill again should not duplicate the trade
+			err = db.Record(o)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := db.GetByID(o.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Exchange != "binance" || resp.Base != "BTC" || resp.Quote != "USDT" {
+				t.Errorf("unexpected exchange %v, base %v or quote %v", resp.Exchange, resp.Base, resp.Quote)
+			}
+			if resp.Status != "PARTIALLY_FILLED" || resp.ExecutedAmount != 1 {
+				t.Errorf("unexpected status %v or executed amount %v", resp.Status, resp.ExecutedAmount)
+			}
+			if !resp.CreatedDate.Equal(now) || !resp.UpdatedDate.Equal(now.Add(time.Minute)) {
+				t.Errorf("unexpected created date %v or updated date %v", resp.CreatedDate, resp.UpdatedDate)
+			}
+			if len(resp.Trades) != 1 {
+				t.Fatalf("expected 1 trade, received %v", len(resp.Trades))
+			}
+			if resp.Trades[0].Fee != 0.1 || resp.Trades[0].Side != "BUY" {
+				t.Errorf("unexpected trade fee %v or side %v", resp.Trades[0].Fee, resp.Trades[0].Side)
+			}
+
+			events, err := db.GetEvents(o.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(events) != 2 {
+				t.Fatalf("expected 2 events, received %v", len(events))
+			}
+			if events[0].Event != "SUBMITTED" || events[1].Event != "FILL" {
+				t.Errorf("unexpected events %v %v", events[0].Event, events[1].Event)
+			}
+			if events[1].OrderID != o.ID || events[1].ExecutedAmount != 1 {
+				t.Errorf("unexpected event order ID %v or executed amount %v", events[1].OrderID, events[1].ExecutedAmount)
+			}
+
+			err = db.Record(&Order{
+				Exchange:    "Binance",
+				Asset:       "spot",
+				Base:        "ETH",
+				Quote:       "USDT",
+				OrderID:     "1338",
+				Type:        "LIMIT",
+				Side:        "SELL",
+				Status:      "CANCELLED",
+				Price:       10,
+				Amount:      1,
+				CreatedDate: now.Add(time.Hour),
+				UpdatedDate: now.Add(time.Hour),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			orders, err := db.Search(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(orders) != 2 {
+				t.Fatalf("expected 2 orders, received %v", len(orders))
+			}
+
+			orders, err = db.Search(&Filter{Exchange: "BINANCE", Statuses: []string{"NEW", "PARTIALLY_FILLED"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(orders) != 1 || orders[0].OrderID != "1337" {
+				t.Fatalf("expected order 1337 to be returned, received %v", orders)
+			}
+
+			orders, err = db.Search(&Filter{Base: "eth", Quote: "usdt"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(orders) != 1 || orders[0].OrderID != "1338" {
+				t.Fatalf("expected order 1338 to be returned, received %v", orders)
+			}
+
+			orders, err = db.Search(&Filter{StartDate: now.Add(time.Minute), EndDate: now.Add(time.Hour * 2)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(orders) != 1 || orders[0].OrderID != "1338" {
+				t.Fatalf("expected order 1338 to be returned, received %v", orders)
+			}
+
+			orders, err = db.Search(&Filter{Limit: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(orders) != 1 || orders[0].OrderID != "1337" {
+				t.Fatalf("expected order 1337 to be returned, received %v", orders)
+			}
+
+			// Exchanges which assign a new ID on modification must update the
+			// stored order rather than insert a new one
+			o.OrderID = "1339"
+			err = db.Record(o, &Event{Event: "MODIFIED", Status: "PARTIALLY_FILLED", CreatedDate: now.Add(time.Minute * 2)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err = db.GetByID(o.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.OrderID != "1339" {
+				t.Errorf("expected order ID 1339, received %v", resp.OrderID)
+			}
+			orders, err = db.Search(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(orders) != 2 {
+				t.Errorf("expected 2 orders, received %v", len(orders))
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
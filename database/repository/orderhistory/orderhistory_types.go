@@ -0,0 +1,85 @@
+package orderhistory
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Order is a DTO for database data
+type Order struct {
+	// ID is the internal order ID assigned by the order manager
+	ID                   string
+	Exchange             string
+	Asset                string
+	Base                 string
+	Quote                string
+	OrderID              string
+	ClientOrderID        string
+	Type                 string
+	Side                 string
+	Status               string
+	Price                float64
+	Amount               float64
+	ExecutedAmount       float64
+	RemainingAmount      float64
+	AverageExecutedPrice float64
+	Fee                  float64
+	Cost                 float64
+	CreatedDate          time.Time
+	UpdatedDate          time.Time
+	Trades               []Trade
+}
+
+// Trade is a DTO for a fill of an order
+type Trade struct {
+	TID       string
+	Price     float64
+	Amount    float64
+	Fee       float64
+	Side      string
+	Timestamp time.Time
+}
+
+// Event is a DTO for a change in an order's lifecycle
+type Event struct {
+	ID string
+	// OrderID is the internal order ID of the order the event belongs to
+	OrderID        string
+	Event          string
+	Status         string
+	Price          float64
+	Amount         float64
+	ExecutedAmount float64
+	Message        string
+	CreatedDate    time.Time
+}
+
+// Filter limits the orders returned by a search, empty fields are not
+// filtered on
+type Filter struct {
+	Exchange  string
+	Asset     string
+	Base      string
+	Quote     string
+	Statuses  []string
+	StartDate time.Time
+	EndDate   time.Time
+	Limit     int
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using order history database service
+// without needing to care about implementation
+type IDBService interface {
+	Record(*Order, ...*Event) error
+	GetByID(string) (*Order, error)
+	Search(*Filter) ([]Order, error)
+	GetEvents(string) ([]Event, error)
+}
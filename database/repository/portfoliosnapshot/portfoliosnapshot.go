@@ -0,0 +1,239 @@
+package portfoliosnapshot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+var errNilSnapshot = errors.New("nil snapshot received")
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert stores a snapshot and its holdings, assigning an ID when one is not
+// set
+func (db *DBService) Insert(s *Snapshot) error {
+	if s == nil {
+		return errNilSnapshot
+	}
+	if s.ID == "" {
+		freshUUID, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		s.ID = freshUUID.String()
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSQLite(ctx, tx, s)
+	case database.DBPostgreSQL:
+		err = insertPostgres(ctx, tx, s)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns snapshots and their holdings taken between the start and
+// end dates ordered by time. A zero start or end date is not filtered on
+func (db *DBService) GetInRange(start, end time.Time) ([]Snapshot, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getInRangeSQLite(start, end)
+	case database.DBPostgreSQL:
+		return db.getInRangePostgres(start, end)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, s *Snapshot) error {
+	var tempSnapshot = sqlite3.Portfoliosnapshot{
+		ID:           s.ID,
+		FiatCurrency: strings.ToUpper(s.FiatCurrency),
+		TotalValue:   s.TotalValue,
+		CreatedAt:    s.CreatedDate.UTC().Format(time.RFC3339),
+	}
+	err := tempSnapshot.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return err
+	}
+	for i := range s.Holdings {
+		var tempHolding = sqlite3.Portfoliosnapshotholding{
+			PortfoliosnapshotID: s.ID,
+			Source:              strings.ToLower(s.Holdings[i].Source),
+			Asset:               strings.ToLower(s.Holdings[i].Asset),
+			Currency:            strings.ToUpper(s.Holdings[i].Currency),
+			Amount:              s.Holdings[i].Amount,
+			Price:               s.Holdings[i].Price,
+			Value:               s.Holdings[i].Value,
+		}
+		err = tempHolding.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, s *Snapshot) error {
+	var tempSnapshot = postgres.Portfoliosnapshot{
+		ID:           s.ID,
+		FiatCurrency: strings.ToUpper(s.FiatCurrency),
+		TotalValue:   s.TotalValue,
+		CreatedAt:    s.CreatedDate.UTC(),
+	}
+	err := tempSnapshot.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return err
+	}
+	for i := range s.Holdings {
+		var tempHolding = postgres.Portfoliosnapshotholding{
+			PortfoliosnapshotID: s.ID,
+			Source:              strings.ToLower(s.Holdings[i].Source),
+			Asset:               strings.ToLower(s.Holdings[i].Asset),
+			Currency:            strings.ToUpper(s.Holdings[i].Currency),
+			Amount:              s.Holdings[i].Amount,
+			Price:               s.Holdings[i].Price,
+			Value:               s.Holdings[i].Value,
+		}
+		err = tempHolding.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rangeMods(start, end time.Time, formatDate func(time.Time) interface{}) []qm.QueryMod {
+	var mods []qm.QueryMod
+	if !start.IsZero() {
+		mods = append(mods, qm.Where("created_at >= ?", formatDate(start)))
+	}
+	if !end.IsZero() {
+		mods = append(mods, qm.Where("created_at <= ?", formatDate(end)))
+	}
+	return append(mods, qm.OrderBy("created_at"))
+}
+
+func (db *DBService) getInRangeSQLite(start, end time.Time) ([]Snapshot, error) {
+	mods := rangeMods(start, end, func(t time.Time) interface{} {
+		return t.UTC().Format(time.RFC3339)
+	})
+	mods = append(mods, qm.Load(sqlite3.PortfoliosnapshotRels.Portfoliosnapshotholdings))
+	results, err := sqlite3.Portfoliosnapshots(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Snapshot, len(results))
+	for i := range results {
+		var created time.Time
+		created, err = time.Parse(time.RFC3339, results[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Snapshot{
+			ID:           results[i].ID,
+			FiatCurrency: results[i].FiatCurrency,
+			TotalValue:   results[i].TotalValue,
+			CreatedDate:  created,
+		}
+		if results[i].R == nil {
+			continue
+		}
+		resp[i].Holdings = make([]Holding, len(results[i].R.Portfoliosnapshotholdings))
+		for j, h := range results[i].R.Portfoliosnapshotholdings {
+			resp[i].Holdings[j] = Holding{
+				Source:   h.Source,
+				Asset:    h.Asset,
+				Currency: h.Currency,
+				Amount:   h.Amount,
+				Price:    h.Price,
+				Value:    h.Value,
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) getInRangePostgres(start, end time.Time) ([]Snapshot, error) {
+	mods := rangeMods(start, end, func(t time.Time) interface{} {
+		return t.UTC()
+	})
+	mods = append(mods, qm.Load(postgres.PortfoliosnapshotRels.Portfoliosnapshotholdings))
+	results, err := postgres.Portfoliosnapshots(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Snapshot, len(results))
+	for i := range results {
+		resp[i] = Snapshot{
+			ID:           results[i].ID,
+			FiatCurrency: results[i].FiatCurrency,
+			TotalValue:   results[i].TotalValue,
+			CreatedDate:  results[i].CreatedAt,
+		}
+		if results[i].R == nil {
+			continue
+		}
+		resp[i].Holdings = make([]Holding, len(results[i].R.Portfoliosnapshotholdings))
+		for j, h := range results[i].R.Portfoliosnapshotholdings {
+			resp[i].Holdings[j] = Holding{
+				Source:   h.Source,
+				Asset:    h.Asset,
+				Currency: h.Currency,
+				Amount:   h.Amount,
+				Price:    h.Price,
+				Value:    h.Value,
+			}
+		}
+	}
+	return resp, nil
+}
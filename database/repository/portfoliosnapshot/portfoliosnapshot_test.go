@@ -0,0 +1,137 @@
+package portfoliosnapshot
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestPortfolioSnapshot(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.Insert(nil)
+			if !errors.Is(err, errNilSnapshot) {
+				t.Errorf("received '%v' expected '%v'", err, errNilSnapshot)
+			}
+
+			now := time.Now().Truncate(time.Second)
+			for i := 0; i < 3; i++ {
+				s := &Snapshot{
+					FiatCurrency: "usd",
+					TotalValue:   float64(1000 * (i + 1)),
+					CreatedDate:  now.Add(time.Hour * time.Duration(i)),
+					Holdings: []Holding{
+						{Source: "Bitstamp", Asset: "SPOT", Currency: "btc", Amount: 0.1, Price: 5000, Value: 500},
+						{Source: "offline", Currency: "eth", Amount: 1, Price: float64(500 + 1000*i), Value: float64(500 + 1000*i)},
+					},
+				}
+				err = db.Insert(s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if s.ID == "" {
+					t.Fatal("expected snapshot ID to be assigned")
+				}
+			}
+
+			snapshots, err := db.GetInRange(time.Time{}, time.Time{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(snapshots) != 3 {
+				t.Fatalf("expected 3 snapshots, received %v", len(snapshots))
+			}
+			if snapshots[0].TotalValue != 1000 || snapshots[2].TotalValue != 3000 {
+				t.Errorf("expected snapshots to be ordered by time, received %v", snapshots)
+			}
+			if snapshots[0].FiatCurrency != "USD" {
+				t.Errorf("expected fiat currency USD, received %v", snapshots[0].FiatCurrency)
+			}
+			if len(snapshots[0].Holdings) != 2 {
+				t.Fatalf("expected 2 holdings, received %v", len(snapshots[0].Holdings))
+			}
+			for _, h := range snapshots[0].Holdings {
+				if h.Source == "bitstamp" && (h.Asset != "spot" || h.Currency != "BTC" || h.Value != 500) {
+					t.Errorf("unexpected exchange holding %+v", h)
+				}
+			}
+
+			snapshots, err = db.GetInRange(now.Add(time.Minute), now.Add(time.Hour*3))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(snapshots) != 2 {
+				t.Errorf("expected 2 snapshots in range, received %v", len(snapshots))
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
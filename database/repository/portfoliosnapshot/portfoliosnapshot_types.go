@@ -0,0 +1,41 @@
+package portfoliosnapshot
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Snapshot is a DTO for database data
+type Snapshot struct {
+	ID           string
+	FiatCurrency string
+	TotalValue   float64
+	CreatedDate  time.Time
+	Holdings     []Holding
+}
+
+// Holding is a DTO for a currency held by a source at the time of a snapshot
+type Holding struct {
+	// Source is the exchange name or the offline label the currency is held in
+	Source   string
+	Asset    string
+	Currency string
+	Amount   float64
+	Price    float64
+	Value    float64
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using portfolio snapshot database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(*Snapshot) error
+	GetInRange(start, end time.Time) ([]Snapshot, error)
+}
@@ -68,6 +68,13 @@ func IsRunning() bool {
 	return dispatcher.isRunning()
 }
 
+// QueueDepth returns the number of jobs waiting to be relayed and the maximum
+// number of jobs the queue can hold. Both are zero when the dispatch service is
+// not running
+func QueueDepth() (depth, capacity int) {
+	return dispatcher.queueDepth()
+}
+
 // start compares atomic running value, sets defaults, overrides with
 // configuration, then spawns workers
 func (d *Dispatcher) start(workers, channelCapacity int) error {
@@ -166,6 +173,20 @@ func (d *Dispatcher) isRunning() bool {
 	return d.running
 }
 
+// queueDepth returns the number of queued jobs and the job queue capacity
+func (d *Dispatcher) queueDepth() (depth, capacity int) {
+	if d == nil {
+		return 0, 0
+	}
+
+	d.m.RLock()
+	defer d.m.RUnlock()
+	if !d.running {
+		return 0, 0
+	}
+	return len(d.jobs), cap(d.jobs)
+}
+
 // relayer routine relays communications across the defined routes
 func (d *Dispatcher) relayer() {
 	for {
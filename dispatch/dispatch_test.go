@@ -70,6 +70,28 @@ func TestStartStop(t *testing.T) {
 	assert.False(t, d.isRunning(), "IsRunning should return false")
 }
 
+func TestQueueDepth(t *testing.T) {
+	t.Parallel()
+	var d *Dispatcher
+	depth, capacity := d.queueDepth()
+	assert.Zero(t, depth, "queueDepth should return zero depth on nil dispatcher")
+	assert.Zero(t, capacity, "queueDepth should return zero capacity on nil dispatcher")
+
+	d = NewDispatcher()
+	depth, capacity = d.queueDepth()
+	assert.Zero(t, depth, "queueDepth should return zero depth when not running")
+	assert.Zero(t, capacity, "queueDepth should return zero capacity when not running")
+
+	require.NoError(t, d.start(1, 5), "start must not error")
+	d.m.Lock()
+	d.jobs <- job{}
+	d.m.Unlock()
+	depth, capacity = d.queueDepth()
+	assert.LessOrEqual(t, depth, 1, "queueDepth should return the queued jobs")
+	assert.Equal(t, 5, capacity, "queueDepth should return the jobs limit")
+	require.NoError(t, d.stop(), "stop must not error")
+}
+
 func TestSubscribe(t *testing.T) {
 	t.Parallel()
 	var d *Dispatcher
@@ -0,0 +1,596 @@
+package eventbus
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func init() {
+	bus = NewBus(nil)
+}
+
+// NewBus returns an event bus relaying events through the supplied
+// multiplexer, if nil it will default to the global dispatcher
+func NewBus(mux *dispatch.Mux) *Bus {
+	if mux == nil {
+		mux = dispatch.GetNewMux(nil)
+	}
+	b := &Bus{
+		mux:    mux,
+		topics: make(map[Topic]*topic, len(Topics)),
+		subs:   make(map[*Subscription]struct{}),
+	}
+	for i := range Topics {
+		b.topics[Topics[i]] = &topic{}
+	}
+	return b
+}
+
+// Subscribe subscribes to a topic on the default event bus
+func Subscribe(t Topic, opts *SubscribeOptions) (*Subscription, error) {
+	return bus.Subscribe(t, opts)
+}
+
+// Publish publishes an event on the default event bus
+func Publish(e *Event) error {
+	return bus.Publish(e)
+}
+
+// HasSubscribers returns whether a topic on the default event bus has any
+// subscribers
+func HasSubscribers(t Topic) bool {
+	return bus.HasSubscribers(t)
+}
+
+// PublishTicker publishes a ticker update on the default event bus
+func PublishTicker(p *ticker.Price) error {
+	return bus.PublishTicker(p)
+}
+
+// PublishOrderbook publishes an orderbook update on the default event bus
+func PublishOrderbook(ob *orderbook.Base) error {
+	return bus.PublishOrderbook(ob)
+}
+
+// PublishTrades publishes an event per trade on the default event bus
+func PublishTrades(trades []trade.Data) error {
+	return bus.PublishTrades(trades)
+}
+
+// PublishFills publishes an event per fill on the default event bus
+func PublishFills(fills []fill.Data) error {
+	return bus.PublishFills(fills)
+}
+
+// PublishOrder publishes an order update on the default event bus
+func PublishOrder(d *order.Detail) error {
+	return bus.PublishOrder(d)
+}
+
+// PublishAccountChange publishes an account balance change on the default
+// event bus
+func PublishAccountChange(c *account.Change) error {
+	return bus.PublishAccountChange(c)
+}
+
+// PublishPosition publishes a futures position change on the default event
+// bus
+func PublishPosition(p *futures.Position) error {
+	return bus.PublishPosition(p)
+}
+
+// GetMetrics returns the metrics of the default event bus
+func GetMetrics() (*Metrics, error) {
+	return bus.GetMetrics()
+}
+
+// Subscribe returns a subscription receiving events published on the topic
+// which pass the options' filter
+func (b *Bus) Subscribe(t Topic, opts *SubscribeOptions) (*Subscription, error) {
+	if b == nil {
+		return nil, errBusIsNil
+	}
+	if opts == nil {
+		opts = &SubscribeOptions{}
+	}
+	if opts.DropPolicy != DropNewest && opts.DropPolicy != DropOldest {
+		return nil, fmt.Errorf("%w %v", errUnknownDropPolicy, opts.DropPolicy)
+	}
+	if opts.BufferSize < 0 {
+		return nil, fmt.Errorf("%w %v", errInvalidBufferSize, opts.BufferSize)
+	}
+	bufferSize := opts.BufferSize
+	if bufferSize == 0 {
+		bufferSize = DefaultBufferSize
+	}
+	id, err := b.getTopicID(t)
+	if err != nil {
+		return nil, err
+	}
+	pipe, err := b.mux.Subscribe(id)
+	if err != nil {
+		return nil, err
+	}
+	s := &Subscription{
+		bus:      b,
+		topic:    t,
+		filter:   opts.Filter,
+		policy:   opts.DropPolicy,
+		pipe:     pipe,
+		c:        make(chan *Event, bufferSize),
+		shutdown: make(chan struct{}),
+	}
+	b.m.Lock()
+	b.subs[s] = struct{}{}
+	b.m.Unlock()
+	atomic.AddInt64(&b.topics[t].subscribers, 1)
+	s.wg.Add(1)
+	go s.run()
+	return s, nil
+}
+
+// Publish publishes an event to the subscribers of its topic. The published
+// time is set when it is zero
+func (b *Bus) Publish(e *Event) error {
+	if b == nil {
+		return errBusIsNil
+	}
+	if e == nil {
+		return errEventIsNil
+	}
+	if !e.hasTopicData() {
+		return fmt.Errorf("%w %v", errEventDataMismatch, e.Topic)
+	}
+	if !b.HasSubscribers(e.Topic) {
+		return nil
+	}
+	id, err := b.getTopicID(e.Topic)
+	if err != nil {
+		return err
+	}
+	if e.Published.IsZero() {
+		e.Published = time.Now()
+	}
+	t := b.topics[e.Topic]
+	if err := b.mux.Publish(e, id); err != nil {
+		atomic.AddUint64(&t.failed, 1)
+		return err
+	}
+	atomic.AddUint64(&t.published, 1)
+	return nil
+}
+
+// HasSubscribers returns whether a topic has any subscribers, producers can
+// use it to avoid building events nobody will receive
+func (b *Bus) HasSubscribers(t Topic) bool {
+	if b == nil {
+		return false
+	}
+	ts, ok := b.topics[t]
+	return ok && atomic.LoadInt64(&ts.subscribers) > 0
+}
+
+// PublishTicker publishes a ticker update
+func (b *Bus) PublishTicker(p *ticker.Price) error {
+	if p == nil {
+		return errEventIsNil
+	}
+	if !b.HasSubscribers(TickerTopic) {
+		return nil
+	}
+	return b.Publish(&Event{
+		Topic:    TickerTopic,
+		Exchange: p.ExchangeName,
+		Asset:    p.AssetType,
+		Pair:     p.Pair,
+		Ticker:   p,
+	})
+}
+
+// PublishOrderbook publishes an orderbook update
+func (b *Bus) PublishOrderbook(ob *orderbook.Base) error {
+	if ob == nil {
+		return errEventIsNil
+	}
+	if !b.HasSubscribers(OrderbookTopic) {
+		return nil
+	}
+	return b.Publish(&Event{
+		Topic:     OrderbookTopic,
+		Exchange:  ob.Exchange,
+		Asset:     ob.Asset,
+		Pair:      ob.Pair,
+		Orderbook: ob,
+	})
+}
+
+// PublishTrades publishes an event per trade
+func (b *Bus) PublishTrades(trades []trade.Data) error {
+	if !b.HasSubscribers(TradeTopic) {
+		return nil
+	}
+	for i := range trades {
+		td := trades[i]
+		if err := b.Publish(&Event{
+			Topic:    TradeTopic,
+			Exchange: td.Exchange,
+			Asset:    td.AssetType,
+			Pair:     td.CurrencyPair,
+			Trade:    &td,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PublishFills publishes an event per fill
+func (b *Bus) PublishFills(fills []fill.Data) error {
+	if !b.HasSubscribers(FillTopic) {
+		return nil
+	}
+	for i := range fills {
+		fd := fills[i]
+		if err := b.Publish(&Event{
+			Topic:    FillTopic,
+			Exchange: fd.Exchange,
+			Asset:    fd.AssetType,
+			Pair:     fd.CurrencyPair,
+			Fill:     &fd,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PublishOrder publishes a copy of an order update
+func (b *Bus) PublishOrder(d *order.Detail) error {
+	if d == nil {
+		return errEventIsNil
+	}
+	if !b.HasSubscribers(OrderTopic) {
+		return nil
+	}
+	return b.Publish(&Event{
+		Topic:    OrderTopic,
+		Exchange: d.Exchange,
+		Asset:    d.AssetType,
+		Pair:     d.Pair,
+		Order:    d.CopyToPointer(),
+	})
+}
+
+// PublishAccountChange publishes an account balance change
+func (b *Bus) PublishAccountChange(c *account.Change) error {
+	if c == nil {
+		return errEventIsNil
+	}
+	if !b.HasSubscribers(AccountTopic) {
+		return nil
+	}
+	change := *c
+	return b.Publish(&Event{
+		Topic:    AccountTopic,
+		Exchange: change.Exchange,
+		Asset:    change.Asset,
+		Account:  &change,
+	})
+}
+
+// PublishPosition publishes a futures position change
+func (b *Bus) PublishPosition(p *futures.Position) error {
+	if p == nil {
+		return errEventIsNil
+	}
+	if !b.HasSubscribers(PositionTopic) {
+		return nil
+	}
+	return b.Publish(&Event{
+		Topic:    PositionTopic,
+		Exchange: p.Exchange,
+		Asset:    p.Asset,
+		Pair:     p.Pair,
+		Position: p,
+	})
+}
+
+// GetMetrics returns the publish counters of every topic and the delivery
+// metrics of every subscription
+func (b *Bus) GetMetrics() (*Metrics, error) {
+	if b == nil {
+		return nil, errBusIsNil
+	}
+	m := &Metrics{Topics: make([]TopicMetrics, len(Topics))}
+	for i := range Topics {
+		t := b.topics[Topics[i]]
+		m.Topics[i] = TopicMetrics{
+			Topic:           Topics[i],
+			Subscribers:     atomic.LoadInt64(&t.subscribers),
+			Published:       atomic.LoadUint64(&t.published),
+			PublishFailures: atomic.LoadUint64(&t.failed),
+		}
+	}
+	b.m.Lock()
+	m.Subscriptions = make([]SubscriptionMetrics, 0, len(b.subs))
+	for s := range b.subs {
+		m.Subscriptions = append(m.Subscriptions, s.Metrics())
+	}
+	b.m.Unlock()
+	m.DispatchQueueDepth, m.DispatchQueueCapacity = dispatch.QueueDepth()
+	return m, nil
+}
+
+// getTopicID returns the dispatch route of a topic, generating it on first
+// use
+func (b *Bus) getTopicID(t Topic) (uuid.UUID, error) {
+	ts, ok := b.topics[t]
+	if !ok {
+		return uuid.Nil, fmt.Errorf("%w %v", ErrUnknownTopic, t)
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	if ts.id.IsNil() {
+		id, err := b.mux.GetID()
+		if err != nil {
+			return uuid.Nil, err
+		}
+		ts.id = id
+	}
+	return ts.id, nil
+}
+
+// C returns the channel events are delivered on, it is closed once the
+// subscription is unsubscribed or the dispatch system stops
+func (s *Subscription) C() <-chan *Event {
+	return s.c
+}
+
+// Topic returns the topic of the subscription
+func (s *Subscription) Topic() Topic {
+	return s.topic
+}
+
+// Unsubscribe stops delivery and releases the subscription's dispatch route
+func (s *Subscription) Unsubscribe() error {
+	if s == nil {
+		return errSubscriptionIsNil
+	}
+	var err error
+	s.once.Do(func() {
+		close(s.shutdown)
+		s.wg.Wait()
+		err = s.pipe.Release()
+		s.bus.m.Lock()
+		delete(s.bus.subs, s)
+		s.bus.m.Unlock()
+		atomic.AddInt64(&s.bus.topics[s.topic].subscribers, -1)
+	})
+	return err
+}
+
+// Metrics returns the delivery metrics of the subscription
+func (s *Subscription) Metrics() SubscriptionMetrics {
+	m := SubscriptionMetrics{
+		Topic:      s.topic,
+		DropPolicy: s.policy,
+		Delivered:  atomic.LoadUint64(&s.delivered),
+		Dropped:    atomic.LoadUint64(&s.dropped),
+		Filtered:   atomic.LoadUint64(&s.filtered),
+		Pending:    len(s.c),
+		Capacity:   cap(s.c),
+	}
+	s.lagMtx.Lock()
+	m.LastLag = s.lastLag
+	m.MaxLag = s.maxLag
+	if received := m.Delivered + m.Dropped; received > 0 {
+		m.AverageLag = s.totalLag / time.Duration(received)
+	}
+	s.lagMtx.Unlock()
+	return m
+}
+
+// run relays events from the dispatch route into the subscriber's buffer
+func (s *Subscription) run() {
+	defer s.wg.Done()
+	defer close(s.c)
+	for {
+		select {
+		case <-s.shutdown:
+			return
+		case data, ok := <-s.pipe.Channel():
+			if !ok {
+				return
+			}
+			e, ok := data.(*Event)
+			if !ok {
+				log.Errorf(log.DispatchMgr, "%v %T", errUnexpectedEventType, data)
+				continue
+			}
+			s.deliver(e)
+		}
+	}
+}
+
+// deliver buffers an event for the subscriber, applying the drop policy
+// when the buffer is full
+func (s *Subscription) deliver(e *Event) {
+	if s.filter != nil && !s.filter(e) {
+		atomic.AddUint64(&s.filtered, 1)
+		return
+	}
+	lag := time.Since(e.Published)
+	s.lagMtx.Lock()
+	s.lastLag = lag
+	if lag > s.maxLag {
+		s.maxLag = lag
+	}
+	s.totalLag += lag
+	s.lagMtx.Unlock()
+	for {
+		select {
+		case s.c <- e:
+			atomic.AddUint64(&s.delivered, 1)
+			return
+		default:
+		}
+		if s.policy == DropNewest {
+			atomic.AddUint64(&s.dropped, 1)
+			return
+		}
+		// The subscription is the only sender so evicting the oldest event
+		// guarantees space unless the subscriber has already read it
+		select {
+		case <-s.c:
+			atomic.AddUint64(&s.dropped, 1)
+		default:
+		}
+	}
+}
+
+// Data returns the payload matching the event's topic
+func (e *Event) Data() interface{} {
+	switch e.Topic {
+	case TickerTopic:
+		return e.Ticker
+	case OrderbookTopic:
+		return e.Orderbook
+	case TradeTopic:
+		return e.Trade
+	case FillTopic:
+		return e.Fill
+	case OrderTopic:
+		return e.Order
+	case AccountTopic:
+		return e.Account
+	case PositionTopic:
+		return e.Position
+	}
+	return nil
+}
+
+// hasTopicData returns whether the event's payload matches its topic
+func (e *Event) hasTopicData() bool {
+	switch e.Topic {
+	case TickerTopic:
+		return e.Ticker != nil
+	case OrderbookTopic:
+		return e.Orderbook != nil
+	case TradeTopic:
+		return e.Trade != nil
+	case FillTopic:
+		return e.Fill != nil
+	case OrderTopic:
+		return e.Order != nil
+	case AccountTopic:
+		return e.Account != nil
+	case PositionTopic:
+		return e.Position != nil
+	}
+	return false
+}
+
+// ExchangeFilter returns a filter matching events from an exchange
+func ExchangeFilter(exchange string) Filter {
+	return func(e *Event) bool {
+		return strings.EqualFold(e.Exchange, exchange)
+	}
+}
+
+// AssetFilter returns a filter matching events for an asset type
+func AssetFilter(a asset.Item) Filter {
+	return func(e *Event) bool {
+		return e.Asset == a
+	}
+}
+
+// PairFilter returns a filter matching events for a currency pair
+func PairFilter(p currency.Pair) Filter {
+	return func(e *Event) bool {
+		return e.Pair.Equal(p)
+	}
+}
+
+// AllFilters returns a filter matching events which pass every supplied
+// filter, nil filters are ignored
+func AllFilters(filters ...Filter) Filter {
+	return func(e *Event) bool {
+		for i := range filters {
+			if filters[i] != nil && !filters[i](e) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// String implements the stringer interface
+func (t Topic) String() string {
+	switch t {
+	case TickerTopic:
+		return "ticker"
+	case OrderbookTopic:
+		return "orderbook"
+	case TradeTopic:
+		return "trade"
+	case FillTopic:
+		return "fill"
+	case OrderTopic:
+		return "order"
+	case AccountTopic:
+		return "account"
+	case PositionTopic:
+		return "position"
+	default:
+		return "unknown"
+	}
+}
+
+// StringToTopic returns the topic matching the string, ignoring case
+func StringToTopic(s string) (Topic, error) {
+	for i := range Topics {
+		if strings.EqualFold(Topics[i].String(), s) {
+			return Topics[i], nil
+		}
+	}
+	return UnknownTopic, fmt.Errorf("%w %q", ErrUnknownTopic, s)
+}
+
+// String implements the stringer interface
+func (d DropPolicy) String() string {
+	switch d {
+	case DropNewest:
+		return "dropnewest"
+	case DropOldest:
+		return "dropoldest"
+	default:
+		return "unknown"
+	}
+}
+
+// StringToDropPolicy returns the drop policy matching the string, ignoring
+// case. An empty string returns DropNewest
+func StringToDropPolicy(s string) (DropPolicy, error) {
+	switch strings.ToLower(s) {
+	case "", "dropnewest":
+		return DropNewest, nil
+	case "dropoldest":
+		return DropOldest, nil
+	}
+	return DropNewest, fmt.Errorf("%w %q", errUnknownDropPolicy, s)
+}
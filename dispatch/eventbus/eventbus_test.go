@@ -0,0 +1,241 @@
+package eventbus
+
+import (
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+var btcusd = currency.NewPair(currency.BTC, currency.USD)
+
+func TestMain(m *testing.M) {
+	if err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit*10); err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+// receive waits for an event to be delivered to the subscription
+func receive(t *testing.T, s *Subscription) *Event {
+	t.Helper()
+	select {
+	case e := <-s.C():
+		return e
+	case <-time.After(time.Second):
+		require.FailNow(t, "event must be delivered")
+	}
+	return nil
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+	var nilBus *Bus
+	_, err := nilBus.Subscribe(TickerTopic, nil)
+	assert.ErrorIs(t, err, errBusIsNil, "Subscribe should error on a nil bus")
+
+	b := NewBus(nil)
+	_, err = b.Subscribe(UnknownTopic, nil)
+	assert.ErrorIs(t, err, ErrUnknownTopic, "Subscribe should error on an unknown topic")
+	_, err = b.Subscribe(TickerTopic, &SubscribeOptions{DropPolicy: 255})
+	assert.ErrorIs(t, err, errUnknownDropPolicy, "Subscribe should error on an unknown drop policy")
+	_, err = b.Subscribe(TickerTopic, &SubscribeOptions{BufferSize: -1})
+	assert.ErrorIs(t, err, errInvalidBufferSize, "Subscribe should error on a negative buffer size")
+
+	s, err := b.Subscribe(TickerTopic, nil)
+	require.NoError(t, err, "Subscribe must not error")
+	assert.Equal(t, TickerTopic, s.Topic(), "Topic should be correct")
+	assert.Equal(t, DefaultBufferSize, cap(s.C()), "Buffer should default to DefaultBufferSize")
+	assert.True(t, b.HasSubscribers(TickerTopic), "HasSubscribers should return true")
+	assert.False(t, b.HasSubscribers(OrderTopic), "HasSubscribers should return false for other topics")
+
+	require.NoError(t, s.Unsubscribe(), "Unsubscribe must not error")
+	_, ok := <-s.C()
+	assert.False(t, ok, "Channel should be closed after unsubscribing")
+	assert.False(t, b.HasSubscribers(TickerTopic), "HasSubscribers should return false after unsubscribing")
+	assert.NoError(t, s.Unsubscribe(), "Unsubscribe should not error when called twice")
+	var nilSub *Subscription
+	assert.ErrorIs(t, nilSub.Unsubscribe(), errSubscriptionIsNil, "Unsubscribe should error on a nil subscription")
+}
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+	var nilBus *Bus
+	assert.ErrorIs(t, nilBus.Publish(&Event{}), errBusIsNil, "Publish should error on a nil bus")
+
+	b := NewBus(nil)
+	assert.ErrorIs(t, b.Publish(nil), errEventIsNil, "Publish should error on a nil event")
+	assert.ErrorIs(t, b.Publish(&Event{Topic: TickerTopic, Order: &order.Detail{}}), errEventDataMismatch, "Publish should error when the data does not match the topic")
+	assert.NoError(t, b.Publish(&Event{Topic: TickerTopic, Ticker: &ticker.Price{}}), "Publish should not error without subscribers")
+
+	s, err := b.Subscribe(TickerTopic, &SubscribeOptions{Filter: ExchangeFilter("Bitstamp")})
+	require.NoError(t, err, "Subscribe must not error")
+	require.NoError(t, b.PublishTicker(&ticker.Price{ExchangeName: "Kraken", Pair: btcusd, AssetType: asset.Spot}), "PublishTicker must not error")
+	require.NoError(t, b.PublishTicker(&ticker.Price{ExchangeName: "bitstamp", Pair: btcusd, AssetType: asset.Spot, Last: 1337}), "PublishTicker must not error")
+	e := receive(t, s)
+	assert.Equal(t, 1337.0, e.Ticker.Last, "Filtered event should be delivered")
+	assert.Equal(t, "bitstamp", e.Exchange, "Exchange should be correct")
+	assert.False(t, e.Published.IsZero(), "Published should be set")
+	assert.Eventually(t, func() bool { return s.Metrics().Filtered == 1 }, time.Second, time.Millisecond, "Filtered event should be counted")
+	require.NoError(t, s.Unsubscribe(), "Unsubscribe must not error")
+}
+
+func TestPublishTopics(t *testing.T) {
+	t.Parallel()
+	b := NewBus(nil)
+	for _, tc := range []struct {
+		topic   Topic
+		publish func() error
+		check   func(*Event) bool
+	}{
+		{OrderbookTopic, func() error {
+			return b.PublishOrderbook(&orderbook.Base{Exchange: "test", Asset: asset.Spot, Pair: btcusd})
+		}, func(e *Event) bool { return e.Orderbook != nil }},
+		{TradeTopic, func() error {
+			return b.PublishTrades([]trade.Data{{Exchange: "test", AssetType: asset.Spot, CurrencyPair: btcusd, Price: 1}})
+		}, func(e *Event) bool { return e.Trade != nil && e.Trade.Price == 1 }},
+		{FillTopic, func() error {
+			return b.PublishFills([]fill.Data{{Exchange: "test", AssetType: asset.Spot, CurrencyPair: btcusd, Amount: 2}})
+		}, func(e *Event) bool { return e.Fill != nil && e.Fill.Amount == 2 }},
+		{OrderTopic, func() error {
+			return b.PublishOrder(&order.Detail{Exchange: "test", AssetType: asset.Spot, Pair: btcusd, OrderID: "1"})
+		}, func(e *Event) bool { return e.Order != nil && e.Order.OrderID == "1" }},
+		{AccountTopic, func() error {
+			return b.PublishAccountChange(&account.Change{Exchange: "test", Asset: asset.Spot, Currency: currency.BTC, Amount: 3})
+		}, func(e *Event) bool { return e.Account != nil && e.Account.Amount == 3 && e.Pair.IsEmpty() }},
+		{PositionTopic, func() error {
+			return b.PublishPosition(&futures.Position{Exchange: "test", Asset: asset.Spot, Pair: btcusd})
+		}, func(e *Event) bool { return e.Position != nil }},
+	} {
+		s, err := b.Subscribe(tc.topic, &SubscribeOptions{Filter: AllFilters(ExchangeFilter("test"), AssetFilter(asset.Spot))})
+		require.NoErrorf(t, err, "Subscribe must not error for %s", tc.topic)
+		require.NoErrorf(t, tc.publish(), "Publishing must not error for %s", tc.topic)
+		e := receive(t, s)
+		assert.Equalf(t, tc.topic, e.Topic, "Topic should be correct for %s", tc.topic)
+		assert.Equalf(t, "test", e.Exchange, "Exchange should be correct for %s", tc.topic)
+		assert.Truef(t, tc.check(e), "Event data should be correct for %s", tc.topic)
+		require.NoError(t, s.Unsubscribe(), "Unsubscribe must not error")
+	}
+	assert.ErrorIs(t, b.PublishTicker(nil), errEventIsNil, "PublishTicker should error on nil")
+	assert.ErrorIs(t, b.PublishOrderbook(nil), errEventIsNil, "PublishOrderbook should error on nil")
+	assert.ErrorIs(t, b.PublishOrder(nil), errEventIsNil, "PublishOrder should error on nil")
+	assert.ErrorIs(t, b.PublishAccountChange(nil), errEventIsNil, "PublishAccountChange should error on nil")
+	assert.ErrorIs(t, b.PublishPosition(nil), errEventIsNil, "PublishPosition should error on nil")
+}
+
+func TestDropPolicies(t *testing.T) {
+	t.Parallel()
+	b := NewBus(nil)
+	newest, err := b.Subscribe(TickerTopic, &SubscribeOptions{BufferSize: 1, DropPolicy: DropNewest})
+	require.NoError(t, err, "Subscribe must not error")
+	oldest, err := b.Subscribe(TickerTopic, &SubscribeOptions{BufferSize: 1, DropPolicy: DropOldest})
+	require.NoError(t, err, "Subscribe must not error")
+	for i := 0; i < 3; i++ {
+		require.NoError(t, b.PublishTicker(&ticker.Price{ExchangeName: "test", Last: float64(i)}), "PublishTicker must not error")
+	}
+	assert.Eventually(t, func() bool {
+		m := newest.Metrics()
+		return m.Delivered+m.Dropped == 3
+	}, time.Second, time.Millisecond, "All events should reach the drop newest subscriber")
+	assert.Eventually(t, func() bool {
+		m := oldest.Metrics()
+		return m.Delivered == 3
+	}, time.Second, time.Millisecond, "All events should be delivered to the drop oldest subscriber")
+
+	m := newest.Metrics()
+	assert.Equal(t, uint64(1), m.Delivered, "Drop newest should only deliver the first event")
+	assert.Equal(t, uint64(2), m.Dropped, "Drop newest should drop incoming events")
+	assert.Equal(t, 1, m.Pending, "Drop newest should have a pending event")
+	m = oldest.Metrics()
+	assert.Equal(t, uint64(2), m.Dropped, "Drop oldest should evict buffered events")
+	assert.Equal(t, 1, m.Capacity, "Capacity should be correct")
+	assert.Positive(t, m.MaxLag, "MaxLag should be recorded")
+	assert.LessOrEqual(t, m.AverageLag, m.MaxLag, "AverageLag should not exceed MaxLag")
+
+	metrics, err := b.GetMetrics()
+	require.NoError(t, err, "GetMetrics must not error")
+	require.Len(t, metrics.Topics, len(Topics), "GetMetrics must return every topic")
+	assert.Equal(t, int64(2), metrics.Topics[0].Subscribers, "Subscribers should be correct")
+	assert.Equal(t, uint64(3), metrics.Topics[0].Published, "Published should be correct")
+	assert.Len(t, metrics.Subscriptions, 2, "GetMetrics should return every subscription")
+	assert.Positive(t, metrics.DispatchQueueCapacity, "DispatchQueueCapacity should be set while dispatch is running")
+
+	require.NoError(t, newest.Unsubscribe(), "Unsubscribe must not error")
+	require.NoError(t, oldest.Unsubscribe(), "Unsubscribe must not error")
+	var nilBus *Bus
+	_, err = nilBus.GetMetrics()
+	assert.ErrorIs(t, err, errBusIsNil, "GetMetrics should error on a nil bus")
+}
+
+func TestDefaultBus(t *testing.T) {
+	t.Parallel()
+	s, err := Subscribe(FillTopic, &SubscribeOptions{Filter: ExchangeFilter("defaultbus")})
+	require.NoError(t, err, "Subscribe must not error")
+	assert.True(t, HasSubscribers(FillTopic), "HasSubscribers should return true")
+	require.NoError(t, PublishFills([]fill.Data{{Exchange: "defaultbus", ID: "1"}}), "PublishFills must not error")
+	assert.Equal(t, "1", receive(t, s).Fill.ID, "Fill should be delivered")
+	require.NoError(t, Publish(&Event{Topic: FillTopic, Exchange: "defaultbus", Fill: &fill.Data{ID: "2"}}), "Publish must not error")
+	assert.Equal(t, "2", receive(t, s).Fill.ID, "Fill should be delivered")
+	metrics, err := GetMetrics()
+	require.NoError(t, err, "GetMetrics must not error")
+	assert.NotEmpty(t, metrics.Subscriptions, "GetMetrics should return the subscription")
+	require.NoError(t, s.Unsubscribe(), "Unsubscribe must not error")
+
+	for _, f := range []func() error{
+		func() error { return PublishTicker(&ticker.Price{}) },
+		func() error { return PublishOrderbook(&orderbook.Base{}) },
+		func() error { return PublishTrades([]trade.Data{{}}) },
+		func() error { return PublishOrder(&order.Detail{}) },
+		func() error { return PublishAccountChange(&account.Change{}) },
+		func() error { return PublishPosition(&futures.Position{}) },
+	} {
+		assert.NoError(t, f(), "Publishing without subscribers should not error")
+	}
+}
+
+func TestFilters(t *testing.T) {
+	t.Parallel()
+	e := &Event{Exchange: "Binance", Asset: asset.Spot, Pair: btcusd}
+	assert.True(t, ExchangeFilter("binance")(e), "ExchangeFilter should match case insensitively")
+	assert.False(t, ExchangeFilter("kraken")(e), "ExchangeFilter should not match another exchange")
+	assert.True(t, AssetFilter(asset.Spot)(e), "AssetFilter should match")
+	assert.False(t, AssetFilter(asset.Futures)(e), "AssetFilter should not match another asset")
+	assert.True(t, PairFilter(btcusd)(e), "PairFilter should match")
+	assert.False(t, PairFilter(currency.NewPair(currency.ETH, currency.USD))(e), "PairFilter should not match another pair")
+	assert.True(t, AllFilters(ExchangeFilter("binance"), nil, PairFilter(btcusd))(e), "AllFilters should match when every filter matches")
+	assert.False(t, AllFilters(ExchangeFilter("binance"), AssetFilter(asset.Futures))(e), "AllFilters should not match when any filter fails")
+}
+
+func TestStringConversions(t *testing.T) {
+	t.Parallel()
+	for i := range Topics {
+		topic, err := StringToTopic(Topics[i].String())
+		require.NoError(t, err, "StringToTopic must not error")
+		assert.Equal(t, Topics[i], topic, "StringToTopic should return the topic")
+	}
+	_, err := StringToTopic("kline")
+	assert.ErrorIs(t, err, ErrUnknownTopic, "StringToTopic should error on an unknown topic")
+	assert.Equal(t, "unknown", UnknownTopic.String(), "String should return unknown")
+
+	for s, expected := range map[string]DropPolicy{"": DropNewest, "DropNewest": DropNewest, "dropoldest": DropOldest} {
+		p, err := StringToDropPolicy(s)
+		require.NoError(t, err, "StringToDropPolicy must not error")
+		assert.Equal(t, expected, p, "StringToDropPolicy should return the policy")
+	}
+	_, err = StringToDropPolicy("block")
+	assert.ErrorIs(t, err, errUnknownDropPolicy, "StringToDropPolicy should error on an unknown policy")
+	assert.Equal(t, "unknown", DropPolicy(255).String(), "String should return unknown")
+}
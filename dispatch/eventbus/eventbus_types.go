@@ -0,0 +1,180 @@
+package eventbus
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// DefaultBufferSize is the number of events buffered for a subscriber when
+// a buffer size is not specified
+const DefaultBufferSize = 1000
+
+var (
+	// ErrUnknownTopic is returned when a topic is not supported by the bus
+	ErrUnknownTopic = errors.New("unknown event bus topic")
+
+	errBusIsNil            = errors.New("event bus is nil")
+	errSubscriptionIsNil   = errors.New("event bus subscription is nil")
+	errEventIsNil          = errors.New("event is nil")
+	errEventDataMismatch   = errors.New("event data does not match its topic")
+	errUnknownDropPolicy   = errors.New("unknown drop policy")
+	errInvalidBufferSize   = errors.New("buffer size cannot be negative")
+	errUnexpectedEventType = errors.New("unexpected event type received from dispatch")
+)
+
+// bus is the default event bus shared by producers and consumers
+var bus *Bus
+
+// Topic is a category of event published on the bus
+type Topic uint8
+
+// Supported topics
+const (
+	UnknownTopic Topic = iota
+	TickerTopic
+	OrderbookTopic
+	TradeTopic
+	FillTopic
+	OrderTopic
+	AccountTopic
+	PositionTopic
+)
+
+// Topics lists every topic supported by the bus
+var Topics = []Topic{TickerTopic, OrderbookTopic, TradeTopic, FillTopic, OrderTopic, AccountTopic, PositionTopic}
+
+// DropPolicy determines which event is discarded when a subscriber's buffer
+// is full
+type DropPolicy uint8
+
+// Drop policies
+const (
+	// DropNewest discards incoming events until the subscriber frees space
+	DropNewest DropPolicy = iota
+	// DropOldest evicts the oldest buffered event to make room for the
+	// incoming event
+	DropOldest
+)
+
+// Filter returns whether an event should be delivered to a subscriber
+type Filter func(*Event) bool
+
+// Event is a single update published on the bus. Only the field matching the
+// topic is set and all fields must be treated as read only as the event is
+// shared between subscribers
+type Event struct {
+	Topic     Topic
+	Exchange  string
+	Asset     asset.Item
+	Pair      currency.Pair
+	Published time.Time
+
+	Ticker    *ticker.Price
+	Orderbook *orderbook.Base
+	Trade     *trade.Data
+	Fill      *fill.Data
+	Order     *order.Detail
+	Account   *account.Change
+	Position  *futures.Position
+}
+
+// SubscribeOptions configures a subscription
+type SubscribeOptions struct {
+	// BufferSize is the number of events held for the subscriber, it defaults
+	// to DefaultBufferSize when zero
+	BufferSize int
+	DropPolicy DropPolicy
+	// Filter is optional, all events on the topic are delivered when nil
+	Filter Filter
+}
+
+// Bus is a typed publish/subscribe event bus which routes events through
+// the dispatch system. Each topic is a dispatch route and every subscriber
+// has its own bounded buffer so a slow consumer cannot stall producers or
+// other subscribers. Dispatch workers relay events concurrently so ordering
+// is not guaranteed, consumers should rely on event timestamps
+type Bus struct {
+	mux    *dispatch.Mux
+	topics map[Topic]*topic
+	m      sync.Mutex
+	subs   map[*Subscription]struct{}
+}
+
+// topic holds the dispatch route and publish counters of a topic
+type topic struct {
+	id          uuid.UUID
+	published   uint64
+	failed      uint64
+	subscribers int64
+}
+
+// Subscription receives the events published on a topic which pass its
+// filter
+type Subscription struct {
+	bus      *Bus
+	topic    Topic
+	filter   Filter
+	policy   DropPolicy
+	pipe     dispatch.Pipe
+	c        chan *Event
+	shutdown chan struct{}
+	once     sync.Once
+	wg       sync.WaitGroup
+
+	delivered uint64
+	dropped   uint64
+	filtered  uint64
+
+	lagMtx   sync.Mutex
+	lastLag  time.Duration
+	maxLag   time.Duration
+	totalLag time.Duration
+}
+
+// TopicMetrics are the publish counters of a topic
+type TopicMetrics struct {
+	Topic       Topic
+	Subscribers int64
+	Published   uint64
+	// PublishFailures counts events which could not be queued by the
+	// dispatch system
+	PublishFailures uint64
+}
+
+// SubscriptionMetrics measure how well a subscriber keeps up with its topic.
+// Lag is the time between an event being published and it being buffered
+// for the subscriber, Pending is the number of buffered events not yet read
+type SubscriptionMetrics struct {
+	Topic      Topic
+	DropPolicy DropPolicy
+	Delivered  uint64
+	Dropped    uint64
+	Filtered   uint64
+	Pending    int
+	Capacity   int
+	LastLag    time.Duration
+	MaxLag     time.Duration
+	AverageLag time.Duration
+}
+
+// Metrics are the counters of every topic and subscription on the bus along
+// with the depth of the dispatch job queue shared by all topics
+type Metrics struct {
+	Topics                []TopicMetrics
+	Subscriptions         []SubscriptionMetrics
+	DispatchQueueDepth    int
+	DispatchQueueCapacity int
+}
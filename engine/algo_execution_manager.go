@@ -0,0 +1,706 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// amountTolerance is the proportion of a parent order amount which is
+// considered negligible when comparing submitted and filled amounts
+const amountTolerance = 1e-8
+
+// SetupAlgoExecutionManager applies configuration parameters before running
+func SetupAlgoExecutionManager(exchangeManager iExchangeManager, orderManager iAlgoOrderManager, cfg *config.AlgoExecutionManager) (*AlgoExecutionManager, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", AlgoExecutionManagerName, errNilConfig)
+	}
+	checkInterval := cfg.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = config.DefaultAlgoExecutionCheckInterval
+	}
+	return &AlgoExecutionManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		orders:          make(map[string]*ParentOrder),
+		checkInterval:   checkInterval,
+		verbose:         cfg.Verbose,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *AlgoExecutionManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *AlgoExecutionManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Algo execution manager %s", MsgSubSystemStarting)
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.OrderMgr, "Algo execution manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem. Resting child orders are left on
+// the exchange and parent orders resume processing when the subsystem is
+// started again
+func (m *AlgoExecutionManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Algo execution manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	atomic.StoreInt32(&m.started, 0)
+	log.Debugf(log.OrderMgr, "Algo execution manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run checks parent order schedules and child order fills every check
+// interval
+func (m *AlgoExecutionManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func(shutdown <-chan struct{}) {
+		<-shutdown
+		cancel()
+	}(m.shutdown)
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.processOrders(ctx, time.Now())
+		}
+	}
+}
+
+// Create validates a parent order, records its arrival price and builds its
+// execution schedule. The first child order is submitted immediately when the
+// execution window has started. Returns the parent order ID
+func (m *AlgoExecutionManager) Create(ctx context.Context, p *ParentOrder) (string, error) {
+	if !m.IsRunning() {
+		return "", fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	parent, err := m.prepare(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	m.m.Lock()
+	m.orders[parent.ID] = parent
+	log.Infof(log.OrderMgr, "Algo execution manager created %s %s parent order %s for %v %s %s %s arrival price %v",
+		parent.Strategy, parent.Side, parent.ID, parent.Amount, parent.Exchange, parent.Asset, parent.Pair, parent.ArrivalPrice)
+	a := m.processOrder(parent, time.Now())
+	m.m.Unlock()
+	if a != nil {
+		m.execute(ctx, a)
+		m.m.Lock()
+		m.apply(a, time.Now())
+		m.m.Unlock()
+	}
+	return parent.ID, nil
+}
+
+// Pause stops new child orders being submitted for a parent order. Resting
+// child orders remain on the exchange and their fills continue to be tracked
+func (m *AlgoExecutionManager) Pause(id string) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	p, ok := m.orders[id]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrParentOrderNotFound, id)
+	}
+	if p.Status != ParentOrderActive {
+		return fmt.Errorf("%w %s status %s", errParentOrderNotActive, id, p.Status)
+	}
+	p.Status = ParentOrderPaused
+	p.UpdatedDate = time.Now()
+	return nil
+}
+
+// Resume continues a paused parent order. TWAP and VWAP orders catch up to
+// their schedule on the next check
+func (m *AlgoExecutionManager) Resume(id string) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	p, ok := m.orders[id]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrParentOrderNotFound, id)
+	}
+	if p.Status != ParentOrderPaused {
+		return fmt.Errorf("%w %s status %s", errParentOrderNotPaused, id, p.Status)
+	}
+	p.Status = ParentOrderActive
+	p.UpdatedDate = time.Now()
+	return nil
+}
+
+// Cancel stops a parent order and cancels any of its resting child orders
+func (m *AlgoExecutionManager) Cancel(ctx context.Context, id string) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	p, ok := m.orders[id]
+	if !ok {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s", ErrParentOrderNotFound, id)
+	}
+	if p.Status != ParentOrderActive && p.Status != ParentOrderPaused {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s status %s", errParentOrderNotActive, id, p.Status)
+	}
+	if p.pending {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s", errParentOrderBusy, id)
+	}
+	a := &algoAction{parent: p, cancels: p.openChildren()}
+	p.pending = true
+	m.m.Unlock()
+
+	m.execute(ctx, a)
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	var errs error
+	for i, idx := range a.cancels {
+		if a.cancelErrs[i] != nil {
+			errs = common.AppendError(errs, fmt.Errorf("child order %s: %w", p.Children[idx].OrderID, a.cancelErrs[i]))
+			continue
+		}
+		p.Children[idx].Status = order.Cancelled
+	}
+	p.pending = false
+	p.Status = ParentOrderCancelled
+	p.Reason = "cancelled by user"
+	p.UpdatedDate = time.Now()
+	return errs
+}
+
+// GetOrder returns a copy of a parent order by its ID
+func (m *AlgoExecutionManager) GetOrder(id string) (*ParentOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrNilSubsystem)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	p, ok := m.orders[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrParentOrderNotFound, id)
+	}
+	return p.copy(), nil
+}
+
+// GetOrders returns copies of all parent orders ordered by creation date
+func (m *AlgoExecutionManager) GetOrders() ([]ParentOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrNilSubsystem)
+	}
+	m.m.Lock()
+	resp := make([]ParentOrder, 0, len(m.orders))
+	for _, p := range m.orders {
+		resp = append(resp, *p.copy())
+	}
+	m.m.Unlock()
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CreatedDate.Before(resp[j].CreatedDate)
+	})
+	return resp, nil
+}
+
+// processOrders updates fills and submits due child orders for all active
+// and paused parent orders. Orders are submitted and cancelled without the
+// lock held
+func (m *AlgoExecutionManager) processOrders(ctx context.Context, now time.Time) {
+	m.m.Lock()
+	var actions []*algoAction
+	for _, p := range m.orders {
+		if a := m.processOrder(p, now); a != nil {
+			actions = append(actions, a)
+		}
+	}
+	m.m.Unlock()
+	if len(actions) == 0 {
+		return
+	}
+	for i := range actions {
+		m.execute(ctx, actions[i])
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range actions {
+		m.apply(actions[i], now)
+	}
+}
+
+// processOrder updates the fills of a parent order's child orders and returns
+// the child order to submit when one is due, or the child orders to cancel
+// when a TWAP or VWAP execution window has ended. The parent order is marked
+// pending until the action is applied. Must be called with the lock held
+func (m *AlgoExecutionManager) processOrder(p *ParentOrder, now time.Time) *algoAction {
+	if p.pending || (p.Status != ParentOrderActive && p.Status != ParentOrderPaused) {
+		return nil
+	}
+	m.updateFills(p)
+	if p.Amount-p.FilledAmount <= p.Amount*amountTolerance {
+		p.Status = ParentOrderComplete
+		p.UpdatedDate = now
+		log.Infof(log.OrderMgr, "Algo execution manager parent order %s complete, filled %v at average price %v slippage %.2f bps",
+			p.ID, p.FilledAmount, p.AverageFillPrice, p.SlippageBasisPoints)
+		return nil
+	}
+	if (p.Strategy == TWAP || p.Strategy == VWAP) && now.After(p.EndTime) {
+		a := &algoAction{parent: p, cancels: p.openChildren(), expire: true}
+		if len(a.cancels) == 0 {
+			m.apply(a, now)
+			return nil
+		}
+		p.pending = true
+		return a
+	}
+	if p.Status != ParentOrderActive {
+		return nil
+	}
+
+	var amount float64
+	switch p.Strategy {
+	case TWAP, VWAP:
+		amount = p.scheduledAmount(now) - p.committedAmount()
+	case Iceberg:
+		if p.hasOpenChild() {
+			return nil
+		}
+		amount = math.Min(p.VisibleAmount, p.Amount-p.FilledAmount)
+	}
+	if amount <= p.Amount*amountTolerance {
+		return nil
+	}
+	s := &order.Submit{
+		Exchange:  p.Exchange,
+		Type:      order.Market,
+		Side:      p.Side,
+		Pair:      p.Pair,
+		AssetType: p.Asset,
+		Amount:    amount,
+	}
+	if p.LimitPrice > 0 {
+		s.Type = order.Limit
+		s.Price = p.LimitPrice
+	}
+	p.pending = true
+	return &algoAction{parent: p, submit: s}
+}
+
+// execute submits or cancels the child orders of an action through the order
+// manager. Must be called without the lock held
+func (m *AlgoExecutionManager) execute(ctx context.Context, a *algoAction) {
+	if a.submit != nil {
+		a.submitResp, a.submitErr = m.orderManager.Submit(ctx, a.submit)
+	}
+	a.cancelErrs = make([]error, len(a.cancels))
+	for i, idx := range a.cancels {
+		a.cancelErrs[i] = m.orderManager.Cancel(ctx, &order.Cancel{
+			Exchange:  a.parent.Exchange,
+			OrderID:   a.parent.Children[idx].OrderID,
+			Side:      a.parent.Side,
+			Pair:      a.parent.Pair,
+			AssetType: a.parent.Asset,
+		})
+	}
+}
+
+// apply records the result of an action against its parent order. Must be
+// called with the lock held
+func (m *AlgoExecutionManager) apply(a *algoAction, now time.Time) {
+	p := a.parent
+	p.pending = false
+	p.UpdatedDate = now
+	if a.submit != nil {
+		m.recordChild(p, a.submit, a.submitResp, a.submitErr, now)
+	}
+	var cancelErrs error
+	for i, idx := range a.cancels {
+		if a.cancelErrs[i] != nil {
+			cancelErrs = common.AppendError(cancelErrs, fmt.Errorf("child order %s: %w", p.Children[idx].OrderID, a.cancelErrs[i]))
+			continue
+		}
+		p.Children[idx].Status = order.Cancelled
+	}
+	if !a.expire {
+		return
+	}
+	p.Status = ParentOrderExpired
+	p.Reason = fmt.Sprintf("execution window ended with %v unfilled", p.Amount-p.FilledAmount)
+	if cancelErrs != nil {
+		p.Reason += fmt.Sprintf(", unable to cancel %v", cancelErrs)
+		log.Errorf(log.OrderMgr, "Algo execution manager failed to cancel child orders for expired parent order %s: %v", p.ID, cancelErrs)
+	}
+	log.Infof(log.OrderMgr, "Algo execution manager parent order %s expired, filled %v of %v at average price %v slippage %.2f bps",
+		p.ID, p.FilledAmount, p.Amount, p.AverageFillPrice, p.SlippageBasisPoints)
+}
+
+// recordChild adds a submitted child order to its parent order, failing the
+// parent order if the submission is rejected or the exchange does not return
+// an order ID to track its fills by
+func (m *AlgoExecutionManager) recordChild(p *ParentOrder, s *order.Submit, resp *OrderSubmitResponse, err error, now time.Time) {
+	if err != nil {
+		p.Status = ParentOrderFailed
+		p.Reason = err.Error()
+		log.Errorf(log.OrderMgr, "Algo execution manager failed to submit child order for parent order %s: %v", p.ID, err)
+		return
+	}
+	child := ChildOrder{
+		Amount:        s.Amount,
+		SubmittedDate: now,
+	}
+	if resp != nil && resp.Detail != nil {
+		child.OrderID = resp.OrderID
+		child.Status = resp.Status
+		child.FilledAmount, child.AveragePrice = filledFromDetail(resp.Detail)
+	}
+	if child.OrderID == "" {
+		child.Status = order.Rejected
+		p.Children = append(p.Children, child)
+		p.summarise()
+		p.Status = ParentOrderFailed
+		p.Reason = errChildOrderIDMissing.Error()
+		log.Errorf(log.OrderMgr, "Algo execution manager parent order %s: %v", p.ID, errChildOrderIDMissing)
+		return
+	}
+	p.Children = append(p.Children, child)
+	p.summarise()
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Algo execution manager submitted %s %s child order %s for %v, parent order %s",
+			s.Side, s.Type, child.OrderID, s.Amount, p.ID)
+	}
+}
+
+// updateFills refreshes the fills of open child orders from the order manager
+func (m *AlgoExecutionManager) updateFills(p *ParentOrder) {
+	for i := range p.Children {
+		if !p.Children[i].isOpen() {
+			continue
+		}
+		d, err := m.orderManager.GetByExchangeAndID(p.Exchange, p.Children[i].OrderID)
+		if err != nil {
+			if m.verbose {
+				log.Warnf(log.OrderMgr, "Algo execution manager unable to retrieve child order %s for parent order %s: %v",
+					p.Children[i].OrderID, p.ID, err)
+			}
+			continue
+		}
+		p.Children[i].FilledAmount, p.Children[i].AveragePrice = filledFromDetail(d)
+		if d.Status != order.UnknownStatus {
+			p.Children[i].Status = d.Status
+		}
+	}
+	p.summarise()
+}
+
+// prepare validates a parent order and returns a copy ready to be stored
+func (m *AlgoExecutionManager) prepare(ctx context.Context, p *ParentOrder) (*ParentOrder, error) {
+	if p == nil {
+		return nil, errNilParentOrder
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(p.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	if p.Pair.IsEmpty() {
+		return nil, order.ErrPairIsEmpty
+	}
+	if !p.Asset.IsValid() {
+		return nil, fmt.Errorf("'%s' %w", p.Asset, asset.ErrNotSupported)
+	}
+	if !order.IsValidOrderSubmissionSide(p.Side) {
+		return nil, fmt.Errorf("%w %v", order.ErrSideIsInvalid, p.Side)
+	}
+	if p.Amount <= 0 {
+		return nil, fmt.Errorf("%w %v", order.ErrAmountIsInvalid, p.Amount)
+	}
+	if p.LimitPrice < 0 {
+		return nil, fmt.Errorf("%w %v", order.ErrPriceBelowMin, p.LimitPrice)
+	}
+
+	now := time.Now()
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	parent := p.copy()
+	parent.ID = id.String()
+	parent.Exchange = exch.GetName()
+	parent.Status = ParentOrderActive
+	parent.Reason = ""
+	parent.Children = nil
+	parent.CreatedDate = now
+	parent.UpdatedDate = now
+	if parent.StartTime.IsZero() {
+		parent.StartTime = now
+	}
+
+	switch parent.Strategy {
+	case TWAP, VWAP:
+		if parent.Slices <= 0 {
+			return nil, errInvalidSliceCount
+		}
+		if !parent.EndTime.After(parent.StartTime) {
+			return nil, common.ErrStartAfterEnd
+		}
+		if !parent.EndTime.After(now) {
+			return nil, errExecutionWindowElapsed
+		}
+		weights := make([]float64, parent.Slices)
+		for i := range weights {
+			weights[i] = 1
+		}
+		if parent.Strategy == VWAP {
+			weights, err = m.volumeWeights(ctx, parent)
+			if err != nil {
+				return nil, err
+			}
+		}
+		parent.schedule = buildSchedule(parent, weights)
+	case Iceberg:
+		if parent.VisibleAmount <= 0 || parent.VisibleAmount > parent.Amount {
+			return nil, errVisibleAmountInvalid
+		}
+		if parent.LimitPrice <= 0 {
+			return nil, errIcebergLimitPrice
+		}
+	default:
+		return nil, fmt.Errorf("%w %q", errExecutionStrategyInvalid, parent.Strategy)
+	}
+
+	tick, err := exch.FetchTicker(ctx, parent.Pair, parent.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errNoArrivalPrice, err)
+	}
+	switch {
+	case tick.Bid > 0 && tick.Ask > 0:
+		parent.ArrivalPrice = (tick.Bid + tick.Ask) / 2
+	case tick.Last > 0:
+		parent.ArrivalPrice = tick.Last
+	default:
+		return nil, fmt.Errorf("%w for %s %s %s", errNoArrivalPrice, parent.Exchange, parent.Asset, parent.Pair)
+	}
+	return parent, nil
+}
+
+// volumeWeights returns the proportion of historic volume traded in each
+// slice of a VWAP parent order's execution window
+func (m *AlgoExecutionManager) volumeWeights(ctx context.Context, p *ParentOrder) ([]float64, error) {
+	if p.EndTime.Sub(p.StartTime) > maxVWAPDuration {
+		return nil, errVWAPDurationTooLong
+	}
+	if p.VolumeInterval <= 0 {
+		p.VolumeInterval = defaultVWAPInterval
+	}
+	if p.VolumeLookback <= 0 {
+		p.VolumeLookback = defaultVWAPLookback
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(p.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	end := time.Now().Truncate(p.VolumeInterval.Duration())
+	candles, err := exch.GetHistoricCandlesExtended(ctx, p.Pair, p.Asset, p.VolumeInterval, end.Add(-p.VolumeLookback), end)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build VWAP volume profile: %w", err)
+	}
+	return volumeProfile(candles, p.StartTime, p.EndTime.Sub(p.StartTime)/time.Duration(p.Slices), int(p.Slices)), nil
+}
+
+// volumeProfile buckets historic candle volume by time of day into each slice
+// of an execution window. An even profile is returned when there is no volume
+func volumeProfile(item *kline.Item, start time.Time, sliceDuration time.Duration, slices int) []float64 {
+	weights := make([]float64, slices)
+	window := sliceDuration * time.Duration(slices)
+	var total float64
+	if item != nil && sliceDuration > 0 {
+		day := time.Hour * 24
+		for i := range item.Candles {
+			offset := item.Candles[i].Time.Sub(start) % day
+			if offset < 0 {
+				offset += day
+			}
+			if offset >= window {
+				continue
+			}
+			weights[offset/sliceDuration] += item.Candles[i].Volume
+			total += item.Candles[i].Volume
+		}
+	}
+	if total <= 0 {
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+	return weights
+}
+
+// buildSchedule returns the cumulative amount of a parent order to be
+// submitted at the start of each slice of its execution window
+func buildSchedule(p *ParentOrder, weights []float64) []executionSlice {
+	var total float64
+	for i := range weights {
+		total += weights[i]
+	}
+	sliceDuration := p.EndTime.Sub(p.StartTime) / time.Duration(len(weights))
+	schedule := make([]executionSlice, len(weights))
+	var cumulative float64
+	for i := range weights {
+		cumulative += weights[i]
+		schedule[i] = executionSlice{
+			Time:   p.StartTime.Add(sliceDuration * time.Duration(i)),
+			Amount: p.Amount * cumulative / total,
+		}
+	}
+	schedule[len(schedule)-1].Amount = p.Amount
+	return schedule
+}
+
+// filledFromDetail returns the filled amount and average fill price of an
+// order from its trades, falling back to the executed amount reported by the
+// exchange when trades are unavailable
+func filledFromDetail(d *order.Detail) (amount, averagePrice float64) {
+	if len(d.Trades) == 0 {
+		return d.ExecutedAmount, d.AverageExecutedPrice
+	}
+	var notional float64
+	for i := range d.Trades {
+		amount += d.Trades[i].Amount
+		notional += d.Trades[i].Amount * d.Trades[i].Price
+	}
+	if amount > 0 {
+		averagePrice = notional / amount
+	}
+	return amount, averagePrice
+}
+
+// scheduledAmount returns the cumulative amount which should have been
+// submitted by the supplied time
+func (p *ParentOrder) scheduledAmount(now time.Time) float64 {
+	var amount float64
+	for i := range p.schedule {
+		if p.schedule[i].Time.After(now) {
+			break
+		}
+		amount = p.schedule[i].Amount
+	}
+	return amount
+}
+
+// committedAmount returns the amount resting in open child orders along with
+// the amount filled by closed child orders
+func (p *ParentOrder) committedAmount() float64 {
+	var amount float64
+	for i := range p.Children {
+		if p.Children[i].isOpen() {
+			amount += math.Max(p.Children[i].Amount, p.Children[i].FilledAmount)
+			continue
+		}
+		amount += p.Children[i].FilledAmount
+	}
+	return amount
+}
+
+// openChildren returns the indexes of child orders resting on the exchange
+func (p *ParentOrder) openChildren() []int {
+	var open []int
+	for i := range p.Children {
+		if p.Children[i].isOpen() {
+			open = append(open, i)
+		}
+	}
+	return open
+}
+
+// hasOpenChild returns whether any child order is resting on the exchange
+func (p *ParentOrder) hasOpenChild() bool {
+	for i := range p.Children {
+		if p.Children[i].isOpen() {
+			return true
+		}
+	}
+	return false
+}
+
+// summarise calculates the filled amount, average fill price and slippage
+// against the arrival price across all child orders
+func (p *ParentOrder) summarise() {
+	var filled, notional float64
+	for i := range p.Children {
+		filled += p.Children[i].FilledAmount
+		notional += p.Children[i].FilledAmount * p.Children[i].AveragePrice
+	}
+	p.FilledAmount = filled
+	p.AverageFillPrice = 0
+	p.SlippageBasisPoints = 0
+	p.SlippageCost = 0
+	if filled <= 0 {
+		return
+	}
+	p.AverageFillPrice = notional / filled
+	if p.ArrivalPrice <= 0 {
+		return
+	}
+	diff := p.AverageFillPrice - p.ArrivalPrice
+	if p.Side.IsShort() {
+		diff = -diff
+	}
+	p.SlippageBasisPoints = diff / p.ArrivalPrice * 10000
+	p.SlippageCost = diff * filled
+}
+
+// copy returns a deep copy of a parent order
+func (p *ParentOrder) copy() *ParentOrder {
+	cpy := *p
+	cpy.Children = append([]ChildOrder(nil), p.Children...)
+	cpy.schedule = append([]executionSlice(nil), p.schedule...)
+	return &cpy
+}
+
+// isOpen returns whether the child order may still receive fills. Child
+// orders without an order ID cannot be tracked and are never open
+func (c *ChildOrder) isOpen() bool {
+	return c.OrderID != "" && (c.Status == order.UnknownStatus || !c.Status.IsInactive())
+}
@@ -0,0 +1,589 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var errFakeCandles = errors.New("fake candle failure")
+
+// fakeAlgoOrderManager stores submitted child orders in memory so tests can
+// control their fills
+type fakeAlgoOrderManager struct {
+	m         sync.Mutex
+	submitted []order.Submit
+	orders    map[string]*order.Detail
+	cancelled []string
+	fail      bool
+	// fillPrice fills submitted orders immediately when set
+	fillPrice float64
+	// noOrderID omits the order ID from submission responses
+	noOrderID bool
+	// onSubmit is called with the submission context before an order is
+	// submitted
+	onSubmit func(context.Context)
+}
+
+func (f *fakeAlgoOrderManager) IsRunning() bool {
+	return true
+}
+
+func (f *fakeAlgoOrderManager) Submit(ctx context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	if f.onSubmit != nil {
+		f.onSubmit(ctx)
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.fail {
+		return nil, errFakeSubmit
+	}
+	f.submitted = append(f.submitted, *s)
+	d := &order.Detail{
+		Exchange:  s.Exchange,
+		OrderID:   strconv.Itoa(len(f.submitted)),
+		Type:      s.Type,
+		Side:      s.Side,
+		Pair:      s.Pair,
+		AssetType: s.AssetType,
+		Amount:    s.Amount,
+		Price:     s.Price,
+		Status:    order.New,
+	}
+	if f.fillPrice > 0 {
+		d.Trades = []order.TradeHistory{{Amount: s.Amount, Price: f.fillPrice}}
+		d.Status = order.Filled
+	}
+	f.orders[d.OrderID] = d
+	cpy := d.Copy()
+	if f.noOrderID {
+		cpy.OrderID = ""
+	}
+	return &OrderSubmitResponse{Detail: &cpy}, nil
+}
+
+func (f *fakeAlgoOrderManager) Cancel(_ context.Context, c *order.Cancel) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	d, ok := f.orders[c.OrderID]
+	if !ok {
+		return ErrOrderNotFound
+	}
+	d.Status = order.Cancelled
+	f.cancelled = append(f.cancelled, c.OrderID)
+	return nil
+}
+
+func (f *fakeAlgoOrderManager) GetByExchangeAndID(_, id string) (*order.Detail, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	d, ok := f.orders[id]
+	if !ok {
+		return nil, ErrOrderNotFound
+	}
+	cpy := d.Copy()
+	return &cpy, nil
+}
+
+// fill adds a trade to a child order and sets its status
+func (f *fakeAlgoOrderManager) fill(id string, amount, price float64, status order.Status) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	d := f.orders[id]
+	d.Trades = append(d.Trades, order.TradeHistory{Amount: amount, Price: price})
+	d.Status = status
+}
+
+func (f *fakeAlgoOrderManager) getSubmitted() []order.Submit {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]order.Submit(nil), f.submitted...)
+}
+
+// algoTestExchange overrides the market data used to determine arrival prices
+// and VWAP volume profiles
+type algoTestExchange struct {
+	exchange.IBotExchange
+	tick    *ticker.Price
+	candles *kline.Item
+}
+
+func (a *algoTestExchange) FetchTicker(context.Context, currency.Pair, asset.Item) (*ticker.Price, error) {
+	if a.tick == nil {
+		return nil, ticker.ErrNoTickerFound
+	}
+	return a.tick, nil
+}
+
+func (a *algoTestExchange) GetHistoricCandlesExtended(context.Context, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (*kline.Item, error) {
+	if a.candles == nil {
+		return nil, errFakeCandles
+	}
+	return a.candles, nil
+}
+
+func setupAlgoExecutionTest(t *testing.T) (*AlgoExecutionManager, *fakeAlgoOrderManager, *algoTestExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	wrapped := &algoTestExchange{
+		IBotExchange: exch,
+		tick:         &ticker.Price{Bid: 99.5, Ask: 100.5, Last: 90},
+	}
+	require.NoError(t, em.Add(wrapped), "Add must not error")
+	om := &fakeAlgoOrderManager{orders: make(map[string]*order.Detail)}
+	m, err := SetupAlgoExecutionManager(em, om, &config.AlgoExecutionManager{CheckInterval: time.Hour})
+	require.NoError(t, err, "SetupAlgoExecutionManager must not error")
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() {
+		assert.NoError(t, m.Stop(), "Stop should not error")
+	})
+	return m, om, wrapped
+}
+
+func algoTestOrder(strategy ExecutionStrategy) *ParentOrder {
+	now := time.Now()
+	return &ParentOrder{
+		Exchange:      testExchange,
+		Asset:         asset.Spot,
+		Pair:          btcusdPair,
+		Side:          order.Buy,
+		Strategy:      strategy,
+		Amount:        4,
+		StartTime:     now,
+		EndTime:       now.Add(time.Hour),
+		Slices:        4,
+		VisibleAmount: 1,
+	}
+}
+
+func TestSetupAlgoExecutionManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupAlgoExecutionManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupAlgoExecutionManager should error on nil exchange manager")
+	_, err = SetupAlgoExecutionManager(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager, "SetupAlgoExecutionManager should error on nil order manager")
+	_, err = SetupAlgoExecutionManager(NewExchangeManager(), &fakeAlgoOrderManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig, "SetupAlgoExecutionManager should error on nil config")
+	m, err := SetupAlgoExecutionManager(NewExchangeManager(), &fakeAlgoOrderManager{}, &config.AlgoExecutionManager{})
+	require.NoError(t, err, "SetupAlgoExecutionManager must not error")
+	assert.Equal(t, config.DefaultAlgoExecutionCheckInterval, m.checkInterval, "checkInterval should be set to the default")
+}
+
+func TestAlgoExecutionManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *AlgoExecutionManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem, "Start should error on nil subsystem")
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem, "Stop should error on nil subsystem")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+
+	m, err := SetupAlgoExecutionManager(NewExchangeManager(), &fakeAlgoOrderManager{}, &config.AlgoExecutionManager{})
+	require.NoError(t, err, "SetupAlgoExecutionManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted, "Stop should error when not started")
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted, "Start should error when already started")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+	require.NoError(t, m.Start(), "Start must not error after being stopped")
+	require.NoError(t, m.Stop(), "Stop must not error")
+}
+
+func TestAlgoExecutionManagerCreate(t *testing.T) {
+	t.Parallel()
+	m, err := SetupAlgoExecutionManager(NewExchangeManager(), &fakeAlgoOrderManager{}, &config.AlgoExecutionManager{})
+	require.NoError(t, err, "SetupAlgoExecutionManager must not error")
+	_, err = m.Create(context.Background(), algoTestOrder(TWAP))
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "Create should error when not started")
+
+	m, _, exch := setupAlgoExecutionTest(t)
+	_, err = m.Create(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilParentOrder, "Create should error on nil parent order")
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*ParentOrder)
+		err    error
+	}{
+		{"exchange", func(p *ParentOrder) { p.Exchange = "bruh" }, ErrExchangeNotFound},
+		{"pair", func(p *ParentOrder) { p.Pair = currency.EMPTYPAIR }, order.ErrPairIsEmpty},
+		{"asset", func(p *ParentOrder) { p.Asset = asset.Empty }, asset.ErrNotSupported},
+		{"side", func(p *ParentOrder) { p.Side = order.AnySide }, order.ErrSideIsInvalid},
+		{"amount", func(p *ParentOrder) { p.Amount = 0 }, order.ErrAmountIsInvalid},
+		{"price", func(p *ParentOrder) { p.LimitPrice = -1 }, order.ErrPriceBelowMin},
+		{"strategy", func(p *ParentOrder) { p.Strategy = "POV" }, errExecutionStrategyInvalid},
+		{"slices", func(p *ParentOrder) { p.Slices = 0 }, errInvalidSliceCount},
+		{"window", func(p *ParentOrder) { p.EndTime = p.StartTime }, common.ErrStartAfterEnd},
+		{"elapsed", func(p *ParentOrder) {
+			p.StartTime = p.StartTime.Add(-time.Hour * 2)
+			p.EndTime = p.EndTime.Add(-time.Hour * 2)
+		}, errExecutionWindowElapsed},
+		{"vwap window", func(p *ParentOrder) {
+			p.Strategy = VWAP
+			p.EndTime = p.StartTime.Add(time.Hour * 25)
+		}, errVWAPDurationTooLong},
+		{"vwap candles", func(p *ParentOrder) { p.Strategy = VWAP }, errFakeCandles},
+		{"iceberg visible", func(p *ParentOrder) {
+			p.Strategy = Iceberg
+			p.VisibleAmount = 5
+		}, errVisibleAmountInvalid},
+		{"iceberg price", func(p *ParentOrder) { p.Strategy = Iceberg }, errIcebergLimitPrice},
+	} {
+		p := algoTestOrder(TWAP)
+		tc.modify(p)
+		_, err = m.Create(context.Background(), p)
+		assert.ErrorIsf(t, err, tc.err, "Create should error on invalid %s", tc.name)
+	}
+
+	exch.tick = &ticker.Price{}
+	_, err = m.Create(context.Background(), algoTestOrder(TWAP))
+	assert.ErrorIs(t, err, errNoArrivalPrice, "Create should error without an arrival price")
+	exch.tick = &ticker.Price{Last: 90}
+	id, err := m.Create(context.Background(), algoTestOrder(TWAP))
+	require.NoError(t, err, "Create must not error")
+	p, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, 90.0, p.ArrivalPrice, "ArrivalPrice should fall back to the last price")
+}
+
+func TestAlgoExecutionManagerTWAP(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupAlgoExecutionTest(t)
+	p := algoTestOrder(TWAP)
+	id, err := m.Create(context.Background(), p)
+	require.NoError(t, err, "Create must not error")
+
+	submitted := om.getSubmitted()
+	require.Len(t, submitted, 1, "first slice must be submitted immediately")
+	assert.Equal(t, 1.0, submitted[0].Amount, "first slice amount should be correct")
+	assert.Equal(t, order.Market, submitted[0].Type, "child order should be a market order without a limit price")
+
+	m.processOrders(context.Background(), p.StartTime.Add(time.Minute))
+	assert.Len(t, om.getSubmitted(), 1, "no further slices should be submitted before they are due")
+
+	om.fill("1", 1, 101, order.Filled)
+	m.processOrders(context.Background(), p.StartTime.Add(time.Minute*16))
+	submitted = om.getSubmitted()
+	require.Len(t, submitted, 2, "second slice must be submitted when due")
+	assert.Equal(t, 1.0, submitted[1].Amount, "second slice amount should be correct")
+
+	om.fill("2", 1, 101, order.Filled)
+	m.processOrders(context.Background(), p.EndTime)
+	submitted = om.getSubmitted()
+	require.Len(t, submitted, 3, "remaining slices must be submitted together when behind schedule")
+	assert.Equal(t, 2.0, submitted[2].Amount, "catch up slice amount should cover remaining schedule")
+
+	om.fill("3", 2, 101, order.Filled)
+	m.processOrders(context.Background(), p.EndTime)
+	resp, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ParentOrderComplete, resp.Status, "parent order should be complete")
+	assert.Equal(t, 4.0, resp.FilledAmount, "FilledAmount should be correct")
+	assert.Equal(t, 101.0, resp.AverageFillPrice, "AverageFillPrice should be correct")
+	assert.InDelta(t, 100.0, resp.SlippageBasisPoints, 1e-9, "SlippageBasisPoints should be correct")
+	assert.InDelta(t, 4.0, resp.SlippageCost, 1e-9, "SlippageCost should be correct")
+	assert.Len(t, resp.Children, 3, "all child orders should be recorded")
+}
+
+func TestAlgoExecutionManagerVWAP(t *testing.T) {
+	t.Parallel()
+	m, om, exch := setupAlgoExecutionTest(t)
+	p := algoTestOrder(VWAP)
+	p.Slices = 2
+	p.Amount = 10
+	// volume is profiled by time of day from the previous day's candles
+	day := p.StartTime.Add(-time.Hour * 24)
+	exch.candles = &kline.Item{
+		Candles: []kline.Candle{
+			{Time: day, Volume: 6},
+			{Time: day.Add(time.Minute * 30), Volume: 2},
+			{Time: day.Add(time.Hour * 2), Volume: 100},
+		},
+	}
+	id, err := m.Create(context.Background(), p)
+	require.NoError(t, err, "Create must not error")
+	resp, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, defaultVWAPInterval, resp.VolumeInterval, "VolumeInterval should be set to the default")
+	assert.Equal(t, defaultVWAPLookback, resp.VolumeLookback, "VolumeLookback should be set to the default")
+
+	submitted := om.getSubmitted()
+	require.Len(t, submitted, 1, "first slice must be submitted immediately")
+	assert.InDelta(t, 7.5, submitted[0].Amount, 1e-9, "first slice should be weighted by historic volume")
+	om.fill("1", 7.5, 100, order.Filled)
+	m.processOrders(context.Background(), p.StartTime.Add(time.Minute*30))
+	submitted = om.getSubmitted()
+	require.Len(t, submitted, 2, "second slice must be submitted when due")
+	assert.InDelta(t, 2.5, submitted[1].Amount, 1e-9, "second slice should be weighted by historic volume")
+}
+
+func TestVolumeProfile(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []float64{1, 1}, volumeProfile(nil, start, time.Hour, 2), "volumeProfile should return an even profile without candles")
+	item := &kline.Item{
+		Candles: []kline.Candle{
+			{Time: start.Add(-time.Hour * 24), Volume: 3},
+			{Time: start.Add(-time.Hour * 23), Volume: 1},
+			{Time: start.Add(-time.Hour * 22), Volume: 100},
+			{Time: start.Add(-time.Hour * 48).Add(time.Minute * 30), Volume: 1},
+			{Time: start.Add(-time.Minute * 30), Volume: 50},
+		},
+	}
+	assert.Equal(t, []float64{4, 1}, volumeProfile(item, start, time.Hour, 2), "volumeProfile should bucket volume by time of day")
+	item.Candles = []kline.Candle{{Time: start.Add(-time.Hour * 12), Volume: 10}}
+	assert.Equal(t, []float64{1, 1}, volumeProfile(item, start, time.Hour, 2), "volumeProfile should return an even profile without volume in the window")
+}
+
+func TestBuildSchedule(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	p := &ParentOrder{Amount: 3, StartTime: start, EndTime: start.Add(time.Hour * 3)}
+	schedule := buildSchedule(p, []float64{1, 0, 2})
+	require.Len(t, schedule, 3, "buildSchedule must return a slice per weight")
+	assert.Equal(t, start, schedule[0].Time, "first slice should begin at the start time")
+	assert.Equal(t, start.Add(time.Hour*2), schedule[2].Time, "last slice should begin at the correct time")
+	assert.Equal(t, 1.0, schedule[0].Amount, "first slice amount should be correct")
+	assert.Equal(t, 1.0, schedule[1].Amount, "slice without volume should not increase the cumulative amount")
+	assert.Equal(t, 3.0, schedule[2].Amount, "last slice should equal the parent amount")
+
+	p.schedule = schedule
+	assert.Zero(t, p.scheduledAmount(start.Add(-time.Second)), "scheduledAmount should be zero before the start time")
+	assert.Equal(t, 1.0, p.scheduledAmount(start.Add(time.Hour+time.Minute)), "scheduledAmount should be correct")
+	assert.Equal(t, 3.0, p.scheduledAmount(start.Add(time.Hour*5)), "scheduledAmount should be the parent amount after the window")
+}
+
+func TestAlgoExecutionManagerIceberg(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupAlgoExecutionTest(t)
+	p := algoTestOrder(Iceberg)
+	p.Amount = 2.5
+	p.LimitPrice = 100
+	id, err := m.Create(context.Background(), p)
+	require.NoError(t, err, "Create must not error")
+
+	submitted := om.getSubmitted()
+	require.Len(t, submitted, 1, "first visible slice must be submitted immediately")
+	assert.Equal(t, order.Limit, submitted[0].Type, "iceberg child orders should be limit orders")
+	assert.Equal(t, 100.0, submitted[0].Price, "iceberg child orders should use the limit price")
+	assert.Equal(t, 1.0, submitted[0].Amount, "child amount should equal the visible amount")
+
+	om.fill("1", 0.5, 99.5, order.PartiallyFilled)
+	m.processOrders(context.Background(), time.Now())
+	assert.Len(t, om.getSubmitted(), 1, "no child should be submitted while one is resting")
+
+	om.fill("1", 0.5, 99.5, order.Filled)
+	m.processOrders(context.Background(), time.Now())
+	submitted = om.getSubmitted()
+	require.Len(t, submitted, 2, "iceberg must refill once the resting child fills")
+	assert.Equal(t, 1.0, submitted[1].Amount, "refilled child amount should equal the visible amount")
+
+	om.fill("2", 1, 99.5, order.Filled)
+	m.processOrders(context.Background(), time.Now())
+	submitted = om.getSubmitted()
+	require.Len(t, submitted, 3, "iceberg must refill once the resting child fills")
+	assert.Equal(t, 0.5, submitted[2].Amount, "final child amount should be limited to the remaining amount")
+
+	om.fill("3", 0.5, 99.5, order.Filled)
+	m.processOrders(context.Background(), time.Now())
+	resp, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ParentOrderComplete, resp.Status, "parent order should be complete")
+	assert.Equal(t, 2.5, resp.FilledAmount, "FilledAmount should be correct")
+	assert.InDelta(t, -50.0, resp.SlippageBasisPoints, 1e-9, "SlippageBasisPoints should be negative when filled below arrival")
+}
+
+func TestAlgoExecutionManagerPauseResumeCancel(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupAlgoExecutionTest(t)
+	p := algoTestOrder(TWAP)
+	id, err := m.Create(context.Background(), p)
+	require.NoError(t, err, "Create must not error")
+
+	assert.ErrorIs(t, m.Pause("bruh"), ErrParentOrderNotFound, "Pause should error on unknown id")
+	assert.ErrorIs(t, m.Resume(id), errParentOrderNotPaused, "Resume should error when not paused")
+	require.NoError(t, m.Pause(id), "Pause must not error")
+	assert.ErrorIs(t, m.Pause(id), errParentOrderNotActive, "Pause should error when already paused")
+
+	om.fill("1", 1, 100, order.Filled)
+	m.processOrders(context.Background(), p.EndTime)
+	assert.Len(t, om.getSubmitted(), 1, "no child should be submitted while paused")
+	resp, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, 1.0, resp.FilledAmount, "fills should be tracked while paused")
+
+	require.NoError(t, m.Resume(id), "Resume must not error")
+	m.processOrders(context.Background(), p.EndTime)
+	require.Len(t, om.getSubmitted(), 2, "schedule must catch up once resumed")
+
+	assert.ErrorIs(t, m.Cancel(context.Background(), "bruh"), ErrParentOrderNotFound, "Cancel should error on unknown id")
+	require.NoError(t, m.Cancel(context.Background(), id), "Cancel must not error")
+	assert.Equal(t, []string{"2"}, om.cancelled, "only the resting child order should be cancelled")
+	resp, err = m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ParentOrderCancelled, resp.Status, "parent order should be cancelled")
+	assert.Equal(t, order.Cancelled, resp.Children[1].Status, "child order should be cancelled")
+	assert.ErrorIs(t, m.Cancel(context.Background(), id), errParentOrderNotActive, "Cancel should error when already cancelled")
+	assert.ErrorIs(t, m.Resume(id), errParentOrderNotPaused, "Resume should error when cancelled")
+}
+
+func TestAlgoExecutionManagerSubmitFailure(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupAlgoExecutionTest(t)
+	om.fail = true
+	id, err := m.Create(context.Background(), algoTestOrder(TWAP))
+	require.NoError(t, err, "Create must not error")
+	resp, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ParentOrderFailed, resp.Status, "parent order should fail when a child is rejected")
+	assert.Equal(t, errFakeSubmit.Error(), resp.Reason, "Reason should be the submission error")
+	assert.Empty(t, resp.Children, "rejected child orders should not be recorded")
+}
+
+func TestAlgoExecutionManagerMissingOrderID(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupAlgoExecutionTest(t)
+	om.noOrderID = true
+	p := algoTestOrder(Iceberg)
+	p.LimitPrice = 100
+	id, err := m.Create(context.Background(), p)
+	require.NoError(t, err, "Create must not error")
+	resp, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ParentOrderFailed, resp.Status, "parent order should fail when a child has no order ID")
+	assert.Equal(t, errChildOrderIDMissing.Error(), resp.Reason, "Reason should be the missing order ID")
+	require.Len(t, resp.Children, 1, "child order must be recorded")
+	assert.False(t, resp.Children[0].isOpen(), "child order without an order ID should not be open")
+}
+
+func TestAlgoExecutionManagerExpire(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupAlgoExecutionTest(t)
+	p := algoTestOrder(TWAP)
+	p.LimitPrice = 100
+	id, err := m.Create(context.Background(), p)
+	require.NoError(t, err, "Create must not error")
+	om.fill("1", 0.5, 100, order.PartiallyFilled)
+
+	m.processOrders(context.Background(), p.EndTime.Add(time.Second))
+	assert.Len(t, om.getSubmitted(), 1, "no child should be submitted after the execution window")
+	assert.Equal(t, []string{"1"}, om.cancelled, "resting child order should be cancelled")
+	resp, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ParentOrderExpired, resp.Status, "parent order should expire")
+	assert.Equal(t, 0.5, resp.FilledAmount, "FilledAmount should be correct")
+	assert.Equal(t, order.Cancelled, resp.Children[0].Status, "child order should be cancelled")
+	assert.ErrorIs(t, m.Cancel(context.Background(), id), errParentOrderNotActive, "Cancel should error when expired")
+
+	p = algoTestOrder(TWAP)
+	p.Slices = 1
+	om.fillPrice = 100
+	id, err = m.Create(context.Background(), p)
+	require.NoError(t, err, "Create must not error")
+	require.NoError(t, m.Pause(id), "Pause must not error")
+	m.processOrders(context.Background(), p.EndTime.Add(time.Second))
+	resp, err = m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ParentOrderComplete, resp.Status, "filled parent order should complete rather than expire")
+}
+
+func TestAlgoExecutionManagerSubmitUnlocked(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupAlgoExecutionTest(t)
+	var id string
+	var getErr, cancelErr error
+	om.onSubmit = func(context.Context) {
+		if id == "" {
+			return
+		}
+		// fails with a deadlock if the manager lock is held
+		_, getErr = m.GetOrder(id)
+		cancelErr = m.Cancel(context.Background(), id)
+	}
+	p := algoTestOrder(TWAP)
+	var err error
+	id, err = m.Create(context.Background(), p)
+	require.NoError(t, err, "Create must not error")
+	om.fill("1", 1, 100, order.Filled)
+	m.processOrders(context.Background(), p.StartTime.Add(time.Minute*16))
+	assert.NoError(t, getErr, "GetOrder should not error while a child order is submitted")
+	assert.ErrorIs(t, cancelErr, errParentOrderBusy, "Cancel should error while a child order is submitted")
+	resp, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Len(t, resp.Children, 2, "submitted child order should be recorded")
+}
+
+func TestAlgoExecutionManagerGetOrders(t *testing.T) {
+	t.Parallel()
+	var m *AlgoExecutionManager
+	_, err := m.GetOrders()
+	assert.ErrorIs(t, err, ErrNilSubsystem, "GetOrders should error on nil subsystem")
+	_, err = m.GetOrder("")
+	assert.ErrorIs(t, err, ErrNilSubsystem, "GetOrder should error on nil subsystem")
+
+	m, _, _ = setupAlgoExecutionTest(t)
+	first, err := m.Create(context.Background(), algoTestOrder(TWAP))
+	require.NoError(t, err, "Create must not error")
+	second, err := m.Create(context.Background(), algoTestOrder(TWAP))
+	require.NoError(t, err, "Create must not error")
+	resp, err := m.GetOrders()
+	require.NoError(t, err, "GetOrders must not error")
+	require.Len(t, resp, 2, "GetOrders must return all parent orders")
+	assert.Equal(t, first, resp[0].ID, "GetOrders should order by creation date")
+	assert.Equal(t, second, resp[1].ID, "GetOrders should order by creation date")
+
+	resp[0].Children[0].Amount = 1337
+	p, err := m.GetOrder(first)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, 1.0, p.Children[0].Amount, "GetOrders should return copies")
+	_, err = m.GetOrder("bruh")
+	assert.ErrorIs(t, err, ErrParentOrderNotFound, "GetOrder should error on unknown id")
+}
+
+func TestParentOrderSummarise(t *testing.T) {
+	t.Parallel()
+	p := &ParentOrder{Side: order.Sell, ArrivalPrice: 100}
+	p.summarise()
+	assert.Zero(t, p.AverageFillPrice, "AverageFillPrice should be zero without fills")
+	p.Children = []ChildOrder{
+		{FilledAmount: 1, AveragePrice: 99},
+		{FilledAmount: 3, AveragePrice: 98},
+	}
+	p.summarise()
+	assert.Equal(t, 4.0, p.FilledAmount, "FilledAmount should be correct")
+	assert.Equal(t, 98.25, p.AverageFillPrice, "AverageFillPrice should be volume weighted")
+	assert.InDelta(t, 175.0, p.SlippageBasisPoints, 1e-9, "SlippageBasisPoints should be positive when selling below arrival")
+	assert.InDelta(t, 7.0, p.SlippageCost, 1e-9, "SlippageCost should be correct")
+}
+
+func TestFilledFromDetail(t *testing.T) {
+	t.Parallel()
+	amount, price := filledFromDetail(&order.Detail{ExecutedAmount: 2, AverageExecutedPrice: 50})
+	assert.Equal(t, 2.0, amount, "amount should fall back to the executed amount")
+	assert.Equal(t, 50.0, price, "price should fall back to the average executed price")
+	amount, price = filledFromDetail(&order.Detail{
+		ExecutedAmount: 5,
+		Trades: []order.TradeHistory{
+			{Amount: 1, Price: 10},
+			{Amount: 3, Price: 20},
+		},
+	})
+	assert.Equal(t, 4.0, amount, "amount should be the sum of trades")
+	assert.Equal(t, 17.5, price, "price should be the volume weighted trade price")
+}
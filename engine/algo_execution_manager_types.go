@@ -0,0 +1,168 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// AlgoExecutionManagerName is an exported subsystem name
+const AlgoExecutionManagerName = "algo_execution_manager"
+
+const (
+	// defaultVWAPInterval is the candle interval used to build a VWAP volume
+	// profile when one is not specified
+	defaultVWAPInterval = kline.OneHour
+	// defaultVWAPLookback is the period of historic candles used to build a
+	// VWAP volume profile when one is not specified
+	defaultVWAPLookback = time.Hour * 24 * 7
+	// maxVWAPDuration is the longest VWAP execution window, volume profiles
+	// are built by time of day
+	maxVWAPDuration = time.Hour * 24
+)
+
+var (
+	// ErrParentOrderNotFound is returned when a parent order does not exist
+	ErrParentOrderNotFound = errors.New("parent order not found")
+
+	errNilParentOrder           = errors.New("nil parent order received")
+	errExecutionStrategyInvalid = errors.New("execution strategy is not supported, supported strategies: TWAP, VWAP, ICEBERG")
+	errInvalidSliceCount        = errors.New("number of slices must be greater than zero")
+	errVisibleAmountInvalid     = errors.New("iceberg visible amount must be greater than zero and less than the parent amount")
+	errIcebergLimitPrice        = errors.New("iceberg orders require a limit price")
+	errVWAPDurationTooLong      = errors.New("VWAP execution window cannot exceed 24 hours")
+	errExecutionWindowElapsed   = errors.New("execution window end time has already passed")
+	errParentOrderNotActive     = errors.New("parent order is not active")
+	errParentOrderNotPaused     = errors.New("parent order is not paused")
+	errNoArrivalPrice           = errors.New("unable to determine arrival price")
+	errParentOrderBusy          = errors.New("parent order child orders are being submitted or cancelled, try again")
+	errChildOrderIDMissing      = errors.New("child order submitted without an order ID, fills cannot be tracked")
+)
+
+// ExecutionStrategy defines how a parent order is sliced into child orders
+type ExecutionStrategy string
+
+// Supported execution strategies
+const (
+	// TWAP slices a parent order evenly over time
+	TWAP ExecutionStrategy = "TWAP"
+	// VWAP slices a parent order over time in proportion to the historic
+	// volume traded at each time of day
+	VWAP ExecutionStrategy = "VWAP"
+	// Iceberg shows a visible slice of the parent order, refilling it each
+	// time the resting child order fills
+	Iceberg ExecutionStrategy = "ICEBERG"
+)
+
+// ParentOrderStatus defines the lifecycle state of a parent order
+type ParentOrderStatus string
+
+// Parent order statuses
+const (
+	ParentOrderActive    ParentOrderStatus = "ACTIVE"
+	ParentOrderPaused    ParentOrderStatus = "PAUSED"
+	ParentOrderCancelled ParentOrderStatus = "CANCELLED"
+	ParentOrderComplete  ParentOrderStatus = "COMPLETE"
+	ParentOrderFailed    ParentOrderStatus = "FAILED"
+	// ParentOrderExpired is set when a TWAP or VWAP execution window ends
+	// before the parent order fills, any resting child orders are cancelled
+	ParentOrderExpired ParentOrderStatus = "EXPIRED"
+)
+
+// AlgoExecutionManager slices large parent orders into child orders which are
+// submitted through the order manager over time, tracking their fills and
+// reporting slippage against the price at the time the parent was created
+type AlgoExecutionManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iAlgoOrderManager
+	orders          map[string]*ParentOrder
+	checkInterval   time.Duration
+	verbose         bool
+	m               sync.Mutex
+}
+
+// ParentOrder defines a large order which is executed as a series of child
+// orders according to its strategy
+type ParentOrder struct {
+	ID       string
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Side     order.Side
+	Strategy ExecutionStrategy
+	Amount   float64
+	// LimitPrice submits child orders as limit orders when set, otherwise
+	// market orders are used. Required for iceberg orders
+	LimitPrice float64
+	// StartTime and EndTime define the execution window for TWAP and VWAP
+	// orders
+	StartTime time.Time
+	EndTime   time.Time
+	// Slices is the number of child orders TWAP and VWAP orders are split
+	// into
+	Slices int64
+	// VisibleAmount is the size of each iceberg child order
+	VisibleAmount float64
+	// VolumeInterval and VolumeLookback define the historic candles used to
+	// build a VWAP volume profile
+	VolumeInterval kline.Interval
+	VolumeLookback time.Duration
+	Status         ParentOrderStatus
+	Reason         string
+	// ArrivalPrice is the mid price when the parent order was created which
+	// slippage is measured against
+	ArrivalPrice     float64
+	FilledAmount     float64
+	AverageFillPrice float64
+	// SlippageBasisPoints is positive when fills are worse than the arrival
+	// price
+	SlippageBasisPoints float64
+	// SlippageCost is the cost of slippage in the quote currency
+	SlippageCost float64
+	Children     []ChildOrder
+	CreatedDate  time.Time
+	UpdatedDate  time.Time
+	schedule     []executionSlice
+	// pending is set while child orders are submitted or cancelled without
+	// the lock held
+	pending bool
+}
+
+// ChildOrder holds the details of an order submitted on behalf of a parent
+// order
+type ChildOrder struct {
+	OrderID       string
+	Amount        float64
+	FilledAmount  float64
+	AveragePrice  float64
+	Status        order.Status
+	SubmittedDate time.Time
+}
+
+// algoAction holds the child order to submit or the child orders to cancel
+// for a parent order along with their results
+type algoAction struct {
+	parent     *ParentOrder
+	submit     *order.Submit
+	submitResp *OrderSubmitResponse
+	submitErr  error
+	// cancels holds the indexes of the parent order's child orders to cancel
+	cancels    []int
+	cancelErrs []error
+	expire     bool
+}
+
+// executionSlice defines the cumulative amount of a parent order which should
+// have been submitted by a point in time
+type executionSlice struct {
+	Time   time.Time
+	Amount float64
+}
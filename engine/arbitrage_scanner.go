@@ -0,0 +1,524 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// arbitrageCodeAliases maps currency codes which some exchanges use in place
+// of the common code for the same currency
+var arbitrageCodeAliases = map[*currency.Item]currency.Code{
+	currency.XBT.Item:  currency.BTC,
+	currency.XXBT.Item: currency.BTC,
+	currency.XETH.Item: currency.ETH,
+	currency.XDG.Item:  currency.DOGE,
+}
+
+// SetupArbitrageScanner creates a new cross-exchange arbitrage scanner
+func SetupArbitrageScanner(exchangeManager iExchangeManager, communicationsManager iCommsManager, cfg *config.ArbitrageScanner) (*ArbitrageScanner, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if communicationsManager == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", ArbitrageScannerName, errNilConfig)
+	}
+	checkInterval := cfg.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = config.DefaultArbitrageScannerCheckInterval
+	}
+	alertCooldown := cfg.AlertCooldown
+	if alertCooldown <= 0 {
+		alertCooldown = config.DefaultArbitrageAlertCooldown
+	}
+	return &ArbitrageScanner{
+		shutdown:        make(chan struct{}),
+		exchangeManager: exchangeManager,
+		commsManager:    communicationsManager,
+		checkInterval:   checkInterval,
+		alertThreshold:  cfg.AlertThresholdBasisPoints,
+		alertCooldown:   alertCooldown,
+		verbose:         cfg.Verbose,
+		pipes:           make(map[string]dispatch.Pipe),
+		books:           make(map[key.PairAsset]map[string]*orderbook.Base),
+		opportunities:   make(map[arbitrageKey]ArbitrageOpportunity),
+		fees:            make(map[arbitrageFeeKey]arbitrageFee),
+		lastAlert:       make(map[arbitrageKey]time.Time),
+		subscribers:     make(map[uuid.UUID]chan ArbitrageOpportunity),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (a *ArbitrageScanner) IsRunning() bool {
+	return a != nil && atomic.LoadInt32(&a.started) == 1
+}
+
+// Start runs the subsystem
+func (a *ArbitrageScanner) Start() error {
+	if a == nil {
+		return fmt.Errorf("%s %w", ArbitrageScannerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&a.started, 0, 1) {
+		return fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Arbitrage scanner %s", MsgSubSystemStarting)
+	a.shutdown = make(chan struct{})
+	a.wg.Add(1)
+	go a.run()
+	log.Debugf(log.OrderMgr, "Arbitrage scanner %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem, releasing all orderbook
+// subscriptions and closing any opportunity subscriber channels
+func (a *ArbitrageScanner) Stop() error {
+	if a == nil {
+		return fmt.Errorf("%s %w", ArbitrageScannerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&a.started) == 0 {
+		return fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Arbitrage scanner %s", MsgSubSystemShuttingDown)
+	close(a.shutdown)
+	a.wg.Wait()
+	a.m.Lock()
+	for id, ch := range a.subscribers {
+		close(ch)
+		delete(a.subscribers, id)
+	}
+	a.pipes = make(map[string]dispatch.Pipe)
+	a.books = make(map[key.PairAsset]map[string]*orderbook.Base)
+	a.opportunities = make(map[arbitrageKey]ArbitrageOpportunity)
+	a.m.Unlock()
+	atomic.StoreInt32(&a.started, 0)
+	log.Debugf(log.OrderMgr, "Arbitrage scanner %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// GetOpportunities returns all current opportunities ordered by net spread
+func (a *ArbitrageScanner) GetOpportunities() ([]ArbitrageOpportunity, error) {
+	if !a.IsRunning() {
+		return nil, fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemNotStarted)
+	}
+	a.m.Lock()
+	resp := make([]ArbitrageOpportunity, 0, len(a.opportunities))
+	for _, o := range a.opportunities {
+		resp = append(resp, o)
+	}
+	a.m.Unlock()
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].NetSpreadBasisPoints > resp[j].NetSpreadBasisPoints
+	})
+	return resp, nil
+}
+
+// Subscribe returns a channel which receives opportunities as they are found
+// or updated along with an ID used to unsubscribe. Opportunities are dropped
+// for subscribers which do not keep up and the channel is closed when the
+// subsystem is stopped
+func (a *ArbitrageScanner) Subscribe() (uuid.UUID, <-chan ArbitrageOpportunity, error) {
+	if !a.IsRunning() {
+		return uuid.Nil, nil, fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemNotStarted)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	ch := make(chan ArbitrageOpportunity, arbitrageSubscriberBuffer)
+	a.m.Lock()
+	a.subscribers[id] = ch
+	a.m.Unlock()
+	return id, ch, nil
+}
+
+// Unsubscribe removes an opportunity subscriber and closes its channel
+func (a *ArbitrageScanner) Unsubscribe(id uuid.UUID) {
+	if a == nil {
+		return
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	if ch, ok := a.subscribers[id]; ok {
+		close(ch)
+		delete(a.subscribers, id)
+	}
+}
+
+// run subscribes to the orderbooks of every enabled exchange, retrying on
+// each interval for exchanges which have not yet received an orderbook
+func (a *ArbitrageScanner) run() {
+	defer a.wg.Done()
+	tick := time.NewTicker(a.checkInterval)
+	defer tick.Stop()
+	a.subscribe()
+	for {
+		select {
+		case <-a.shutdown:
+			return
+		case <-tick.C:
+			a.subscribe()
+			a.removeStaleBooks()
+		}
+	}
+}
+
+// subscribe creates an orderbook subscription for each enabled exchange which
+// does not already have one
+func (a *ArbitrageScanner) subscribe() {
+	exchanges, err := a.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Arbitrage scanner unable to get exchanges: %v", err)
+		return
+	}
+	for i := range exchanges {
+		if !exchanges[i].IsEnabled() {
+			continue
+		}
+		name := exchanges[i].GetName()
+		a.m.Lock()
+		_, ok := a.pipes[name]
+		a.m.Unlock()
+		if ok {
+			continue
+		}
+		pipe, err := orderbook.SubscribeToExchangeOrderbooks(name)
+		if err != nil {
+			// Orderbooks cannot be subscribed to until the exchange has
+			// processed at least one
+			if a.verbose {
+				log.Debugf(log.OrderMgr, "Arbitrage scanner unable to subscribe to %s orderbooks: %v", name, err)
+			}
+			continue
+		}
+		a.m.Lock()
+		a.pipes[name] = pipe
+		a.m.Unlock()
+		a.wg.Add(1)
+		go a.consume(name, pipe)
+	}
+}
+
+// consume processes orderbook updates from a subscription until shutdown
+func (a *ArbitrageScanner) consume(exch string, pipe dispatch.Pipe) {
+	defer a.wg.Done()
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Errorf(log.OrderMgr, "Arbitrage scanner unable to release %s orderbook subscription: %v", exch, err)
+		}
+	}()
+	for {
+		select {
+		case <-a.shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				// Allow the subscription to be recreated on the next interval
+				a.m.Lock()
+				delete(a.pipes, exch)
+				a.m.Unlock()
+				return
+			}
+			d, ok := data.(orderbook.Outbound)
+			if !ok {
+				continue
+			}
+			b, err := d.Retrieve()
+			if err != nil {
+				continue
+			}
+			a.processOrderbook(context.TODO(), b)
+		}
+	}
+}
+
+// processOrderbook stores an updated orderbook and compares it against the
+// orderbooks for the same pair on every other exchange. Opportunities are only
+// evaluated for spot orderbooks as they rely on transferring the bought
+// currency to the selling exchange
+func (a *ArbitrageScanner) processOrderbook(ctx context.Context, b *orderbook.Base) {
+	if b == nil || b.Asset != asset.Spot || b.Pair.IsEmpty() {
+		return
+	}
+	if len(b.Bids) > arbitrageMaxLevels {
+		b.Bids = b.Bids[:arbitrageMaxLevels]
+	}
+	if len(b.Asks) > arbitrageMaxLevels {
+		b.Asks = b.Asks[:arbitrageMaxLevels]
+	}
+	k := normaliseArbitragePair(b.Pair, b.Asset)
+	a.m.Lock()
+	books, ok := a.books[k]
+	if !ok {
+		books = make(map[string]*orderbook.Base)
+		a.books[k] = books
+	}
+	books[b.Exchange] = b
+	others := make([]*orderbook.Base, 0, len(books)-1)
+	for name, other := range books {
+		if name == b.Exchange || time.Since(other.LastUpdated) > arbitrageStaleBookAge {
+			continue
+		}
+		others = append(others, other)
+	}
+	a.m.Unlock()
+
+	for i := range others {
+		a.update(k, b.Exchange, others[i].Exchange, a.evaluate(ctx, k, b, others[i]))
+		a.update(k, others[i].Exchange, b.Exchange, a.evaluate(ctx, k, others[i], b))
+	}
+}
+
+// normaliseArbitragePair returns a common key for pairs which are named
+// differently across exchanges
+func normaliseArbitragePair(p currency.Pair, a asset.Item) key.PairAsset {
+	if c, ok := arbitrageCodeAliases[p.Base.Item]; ok {
+		p.Base = c
+	}
+	if c, ok := arbitrageCodeAliases[p.Quote.Item]; ok {
+		p.Quote = c
+	}
+	return key.PairAsset{Base: p.Base.Item, Quote: p.Quote.Item, Asset: a}
+}
+
+// evaluate calculates the opportunity to buy from one orderbook's asks and
+// sell into another's bids, returning nil when none exists after fees or when
+// the currency cannot be transferred between the exchanges
+func (a *ArbitrageScanner) evaluate(ctx context.Context, k key.PairAsset, buy, sell *orderbook.Base) *ArbitrageOpportunity {
+	if len(buy.Asks) == 0 || len(sell.Bids) == 0 || sell.Bids[0].Price <= buy.Asks[0].Price {
+		return nil
+	}
+	buyExch, err := a.exchangeManager.GetExchangeByName(buy.Exchange)
+	if err != nil {
+		return nil
+	}
+	sellExch, err := a.exchangeManager.GetExchangeByName(sell.Exchange)
+	if err != nil {
+		return nil
+	}
+	if err = buyExch.CanWithdraw(buy.Pair.Base, buy.Asset); err != nil && !errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		a.logEvaluation(buy.Exchange, sell.Exchange, k, err)
+		return nil
+	}
+	if err = sellExch.CanDeposit(sell.Pair.Base, sell.Asset); err != nil && !errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		a.logEvaluation(buy.Exchange, sell.Exchange, k, err)
+		return nil
+	}
+	buyFee, err := a.getTradingFeeRate(ctx, buyExch, buy)
+	if err != nil {
+		a.logEvaluation(buy.Exchange, sell.Exchange, k, err)
+		return nil
+	}
+	sellFee, err := a.getTradingFeeRate(ctx, sellExch, sell)
+	if err != nil {
+		a.logEvaluation(buy.Exchange, sell.Exchange, k, err)
+		return nil
+	}
+	withdrawalFee, err := a.getWithdrawalFee(ctx, buyExch, buy)
+	if err != nil {
+		a.logEvaluation(buy.Exchange, sell.Exchange, k, err)
+		return nil
+	}
+
+	// Walk both orderbooks while the bid still exceeds the ask after fees
+	var amount, cost, proceeds float64
+	asks := append(orderbook.Items(nil), buy.Asks...)
+	bids := append(orderbook.Items(nil), sell.Bids...)
+	for i, j := 0, 0; i < len(asks) && j < len(bids); {
+		if bids[j].Price*(1-sellFee) <= asks[i].Price*(1+buyFee) {
+			break
+		}
+		take := math.Min(asks[i].Amount, bids[j].Amount)
+		amount += take
+		cost += take * asks[i].Price
+		proceeds += take * bids[j].Price
+		asks[i].Amount -= take
+		bids[j].Amount -= take
+		if asks[i].Amount <= 0 {
+			i++
+		}
+		if bids[j].Amount <= 0 {
+			j++
+		}
+	}
+	if amount <= 0 {
+		return nil
+	}
+	o := &ArbitrageOpportunity{
+		Pair:             k.Pair(),
+		Asset:            k.Asset,
+		BuyExchange:      buy.Exchange,
+		SellExchange:     sell.Exchange,
+		BuyPrice:         buy.Asks[0].Price,
+		SellPrice:        sell.Bids[0].Price,
+		Amount:           amount,
+		AverageBuyPrice:  cost / amount,
+		AverageSellPrice: proceeds / amount,
+		GrossProfit:      proceeds - cost,
+		TradingFees:      cost*buyFee + proceeds*sellFee,
+		Time:             time.Now(),
+	}
+	o.WithdrawalFee = withdrawalFee * o.AverageSellPrice
+	o.NetProfit = o.GrossProfit - o.TradingFees - o.WithdrawalFee
+	if o.NetProfit <= 0 {
+		return nil
+	}
+	o.NetSpreadBasisPoints = o.NetProfit / cost * 10000
+	return o
+}
+
+// logEvaluation logs why a pair could not be evaluated when verbose
+func (a *ArbitrageScanner) logEvaluation(buy, sell string, k key.PairAsset, err error) {
+	if a.verbose {
+		log.Debugf(log.OrderMgr, "Arbitrage scanner cannot evaluate %s %s buying on %s and selling on %s: %v",
+			k.Asset, k.Pair(), buy, sell, err)
+	}
+}
+
+// getTradingFeeRate returns the cached taker fee rate of an exchange's pair,
+// retrieving it for a single unit at the best price when not cached
+func (a *ArbitrageScanner) getTradingFeeRate(ctx context.Context, exch exchange.IBotExchange, b *orderbook.Base) (float64, error) {
+	price := b.Asks[0].Price
+	if len(b.Bids) > 0 {
+		price = b.Bids[0].Price
+	}
+	return a.getFee(arbitrageFeeKey{
+		exchange:  b.Exchange,
+		PairAsset: key.PairAsset{Base: b.Pair.Base.Item, Quote: b.Pair.Quote.Item, Asset: b.Asset},
+	}, func() (float64, error) {
+		fee, err := exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+			FeeType:       exchange.CryptocurrencyTradeFee,
+			Pair:          b.Pair,
+			PurchasePrice: price,
+			Amount:        1,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("unable to retrieve taker fee: %w", err)
+		}
+		return fee / price, nil
+	})
+}
+
+// getWithdrawalFee returns the cached fee for withdrawing the base currency
+// of a pair from an exchange in units of that currency
+func (a *ArbitrageScanner) getWithdrawalFee(ctx context.Context, exch exchange.IBotExchange, b *orderbook.Base) (float64, error) {
+	return a.getFee(arbitrageFeeKey{
+		exchange:   b.Exchange,
+		PairAsset:  key.PairAsset{Base: b.Pair.Base.Item, Quote: b.Pair.Quote.Item, Asset: b.Asset},
+		withdrawal: true,
+	}, func() (float64, error) {
+		fee, err := exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+			FeeType: exchange.CryptocurrencyWithdrawalFee,
+			Pair:    b.Pair,
+			Amount:  1,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("unable to retrieve withdrawal fee: %w", err)
+		}
+		return fee, nil
+	})
+}
+
+// getFee returns a cached fee, or the cached error from retrieving it, calling
+// fetch when there is no recent entry
+func (a *ArbitrageScanner) getFee(k arbitrageFeeKey, fetch func() (float64, error)) (float64, error) {
+	a.m.Lock()
+	f, ok := a.fees[k]
+	a.m.Unlock()
+	if ok && time.Since(f.updated) < arbitrageFeeCacheDuration {
+		return f.value, f.err
+	}
+	f.value, f.err = fetch()
+	f.updated = time.Now()
+	a.m.Lock()
+	a.fees[k] = f
+	a.m.Unlock()
+	return f.value, f.err
+}
+
+// update stores or removes an opportunity, publishing it to subscribers and
+// pushing an alert when it exceeds the configured threshold
+func (a *ArbitrageScanner) update(k key.PairAsset, buy, sell string, o *ArbitrageOpportunity) {
+	ak := arbitrageKey{PairAsset: k, buy: buy, sell: sell}
+	a.m.Lock()
+	defer a.m.Unlock()
+	if o == nil {
+		delete(a.opportunities, ak)
+		return
+	}
+	prev, ok := a.opportunities[ak]
+	a.opportunities[ak] = *o
+	if ok && prev.Amount == o.Amount && prev.NetProfit == o.NetProfit {
+		return
+	}
+	for _, ch := range a.subscribers {
+		select {
+		case ch <- *o:
+		default:
+		}
+	}
+	if a.verbose {
+		log.Debugf(log.OrderMgr, "Arbitrage scanner %s", o)
+	}
+	if a.alertThreshold <= 0 || o.NetSpreadBasisPoints < a.alertThreshold {
+		return
+	}
+	if last, ok := a.lastAlert[ak]; ok && o.Time.Sub(last) < a.alertCooldown {
+		return
+	}
+	a.lastAlert[ak] = o.Time
+	a.commsManager.PushEvent(base.Event{Type: "arbitrage", Message: o.String()})
+}
+
+// removeStaleBooks drops orderbooks which have stopped updating along with
+// any opportunities which rely on them, and alert cooldowns which have passed
+func (a *ArbitrageScanner) removeStaleBooks() {
+	a.m.Lock()
+	defer a.m.Unlock()
+	for ak, last := range a.lastAlert {
+		if time.Since(last) >= a.alertCooldown {
+			delete(a.lastAlert, ak)
+		}
+	}
+	for k, books := range a.books {
+		for name, b := range books {
+			if time.Since(b.LastUpdated) <= arbitrageStaleBookAge {
+				continue
+			}
+			delete(books, name)
+			for ak := range a.opportunities {
+				if ak.PairAsset == k && (ak.buy == name || ak.sell == name) {
+					delete(a.opportunities, ak)
+				}
+			}
+		}
+		if len(books) == 0 {
+			delete(a.books, k)
+		}
+	}
+}
+
+// String returns a human readable summary of the opportunity
+func (o *ArbitrageOpportunity) String() string {
+	return fmt.Sprintf("%s %s buy %v on %s at %v, sell on %s at %v, net profit %v (%.2f bps)",
+		o.Asset, o.Pair, o.Amount, o.BuyExchange, o.AverageBuyPrice,
+		o.SellExchange, o.AverageSellPrice, o.NetProfit, o.NetSpreadBasisPoints)
+}
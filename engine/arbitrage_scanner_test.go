@@ -0,0 +1,306 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var errFakeCurrencyState = errors.New("fake currency state failure")
+
+// arbitrageTestExchange overrides the withdrawal fee and currency state of a
+// route test exchange
+type arbitrageTestExchange struct {
+	routeTestExchange
+	withdrawalFee float64
+	withdrawErr   error
+	depositErr    error
+}
+
+func (a *arbitrageTestExchange) GetFeeByType(ctx context.Context, f *exchange.FeeBuilder) (float64, error) {
+	if f.FeeType == exchange.CryptocurrencyWithdrawalFee {
+		return a.withdrawalFee, nil
+	}
+	return a.routeTestExchange.GetFeeByType(ctx, f)
+}
+
+func (a *arbitrageTestExchange) CanWithdraw(currency.Code, asset.Item) error {
+	return a.withdrawErr
+}
+
+func (a *arbitrageTestExchange) CanDeposit(currency.Code, asset.Item) error {
+	return a.depositErr
+}
+
+// fakeArbitrageComms records pushed events
+type fakeArbitrageComms struct {
+	m      sync.Mutex
+	events []base.Event
+}
+
+func (f *fakeArbitrageComms) PushEvent(evt base.Event) {
+	f.m.Lock()
+	f.events = append(f.events, evt)
+	f.m.Unlock()
+}
+
+func (f *fakeArbitrageComms) getEvents() []base.Event {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]base.Event(nil), f.events...)
+}
+
+func setupArbitrageScannerTest(t *testing.T, cfg *config.ArbitrageScanner, exchanges ...*arbitrageTestExchange) (*ArbitrageScanner, *fakeArbitrageComms) {
+	t.Helper()
+	em := NewExchangeManager()
+	for i := range exchanges {
+		exch, err := em.NewExchangeByName(testExchange)
+		require.NoError(t, err, "NewExchangeByName must not error")
+		exch.SetDefaults()
+		exchanges[i].IBotExchange = exch
+		require.NoError(t, em.Add(exchanges[i]), "Add must not error")
+	}
+	comms := &fakeArbitrageComms{}
+	a, err := SetupArbitrageScanner(em, comms, cfg)
+	require.NoError(t, err, "SetupArbitrageScanner must not error")
+	// Marked as running without starting so that orderbooks are only
+	// processed when supplied by the test
+	a.started = 1
+	return a, comms
+}
+
+func arbitrageTestBook(exch string, pair currency.Pair, bids, asks orderbook.Items) *orderbook.Base {
+	return &orderbook.Base{
+		Exchange:    exch,
+		Pair:        pair,
+		Asset:       asset.Spot,
+		Bids:        bids,
+		Asks:        asks,
+		LastUpdated: time.Now(),
+	}
+}
+
+func TestSetupArbitrageScanner(t *testing.T) {
+	t.Parallel()
+	_, err := SetupArbitrageScanner(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupArbitrageScanner should error on nil exchange manager")
+	_, err = SetupArbitrageScanner(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager, "SetupArbitrageScanner should error on nil comms manager")
+	_, err = SetupArbitrageScanner(NewExchangeManager(), &CommunicationManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig, "SetupArbitrageScanner should error on nil config")
+	a, err := SetupArbitrageScanner(NewExchangeManager(), &CommunicationManager{}, &config.ArbitrageScanner{})
+	require.NoError(t, err, "SetupArbitrageScanner must not error")
+	assert.Equal(t, config.DefaultArbitrageScannerCheckInterval, a.checkInterval, "checkInterval should default")
+	assert.Equal(t, config.DefaultArbitrageAlertCooldown, a.alertCooldown, "alertCooldown should default")
+}
+
+func TestArbitrageScannerStartStop(t *testing.T) {
+	t.Parallel()
+	var a *ArbitrageScanner
+	assert.ErrorIs(t, a.Start(), ErrNilSubsystem, "Start should error on nil subsystem")
+	assert.ErrorIs(t, a.Stop(), ErrNilSubsystem, "Stop should error on nil subsystem")
+	assert.False(t, a.IsRunning(), "IsRunning should return false")
+
+	a, err := SetupArbitrageScanner(NewExchangeManager(), &CommunicationManager{}, &config.ArbitrageScanner{})
+	require.NoError(t, err, "SetupArbitrageScanner must not error")
+	_, _, err = a.Subscribe()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "Subscribe should error when not started")
+	_, err = a.GetOpportunities()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetOpportunities should error when not started")
+	assert.ErrorIs(t, a.Stop(), ErrSubSystemNotStarted, "Stop should error when not started")
+	require.NoError(t, a.Start(), "Start must not error")
+	assert.ErrorIs(t, a.Start(), ErrSubSystemAlreadyStarted, "Start should error when already started")
+	assert.True(t, a.IsRunning(), "IsRunning should return true")
+
+	_, ch, err := a.Subscribe()
+	require.NoError(t, err, "Subscribe must not error")
+	require.NoError(t, a.Stop(), "Stop must not error")
+	_, ok := <-ch
+	assert.False(t, ok, "Subscriber channel should be closed on Stop")
+	assert.False(t, a.IsRunning(), "IsRunning should return false")
+}
+
+func TestArbitrageScannerProcessOrderbook(t *testing.T) {
+	t.Parallel()
+	a, comms := setupArbitrageScannerTest(t, &config.ArbitrageScanner{AlertThresholdBasisPoints: 100},
+		&arbitrageTestExchange{routeTestExchange: routeTestExchange{name: "arbbuy", feeRate: 0.001}, withdrawalFee: 0.0005},
+		&arbitrageTestExchange{routeTestExchange: routeTestExchange{name: "arbsell", feeRate: 0.001}},
+	)
+	id, ch, err := a.Subscribe()
+	require.NoError(t, err, "Subscribe must not error")
+	defer a.Unsubscribe(id)
+
+	ctx := context.Background()
+	a.processOrderbook(ctx, arbitrageTestBook("arbbuy", btcusdPair,
+		orderbook.Items{{Price: 99, Amount: 1}},
+		orderbook.Items{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}}))
+	opps, err := a.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	assert.Empty(t, opps, "GetOpportunities should return nothing for a single exchange")
+
+	sellBook := arbitrageTestBook("arbsell", btcusdPair,
+		orderbook.Items{{Price: 103, Amount: 0.5}, {Price: 102, Amount: 1}, {Price: 100, Amount: 1}},
+		orderbook.Items{{Price: 104, Amount: 1}})
+	a.processOrderbook(ctx, sellBook)
+	opps, err = a.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	require.Len(t, opps, 1, "GetOpportunities must return a single direction")
+	o := opps[0]
+	assert.Equal(t, "arbbuy", o.BuyExchange, "BuyExchange should be correct")
+	assert.Equal(t, "arbsell", o.SellExchange, "SellExchange should be correct")
+	assert.True(t, o.Pair.Equal(btcusdPair), "Pair should be correct")
+	assert.Equal(t, 100.0, o.BuyPrice, "BuyPrice should be the best ask")
+	assert.Equal(t, 103.0, o.SellPrice, "SellPrice should be the best bid")
+	assert.InDelta(t, 1.5, o.Amount, 1e-9, "Amount should stop where fees exceed the spread")
+	assert.InDelta(t, 150.5/1.5, o.AverageBuyPrice, 1e-9, "AverageBuyPrice should be correct")
+	assert.InDelta(t, 153.5/1.5, o.AverageSellPrice, 1e-9, "AverageSellPrice should be correct")
+	assert.InDelta(t, 3, o.GrossProfit, 1e-9, "GrossProfit should be correct")
+	assert.InDelta(t, 0.304, o.TradingFees, 1e-9, "TradingFees should be correct")
+	assert.InDelta(t, 0.0005*153.5/1.5, o.WithdrawalFee, 1e-9, "WithdrawalFee should be valued at the sell price")
+	assert.InDelta(t, 3-0.304-0.0005*153.5/1.5, o.NetProfit, 1e-9, "NetProfit should be correct")
+	assert.InDelta(t, o.NetProfit/150.5*10000, o.NetSpreadBasisPoints, 1e-9, "NetSpreadBasisPoints should be correct")
+
+	select {
+	case published := <-ch:
+		assert.Equal(t, o, published, "Subscriber should receive the opportunity")
+	default:
+		t.Fatal("Subscriber must receive the opportunity")
+	}
+	events := comms.getEvents()
+	require.Len(t, events, 1, "An alert must be pushed above the threshold")
+	assert.Equal(t, "arbitrage", events[0].Type, "Event type should be correct")
+
+	// An unchanged opportunity is not published or alerted again
+	sellBook.LastUpdated = time.Now()
+	a.processOrderbook(ctx, sellBook)
+	assert.Empty(t, ch, "Subscriber should not receive an unchanged opportunity")
+	assert.Len(t, comms.getEvents(), 1, "Alert should not be pushed again")
+
+	// A changed opportunity is published but the alert is within cooldown
+	a.processOrderbook(ctx, arbitrageTestBook("arbsell", btcusdPair,
+		orderbook.Items{{Price: 103, Amount: 0.25}},
+		orderbook.Items{{Price: 104, Amount: 1}}))
+	assert.Len(t, ch, 1, "Subscriber should receive the updated opportunity")
+	assert.Len(t, comms.getEvents(), 1, "Alert should not be pushed within cooldown")
+
+	// Closing the spread removes the opportunity
+	a.processOrderbook(ctx, arbitrageTestBook("arbsell", btcusdPair,
+		orderbook.Items{{Price: 100, Amount: 1}},
+		orderbook.Items{{Price: 104, Amount: 1}}))
+	opps, err = a.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	assert.Empty(t, opps, "Opportunity should be removed once fees exceed the spread")
+}
+
+func TestArbitrageScannerNormalisesPairs(t *testing.T) {
+	t.Parallel()
+	a, comms := setupArbitrageScannerTest(t, &config.ArbitrageScanner{},
+		&arbitrageTestExchange{routeTestExchange: routeTestExchange{name: "arbxbt"}},
+		&arbitrageTestExchange{routeTestExchange: routeTestExchange{name: "arbbtc"}},
+	)
+	ctx := context.Background()
+	a.processOrderbook(ctx, arbitrageTestBook("arbxbt", currency.NewPair(currency.XBT, currency.USD),
+		orderbook.Items{{Price: 99, Amount: 1}},
+		orderbook.Items{{Price: 100, Amount: 1}}))
+	a.processOrderbook(ctx, arbitrageTestBook("arbbtc", btcusdPair,
+		orderbook.Items{{Price: 102, Amount: 1}},
+		orderbook.Items{{Price: 103, Amount: 1}}))
+	opps, err := a.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	require.Len(t, opps, 1, "XBT and BTC orderbooks must be compared")
+	assert.True(t, opps[0].Pair.Equal(btcusdPair), "Pair should be normalised")
+	assert.Equal(t, "arbxbt", opps[0].BuyExchange, "BuyExchange should be correct")
+	assert.Empty(t, comms.getEvents(), "Alerts should not be pushed without a threshold")
+
+	a.processOrderbook(ctx, arbitrageTestBook("arbbtc", btcusdPair, nil, nil))
+	a.processOrderbook(ctx, &orderbook.Base{Exchange: "arbbtc", Pair: btcusdPair, Asset: asset.Futures})
+	a.processOrderbook(ctx, nil)
+	opps, err = a.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	assert.Empty(t, opps, "Opportunity should be removed without liquidity")
+}
+
+func TestArbitrageScannerExclusions(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name      string
+		buy, sell arbitrageTestExchange
+		expected  bool
+	}{
+		{"withdrawals disabled", arbitrageTestExchange{withdrawErr: errFakeCurrencyState}, arbitrageTestExchange{}, false},
+		{"deposits disabled", arbitrageTestExchange{}, arbitrageTestExchange{depositErr: errFakeCurrencyState}, false},
+		{"state not found", arbitrageTestExchange{withdrawErr: currencystate.ErrCurrencyStateNotFound}, arbitrageTestExchange{depositErr: currencystate.ErrCurrencyStateNotFound}, true},
+		{"fee error", arbitrageTestExchange{routeTestExchange: routeTestExchange{feeErr: errFakeFee}}, arbitrageTestExchange{}, false},
+		{"withdrawal fee too high", arbitrageTestExchange{withdrawalFee: 0.1}, arbitrageTestExchange{}, false},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			buy, sell := tc.buy, tc.sell
+			buy.name, sell.name = "arbexclbuy"+tc.name, "arbexclsell"+tc.name
+			a, _ := setupArbitrageScannerTest(t, &config.ArbitrageScanner{}, &buy, &sell)
+			ctx := context.Background()
+			a.processOrderbook(ctx, arbitrageTestBook(buy.name, btcusdPair,
+				orderbook.Items{{Price: 99, Amount: 1}},
+				orderbook.Items{{Price: 100, Amount: 1}}))
+			a.processOrderbook(ctx, arbitrageTestBook(sell.name, btcusdPair,
+				orderbook.Items{{Price: 101, Amount: 1}},
+				orderbook.Items{{Price: 102, Amount: 1}}))
+			opps, err := a.GetOpportunities()
+			require.NoError(t, err, "GetOpportunities must not error")
+			if tc.expected {
+				assert.Len(t, opps, 1, "Opportunity should be found")
+			} else {
+				assert.Empty(t, opps, "Opportunity should be excluded")
+			}
+		})
+	}
+}
+
+func TestArbitrageScannerRemoveStaleBooks(t *testing.T) {
+	t.Parallel()
+	a, _ := setupArbitrageScannerTest(t, &config.ArbitrageScanner{},
+		&arbitrageTestExchange{routeTestExchange: routeTestExchange{name: "arbstalea"}},
+		&arbitrageTestExchange{routeTestExchange: routeTestExchange{name: "arbstaleb"}},
+	)
+	ctx := context.Background()
+	stale := arbitrageTestBook("arbstalea", btcusdPair,
+		orderbook.Items{{Price: 99, Amount: 1}},
+		orderbook.Items{{Price: 100, Amount: 1}})
+	a.processOrderbook(ctx, stale)
+	a.processOrderbook(ctx, arbitrageTestBook("arbstaleb", btcusdPair,
+		orderbook.Items{{Price: 102, Amount: 1}},
+		orderbook.Items{{Price: 103, Amount: 1}}))
+	opps, err := a.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	require.Len(t, opps, 1, "GetOpportunities must return the opportunity")
+
+	a.m.Lock()
+	stale.LastUpdated = time.Now().Add(-arbitrageStaleBookAge * 2)
+	expired := arbitrageKey{PairAsset: normaliseArbitragePair(btcusdPair, asset.Spot), buy: "arbstalea", sell: "arbstaleb"}
+	active := arbitrageKey{PairAsset: expired.PairAsset, buy: "arbstaleb", sell: "arbstalea"}
+	a.lastAlert[expired] = time.Now().Add(-a.alertCooldown - time.Minute)
+	a.lastAlert[active] = time.Now().Add(time.Minute)
+	a.m.Unlock()
+	a.removeStaleBooks()
+	opps, err = a.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	assert.Empty(t, opps, "Opportunities relying on stale orderbooks should be removed")
+	a.m.Lock()
+	assert.Len(t, a.books[normaliseArbitragePair(btcusdPair, asset.Spot)], 1, "Stale orderbook should be removed")
+	assert.NotContains(t, a.lastAlert, expired, "Alert past its cooldown should be removed")
+	assert.Contains(t, a.lastAlert, active, "Alert within its cooldown should be retained")
+	a.m.Unlock()
+}
@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// ArbitrageScannerName is an exported subsystem name
+const ArbitrageScannerName = "arbitrage_scanner"
+
+const (
+	// arbitrageMaxLevels limits the orderbook depth walked when calculating
+	// the executable amount of an opportunity
+	arbitrageMaxLevels = 50
+	// arbitrageStaleBookAge excludes orderbooks which have not been updated
+	// recently from being compared
+	arbitrageStaleBookAge = time.Minute
+	// arbitrageFeeCacheDuration is how long retrieved trading and withdrawal
+	// fees are reused before being fetched again
+	arbitrageFeeCacheDuration = time.Hour
+	// arbitrageSubscriberBuffer is the number of opportunities buffered for
+	// each subscriber before updates are dropped
+	arbitrageSubscriberBuffer = 100
+)
+
+// ArbitrageScanner compares orderbooks for the same pair across all enabled
+// exchanges as they update, reporting opportunities to buy on one exchange and
+// sell on another which remain profitable after trading and withdrawal fees
+type ArbitrageScanner struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	commsManager    iCommsManager
+	checkInterval   time.Duration
+	alertThreshold  float64
+	alertCooldown   time.Duration
+	verbose         bool
+
+	m             sync.Mutex
+	pipes         map[string]dispatch.Pipe
+	books         map[key.PairAsset]map[string]*orderbook.Base
+	opportunities map[arbitrageKey]ArbitrageOpportunity
+	fees          map[arbitrageFeeKey]arbitrageFee
+	lastAlert     map[arbitrageKey]time.Time
+	subscribers   map[uuid.UUID]chan ArbitrageOpportunity
+}
+
+// ArbitrageOpportunity defines the amount of a pair which can be bought on
+// one exchange and sold on another at a profit
+type ArbitrageOpportunity struct {
+	Pair         currency.Pair
+	Asset        asset.Item
+	BuyExchange  string
+	SellExchange string
+	// BuyPrice and SellPrice are the best ask and bid of each exchange
+	BuyPrice  float64
+	SellPrice float64
+	// Amount is the quantity which can be executed profitably across both
+	// orderbooks
+	Amount           float64
+	AverageBuyPrice  float64
+	AverageSellPrice float64
+	GrossProfit      float64
+	TradingFees      float64
+	// WithdrawalFee is the cost of moving the bought currency to the selling
+	// exchange, valued in the quote currency
+	WithdrawalFee float64
+	NetProfit     float64
+	// NetSpreadBasisPoints is the net profit relative to the cost of the
+	// buy side
+	NetSpreadBasisPoints float64
+	Time                 time.Time
+}
+
+// arbitrageKey uniquely identifies an opportunity
+type arbitrageKey struct {
+	key.PairAsset
+	buy  string
+	sell string
+}
+
+// arbitrageFeeKey identifies a cached fee, code is only set for withdrawal
+// fees and pair only for trading fees
+type arbitrageFeeKey struct {
+	exchange string
+	key.PairAsset
+	withdrawal bool
+}
+
+// arbitrageFee holds a cached fee or the error received retrieving it
+type arbitrageFee struct {
+	value   float64
+	err     error
+	updated time.Time
+}
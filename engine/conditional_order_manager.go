@@ -0,0 +1,759 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/conditionalorder"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupConditionalOrderManager applies configuration parameters before
+// running. The database connection manager is optional, without a connected
+// database conditional orders will not persist across restarts
+func SetupConditionalOrderManager(exchangeManager iExchangeManager, orderManager iOrderSubmitter, databaseManager iDatabaseConnectionManager, cfg *config.ConditionalOrderManager) (*ConditionalOrderManager, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", ConditionalOrderManagerName, errNilConfig)
+	}
+	var db conditionalorder.IDBService
+	if databaseManager != nil {
+		dbService, err := conditionalorder.Setup(databaseManager.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		if dbService != nil {
+			db = dbService
+		}
+	}
+	return &ConditionalOrderManager{
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		db:              db,
+		orders:          make(map[string]*ConditionalOrder),
+		verbose:         cfg.Verbose,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *ConditionalOrderManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem, restores active conditional orders from the
+// database and evaluates them against ticker and orderbook events published
+// on the event bus
+func (m *ConditionalOrderManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", ConditionalOrderManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", ConditionalOrderManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Conditional order manager %s", MsgSubSystemStarting)
+	if m.db == nil {
+		log.Warnln(log.OrderMgr, "Conditional order manager database not connected, conditional orders will not persist across restarts")
+	} else {
+		unsubmitted, err := m.loadActiveOrders()
+		if err != nil {
+			atomic.StoreInt32(&m.started, 0)
+			return err
+		}
+		m.m.Lock()
+		m.wg.Add(len(unsubmitted))
+		m.m.Unlock()
+		for i := range unsubmitted {
+			log.Warnf(log.OrderMgr, "Conditional order manager order %s was triggered without recording an exchange order, reconciling with %s",
+				unsubmitted[i].ID, unsubmitted[i].Exchange)
+			go m.resubmit(unsubmitted[i])
+		}
+	}
+	if err := m.subscribe(); err != nil {
+		log.Warnf(log.OrderMgr, "Conditional order manager unable to subscribe to the event bus, conditional orders will not trigger: %v", err)
+	}
+	log.Debugf(log.OrderMgr, "Conditional order manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem, waiting for any triggered orders to
+// finish submitting
+func (m *ConditionalOrderManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", ConditionalOrderManagerName, ErrNilSubsystem)
+	}
+	// The lock ensures no order can be triggered and added to the wait group
+	// once shutdown has begun
+	m.m.Lock()
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		m.m.Unlock()
+		return fmt.Errorf("%s %w", ConditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+	subs := m.subs
+	m.subs = nil
+	m.m.Unlock()
+	log.Debugf(log.OrderMgr, "Conditional order manager %s", MsgSubSystemShuttingDown)
+	for i := range subs {
+		if err := subs[i].Unsubscribe(); err != nil {
+			log.Errorf(log.OrderMgr, "Conditional order manager unable to unsubscribe from %s events: %v", subs[i].Topic(), err)
+		}
+	}
+	m.wg.Wait()
+	log.Debugf(log.OrderMgr, "Conditional order manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// Add validates and stores a conditional order, returning its ID
+func (m *ConditionalOrderManager) Add(o *ConditionalOrder) (string, error) {
+	if !m.IsRunning() {
+		return "", fmt.Errorf("%s %w", ConditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+	newOrder, err := m.prepare(o)
+	if err != nil {
+		return "", err
+	}
+	if err = m.persist(newOrder); err != nil {
+		return "", err
+	}
+	m.m.Lock()
+	m.orders[newOrder.ID] = newOrder
+	m.m.Unlock()
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Conditional order manager added %s %s order %s for %s %s %s trigger price %v",
+			newOrder.Side, newOrder.Type, newOrder.ID, newOrder.Exchange, newOrder.Asset, newOrder.Pair, newOrder.TriggerPrice)
+	}
+	return newOrder.ID, nil
+}
+
+// AddOCO validates and stores two conditional orders which are linked
+// together, when one is triggered the other is cancelled. Returns the OCO
+// group ID which is shared by both orders
+func (m *ConditionalOrderManager) AddOCO(first, second *ConditionalOrder) (string, error) {
+	if !m.IsRunning() {
+		return "", fmt.Errorf("%s %w", ConditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+	legOne, err := m.prepare(first)
+	if err != nil {
+		return "", err
+	}
+	legTwo, err := m.prepare(second)
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(legOne.Exchange, legTwo.Exchange) ||
+		legOne.Asset != legTwo.Asset ||
+		!legOne.Pair.Equal(legTwo.Pair) ||
+		legOne.Side != legTwo.Side {
+		return "", errOCOLegMismatch
+	}
+	group, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+	legOne.OCOGroup = group.String()
+	legTwo.OCOGroup = group.String()
+	if err = m.persist(legOne, legTwo); err != nil {
+		return "", err
+	}
+	m.m.Lock()
+	m.orders[legOne.ID] = legOne
+	m.orders[legTwo.ID] = legTwo
+	m.m.Unlock()
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Conditional order manager added OCO group %s with orders %s and %s",
+			legOne.OCOGroup, legOne.ID, legTwo.ID)
+	}
+	return legOne.OCOGroup, nil
+}
+
+// Cancel cancels an active conditional order along with any linked OCO order
+func (m *ConditionalOrderManager) Cancel(id string) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", ConditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	o, ok := m.orders[id]
+	if !ok {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s", ErrConditionalOrderNotFound, id)
+	}
+	if o.Status != ConditionalOrderActive {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s status %s", errConditionalOrderNotActive, id, o.Status)
+	}
+	now := time.Now()
+	o.Status = ConditionalOrderCancelled
+	o.Reason = "cancelled by user"
+	o.UpdatedDate = now
+	cancelled := []*ConditionalOrder{o.copy()}
+	for _, sibling := range m.getActiveSiblings(o) {
+		sibling.Status = ConditionalOrderCancelled
+		sibling.Reason = "OCO linked order " + o.ID + " cancelled"
+		sibling.UpdatedDate = now
+		cancelled = append(cancelled, sibling.copy())
+	}
+	m.m.Unlock()
+	return m.persist(cancelled...)
+}
+
+// GetOrder returns a copy of a conditional order by its ID
+func (m *ConditionalOrderManager) GetOrder(id string) (*ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", ConditionalOrderManagerName, ErrNilSubsystem)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	o, ok := m.orders[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrConditionalOrderNotFound, id)
+	}
+	return o.copy(), nil
+}
+
+// GetActiveOrders returns copies of all conditional orders awaiting their
+// trigger price ordered by creation date
+func (m *ConditionalOrderManager) GetActiveOrders() ([]ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", ConditionalOrderManagerName, ErrNilSubsystem)
+	}
+	m.m.Lock()
+	resp := make([]ConditionalOrder, 0, len(m.orders))
+	for _, o := range m.orders {
+		if o.Status == ConditionalOrderActive {
+			resp = append(resp, *o)
+		}
+	}
+	m.m.Unlock()
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CreatedDate.Before(resp[j].CreatedDate)
+	})
+	return resp, nil
+}
+
+// ProcessTicker evaluates conditional orders against a ticker update. The last
+// traded price is used when available, otherwise the side of the book the
+// triggered order would execute against
+func (m *ConditionalOrderManager) ProcessTicker(t *ticker.Price) error {
+	if !m.IsRunning() {
+		return nil
+	}
+	if t == nil {
+		return errNilTicker
+	}
+	return m.evaluate(t.ExchangeName, t.AssetType, t.Pair, func(s order.Side) float64 {
+		if t.Last > 0 {
+			return t.Last
+		}
+		if s.IsLong() {
+			return t.Ask
+		}
+		return t.Bid
+	})
+}
+
+// ProcessOrderbook evaluates conditional orders against an orderbook update
+// using the best price on the side of the book the triggered order would
+// execute against
+func (m *ConditionalOrderManager) ProcessOrderbook(b *orderbook.Base) error {
+	if !m.IsRunning() {
+		return nil
+	}
+	if b == nil {
+		return errNilOrderbook
+	}
+	return m.evaluate(b.Exchange, b.Asset, b.Pair, func(s order.Side) float64 {
+		if s.IsLong() {
+			if len(b.Asks) == 0 {
+				return 0
+			}
+			return b.Asks[0].Price
+		}
+		if len(b.Bids) == 0 {
+			return 0
+		}
+		return b.Bids[0].Price
+	})
+}
+
+// subscribe consumes ticker and orderbook events from the event bus until
+// the subscriptions are released on shutdown. Only the latest prices matter
+// when evaluating triggers so the oldest events are dropped when behind
+func (m *ConditionalOrderManager) subscribe() error {
+	opts := &eventbus.SubscribeOptions{DropPolicy: eventbus.DropOldest}
+	tickers, err := eventbus.Subscribe(eventbus.TickerTopic, opts)
+	if err != nil {
+		return err
+	}
+	orderbooks, err := eventbus.Subscribe(eventbus.OrderbookTopic, opts)
+	if err != nil {
+		return common.AppendError(err, tickers.Unsubscribe())
+	}
+	m.m.Lock()
+	m.subs = []*eventbus.Subscription{tickers, orderbooks}
+	m.wg.Add(1)
+	m.m.Unlock()
+	go m.consume(tickers.C(), orderbooks.C())
+	return nil
+}
+
+// consume evaluates conditional orders against events until both channels
+// are closed
+func (m *ConditionalOrderManager) consume(tickers, orderbooks <-chan *eventbus.Event) {
+	defer m.wg.Done()
+	for tickers != nil || orderbooks != nil {
+		var err error
+		select {
+		case e, ok := <-tickers:
+			if !ok {
+				tickers = nil
+				continue
+			}
+			err = m.ProcessTicker(e.Ticker)
+		case e, ok := <-orderbooks:
+			if !ok {
+				orderbooks = nil
+				continue
+			}
+			err = m.ProcessOrderbook(e.Orderbook)
+		}
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Conditional order manager unable to process event: %v", err)
+		}
+	}
+}
+
+// evaluate checks all active orders for the exchange, asset and pair against
+// the supplied price, submitting orders which have met their trigger price
+func (m *ConditionalOrderManager) evaluate(exchName string, a asset.Item, p currency.Pair, priceBySide func(order.Side) float64) error {
+	var triggered, updated []*ConditionalOrder
+	m.m.Lock()
+	if atomic.LoadInt32(&m.started) != 1 {
+		m.m.Unlock()
+		return nil
+	}
+	for _, o := range m.orders {
+		if o.Status != ConditionalOrderActive ||
+			o.Asset != a ||
+			!strings.EqualFold(o.Exchange, exchName) ||
+			!o.Pair.Equal(p) {
+			continue
+		}
+		price := priceBySide(o.Side)
+		if price <= 0 {
+			continue
+		}
+		trailed := o.updateTrailingStop(price)
+		if !o.isTriggered(price) {
+			if trailed {
+				updated = append(updated, o.copy())
+			}
+			continue
+		}
+		now := time.Now()
+		o.Status = ConditionalOrderTriggered
+		o.UpdatedDate = now
+		triggered = append(triggered, o.copy())
+		for _, sibling := range m.getActiveSiblings(o) {
+			sibling.Status = ConditionalOrderCancelled
+			sibling.Reason = "OCO linked order " + o.ID + " triggered"
+			sibling.UpdatedDate = now
+			updated = append(updated, sibling.copy())
+		}
+		if m.verbose {
+			log.Debugf(log.OrderMgr, "Conditional order manager %s %s order %s triggered at price %v",
+				o.Side, o.Type, o.ID, price)
+		}
+	}
+	m.wg.Add(len(triggered))
+	m.m.Unlock()
+
+	err := m.persist(append(updated, triggered...)...)
+	for i := range triggered {
+		go m.submit(triggered[i])
+	}
+	return err
+}
+
+// submit sends the underlying market or limit order of a triggered
+// conditional order through the order manager. The conditional order ID is
+// used as the client order ID so an interrupted submission can be reconciled
+func (m *ConditionalOrderManager) submit(o *ConditionalOrder) {
+	defer m.wg.Done()
+	s := &order.Submit{
+		Exchange:      o.Exchange,
+		Type:          order.Market,
+		Side:          o.Side,
+		Pair:          o.Pair,
+		AssetType:     o.Asset,
+		Amount:        o.Amount,
+		ClientOrderID: o.ID,
+	}
+	if o.LimitPrice > 0 && o.Type != order.StopMarket && o.Type != order.TakeProfitMarket {
+		s.Type = order.Limit
+		s.Price = o.LimitPrice
+	}
+	resp, err := m.orderManager.Submit(context.TODO(), s)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Conditional order manager failed to submit %s order %s for %s %s %s: %v",
+			o.Type, o.ID, o.Exchange, o.Asset, o.Pair, err)
+		m.record(o.ID, "", err)
+		return
+	}
+	var orderID string
+	if resp != nil && resp.Detail != nil {
+		orderID = resp.OrderID
+	}
+	log.Infof(log.OrderMgr, "Conditional order manager submitted %s %s order for %s %s %s, conditional order %s exchange order ID %s",
+		s.Side, s.Type, o.Exchange, o.Asset, o.Pair, o.ID, orderID)
+	m.record(o.ID, orderID, nil)
+}
+
+// resubmit reconciles a triggered order which was interrupted before its
+// exchange order was recorded. The order is only submitted again when the
+// exchange holds no order carrying its client order ID, if the exchange
+// cannot be checked the order fails rather than risk submitting it twice
+func (m *ConditionalOrderManager) resubmit(o *ConditionalOrder) {
+	orderID, err := m.findSubmittedOrder(context.TODO(), o)
+	switch {
+	case err != nil:
+		err = fmt.Errorf("%w: %v", errConditionalOrderReconcile, err)
+		log.Errorf(log.OrderMgr, "Conditional order manager order %s for %s %s %s: %v", o.ID, o.Exchange, o.Asset, o.Pair, err)
+		m.record(o.ID, "", err)
+	case orderID != "":
+		log.Infof(log.OrderMgr, "Conditional order manager found exchange order ID %s for conditional order %s on %s",
+			orderID, o.ID, o.Exchange)
+		m.record(o.ID, orderID, nil)
+	default:
+		m.submit(o)
+		return
+	}
+	m.wg.Done()
+}
+
+// findSubmittedOrder searches the exchange's open orders and order history
+// since the order was triggered for an order carrying the conditional order ID
+// as its client order ID. Returns an empty order ID if none is found
+func (m *ConditionalOrderManager) findSubmittedOrder(ctx context.Context, o *ConditionalOrder) (string, error) {
+	exch, err := m.exchangeManager.GetExchangeByName(o.Exchange)
+	if err != nil {
+		return "", err
+	}
+	req := &order.MultiOrderRequest{
+		Pairs:     currency.Pairs{o.Pair},
+		AssetType: o.Asset,
+		Type:      order.AnyType,
+		Side:      order.AnySide,
+	}
+	active, err := exch.GetActiveOrders(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if orderID := matchClientOrderID(active, o.ID); orderID != "" {
+		return orderID, nil
+	}
+	req.StartTime = o.UpdatedDate.Add(-time.Minute)
+	req.EndTime = time.Now()
+	history, err := exch.GetOrderHistory(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return matchClientOrderID(history, o.ID), nil
+}
+
+// record stores the outcome of submitting a triggered conditional order
+func (m *ConditionalOrderManager) record(id, orderID string, err error) {
+	m.m.Lock()
+	stored, ok := m.orders[id]
+	if !ok {
+		m.m.Unlock()
+		return
+	}
+	if err != nil {
+		stored.Status = ConditionalOrderFailed
+		stored.Reason = err.Error()
+	} else {
+		stored.OrderID = orderID
+	}
+	stored.UpdatedDate = time.Now()
+	result := stored.copy()
+	m.m.Unlock()
+
+	if err = m.persist(result); err != nil {
+		log.Errorf(log.OrderMgr, "Conditional order manager failed to store order %s: %v", result.ID, err)
+	}
+}
+
+// prepare validates a conditional order and returns a copy ready to be stored
+func (m *ConditionalOrderManager) prepare(o *ConditionalOrder) (*ConditionalOrder, error) {
+	if o == nil {
+		return nil, errNilConditionalOrder
+	}
+	if _, err := m.exchangeManager.GetExchangeByName(o.Exchange); err != nil {
+		return nil, err
+	}
+	if o.Pair.IsEmpty() {
+		return nil, order.ErrPairIsEmpty
+	}
+	if !o.Asset.IsValid() {
+		return nil, fmt.Errorf("'%s' %w", o.Asset, asset.ErrNotSupported)
+	}
+	if !order.IsValidOrderSubmissionSide(o.Side) {
+		return nil, fmt.Errorf("%w %v", order.ErrSideIsInvalid, o.Side)
+	}
+	if o.Amount <= 0 {
+		return nil, fmt.Errorf("%w %v", order.ErrAmountIsInvalid, o.Amount)
+	}
+	switch o.Type {
+	case order.Stop, order.StopMarket, order.TakeProfit, order.TakeProfitMarket:
+		if o.TriggerPrice <= 0 {
+			return nil, errTriggerPriceRequired
+		}
+	case order.StopLimit:
+		if o.TriggerPrice <= 0 {
+			return nil, errTriggerPriceRequired
+		}
+		if o.LimitPrice <= 0 {
+			return nil, errLimitPriceRequired
+		}
+	case order.TrailingStop:
+		if o.TrailingDistance <= 0 && o.TrailingPercentage <= 0 {
+			return nil, errTrailingOffsetRequired
+		}
+		if o.TrailingDistance > 0 && o.TrailingPercentage > 0 {
+			return nil, errTrailingOffsetConflict
+		}
+		if o.TriggerPrice < 0 {
+			return nil, errTriggerPriceRequired
+		}
+	default:
+		return nil, fmt.Errorf("%w, received: %s", errConditionalOrderTypeInvalid, o.Type)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &ConditionalOrder{
+		ID:                 id.String(),
+		Exchange:           strings.ToLower(o.Exchange),
+		Asset:              o.Asset,
+		Pair:               o.Pair,
+		Type:               o.Type,
+		Side:               o.Side,
+		Amount:             o.Amount,
+		TriggerPrice:       o.TriggerPrice,
+		LimitPrice:         o.LimitPrice,
+		TrailingDistance:   o.TrailingDistance,
+		TrailingPercentage: o.TrailingPercentage,
+		Status:             ConditionalOrderActive,
+		CreatedDate:        now,
+		UpdatedDate:        now,
+	}, nil
+}
+
+// getActiveSiblings returns the active orders linked to the order through an
+// OCO group. Must be called with the lock held
+func (m *ConditionalOrderManager) getActiveSiblings(o *ConditionalOrder) []*ConditionalOrder {
+	if o.OCOGroup == "" {
+		return nil
+	}
+	var siblings []*ConditionalOrder
+	for _, sibling := range m.orders {
+		if sibling.ID != o.ID &&
+			sibling.OCOGroup == o.OCOGroup &&
+			sibling.Status == ConditionalOrderActive {
+			siblings = append(siblings, sibling)
+		}
+	}
+	return siblings
+}
+
+// loadActiveOrders restores active conditional orders from the database along
+// with triggered orders which were interrupted before their exchange order was
+// recorded. Returns copies of the triggered orders to be submitted again
+func (m *ConditionalOrderManager) loadActiveOrders() ([]*ConditionalOrder, error) {
+	stored, err := m.db.GetByStatus(string(ConditionalOrderActive))
+	if err != nil {
+		return nil, err
+	}
+	triggered, err := m.db.GetByStatus(string(ConditionalOrderTriggered))
+	if err != nil {
+		return nil, err
+	}
+	for i := range triggered {
+		if triggered[i].OrderID == "" {
+			stored = append(stored, triggered[i])
+		}
+	}
+	var restored int
+	var unsubmitted []*ConditionalOrder
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range stored {
+		var o *ConditionalOrder
+		o, err = conditionalOrderFromDB(&stored[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Conditional order manager unable to restore order %s: %v", stored[i].ID, err)
+			continue
+		}
+		m.orders[o.ID] = o
+		restored++
+		if o.Status == ConditionalOrderTriggered {
+			unsubmitted = append(unsubmitted, o.copy())
+		}
+	}
+	if restored > 0 {
+		log.Infof(log.OrderMgr, "Conditional order manager restored %d active and unsubmitted triggered conditional orders", restored)
+	}
+	return unsubmitted, nil
+}
+
+// persist stores conditional orders in the database when connected
+func (m *ConditionalOrderManager) persist(orders ...*ConditionalOrder) error {
+	if m.db == nil || len(orders) == 0 {
+		return nil
+	}
+	dbOrders := make([]*conditionalorder.ConditionalOrder, len(orders))
+	for i := range orders {
+		dbOrders[i] = &conditionalorder.ConditionalOrder{
+			ID:                 orders[i].ID,
+			Exchange:           orders[i].Exchange,
+			Asset:              orders[i].Asset.String(),
+			Base:               orders[i].Pair.Base.String(),
+			Quote:              orders[i].Pair.Quote.String(),
+			OrderType:          orders[i].Type.String(),
+			Side:               orders[i].Side.String(),
+			Amount:             orders[i].Amount,
+			TriggerPrice:       orders[i].TriggerPrice,
+			LimitPrice:         orders[i].LimitPrice,
+			TrailingDistance:   orders[i].TrailingDistance,
+			TrailingPercentage: orders[i].TrailingPercentage,
+			WatermarkPrice:     orders[i].WatermarkPrice,
+			OCOGroup:           orders[i].OCOGroup,
+			Status:             string(orders[i].Status),
+			OrderID:            orders[i].OrderID,
+			Reason:             orders[i].Reason,
+			CreatedDate:        orders[i].CreatedDate,
+			UpdatedDate:        orders[i].UpdatedDate,
+		}
+	}
+	return m.db.Upsert(dbOrders...)
+}
+
+// conditionalOrderFromDB converts a database conditional order
+func conditionalOrderFromDB(o *conditionalorder.ConditionalOrder) (*ConditionalOrder, error) {
+	a, err := asset.New(o.Asset)
+	if err != nil {
+		return nil, err
+	}
+	oType, err := order.StringToOrderType(o.OrderType)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(o.Side)
+	if err != nil {
+		return nil, err
+	}
+	return &ConditionalOrder{
+		ID:                 o.ID,
+		Exchange:           o.Exchange,
+		Asset:              a,
+		Pair:               currency.NewPair(currency.NewCode(o.Base), currency.NewCode(o.Quote)),
+		Type:               oType,
+		Side:               side,
+		Amount:             o.Amount,
+		TriggerPrice:       o.TriggerPrice,
+		LimitPrice:         o.LimitPrice,
+		TrailingDistance:   o.TrailingDistance,
+		TrailingPercentage: o.TrailingPercentage,
+		WatermarkPrice:     o.WatermarkPrice,
+		OCOGroup:           o.OCOGroup,
+		Status:             ConditionalOrderStatus(o.Status),
+		OrderID:            o.OrderID,
+		Reason:             o.Reason,
+		CreatedDate:        o.CreatedDate,
+		UpdatedDate:        o.UpdatedDate,
+	}, nil
+}
+
+// updateTrailingStop moves the trigger price of a trailing stop as the market
+// moves in favour of the position. Returns true if the order was updated
+func (o *ConditionalOrder) updateTrailingStop(price float64) bool {
+	if o.Type != order.TrailingStop {
+		return false
+	}
+	isLong := o.Side.IsLong()
+	if o.WatermarkPrice > 0 &&
+		((isLong && price >= o.WatermarkPrice) || (!isLong && price <= o.WatermarkPrice)) {
+		return false
+	}
+	o.WatermarkPrice = price
+	offset := o.TrailingDistance
+	if o.TrailingPercentage > 0 {
+		offset = price * o.TrailingPercentage / 100
+	}
+	trigger := price - offset
+	if isLong {
+		trigger = price + offset
+	}
+	// A trailing stop only ever tightens, an initial trigger price is kept
+	// until the market moves far enough to improve it
+	if o.TriggerPrice == 0 || (isLong && trigger < o.TriggerPrice) || (!isLong && trigger > o.TriggerPrice) {
+		o.TriggerPrice = trigger
+	}
+	o.UpdatedDate = time.Now()
+	return true
+}
+
+// isTriggered returns whether the price has reached the trigger price. Stops
+// trigger when the market moves against the order side, take profits when it
+// moves in favour
+func (o *ConditionalOrder) isTriggered(price float64) bool {
+	switch o.Type {
+	case order.Stop, order.StopMarket, order.StopLimit, order.TrailingStop:
+		if o.Side.IsLong() {
+			return price >= o.TriggerPrice
+		}
+		return price <= o.TriggerPrice
+	case order.TakeProfit, order.TakeProfitMarket:
+		if o.Side.IsLong() {
+			return price <= o.TriggerPrice
+		}
+		return price >= o.TriggerPrice
+	}
+	return false
+}
+
+// matchClientOrderID returns the exchange order ID of the order carrying the
+// client order ID
+func matchClientOrderID(orders []order.Detail, clientOrderID string) string {
+	for i := range orders {
+		if orders[i].ClientOrderID == clientOrderID && orders[i].OrderID != "" {
+			return orders[i].OrderID
+		}
+	}
+	return ""
+}
+
+// copy returns a copy of the conditional order
+func (o *ConditionalOrder) copy() *ConditionalOrder {
+	c := *o
+	return &c
+}
@@ -0,0 +1,544 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/conditionalorder"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var errFakeSubmit = errors.New("fake submission failure")
+
+// fakeOrderSubmitter records submitted orders
+type fakeOrderSubmitter struct {
+	m         sync.Mutex
+	submitted []order.Submit
+	fail      bool
+}
+
+func (f *fakeOrderSubmitter) IsRunning() bool {
+	return true
+}
+
+func (f *fakeOrderSubmitter) Submit(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.fail {
+		return nil, errFakeSubmit
+	}
+	f.submitted = append(f.submitted, *s)
+	return &OrderSubmitResponse{Detail: &order.Detail{OrderID: "1337"}}, nil
+}
+
+func (f *fakeOrderSubmitter) getSubmitted() []order.Submit {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]order.Submit(nil), f.submitted...)
+}
+
+// fakeConditionalOrderDB stores conditional orders in memory
+type fakeConditionalOrderDB struct {
+	m      sync.Mutex
+	orders map[string]conditionalorder.ConditionalOrder
+}
+
+func (f *fakeConditionalOrderDB) Upsert(orders ...*conditionalorder.ConditionalOrder) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	for i := range orders {
+		f.orders[orders[i].ID] = *orders[i]
+	}
+	return nil
+}
+
+func (f *fakeConditionalOrderDB) GetByID(id string) (*conditionalorder.ConditionalOrder, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	o, ok := f.orders[id]
+	if !ok {
+		return nil, ErrConditionalOrderNotFound
+	}
+	return &o, nil
+}
+
+func (f *fakeConditionalOrderDB) GetByStatus(status string) ([]conditionalorder.ConditionalOrder, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	var resp []conditionalorder.ConditionalOrder
+	for _, o := range f.orders {
+		if o.Status == status {
+			resp = append(resp, o)
+		}
+	}
+	return resp, nil
+}
+
+// conditionalTestExchange returns stored orders when reconciling interrupted
+// submissions
+type conditionalTestExchange struct {
+	exchange.IBotExchange
+	active  []order.Detail
+	history []order.Detail
+	err     error
+}
+
+func (c *conditionalTestExchange) GetActiveOrders(context.Context, *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	return c.active, c.err
+}
+
+func (c *conditionalTestExchange) GetOrderHistory(context.Context, *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	return c.history, c.err
+}
+
+func setupConditionalOrderTest(t *testing.T, submitter iOrderSubmitter) *ConditionalOrderManager {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	m, err := SetupConditionalOrderManager(em, submitter, nil, &config.ConditionalOrderManager{})
+	require.NoError(t, err, "SetupConditionalOrderManager must not error")
+	m.db = &fakeConditionalOrderDB{orders: make(map[string]conditionalorder.ConditionalOrder)}
+	require.NoError(t, m.Start(), "Start must not error")
+	return m
+}
+
+func conditionalTestOrder(oType order.Type, side order.Side, trigger float64) *ConditionalOrder {
+	return &ConditionalOrder{
+		Exchange:     testExchange,
+		Asset:        asset.Spot,
+		Pair:         btcusdPair,
+		Type:         oType,
+		Side:         side,
+		Amount:       1,
+		TriggerPrice: trigger,
+	}
+}
+
+func conditionalTestTicker(last float64) *ticker.Price {
+	return &ticker.Price{
+		ExchangeName: testExchange,
+		AssetType:    asset.Spot,
+		Pair:         btcusdPair,
+		Last:         last,
+	}
+}
+
+func TestSetupConditionalOrderManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupConditionalOrderManager(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupConditionalOrderManager should error on nil exchange manager")
+	_, err = SetupConditionalOrderManager(NewExchangeManager(), nil, nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager, "SetupConditionalOrderManager should error on nil order manager")
+	_, err = SetupConditionalOrderManager(NewExchangeManager(), &fakeOrderSubmitter{}, nil, nil)
+	assert.ErrorIs(t, err, errNilConfig, "SetupConditionalOrderManager should error on nil config")
+	m, err := SetupConditionalOrderManager(NewExchangeManager(), &fakeOrderSubmitter{}, &DatabaseConnectionManager{}, &config.ConditionalOrderManager{})
+	require.NoError(t, err, "SetupConditionalOrderManager must not error")
+	assert.Nil(t, m.db, "db should be nil without a connected database")
+}
+
+func TestConditionalOrderManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *ConditionalOrderManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem, "Start should error on nil subsystem")
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem, "Stop should error on nil subsystem")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+
+	m, err := SetupConditionalOrderManager(NewExchangeManager(), &fakeOrderSubmitter{}, nil, &config.ConditionalOrderManager{})
+	require.NoError(t, err, "SetupConditionalOrderManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted, "Stop should error when not started")
+	_, err = m.Add(conditionalTestOrder(order.Stop, order.Sell, 90))
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "Add should error when not started")
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted, "Start should error when already started")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	require.NoError(t, m.Stop(), "Stop must not error")
+}
+
+func TestConditionalOrderManagerStopWhileTriggering(t *testing.T) {
+	t.Parallel()
+	f := &fakeOrderSubmitter{}
+	m := setupConditionalOrderTest(t, f)
+	for i := 0; i < 20; i++ {
+		_, err := m.Add(conditionalTestOrder(order.Stop, order.Sell, 90))
+		require.NoError(t, err, "Add must not error")
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			assert.NoError(t, m.evaluate(testExchange, asset.Spot, btcusdPair, func(order.Side) float64 { return 85 }), "evaluate should not error")
+		}
+	}()
+	require.NoError(t, m.Stop(), "Stop must not error")
+	submitted := len(f.getSubmitted())
+	wg.Wait()
+	assert.Len(t, f.getSubmitted(), submitted, "Orders should not be submitted once stopped")
+}
+
+func TestConditionalOrderManagerAdd(t *testing.T) {
+	t.Parallel()
+	m := setupConditionalOrderTest(t, &fakeOrderSubmitter{})
+
+	_, err := m.Add(nil)
+	assert.ErrorIs(t, err, errNilConditionalOrder, "Add should error on nil order")
+
+	o := conditionalTestOrder(order.Stop, order.Sell, 90)
+	o.Exchange = "bruh"
+	_, err = m.Add(o)
+	assert.ErrorIs(t, err, ErrExchangeNotFound, "Add should error on unknown exchange")
+
+	o = conditionalTestOrder(order.Stop, order.Sell, 90)
+	o.Amount = 0
+	_, err = m.Add(o)
+	assert.ErrorIs(t, err, order.ErrAmountIsInvalid, "Add should error on zero amount")
+
+	_, err = m.Add(conditionalTestOrder(order.Stop, order.AnySide, 90))
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid, "Add should error on invalid side")
+
+	_, err = m.Add(conditionalTestOrder(order.Limit, order.Sell, 90))
+	assert.ErrorIs(t, err, errConditionalOrderTypeInvalid, "Add should error on unsupported type")
+
+	_, err = m.Add(conditionalTestOrder(order.Stop, order.Sell, 0))
+	assert.ErrorIs(t, err, errTriggerPriceRequired, "Add should error without trigger price")
+
+	_, err = m.Add(conditionalTestOrder(order.StopLimit, order.Sell, 90))
+	assert.ErrorIs(t, err, errLimitPriceRequired, "Add should error without limit price")
+
+	_, err = m.Add(conditionalTestOrder(order.TrailingStop, order.Sell, 0))
+	assert.ErrorIs(t, err, errTrailingOffsetRequired, "Add should error without trailing offset")
+
+	o = conditionalTestOrder(order.TrailingStop, order.Sell, 0)
+	o.TrailingDistance = 1
+	o.TrailingPercentage = 1
+	_, err = m.Add(o)
+	assert.ErrorIs(t, err, errTrailingOffsetConflict, "Add should error with both trailing offsets")
+
+	id, err := m.Add(conditionalTestOrder(order.Stop, order.Sell, 90))
+	require.NoError(t, err, "Add must not error")
+	stored, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ConditionalOrderActive, stored.Status, "Status should be active")
+	dbOrder, err := m.db.GetByID(id)
+	require.NoError(t, err, "GetByID must not error")
+	assert.Equal(t, "STOP", dbOrder.OrderType, "OrderType should be stored")
+
+	active, err := m.GetActiveOrders()
+	require.NoError(t, err, "GetActiveOrders must not error")
+	assert.Len(t, active, 1, "GetActiveOrders should return the order")
+
+	_, err = m.GetOrder("bruh")
+	assert.ErrorIs(t, err, ErrConditionalOrderNotFound, "GetOrder should error on unknown ID")
+}
+
+func TestConditionalOrderManagerProcessTicker(t *testing.T) {
+	t.Parallel()
+	f := &fakeOrderSubmitter{}
+	m := setupConditionalOrderTest(t, f)
+
+	stopID, err := m.Add(conditionalTestOrder(order.Stop, order.Sell, 90))
+	require.NoError(t, err, "Add must not error")
+	takeProfitID, err := m.Add(conditionalTestOrder(order.TakeProfit, order.Sell, 110))
+	require.NoError(t, err, "Add must not error")
+	stopLimit := conditionalTestOrder(order.StopLimit, order.Buy, 105)
+	stopLimit.LimitPrice = 106
+	stopLimitID, err := m.Add(stopLimit)
+	require.NoError(t, err, "Add must not error")
+
+	assert.ErrorIs(t, m.ProcessTicker(nil), errNilTicker, "ProcessTicker should error on nil ticker")
+	require.NoError(t, m.ProcessTicker(conditionalTestTicker(100)), "ProcessTicker must not error")
+	other := conditionalTestTicker(50)
+	other.AssetType = asset.Futures
+	require.NoError(t, m.ProcessTicker(other), "ProcessTicker must not error")
+	m.wg.Wait()
+	assert.Empty(t, f.getSubmitted(), "No orders should be submitted before trigger price")
+
+	require.NoError(t, m.ProcessTicker(conditionalTestTicker(89)), "ProcessTicker must not error")
+	m.wg.Wait()
+	submitted := f.getSubmitted()
+	require.Len(t, submitted, 1, "Stop order must be submitted")
+	assert.Equal(t, order.Market, submitted[0].Type, "Stop should submit a market order")
+	assert.Equal(t, order.Sell, submitted[0].Side, "Stop should submit a sell order")
+	stored, err := m.GetOrder(stopID)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ConditionalOrderTriggered, stored.Status, "Status should be triggered")
+	assert.Equal(t, "1337", stored.OrderID, "OrderID should be set from the submission")
+
+	require.NoError(t, m.ProcessTicker(conditionalTestTicker(110)), "ProcessTicker must not error")
+	m.wg.Wait()
+	submitted = f.getSubmitted()
+	require.Len(t, submitted, 3, "Take profit and stop limit orders must be submitted")
+	for i := range submitted[1:] {
+		if submitted[i+1].Side == order.Buy {
+			assert.Equal(t, order.Limit, submitted[i+1].Type, "Stop limit should submit a limit order")
+			assert.Equal(t, 106.0, submitted[i+1].Price, "Stop limit should submit at the limit price")
+		}
+	}
+	for _, id := range []string{takeProfitID, stopLimitID} {
+		stored, err = m.GetOrder(id)
+		require.NoError(t, err, "GetOrder must not error")
+		assert.Equal(t, ConditionalOrderTriggered, stored.Status, "Status should be triggered")
+	}
+
+	f.fail = true
+	failID, err := m.Add(conditionalTestOrder(order.Stop, order.Sell, 100))
+	require.NoError(t, err, "Add must not error")
+	require.NoError(t, m.ProcessTicker(conditionalTestTicker(99)), "ProcessTicker must not error")
+	m.wg.Wait()
+	stored, err = m.GetOrder(failID)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ConditionalOrderFailed, stored.Status, "Status should be failed")
+	assert.Equal(t, errFakeSubmit.Error(), stored.Reason, "Reason should contain the submission error")
+}
+
+func TestConditionalOrderManagerProcessOrderbook(t *testing.T) {
+	t.Parallel()
+	f := &fakeOrderSubmitter{}
+	m := setupConditionalOrderTest(t, f)
+	_, err := m.Add(conditionalTestOrder(order.Stop, order.Buy, 105))
+	require.NoError(t, err, "Add must not error")
+
+	assert.ErrorIs(t, m.ProcessOrderbook(nil), errNilOrderbook, "ProcessOrderbook should error on nil orderbook")
+	book := &orderbook.Base{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     btcusdPair,
+		Bids:     orderbook.Items{{Price: 106, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 104, Amount: 1}},
+	}
+	require.NoError(t, m.ProcessOrderbook(book), "ProcessOrderbook must not error")
+	m.wg.Wait()
+	assert.Empty(t, f.getSubmitted(), "Buy stop should use the best ask")
+
+	book.Asks[0].Price = 105
+	require.NoError(t, m.ProcessOrderbook(book), "ProcessOrderbook must not error")
+	m.wg.Wait()
+	assert.Len(t, f.getSubmitted(), 1, "Buy stop should trigger at the best ask")
+}
+
+func TestConditionalOrderManagerTrailingStop(t *testing.T) {
+	t.Parallel()
+	f := &fakeOrderSubmitter{}
+	m := setupConditionalOrderTest(t, f)
+	o := conditionalTestOrder(order.TrailingStop, order.Sell, 0)
+	o.TrailingDistance = 5
+	id, err := m.Add(o)
+	require.NoError(t, err, "Add must not error")
+
+	for _, price := range []float64{100, 110, 107} {
+		require.NoError(t, m.ProcessTicker(conditionalTestTicker(price)), "ProcessTicker must not error")
+	}
+	m.wg.Wait()
+	assert.Empty(t, f.getSubmitted(), "Trailing stop should not trigger within the trailing distance")
+	stored, err := m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, 110.0, stored.WatermarkPrice, "WatermarkPrice should track the highest price")
+	assert.Equal(t, 105.0, stored.TriggerPrice, "TriggerPrice should trail the highest price")
+	dbOrder, err := m.db.GetByID(id)
+	require.NoError(t, err, "GetByID must not error")
+	assert.Equal(t, 110.0, dbOrder.WatermarkPrice, "WatermarkPrice should be stored")
+
+	require.NoError(t, m.ProcessTicker(conditionalTestTicker(105)), "ProcessTicker must not error")
+	m.wg.Wait()
+	assert.Len(t, f.getSubmitted(), 1, "Trailing stop should trigger")
+
+	o = conditionalTestOrder(order.TrailingStop, order.Buy, 0)
+	o.TrailingPercentage = 10
+	id, err = m.Add(o)
+	require.NoError(t, err, "Add must not error")
+	for _, price := range []float64{100, 90, 95} {
+		require.NoError(t, m.ProcessTicker(conditionalTestTicker(price)), "ProcessTicker must not error")
+	}
+	stored, err = m.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, 90.0, stored.WatermarkPrice, "WatermarkPrice should track the lowest price")
+	assert.Equal(t, 99.0, stored.TriggerPrice, "TriggerPrice should trail the lowest price by percentage")
+}
+
+func TestConditionalOrderManagerOCO(t *testing.T) {
+	t.Parallel()
+	f := &fakeOrderSubmitter{}
+	m := setupConditionalOrderTest(t, f)
+
+	_, err := m.AddOCO(conditionalTestOrder(order.TakeProfit, order.Sell, 110), conditionalTestOrder(order.Stop, order.Buy, 90))
+	assert.ErrorIs(t, err, errOCOLegMismatch, "AddOCO should error on mismatched sides")
+
+	_, err = m.AddOCO(conditionalTestOrder(order.TakeProfit, order.Sell, 110), nil)
+	assert.ErrorIs(t, err, errNilConditionalOrder, "AddOCO should error on nil leg")
+
+	group, err := m.AddOCO(conditionalTestOrder(order.TakeProfit, order.Sell, 110), conditionalTestOrder(order.Stop, order.Sell, 90))
+	require.NoError(t, err, "AddOCO must not error")
+	active, err := m.GetActiveOrders()
+	require.NoError(t, err, "GetActiveOrders must not error")
+	require.Len(t, active, 2, "GetActiveOrders must return both legs")
+	for i := range active {
+		assert.Equal(t, group, active[i].OCOGroup, "OCOGroup should be shared")
+	}
+
+	require.NoError(t, m.ProcessTicker(conditionalTestTicker(111)), "ProcessTicker must not error")
+	m.wg.Wait()
+	assert.Len(t, f.getSubmitted(), 1, "Only the take profit leg should be submitted")
+	for i := range active {
+		stored, err := m.GetOrder(active[i].ID)
+		require.NoError(t, err, "GetOrder must not error")
+		if stored.Type == order.Stop {
+			assert.Equal(t, ConditionalOrderCancelled, stored.Status, "Stop leg should be cancelled")
+		} else {
+			assert.Equal(t, ConditionalOrderTriggered, stored.Status, "Take profit leg should be triggered")
+		}
+	}
+
+	require.NoError(t, m.ProcessTicker(conditionalTestTicker(80)), "ProcessTicker must not error")
+	m.wg.Wait()
+	assert.Len(t, f.getSubmitted(), 1, "Cancelled stop leg should not be submitted")
+}
+
+func TestConditionalOrderManagerCancel(t *testing.T) {
+	t.Parallel()
+	m := setupConditionalOrderTest(t, &fakeOrderSubmitter{})
+	assert.ErrorIs(t, m.Cancel("bruh"), ErrConditionalOrderNotFound, "Cancel should error on unknown ID")
+
+	_, err := m.AddOCO(conditionalTestOrder(order.TakeProfit, order.Sell, 110), conditionalTestOrder(order.Stop, order.Sell, 90))
+	require.NoError(t, err, "AddOCO must not error")
+	active, err := m.GetActiveOrders()
+	require.NoError(t, err, "GetActiveOrders must not error")
+	require.Len(t, active, 2, "GetActiveOrders must return both legs")
+
+	require.NoError(t, m.Cancel(active[0].ID), "Cancel must not error")
+	assert.ErrorIs(t, m.Cancel(active[0].ID), errConditionalOrderNotActive, "Cancel should error when already cancelled")
+	active, err = m.GetActiveOrders()
+	require.NoError(t, err, "GetActiveOrders must not error")
+	assert.Empty(t, active, "Cancel should cancel the linked OCO order")
+}
+
+func TestConditionalOrderManagerRestore(t *testing.T) {
+	t.Parallel()
+	m := setupConditionalOrderTest(t, &fakeOrderSubmitter{})
+	id, err := m.Add(conditionalTestOrder(order.TakeProfit, order.Sell, 110))
+	require.NoError(t, err, "Add must not error")
+	cancelledID, err := m.Add(conditionalTestOrder(order.Stop, order.Sell, 90))
+	require.NoError(t, err, "Add must not error")
+	require.NoError(t, m.Cancel(cancelledID), "Cancel must not error")
+	require.NoError(t, m.Stop(), "Stop must not error")
+
+	// Triggered orders interrupted before their exchange order was recorded
+	// are reconciled with the exchange on start and only submitted again if
+	// the exchange holds no order carrying their client order ID
+	db, ok := m.db.(*fakeConditionalOrderDB)
+	require.True(t, ok, "db must be a fakeConditionalOrderDB")
+	interrupted := db.orders[id]
+	interrupted.ID = "interrupted"
+	interrupted.Status = string(ConditionalOrderTriggered)
+	db.orders[interrupted.ID] = interrupted
+	reachedExchange := interrupted
+	reachedExchange.ID = "reachedexchange"
+	db.orders[reachedExchange.ID] = reachedExchange
+	submitted := interrupted
+	submitted.ID = "submitted"
+	submitted.OrderID = "1"
+	db.orders[submitted.ID] = submitted
+
+	exch, err := m.exchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err, "GetExchangeByName must not error")
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(&conditionalTestExchange{
+		IBotExchange: exch,
+		history:      []order.Detail{{OrderID: "42", ClientOrderID: reachedExchange.ID}},
+	}), "Add must not error")
+	f := &fakeOrderSubmitter{}
+	restarted, err := SetupConditionalOrderManager(em, f, nil, &config.ConditionalOrderManager{})
+	require.NoError(t, err, "SetupConditionalOrderManager must not error")
+	restarted.db = m.db
+	require.NoError(t, restarted.Start(), "Start must not error")
+	restarted.wg.Wait()
+	require.Len(t, f.getSubmitted(), 1, "Interrupted triggered order must be submitted again")
+	assert.Equal(t, interrupted.ID, f.getSubmitted()[0].ClientOrderID, "ClientOrderID should be the conditional order ID")
+	resubmitted, err := restarted.GetOrder(interrupted.ID)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, "1337", resubmitted.OrderID, "OrderID should be recorded once submitted")
+	stored, err := db.GetByID(interrupted.ID)
+	require.NoError(t, err, "GetByID must not error")
+	assert.Equal(t, "1337", stored.OrderID, "OrderID should be persisted once submitted")
+	stored, err = db.GetByID(reachedExchange.ID)
+	require.NoError(t, err, "GetByID must not error")
+	assert.Equal(t, "42", stored.OrderID, "OrderID should be recovered from the exchange without resubmitting")
+	_, err = restarted.GetOrder(submitted.ID)
+	assert.ErrorIs(t, err, ErrConditionalOrderNotFound, "Submitted triggered orders should not be restored")
+
+	active, err := restarted.GetActiveOrders()
+	require.NoError(t, err, "GetActiveOrders must not error")
+	require.Len(t, active, 1, "Only active orders must be restored")
+	assert.Equal(t, id, active[0].ID, "ID should be restored")
+	assert.Equal(t, order.TakeProfit, active[0].Type, "Type should be restored")
+	assert.True(t, active[0].Pair.Equal(btcusdPair), "Pair should be restored")
+
+	require.NoError(t, restarted.ProcessTicker(conditionalTestTicker(110)), "ProcessTicker must not error")
+	restarted.wg.Wait()
+	assert.Len(t, f.getSubmitted(), 2, "Restored order should be submitted when triggered")
+}
+
+func TestConditionalOrderManagerReconcileFailure(t *testing.T) {
+	t.Parallel()
+	m := setupConditionalOrderTest(t, &fakeOrderSubmitter{})
+	id, err := m.Add(conditionalTestOrder(order.Stop, order.Sell, 90))
+	require.NoError(t, err, "Add must not error")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	db, ok := m.db.(*fakeConditionalOrderDB)
+	require.True(t, ok, "db must be a fakeConditionalOrderDB")
+	interrupted := db.orders[id]
+	interrupted.Status = string(ConditionalOrderTriggered)
+	db.orders[id] = interrupted
+
+	exch, err := m.exchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err, "GetExchangeByName must not error")
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(&conditionalTestExchange{IBotExchange: exch, err: errFakeSubmit}), "Add must not error")
+	f := &fakeOrderSubmitter{}
+	restarted, err := SetupConditionalOrderManager(em, f, nil, &config.ConditionalOrderManager{})
+	require.NoError(t, err, "SetupConditionalOrderManager must not error")
+	restarted.db = m.db
+	require.NoError(t, restarted.Start(), "Start must not error")
+	restarted.wg.Wait()
+	assert.Empty(t, f.getSubmitted(), "Order should not be submitted again when the exchange cannot be checked")
+	o, err := restarted.GetOrder(id)
+	require.NoError(t, err, "GetOrder must not error")
+	assert.Equal(t, ConditionalOrderFailed, o.Status, "Status should be failed")
+	assert.Contains(t, o.Reason, errConditionalOrderReconcile.Error(), "Reason should explain the order could not be reconciled")
+}
+
+func TestConditionalOrderManagerEventBus(t *testing.T) {
+	startEventBus(t)
+	f := &fakeOrderSubmitter{}
+	m := setupConditionalOrderTest(t, f)
+	defer func() {
+		assert.NoError(t, m.Stop(), "Stop should not error")
+	}()
+	_, err := m.Add(conditionalTestOrder(order.Stop, order.Sell, 90))
+	require.NoError(t, err, "Add must not error")
+
+	require.NoError(t, eventbus.PublishTicker(conditionalTestTicker(95)), "PublishTicker must not error")
+	require.NoError(t, eventbus.PublishOrderbook(&orderbook.Base{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     btcusdPair,
+		Bids:     orderbook.Items{{Price: 85, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 86, Amount: 1}},
+	}), "PublishOrderbook must not error")
+	assert.Eventually(t, func() bool {
+		return len(f.getSubmitted()) == 1
+	}, time.Second*5, time.Millisecond*10, "Stop should trigger from orderbook events")
+}
@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/conditionalorder"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// ConditionalOrderManagerName is an exported subsystem name
+const ConditionalOrderManagerName = "conditional_order_manager"
+
+var (
+	// ErrConditionalOrderNotFound is returned when a conditional order does
+	// not exist
+	ErrConditionalOrderNotFound = errors.New("conditional order not found")
+
+	errNilConditionalOrder         = errors.New("nil conditional order received")
+	errConditionalOrderTypeInvalid = errors.New("order type is not supported for conditional orders, supported types: STOP, STOP MARKET, STOP LIMIT, TAKE PROFIT, TAKE PROFIT MARKET, TRAILING_STOP")
+	errConditionalOrderNotActive   = errors.New("conditional order is not active")
+	errTriggerPriceRequired        = errors.New("trigger price must be greater than zero")
+	errLimitPriceRequired          = errors.New("limit price must be set for stop limit orders")
+	errTrailingOffsetRequired      = errors.New("either trailing distance or trailing percentage must be set for trailing stop orders")
+	errTrailingOffsetConflict      = errors.New("trailing distance and trailing percentage cannot both be set")
+	errOCOLegMismatch              = errors.New("OCO legs must share the same exchange, asset, pair and side")
+	errConditionalOrderReconcile   = errors.New("unable to reconcile interrupted submission with exchange, not resubmitting")
+	errNilTicker                   = errors.New("nil ticker received")
+	errNilOrderbook                = errors.New("nil orderbook received")
+)
+
+// ConditionalOrderStatus defines the lifecycle state of a conditional order
+type ConditionalOrderStatus string
+
+// Conditional order statuses
+const (
+	ConditionalOrderActive    ConditionalOrderStatus = "ACTIVE"
+	ConditionalOrderTriggered ConditionalOrderStatus = "TRIGGERED"
+	ConditionalOrderCancelled ConditionalOrderStatus = "CANCELLED"
+	ConditionalOrderFailed    ConditionalOrderStatus = "FAILED"
+)
+
+// ConditionalOrderManager holds stop, trailing stop and take profit orders
+// locally and submits the underlying market or limit order through the order
+// manager once the trigger condition is met. This allows these order types to
+// be used on exchanges which do not natively support them.
+type ConditionalOrderManager struct {
+	started         int32
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iOrderSubmitter
+	db              conditionalorder.IDBService
+	orders          map[string]*ConditionalOrder
+	subs            []*eventbus.Subscription
+	verbose         bool
+	m               sync.Mutex
+}
+
+// ConditionalOrder defines an order which is held by the engine until its
+// trigger price is reached
+type ConditionalOrder struct {
+	ID       string
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Type     order.Type
+	Side     order.Side
+	Amount   float64
+	// TriggerPrice is the price which causes the order to be submitted. For
+	// trailing stops it is recalculated as the market moves in favour of the
+	// position
+	TriggerPrice float64
+	// LimitPrice submits a limit order instead of a market order when set,
+	// required for stop limit orders
+	LimitPrice float64
+	// TrailingDistance is the absolute distance the trigger price trails the
+	// best price seen
+	TrailingDistance float64
+	// TrailingPercentage is the percentage distance the trigger price trails
+	// the best price seen
+	TrailingPercentage float64
+	// WatermarkPrice is the best price seen for a trailing stop
+	WatermarkPrice float64
+	// OCOGroup links orders which cancel each other when one is triggered
+	OCOGroup    string
+	Status      ConditionalOrderStatus
+	OrderID     string
+	Reason      string
+	CreatedDate time.Time
+	UpdatedDate time.Time
+}
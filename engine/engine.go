@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	orderbookrecorder "github.com/thrasher-corp/gocryptotrader/backtester/data/kline/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -21,7 +22,9 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/alert"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	gctlog "github.com/thrasher-corp/gocryptotrader/log"
@@ -49,6 +52,20 @@ type Engine struct {
 	WithdrawManager         *WithdrawManager
 	dataHistoryManager      *DataHistoryManager
 	currencyStateManager    *CurrencyStateManager
+	riskManager             *RiskManager
+	conditionalOrderManager *ConditionalOrderManager
+	algoExecutionManager    *AlgoExecutionManager
+	smartOrderRouter        *SmartOrderRouter
+	arbitrageScanner        *ArbitrageScanner
+	marketMakingManager     *MarketMakingManager
+	gridTradingManager      *GridTradingManager
+	metricsManager          *MetricsManager
+	portfolioRebalancer     *PortfolioRebalancer
+	portfolioSnapshots      *PortfolioSnapshotManager
+	fundingRateMonitor      *FundingRateMonitor
+	liquidationMonitor      *LiquidationMonitor
+	orderbookRecorders      map[string]*orderbookrecorder.Recorder
+	orderbookRecordersMtx   sync.Mutex
 	Settings                Settings
 	uptime                  time.Time
 	GRPCShutdownSignal      chan struct{}
@@ -177,6 +194,19 @@ func validateSettings(b *Engine, s *Settings, flagSet FlagSet) {
 	flagSet.WithBool("datahistorymanager", &b.Settings.EnableDataHistoryManager, b.Config.DataHistoryManager.Enabled)
 	flagSet.WithBool("currencystatemanager", &b.Settings.EnableCurrencyStateManager, b.Config.CurrencyStateManager.Enabled != nil && *b.Config.CurrencyStateManager.Enabled)
 	flagSet.WithBool("gctscriptmanager", &b.Settings.EnableGCTScriptManager, b.Config.GCTScript.Enabled)
+	flagSet.WithBool("papertrading", &b.Settings.EnablePaperTrading, b.Config.PaperTrading.Enabled)
+	flagSet.WithBool("orderbookrecording", &b.Settings.EnableOrderbookRecording, b.Config.OrderbookRecording.Enabled)
+	flagSet.WithBool("riskmanager", &b.Settings.EnableRiskManager, b.Config.RiskManager.Enabled)
+	flagSet.WithBool("conditionalordermanager", &b.Settings.EnableConditionalOrders, b.Config.ConditionalOrderManager.Enabled)
+	flagSet.WithBool("algoexecutionmanager", &b.Settings.EnableAlgoExecution, b.Config.AlgoExecutionManager.Enabled)
+	flagSet.WithBool("arbitragescanner", &b.Settings.EnableArbitrageScanner, b.Config.ArbitrageScanner.Enabled)
+	flagSet.WithBool("marketmakingmanager", &b.Settings.EnableMarketMaking, b.Config.MarketMakingManager.Enabled)
+	flagSet.WithBool("gridtradingmanager", &b.Settings.EnableGridTrading, b.Config.GridTradingManager.Enabled)
+	flagSet.WithBool("metricsmanager", &b.Settings.EnableMetricsManager, b.Config.MetricsManager.Enabled)
+	flagSet.WithBool("portfoliorebalancer", &b.Settings.EnablePortfolioRebalancer, b.Config.PortfolioRebalancer.Enabled)
+	flagSet.WithBool("portfoliosnapshots", &b.Settings.EnablePortfolioSnapshots, b.Config.PortfolioSnapshot.Enabled)
+	flagSet.WithBool("fundingratemonitor", &b.Settings.EnableFundingRateMonitor, b.Config.FundingRateMonitor.Enabled)
+	flagSet.WithBool("liquidationmonitor", &b.Settings.EnableLiquidationMonitor, b.Config.LiquidationMonitor.Enabled)
 
 	flagSet.WithBool("tickersync", &b.Settings.EnableTickerSyncing, b.Config.SyncManagerConfig.SynchronizeTicker)
 	flagSet.WithBool("orderbooksync", &b.Settings.EnableOrderbookSyncing, b.Config.SyncManagerConfig.SynchronizeOrderbook)
@@ -372,6 +402,21 @@ func (bot *Engine) Start() error {
 		bot.Config.PurgeExchangeAPICredentials()
 	}
 
+	// The metrics manager is started before exchanges are set up as
+	// requesters capture the global reporter when they are created
+	if bot.Settings.EnableMetricsManager {
+		if m, err := SetupMetricsManager(bot.ExchangeManager, &bot.Config.MetricsManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to setup: %s", err)
+		} else {
+			bot.metricsManager = m
+			if err = bot.metricsManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Metrics manager unable to start: %s", err)
+			}
+			request.SetupGlobalReporter(bot.metricsManager.HTTPReporter())
+			stream.SetupGlobalReporter(bot.metricsManager.WebsocketReporter())
+		}
+	}
+
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	if err := bot.SetupExchanges(); err != nil {
 		return err
@@ -479,12 +524,45 @@ func (bot *Engine) Start() error {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
 		} else {
 			bot.OrderManager = o
+			if bot.DatabaseManager != nil {
+				if err = bot.OrderManager.SetDatabaseManager(bot.DatabaseManager); err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to attach database: %s", err)
+				}
+			}
 			if err = bot.OrderManager.Start(); err != nil {
 				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
 			}
 		}
 	}
 
+	if bot.OrderManager != nil {
+		if r, err := SetupSmartOrderRouter(bot.ExchangeManager, bot.OrderManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Smart order router unable to setup: %s", err)
+		} else {
+			bot.smartOrderRouter = r
+		}
+	}
+
+	if bot.Settings.EnableRiskManager {
+		if bot.OrderManager == nil {
+			gctlog.Errorf(gctlog.Global, "Risk manager unable to setup: %s", errNilOrderManager)
+		} else if r, err := SetupRiskManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			bot.CommunicationsManager,
+			&bot.Config.RiskManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Risk manager unable to setup: %s", err)
+		} else {
+			bot.riskManager = r
+			if err = bot.OrderManager.SetRiskManager(bot.riskManager); err != nil {
+				gctlog.Errorf(gctlog.Global, "Risk manager unable to attach to order manager: %s", err)
+			}
+			if err = bot.riskManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Risk manager unable to start: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableExchangeSyncManager {
 		cfg := bot.Config.SyncManagerConfig
 		cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
@@ -541,6 +619,167 @@ func (bot *Engine) Start() error {
 			if err = bot.WebsocketRoutineManager.Start(); err != nil {
 				gctlog.Errorf(gctlog.Global, "failed to start websocket routine manager. Err: %s", err)
 			}
+			if bot.OrderManager != nil {
+				if err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(bot.OrderManager.websocketDataHandler, false); err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to attach to websocket reconnections: %s", err)
+				}
+			}
+		}
+	}
+
+	if bot.Settings.EnableConditionalOrders {
+		var dbManager iDatabaseConnectionManager
+		if bot.DatabaseManager != nil {
+			dbManager = bot.DatabaseManager
+		}
+		if bot.OrderManager == nil {
+			gctlog.Errorf(gctlog.Global, "Conditional order manager unable to setup: %s", errNilOrderManager)
+		} else if c, err := SetupConditionalOrderManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			dbManager,
+			&bot.Config.ConditionalOrderManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Conditional order manager unable to setup: %s", err)
+		} else {
+			bot.conditionalOrderManager = c
+			if err = bot.conditionalOrderManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Conditional order manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableAlgoExecution {
+		if bot.OrderManager == nil {
+			gctlog.Errorf(gctlog.Global, "Algo execution manager unable to setup: %s", errNilOrderManager)
+		} else if a, err := SetupAlgoExecutionManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			&bot.Config.AlgoExecutionManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Algo execution manager unable to setup: %s", err)
+		} else {
+			bot.algoExecutionManager = a
+			if err = bot.algoExecutionManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Algo execution manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableArbitrageScanner {
+		if a, err := SetupArbitrageScanner(
+			bot.ExchangeManager,
+			bot.CommunicationsManager,
+			&bot.Config.ArbitrageScanner); err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage scanner unable to setup: %s", err)
+		} else {
+			bot.arbitrageScanner = a
+			if err = bot.arbitrageScanner.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Arbitrage scanner unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableMarketMaking {
+		if bot.OrderManager == nil {
+			gctlog.Errorf(gctlog.Global, "Market making manager unable to setup: %s", errNilOrderManager)
+		} else if m, err := SetupMarketMakingManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			&bot.Config.MarketMakingManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Market making manager unable to setup: %s", err)
+		} else {
+			bot.marketMakingManager = m
+			if err = bot.marketMakingManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Market making manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableGridTrading {
+		var dbManager iDatabaseConnectionManager
+		if bot.DatabaseManager != nil {
+			dbManager = bot.DatabaseManager
+		}
+		if bot.OrderManager == nil {
+			gctlog.Errorf(gctlog.Global, "Grid trading manager unable to setup: %s", errNilOrderManager)
+		} else if g, err := SetupGridTradingManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			dbManager,
+			&bot.Config.GridTradingManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Grid trading manager unable to setup: %s", err)
+		} else {
+			bot.gridTradingManager = g
+			if err = bot.gridTradingManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Grid trading manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnablePortfolioRebalancer {
+		if bot.OrderManager == nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio rebalancer unable to setup: %s", errNilOrderManager)
+		} else if r, err := SetupPortfolioRebalancer(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			&bot.Config.PortfolioRebalancer); err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio rebalancer unable to setup: %s", err)
+		} else {
+			bot.portfolioRebalancer = r
+			if err = bot.portfolioRebalancer.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Portfolio rebalancer unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnablePortfolioSnapshots {
+		var portfolioManager iPortfolioAddresses
+		if bot.portfolioManager != nil {
+			portfolioManager = bot.portfolioManager
+		}
+		if bot.DatabaseManager == nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio snapshot manager unable to setup: %s", errNilDatabaseConnectionManager)
+		} else if p, err := SetupPortfolioSnapshotManager(
+			bot.ExchangeManager,
+			portfolioManager,
+			bot.DatabaseManager,
+			&bot.Config.PortfolioSnapshot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio snapshot manager unable to setup: %s", err)
+		} else {
+			bot.portfolioSnapshots = p
+			if err = bot.portfolioSnapshots.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Portfolio snapshot manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableFundingRateMonitor {
+		if f, err := SetupFundingRateMonitor(
+			bot.ExchangeManager,
+			bot.CommunicationsManager,
+			&bot.Config.FundingRateMonitor); err != nil {
+			gctlog.Errorf(gctlog.Global, "Funding rate monitor unable to setup: %s", err)
+		} else {
+			bot.fundingRateMonitor = f
+			if err = bot.fundingRateMonitor.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Funding rate monitor unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableLiquidationMonitor {
+		if bot.OrderManager == nil {
+			gctlog.Errorf(gctlog.Global, "Liquidation monitor unable to setup: %s", errNilOrderManager)
+		} else if l, err := SetupLiquidationMonitor(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			bot.CommunicationsManager,
+			&bot.Config.LiquidationMonitor); err != nil {
+			gctlog.Errorf(gctlog.Global, "Liquidation monitor unable to setup: %s", err)
+		} else {
+			bot.liquidationMonitor = l
+			if err = bot.liquidationMonitor.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Liquidation monitor unable to start: %s", err)
+			}
 		}
 	}
 
@@ -575,6 +814,26 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.metricsManager != nil {
+		if err := bot.attachMetricsManager(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to attach to subsystems: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// attachMetricsManager sets the subsystems the metrics manager collects order
+// and orderbook sync state from
+func (bot *Engine) attachMetricsManager() error {
+	if bot.OrderManager != nil {
+		if err := bot.metricsManager.SetOrderManager(bot.OrderManager); err != nil {
+			return err
+		}
+	}
+	if bot.currencyPairSyncer != nil {
+		return bot.metricsManager.SetSyncManager(bot.currencyPairSyncer)
+	}
 	return nil
 }
 
@@ -594,6 +853,61 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.liquidationMonitor.IsRunning() {
+		if err := bot.liquidationMonitor.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Liquidation monitor unable to stop. Error: %v", err)
+		}
+	}
+	if bot.fundingRateMonitor.IsRunning() {
+		if err := bot.fundingRateMonitor.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Funding rate monitor unable to stop. Error: %v", err)
+		}
+	}
+	if bot.portfolioSnapshots.IsRunning() {
+		if err := bot.portfolioSnapshots.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio snapshot manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.portfolioRebalancer.IsRunning() {
+		if err := bot.portfolioRebalancer.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio rebalancer unable to stop. Error: %v", err)
+		}
+	}
+	if bot.metricsManager.IsRunning() {
+		if err := bot.metricsManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.gridTradingManager.IsRunning() {
+		if err := bot.gridTradingManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Grid trading manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.marketMakingManager.IsRunning() {
+		if err := bot.marketMakingManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Market making manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.arbitrageScanner.IsRunning() {
+		if err := bot.arbitrageScanner.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage scanner unable to stop. Error: %v", err)
+		}
+	}
+	if bot.algoExecutionManager.IsRunning() {
+		if err := bot.algoExecutionManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Algo execution manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.conditionalOrderManager.IsRunning() {
+		if err := bot.conditionalOrderManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Conditional order manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.riskManager.IsRunning() {
+		if err := bot.riskManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Risk manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.OrderManager.IsRunning() {
 		if err := bot.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -666,6 +980,7 @@ func (bot *Engine) Stop() {
 	if err != nil {
 		gctlog.Errorf(gctlog.Global, "Exchange manager unable to stop. Error: %v", err)
 	}
+	bot.closeOrderbookRecorders()
 
 	err = currency.ShutdownStorageUpdater()
 	if err != nil {
@@ -707,7 +1022,7 @@ func (bot *Engine) UnloadExchange(exchName string) error {
 	}
 
 	exchCfg.Enabled = false
-	return nil
+	return bot.closeOrderbookRecorder(exchName)
 }
 
 // GetExchanges retrieves the loaded exchanges
@@ -815,6 +1130,20 @@ func (bot *Engine) LoadExchange(name string) error {
 		return err
 	}
 
+	if bot.Settings.EnableOrderbookRecording && exch.IsWebsocketEnabled() {
+		err = bot.setupOrderbookRecorder(exch)
+		if err != nil {
+			return err
+		}
+	}
+
+	if bot.Settings.EnablePaperTrading {
+		exch, err = bot.setupPaperTrading(exch)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = bot.ExchangeManager.Add(exch)
 	if err != nil {
 		return err
@@ -849,6 +1178,89 @@ func (bot *Engine) LoadExchange(name string) error {
 	return exchange.Bootstrap(context.TODO(), exch)
 }
 
+// setupPaperTrading wraps an exchange in the paper trading simulator, loads its
+// configured starting balances and starts matching resting orders against the
+// live orderbook depth
+func (bot *Engine) setupPaperTrading(exch exchange.IBotExchange) (exchange.IBotExchange, error) {
+	p, err := paper.New(exch, bot.Config.PaperTrading.MatchInterval)
+	if err != nil {
+		return nil, err
+	}
+	for i := range bot.Config.PaperTrading.Balances {
+		b := &bot.Config.PaperTrading.Balances[i]
+		if !strings.EqualFold(b.Exchange, exch.GetName()) {
+			continue
+		}
+		err = p.Deposit(b.Asset, b.Currency, b.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("%s paper trading balance %s %s: %w", exch.GetName(), b.Asset, b.Currency, err)
+		}
+	}
+	err = p.Start()
+	if err != nil {
+		return nil, err
+	}
+	gctlog.Warnf(gctlog.ExchangeSys, "%s: Paper trading enabled, orders will be simulated against live orderbook depth.\n", exch.GetName())
+	return p, nil
+}
+
+// setupOrderbookRecorder records every orderbook snapshot and update received
+// by the exchange websocket so it can be replayed by the backtester
+func (bot *Engine) setupOrderbookRecorder(exch exchange.IBotExchange) error {
+	path := bot.Config.OrderbookRecording.Path
+	if path == "" {
+		path = filepath.Join(bot.Settings.DataDir, "orderbooks")
+	}
+	r, err := orderbookrecorder.NewRecorder(exch.GetName(), path)
+	if err != nil {
+		return err
+	}
+	err = r.Attach(exch)
+	if err != nil {
+		return err
+	}
+	bot.orderbookRecordersMtx.Lock()
+	defer bot.orderbookRecordersMtx.Unlock()
+	if bot.orderbookRecorders == nil {
+		bot.orderbookRecorders = make(map[string]*orderbookrecorder.Recorder)
+	}
+	name := strings.ToLower(exch.GetName())
+	if prev, ok := bot.orderbookRecorders[name]; ok {
+		if err = prev.Close(); err != nil {
+			gctlog.Errorf(gctlog.ExchangeSys, "%s: Unable to close previous orderbook recorder: %v", exch.GetName(), err)
+		}
+	}
+	bot.orderbookRecorders[name] = r
+	gctlog.Infof(gctlog.ExchangeSys, "%s: Recording websocket orderbooks to %s.\n", exch.GetName(), path)
+	return nil
+}
+
+// closeOrderbookRecorder finalises the recorded orderbook files of an exchange
+func (bot *Engine) closeOrderbookRecorder(exchName string) error {
+	bot.orderbookRecordersMtx.Lock()
+	defer bot.orderbookRecordersMtx.Unlock()
+	name := strings.ToLower(exchName)
+	r, ok := bot.orderbookRecorders[name]
+	if !ok {
+		return nil
+	}
+	delete(bot.orderbookRecorders, name)
+	return r.Close()
+}
+
+// closeOrderbookRecorders finalises the recorded orderbook files of every
+// exchange
+func (bot *Engine) closeOrderbookRecorders() {
+	bot.orderbookRecordersMtx.Lock()
+	defer bot.orderbookRecordersMtx.Unlock()
+	for name, r := range bot.orderbookRecorders {
+		if err := r.Close(); err != nil {
+			gctlog.Errorf(gctlog.ExchangeSys, "%s: Unable to close orderbook recorder: %v", name, err)
+		}
+		delete(bot.orderbookRecorders, name)
+	}
+}
+
 func (bot *Engine) dryRunParamInteraction(param string) {
 	if !bot.Settings.CheckParamInteraction {
 		return
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -229,6 +230,43 @@ func TestUnloadExchange(t *testing.T) {
 	}
 }
 
+func TestSetupOrderbookRecorder(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	assert.NoError(t, err, "NewExchangeByName should not error")
+	exch.SetDefaults()
+	exch.SetEnabled(true)
+	err = em.Add(exch)
+	assert.NoError(t, err, "Add should not error")
+	dir := t.TempDir()
+	e := &Engine{
+		ExchangeManager: em,
+		Config:          &config.Config{Exchanges: []config.Exchange{{Name: testExchange}}},
+		Settings:        Settings{DataDir: dir},
+	}
+
+	err = e.setupOrderbookRecorder(exch)
+	assert.NoError(t, err, "setupOrderbookRecorder should not error")
+	assert.DirExists(t, filepath.Join(dir, "orderbooks"), "recordings should default to the data directory")
+	assert.Len(t, e.orderbookRecorders, 1, "setupOrderbookRecorder should store the recorder")
+
+	e.Config.OrderbookRecording.Path = filepath.Join(dir, "custom")
+	err = e.setupOrderbookRecorder(exch)
+	assert.NoError(t, err, "setupOrderbookRecorder should not error")
+	assert.DirExists(t, e.Config.OrderbookRecording.Path, "recordings should use the configured path")
+	assert.Len(t, e.orderbookRecorders, 1, "setupOrderbookRecorder should replace the exchange's recorder")
+
+	err = e.UnloadExchange(testExchange)
+	assert.NoError(t, err, "UnloadExchange should not error")
+	assert.Empty(t, e.orderbookRecorders, "UnloadExchange should close the exchange's recorder")
+
+	err = e.setupOrderbookRecorder(exch)
+	assert.NoError(t, err, "setupOrderbookRecorder should not error")
+	e.closeOrderbookRecorders()
+	assert.Empty(t, e.orderbookRecorders, "closeOrderbookRecorders should close every recorder")
+}
+
 func TestDryRunParamInteraction(t *testing.T) {
 	t.Parallel()
 	bot := &Engine{
@@ -54,6 +54,19 @@ type CoreSettings struct {
 	EnableNTPClient             bool
 	EnableWebsocketRoutine      bool
 	EnableCurrencyStateManager  bool
+	EnablePaperTrading          bool
+	EnableOrderbookRecording    bool
+	EnableRiskManager           bool
+	EnableConditionalOrders     bool
+	EnableAlgoExecution         bool
+	EnableArbitrageScanner      bool
+	EnableMarketMaking          bool
+	EnableGridTrading           bool
+	EnableMetricsManager        bool
+	EnablePortfolioRebalancer   bool
+	EnablePortfolioSnapshots    bool
+	EnableFundingRateMonitor    bool
+	EnableLiquidationMonitor    bool
 	EventManagerDelay           time.Duration
 	EnableFuturesTracking       bool
 	Verbose                     bool
@@ -0,0 +1,540 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupFundingRateMonitor creates a new perpetual futures funding rate monitor
+func SetupFundingRateMonitor(exchangeManager iExchangeManager, communicationsManager iCommsManager, cfg *config.FundingRateMonitor) (*FundingRateMonitor, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if communicationsManager == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", FundingRateMonitorName, errNilConfig)
+	}
+	checkInterval := cfg.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = config.DefaultFundingRateMonitorCheckInterval
+	}
+	alertCooldown := cfg.AlertCooldown
+	if alertCooldown <= 0 {
+		alertCooldown = config.DefaultFundingRateAlertCooldown
+	}
+	return &FundingRateMonitor{
+		shutdown:        make(chan struct{}),
+		exchangeManager: exchangeManager,
+		commsManager:    communicationsManager,
+		checkInterval:   checkInterval,
+		minimumCarry:    cfg.MinimumAnnualisedCarry,
+		alertThreshold:  cfg.AlertThresholdAnnualisedCarry,
+		alertCooldown:   alertCooldown,
+		verbose:         cfg.Verbose,
+		lastAlert:       make(map[fundingOpportunityKey]time.Time),
+		subscribers:     make(map[uuid.UUID]chan FundingRateOpportunity),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (f *FundingRateMonitor) IsRunning() bool {
+	return f != nil && atomic.LoadInt32(&f.started) == 1
+}
+
+// Start runs the subsystem
+func (f *FundingRateMonitor) Start() error {
+	if f == nil {
+		return fmt.Errorf("%s %w", FundingRateMonitorName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&f.started, 0, 1) {
+		return fmt.Errorf("%s %w", FundingRateMonitorName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Funding rate monitor %s", MsgSubSystemStarting)
+	f.shutdown = make(chan struct{})
+	f.wg.Add(1)
+	go f.run()
+	log.Debugf(log.ExchangeSys, "Funding rate monitor %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem, closing any opportunity subscriber
+// channels
+func (f *FundingRateMonitor) Stop() error {
+	if f == nil {
+		return fmt.Errorf("%s %w", FundingRateMonitorName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&f.started) == 0 {
+		return fmt.Errorf("%s %w", FundingRateMonitorName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Funding rate monitor %s", MsgSubSystemShuttingDown)
+	close(f.shutdown)
+	f.wg.Wait()
+	f.m.Lock()
+	for id, ch := range f.subscribers {
+		close(ch)
+		delete(f.subscribers, id)
+	}
+	f.rates = nil
+	f.opportunities = nil
+	f.m.Unlock()
+	atomic.StoreInt32(&f.started, 0)
+	log.Debugf(log.ExchangeSys, "Funding rate monitor %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// GetFundingRates returns the funding rates retrieved by the latest poll
+// ordered by annualised rate
+func (f *FundingRateMonitor) GetFundingRates() ([]PerpetualFundingRate, error) {
+	if !f.IsRunning() {
+		return nil, fmt.Errorf("%s %w", FundingRateMonitorName, ErrSubSystemNotStarted)
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]PerpetualFundingRate(nil), f.rates...), nil
+}
+
+// GetOpportunities returns all current opportunities ordered by annualised
+// carry
+func (f *FundingRateMonitor) GetOpportunities() ([]FundingRateOpportunity, error) {
+	if !f.IsRunning() {
+		return nil, fmt.Errorf("%s %w", FundingRateMonitorName, ErrSubSystemNotStarted)
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]FundingRateOpportunity(nil), f.opportunities...), nil
+}
+
+// Subscribe returns a channel which receives every opportunity found by each
+// poll along with an ID used to unsubscribe. Opportunities are dropped for
+// subscribers which do not keep up and the channel is closed when the
+// subsystem is stopped
+func (f *FundingRateMonitor) Subscribe() (uuid.UUID, <-chan FundingRateOpportunity, error) {
+	if !f.IsRunning() {
+		return uuid.Nil, nil, fmt.Errorf("%s %w", FundingRateMonitorName, ErrSubSystemNotStarted)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	ch := make(chan FundingRateOpportunity, fundingRateSubscriberBuffer)
+	f.m.Lock()
+	f.subscribers[id] = ch
+	f.m.Unlock()
+	return id, ch, nil
+}
+
+// Unsubscribe removes an opportunity subscriber and closes its channel
+func (f *FundingRateMonitor) Unsubscribe(id uuid.UUID) {
+	if f == nil {
+		return
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	if ch, ok := f.subscribers[id]; ok {
+		close(ch)
+		delete(f.subscribers, id)
+	}
+}
+
+// run polls funding rates on startup and on each interval until shutdown
+func (f *FundingRateMonitor) run() {
+	defer f.wg.Done()
+	tick := time.NewTicker(f.checkInterval)
+	defer tick.Stop()
+	f.poll(context.TODO())
+	for {
+		select {
+		case <-f.shutdown:
+			return
+		case <-tick.C:
+			f.poll(context.TODO())
+		}
+	}
+}
+
+// poll retrieves the latest funding rates from every enabled exchange and
+// replaces the current opportunities with those they produce
+func (f *FundingRateMonitor) poll(ctx context.Context) {
+	exchanges, err := f.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Funding rate monitor unable to get exchanges: %v", err)
+		return
+	}
+	var rates []PerpetualFundingRate
+	for i := range exchanges {
+		if !exchanges[i].IsEnabled() {
+			continue
+		}
+		rates = append(rates, f.getFundingRates(ctx, exchanges[i])...)
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].AnnualisedRate > rates[j].AnnualisedRate
+	})
+	f.update(rates, rankFundingOpportunities(rates, f.minimumCarry))
+}
+
+// getFundingRates returns the latest funding rates of an exchange's enabled
+// perpetual futures. Rates are requested for all pairs of an asset at once,
+// falling back to each pair for exchanges which require one
+func (f *FundingRateMonitor) getFundingRates(ctx context.Context, exch exchange.IBotExchange) []PerpetualFundingRate {
+	var resp []PerpetualFundingRate
+	assets := exch.GetAssetTypes(true)
+	for _, a := range assets {
+		if !a.IsFutures() {
+			continue
+		}
+		perps, err := f.getPerpetualPairs(exch, a)
+		if err != nil {
+			f.logPoll(exch.GetName(), a, err)
+			continue
+		}
+		if len(perps) == 0 {
+			continue
+		}
+		latest, err := exch.GetLatestFundingRates(ctx, &fundingrate.LatestRateRequest{Asset: a})
+		if err != nil {
+			latest = nil
+			for i := range perps {
+				var rates []fundingrate.LatestRateResponse
+				rates, err = exch.GetLatestFundingRates(ctx, &fundingrate.LatestRateRequest{Asset: a, Pair: perps[i]})
+				if err != nil {
+					f.logPoll(exch.GetName(), a, err)
+					continue
+				}
+				latest = append(latest, rates...)
+			}
+		}
+		for i := range latest {
+			if !perps.Contains(latest[i].Pair, true) {
+				continue
+			}
+			var rate *PerpetualFundingRate
+			rate, err = f.getFundingRate(ctx, exch, &latest[i])
+			if err != nil {
+				f.logPoll(exch.GetName(), a, err)
+				continue
+			}
+			resp = append(resp, *rate)
+		}
+	}
+	return resp
+}
+
+// getPerpetualPairs returns the enabled pairs of an asset which are perpetual
+// futures
+func (f *FundingRateMonitor) getPerpetualPairs(exch exchange.IBotExchange, a asset.Item) (currency.Pairs, error) {
+	pairs, err := exch.GetEnabledPairs(a)
+	if err != nil {
+		return nil, err
+	}
+	perps := make(currency.Pairs, 0, len(pairs))
+	for i := range pairs {
+		var isPerp bool
+		isPerp, err = exch.IsPerpetualFutureCurrency(a, pairs[i])
+		if err != nil {
+			return nil, err
+		}
+		if isPerp {
+			perps = append(perps, pairs[i])
+		}
+	}
+	return perps, nil
+}
+
+// getFundingRate annualises a funding rate and prices the perpetual against
+// the matching spot market of the exchange when one is enabled
+func (f *FundingRateMonitor) getFundingRate(ctx context.Context, exch exchange.IBotExchange, latest *fundingrate.LatestRateResponse) (*PerpetualFundingRate, error) {
+	tick, err := exch.FetchTicker(ctx, latest.Pair, latest.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("unable to price %s %s: %w", latest.Asset, latest.Pair, err)
+	}
+	price := tick.MarkPrice
+	if price <= 0 {
+		price = tick.Last
+	}
+	if price <= 0 {
+		return nil, fmt.Errorf("%w for %s %s", errNoFundingPrice, latest.Asset, latest.Pair)
+	}
+	interval := fundingInterval(latest)
+	rate := &PerpetualFundingRate{
+		Exchange:       exch.GetName(),
+		Asset:          latest.Asset,
+		Pair:           latest.Pair,
+		Underlying:     fundingUnderlying(latest.Pair),
+		Rate:           latest.LatestRate.Rate.InexactFloat64(),
+		Interval:       interval,
+		TimeOfNextRate: latest.TimeOfNextRate,
+		Price:          price,
+		Time:           time.Now(),
+	}
+	rate.AnnualisedRate = annualiseFundingRate(rate.Rate, interval)
+
+	spotPair, err := getFundingSpotPair(exch, latest.Pair, rate.Underlying)
+	if err != nil {
+		// The perpetual is still compared against other perpetuals
+		f.logPoll(exch.GetName(), asset.Spot, err)
+		return rate, nil
+	}
+	spotTick, err := exch.FetchTicker(ctx, spotPair, asset.Spot)
+	if err != nil || spotTick.Last <= 0 {
+		f.logPoll(exch.GetName(), asset.Spot, fmt.Errorf("unable to price %s: %v", spotPair, err))
+		return rate, nil
+	}
+	rate.SpotPair = spotPair
+	rate.SpotPrice = spotTick.Last
+	rate.BasisPercent = (rate.Price - rate.SpotPrice) / rate.SpotPrice * 100
+	return rate, nil
+}
+
+// getFundingSpotPair returns the enabled spot pair of the perpetual's
+// underlying, preferring the perpetual's quote currency followed by common
+// stablecoin and fiat quotes
+func getFundingSpotPair(exch exchange.IBotExchange, perp currency.Pair, underlying currency.Code) (currency.Pair, error) {
+	spot, err := exch.GetEnabledPairs(asset.Spot)
+	if err != nil {
+		return currency.EMPTYPAIR, err
+	}
+	quotes := append([]currency.Code{perp.Quote}, fundingSpotQuotes...)
+	for i := range quotes {
+		for j := range spot {
+			if normaliseArbitragePair(spot[j], asset.Spot).Base == underlying.Item && spot[j].Quote.Equal(quotes[i]) {
+				return spot[j], nil
+			}
+		}
+	}
+	return currency.EMPTYPAIR, fmt.Errorf("%w for %s", currency.ErrPairNotFound, underlying)
+}
+
+// fundingUnderlying returns the common code of the currency a perpetual
+// tracks. Perpetuals quoted in PERP carry their settlement currency in the
+// base e.g. BTCUSD-PERP
+func fundingUnderlying(p currency.Pair) currency.Code {
+	if p.Quote.Equal(currency.PERP) {
+		base := p.Base.Upper().String()
+		for _, q := range []string{"USDT", "USD"} {
+			if trimmed := strings.TrimSuffix(base, q); trimmed != base && trimmed != "" {
+				p.Base = currency.NewCode(trimmed)
+				break
+			}
+		}
+	}
+	k := normaliseArbitragePair(p, asset.Empty)
+	return currency.Code{Item: k.Base, UpperCase: true}
+}
+
+// fundingInterval infers the time between funding payments from the latest
+// and next funding times
+func fundingInterval(latest *fundingrate.LatestRateResponse) time.Duration {
+	if latest.LatestRate.Time.IsZero() || latest.TimeOfNextRate.IsZero() {
+		return defaultFundingInterval
+	}
+	interval := latest.TimeOfNextRate.Sub(latest.LatestRate.Time)
+	if interval <= 0 || interval > maximumFundingInterval {
+		return defaultFundingInterval
+	}
+	return interval
+}
+
+// annualiseFundingRate converts a rate paid each interval to an annual
+// percentage
+func annualiseFundingRate(rate float64, interval time.Duration) float64 {
+	if interval <= 0 {
+		interval = defaultFundingInterval
+	}
+	return rate * (hoursPerYear * float64(time.Hour) / float64(interval)) * 100
+}
+
+// rankFundingOpportunities compares every perpetual against its spot market
+// and against every other perpetual on the same underlying, returning the
+// opportunities with an annualised carry above the minimum ordered by carry
+func rankFundingOpportunities(rates []PerpetualFundingRate, minimumCarry float64) []FundingRateOpportunity {
+	var resp []FundingRateOpportunity
+	add := func(o *FundingRateOpportunity) {
+		if o.AnnualisedCarry > 0 && o.AnnualisedCarry >= minimumCarry {
+			resp = append(resp, *o)
+		}
+	}
+	for i := range rates {
+		if rates[i].SpotPrice > 0 {
+			add(cashAndCarry(&rates[i]))
+		}
+		for j := i + 1; j < len(rates); j++ {
+			if !rates[i].Underlying.Equal(rates[j].Underlying) ||
+				(rates[i].Exchange == rates[j].Exchange && rates[i].Asset == rates[j].Asset) {
+				continue
+			}
+			add(fundingSpread(&rates[i], &rates[j]))
+		}
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].AnnualisedCarry > resp[j].AnnualisedCarry
+	})
+	return resp
+}
+
+// cashAndCarry pairs a perpetual with its spot market, shorting the
+// perpetual when funding is positive and buying it when negative
+func cashAndCarry(r *PerpetualFundingRate) *FundingRateOpportunity {
+	o := &FundingRateOpportunity{
+		Type:           CashAndCarry,
+		Underlying:     r.Underlying,
+		LongExchange:   r.Exchange,
+		LongAsset:      asset.Spot,
+		LongPair:       r.SpotPair,
+		LongPrice:      r.SpotPrice,
+		ShortExchange:  r.Exchange,
+		ShortAsset:     r.Asset,
+		ShortPair:      r.Pair,
+		ShortPrice:     r.Price,
+		TimeOfNextRate: r.TimeOfNextRate,
+		Time:           r.Time,
+	}
+	o.ShortAnnualisedRate = r.AnnualisedRate
+	if r.AnnualisedRate < 0 {
+		o.Type = ReverseCashAndCarry
+		o.LongAsset, o.ShortAsset = o.ShortAsset, o.LongAsset
+		o.LongPair, o.ShortPair = o.ShortPair, o.LongPair
+		o.LongPrice, o.ShortPrice = o.ShortPrice, o.LongPrice
+		o.LongAnnualisedRate, o.ShortAnnualisedRate = r.AnnualisedRate, 0
+	}
+	o.AnnualisedCarry = o.ShortAnnualisedRate - o.LongAnnualisedRate
+	o.BasisPercent = (o.ShortPrice - o.LongPrice) / o.LongPrice * 100
+	return o
+}
+
+// fundingSpread buys the perpetual with the lower annualised funding rate
+// and shorts the one with the higher rate
+func fundingSpread(a, b *PerpetualFundingRate) *FundingRateOpportunity {
+	long, short := a, b
+	if a.AnnualisedRate > b.AnnualisedRate {
+		long, short = b, a
+	}
+	next := long.TimeOfNextRate
+	if next.IsZero() || (!short.TimeOfNextRate.IsZero() && short.TimeOfNextRate.Before(next)) {
+		next = short.TimeOfNextRate
+	}
+	return &FundingRateOpportunity{
+		Type:                FundingSpread,
+		Underlying:          long.Underlying,
+		LongExchange:        long.Exchange,
+		LongAsset:           long.Asset,
+		LongPair:            long.Pair,
+		LongPrice:           long.Price,
+		LongAnnualisedRate:  long.AnnualisedRate,
+		ShortExchange:       short.Exchange,
+		ShortAsset:          short.Asset,
+		ShortPair:           short.Pair,
+		ShortPrice:          short.Price,
+		ShortAnnualisedRate: short.AnnualisedRate,
+		AnnualisedCarry:     short.AnnualisedRate - long.AnnualisedRate,
+		BasisPercent:        (short.Price - long.Price) / long.Price * 100,
+		TimeOfNextRate:      next,
+		Time:                time.Now(),
+	}
+}
+
+// update replaces the current rates and opportunities, publishing each
+// opportunity to subscribers and pushing alerts for those which exceed the
+// configured threshold
+func (f *FundingRateMonitor) update(rates []PerpetualFundingRate, opportunities []FundingRateOpportunity) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.rates = rates
+	f.opportunities = opportunities
+	for k, last := range f.lastAlert {
+		if time.Since(last) >= f.alertCooldown {
+			delete(f.lastAlert, k)
+		}
+	}
+	for i := range opportunities {
+		for _, ch := range f.subscribers {
+			select {
+			case ch <- opportunities[i]:
+			default:
+			}
+		}
+		if f.verbose {
+			log.Debugf(log.ExchangeSys, "Funding rate monitor %s", &opportunities[i])
+		}
+		if f.alertThreshold <= 0 || opportunities[i].AnnualisedCarry < f.alertThreshold {
+			continue
+		}
+		k := opportunities[i].key()
+		if last, ok := f.lastAlert[k]; ok && opportunities[i].Time.Sub(last) < f.alertCooldown {
+			continue
+		}
+		f.lastAlert[k] = opportunities[i].Time
+		f.commsManager.PushEvent(base.Event{Type: "funding", Message: opportunities[i].String()})
+	}
+}
+
+// logPoll logs why funding rates could not be retrieved when verbose
+func (f *FundingRateMonitor) logPoll(exch string, a asset.Item, err error) {
+	if f.verbose {
+		log.Debugf(log.ExchangeSys, "Funding rate monitor unable to process %s %s: %v", exch, a, err)
+	}
+}
+
+// key returns the unique identifier of the opportunity
+func (o *FundingRateOpportunity) key() fundingOpportunityKey {
+	return fundingOpportunityKey{
+		typ: o.Type,
+		long: fundingLegKey{
+			exchange:  o.LongExchange,
+			PairAsset: key.PairAsset{Base: o.LongPair.Base.Item, Quote: o.LongPair.Quote.Item, Asset: o.LongAsset},
+		},
+		short: fundingLegKey{
+			exchange:  o.ShortExchange,
+			PairAsset: key.PairAsset{Base: o.ShortPair.Base.Item, Quote: o.ShortPair.Quote.Item, Asset: o.ShortAsset},
+		},
+	}
+}
+
+// String returns a human readable summary of the opportunity
+func (o *FundingRateOpportunity) String() string {
+	return fmt.Sprintf("%s %s long %s %s %s, short %s %s %s, annualised carry %.2f%%, basis %.4f%%",
+		o.Type, o.Underlying, o.LongExchange, o.LongAsset, o.LongPair,
+		o.ShortExchange, o.ShortAsset, o.ShortPair, o.AnnualisedCarry, o.BasisPercent)
+}
+
+// String implements the stringer interface
+func (t FundingOpportunityType) String() string {
+	switch t {
+	case CashAndCarry:
+		return "cash and carry"
+	case ReverseCashAndCarry:
+		return "reverse cash and carry"
+	case FundingSpread:
+		return "funding spread"
+	default:
+		return "unknown"
+	}
+}
+
+// fundingOpportunityTypeFromString returns the opportunity type matching the
+// string, ignoring case and separators
+func fundingOpportunityTypeFromString(s string) (FundingOpportunityType, error) {
+	normalised := strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(s))
+	for _, t := range []FundingOpportunityType{CashAndCarry, ReverseCashAndCarry, FundingSpread} {
+		if strings.ReplaceAll(t.String(), " ", "") == normalised {
+			return t, nil
+		}
+	}
+	return UnknownFundingOpportunity, fmt.Errorf("%w %q", errUnknownFundingOpportunityType, s)
+}
@@ -0,0 +1,264 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var (
+	fundingPerpPair = currency.NewPair(currency.BTC, currency.USDT)
+	errPairRequired = errors.New("pair required")
+)
+
+// fundingTestExchange returns a fixed funding rate for a single USDT margined
+// perpetual along with optional spot market prices
+type fundingTestExchange struct {
+	exchange.IBotExchange
+	name         string
+	rate         float64
+	perpPrice    float64
+	spotPrice    float64
+	requiresPair bool
+}
+
+func (f *fundingTestExchange) GetName() string {
+	return f.name
+}
+
+func (f *fundingTestExchange) IsEnabled() bool {
+	return true
+}
+
+func (f *fundingTestExchange) GetAssetTypes(bool) asset.Items {
+	if f.spotPrice > 0 {
+		return asset.Items{asset.Spot, asset.USDTMarginedFutures}
+	}
+	return asset.Items{asset.USDTMarginedFutures}
+}
+
+func (f *fundingTestExchange) GetEnabledPairs(a asset.Item) (currency.Pairs, error) {
+	if a == asset.Spot && f.spotPrice <= 0 {
+		return nil, asset.ErrNotEnabled
+	}
+	return currency.Pairs{fundingPerpPair}, nil
+}
+
+func (f *fundingTestExchange) IsPerpetualFutureCurrency(a asset.Item, _ currency.Pair) (bool, error) {
+	return a == asset.USDTMarginedFutures, nil
+}
+
+func (f *fundingTestExchange) GetLatestFundingRates(_ context.Context, r *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	if f.requiresPair && r.Pair.IsEmpty() {
+		return nil, errPairRequired
+	}
+	last := time.Now().Truncate(time.Hour * 8)
+	return []fundingrate.LatestRateResponse{{
+		Exchange:       f.name,
+		Asset:          r.Asset,
+		Pair:           fundingPerpPair,
+		LatestRate:     fundingrate.Rate{Time: last, Rate: decimal.NewFromFloat(f.rate)},
+		TimeOfNextRate: last.Add(time.Hour * 8),
+	}}, nil
+}
+
+func (f *fundingTestExchange) FetchTicker(_ context.Context, p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	if a == asset.Spot {
+		return &ticker.Price{Pair: p, AssetType: a, Last: f.spotPrice}, nil
+	}
+	return &ticker.Price{Pair: p, AssetType: a, Last: f.perpPrice - 1, MarkPrice: f.perpPrice}, nil
+}
+
+func setupFundingRateMonitorTest(t *testing.T, cfg *config.FundingRateMonitor, exchanges ...*fundingTestExchange) (*FundingRateMonitor, *fakeArbitrageComms) {
+	t.Helper()
+	em := NewExchangeManager()
+	for i := range exchanges {
+		require.NoError(t, em.Add(exchanges[i]), "Add must not error")
+	}
+	comms := &fakeArbitrageComms{}
+	f, err := SetupFundingRateMonitor(em, comms, cfg)
+	require.NoError(t, err, "SetupFundingRateMonitor must not error")
+	// Marked as running without starting so that funding rates are only
+	// polled when called by the test
+	f.started = 1
+	return f, comms
+}
+
+func TestSetupFundingRateMonitor(t *testing.T) {
+	t.Parallel()
+	_, err := SetupFundingRateMonitor(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupFundingRateMonitor should error on nil exchange manager")
+	_, err = SetupFundingRateMonitor(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager, "SetupFundingRateMonitor should error on nil comms manager")
+	_, err = SetupFundingRateMonitor(NewExchangeManager(), &CommunicationManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig, "SetupFundingRateMonitor should error on nil config")
+	f, err := SetupFundingRateMonitor(NewExchangeManager(), &CommunicationManager{}, &config.FundingRateMonitor{})
+	require.NoError(t, err, "SetupFundingRateMonitor must not error")
+	assert.Equal(t, config.DefaultFundingRateMonitorCheckInterval, f.checkInterval, "checkInterval should default")
+	assert.Equal(t, config.DefaultFundingRateAlertCooldown, f.alertCooldown, "alertCooldown should default")
+}
+
+func TestFundingRateMonitorStartStop(t *testing.T) {
+	t.Parallel()
+	var f *FundingRateMonitor
+	assert.ErrorIs(t, f.Start(), ErrNilSubsystem, "Start should error on nil subsystem")
+	assert.ErrorIs(t, f.Stop(), ErrNilSubsystem, "Stop should error on nil subsystem")
+	assert.False(t, f.IsRunning(), "IsRunning should return false")
+
+	f, err := SetupFundingRateMonitor(NewExchangeManager(), &CommunicationManager{}, &config.FundingRateMonitor{})
+	require.NoError(t, err, "SetupFundingRateMonitor must not error")
+	_, _, err = f.Subscribe()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "Subscribe should error when not started")
+	_, err = f.GetOpportunities()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetOpportunities should error when not started")
+	_, err = f.GetFundingRates()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetFundingRates should error when not started")
+	assert.ErrorIs(t, f.Stop(), ErrSubSystemNotStarted, "Stop should error when not started")
+	require.NoError(t, f.Start(), "Start must not error")
+	assert.ErrorIs(t, f.Start(), ErrSubSystemAlreadyStarted, "Start should error when already started")
+	assert.True(t, f.IsRunning(), "IsRunning should return true")
+
+	_, ch, err := f.Subscribe()
+	require.NoError(t, err, "Subscribe must not error")
+	require.NoError(t, f.Stop(), "Stop must not error")
+	_, ok := <-ch
+	assert.False(t, ok, "Subscriber channel should be closed on Stop")
+	assert.False(t, f.IsRunning(), "IsRunning should return false")
+}
+
+func TestFundingRateMonitorPoll(t *testing.T) {
+	t.Parallel()
+	f, comms := setupFundingRateMonitorTest(t, &config.FundingRateMonitor{AlertThresholdAnnualisedCarry: 40},
+		&fundingTestExchange{name: "fundinga", rate: 0.0003, perpPrice: 101, spotPrice: 100},
+		&fundingTestExchange{name: "fundingb", rate: -0.0001, perpPrice: 100, requiresPair: true},
+	)
+	_, ch, err := f.Subscribe()
+	require.NoError(t, err, "Subscribe must not error")
+
+	f.poll(context.Background())
+	rates, err := f.GetFundingRates()
+	require.NoError(t, err, "GetFundingRates must not error")
+	require.Len(t, rates, 2, "Rates must be retrieved from both exchanges")
+	assert.Equal(t, "fundinga", rates[0].Exchange, "Rates should be ordered by annualised rate")
+	assert.Equal(t, time.Hour*8, rates[0].Interval, "Interval should be inferred from funding times")
+	assert.InDelta(t, 32.85, rates[0].AnnualisedRate, 1e-9, "AnnualisedRate should be correct")
+	assert.InDelta(t, 1.0, rates[0].BasisPercent, 1e-9, "BasisPercent should use the mark price")
+	assert.True(t, rates[1].SpotPair.IsEmpty(), "SpotPair should be empty without a spot market")
+	assert.Equal(t, currency.BTC, rates[1].Underlying, "Underlying should be the base currency")
+
+	opportunities, err := f.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	require.Len(t, opportunities, 2, "Opportunities must be found")
+	assert.Equal(t, FundingSpread, opportunities[0].Type, "Opportunities should be ordered by carry")
+	assert.Equal(t, "fundingb", opportunities[0].LongExchange, "Long leg should have the lower funding rate")
+	assert.Equal(t, "fundinga", opportunities[0].ShortExchange, "Short leg should have the higher funding rate")
+	assert.InDelta(t, 43.8, opportunities[0].AnnualisedCarry, 1e-9, "AnnualisedCarry should be correct")
+	assert.InDelta(t, 1.0, opportunities[0].BasisPercent, 1e-9, "BasisPercent should be correct")
+	assert.Equal(t, CashAndCarry, opportunities[1].Type, "Cash and carry should be found")
+	assert.Equal(t, asset.Spot, opportunities[1].LongAsset, "Cash and carry should buy spot")
+	assert.Len(t, ch, 2, "Opportunities should be published to subscribers")
+
+	events := comms.getEvents()
+	require.Len(t, events, 1, "Only the opportunity above the alert threshold must be alerted")
+	assert.Equal(t, "funding", events[0].Type, "Event type should be correct")
+
+	f.poll(context.Background())
+	assert.Len(t, comms.getEvents(), 1, "Alerts should not repeat within the cooldown")
+
+	f.minimumCarry = 50
+	f.poll(context.Background())
+	opportunities, err = f.GetOpportunities()
+	require.NoError(t, err, "GetOpportunities must not error")
+	assert.Empty(t, opportunities, "Opportunities below the minimum carry should be excluded")
+	require.Len(t, f.lastAlert, 1, "Alert must be remembered within the cooldown")
+
+	for k := range f.lastAlert {
+		f.lastAlert[k] = time.Now().Add(-f.alertCooldown)
+	}
+	f.poll(context.Background())
+	assert.Empty(t, f.lastAlert, "Alerts should be evicted once the cooldown has passed")
+}
+
+func TestCashAndCarry(t *testing.T) {
+	t.Parallel()
+	r := &PerpetualFundingRate{
+		Exchange:       "test",
+		Asset:          asset.USDTMarginedFutures,
+		Pair:           fundingPerpPair,
+		Underlying:     currency.BTC,
+		AnnualisedRate: -10,
+		Price:          99,
+		SpotPair:       fundingPerpPair,
+		SpotPrice:      100,
+	}
+	o := cashAndCarry(r)
+	assert.Equal(t, ReverseCashAndCarry, o.Type, "Negative funding should reverse the trade")
+	assert.Equal(t, asset.USDTMarginedFutures, o.LongAsset, "Long leg should be the perpetual")
+	assert.Equal(t, asset.Spot, o.ShortAsset, "Short leg should be spot")
+	assert.Equal(t, 10.0, o.AnnualisedCarry, "AnnualisedCarry should be positive")
+	assert.InDelta(t, 1.0101010101, o.BasisPercent, 1e-9, "BasisPercent should be correct")
+
+	r.AnnualisedRate = 10
+	o = cashAndCarry(r)
+	assert.Equal(t, CashAndCarry, o.Type, "Positive funding should short the perpetual")
+	assert.Equal(t, 10.0, o.AnnualisedCarry, "AnnualisedCarry should be correct")
+}
+
+func TestFundingInterval(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	r := &fundingrate.LatestRateResponse{}
+	assert.Equal(t, defaultFundingInterval, fundingInterval(r), "Interval should default without funding times")
+	r.LatestRate.Time = now
+	r.TimeOfNextRate = now.Add(time.Hour * 4)
+	assert.Equal(t, time.Hour*4, fundingInterval(r), "Interval should be inferred")
+	r.TimeOfNextRate = now.Add(time.Hour * 48)
+	assert.Equal(t, defaultFundingInterval, fundingInterval(r), "Interval should default when too long")
+	r.TimeOfNextRate = now
+	assert.Equal(t, defaultFundingInterval, fundingInterval(r), "Interval should default when not positive")
+}
+
+func TestAnnualiseFundingRate(t *testing.T) {
+	t.Parallel()
+	assert.InDelta(t, 10.95, annualiseFundingRate(0.0001, time.Hour*8), 1e-9, "8 hourly rate should annualise")
+	assert.InDelta(t, 87.6, annualiseFundingRate(0.0001, time.Hour), 1e-9, "Hourly rate should annualise")
+	assert.InDelta(t, 10.95, annualiseFundingRate(0.0001, 0), 1e-9, "Interval should default")
+}
+
+func TestFundingUnderlying(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, currency.BTC, fundingUnderlying(currency.NewPair(currency.XBT, currency.USD)), "Aliases should be normalised")
+	assert.Equal(t, currency.BTC, fundingUnderlying(currency.NewPair(currency.NewCode("BTCUSD"), currency.PERP)), "Settlement currency should be trimmed")
+	assert.Equal(t, currency.ETH, fundingUnderlying(currency.NewPair(currency.ETH, currency.USDT)), "Base should be returned")
+}
+
+func TestFundingOpportunityTypeFromString(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		in  string
+		out FundingOpportunityType
+	}{
+		{"cash and carry", CashAndCarry},
+		{"Reverse_Cash_And_Carry", ReverseCashAndCarry},
+		{"funding-spread", FundingSpread},
+	} {
+		typ, err := fundingOpportunityTypeFromString(tc.in)
+		require.NoError(t, err, "fundingOpportunityTypeFromString must not error")
+		assert.Equal(t, tc.out, typ, "Type should match %q", tc.in)
+	}
+	_, err := fundingOpportunityTypeFromString("meow")
+	assert.ErrorIs(t, err, errUnknownFundingOpportunityType, "fundingOpportunityTypeFromString should error on unknown type")
+	assert.Equal(t, "unknown", UnknownFundingOpportunity.String(), "String should be correct")
+	assert.Equal(t, "cash and carry", CashAndCarry.String(), "String should be correct")
+}
@@ -0,0 +1,142 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// FundingRateMonitorName is an exported subsystem name
+const FundingRateMonitorName = "funding_rate_monitor"
+
+const (
+	// defaultFundingInterval is used to annualise funding rates when the
+	// interval cannot be inferred from the exchange's funding times
+	defaultFundingInterval = time.Hour * 8
+	// maximumFundingInterval bounds inferred funding intervals so that
+	// irregular funding times do not understate annualised rates
+	maximumFundingInterval = time.Hour * 24
+	// fundingRateSubscriberBuffer is the number of opportunities buffered for
+	// each subscriber before updates are dropped
+	fundingRateSubscriberBuffer = 100
+	// hoursPerYear is used to annualise funding rates
+	hoursPerYear = 365 * 24
+)
+
+var (
+	errNoFundingPrice                = errors.New("no price available")
+	errUnknownFundingOpportunityType = errors.New("unknown funding opportunity type")
+)
+
+// FundingOpportunityType describes how an opportunity captures funding
+type FundingOpportunityType uint8
+
+// Funding opportunity types
+const (
+	UnknownFundingOpportunity FundingOpportunityType = iota
+	// CashAndCarry buys spot and shorts the perpetual to receive positive
+	// funding
+	CashAndCarry
+	// ReverseCashAndCarry shorts spot and buys the perpetual to receive
+	// negative funding
+	ReverseCashAndCarry
+	// FundingSpread buys the perpetual with the lower funding rate and shorts
+	// the perpetual with the higher funding rate
+	FundingSpread
+)
+
+// fundingSpotQuotes are the quote currencies checked, after the perpetual's
+// own quote, when matching a perpetual to a spot market
+var fundingSpotQuotes = []currency.Code{currency.USDT, currency.USD, currency.USDC, currency.BUSD}
+
+// FundingRateMonitor polls the latest funding rates of enabled perpetual
+// futures across all exchanges, ranking cash and carry opportunities against
+// spot markets and funding rate spreads between perpetuals on the same
+// underlying
+type FundingRateMonitor struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	commsManager    iCommsManager
+	checkInterval   time.Duration
+	minimumCarry    float64
+	alertThreshold  float64
+	alertCooldown   time.Duration
+	verbose         bool
+
+	m             sync.Mutex
+	rates         []PerpetualFundingRate
+	opportunities []FundingRateOpportunity
+	lastAlert     map[fundingOpportunityKey]time.Time
+	subscribers   map[uuid.UUID]chan FundingRateOpportunity
+}
+
+// PerpetualFundingRate is the latest funding rate of a perpetual future along
+// with its basis against the matching spot market on the same exchange
+type PerpetualFundingRate struct {
+	Exchange   string
+	Asset      asset.Item
+	Pair       currency.Pair
+	Underlying currency.Code
+	// Rate is the funding rate paid by longs to shorts each interval
+	Rate     float64
+	Interval time.Duration
+	// AnnualisedRate is the funding rate as an annual percentage
+	AnnualisedRate float64
+	TimeOfNextRate time.Time
+	Price          float64
+	// SpotPair and SpotPrice are empty when the exchange has no enabled spot
+	// market for the underlying
+	SpotPair  currency.Pair
+	SpotPrice float64
+	// BasisPercent is the perpetual's premium to the spot price
+	BasisPercent float64
+	Time         time.Time
+}
+
+// FundingRateOpportunity is a pair of offsetting positions which earn the
+// difference in funding between the short and long legs. Spot legs do not
+// pay or receive funding
+type FundingRateOpportunity struct {
+	Type       FundingOpportunityType
+	Underlying currency.Code
+
+	LongExchange       string
+	LongAsset          asset.Item
+	LongPair           currency.Pair
+	LongPrice          float64
+	LongAnnualisedRate float64
+
+	ShortExchange       string
+	ShortAsset          asset.Item
+	ShortPair           currency.Pair
+	ShortPrice          float64
+	ShortAnnualisedRate float64
+
+	// AnnualisedCarry is the annual percentage earned from funding, being the
+	// short leg's annualised rate less the long leg's
+	AnnualisedCarry float64
+	// BasisPercent is the short leg's premium to the long leg's price
+	BasisPercent   float64
+	TimeOfNextRate time.Time
+	Time           time.Time
+}
+
+// fundingLegKey identifies one side of an opportunity
+type fundingLegKey struct {
+	exchange string
+	key.PairAsset
+}
+
+// fundingOpportunityKey uniquely identifies an opportunity
+type fundingOpportunityKey struct {
+	typ   FundingOpportunityType
+	long  fundingLegKey
+	short fundingLegKey
+}
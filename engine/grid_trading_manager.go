@@ -0,0 +1,670 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/grid"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupGridTradingManager applies configuration parameters before running.
+// The database connection manager is optional, without a connected database
+// grids will not persist across restarts
+func SetupGridTradingManager(exchangeManager iExchangeManager, orderManager iAlgoOrderManager, databaseManager iDatabaseConnectionManager, cfg *config.GridTradingManager) (*GridTradingManager, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", GridTradingManagerName, errNilConfig)
+	}
+	var db grid.IDBService
+	if databaseManager != nil {
+		dbService, err := grid.Setup(databaseManager.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		if dbService != nil {
+			db = dbService
+		}
+	}
+	checkInterval := cfg.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = config.DefaultGridTradingCheckInterval
+	}
+	return &GridTradingManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		db:              db,
+		grids:           make(map[string]*Grid),
+		checkInterval:   checkInterval,
+		verbose:         cfg.Verbose,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *GridTradingManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem and restores active grids from the database
+func (m *GridTradingManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", GridTradingManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", GridTradingManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Grid trading manager %s", MsgSubSystemStarting)
+	if m.db == nil {
+		log.Warnln(log.OrderMgr, "Grid trading manager database not connected, grids will not persist across restarts")
+	} else if err := m.loadActiveGrids(); err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return err
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.OrderMgr, "Grid trading manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem. Resting grid orders are left on
+// the exchange and active grids resume when the subsystem is started again
+func (m *GridTradingManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", GridTradingManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("%s %w", GridTradingManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Grid trading manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	atomic.StoreInt32(&m.started, 0)
+	log.Debugf(log.OrderMgr, "Grid trading manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run checks grid orders for fills every check interval
+func (m *GridTradingManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func(shutdown <-chan struct{}) {
+		<-shutdown
+		cancel()
+	}(m.shutdown)
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.processGrids(ctx)
+		}
+	}
+}
+
+// Create validates a grid, calculates its level prices and places its initial
+// orders. Levels below the current price are bought and levels above it are
+// sold, leaving the level closest to the current price empty. Returns the
+// grid ID
+func (m *GridTradingManager) Create(ctx context.Context, g *Grid) (string, error) {
+	if !m.IsRunning() {
+		return "", fmt.Errorf("%s %w", GridTradingManagerName, ErrSubSystemNotStarted)
+	}
+	newGrid, err := m.prepare(ctx, g)
+	if err != nil {
+		return "", err
+	}
+	m.m.Lock()
+	m.grids[newGrid.ID] = newGrid
+	log.Infof(log.OrderMgr, "Grid trading manager created %s grid %s for %s %s %s with %d levels between %v and %v",
+		newGrid.Spacing, newGrid.ID, newGrid.Exchange, newGrid.Asset, newGrid.Pair, newGrid.Levels, newGrid.LowerPrice, newGrid.UpperPrice)
+	placements := m.pendingOrders(newGrid)
+	m.m.Unlock()
+
+	m.submitOrders(ctx, placements)
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.applyOrders(newGrid, placements)
+	if err = m.persist(newGrid); err != nil {
+		log.Errorf(log.OrderMgr, "Grid trading manager unable to persist grid %s: %v", newGrid.ID, err)
+	}
+	return newGrid.ID, nil
+}
+
+// StopGrid stops an active grid and cancels its resting orders
+func (m *GridTradingManager) StopGrid(ctx context.Context, id string) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", GridTradingManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	g, ok := m.grids[id]
+	if !ok {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s", ErrGridNotFound, id)
+	}
+	if g.Status != GridActive {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s status %s", errGridNotActive, id, g.Status)
+	}
+	if g.pending {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s", errGridBusy, id)
+	}
+	var cancels []order.Cancel
+	var levels []int
+	for i := range g.GridLevels {
+		if g.GridLevels[i].OrderID == "" {
+			continue
+		}
+		cancels = append(cancels, order.Cancel{
+			Exchange:  g.Exchange,
+			OrderID:   g.GridLevels[i].OrderID,
+			Side:      g.GridLevels[i].Side,
+			Pair:      g.Pair,
+			AssetType: g.Asset,
+		})
+		levels = append(levels, i)
+	}
+	g.pending = true
+	m.m.Unlock()
+
+	cancelErrs := make([]error, len(cancels))
+	for i := range cancels {
+		cancelErrs[i] = m.orderManager.Cancel(ctx, &cancels[i])
+	}
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	g.pending = false
+	var errs error
+	for i, idx := range levels {
+		if cancelErrs[i] != nil {
+			errs = common.AppendError(errs, fmt.Errorf("level %d order %s: %w", g.GridLevels[idx].Level, cancels[i].OrderID, cancelErrs[i]))
+			continue
+		}
+		g.GridLevels[idx].OrderID = ""
+	}
+	g.Status = GridStopped
+	g.Reason = "stopped by user"
+	g.UpdatedDate = time.Now()
+	if err := m.persist(g); err != nil {
+		errs = common.AppendError(errs, err)
+	}
+	return errs
+}
+
+// GetGrid returns a copy of a grid by its ID
+func (m *GridTradingManager) GetGrid(id string) (*Grid, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", GridTradingManagerName, ErrNilSubsystem)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	g, ok := m.grids[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrGridNotFound, id)
+	}
+	return g.copy(), nil
+}
+
+// GetGrids returns copies of all grids ordered by creation date
+func (m *GridTradingManager) GetGrids() ([]Grid, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", GridTradingManagerName, ErrNilSubsystem)
+	}
+	m.m.Lock()
+	resp := make([]Grid, 0, len(m.grids))
+	for _, g := range m.grids {
+		resp = append(resp, *g.copy())
+	}
+	m.m.Unlock()
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CreatedDate.Before(resp[j].CreatedDate)
+	})
+	return resp, nil
+}
+
+// processGrids checks the orders of all active grids. Orders are placed
+// without the lock held
+func (m *GridTradingManager) processGrids(ctx context.Context) {
+	m.m.Lock()
+	placements := make(map[*Grid][]gridPlacement)
+	for _, g := range m.grids {
+		if p := m.processGrid(g); len(p) > 0 {
+			placements[g] = p
+		}
+	}
+	m.m.Unlock()
+	if len(placements) == 0 {
+		return
+	}
+	for _, p := range placements {
+		m.submitOrders(ctx, p)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for g, p := range placements {
+		if !m.applyOrders(g, p) {
+			continue
+		}
+		g.UpdatedDate = time.Now()
+		if err := m.persist(g); err != nil {
+			log.Errorf(log.OrderMgr, "Grid trading manager unable to persist grid %s: %v", g.ID, err)
+		}
+	}
+}
+
+// processGrid replaces filled levels with an order on the opposite side one
+// level away, persisting the grid when it changes, and returns the orders to
+// place for levels without one. Must be called with the lock held
+func (m *GridTradingManager) processGrid(g *Grid) []gridPlacement {
+	if g.Status != GridActive || g.pending {
+		return nil
+	}
+	var changed bool
+	var replacements []gridReplacement
+	for i := range g.GridLevels {
+		l := &g.GridLevels[i]
+		if l.OrderID == "" {
+			continue
+		}
+		d, err := m.orderManager.GetByExchangeAndID(g.Exchange, l.OrderID)
+		if err != nil {
+			if m.verbose {
+				log.Warnf(log.OrderMgr, "Grid trading manager unable to retrieve order %s for grid %s level %d: %v",
+					l.OrderID, g.ID, l.Level, err)
+			}
+			continue
+		}
+		switch {
+		case d.Status == order.Filled:
+			if r, ok := g.fill(i, d); ok {
+				replacements = append(replacements, r)
+			}
+			changed = true
+		case d.Status.IsInactive():
+			// The order was removed without filling, it is placed again
+			// below
+			if m.verbose {
+				log.Debugf(log.OrderMgr, "Grid trading manager grid %s level %d order %s is %s, replacing",
+					g.ID, l.Level, l.OrderID, d.Status)
+			}
+			l.OrderID = ""
+			changed = true
+		}
+	}
+	// Replacements are assigned once every filled level has been cleared so
+	// that a price gap through several levels replaces each of them
+	for i := range replacements {
+		g.replace(&replacements[i])
+	}
+	if changed {
+		g.UpdatedDate = time.Now()
+		if err := m.persist(g); err != nil {
+			log.Errorf(log.OrderMgr, "Grid trading manager unable to persist grid %s: %v", g.ID, err)
+		}
+	}
+	return m.pendingOrders(g)
+}
+
+// pendingOrders returns limit orders for levels which have a side but no
+// resting order, marking the grid pending until they are applied. Must be
+// called with the lock held
+func (m *GridTradingManager) pendingOrders(g *Grid) []gridPlacement {
+	var placements []gridPlacement
+	for i := range g.GridLevels {
+		l := &g.GridLevels[i]
+		if l.Side == order.UnknownSide || l.OrderID != "" {
+			continue
+		}
+		placements = append(placements, gridPlacement{
+			level: i,
+			submit: &order.Submit{
+				Exchange:  g.Exchange,
+				Type:      order.Limit,
+				Side:      l.Side,
+				Pair:      g.Pair,
+				AssetType: g.Asset,
+				Amount:    g.AmountPerLevel,
+				Price:     l.Price,
+			},
+		})
+	}
+	if len(placements) > 0 {
+		g.pending = true
+	}
+	return placements
+}
+
+// submitOrders submits grid orders through the order manager. Must be called
+// without the lock held
+func (m *GridTradingManager) submitOrders(ctx context.Context, placements []gridPlacement) {
+	for i := range placements {
+		placements[i].resp, placements[i].err = m.orderManager.Submit(ctx, placements[i].submit)
+	}
+}
+
+// applyOrders records submitted orders against their levels. Returns whether
+// any order was placed. Failed submissions are retried on the next check.
+// Must be called with the lock held
+func (m *GridTradingManager) applyOrders(g *Grid, placements []gridPlacement) bool {
+	g.pending = false
+	var placed bool
+	for i := range placements {
+		l := &g.GridLevels[placements[i].level]
+		if err := placements[i].err; err != nil {
+			g.Reason = err.Error()
+			log.Errorf(log.OrderMgr, "Grid trading manager failed to place %s order for grid %s level %d at %v: %v",
+				l.Side, g.ID, l.Level, l.Price, err)
+			continue
+		}
+		l.OrderID = placements[i].resp.OrderID
+		g.Reason = ""
+		placed = true
+		if m.verbose {
+			log.Debugf(log.OrderMgr, "Grid trading manager placed %s order %s for grid %s level %d at %v",
+				l.Side, l.OrderID, g.ID, l.Level, l.Price)
+		}
+	}
+	return placed
+}
+
+// prepare validates a grid and returns a copy with its level prices and
+// amount conformed to the exchange's limits, ready to be stored
+func (m *GridTradingManager) prepare(ctx context.Context, g *Grid) (*Grid, error) {
+	if g == nil {
+		return nil, errNilGrid
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(g.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	if g.Pair.IsEmpty() {
+		return nil, order.ErrPairIsEmpty
+	}
+	if !g.Asset.IsValid() {
+		return nil, fmt.Errorf("'%s' %w", g.Asset, asset.ErrNotSupported)
+	}
+	if g.Levels < 2 {
+		return nil, errGridLevelCountInvalid
+	}
+	if g.LowerPrice <= 0 || g.LowerPrice >= g.UpperPrice {
+		return nil, errGridPriceRangeInvalid
+	}
+	spacing := GridSpacing(strings.ToUpper(string(g.Spacing)))
+	if spacing != ArithmeticGrid && spacing != GeometricGrid {
+		return nil, fmt.Errorf("%w %q", errGridSpacingInvalid, g.Spacing)
+	}
+
+	limits, err := exch.GetOrderExecutionLimits(g.Asset, g.Pair)
+	if err != nil {
+		// Orders are checked against the exchange's limits before submission
+		limits = order.MinMaxLevel{}
+	}
+	amount := limits.ConformToAmount(g.AmountPerLevel)
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w %v", order.ErrAmountIsInvalid, g.AmountPerLevel)
+	}
+	prices := gridLevelPrices(g.LowerPrice, g.UpperPrice, g.Levels, spacing)
+	for i := range prices {
+		prices[i] = conformGridPrice(prices[i], limits.PriceStepIncrementSize)
+		if i > 0 && prices[i]-prices[i-1] <= prices[i]*amountTolerance {
+			return nil, fmt.Errorf("%w %v", errGridLevelsTooClose, limits.PriceStepIncrementSize)
+		}
+	}
+
+	tick, err := exch.FetchTicker(ctx, g.Pair, g.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errNoGridReferencePrice, err)
+	}
+	var reference float64
+	switch {
+	case tick.Bid > 0 && tick.Ask > 0:
+		reference = (tick.Bid + tick.Ask) / 2
+	case tick.Last > 0:
+		reference = tick.Last
+	default:
+		return nil, fmt.Errorf("%w for %s %s %s", errNoGridReferencePrice, g.Exchange, g.Asset, g.Pair)
+	}
+
+	now := time.Now()
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	newGrid := &Grid{
+		ID:             id.String(),
+		Exchange:       exch.GetName(),
+		Asset:          g.Asset,
+		Pair:           g.Pair,
+		LowerPrice:     prices[0],
+		UpperPrice:     prices[len(prices)-1],
+		Levels:         g.Levels,
+		Spacing:        spacing,
+		AmountPerLevel: amount,
+		Status:         GridActive,
+		GridLevels:     make([]GridLevel, len(prices)),
+		CreatedDate:    now,
+		UpdatedDate:    now,
+	}
+	closest := 0
+	for i := range prices {
+		if math.Abs(prices[i]-reference) < math.Abs(prices[closest]-reference) {
+			closest = i
+		}
+	}
+	for i := range prices {
+		newGrid.GridLevels[i] = GridLevel{Level: int64(i), Price: prices[i]}
+		switch {
+		case i < closest:
+			newGrid.GridLevels[i].Side = order.Buy
+		case i > closest:
+			newGrid.GridLevels[i].Side = order.Sell
+		}
+	}
+	return newGrid, nil
+}
+
+// loadActiveGrids restores active grids from the database
+func (m *GridTradingManager) loadActiveGrids() error {
+	stored, err := m.db.GetByStatus(string(GridActive))
+	if err != nil {
+		return err
+	}
+	var restored int
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range stored {
+		var g *Grid
+		g, err = gridFromDB(&stored[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Grid trading manager unable to restore grid %s: %v", stored[i].ID, err)
+			continue
+		}
+		m.grids[g.ID] = g
+		restored++
+	}
+	if restored > 0 {
+		log.Infof(log.OrderMgr, "Grid trading manager restored %d active grids", restored)
+	}
+	return nil
+}
+
+// persist stores a grid and its levels in the database when connected
+func (m *GridTradingManager) persist(g *Grid) error {
+	if m.db == nil {
+		return nil
+	}
+	dbGrid := &grid.Grid{
+		ID:             g.ID,
+		Exchange:       g.Exchange,
+		Asset:          g.Asset.String(),
+		Base:           g.Pair.Base.String(),
+		Quote:          g.Pair.Quote.String(),
+		LowerPrice:     g.LowerPrice,
+		UpperPrice:     g.UpperPrice,
+		Levels:         g.Levels,
+		Spacing:        string(g.Spacing),
+		Amount:         g.AmountPerLevel,
+		Status:         string(g.Status),
+		Reason:         g.Reason,
+		RealisedProfit: g.RealisedProfit,
+		RoundTrips:     g.RoundTrips,
+		CreatedDate:    g.CreatedDate,
+		UpdatedDate:    g.UpdatedDate,
+		GridLevels:     make([]grid.Level, len(g.GridLevels)),
+	}
+	for i := range g.GridLevels {
+		dbGrid.GridLevels[i] = grid.Level{
+			Level:      g.GridLevels[i].Level,
+			Price:      g.GridLevels[i].Price,
+			OrderID:    g.GridLevels[i].OrderID,
+			EntryPrice: g.GridLevels[i].EntryPrice,
+		}
+		if g.GridLevels[i].Side != order.UnknownSide {
+			dbGrid.GridLevels[i].Side = g.GridLevels[i].Side.String()
+		}
+	}
+	return m.db.Upsert(dbGrid)
+}
+
+// gridFromDB converts a database grid
+func gridFromDB(g *grid.Grid) (*Grid, error) {
+	a, err := asset.New(g.Asset)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Grid{
+		ID:             g.ID,
+		Exchange:       g.Exchange,
+		Asset:          a,
+		Pair:           currency.NewPair(currency.NewCode(g.Base), currency.NewCode(g.Quote)),
+		LowerPrice:     g.LowerPrice,
+		UpperPrice:     g.UpperPrice,
+		Levels:         g.Levels,
+		Spacing:        GridSpacing(g.Spacing),
+		AmountPerLevel: g.Amount,
+		Status:         GridStatus(g.Status),
+		Reason:         g.Reason,
+		RealisedProfit: g.RealisedProfit,
+		RoundTrips:     g.RoundTrips,
+		GridLevels:     make([]GridLevel, len(g.GridLevels)),
+		CreatedDate:    g.CreatedDate,
+		UpdatedDate:    g.UpdatedDate,
+	}
+	for i := range g.GridLevels {
+		resp.GridLevels[i] = GridLevel{
+			Level:      g.GridLevels[i].Level,
+			Price:      g.GridLevels[i].Price,
+			OrderID:    g.GridLevels[i].OrderID,
+			EntryPrice: g.GridLevels[i].EntryPrice,
+		}
+		if g.GridLevels[i].Side == "" {
+			continue
+		}
+		resp.GridLevels[i].Side, err = order.StringToOrderSide(g.GridLevels[i].Side)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// gridLevelPrices returns the prices of each level of a grid from the lower to
+// the upper price inclusive
+func gridLevelPrices(lower, upper float64, levels int64, spacing GridSpacing) []float64 {
+	prices := make([]float64, levels)
+	steps := float64(levels - 1)
+	for i := range prices {
+		if spacing == GeometricGrid {
+			prices[i] = lower * math.Pow(upper/lower, float64(i)/steps)
+			continue
+		}
+		prices[i] = lower + (upper-lower)*float64(i)/steps
+	}
+	return prices
+}
+
+// conformGridPrice rounds a level's price to the nearest multiple of the
+// exchange's price step
+func conformGridPrice(price, step float64) float64 {
+	if step <= 0 {
+		return price
+	}
+	return math.Round(price/step) * step
+}
+
+// fill records the profit of a filled level and clears it. Returns the order
+// to place on the opposite side one level away, if the level is within the
+// grid
+func (g *Grid) fill(idx int, d *order.Detail) (gridReplacement, bool) {
+	l := &g.GridLevels[idx]
+	amount, price := filledFromDetail(d)
+	if amount <= 0 {
+		amount = g.AmountPerLevel
+	}
+	if price <= 0 {
+		price = l.Price
+	}
+	r := gridReplacement{from: idx, target: idx + 1, side: order.Sell, entryPrice: price}
+	if l.Side.IsShort() {
+		r.target = idx - 1
+		r.side = order.Buy
+	}
+	if l.EntryPrice > 0 {
+		profit := (price - l.EntryPrice) * amount
+		if l.Side.IsLong() {
+			profit = -profit
+		}
+		g.RealisedProfit += profit
+		g.RoundTrips++
+	}
+	log.Infof(log.OrderMgr, "Grid trading manager grid %s level %d %s order %s filled %v at %v, realised profit %v",
+		g.ID, l.Level, l.Side, l.OrderID, amount, price, g.RealisedProfit)
+	l.Side = order.UnknownSide
+	l.OrderID = ""
+	l.EntryPrice = 0
+	return r, r.target >= 0 && r.target < len(g.GridLevels)
+}
+
+// replace assigns the opposite side order of a filled level to its target
+// level, unless the target level already has an order
+func (g *Grid) replace(r *gridReplacement) {
+	next := &g.GridLevels[r.target]
+	if next.Side != order.UnknownSide {
+		log.Warnf(log.OrderMgr, "Grid trading manager grid %s level %d already has a %s order, not replacing fill of level %d",
+			g.ID, next.Level, next.Side, g.GridLevels[r.from].Level)
+		return
+	}
+	next.Side = r.side
+	next.EntryPrice = r.entryPrice
+	next.OrderID = ""
+}
+
+// copy returns a deep copy of a grid
+func (g *Grid) copy() *Grid {
+	cpy := *g
+	cpy.GridLevels = append([]GridLevel(nil), g.GridLevels...)
+	return &cpy
+}
@@ -0,0 +1,385 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/grid"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+type fakeGridDB struct {
+	m     sync.Mutex
+	grids map[string]grid.Grid
+}
+
+func (f *fakeGridDB) Upsert(grids ...*grid.Grid) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	for i := range grids {
+		g := *grids[i]
+		g.GridLevels = append([]grid.Level(nil), grids[i].GridLevels...)
+		f.grids[grids[i].ID] = g
+	}
+	return nil
+}
+
+func (f *fakeGridDB) GetByID(id string) (*grid.Grid, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	g, ok := f.grids[id]
+	if !ok {
+		return nil, ErrGridNotFound
+	}
+	return &g, nil
+}
+
+func (f *fakeGridDB) GetByStatus(status string) ([]grid.Grid, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	var resp []grid.Grid
+	for _, g := range f.grids {
+		if g.Status == status {
+			resp = append(resp, g)
+		}
+	}
+	return resp, nil
+}
+
+// gridTestExchange overrides the reference price and execution limits
+type gridTestExchange struct {
+	exchange.IBotExchange
+	tick   *ticker.Price
+	limits order.MinMaxLevel
+}
+
+func (e *gridTestExchange) FetchTicker(context.Context, currency.Pair, asset.Item) (*ticker.Price, error) {
+	if e.tick == nil {
+		return nil, ticker.ErrNoTickerFound
+	}
+	return e.tick, nil
+}
+
+func (e *gridTestExchange) GetOrderExecutionLimits(asset.Item, currency.Pair) (order.MinMaxLevel, error) {
+	return e.limits, nil
+}
+
+func setupGridTradingTest(t *testing.T) (*GridTradingManager, *fakeAlgoOrderManager, *fakeGridDB) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	wrapped := &gridTestExchange{
+		IBotExchange: exch,
+		tick:         &ticker.Price{Bid: 99.5, Ask: 100.5, Last: 90},
+		limits:       order.MinMaxLevel{PriceStepIncrementSize: 0.5, AmountStepIncrementSize: 0.01},
+	}
+	require.NoError(t, em.Add(wrapped), "Add must not error")
+	om := &fakeAlgoOrderManager{orders: make(map[string]*order.Detail)}
+	m, err := SetupGridTradingManager(em, om, nil, &config.GridTradingManager{CheckInterval: time.Hour})
+	require.NoError(t, err, "SetupGridTradingManager must not error")
+	db := &fakeGridDB{grids: make(map[string]grid.Grid)}
+	m.db = db
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() {
+		assert.NoError(t, m.Stop(), "Stop should not error")
+	})
+	return m, om, db
+}
+
+func gridTestGrid() *Grid {
+	return &Grid{
+		Exchange:       testExchange,
+		Asset:          asset.Spot,
+		Pair:           btcusdPair,
+		LowerPrice:     90,
+		UpperPrice:     110,
+		Levels:         5,
+		Spacing:        "arithmetic",
+		AmountPerLevel: 0.123,
+	}
+}
+
+// gridSides returns the side of each level of a grid
+func gridSides(g *Grid) []order.Side {
+	resp := make([]order.Side, len(g.GridLevels))
+	for i := range g.GridLevels {
+		resp[i] = g.GridLevels[i].Side
+	}
+	return resp
+}
+
+func TestSetupGridTradingManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupGridTradingManager(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupGridTradingManager should error on nil exchange manager")
+	_, err = SetupGridTradingManager(NewExchangeManager(), nil, nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager, "SetupGridTradingManager should error on nil order manager")
+	_, err = SetupGridTradingManager(NewExchangeManager(), &fakeAlgoOrderManager{}, nil, nil)
+	assert.ErrorIs(t, err, errNilConfig, "SetupGridTradingManager should error on nil config")
+	m, err := SetupGridTradingManager(NewExchangeManager(), &fakeAlgoOrderManager{}, nil, &config.GridTradingManager{})
+	require.NoError(t, err, "SetupGridTradingManager must not error")
+	assert.Equal(t, config.DefaultGridTradingCheckInterval, m.checkInterval, "checkInterval should be set to the default")
+	assert.Nil(t, m.db, "db should be nil without a database manager")
+}
+
+func TestGridTradingManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *GridTradingManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem, "Start should error on nil subsystem")
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem, "Stop should error on nil subsystem")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+
+	m, err := SetupGridTradingManager(NewExchangeManager(), &fakeAlgoOrderManager{}, nil, &config.GridTradingManager{})
+	require.NoError(t, err, "SetupGridTradingManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted, "Stop should error when not started")
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted, "Start should error when already started")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+}
+
+func TestGridLevelPrices(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []float64{90, 95, 100, 105, 110}, gridLevelPrices(90, 110, 5, ArithmeticGrid), "arithmetic prices should be evenly spaced")
+	prices := gridLevelPrices(100, 400, 3, GeometricGrid)
+	require.Len(t, prices, 3, "gridLevelPrices must return a price for each level")
+	assert.InDelta(t, 100, prices[0], 1e-9, "first geometric price should be the lower price")
+	assert.InDelta(t, 200, prices[1], 1e-9, "geometric prices should share a ratio")
+	assert.InDelta(t, 400, prices[2], 1e-9, "last geometric price should be the upper price")
+	assert.Equal(t, 100.5, conformGridPrice(100.3, 0.5), "conformGridPrice should round to the nearest step")
+	assert.Equal(t, 100.3, conformGridPrice(100.3, 0), "conformGridPrice should not change the price without a step")
+}
+
+func TestGridTradingManagerCreate(t *testing.T) {
+	t.Parallel()
+	m, err := SetupGridTradingManager(NewExchangeManager(), &fakeAlgoOrderManager{}, nil, &config.GridTradingManager{})
+	require.NoError(t, err, "SetupGridTradingManager must not error")
+	_, err = m.Create(context.Background(), gridTestGrid())
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "Create should error when not started")
+
+	m, om, db := setupGridTradingTest(t)
+	_, err = m.Create(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilGrid, "Create should error on nil grid")
+
+	g := gridTestGrid()
+	g.Levels = 1
+	_, err = m.Create(context.Background(), g)
+	assert.ErrorIs(t, err, errGridLevelCountInvalid, "Create should error on a single level")
+
+	g = gridTestGrid()
+	g.LowerPrice = 120
+	_, err = m.Create(context.Background(), g)
+	assert.ErrorIs(t, err, errGridPriceRangeInvalid, "Create should error when the lower price exceeds the upper price")
+
+	g = gridTestGrid()
+	g.Spacing = "fibonacci"
+	_, err = m.Create(context.Background(), g)
+	assert.ErrorIs(t, err, errGridSpacingInvalid, "Create should error on an unsupported spacing")
+
+	g = gridTestGrid()
+	g.AmountPerLevel = 0.001
+	_, err = m.Create(context.Background(), g)
+	assert.ErrorIs(t, err, order.ErrAmountIsInvalid, "Create should error when the amount is below the amount step")
+
+	g = gridTestGrid()
+	g.LowerPrice, g.UpperPrice, g.Levels = 100, 101, 5
+	_, err = m.Create(context.Background(), g)
+	assert.ErrorIs(t, err, errGridLevelsTooClose, "Create should error when levels round to the same price")
+
+	id, err := m.Create(context.Background(), gridTestGrid())
+	require.NoError(t, err, "Create must not error")
+	created, err := m.GetGrid(id)
+	require.NoError(t, err, "GetGrid must not error")
+	assert.Equal(t, GridActive, created.Status, "Status should be active")
+	assert.Equal(t, ArithmeticGrid, created.Spacing, "Spacing should be normalised")
+	assert.Equal(t, 0.12, created.AmountPerLevel, "AmountPerLevel should be conformed to the amount step")
+	assert.Equal(t, []order.Side{order.Buy, order.Buy, order.UnknownSide, order.Sell, order.Sell}, gridSides(created),
+		"levels below the reference price should buy and above should sell")
+	submitted := om.getSubmitted()
+	require.Len(t, submitted, 4, "an order must be placed for each level except the closest to the reference price")
+	for i := range submitted {
+		assert.Equal(t, order.Limit, submitted[i].Type, "grid orders should be limit orders")
+		assert.Equal(t, 0.12, submitted[i].Amount, "grid orders should use the conformed amount")
+	}
+	assert.Equal(t, 95.0, submitted[1].Price, "second order should be placed at the second level")
+	stored, err := db.GetByID(id)
+	require.NoError(t, err, "GetByID must not error")
+	require.Len(t, stored.GridLevels, 5, "grid levels must be persisted")
+	assert.Equal(t, "BUY", stored.GridLevels[0].Side, "level side should be persisted")
+	assert.Empty(t, stored.GridLevels[2].Side, "empty level should not have a side")
+}
+
+func TestGridTradingManagerProcessGrids(t *testing.T) {
+	t.Parallel()
+	m, om, db := setupGridTradingTest(t)
+	id, err := m.Create(context.Background(), gridTestGrid())
+	require.NoError(t, err, "Create must not error")
+
+	// Fill the buy at 95, a sell is placed one level up at 100
+	om.fill("2", 0.12, 95, order.Filled)
+	m.processGrids(context.Background())
+	g, err := m.GetGrid(id)
+	require.NoError(t, err, "GetGrid must not error")
+	assert.Equal(t, []order.Side{order.Buy, order.UnknownSide, order.Sell, order.Sell, order.Sell}, gridSides(g),
+		"filled buy should be replaced by a sell one level up")
+	assert.Equal(t, 95.0, g.GridLevels[2].EntryPrice, "replacement should record the entry price")
+	assert.Equal(t, "5", g.GridLevels[2].OrderID, "replacement order should be placed")
+	assert.Zero(t, g.RoundTrips, "RoundTrips should not increase without a completed round trip")
+
+	// Fill the sell at 100, completing a round trip
+	om.fill("5", 0.12, 100, order.Filled)
+	m.processGrids(context.Background())
+	g, err = m.GetGrid(id)
+	require.NoError(t, err, "GetGrid must not error")
+	assert.Equal(t, []order.Side{order.Buy, order.Buy, order.UnknownSide, order.Sell, order.Sell}, gridSides(g),
+		"filled sell should be replaced by a buy one level down")
+	assert.Equal(t, int64(1), g.RoundTrips, "RoundTrips should increase")
+	assert.InDelta(t, 0.6, g.RealisedProfit, 1e-9, "RealisedProfit should be the level distance multiplied by the amount")
+	assert.Equal(t, 100.0, g.GridLevels[1].EntryPrice, "buy replacement should record the sell price")
+
+	// An order removed without filling is placed again
+	om.fill("1", 0, 0, order.Cancelled)
+	m.processGrids(context.Background())
+	g, err = m.GetGrid(id)
+	require.NoError(t, err, "GetGrid must not error")
+	assert.Equal(t, "7", g.GridLevels[0].OrderID, "cancelled level should be placed again")
+	assert.Equal(t, int64(1), g.RoundTrips, "RoundTrips should not change when an order is cancelled")
+
+	stored, err := db.GetByID(id)
+	require.NoError(t, err, "GetByID must not error")
+	assert.InDelta(t, 0.6, stored.RealisedProfit, 1e-9, "RealisedProfit should be persisted")
+	assert.Equal(t, "7", stored.GridLevels[0].OrderID, "replacement order ID should be persisted")
+
+	om.m.Lock()
+	om.fail = true
+	om.m.Unlock()
+	om.fill("3", 0.12, 105, order.Filled)
+	m.processGrids(context.Background())
+	g, err = m.GetGrid(id)
+	require.NoError(t, err, "GetGrid must not error")
+	assert.Empty(t, g.GridLevels[2].OrderID, "failed placement should leave the level without an order")
+	assert.Equal(t, order.Buy, g.GridLevels[2].Side, "failed placement should keep the level's side to retry")
+	assert.NotEmpty(t, g.Reason, "Reason should record the placement failure")
+}
+
+func TestGridTradingManagerGapThroughLevels(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupGridTradingTest(t)
+	id, err := m.Create(context.Background(), gridTestGrid())
+	require.NoError(t, err, "Create must not error")
+
+	// Price gaps down through both buys before the grid is checked
+	om.fill("1", 0.12, 90, order.Filled)
+	om.fill("2", 0.12, 95, order.Filled)
+	m.processGrids(context.Background())
+	g, err := m.GetGrid(id)
+	require.NoError(t, err, "GetGrid must not error")
+	assert.Equal(t, []order.Side{order.UnknownSide, order.Sell, order.Sell, order.Sell, order.Sell}, gridSides(g),
+		"each filled buy should be replaced by a sell one level up")
+	assert.Equal(t, 90.0, g.GridLevels[1].EntryPrice, "lower replacement should record its fill price")
+	assert.Equal(t, 95.0, g.GridLevels[2].EntryPrice, "upper replacement should record its fill price")
+	assert.Len(t, om.getSubmitted(), 6, "both replacements should be placed")
+
+	// Price gaps back up through all sells
+	for _, orderID := range []string{"3", "4", "5", "6"} {
+		om.fill(orderID, 0.12, 0, order.Filled)
+	}
+	m.processGrids(context.Background())
+	g, err = m.GetGrid(id)
+	require.NoError(t, err, "GetGrid must not error")
+	assert.Equal(t, []order.Side{order.Buy, order.Buy, order.Buy, order.Buy, order.UnknownSide}, gridSides(g),
+		"each filled sell should be replaced by a buy one level down")
+	assert.Equal(t, int64(2), g.RoundTrips, "RoundTrips should include both completed round trips")
+}
+
+func TestGridTradingManagerStopGrid(t *testing.T) {
+	t.Parallel()
+	m, om, db := setupGridTradingTest(t)
+	assert.ErrorIs(t, m.StopGrid(context.Background(), "1337"), ErrGridNotFound, "StopGrid should error on unknown id")
+	id, err := m.Create(context.Background(), gridTestGrid())
+	require.NoError(t, err, "Create must not error")
+	require.NoError(t, m.StopGrid(context.Background(), id), "StopGrid must not error")
+	assert.ErrorIs(t, m.StopGrid(context.Background(), id), errGridNotActive, "StopGrid should error when already stopped")
+	om.m.Lock()
+	assert.Len(t, om.cancelled, 4, "all resting orders should be cancelled")
+	om.m.Unlock()
+	stored, err := db.GetByID(id)
+	require.NoError(t, err, "GetByID must not error")
+	assert.Equal(t, string(GridStopped), stored.Status, "Status should be persisted")
+
+	om.fill("2", 0.12, 95, order.Filled)
+	m.processGrids(context.Background())
+	assert.Len(t, om.getSubmitted(), 4, "stopped grids should not place orders")
+
+	grids, err := m.GetGrids()
+	require.NoError(t, err, "GetGrids must not error")
+	require.Len(t, grids, 1, "GetGrids must return the grid")
+	assert.Equal(t, GridStopped, grids[0].Status, "Status should be stopped")
+}
+
+func TestGridTradingManagerSubmitUnlocked(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupGridTradingTest(t)
+	type ctxKey struct{}
+	var id string
+	var getErr, stopErr error
+	var ctxValues []any
+	om.onSubmit = func(ctx context.Context) {
+		ctxValues = append(ctxValues, ctx.Value(ctxKey{}))
+		if id == "" {
+			return
+		}
+		// fails with a deadlock if the manager lock is held
+		_, getErr = m.GetGrid(id)
+		stopErr = m.StopGrid(context.Background(), id)
+	}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "create")
+	id, err := m.Create(ctx, gridTestGrid())
+	require.NoError(t, err, "Create must not error")
+	require.Len(t, ctxValues, 4, "initial orders must be submitted")
+	for i := range ctxValues {
+		assert.Equal(t, "create", ctxValues[i], "initial orders should be submitted with the caller's context")
+	}
+
+	om.fill("2", 0.12, 95, order.Filled)
+	m.processGrids(context.Background())
+	assert.NoError(t, getErr, "GetGrid should not error while orders are placed")
+	assert.ErrorIs(t, stopErr, errGridBusy, "StopGrid should error while orders are placed")
+	g, err := m.GetGrid(id)
+	require.NoError(t, err, "GetGrid must not error")
+	assert.Equal(t, "5", g.GridLevels[2].OrderID, "replacement order should be placed")
+	assert.Equal(t, GridActive, g.Status, "grid should remain active")
+}
+
+func TestGridTradingManagerLoadActiveGrids(t *testing.T) {
+	t.Parallel()
+	m, _, db := setupGridTradingTest(t)
+	id, err := m.Create(context.Background(), gridTestGrid())
+	require.NoError(t, err, "Create must not error")
+	require.NoError(t, m.Stop(), "Stop must not error")
+
+	m.grids = make(map[string]*Grid)
+	db.grids["stopped"] = grid.Grid{ID: "stopped", Status: string(GridStopped)}
+	db.grids["invalid"] = grid.Grid{ID: "invalid", Status: string(GridActive), Asset: "fake"}
+	require.NoError(t, m.Start(), "Start must not error")
+	grids, err := m.GetGrids()
+	require.NoError(t, err, "GetGrids must not error")
+	require.Len(t, grids, 1, "only valid active grids must be restored")
+	assert.Equal(t, id, grids[0].ID, "restored grid should have the same ID")
+	assert.Equal(t, btcusdPair.Base, grids[0].Pair.Base, "restored grid should have the same base")
+	assert.Equal(t, []order.Side{order.Buy, order.Buy, order.UnknownSide, order.Sell, order.Sell}, gridSides(&grids[0]),
+		"restored grid should have the same level sides")
+	assert.Equal(t, "1", grids[0].GridLevels[0].OrderID, "restored grid should have the same order IDs")
+}
@@ -0,0 +1,126 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/grid"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// GridTradingManagerName is an exported subsystem name
+const GridTradingManagerName = "grid_trading_manager"
+
+var (
+	// ErrGridNotFound is returned when a grid does not exist
+	ErrGridNotFound = errors.New("grid not found")
+
+	errNilGrid               = errors.New("nil grid received")
+	errGridSpacingInvalid    = errors.New("grid spacing is not supported, supported spacings: ARITHMETIC, GEOMETRIC")
+	errGridLevelCountInvalid = errors.New("number of grid levels must be at least two")
+	errGridPriceRangeInvalid = errors.New("grid lower price must be greater than zero and less than the upper price")
+	errGridLevelsTooClose    = errors.New("grid levels are closer than the exchange's price step")
+	errGridNotActive         = errors.New("grid is not active")
+	errNoGridReferencePrice  = errors.New("unable to determine grid reference price")
+	errGridBusy              = errors.New("grid orders are being placed or cancelled, try again")
+)
+
+// GridSpacing defines how the prices of grid levels are distributed between
+// the lower and upper price
+type GridSpacing string
+
+// Supported grid spacings
+const (
+	// ArithmeticGrid places levels an equal price distance apart
+	ArithmeticGrid GridSpacing = "ARITHMETIC"
+	// GeometricGrid places levels an equal percentage distance apart
+	GeometricGrid GridSpacing = "GEOMETRIC"
+)
+
+// GridStatus defines the lifecycle state of a grid
+type GridStatus string
+
+// Grid statuses
+const (
+	GridActive  GridStatus = "ACTIVE"
+	GridStopped GridStatus = "STOPPED"
+)
+
+// GridTradingManager maintains ladders of limit orders between a lower and
+// upper price. Each time a level fills it is replaced by an order on the
+// opposite side one level away, capturing the distance between levels as
+// profit on every completed round trip
+type GridTradingManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iAlgoOrderManager
+	db              grid.IDBService
+	grids           map[string]*Grid
+	checkInterval   time.Duration
+	verbose         bool
+	m               sync.Mutex
+}
+
+// Grid defines a ladder of limit orders along with its realised profit
+type Grid struct {
+	ID         string
+	Exchange   string
+	Asset      asset.Item
+	Pair       currency.Pair
+	LowerPrice float64
+	UpperPrice float64
+	// Levels is the number of prices in the grid including the lower and
+	// upper price
+	Levels  int64
+	Spacing GridSpacing
+	// AmountPerLevel is the base amount of each level's order
+	AmountPerLevel float64
+	Status         GridStatus
+	Reason         string
+	// RealisedProfit is the quote currency profit of completed round trips,
+	// a buy and sell one level apart
+	RealisedProfit float64
+	RoundTrips     int64
+	GridLevels     []GridLevel
+	CreatedDate    time.Time
+	UpdatedDate    time.Time
+	// pending is set while orders are placed or cancelled without the lock
+	// held
+	pending bool
+}
+
+// gridPlacement holds an order to place for a grid level along with its
+// submission result
+type gridPlacement struct {
+	level  int
+	submit *order.Submit
+	resp   *OrderSubmitResponse
+	err    error
+}
+
+// gridReplacement is the opposite side order placed one level away from a
+// filled level
+type gridReplacement struct {
+	from       int
+	target     int
+	side       order.Side
+	entryPrice float64
+}
+
+// GridLevel is a price in a grid along with the order resting at it. Only one
+// level of a grid is left without an order so that each fill can be replaced
+// one level away
+type GridLevel struct {
+	Level   int64
+	Price   float64
+	Side    order.Side
+	OrderID string
+	// EntryPrice is the fill price of the opposite order which caused this
+	// level's order to be placed, used to calculate round trip profit
+	EntryPrice float64
+}
@@ -53,7 +53,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okx"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/poloniex"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/yobit"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
@@ -86,6 +88,17 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		dispatch.Name:                 dispatch.IsRunning(),
 		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
 		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
+		RiskManagerName:               bot.riskManager.IsRunning(),
+		ConditionalOrderManagerName:   bot.conditionalOrderManager.IsRunning(),
+		AlgoExecutionManagerName:      bot.algoExecutionManager.IsRunning(),
+		ArbitrageScannerName:          bot.arbitrageScanner.IsRunning(),
+		MarketMakingManagerName:       bot.marketMakingManager.IsRunning(),
+		GridTradingManagerName:        bot.gridTradingManager.IsRunning(),
+		MetricsManagerName:            bot.metricsManager.IsRunning(),
+		PortfolioRebalancerName:       bot.portfolioRebalancer.IsRunning(),
+		PortfolioSnapshotManagerName:  bot.portfolioSnapshots.IsRunning(),
+		FundingRateMonitorName:        bot.fundingRateMonitor.IsRunning(),
+		LiquidationMonitorName:        bot.liquidationMonitor.IsRunning(),
 	}
 }
 
@@ -163,9 +176,195 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 					return err
 				}
 			}
+			if bot.smartOrderRouter == nil {
+				bot.smartOrderRouter, err = SetupSmartOrderRouter(bot.ExchangeManager, bot.OrderManager)
+				if err != nil {
+					return err
+				}
+			}
+			if bot.DatabaseManager != nil && !bot.OrderManager.IsRunning() {
+				err = bot.OrderManager.SetDatabaseManager(bot.DatabaseManager)
+				if err != nil {
+					return err
+				}
+			}
 			return bot.OrderManager.Start()
 		}
 		return bot.OrderManager.Stop()
+	case RiskManagerName:
+		if enable {
+			if bot.riskManager == nil {
+				if bot.OrderManager == nil {
+					return fmt.Errorf("%s %w", RiskManagerName, errNilOrderManager)
+				}
+				bot.riskManager, err = SetupRiskManager(bot.ExchangeManager, bot.OrderManager, bot.CommunicationsManager, &bot.Config.RiskManager)
+				if err != nil {
+					return err
+				}
+				err = bot.OrderManager.SetRiskManager(bot.riskManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.riskManager.Start()
+		}
+		return bot.riskManager.Stop()
+	case ConditionalOrderManagerName:
+		if enable {
+			if bot.conditionalOrderManager == nil {
+				if bot.OrderManager == nil {
+					return fmt.Errorf("%s %w", ConditionalOrderManagerName, errNilOrderManager)
+				}
+				var dbManager iDatabaseConnectionManager
+				if bot.DatabaseManager != nil {
+					dbManager = bot.DatabaseManager
+				}
+				bot.conditionalOrderManager, err = SetupConditionalOrderManager(bot.ExchangeManager, bot.OrderManager, dbManager, &bot.Config.ConditionalOrderManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.conditionalOrderManager.Start()
+		}
+		return bot.conditionalOrderManager.Stop()
+	case AlgoExecutionManagerName:
+		if enable {
+			if bot.algoExecutionManager == nil {
+				if bot.OrderManager == nil {
+					return fmt.Errorf("%s %w", AlgoExecutionManagerName, errNilOrderManager)
+				}
+				bot.algoExecutionManager, err = SetupAlgoExecutionManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.AlgoExecutionManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.algoExecutionManager.Start()
+		}
+		return bot.algoExecutionManager.Stop()
+	case ArbitrageScannerName:
+		if enable {
+			if bot.arbitrageScanner == nil {
+				if bot.ExchangeManager == nil {
+					return fmt.Errorf("%s %w", ArbitrageScannerName, errNilExchangeManager)
+				}
+				bot.arbitrageScanner, err = SetupArbitrageScanner(bot.ExchangeManager, bot.CommunicationsManager, &bot.Config.ArbitrageScanner)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.arbitrageScanner.Start()
+		}
+		return bot.arbitrageScanner.Stop()
+	case MarketMakingManagerName:
+		if enable {
+			if bot.marketMakingManager == nil {
+				if bot.OrderManager == nil {
+					return fmt.Errorf("%s %w", MarketMakingManagerName, errNilOrderManager)
+				}
+				bot.marketMakingManager, err = SetupMarketMakingManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.MarketMakingManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.marketMakingManager.Start()
+		}
+		return bot.marketMakingManager.Stop()
+	case GridTradingManagerName:
+		if enable {
+			if bot.gridTradingManager == nil {
+				if bot.OrderManager == nil {
+					return fmt.Errorf("%s %w", GridTradingManagerName, errNilOrderManager)
+				}
+				var dbManager iDatabaseConnectionManager
+				if bot.DatabaseManager != nil {
+					dbManager = bot.DatabaseManager
+				}
+				bot.gridTradingManager, err = SetupGridTradingManager(bot.ExchangeManager, bot.OrderManager, dbManager, &bot.Config.GridTradingManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.gridTradingManager.Start()
+		}
+		return bot.gridTradingManager.Stop()
+	case MetricsManagerName:
+		if enable {
+			if bot.metricsManager == nil {
+				bot.metricsManager, err = SetupMetricsManager(bot.ExchangeManager, &bot.Config.MetricsManager)
+				if err != nil {
+					return err
+				}
+				// Requesters and websocket connections created before this
+				// point will not report to the metrics manager
+				request.SetupGlobalReporter(bot.metricsManager.HTTPReporter())
+				stream.SetupGlobalReporter(bot.metricsManager.WebsocketReporter())
+				if err = bot.attachMetricsManager(); err != nil {
+					return err
+				}
+			}
+			return bot.metricsManager.Start()
+		}
+		return bot.metricsManager.Stop()
+	case PortfolioRebalancerName:
+		if enable {
+			if bot.portfolioRebalancer == nil {
+				if bot.OrderManager == nil {
+					return fmt.Errorf("%s %w", PortfolioRebalancerName, errNilOrderManager)
+				}
+				bot.portfolioRebalancer, err = SetupPortfolioRebalancer(bot.ExchangeManager, bot.OrderManager, &bot.Config.PortfolioRebalancer)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.portfolioRebalancer.Start()
+		}
+		return bot.portfolioRebalancer.Stop()
+	case PortfolioSnapshotManagerName:
+		if enable {
+			if bot.portfolioSnapshots == nil {
+				if bot.DatabaseManager == nil {
+					return fmt.Errorf("%s %w", PortfolioSnapshotManagerName, errNilDatabaseConnectionManager)
+				}
+				var portfolioManager iPortfolioAddresses
+				if bot.portfolioManager != nil {
+					portfolioManager = bot.portfolioManager
+				}
+				bot.portfolioSnapshots, err = SetupPortfolioSnapshotManager(bot.ExchangeManager, portfolioManager, bot.DatabaseManager, &bot.Config.PortfolioSnapshot)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.portfolioSnapshots.Start()
+		}
+		return bot.portfolioSnapshots.Stop()
+	case FundingRateMonitorName:
+		if enable {
+			if bot.fundingRateMonitor == nil {
+				if bot.ExchangeManager == nil {
+					return fmt.Errorf("%s %w", FundingRateMonitorName, errNilExchangeManager)
+				}
+				bot.fundingRateMonitor, err = SetupFundingRateMonitor(bot.ExchangeManager, bot.CommunicationsManager, &bot.Config.FundingRateMonitor)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.fundingRateMonitor.Start()
+		}
+		return bot.fundingRateMonitor.Stop()
+	case LiquidationMonitorName:
+		if enable {
+			if bot.liquidationMonitor == nil {
+				if bot.OrderManager == nil {
+					return fmt.Errorf("%s %w", LiquidationMonitorName, errNilOrderManager)
+				}
+				bot.liquidationMonitor, err = SetupLiquidationMonitor(bot.ExchangeManager, bot.OrderManager, bot.CommunicationsManager, &bot.Config.LiquidationMonitor)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.liquidationMonitor.Start()
+		}
+		return bot.liquidationMonitor.Stop()
 	case PortfolioManagerName:
 		if enable {
 			if bot.portfolioManager == nil {
@@ -1067,3 +1266,11 @@ func NewExchangeByNameWithDefaults(ctx context.Context, name string) (exchange.I
 	}
 	return exch, nil
 }
+
+// publishEvent logs events the event bus could not queue, publishing is best
+// effort and must not interrupt the producer's own processing
+func publishEvent(err error) {
+	if err != nil {
+		log.Errorf(log.DispatchMgr, "Event bus unable to publish event: %v", err)
+	}
+}
@@ -100,8 +100,8 @@ func CreateTestBot(tb testing.TB) *Engine {
 
 func TestGetSubsystemsStatus(t *testing.T) {
 	m := (&Engine{}).GetSubsystemsStatus()
-	if len(m) != 15 {
-		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 15, len(m))
+	if len(m) != 26 {
+		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 26, len(m))
 	}
 }
 
@@ -148,6 +148,12 @@ func TestSetSubsystem(t *testing.T) { //nolint // TO-DO: Fix race t.Parallel() u
 			EnableError:  nil,
 			DisableError: nil,
 		},
+		{
+			Subsystem:    OrderManagerName,
+			Engine:       &Engine{Config: &config.Config{}, DatabaseManager: &DatabaseConnectionManager{}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
 		{
 			Subsystem:    PortfolioManagerName,
 			Engine:       &Engine{Config: &config.Config{}},
@@ -207,6 +213,72 @@ func TestSetSubsystem(t *testing.T) { //nolint // TO-DO: Fix race t.Parallel() u
 			EnableError:  database.ErrNilInstance,
 			DisableError: ErrNilSubsystem,
 		},
+		{
+			Subsystem:    RiskManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilOrderManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    ConditionalOrderManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilOrderManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    AlgoExecutionManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilOrderManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    ArbitrageScannerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilExchangeManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    MarketMakingManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilOrderManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    GridTradingManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilOrderManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    MetricsManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errMetricsListenAddressUnset,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    PortfolioRebalancerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilOrderManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    PortfolioSnapshotManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilDatabaseConnectionManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    FundingRateMonitorName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilExchangeManager,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    LiquidationMonitorName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNilOrderManager,
+			DisableError: ErrNilSubsystem,
+		},
 		{
 			Subsystem:    vm.Name,
 			Engine:       &Engine{Config: &config.Config{}},
@@ -0,0 +1,748 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupLiquidationMonitor creates a new open futures position liquidation
+// monitor
+func SetupLiquidationMonitor(exchangeManager iExchangeManager, orderManager iFuturesPositionManager, communicationsManager iCommsManager, cfg *config.LiquidationMonitor) (*LiquidationMonitor, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	if communicationsManager == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", LiquidationMonitorName, errNilConfig)
+	}
+	marginType, err := margin.StringToMarginType(cfg.MarginType)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", LiquidationMonitorName, err)
+	}
+	if marginType == margin.Unset {
+		marginType = margin.Isolated
+	}
+	checkInterval := cfg.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = config.DefaultLiquidationMonitorCheckInterval
+	}
+	alertCooldown := cfg.AlertCooldown
+	if alertCooldown <= 0 {
+		alertCooldown = config.DefaultLiquidationAlertCooldown
+	}
+	maintenanceMarginRate := cfg.MaintenanceMarginRate
+	if maintenanceMarginRate <= 0 {
+		maintenanceMarginRate = config.DefaultLiquidationMaintenanceMarginRate
+	}
+	warningRatio := cfg.WarningMarginRatio
+	if warningRatio <= 0 {
+		warningRatio = config.DefaultLiquidationWarningMarginRatio
+	}
+	criticalRatio := cfg.CriticalMarginRatio
+	if criticalRatio <= 0 {
+		criticalRatio = config.DefaultLiquidationCriticalMarginRatio
+	}
+	autoDeleverage := cfg.AutoDeleverage
+	if autoDeleverage.MarginRatio <= 0 {
+		autoDeleverage.MarginRatio = criticalRatio
+	}
+	if autoDeleverage.Percentage <= 0 {
+		autoDeleverage.Percentage = config.DefaultLiquidationDeleveragePercentage
+	}
+	return &LiquidationMonitor{
+		shutdown:              make(chan struct{}),
+		exchangeManager:       exchangeManager,
+		orderManager:          orderManager,
+		commsManager:          communicationsManager,
+		checkInterval:         checkInterval,
+		marginType:            marginType,
+		maintenanceMarginRate: maintenanceMarginRate,
+		warningRatio:          warningRatio,
+		criticalRatio:         criticalRatio,
+		alertCooldown:         alertCooldown,
+		autoDeleverage:        autoDeleverage,
+		verbose:               cfg.Verbose,
+		pipes:                 make(map[string]dispatch.Pipe),
+		positions:             make(map[key.ExchangePairAsset]*monitoredPosition),
+		health:                make(map[key.ExchangePairAsset]PositionHealth),
+		lastAlert:             make(map[key.ExchangePairAsset]liquidationAlert),
+		lastAction:            make(map[key.ExchangePairAsset]time.Time),
+		subscribers:           make(map[uuid.UUID]chan PositionHealth),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (l *LiquidationMonitor) IsRunning() bool {
+	return l != nil && atomic.LoadInt32(&l.started) == 1
+}
+
+// Start runs the subsystem
+func (l *LiquidationMonitor) Start() error {
+	if l == nil {
+		return fmt.Errorf("%s %w", LiquidationMonitorName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&l.started, 0, 1) {
+		return fmt.Errorf("%s %w", LiquidationMonitorName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Liquidation monitor %s", MsgSubSystemStarting)
+	l.shutdown = make(chan struct{})
+	l.wg.Add(1)
+	go l.run()
+	log.Debugf(log.OrderMgr, "Liquidation monitor %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem, releasing all ticker subscriptions
+// and closing any position health subscriber channels
+func (l *LiquidationMonitor) Stop() error {
+	if l == nil {
+		return fmt.Errorf("%s %w", LiquidationMonitorName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&l.started) == 0 {
+		return fmt.Errorf("%s %w", LiquidationMonitorName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Liquidation monitor %s", MsgSubSystemShuttingDown)
+	close(l.shutdown)
+	l.wg.Wait()
+	l.m.Lock()
+	for id, ch := range l.subscribers {
+		close(ch)
+		delete(l.subscribers, id)
+	}
+	l.pipes = make(map[string]dispatch.Pipe)
+	l.positions = make(map[key.ExchangePairAsset]*monitoredPosition)
+	l.health = make(map[key.ExchangePairAsset]PositionHealth)
+	l.m.Unlock()
+	atomic.StoreInt32(&l.started, 0)
+	log.Debugf(log.OrderMgr, "Liquidation monitor %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// GetPositionHealth returns the latest health of every monitored position
+// ordered by margin ratio
+func (l *LiquidationMonitor) GetPositionHealth() ([]PositionHealth, error) {
+	if !l.IsRunning() {
+		return nil, fmt.Errorf("%s %w", LiquidationMonitorName, ErrSubSystemNotStarted)
+	}
+	l.m.Lock()
+	resp := make([]PositionHealth, 0, len(l.health))
+	for _, h := range l.health {
+		resp = append(resp, h)
+	}
+	l.m.Unlock()
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].MarginRatio > resp[j].MarginRatio
+	})
+	return resp, nil
+}
+
+// Subscribe returns a channel which receives the health of a position each
+// time it is evaluated along with an ID used to unsubscribe. Updates are
+// dropped for subscribers which do not keep up and the channel is closed when
+// the subsystem is stopped
+func (l *LiquidationMonitor) Subscribe() (uuid.UUID, <-chan PositionHealth, error) {
+	if !l.IsRunning() {
+		return uuid.Nil, nil, fmt.Errorf("%s %w", LiquidationMonitorName, ErrSubSystemNotStarted)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	ch := make(chan PositionHealth, liquidationSubscriberBuffer)
+	l.m.Lock()
+	l.subscribers[id] = ch
+	l.m.Unlock()
+	return id, ch, nil
+}
+
+// Unsubscribe removes a position health subscriber and closes its channel
+func (l *LiquidationMonitor) Unsubscribe(id uuid.UUID) {
+	if l == nil {
+		return
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	if ch, ok := l.subscribers[id]; ok {
+		close(ch)
+		delete(l.subscribers, id)
+	}
+}
+
+// run refreshes open positions on startup and on each interval until shutdown
+func (l *LiquidationMonitor) run() {
+	defer l.wg.Done()
+	tick := time.NewTicker(l.checkInterval)
+	defer tick.Stop()
+	l.refresh(context.TODO())
+	for {
+		select {
+		case <-l.shutdown:
+			return
+		case <-tick.C:
+			l.refresh(context.TODO())
+		}
+	}
+}
+
+// refresh retrieves the open futures positions from the order manager along
+// with their leverage, contract details and collateral, evaluating each at
+// its latest known price
+func (l *LiquidationMonitor) refresh(ctx context.Context) {
+	positions, err := l.orderManager.GetAllOpenFuturesPositions()
+	if err != nil && !errors.Is(err, futures.ErrNoPositionsFound) {
+		log.Errorf(log.OrderMgr, "Liquidation monitor unable to get open positions: %v", err)
+		return
+	}
+	contracts := make(map[key.ExchangeAsset][]futures.Contract)
+	collateral := make(map[key.ExchangeAsset]float64)
+	open := make(map[key.ExchangePairAsset]*monitoredPosition, len(positions))
+	for i := range positions {
+		k := key.ExchangePairAsset{
+			Exchange: positions[i].Exchange,
+			Base:     positions[i].Pair.Base.Item,
+			Quote:    positions[i].Pair.Quote.Item,
+			Asset:    positions[i].Asset,
+		}
+		exch, err := l.exchangeManager.GetExchangeByName(positions[i].Exchange)
+		if err != nil {
+			l.logPosition(&k, err)
+			continue
+		}
+		p, err := l.getMonitoredPosition(ctx, exch, &positions[i], contracts, collateral)
+		if err != nil {
+			l.logPosition(&k, err)
+			continue
+		}
+		open[k] = p
+	}
+	allocateCollateral(open)
+
+	l.m.Lock()
+	for k, p := range open {
+		if prev, ok := l.positions[k]; ok {
+			// Retain the latest ticker price and any margin added while the
+			// position remains open
+			if prev.markPrice > 0 {
+				p.markPrice = prev.markPrice
+			}
+			if prev.side == p.side {
+				p.addedMargin = prev.addedMargin
+			}
+		}
+	}
+	for k := range l.positions {
+		if _, ok := open[k]; !ok {
+			delete(l.health, k)
+			delete(l.lastAlert, k)
+			delete(l.lastAction, k)
+		}
+	}
+	l.positions = open
+	l.m.Unlock()
+
+	l.subscribe()
+	for k, p := range open {
+		l.evaluate(ctx, k, p)
+	}
+}
+
+// getMonitoredPosition returns the details required to evaluate a position's
+// health, caching contract details and collateral per exchange asset for the
+// duration of a refresh
+func (l *LiquidationMonitor) getMonitoredPosition(ctx context.Context, exch exchange.IBotExchange, pos *futures.Position, contracts map[key.ExchangeAsset][]futures.Contract, collateral map[key.ExchangeAsset]float64) (*monitoredPosition, error) {
+	if !pos.LatestDirection.IsLong() && !pos.LatestDirection.IsShort() {
+		return nil, fmt.Errorf("%w %v", order.ErrSideIsInvalid, pos.LatestDirection)
+	}
+	size := pos.LatestSize.Abs().InexactFloat64()
+	if size <= 0 {
+		return nil, errInvalidPositionSize
+	}
+	p := &monitoredPosition{
+		exchange:   pos.Exchange,
+		asset:      pos.Asset,
+		pair:       pos.Pair,
+		side:       pos.LatestDirection,
+		size:       size,
+		entryPrice: pos.OpeningPrice.InexactFloat64(),
+		markPrice:  pos.LatestPrice.InexactFloat64(),
+		marginType: l.marginType,
+		multiplier: 1,
+		settlement: futures.Linear,
+	}
+	if pos.Asset == asset.CoinMarginedFutures {
+		p.settlement = futures.Inverse
+	}
+	var err error
+	p.leverage, err = exch.GetLeverage(ctx, pos.Asset, pos.Pair, l.marginType, pos.LatestDirection)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get leverage: %w", err)
+	}
+	if p.leverage <= 0 {
+		return nil, fmt.Errorf("%w %v", errInvalidLeverage, p.leverage)
+	}
+
+	ea := key.ExchangeAsset{Exchange: pos.Exchange, Asset: pos.Asset}
+	c, ok := contracts[ea]
+	if !ok {
+		c, err = exch.GetFuturesContractDetails(ctx, pos.Asset)
+		if err != nil {
+			// Positions are still evaluated as linear or inverse by asset
+			// with a multiplier of one
+			l.logPosition(&key.ExchangePairAsset{Exchange: pos.Exchange, Base: pos.Pair.Base.Item, Quote: pos.Pair.Quote.Item, Asset: pos.Asset}, err)
+		}
+		contracts[ea] = c
+	}
+	for i := range c {
+		if !c[i].Name.Equal(pos.Pair) {
+			continue
+		}
+		if c[i].Multiplier > 0 {
+			p.multiplier = c[i].Multiplier
+		}
+		if c[i].SettlementType == futures.Linear || c[i].SettlementType == futures.Inverse {
+			p.settlement = c[i].SettlementType
+		}
+		break
+	}
+
+	if l.marginType != margin.Multi {
+		return p, nil
+	}
+	available, ok := collateral[ea]
+	if !ok {
+		available, err = getAvailableCollateral(ctx, exch, pos.Asset)
+		if err != nil {
+			return nil, fmt.Errorf("unable to calculate collateral: %w", err)
+		}
+		collateral[ea] = available
+	}
+	p.collateral = available
+	return p, nil
+}
+
+// allocateCollateral shares each account's available collateral between its
+// cross margin positions in proportion to their notional value at entry, so
+// the collateral backs the account's positions once rather than each in full
+func allocateCollateral(positions map[key.ExchangePairAsset]*monitoredPosition) {
+	totals := make(map[key.ExchangeAsset]float64)
+	for _, p := range positions {
+		if p.marginType == margin.Multi {
+			totals[key.ExchangeAsset{Exchange: p.exchange, Asset: p.asset}] += p.entryNotional()
+		}
+	}
+	for _, p := range positions {
+		if p.marginType != margin.Multi {
+			continue
+		}
+		total := totals[key.ExchangeAsset{Exchange: p.exchange, Asset: p.asset}]
+		if total <= 0 {
+			p.collateral = 0
+			continue
+		}
+		p.collateral *= p.entryNotional() / total
+	}
+}
+
+// entryNotional returns the value of the position at its entry price in the
+// quote currency
+func (p *monitoredPosition) entryNotional() float64 {
+	if p.settlement == futures.Inverse {
+		return p.size * p.multiplier
+	}
+	return p.size * p.multiplier * p.entryPrice
+}
+
+// getAvailableCollateral returns the collateral available to cross margin
+// positions of an exchange's futures asset from its account holdings
+func getAvailableCollateral(ctx context.Context, exch exchange.IBotExchange, a asset.Item) (float64, error) {
+	holdings, err := exch.FetchAccountInfo(ctx, a)
+	if err != nil {
+		return 0, err
+	}
+	var calculators []futures.CollateralCalculator
+	for i := range holdings.Accounts {
+		for j := range holdings.Accounts[i].Currencies {
+			total := decimal.NewFromFloat(holdings.Accounts[i].Currencies[j].Total)
+			free := decimal.NewFromFloat(holdings.Accounts[i].Currencies[j].AvailableWithoutBorrow)
+			calculators = append(calculators, futures.CollateralCalculator{
+				CollateralCurrency: holdings.Accounts[i].Currencies[j].Currency,
+				Asset:              a,
+				FreeCollateral:     free,
+				LockedCollateral:   total.Sub(free),
+			})
+		}
+	}
+	resp, err := exch.CalculateTotalCollateral(ctx, &futures.TotalCollateralCalculator{
+		CollateralAssets: calculators,
+		FetchPositions:   true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.AvailableCollateral.InexactFloat64(), nil
+}
+
+// subscribe creates a ticker subscription for each exchange with an open
+// position which does not already have one
+func (l *LiquidationMonitor) subscribe() {
+	l.m.Lock()
+	var exchanges []string
+	for k := range l.positions {
+		if _, ok := l.pipes[k.Exchange]; !ok {
+			exchanges = append(exchanges, k.Exchange)
+		}
+	}
+	l.m.Unlock()
+	for i := range exchanges {
+		l.m.Lock()
+		_, ok := l.pipes[exchanges[i]]
+		l.m.Unlock()
+		if ok {
+			continue
+		}
+		pipe, err := ticker.SubscribeToExchangeTickers(exchanges[i])
+		if err != nil {
+			// Tickers cannot be subscribed to until the exchange has
+			// processed at least one
+			if l.verbose {
+				log.Debugf(log.OrderMgr, "Liquidation monitor unable to subscribe to %s tickers: %v", exchanges[i], err)
+			}
+			continue
+		}
+		l.m.Lock()
+		l.pipes[exchanges[i]] = pipe
+		l.m.Unlock()
+		l.wg.Add(1)
+		go l.consume(exchanges[i], pipe)
+	}
+}
+
+// consume re-evaluates positions as ticker updates arrive until shutdown
+func (l *LiquidationMonitor) consume(exch string, pipe dispatch.Pipe) {
+	defer l.wg.Done()
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Errorf(log.OrderMgr, "Liquidation monitor unable to release %s ticker subscription: %v", exch, err)
+		}
+	}()
+	for {
+		select {
+		case <-l.shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				// Allow the subscription to be recreated on the next interval
+				l.m.Lock()
+				delete(l.pipes, exch)
+				l.m.Unlock()
+				return
+			}
+			t, ok := data.(*ticker.Price)
+			if !ok {
+				continue
+			}
+			l.processTicker(context.TODO(), t)
+		}
+	}
+}
+
+// processTicker updates the mark price of the position matching a ticker and
+// evaluates its health
+func (l *LiquidationMonitor) processTicker(ctx context.Context, t *ticker.Price) {
+	if t == nil || !t.AssetType.IsFutures() {
+		return
+	}
+	price := t.MarkPrice
+	if price <= 0 {
+		price = t.Last
+	}
+	if price <= 0 {
+		return
+	}
+	k := key.ExchangePairAsset{
+		Exchange: t.ExchangeName,
+		Base:     t.Pair.Base.Item,
+		Quote:    t.Pair.Quote.Item,
+		Asset:    t.AssetType,
+	}
+	l.m.Lock()
+	p, ok := l.positions[k]
+	if ok {
+		p.markPrice = price
+	}
+	l.m.Unlock()
+	if ok {
+		l.evaluate(ctx, k, p)
+	}
+}
+
+// evaluate calculates the health of a position, publishing it to subscribers,
+// escalating alerts and taking any configured auto deleverage action
+func (l *LiquidationMonitor) evaluate(ctx context.Context, k key.ExchangePairAsset, p *monitoredPosition) {
+	l.m.Lock()
+	h, err := p.health(l.maintenanceMarginRate)
+	l.m.Unlock()
+	if err != nil {
+		l.logPosition(&k, err)
+		return
+	}
+	h.Level = l.riskLevel(h.MarginRatio)
+	if !l.update(k, &h) {
+		return
+	}
+	if err = l.deleverage(ctx, k, p, &h); err != nil {
+		log.Errorf(log.OrderMgr, "Liquidation monitor unable to %s %s %s %s: %v",
+			l.autoDeleverage.Action, h.Exchange, h.Asset, h.Pair, err)
+	}
+}
+
+// health estimates the margin ratio and liquidation price of the position at
+// its mark price. Linear contracts are margined in the quote currency and
+// inverse contracts in the base currency
+func (p *monitoredPosition) health(maintenanceMarginRate float64) (PositionHealth, error) {
+	if p.leverage <= 0 {
+		return PositionHealth{}, fmt.Errorf("%w %v", errInvalidLeverage, p.leverage)
+	}
+	if p.entryPrice <= 0 || p.markPrice <= 0 {
+		return PositionHealth{}, errNoPositionPrice
+	}
+	h := PositionHealth{
+		Exchange:   p.exchange,
+		Asset:      p.asset,
+		Pair:       p.pair,
+		Side:       p.side,
+		Size:       p.size,
+		EntryPrice: p.entryPrice,
+		MarkPrice:  p.markPrice,
+		Leverage:   p.leverage,
+		MarginType: p.marginType,
+		Time:       time.Now(),
+	}
+	notional := p.size * p.multiplier
+	long := p.side.IsLong()
+	if p.settlement == futures.Inverse {
+		// notional is in the quote currency and margin in the base currency
+		h.Margin = notional/p.entryPrice/p.leverage + p.addedMargin
+		if p.marginType == margin.Multi {
+			h.Margin += p.collateral / p.markPrice
+		}
+		h.MaintenanceMargin = notional / p.markPrice * maintenanceMarginRate
+		if long {
+			h.UnrealisedPNL = notional * (1/p.entryPrice - 1/p.markPrice)
+			h.EstimatedLiquidationPrice = notional * (1 + maintenanceMarginRate) / (h.Margin + notional/p.entryPrice)
+		} else {
+			h.UnrealisedPNL = notional * (1/p.markPrice - 1/p.entryPrice)
+			if d := notional/p.entryPrice - h.Margin; d > 0 {
+				h.EstimatedLiquidationPrice = notional * (1 - maintenanceMarginRate) / d
+			}
+		}
+	} else {
+		// notional is in the base currency and margin in the quote currency
+		h.Margin = notional*p.entryPrice/p.leverage + p.addedMargin
+		if p.marginType == margin.Multi {
+			h.Margin += p.collateral
+		}
+		h.MaintenanceMargin = notional * p.markPrice * maintenanceMarginRate
+		if long {
+			h.UnrealisedPNL = notional * (p.markPrice - p.entryPrice)
+			h.EstimatedLiquidationPrice = (notional*p.entryPrice - h.Margin) / (notional * (1 - maintenanceMarginRate))
+		} else {
+			h.UnrealisedPNL = notional * (p.entryPrice - p.markPrice)
+			h.EstimatedLiquidationPrice = (h.Margin + notional*p.entryPrice) / (notional * (1 + maintenanceMarginRate))
+		}
+	}
+	if h.EstimatedLiquidationPrice < 0 {
+		h.EstimatedLiquidationPrice = 0
+	}
+	if h.EstimatedLiquidationPrice > 0 {
+		h.DistanceToLiquidation = math.Abs(p.markPrice-h.EstimatedLiquidationPrice) / p.markPrice * 100
+	}
+	h.MarginRatio = 1
+	if balance := h.Margin + h.UnrealisedPNL; balance > 0 {
+		h.MarginRatio = math.Min(h.MaintenanceMargin/balance, 1)
+	}
+	return h, nil
+}
+
+// riskLevel returns the risk level of a margin ratio
+func (l *LiquidationMonitor) riskLevel(marginRatio float64) LiquidationRiskLevel {
+	switch {
+	case marginRatio >= l.criticalRatio:
+		return CriticalPosition
+	case marginRatio >= l.warningRatio:
+		return WarningPosition
+	default:
+		return HealthyPosition
+	}
+}
+
+// update stores the health of a position and publishes it to subscribers. An
+// alert is pushed when the risk level escalates, or repeated after the
+// cooldown while it remains elevated. It returns false when the position is
+// no longer monitored
+func (l *LiquidationMonitor) update(k key.ExchangePairAsset, h *PositionHealth) bool {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if _, ok := l.positions[k]; !ok {
+		return false
+	}
+	l.health[k] = *h
+	for _, ch := range l.subscribers {
+		select {
+		case ch <- *h:
+		default:
+		}
+	}
+	if l.verbose {
+		log.Debugf(log.OrderMgr, "Liquidation monitor %s", h)
+	}
+	if h.Level == HealthyPosition {
+		delete(l.lastAlert, k)
+		return true
+	}
+	if last, ok := l.lastAlert[k]; ok && h.Level <= last.level && h.Time.Sub(last.time) < l.alertCooldown {
+		return true
+	}
+	l.lastAlert[k] = liquidationAlert{level: h.Level, time: h.Time}
+	l.commsManager.PushEvent(base.Event{Type: "liquidation", Message: h.String()})
+	return true
+}
+
+// deleverage takes the configured auto deleverage action once a position's
+// margin ratio reaches its threshold, at most once per alert cooldown
+func (l *LiquidationMonitor) deleverage(ctx context.Context, k key.ExchangePairAsset, p *monitoredPosition, h *PositionHealth) error {
+	if !l.autoDeleverage.Enabled || h.MarginRatio < l.autoDeleverage.MarginRatio {
+		return nil
+	}
+	l.m.Lock()
+	if last, ok := l.lastAction[k]; ok && h.Time.Sub(last) < l.alertCooldown {
+		l.m.Unlock()
+		return nil
+	}
+	l.lastAction[k] = h.Time
+	l.m.Unlock()
+
+	var msg string
+	switch l.autoDeleverage.Action {
+	case config.LiquidationActionAddMargin:
+		if h.MarginType != margin.Isolated {
+			return errAddMarginRequiresIsolated
+		}
+		exch, err := l.exchangeManager.GetExchangeByName(h.Exchange)
+		if err != nil {
+			return err
+		}
+		added := h.Margin * l.autoDeleverage.Percentage / 100
+		resp, err := exch.ChangePositionMargin(ctx, &margin.PositionChangeRequest{
+			Exchange:                h.Exchange,
+			Pair:                    h.Pair,
+			Asset:                   h.Asset,
+			MarginType:              margin.Isolated,
+			OriginalAllocatedMargin: h.Margin,
+			NewAllocatedMargin:      h.Margin + added,
+		})
+		if err != nil {
+			return err
+		}
+		if resp != nil && resp.AllocatedMargin > h.Margin {
+			added = resp.AllocatedMargin - h.Margin
+		}
+		l.m.Lock()
+		p.addedMargin += added
+		l.m.Unlock()
+		msg = fmt.Sprintf("added %v margin", added)
+	case config.LiquidationActionReducePosition:
+		side := order.Sell
+		if h.Side.IsShort() {
+			side = order.Buy
+		}
+		amount := h.Size * l.autoDeleverage.Percentage / 100
+		if _, err := l.orderManager.Submit(ctx, &order.Submit{
+			Exchange:   h.Exchange,
+			Pair:       h.Pair,
+			AssetType:  h.Asset,
+			Side:       side,
+			Type:       order.Market,
+			Amount:     amount,
+			ReduceOnly: true,
+		}); err != nil {
+			return err
+		}
+		msg = fmt.Sprintf("submitted reduce-only %s order for %v", side, amount)
+	default:
+		return nil
+	}
+	l.commsManager.PushEvent(base.Event{
+		Type:    "liquidation",
+		Message: fmt.Sprintf("%s %s %s %s auto deleverage %s at margin ratio %.4f", h.Exchange, h.Asset, h.Pair, h.Side, msg, h.MarginRatio),
+	})
+	return nil
+}
+
+// logPosition logs why a position could not be evaluated when verbose
+func (l *LiquidationMonitor) logPosition(k *key.ExchangePairAsset, err error) {
+	if l.verbose {
+		log.Debugf(log.OrderMgr, "Liquidation monitor cannot evaluate %s %s %s: %v", k.Exchange, k.Asset, k.Pair(), err)
+	}
+}
+
+// key returns the unique identifier of the position
+func (h *PositionHealth) key() key.ExchangePairAsset {
+	return key.ExchangePairAsset{Exchange: h.Exchange, Base: h.Pair.Base.Item, Quote: h.Pair.Quote.Item, Asset: h.Asset}
+}
+
+// String returns a human readable summary of the position's health
+func (h *PositionHealth) String() string {
+	return fmt.Sprintf("%s %s %s %s %v %s position, mark price %v, estimated liquidation price %v (%.2f%% away), margin ratio %.4f",
+		h.Level, h.Exchange, h.Asset, h.Pair, h.Size, h.Side, h.MarkPrice, h.EstimatedLiquidationPrice, h.DistanceToLiquidation, h.MarginRatio)
+}
+
+// String implements the stringer interface
+func (r LiquidationRiskLevel) String() string {
+	switch r {
+	case HealthyPosition:
+		return "healthy"
+	case WarningPosition:
+		return "warning"
+	case CriticalPosition:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// liquidationRiskLevelFromString returns the risk level matching the string,
+// ignoring case
+func liquidationRiskLevelFromString(s string) (LiquidationRiskLevel, error) {
+	for _, r := range []LiquidationRiskLevel{HealthyPosition, WarningPosition, CriticalPosition} {
+		if strings.EqualFold(r.String(), s) {
+			return r, nil
+		}
+	}
+	return HealthyPosition, fmt.Errorf("%w %q", errUnknownLiquidationRiskLevel, s)
+}
@@ -0,0 +1,349 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// liquidationTestExchange returns fixed leverage, contract and collateral
+// details and records margin changes
+type liquidationTestExchange struct {
+	exchange.IBotExchange
+	name       string
+	leverage   float64
+	multiplier float64
+	collateral float64
+
+	m             sync.Mutex
+	marginChanges []*margin.PositionChangeRequest
+}
+
+func (l *liquidationTestExchange) GetName() string {
+	return l.name
+}
+
+func (l *liquidationTestExchange) GetLeverage(context.Context, asset.Item, currency.Pair, margin.Type, order.Side) (float64, error) {
+	return l.leverage, nil
+}
+
+func (l *liquidationTestExchange) GetFuturesContractDetails(_ context.Context, a asset.Item) ([]futures.Contract, error) {
+	return []futures.Contract{{
+		Exchange:       l.name,
+		Name:           btcusdPair,
+		Asset:          a,
+		SettlementType: futures.Linear,
+		Multiplier:     l.multiplier,
+	}}, nil
+}
+
+func (l *liquidationTestExchange) FetchAccountInfo(_ context.Context, a asset.Item) (account.Holdings, error) {
+	return account.Holdings{
+		Exchange: l.name,
+		Accounts: []account.SubAccount{{
+			AssetType:  a,
+			Currencies: []account.Balance{{Currency: currency.USD, Total: l.collateral, AvailableWithoutBorrow: l.collateral}},
+		}},
+	}, nil
+}
+
+func (l *liquidationTestExchange) CalculateTotalCollateral(_ context.Context, c *futures.TotalCollateralCalculator) (*futures.TotalCollateralResponse, error) {
+	var available decimal.Decimal
+	for i := range c.CollateralAssets {
+		available = available.Add(c.CollateralAssets[i].FreeCollateral)
+	}
+	return &futures.TotalCollateralResponse{CollateralCurrency: currency.USD, AvailableCollateral: available}, nil
+}
+
+func (l *liquidationTestExchange) ChangePositionMargin(_ context.Context, r *margin.PositionChangeRequest) (*margin.PositionChangeResponse, error) {
+	l.m.Lock()
+	l.marginChanges = append(l.marginChanges, r)
+	l.m.Unlock()
+	return &margin.PositionChangeResponse{Exchange: r.Exchange, Pair: r.Pair, Asset: r.Asset, AllocatedMargin: r.NewAllocatedMargin}, nil
+}
+
+// liquidationTestOrderManager returns fixed open positions and records
+// submitted orders
+type liquidationTestOrderManager struct {
+	m         sync.Mutex
+	positions []futures.Position
+	submitted []*order.Submit
+}
+
+func (l *liquidationTestOrderManager) IsRunning() bool {
+	return true
+}
+
+func (l *liquidationTestOrderManager) Submit(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.submitted = append(l.submitted, s)
+	return &OrderSubmitResponse{}, nil
+}
+
+func (l *liquidationTestOrderManager) GetAllOpenFuturesPositions() ([]futures.Position, error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if len(l.positions) == 0 {
+		return nil, futures.ErrNoPositionsFound
+	}
+	return append([]futures.Position(nil), l.positions...), nil
+}
+
+func liquidationTestPosition(exch string, side order.Side) futures.Position {
+	return futures.Position{
+		Exchange:        exch,
+		Asset:           asset.USDTMarginedFutures,
+		Pair:            btcusdPair,
+		Status:          order.Open,
+		OpeningPrice:    decimal.NewFromInt(100),
+		LatestPrice:     decimal.NewFromInt(100),
+		LatestSize:      decimal.NewFromInt(1),
+		LatestDirection: side,
+	}
+}
+
+func setupLiquidationMonitorTest(t *testing.T, cfg *config.LiquidationMonitor, exch *liquidationTestExchange, positions ...futures.Position) (*LiquidationMonitor, *liquidationTestOrderManager, *fakeArbitrageComms) {
+	t.Helper()
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	om := &liquidationTestOrderManager{positions: positions}
+	comms := &fakeArbitrageComms{}
+	l, err := SetupLiquidationMonitor(em, om, comms, cfg)
+	require.NoError(t, err, "SetupLiquidationMonitor must not error")
+	// Marked as running without starting so that positions are only
+	// refreshed when called by the test
+	l.started = 1
+	return l, om, comms
+}
+
+func liquidationTestTicker(exch string, price float64) *ticker.Price {
+	return &ticker.Price{ExchangeName: exch, Pair: btcusdPair, AssetType: asset.USDTMarginedFutures, Last: price - 1, MarkPrice: price}
+}
+
+func TestSetupLiquidationMonitor(t *testing.T) {
+	t.Parallel()
+	_, err := SetupLiquidationMonitor(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupLiquidationMonitor should error on nil exchange manager")
+	_, err = SetupLiquidationMonitor(NewExchangeManager(), nil, nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager, "SetupLiquidationMonitor should error on nil order manager")
+	_, err = SetupLiquidationMonitor(NewExchangeManager(), &liquidationTestOrderManager{}, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager, "SetupLiquidationMonitor should error on nil comms manager")
+	_, err = SetupLiquidationMonitor(NewExchangeManager(), &liquidationTestOrderManager{}, &CommunicationManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig, "SetupLiquidationMonitor should error on nil config")
+	_, err = SetupLiquidationMonitor(NewExchangeManager(), &liquidationTestOrderManager{}, &CommunicationManager{}, &config.LiquidationMonitor{MarginType: "bananas"})
+	assert.ErrorIs(t, err, margin.ErrInvalidMarginType, "SetupLiquidationMonitor should error on invalid margin type")
+	l, err := SetupLiquidationMonitor(NewExchangeManager(), &liquidationTestOrderManager{}, &CommunicationManager{}, &config.LiquidationMonitor{})
+	require.NoError(t, err, "SetupLiquidationMonitor must not error")
+	assert.Equal(t, margin.Isolated, l.marginType, "marginType should default to isolated")
+	assert.Equal(t, config.DefaultLiquidationMonitorCheckInterval, l.checkInterval, "checkInterval should default")
+	assert.Equal(t, config.DefaultLiquidationCriticalMarginRatio, l.autoDeleverage.MarginRatio, "auto deleverage margin ratio should default to critical")
+}
+
+func TestLiquidationMonitorStartStop(t *testing.T) {
+	t.Parallel()
+	var l *LiquidationMonitor
+	assert.ErrorIs(t, l.Start(), ErrNilSubsystem, "Start should error on nil subsystem")
+	assert.ErrorIs(t, l.Stop(), ErrNilSubsystem, "Stop should error on nil subsystem")
+	assert.False(t, l.IsRunning(), "IsRunning should return false")
+
+	l, err := SetupLiquidationMonitor(NewExchangeManager(), &liquidationTestOrderManager{}, &CommunicationManager{}, &config.LiquidationMonitor{})
+	require.NoError(t, err, "SetupLiquidationMonitor must not error")
+	_, _, err = l.Subscribe()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "Subscribe should error when not started")
+	_, err = l.GetPositionHealth()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetPositionHealth should error when not started")
+	assert.ErrorIs(t, l.Stop(), ErrSubSystemNotStarted, "Stop should error when not started")
+	require.NoError(t, l.Start(), "Start must not error")
+	assert.ErrorIs(t, l.Start(), ErrSubSystemAlreadyStarted, "Start should error when already started")
+	assert.True(t, l.IsRunning(), "IsRunning should return true")
+
+	_, ch, err := l.Subscribe()
+	require.NoError(t, err, "Subscribe must not error")
+	require.NoError(t, l.Stop(), "Stop must not error")
+	_, ok := <-ch
+	assert.False(t, ok, "Subscriber channel should be closed on Stop")
+	assert.False(t, l.IsRunning(), "IsRunning should return false")
+}
+
+func TestPositionHealth(t *testing.T) {
+	t.Parallel()
+	p := &monitoredPosition{side: order.Long, size: 1, entryPrice: 100, multiplier: 1, settlement: futures.Linear, marginType: margin.Isolated}
+	_, err := p.health(0.005)
+	assert.ErrorIs(t, err, errInvalidLeverage, "health should error without leverage")
+	p.leverage = 10
+	_, err = p.health(0.005)
+	assert.ErrorIs(t, err, errNoPositionPrice, "health should error without a mark price")
+
+	p.markPrice = 95
+	h, err := p.health(0.005)
+	require.NoError(t, err, "health must not error")
+	assert.Equal(t, 10.0, h.Margin, "Margin should be the initial margin")
+	assert.Equal(t, -5.0, h.UnrealisedPNL, "UnrealisedPNL should be correct")
+	assert.InDelta(t, 0.475, h.MaintenanceMargin, 1e-9, "MaintenanceMargin should be correct")
+	assert.InDelta(t, 0.095, h.MarginRatio, 1e-9, "MarginRatio should be correct")
+	assert.InDelta(t, 90/0.995, h.EstimatedLiquidationPrice, 1e-9, "EstimatedLiquidationPrice should be correct for a long")
+	assert.InDelta(t, (95-90/0.995)/95*100, h.DistanceToLiquidation, 1e-9, "DistanceToLiquidation should be correct")
+
+	p.side = order.Short
+	h, err = p.health(0.005)
+	require.NoError(t, err, "health must not error")
+	assert.Equal(t, 5.0, h.UnrealisedPNL, "UnrealisedPNL should be correct for a short")
+	assert.InDelta(t, 110/1.005, h.EstimatedLiquidationPrice, 1e-9, "EstimatedLiquidationPrice should be correct for a short")
+
+	p.side = order.Long
+	p.marginType = margin.Multi
+	p.collateral = 40
+	h, err = p.health(0.005)
+	require.NoError(t, err, "health must not error")
+	assert.Equal(t, 50.0, h.Margin, "Margin should include cross collateral")
+	assert.InDelta(t, 50/0.995, h.EstimatedLiquidationPrice, 1e-9, "EstimatedLiquidationPrice should use cross collateral")
+
+	p.collateral = 1000
+	h, err = p.health(0.005)
+	require.NoError(t, err, "health must not error")
+	assert.Zero(t, h.EstimatedLiquidationPrice, "EstimatedLiquidationPrice should be zero when margin covers any move")
+	assert.Zero(t, h.DistanceToLiquidation, "DistanceToLiquidation should be zero without a liquidation price")
+
+	p = &monitoredPosition{side: order.Long, size: 10, multiplier: 100, entryPrice: 100, markPrice: 100, leverage: 10, settlement: futures.Inverse, marginType: margin.Isolated}
+	h, err = p.health(0.005)
+	require.NoError(t, err, "health must not error")
+	assert.Equal(t, 1.0, h.Margin, "Margin should be in the base currency for inverse contracts")
+	assert.InDelta(t, 0.05, h.MarginRatio, 1e-9, "MarginRatio should be correct for inverse contracts")
+	assert.InDelta(t, 1005.0/11, h.EstimatedLiquidationPrice, 1e-9, "EstimatedLiquidationPrice should be correct for an inverse long")
+	p.side = order.Short
+	h, err = p.health(0.005)
+	require.NoError(t, err, "health must not error")
+	assert.InDelta(t, 995.0/9, h.EstimatedLiquidationPrice, 1e-9, "EstimatedLiquidationPrice should be correct for an inverse short")
+
+	p.markPrice = 200
+	h, err = p.health(0.005)
+	require.NoError(t, err, "health must not error")
+	assert.Equal(t, 1.0, h.MarginRatio, "MarginRatio should be capped once the margin balance is exhausted")
+}
+
+func TestLiquidationMonitorEscalation(t *testing.T) {
+	t.Parallel()
+	exch := &liquidationTestExchange{name: "liquidationa", leverage: 10}
+	l, om, comms := setupLiquidationMonitorTest(t, &config.LiquidationMonitor{
+		AutoDeleverage: config.LiquidationAutoDeleverage{Enabled: true, Action: config.LiquidationActionReducePosition},
+	}, exch, liquidationTestPosition(exch.name, order.Long))
+	_, ch, err := l.Subscribe()
+	require.NoError(t, err, "Subscribe must not error")
+
+	l.refresh(context.Background())
+	health, err := l.GetPositionHealth()
+	require.NoError(t, err, "GetPositionHealth must not error")
+	require.Len(t, health, 1, "Open position must be evaluated")
+	assert.Equal(t, HealthyPosition, health[0].Level, "Position should be healthy at its entry price")
+	assert.Equal(t, 1.0, l.positions[health[0].key()].multiplier, "Multiplier should default to one when unset")
+	assert.Empty(t, comms.getEvents(), "Healthy positions should not be alerted")
+
+	l.processTicker(context.Background(), &ticker.Price{ExchangeName: exch.name, Pair: currency.NewPair(currency.ETH, currency.USDT), AssetType: asset.USDTMarginedFutures, MarkPrice: 1})
+	l.processTicker(context.Background(), liquidationTestTicker(exch.name, 90.7))
+	health, err = l.GetPositionHealth()
+	require.NoError(t, err, "GetPositionHealth must not error")
+	assert.Equal(t, WarningPosition, health[0].Level, "Position should escalate to warning")
+	assert.Equal(t, 90.7, health[0].MarkPrice, "MarkPrice should use the ticker's mark price")
+	require.Len(t, comms.getEvents(), 1, "Warning must be alerted")
+	assert.Equal(t, "liquidation", comms.getEvents()[0].Type, "Event type should be correct")
+
+	l.processTicker(context.Background(), liquidationTestTicker(exch.name, 90.6))
+	assert.Len(t, comms.getEvents(), 1, "Alerts should not repeat at the same level within the cooldown")
+
+	l.processTicker(context.Background(), liquidationTestTicker(exch.name, 90.5))
+	health, err = l.GetPositionHealth()
+	require.NoError(t, err, "GetPositionHealth must not error")
+	assert.Equal(t, CriticalPosition, health[0].Level, "Position should escalate to critical")
+	assert.Len(t, comms.getEvents(), 3, "Critical escalation and auto deleverage should be alerted")
+	require.Len(t, om.submitted, 1, "Reduce-only order must be submitted")
+	assert.Equal(t, order.Sell, om.submitted[0].Side, "Long positions should be reduced by selling")
+	assert.Equal(t, 0.25, om.submitted[0].Amount, "Amount should be the configured percentage of the position")
+	assert.True(t, om.submitted[0].ReduceOnly, "Order should be reduce-only")
+
+	l.processTicker(context.Background(), liquidationTestTicker(exch.name, 90.4))
+	assert.Len(t, om.submitted, 1, "Auto deleverage should not repeat within the cooldown")
+	assert.Len(t, ch, 5, "Each evaluation should be published to subscribers")
+
+	om.m.Lock()
+	om.positions = nil
+	om.m.Unlock()
+	l.refresh(context.Background())
+	health, err = l.GetPositionHealth()
+	require.NoError(t, err, "GetPositionHealth must not error")
+	assert.Empty(t, health, "Closed positions should no longer be monitored")
+}
+
+func TestLiquidationMonitorAddMargin(t *testing.T) {
+	t.Parallel()
+	exch := &liquidationTestExchange{name: "liquidationb", leverage: 10, multiplier: 2}
+	pos := liquidationTestPosition(exch.name, order.Short)
+	l, _, comms := setupLiquidationMonitorTest(t, &config.LiquidationMonitor{
+		AutoDeleverage: config.LiquidationAutoDeleverage{Enabled: true, Action: config.LiquidationActionAddMargin, Percentage: 50},
+	}, exch, pos)
+	l.refresh(context.Background())
+	l.processTicker(context.Background(), liquidationTestTicker(exch.name, 109.5))
+	exch.m.Lock()
+	require.Len(t, exch.marginChanges, 1, "Margin must be added")
+	assert.Equal(t, 20.0, exch.marginChanges[0].OriginalAllocatedMargin, "OriginalAllocatedMargin should include the contract multiplier")
+	assert.Equal(t, 30.0, exch.marginChanges[0].NewAllocatedMargin, "NewAllocatedMargin should add the configured percentage")
+	assert.Equal(t, margin.Isolated, exch.marginChanges[0].MarginType, "MarginType should be isolated")
+	exch.m.Unlock()
+	assert.Len(t, comms.getEvents(), 2, "Critical alert and auto deleverage should be pushed")
+
+	l.refresh(context.Background())
+	l.processTicker(context.Background(), liquidationTestTicker(exch.name, 109.5))
+	health, err := l.GetPositionHealth()
+	require.NoError(t, err, "GetPositionHealth must not error")
+	require.Len(t, health, 1, "Position must be monitored")
+	assert.Equal(t, 30.0, health[0].Margin, "Added margin should be retained across refreshes")
+	assert.Equal(t, HealthyPosition, health[0].Level, "Added margin should restore the position's health")
+
+	l.marginType = margin.Multi
+	err = l.deleverage(context.Background(), health[0].key(), l.positions[health[0].key()], &PositionHealth{MarginType: margin.Multi, MarginRatio: 1, Time: time.Now().Add(time.Hour * 24)})
+	assert.ErrorIs(t, err, errAddMarginRequiresIsolated, "deleverage should error adding margin to a cross position")
+}
+
+func TestLiquidationMonitorCrossMargin(t *testing.T) {
+	t.Parallel()
+	exch := &liquidationTestExchange{name: "liquidationc", leverage: 10, collateral: 40}
+	l, om, _ := setupLiquidationMonitorTest(t, &config.LiquidationMonitor{MarginType: "cross"}, exch, liquidationTestPosition(exch.name, order.Long))
+	l.refresh(context.Background())
+	health, err := l.GetPositionHealth()
+	require.NoError(t, err, "GetPositionHealth must not error")
+	require.Len(t, health, 1, "Open position must be evaluated")
+	assert.Equal(t, margin.Multi, health[0].MarginType, "MarginType should be cross")
+	assert.Equal(t, 50.0, health[0].Margin, "Margin should include the account's available collateral")
+
+	pos := liquidationTestPosition(exch.name, order.Long)
+	pos.Pair = currency.NewPair(currency.ETH, currency.USD)
+	pos.LatestSize = decimal.NewFromInt(3)
+	om.m.Lock()
+	om.positions = append(om.positions, pos)
+	om.m.Unlock()
+	l.refresh(context.Background())
+	health, err = l.GetPositionHealth()
+	require.NoError(t, err, "GetPositionHealth must not error")
+	require.Len(t, health, 2, "Open positions must be evaluated")
+	for i := range health {
+		if health[i].Pair.Equal(btcusdPair) {
+			assert.InDelta(t, 20.0, health[i].Margin, 1e-9, "Collateral should be shared by notional value")
+			continue
+		}
+		assert.InDelta(t, 60.0, health[i].Margin, 1e-9, "Collateral should be shared by notional value")
+	}
+}
@@ -0,0 +1,135 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// LiquidationMonitorName is an exported subsystem name
+const LiquidationMonitorName = "liquidation_monitor"
+
+// liquidationSubscriberBuffer is the number of position health updates
+// buffered for each subscriber before updates are dropped
+const liquidationSubscriberBuffer = 100
+
+var (
+	errInvalidLeverage             = errors.New("invalid leverage")
+	errInvalidPositionSize         = errors.New("invalid position size")
+	errNoPositionPrice             = errors.New("position has no entry or mark price")
+	errAddMarginRequiresIsolated   = errors.New("adding margin requires an isolated margin position")
+	errUnknownLiquidationRiskLevel = errors.New("unknown liquidation risk level")
+)
+
+// LiquidationRiskLevel describes how close a position is to liquidation
+type LiquidationRiskLevel uint8
+
+// Liquidation risk levels
+const (
+	// HealthyPosition has a margin ratio below the warning threshold
+	HealthyPosition LiquidationRiskLevel = iota
+	// WarningPosition has a margin ratio at or above the warning threshold
+	WarningPosition
+	// CriticalPosition has a margin ratio at or above the critical threshold
+	CriticalPosition
+)
+
+// LiquidationMonitor estimates the liquidation price and margin ratio of
+// every open futures position tracked by the order manager, re-evaluating
+// them on each ticker update and escalating alerts as margin thresholds are
+// crossed. It can optionally add margin or reduce positions automatically
+type LiquidationMonitor struct {
+	started               int32
+	shutdown              chan struct{}
+	wg                    sync.WaitGroup
+	exchangeManager       iExchangeManager
+	orderManager          iFuturesPositionManager
+	commsManager          iCommsManager
+	checkInterval         time.Duration
+	marginType            margin.Type
+	maintenanceMarginRate float64
+	warningRatio          float64
+	criticalRatio         float64
+	alertCooldown         time.Duration
+	autoDeleverage        config.LiquidationAutoDeleverage
+	verbose               bool
+
+	m           sync.Mutex
+	pipes       map[string]dispatch.Pipe
+	positions   map[key.ExchangePairAsset]*monitoredPosition
+	health      map[key.ExchangePairAsset]PositionHealth
+	lastAlert   map[key.ExchangePairAsset]liquidationAlert
+	lastAction  map[key.ExchangePairAsset]time.Time
+	subscribers map[uuid.UUID]chan PositionHealth
+}
+
+// PositionHealth is the estimated liquidation price and margin ratio of an
+// open futures position at its latest mark price
+type PositionHealth struct {
+	Exchange   string
+	Asset      asset.Item
+	Pair       currency.Pair
+	Side       order.Side
+	Size       float64
+	EntryPrice float64
+	MarkPrice  float64
+	Leverage   float64
+	MarginType margin.Type
+	// Margin is the collateral backing the position, including the account's
+	// available collateral for cross margin positions. It is denominated in
+	// the base currency for inverse contracts
+	Margin            float64
+	UnrealisedPNL     float64
+	MaintenanceMargin float64
+	// MarginRatio is the maintenance margin divided by the margin balance,
+	// the position is liquidated when it reaches one
+	MarginRatio float64
+	// EstimatedLiquidationPrice is zero when the position's margin covers any
+	// price move
+	EstimatedLiquidationPrice float64
+	// DistanceToLiquidation is the percentage move from the mark price to
+	// the estimated liquidation price
+	DistanceToLiquidation float64
+	Level                 LiquidationRiskLevel
+	Time                  time.Time
+}
+
+// monitoredPosition holds the details of an open position refreshed on each
+// interval which are combined with ticker prices to evaluate its health
+type monitoredPosition struct {
+	exchange   string
+	asset      asset.Item
+	pair       currency.Pair
+	side       order.Side
+	size       float64
+	entryPrice float64
+	markPrice  float64
+	leverage   float64
+	marginType margin.Type
+	// multiplier converts the position size into base units for linear
+	// contracts or quote units for inverse contracts
+	multiplier float64
+	settlement futures.ContractSettlementType
+	// collateral is the position's share of the account's available
+	// collateral which also backs cross margin positions
+	collateral float64
+	// addedMargin is the margin allocated by auto deleveraging since the
+	// position was opened
+	addedMargin float64
+}
+
+// liquidationAlert records the last alert sent for a position
+type liquidationAlert struct {
+	level LiquidationRiskLevel
+	time  time.Time
+}
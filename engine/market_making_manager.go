@@ -0,0 +1,756 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupMarketMakingManager applies configuration parameters before running
+func SetupMarketMakingManager(exchangeManager iExchangeManager, orderManager iMarketMakingOrderManager, cfg *config.MarketMakingManager) (*MarketMakingManager, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", MarketMakingManagerName, errNilConfig)
+	}
+	refreshInterval := cfg.RefreshInterval
+	if refreshInterval <= 0 {
+		refreshInterval = config.DefaultMarketMakingRefreshInterval
+	}
+	return &MarketMakingManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		strategies:      make(map[string]*MarketMakingStrategy),
+		orders:          make(map[marketMakingOrderKey]string),
+		refresh:         make(chan string, marketMakingRefreshBuffer),
+		refreshInterval: refreshInterval,
+		verbose:         cfg.Verbose,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MarketMakingManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem. Fills published on the event bus requote their
+// strategy immediately, without the event bus strategies are only requoted
+// every refresh interval
+func (m *MarketMakingManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Market making manager %s", MsgSubSystemStarting)
+	m.shutdown = make(chan struct{})
+	var fills <-chan *eventbus.Event
+	sub, err := eventbus.Subscribe(eventbus.FillTopic, nil)
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Market making manager unable to subscribe to fills on the event bus: %v", err)
+	} else {
+		fills = sub.C()
+	}
+	m.fills = sub
+	m.wg.Add(1)
+	go m.run(fills)
+	log.Debugf(log.OrderMgr, "Market making manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem. Quotes are cancelled so that no
+// orders are left resting on exchanges without being managed, strategies are
+// retained and resume quoting when the subsystem is started again
+func (m *MarketMakingManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Market making manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	if m.fills != nil {
+		if err := m.fills.Unsubscribe(); err != nil {
+			log.Errorf(log.OrderMgr, "Market making manager unable to unsubscribe from fills: %v", err)
+		}
+		m.fills = nil
+	}
+	for _, id := range m.strategyIDs() {
+		m.m.Lock()
+		s := m.strategies[id]
+		if s.pending {
+			m.m.Unlock()
+			log.Errorf(log.OrderMgr, "Market making manager unable to cancel quotes for strategy %s: %v", id, errMarketMakingStrategyBusy)
+			continue
+		}
+		w := m.beginUpdate(s)
+		m.m.Unlock()
+		if err := m.cancelQuotes(context.TODO(), w); err != nil {
+			log.Errorf(log.OrderMgr, "Market making manager unable to cancel quotes for strategy %s: %v", id, err)
+		}
+		m.finishUpdate(w)
+	}
+	atomic.StoreInt32(&m.started, 0)
+	log.Debugf(log.OrderMgr, "Market making manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run requotes all strategies every refresh interval and individual
+// strategies as soon as they receive a fill
+func (m *MarketMakingManager) run(fills <-chan *eventbus.Event) {
+	defer m.wg.Done()
+	t := time.NewTicker(m.refreshInterval)
+	defer t.Stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func(shutdown <-chan struct{}) {
+		<-shutdown
+		cancel()
+	}(m.shutdown)
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.requoteAll(ctx)
+		case e, ok := <-fills:
+			if !ok {
+				// The event bus has stopped, fall back to the refresh interval
+				fills = nil
+				continue
+			}
+			if err := m.ProcessFill(e.Fill); err != nil {
+				log.Errorf(log.OrderMgr, "Market making manager unable to process fill: %v", err)
+			}
+		case id := <-m.refresh:
+			m.requote(ctx, id)
+		}
+	}
+}
+
+// Add validates a market making strategy and places its initial quotes.
+// Returns the strategy ID
+func (m *MarketMakingManager) Add(ctx context.Context, s *MarketMakingStrategy) (string, error) {
+	if !m.IsRunning() {
+		return "", fmt.Errorf("%s %w", MarketMakingManagerName, ErrSubSystemNotStarted)
+	}
+	strategy, err := m.prepare(s)
+	if err != nil {
+		return "", err
+	}
+	m.m.Lock()
+	m.strategies[strategy.ID] = strategy
+	w := m.beginUpdate(strategy)
+	m.m.Unlock()
+	log.Infof(log.OrderMgr, "Market making manager added strategy %s quoting %s %s %s with %d layers of %v spread %v bps",
+		strategy.ID, strategy.Exchange, strategy.Asset, strategy.Pair, strategy.Layers, strategy.OrderAmount, strategy.SpreadBasisPoints)
+	m.quote(ctx, w)
+	m.finishUpdate(w)
+	return strategy.ID, nil
+}
+
+// Remove stops a market making strategy and cancels its quotes. Its metrics
+// remain available
+func (m *MarketMakingManager) Remove(ctx context.Context, id string) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	s, ok := m.strategies[id]
+	if !ok {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s", ErrMarketMakingStrategyNotFound, id)
+	}
+	if s.Status != MarketMakingActive {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s status %s", errMarketMakingStrategyStopped, id, s.Status)
+	}
+	if s.pending {
+		m.m.Unlock()
+		return fmt.Errorf("%w %s", errMarketMakingStrategyBusy, id)
+	}
+	s.Status = MarketMakingStopped
+	s.Reason = "stopped by user"
+	s.UpdatedDate = time.Now()
+	w := m.beginUpdate(s)
+	m.m.Unlock()
+	err := m.cancelQuotes(ctx, w)
+	m.finishUpdate(w)
+	return err
+}
+
+// GetStrategy returns a copy of a market making strategy by its ID
+func (m *MarketMakingManager) GetStrategy(id string) (*MarketMakingStrategy, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", MarketMakingManagerName, ErrNilSubsystem)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	s, ok := m.strategies[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrMarketMakingStrategyNotFound, id)
+	}
+	return s.copy(), nil
+}
+
+// GetStrategies returns copies of all market making strategies ordered by
+// creation date
+func (m *MarketMakingManager) GetStrategies() ([]MarketMakingStrategy, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", MarketMakingManagerName, ErrNilSubsystem)
+	}
+	m.m.Lock()
+	resp := make([]MarketMakingStrategy, 0, len(m.strategies))
+	for _, s := range m.strategies {
+		resp = append(resp, *s.copy())
+	}
+	m.m.Unlock()
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CreatedDate.Before(resp[j].CreatedDate)
+	})
+	return resp, nil
+}
+
+// ProcessFill attributes a fill to the strategy which quoted the order,
+// updating its inventory and profit and loss before requoting it
+func (m *MarketMakingManager) ProcessFill(f *fill.Data) error {
+	if !m.IsRunning() {
+		return nil
+	}
+	if f == nil {
+		return fmt.Errorf("%w fill", common.ErrNilPointer)
+	}
+	m.m.Lock()
+	id, ok := m.orders[marketMakingOrderKey{Exchange: strings.ToLower(f.Exchange), OrderID: f.OrderID}]
+	if !ok {
+		m.m.Unlock()
+		return nil
+	}
+	s := m.strategies[id]
+	side := f.Side
+	for i := range s.Quotes {
+		if s.Quotes[i].OrderID == f.OrderID {
+			s.Quotes[i].FilledAmount += f.Amount
+			side = s.Quotes[i].Side
+			break
+		}
+	}
+	timestamp := f.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	s.Metrics.applyFill(side, f.Price, f.Amount, timestamp)
+	s.inventoryStale = true
+	s.UpdatedDate = timestamp
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Market making manager strategy %s %s fill of %v at %v, position %v realised PNL %v",
+			s.ID, side, f.Amount, f.Price, s.Metrics.Position, s.Metrics.RealisedPNL)
+	}
+	m.m.Unlock()
+
+	select {
+	case m.refresh <- id:
+	default:
+		// The strategy will be requoted on the next refresh
+	}
+	return nil
+}
+
+// requoteAll requotes every active strategy
+func (m *MarketMakingManager) requoteAll(ctx context.Context) {
+	for _, id := range m.strategyIDs() {
+		m.requote(ctx, id)
+	}
+}
+
+// requote requotes an active strategy. Strategies whose quotes are already
+// being placed or cancelled are skipped until the next refresh
+func (m *MarketMakingManager) requote(ctx context.Context, id string) {
+	m.m.Lock()
+	s, ok := m.strategies[id]
+	if !ok || s.Status != MarketMakingActive || s.pending {
+		m.m.Unlock()
+		return
+	}
+	w := m.beginUpdate(s)
+	m.m.Unlock()
+	m.quote(ctx, w)
+	m.finishUpdate(w)
+}
+
+// strategyIDs returns the IDs of all strategies
+func (m *MarketMakingManager) strategyIDs() []string {
+	m.m.Lock()
+	defer m.m.Unlock()
+	ids := make([]string, 0, len(m.strategies))
+	for id := range m.strategies {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// beginUpdate marks a strategy as pending and returns a working copy whose
+// quotes can be placed and cancelled without holding the lock. Must be called
+// with the lock held
+func (m *MarketMakingManager) beginUpdate(s *MarketMakingStrategy) *MarketMakingStrategy {
+	s.pending = true
+	w := s.copy()
+	// Fills received while the working copy is quoted mark the inventory
+	// stale again
+	s.inventoryStale = false
+	return w
+}
+
+// finishUpdate applies a working copy to its strategy. Fills received while
+// the working copy was quoted are retained
+func (m *MarketMakingManager) finishUpdate(w *MarketMakingStrategy) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	s := m.strategies[w.ID]
+	s.pending = false
+	filled := make(map[string]float64, len(s.Quotes))
+	for i := range s.Quotes {
+		filled[s.Quotes[i].OrderID] = s.Quotes[i].FilledAmount
+	}
+	for i := range w.Quotes {
+		if amount, ok := filled[w.Quotes[i].OrderID]; ok {
+			w.Quotes[i].FilledAmount = amount
+		}
+	}
+	s.Quotes = w.Quotes
+	s.Reason = w.Reason
+	if w.UpdatedDate.After(s.UpdatedDate) {
+		s.UpdatedDate = w.UpdatedDate
+	}
+	s.Metrics.MidPrice = w.Metrics.MidPrice
+	s.Metrics.FairValue = w.Metrics.FairValue
+	s.Metrics.Inventory = w.Metrics.Inventory
+	s.Metrics.Requotes = w.Metrics.Requotes
+	if s.Metrics.MidPrice > 0 {
+		s.Metrics.UnrealisedPNL = s.Metrics.Position * (s.Metrics.MidPrice - s.Metrics.AverageEntryPrice)
+	}
+	s.inventoryStale = s.inventoryStale || w.inventoryStale
+}
+
+// quote recalculates a working copy's quotes from the current mid price and
+// inventory and reconciles them with its resting orders. Must be called
+// without the lock held
+func (m *MarketMakingManager) quote(ctx context.Context, s *MarketMakingStrategy) {
+	exch, err := m.exchangeManager.GetExchangeByName(s.Exchange)
+	if err != nil {
+		m.setReason(s, err)
+		return
+	}
+	depth, err := orderbook.GetDepth(s.Exchange, s.Pair, s.Asset)
+	if err != nil {
+		m.setReason(s, err)
+		return
+	}
+	mid, err := depth.GetMidPrice()
+	if err != nil {
+		m.setReason(s, err)
+		return
+	}
+	inventory, err := m.getInventory(ctx, exch, s)
+	if err != nil {
+		m.setReason(s, err)
+		return
+	}
+	model := s.Model
+	if model == nil {
+		model = LayeredQuotes
+	}
+	fairValue, desired := model(s, mid, inventory)
+	s.Metrics.MidPrice = mid
+	s.Metrics.FairValue = fairValue
+	s.Metrics.Inventory = inventory
+	s.Reason = ""
+	s.UpdatedDate = time.Now()
+
+	limits, err := exch.GetOrderExecutionLimits(s.Asset, s.Pair)
+	if err != nil {
+		// Quotes are checked against the exchange's limits before submission
+		limits = order.MinMaxLevel{}
+	}
+	m.syncQuotes(s)
+	resting := make(map[quoteSlot]int, len(s.Quotes))
+	for i := range s.Quotes {
+		resting[quoteSlot{side: s.Quotes[i].Side, layer: s.Quotes[i].Layer}] = i
+	}
+	var quotes []Quote
+	for i := range desired {
+		q := desired[i]
+		q.Price = conformQuotePrice(q.Side, q.Price, limits.PriceStepIncrementSize)
+		slot := quoteSlot{side: q.Side, layer: q.Layer}
+		idx, ok := resting[slot]
+		delete(resting, slot)
+		if err = exch.CheckOrderExecutionLimits(s.Asset, s.Pair, q.Price, q.Amount, order.Limit); err != nil {
+			m.setReason(s, fmt.Errorf("%s layer %d quote at %v: %w", q.Side, q.Layer, q.Price, err))
+			if ok && m.cancelQuote(ctx, s, &s.Quotes[idx]) != nil {
+				quotes = append(quotes, s.Quotes[idx])
+			}
+			continue
+		}
+		if !ok {
+			if placed := m.placeQuote(ctx, s, &q); placed != nil {
+				quotes = append(quotes, *placed)
+			}
+			continue
+		}
+		if !s.Quotes[idx].needsRequote(&q, s.RequoteThresholdBasisPoints) {
+			quotes = append(quotes, s.Quotes[idx])
+			continue
+		}
+		if replaced := m.replaceQuote(ctx, s, &s.Quotes[idx], &q); replaced != nil {
+			quotes = append(quotes, *replaced)
+		}
+	}
+	for _, idx := range resting {
+		if m.cancelQuote(ctx, s, &s.Quotes[idx]) != nil {
+			quotes = append(quotes, s.Quotes[idx])
+		}
+	}
+	s.Quotes = quotes
+}
+
+// syncQuotes removes quotes whose orders are no longer resting on the
+// exchange
+func (m *MarketMakingManager) syncQuotes(s *MarketMakingStrategy) {
+	quotes := s.Quotes[:0]
+	for i := range s.Quotes {
+		d, err := m.orderManager.GetByExchangeAndID(s.Exchange, s.Quotes[i].OrderID)
+		if err == nil && d.Status != order.UnknownStatus && d.Status.IsInactive() {
+			m.untrackOrder(s, s.Quotes[i].OrderID)
+			continue
+		}
+		quotes = append(quotes, s.Quotes[i])
+	}
+	s.Quotes = quotes
+}
+
+// placeQuote submits a quote as a limit order, returning nil when it is
+// rejected
+func (m *MarketMakingManager) placeQuote(ctx context.Context, s *MarketMakingStrategy, q *Quote) *Quote {
+	resp, err := m.orderManager.Submit(ctx, &order.Submit{
+		Exchange:  s.Exchange,
+		Type:      order.Limit,
+		Side:      q.Side,
+		Pair:      s.Pair,
+		AssetType: s.Asset,
+		Amount:    q.Amount,
+		Price:     q.Price,
+		PostOnly:  s.PostOnly,
+	})
+	if err != nil {
+		m.setReason(s, fmt.Errorf("unable to place %s layer %d quote: %w", q.Side, q.Layer, err))
+		return nil
+	}
+	placed := *q
+	placed.FilledAmount = 0
+	if resp != nil && resp.Detail != nil {
+		placed.OrderID = resp.OrderID
+	}
+	m.trackOrder(s, placed.OrderID)
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Market making manager strategy %s placed %s layer %d quote %s for %v at %v",
+			s.ID, q.Side, q.Layer, placed.OrderID, q.Amount, q.Price)
+	}
+	return &placed
+}
+
+// replaceQuote modifies a resting quote's price and amount. Exchanges which
+// cannot modify the order have it cancelled and a new quote placed. Returns
+// the quote left resting, if any
+func (m *MarketMakingManager) replaceQuote(ctx context.Context, s *MarketMakingStrategy, existing, q *Quote) *Quote {
+	resp, err := m.orderManager.Modify(ctx, &order.Modify{
+		Exchange:  s.Exchange,
+		OrderID:   existing.OrderID,
+		Type:      order.Limit,
+		Side:      existing.Side,
+		AssetType: s.Asset,
+		Pair:      s.Pair,
+		Price:     q.Price,
+		Amount:    q.Amount,
+	})
+	if err != nil {
+		if m.verbose {
+			log.Debugf(log.OrderMgr, "Market making manager strategy %s unable to modify quote %s, replacing: %v",
+				s.ID, existing.OrderID, err)
+		}
+		if m.cancelQuote(ctx, s, existing) != nil {
+			return existing
+		}
+		return m.placeQuote(ctx, s, q)
+	}
+	s.Metrics.Requotes++
+	replaced := *q
+	replaced.OrderID = existing.OrderID
+	replaced.FilledAmount = existing.FilledAmount
+	if resp != nil && resp.OrderID != "" && resp.OrderID != existing.OrderID {
+		// Some exchanges assign a new ID to modified orders
+		m.untrackOrder(s, existing.OrderID)
+		replaced.OrderID = resp.OrderID
+		replaced.FilledAmount = 0
+		m.trackOrder(s, replaced.OrderID)
+	}
+	return &replaced
+}
+
+// cancelQuote cancels a resting quote
+func (m *MarketMakingManager) cancelQuote(ctx context.Context, s *MarketMakingStrategy, q *Quote) error {
+	err := m.orderManager.Cancel(ctx, &order.Cancel{
+		Exchange:  s.Exchange,
+		OrderID:   q.OrderID,
+		Side:      q.Side,
+		Pair:      s.Pair,
+		AssetType: s.Asset,
+	})
+	if err != nil {
+		m.setReason(s, fmt.Errorf("unable to cancel quote %s: %w", q.OrderID, err))
+		return err
+	}
+	m.untrackOrder(s, q.OrderID)
+	return nil
+}
+
+// trackOrder attributes fills of a resting order to the strategy
+func (m *MarketMakingManager) trackOrder(s *MarketMakingStrategy, orderID string) {
+	m.m.Lock()
+	m.orders[marketMakingOrderKey{Exchange: strings.ToLower(s.Exchange), OrderID: orderID}] = s.ID
+	m.m.Unlock()
+}
+
+// untrackOrder stops attributing fills of an order to a strategy
+func (m *MarketMakingManager) untrackOrder(s *MarketMakingStrategy, orderID string) {
+	m.m.Lock()
+	delete(m.orders, marketMakingOrderKey{Exchange: strings.ToLower(s.Exchange), OrderID: orderID})
+	m.m.Unlock()
+}
+
+// cancelQuotes cancels all of a strategy's resting quotes, retaining those
+// which could not be cancelled
+func (m *MarketMakingManager) cancelQuotes(ctx context.Context, s *MarketMakingStrategy) error {
+	var errs error
+	quotes := s.Quotes[:0]
+	for i := range s.Quotes {
+		if err := m.cancelQuote(ctx, s, &s.Quotes[i]); err != nil {
+			errs = common.AppendError(errs, err)
+			quotes = append(quotes, s.Quotes[i])
+		}
+	}
+	s.Quotes = quotes
+	return errs
+}
+
+// getInventory returns the total base currency held for the strategy's asset.
+// Holdings are refreshed from the exchange after a fill, otherwise the latest
+// stored holdings are used
+func (m *MarketMakingManager) getInventory(ctx context.Context, exch exchange.IBotExchange, s *MarketMakingStrategy) (float64, error) {
+	var holdings account.Holdings
+	var err error
+	if s.inventoryStale {
+		holdings, err = exch.UpdateAccountInfo(ctx, s.Asset)
+	} else {
+		holdings, err = exch.FetchAccountInfo(ctx, s.Asset)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve holdings: %w", err)
+	}
+	s.inventoryStale = false
+	var inventory float64
+	for i := range holdings.Accounts {
+		if holdings.Accounts[i].AssetType != s.Asset {
+			continue
+		}
+		for j := range holdings.Accounts[i].Currencies {
+			if holdings.Accounts[i].Currencies[j].Currency.Equal(s.Pair.Base) {
+				inventory += holdings.Accounts[i].Currencies[j].Total
+			}
+		}
+	}
+	return inventory, nil
+}
+
+// setReason records the latest error encountered by a strategy
+func (m *MarketMakingManager) setReason(s *MarketMakingStrategy, err error) {
+	s.Reason = err.Error()
+	s.UpdatedDate = time.Now()
+	if m.verbose {
+		log.Warnf(log.OrderMgr, "Market making manager strategy %s: %v", s.ID, err)
+	}
+}
+
+// prepare validates a market making strategy and returns a copy ready to be
+// stored
+func (m *MarketMakingManager) prepare(s *MarketMakingStrategy) (*MarketMakingStrategy, error) {
+	if s == nil {
+		return nil, errNilMarketMakingStrategy
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(s.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	if s.Pair.IsEmpty() {
+		return nil, order.ErrPairIsEmpty
+	}
+	if !s.Asset.IsValid() {
+		return nil, fmt.Errorf("'%s' %w", s.Asset, asset.ErrNotSupported)
+	}
+	if s.Layers <= 0 {
+		return nil, fmt.Errorf("%w %v", errInvalidLayerCount, s.Layers)
+	}
+	if s.OrderAmount <= 0 {
+		return nil, fmt.Errorf("%w %v", order.ErrAmountIsInvalid, s.OrderAmount)
+	}
+	if s.SpreadBasisPoints <= 0 {
+		return nil, fmt.Errorf("%w %v", errInvalidSpread, s.SpreadBasisPoints)
+	}
+	if s.LayerSpacingBasisPoints < 0 {
+		return nil, fmt.Errorf("%w %v", errInvalidLayerSpacing, s.LayerSpacingBasisPoints)
+	}
+	if s.MaxInventory < 0 {
+		return nil, fmt.Errorf("%w %v", errInvalidInventoryLimits, s.MaxInventory)
+	}
+	if s.InventorySkewBasisPoints < 0 {
+		return nil, fmt.Errorf("%w %v", errInvalidSkew, s.InventorySkewBasisPoints)
+	}
+	if s.RequoteThresholdBasisPoints < 0 {
+		return nil, fmt.Errorf("%w %v", errInvalidRequoteThreshold, s.RequoteThresholdBasisPoints)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	strategy := s.copy()
+	strategy.ID = id.String()
+	strategy.Exchange = exch.GetName()
+	strategy.Status = MarketMakingActive
+	strategy.Reason = ""
+	strategy.Quotes = nil
+	strategy.Metrics = MarketMakingMetrics{}
+	strategy.CreatedDate = now
+	strategy.UpdatedDate = now
+	strategy.inventoryStale = true
+	return strategy, nil
+}
+
+// LayeredQuotes is the default quote model. The fair value is the mid price
+// moved against the strategy's inventory so that excess inventory is more
+// likely to be sold and a shortfall more likely to be bought back. Each layer
+// quotes the order amount on both sides at half the spread plus the layer
+// spacing from the fair value. Once inventory reaches its limit the side
+// which would increase it further is no longer quoted
+func LayeredQuotes(s *MarketMakingStrategy, midPrice, inventory float64) (fairValue float64, quotes []Quote) {
+	var skew float64
+	if s.MaxInventory > 0 {
+		skew = math.Max(-1, math.Min(1, (inventory-s.TargetInventory)/s.MaxInventory))
+	}
+	fairValue = midPrice * (1 - skew*s.InventorySkewBasisPoints/10000)
+	quotes = make([]Quote, 0, s.Layers*2)
+	for i := int64(0); i < s.Layers; i++ {
+		offset := (s.SpreadBasisPoints/2 + float64(i)*s.LayerSpacingBasisPoints) / 10000
+		if skew < 1 {
+			quotes = append(quotes, Quote{Side: order.Buy, Layer: i, Price: fairValue * (1 - offset), Amount: s.OrderAmount})
+		}
+		if skew > -1 {
+			quotes = append(quotes, Quote{Side: order.Sell, Layer: i, Price: fairValue * (1 + offset), Amount: s.OrderAmount})
+		}
+	}
+	return fairValue, quotes
+}
+
+// conformQuotePrice rounds a quote's price to the exchange's price step away
+// from the fair value so that the quoted spread is never narrowed
+func conformQuotePrice(side order.Side, price, step float64) float64 {
+	if step <= 0 {
+		return price
+	}
+	steps := price / step
+	if side.IsLong() {
+		return math.Floor(steps+amountTolerance) * step
+	}
+	return math.Ceil(steps-amountTolerance) * step
+}
+
+// needsRequote returns whether a resting quote has moved far enough from its
+// desired price, or has a different amount, to be replaced
+func (q *Quote) needsRequote(desired *Quote, thresholdBasisPoints float64) bool {
+	if q.Amount != desired.Amount {
+		return true
+	}
+	if q.Price <= 0 {
+		return true
+	}
+	return math.Abs(desired.Price-q.Price)/q.Price*10000 > thresholdBasisPoints
+}
+
+// applyFill updates the position, average entry price and realised profit
+// and loss from a fill
+func (mm *MarketMakingMetrics) applyFill(side order.Side, price, amount float64, t time.Time) {
+	signed := amount
+	if side.IsShort() {
+		signed = -amount
+		mm.SellVolume += amount
+	} else {
+		mm.BuyVolume += amount
+	}
+	mm.Fills++
+	mm.LastFill = t
+	switch {
+	case mm.Position == 0 || (mm.Position > 0) == (signed > 0):
+		mm.AverageEntryPrice = (mm.AverageEntryPrice*math.Abs(mm.Position) + price*amount) / (math.Abs(mm.Position) + amount)
+		mm.Position += signed
+	default:
+		closed := math.Min(amount, math.Abs(mm.Position))
+		direction := 1.0
+		if mm.Position < 0 {
+			direction = -1
+		}
+		mm.RealisedPNL += closed * (price - mm.AverageEntryPrice) * direction
+		mm.Position += signed
+		switch {
+		case math.Abs(mm.Position) <= amount*amountTolerance:
+			mm.Position = 0
+			mm.AverageEntryPrice = 0
+		case (mm.Position > 0) != (direction > 0):
+			// The fill reversed the position, the remainder was opened at
+			// the fill price
+			mm.AverageEntryPrice = price
+		}
+	}
+	if mm.MidPrice > 0 {
+		mm.UnrealisedPNL = mm.Position * (mm.MidPrice - mm.AverageEntryPrice)
+	}
+}
+
+// copy returns a deep copy of a market making strategy
+func (s *MarketMakingStrategy) copy() *MarketMakingStrategy {
+	cpy := *s
+	cpy.Quotes = append([]Quote(nil), s.Quotes...)
+	return &cpy
+}
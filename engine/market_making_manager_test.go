@@ -0,0 +1,563 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var (
+	errFakeModify = errors.New("fake modify failure")
+	errFakeLimits = errors.New("fake limits failure")
+)
+
+// fakeMarketMakingOrderManager extends the algo order manager fake with order
+// modification
+type fakeMarketMakingOrderManager struct {
+	*fakeAlgoOrderManager
+	modified  []order.Modify
+	modifyErr error
+	// newIDs assigns a new order ID to modified orders when set
+	newIDs bool
+}
+
+func (f *fakeMarketMakingOrderManager) Modify(_ context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.modifyErr != nil {
+		return nil, f.modifyErr
+	}
+	d, ok := f.orders[mod.OrderID]
+	if !ok {
+		return nil, ErrOrderNotFound
+	}
+	f.modified = append(f.modified, *mod)
+	d.Price = mod.Price
+	d.Amount = mod.Amount
+	resp := &order.ModifyResponse{OrderID: mod.OrderID, Price: mod.Price, Amount: mod.Amount}
+	if f.newIDs {
+		delete(f.orders, mod.OrderID)
+		resp.OrderID = "modified-" + mod.OrderID
+		d.OrderID = resp.OrderID
+		f.orders[resp.OrderID] = d
+	}
+	return resp, nil
+}
+
+func (f *fakeMarketMakingOrderManager) getModified() []order.Modify {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]order.Modify(nil), f.modified...)
+}
+
+func (f *fakeMarketMakingOrderManager) getCancelled() []string {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]string(nil), f.cancelled...)
+}
+
+// marketMakingTestExchange overrides holdings and execution limits
+type marketMakingTestExchange struct {
+	exchange.IBotExchange
+	name      string
+	m         sync.Mutex
+	inventory float64
+	updates   int
+	step      float64
+	limitErr  error
+}
+
+func (e *marketMakingTestExchange) GetName() string {
+	return e.name
+}
+
+func (e *marketMakingTestExchange) holdings() account.Holdings {
+	return account.Holdings{
+		Exchange: e.name,
+		Accounts: []account.SubAccount{{
+			AssetType: asset.Spot,
+			Currencies: []account.Balance{
+				{Currency: currency.BTC, Total: e.inventory},
+				{Currency: currency.USD, Total: 1000000},
+			},
+		}},
+	}
+}
+
+func (e *marketMakingTestExchange) FetchAccountInfo(context.Context, asset.Item) (account.Holdings, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.holdings(), nil
+}
+
+func (e *marketMakingTestExchange) UpdateAccountInfo(context.Context, asset.Item) (account.Holdings, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.updates++
+	return e.holdings(), nil
+}
+
+func (e *marketMakingTestExchange) GetOrderExecutionLimits(asset.Item, currency.Pair) (order.MinMaxLevel, error) {
+	return order.MinMaxLevel{PriceStepIncrementSize: e.step}, nil
+}
+
+func (e *marketMakingTestExchange) CheckOrderExecutionLimits(asset.Item, currency.Pair, float64, float64, order.Type) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.limitErr
+}
+
+func (e *marketMakingTestExchange) setInventory(inventory float64) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.inventory = inventory
+}
+
+func (e *marketMakingTestExchange) getUpdates() int {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.updates
+}
+
+// loadMarketMakingOrderbook sets the mid price of the test pair
+func loadMarketMakingOrderbook(t *testing.T, exch string, bid, ask float64) {
+	t.Helper()
+	require.NoError(t, (&orderbook.Base{
+		Exchange: exch,
+		Pair:     btcusdPair,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: bid, Amount: 10}},
+		Asks:     orderbook.Items{{Price: ask, Amount: 10}},
+	}).Process(), "Process must not error")
+}
+
+func setupMarketMakingTest(t *testing.T, name string) (*MarketMakingManager, *fakeMarketMakingOrderManager, *marketMakingTestExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	wrapped := &marketMakingTestExchange{
+		IBotExchange: exch,
+		name:         name,
+		inventory:    1,
+		step:         0.01,
+	}
+	require.NoError(t, em.Add(wrapped), "Add must not error")
+	loadMarketMakingOrderbook(t, name, 99, 101)
+	om := &fakeMarketMakingOrderManager{fakeAlgoOrderManager: &fakeAlgoOrderManager{orders: make(map[string]*order.Detail)}}
+	m, err := SetupMarketMakingManager(em, om, &config.MarketMakingManager{RefreshInterval: time.Hour})
+	require.NoError(t, err, "SetupMarketMakingManager must not error")
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() {
+		if m.IsRunning() {
+			assert.NoError(t, m.Stop(), "Stop should not error")
+		}
+	})
+	return m, om, wrapped
+}
+
+func marketMakingTestStrategy(exch string) *MarketMakingStrategy {
+	return &MarketMakingStrategy{
+		Exchange:                 exch,
+		Asset:                    asset.Spot,
+		Pair:                     btcusdPair,
+		SpreadBasisPoints:        20,
+		Layers:                   2,
+		LayerSpacingBasisPoints:  10,
+		OrderAmount:              0.5,
+		TargetInventory:          1,
+		MaxInventory:             2,
+		InventorySkewBasisPoints: 50,
+	}
+}
+
+// quotePrices returns the strategy's quoted prices by side and layer
+func quotePrices(s *MarketMakingStrategy) map[quoteSlot]float64 {
+	resp := make(map[quoteSlot]float64, len(s.Quotes))
+	for i := range s.Quotes {
+		resp[quoteSlot{side: s.Quotes[i].Side, layer: s.Quotes[i].Layer}] = s.Quotes[i].Price
+	}
+	return resp
+}
+
+func TestSetupMarketMakingManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMarketMakingManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupMarketMakingManager should error on nil exchange manager")
+	_, err = SetupMarketMakingManager(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager, "SetupMarketMakingManager should error on nil order manager")
+	_, err = SetupMarketMakingManager(NewExchangeManager(), &OrderManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig, "SetupMarketMakingManager should error on nil config")
+	m, err := SetupMarketMakingManager(NewExchangeManager(), &OrderManager{}, &config.MarketMakingManager{})
+	require.NoError(t, err, "SetupMarketMakingManager must not error")
+	assert.Equal(t, config.DefaultMarketMakingRefreshInterval, m.refreshInterval, "refreshInterval should default")
+}
+
+func TestMarketMakingManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *MarketMakingManager
+	assert.False(t, m.IsRunning(), "IsRunning should return false on nil manager")
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem, "Start should error on nil manager")
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem, "Stop should error on nil manager")
+
+	m, err := SetupMarketMakingManager(NewExchangeManager(), &OrderManager{}, &config.MarketMakingManager{})
+	require.NoError(t, err, "SetupMarketMakingManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted, "Stop should error when not started")
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted, "Start should error when already started")
+	assert.NoError(t, m.Stop(), "Stop should not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false after Stop")
+}
+
+func TestMarketMakingManagerAdd(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupMarketMakingTest(t, "mmadd")
+
+	_, err := m.Add(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilMarketMakingStrategy, "Add should error on nil strategy")
+	for _, tc := range []struct {
+		name   string
+		modify func(*MarketMakingStrategy)
+		err    error
+	}{
+		{"exchange", func(s *MarketMakingStrategy) { s.Exchange = "meow" }, ErrExchangeNotFound},
+		{"pair", func(s *MarketMakingStrategy) { s.Pair = currency.EMPTYPAIR }, order.ErrPairIsEmpty},
+		{"asset", func(s *MarketMakingStrategy) { s.Asset = asset.Empty }, asset.ErrNotSupported},
+		{"layers", func(s *MarketMakingStrategy) { s.Layers = 0 }, errInvalidLayerCount},
+		{"amount", func(s *MarketMakingStrategy) { s.OrderAmount = 0 }, order.ErrAmountIsInvalid},
+		{"spread", func(s *MarketMakingStrategy) { s.SpreadBasisPoints = 0 }, errInvalidSpread},
+		{"spacing", func(s *MarketMakingStrategy) { s.LayerSpacingBasisPoints = -1 }, errInvalidLayerSpacing},
+		{"inventory", func(s *MarketMakingStrategy) { s.MaxInventory = -1 }, errInvalidInventoryLimits},
+		{"skew", func(s *MarketMakingStrategy) { s.InventorySkewBasisPoints = -1 }, errInvalidSkew},
+		{"threshold", func(s *MarketMakingStrategy) { s.RequoteThresholdBasisPoints = -1 }, errInvalidRequoteThreshold},
+	} {
+		s := marketMakingTestStrategy("mmadd")
+		tc.modify(s)
+		_, err = m.Add(context.Background(), s)
+		assert.ErrorIsf(t, err, tc.err, "Add should error on invalid %s", tc.name)
+	}
+
+	id, err := m.Add(context.Background(), marketMakingTestStrategy("mmadd"))
+	require.NoError(t, err, "Add must not error")
+	s, err := m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	assert.Equal(t, MarketMakingActive, s.Status, "Status should be active")
+	assert.Empty(t, s.Reason, "Reason should be empty")
+	assert.Equal(t, 100.0, s.Metrics.MidPrice, "MidPrice should be correct")
+	assert.Equal(t, 100.0, s.Metrics.FairValue, "FairValue should not be skewed at the target inventory")
+	assert.Equal(t, 1.0, s.Metrics.Inventory, "Inventory should be the base holdings")
+	require.Len(t, s.Quotes, 4, "Quotes must be placed for both sides of each layer")
+	prices := quotePrices(s)
+	assert.InDelta(t, 99.9, prices[quoteSlot{order.Buy, 0}], 1e-9, "First bid should be half the spread below fair value")
+	assert.InDelta(t, 100.1, prices[quoteSlot{order.Sell, 0}], 1e-9, "First ask should be half the spread above fair value")
+	assert.InDelta(t, 99.8, prices[quoteSlot{order.Buy, 1}], 1e-9, "Second bid should include the layer spacing")
+	assert.InDelta(t, 100.2, prices[quoteSlot{order.Sell, 1}], 1e-9, "Second ask should include the layer spacing")
+	submitted := om.getSubmitted()
+	require.Len(t, submitted, 4, "Submit must be called for each quote")
+	assert.Equal(t, order.Limit, submitted[0].Type, "Quotes should be limit orders")
+	assert.Equal(t, 0.5, submitted[0].Amount, "Quotes should be for the order amount")
+
+	_, err = m.GetStrategy("1337")
+	assert.ErrorIs(t, err, ErrMarketMakingStrategyNotFound, "GetStrategy should error on unknown id")
+	strategies, err := m.GetStrategies()
+	require.NoError(t, err, "GetStrategies must not error")
+	assert.Len(t, strategies, 1, "GetStrategies should return the strategy")
+
+	require.NoError(t, m.Stop(), "Stop must not error")
+	_, err = m.Add(context.Background(), marketMakingTestStrategy("mmadd"))
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "Add should error when not started")
+	assert.Len(t, om.getCancelled(), 4, "Stop should cancel resting quotes")
+}
+
+func TestMarketMakingManagerRequote(t *testing.T) {
+	t.Parallel()
+	m, om, exch := setupMarketMakingTest(t, "mmrequote")
+	s := marketMakingTestStrategy("mmrequote")
+	s.RequoteThresholdBasisPoints = 5
+	id, err := m.Add(context.Background(), s)
+	require.NoError(t, err, "Add must not error")
+
+	// A move smaller than the threshold leaves quotes resting
+	loadMarketMakingOrderbook(t, "mmrequote", 99.02, 101.02)
+	m.requoteAll(context.Background())
+	assert.Empty(t, om.getModified(), "Quotes within the requote threshold should not be modified")
+
+	loadMarketMakingOrderbook(t, "mmrequote", 109, 111)
+	m.requoteAll(context.Background())
+	s, err = m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	assert.Len(t, om.getModified(), 4, "Quotes should be modified when the fair value moves")
+	assert.Equal(t, int64(4), s.Metrics.Requotes, "Requotes should be counted")
+	assert.InDelta(t, 109.89, quotePrices(s)[quoteSlot{order.Buy, 0}], 1e-9, "Bid should follow the fair value")
+
+	// Exchanges which assign new IDs have their orders tracked for fills
+	om.m.Lock()
+	om.newIDs = true
+	om.m.Unlock()
+	loadMarketMakingOrderbook(t, "mmrequote", 99, 101)
+	m.requoteAll(context.Background())
+	s, err = m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	require.Len(t, s.Quotes, 4, "Quotes must be retained")
+	assert.Contains(t, s.Quotes[0].OrderID, "modified-", "OrderID should be updated")
+	m.m.Lock()
+	assert.Len(t, m.orders, 4, "Replaced order IDs should be tracked")
+	m.m.Unlock()
+
+	// Exchanges which cannot modify orders have them cancelled and replaced
+	om.m.Lock()
+	om.modifyErr = errFakeModify
+	om.m.Unlock()
+	loadMarketMakingOrderbook(t, "mmrequote", 109, 111)
+	m.requoteAll(context.Background())
+	assert.Len(t, om.getCancelled(), 4, "Quotes should be cancelled when they cannot be modified")
+	assert.Len(t, om.getSubmitted(), 8, "Quotes should be placed after being cancelled")
+
+	// Inventory at its limit stops quoting the side which would increase it
+	exch.setInventory(3)
+	m.m.Lock()
+	m.strategies[id].inventoryStale = true
+	m.m.Unlock()
+	m.requoteAll(context.Background())
+	s, err = m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	assert.Equal(t, 3.0, s.Metrics.Inventory, "Inventory should be refreshed")
+	assert.InDelta(t, 110*(1-0.005), s.Metrics.FairValue, 1e-9, "FairValue should be skewed by the full skew")
+	require.Len(t, s.Quotes, 2, "Only asks must be quoted at the inventory limit")
+	for i := range s.Quotes {
+		assert.Equal(t, order.Sell, s.Quotes[i].Side, "Only asks should be quoted at the inventory limit")
+	}
+
+	// Quotes which fail execution limits are cancelled
+	exch.m.Lock()
+	exch.limitErr = errFakeLimits
+	exch.m.Unlock()
+	m.requoteAll(context.Background())
+	s, err = m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	assert.Empty(t, s.Quotes, "Quotes failing execution limits should be cancelled")
+	assert.Contains(t, s.Reason, errFakeLimits.Error(), "Reason should contain the limits error")
+}
+
+func TestMarketMakingManagerProcessFill(t *testing.T) {
+	t.Parallel()
+	m, om, exch := setupMarketMakingTest(t, "mmfill")
+	id, err := m.Add(context.Background(), marketMakingTestStrategy("mmfill"))
+	require.NoError(t, err, "Add must not error")
+	s, err := m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	updates := exch.getUpdates()
+
+	assert.ErrorIs(t, m.ProcessFill(nil), common.ErrNilPointer, "ProcessFill should error on nil fill")
+	assert.NoError(t, m.ProcessFill(&fill.Data{Exchange: "mmfill", OrderID: "1337"}), "ProcessFill should ignore unknown orders")
+
+	var bid Quote
+	for i := range s.Quotes {
+		if s.Quotes[i].Side == order.Buy && s.Quotes[i].Layer == 0 {
+			bid = s.Quotes[i]
+		}
+	}
+	require.NotEmpty(t, bid.OrderID, "Bid must be quoted")
+	om.fill(bid.OrderID, 0.5, bid.Price, order.Filled)
+	exch.setInventory(1.5)
+	err = m.ProcessFill(&fill.Data{
+		Exchange: "MMFILL",
+		OrderID:  bid.OrderID,
+		Price:    bid.Price,
+		Amount:   0.5,
+	})
+	require.NoError(t, err, "ProcessFill must not error")
+
+	s, err = m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	assert.Equal(t, int64(1), s.Metrics.Fills, "Fills should be counted")
+	assert.Equal(t, 0.5, s.Metrics.Position, "Position should include the fill")
+	assert.Equal(t, 0.5, s.Metrics.BuyVolume, "BuyVolume should include the fill")
+	assert.InDelta(t, bid.Price, s.Metrics.AverageEntryPrice, 1e-9, "AverageEntryPrice should be the fill price")
+
+	// The fill triggers an immediate requote with refreshed holdings
+	assert.Eventually(t, func() bool {
+		return exch.getUpdates() > updates
+	}, time.Second*5, time.Millisecond*10, "Holdings should be refreshed after a fill")
+	assert.Eventually(t, func() bool {
+		s, err = m.GetStrategy(id)
+		return err == nil && s.Metrics.Inventory == 1.5 && len(s.Quotes) == 4
+	}, time.Second*5, time.Millisecond*10, "Filled quote should be replaced")
+	assert.InDelta(t, 100*(1-0.00125), s.Metrics.FairValue, 1e-9, "FairValue should be skewed by the additional inventory")
+}
+
+func TestMarketMakingManagerEventBusFills(t *testing.T) {
+	startEventBus(t)
+	m, _, _ := setupMarketMakingTest(t, "mmbusfill")
+	id, err := m.Add(context.Background(), marketMakingTestStrategy("mmbusfill"))
+	require.NoError(t, err, "Add must not error")
+	s, err := m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	require.NotEmpty(t, s.Quotes, "Quotes must be placed")
+
+	require.NoError(t, eventbus.PublishFills([]fill.Data{{
+		Exchange:     "mmbusfill",
+		AssetType:    asset.Spot,
+		CurrencyPair: s.Pair,
+		OrderID:      s.Quotes[0].OrderID,
+		Price:        s.Quotes[0].Price,
+		Amount:       0.1,
+	}}), "PublishFills must not error")
+	assert.Eventually(t, func() bool {
+		s, err = m.GetStrategy(id)
+		return err == nil && s.Metrics.Fills == 1
+	}, time.Second*5, time.Millisecond*10, "Fill should be consumed from the event bus")
+}
+
+func TestMarketMakingManagerRemove(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupMarketMakingTest(t, "mmremove")
+	id, err := m.Add(context.Background(), marketMakingTestStrategy("mmremove"))
+	require.NoError(t, err, "Add must not error")
+
+	assert.ErrorIs(t, m.Remove(context.Background(), "1337"), ErrMarketMakingStrategyNotFound, "Remove should error on unknown id")
+	require.NoError(t, m.Remove(context.Background(), id), "Remove must not error")
+	assert.Len(t, om.getCancelled(), 4, "Remove should cancel resting quotes")
+	assert.ErrorIs(t, m.Remove(context.Background(), id), errMarketMakingStrategyStopped, "Remove should error on a stopped strategy")
+	s, err := m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	assert.Equal(t, MarketMakingStopped, s.Status, "Status should be stopped")
+	assert.Empty(t, s.Quotes, "Quotes should be removed")
+
+	m.requoteAll(context.Background())
+	assert.Len(t, om.getSubmitted(), 4, "Stopped strategies should not be requoted")
+}
+
+func TestMarketMakingManagerSubmitUnlocked(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupMarketMakingTest(t, "mmunlocked")
+	var id string
+	var getErr, removeErr, fillErr error
+	var runCtx context.Context
+	var mtx sync.Mutex
+	om.onSubmit = func(ctx context.Context) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if id == "" {
+			return
+		}
+		// fails with a deadlock if the manager lock is held
+		_, getErr = m.GetStrategy(id)
+		removeErr = m.Remove(context.Background(), id)
+		fillErr = m.ProcessFill(&fill.Data{Exchange: "mmunlocked", OrderID: "2", Amount: 0.25, Price: 100.1})
+		runCtx = ctx
+	}
+	newID, err := m.Add(context.Background(), marketMakingTestStrategy("mmunlocked"))
+	require.NoError(t, err, "Add must not error")
+	mtx.Lock()
+	id = newID
+	mtx.Unlock()
+
+	// Cancel the first bid so the fill triggered requote places a new one
+	require.NoError(t, om.Cancel(context.Background(), &order.Cancel{OrderID: "1"}), "Cancel must not error")
+	require.NoError(t, m.ProcessFill(&fill.Data{Exchange: "mmunlocked", OrderID: "1", Amount: 0.25, Price: 99.9}), "ProcessFill must not error")
+	assert.Eventually(t, func() bool {
+		return len(om.getSubmitted()) > 4
+	}, time.Second*5, time.Millisecond*10, "Requote should place a new quote")
+
+	mtx.Lock()
+	assert.NoError(t, getErr, "GetStrategy should not error while quotes are placed")
+	assert.ErrorIs(t, removeErr, errMarketMakingStrategyBusy, "Remove should error while quotes are placed")
+	assert.NoError(t, fillErr, "ProcessFill should not error while quotes are placed")
+	ctx := runCtx
+	mtx.Unlock()
+	s, err := m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	assert.Equal(t, MarketMakingActive, s.Status, "Strategy should remain active")
+	assert.Equal(t, int64(2), s.Metrics.Fills, "Fills received while quotes are placed should be retained")
+
+	require.NotNil(t, ctx, "Requote must submit with the run context")
+	assert.NoError(t, ctx.Err(), "Run context should not be cancelled while running")
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.ErrorIs(t, ctx.Err(), context.Canceled, "Run context should be cancelled on Stop")
+}
+
+func TestLayeredQuotes(t *testing.T) {
+	t.Parallel()
+	s := marketMakingTestStrategy(testExchange)
+	fair, quotes := LayeredQuotes(s, 100, 1)
+	assert.Equal(t, 100.0, fair, "FairValue should be the mid price at the target inventory")
+	assert.Len(t, quotes, 4, "Both sides of each layer should be quoted")
+
+	fair, quotes = LayeredQuotes(s, 100, 2)
+	assert.InDelta(t, 99.75, fair, 1e-9, "Excess inventory should lower the fair value")
+	assert.Len(t, quotes, 4, "Both sides should be quoted within the inventory limit")
+
+	fair, quotes = LayeredQuotes(s, 100, -5)
+	assert.InDelta(t, 100.5, fair, 1e-9, "Skew should be limited to the inventory limit")
+	require.Len(t, quotes, 2, "Only one side should be quoted at the inventory limit")
+	assert.Equal(t, order.Buy, quotes[0].Side, "Bids should be quoted when short of inventory")
+
+	s.MaxInventory = 0
+	fair, quotes = LayeredQuotes(s, 100, 100)
+	assert.Equal(t, 100.0, fair, "FairValue should not be skewed without inventory limits")
+	assert.Len(t, quotes, 4, "Both sides should be quoted without inventory limits")
+}
+
+func TestConformQuotePrice(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 99.99, conformQuotePrice(order.Buy, 99.99, 0), "Price should be unchanged without a step")
+	assert.InDelta(t, 99.5, conformQuotePrice(order.Buy, 99.99, 0.5), 1e-9, "Bids should be rounded down")
+	assert.InDelta(t, 100.5, conformQuotePrice(order.Sell, 100.01, 0.5), 1e-9, "Asks should be rounded up")
+	assert.InDelta(t, 100, conformQuotePrice(order.Sell, 100, 0.5), 1e-9, "Prices on a step should be unchanged")
+}
+
+func TestQuoteNeedsRequote(t *testing.T) {
+	t.Parallel()
+	q := &Quote{Price: 100, Amount: 1}
+	assert.False(t, q.needsRequote(&Quote{Price: 100.05, Amount: 1}, 10), "Moves within the threshold should not requote")
+	assert.True(t, q.needsRequote(&Quote{Price: 100.2, Amount: 1}, 10), "Moves beyond the threshold should requote")
+	assert.True(t, q.needsRequote(&Quote{Price: 100, Amount: 2}, 10), "Amount changes should requote")
+}
+
+func TestMarketMakingMetricsApplyFill(t *testing.T) {
+	t.Parallel()
+	var mm MarketMakingMetrics
+	now := time.Now()
+	mm.applyFill(order.Buy, 100, 1, now)
+	mm.applyFill(order.Buy, 110, 1, now)
+	assert.Equal(t, 2.0, mm.Position, "Position should be increased by buys")
+	assert.Equal(t, 105.0, mm.AverageEntryPrice, "AverageEntryPrice should be the weighted average")
+
+	mm.MidPrice = 120
+	mm.applyFill(order.Sell, 120, 1.5, now)
+	assert.Equal(t, 0.5, mm.Position, "Position should be reduced by sells")
+	assert.Equal(t, 22.5, mm.RealisedPNL, "RealisedPNL should be realised on the reduced position")
+	assert.Equal(t, 105.0, mm.AverageEntryPrice, "AverageEntryPrice should be unchanged when reducing")
+	assert.Equal(t, 7.5, mm.UnrealisedPNL, "UnrealisedPNL should be marked at the mid price")
+
+	mm.applyFill(order.Sell, 100, 1, now)
+	assert.Equal(t, -0.5, mm.Position, "Position should reverse")
+	assert.Equal(t, 20.0, mm.RealisedPNL, "RealisedPNL should include the loss on the closed position")
+	assert.Equal(t, 100.0, mm.AverageEntryPrice, "AverageEntryPrice should be the reversal price")
+
+	mm.applyFill(order.Buy, 90, 0.5, now)
+	assert.Zero(t, mm.Position, "Position should be flat")
+	assert.Zero(t, mm.AverageEntryPrice, "AverageEntryPrice should reset when flat")
+	assert.Equal(t, 25.0, mm.RealisedPNL, "RealisedPNL should include the short profit")
+	assert.Equal(t, int64(5), mm.Fills, "Fills should be counted")
+	assert.Equal(t, 2.5, mm.BuyVolume, "BuyVolume should be tracked")
+	assert.Equal(t, 2.5, mm.SellVolume, "SellVolume should be tracked")
+}
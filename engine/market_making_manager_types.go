@@ -0,0 +1,158 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// MarketMakingManagerName is an exported subsystem name
+const MarketMakingManagerName = "market_making_manager"
+
+// marketMakingRefreshBuffer is the number of fill triggered requotes which
+// can be queued before further requests are dropped in favour of the next
+// refresh
+const marketMakingRefreshBuffer = 100
+
+var (
+	// ErrMarketMakingStrategyNotFound is returned when a market making
+	// strategy does not exist
+	ErrMarketMakingStrategyNotFound = errors.New("market making strategy not found")
+
+	errNilMarketMakingStrategy     = errors.New("nil market making strategy received")
+	errInvalidLayerCount           = errors.New("number of quote layers must be greater than zero")
+	errInvalidSpread               = errors.New("spread must be greater than zero")
+	errInvalidLayerSpacing         = errors.New("layer spacing cannot be negative")
+	errInvalidInventoryLimits      = errors.New("maximum inventory cannot be negative")
+	errInvalidSkew                 = errors.New("inventory skew cannot be negative")
+	errInvalidRequoteThreshold     = errors.New("requote threshold cannot be negative")
+	errMarketMakingStrategyStopped = errors.New("market making strategy is not active")
+	errMarketMakingStrategyBusy    = errors.New("market making strategy quotes are being placed or cancelled, try again")
+)
+
+// MarketMakingStatus defines the lifecycle state of a market making strategy
+type MarketMakingStatus string
+
+// Market making strategy statuses
+const (
+	MarketMakingActive  MarketMakingStatus = "ACTIVE"
+	MarketMakingStopped MarketMakingStatus = "STOPPED"
+)
+
+// QuoteModel returns the fair value a strategy quotes around and the quotes
+// it should maintain given the current mid price and inventory of the base
+// currency. Quotes are matched to resting orders by side and layer
+type QuoteModel func(s *MarketMakingStrategy, midPrice, inventory float64) (fairValue float64, quotes []Quote)
+
+// MarketMakingManager continuously quotes two-sided markets, maintaining
+// layers of limit orders around a fair value which is skewed by inventory.
+// Quotes are replaced when the fair value moves and immediately after a fill
+type MarketMakingManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iMarketMakingOrderManager
+	strategies      map[string]*MarketMakingStrategy
+	// orders maps the resting orders of each strategy so that fills can be
+	// attributed to it
+	orders          map[marketMakingOrderKey]string
+	refresh         chan string
+	fills           *eventbus.Subscription
+	refreshInterval time.Duration
+	verbose         bool
+	m               sync.Mutex
+}
+
+// MarketMakingStrategy defines how a pair is quoted along with the quotes it
+// has resting on the exchange and its performance
+type MarketMakingStrategy struct {
+	ID       string
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	// SpreadBasisPoints is the distance between the bid and ask of the first
+	// layer
+	SpreadBasisPoints float64
+	// Layers is the number of quotes maintained on each side
+	Layers int64
+	// LayerSpacingBasisPoints is the additional distance from the fair value
+	// of each layer after the first
+	LayerSpacingBasisPoints float64
+	// OrderAmount is the base amount quoted by each layer
+	OrderAmount float64
+	// TargetInventory is the base holding the strategy skews its quotes
+	// towards
+	TargetInventory float64
+	// MaxInventory is the distance from the target inventory at which quotes
+	// are fully skewed and the side which would increase it is no longer
+	// quoted. Zero disables inventory limits
+	MaxInventory float64
+	// InventorySkewBasisPoints is the distance the fair value is moved from
+	// the mid price when inventory is at its limit
+	InventorySkewBasisPoints float64
+	// RequoteThresholdBasisPoints is the distance a quote's price must move
+	// before its order is modified
+	RequoteThresholdBasisPoints float64
+	PostOnly                    bool
+	// Model calculates the quotes to maintain, defaults to LayeredQuotes
+	Model       QuoteModel
+	Status      MarketMakingStatus
+	Reason      string
+	Quotes      []Quote
+	Metrics     MarketMakingMetrics
+	CreatedDate time.Time
+	UpdatedDate time.Time
+	// inventoryStale forces holdings to be refreshed from the exchange after
+	// a fill
+	inventoryStale bool
+	// pending is set while quotes are placed or cancelled without holding the
+	// manager lock
+	pending bool
+}
+
+// Quote is a limit order maintained by a market making strategy
+type Quote struct {
+	OrderID      string
+	Side         order.Side
+	Layer        int64
+	Price        float64
+	Amount       float64
+	FilledAmount float64
+}
+
+// MarketMakingMetrics tracks the inventory and profit and loss of a strategy
+// from its fills
+type MarketMakingMetrics struct {
+	MidPrice  float64
+	FairValue float64
+	// Inventory is the base currency held on the exchange
+	Inventory float64
+	// Position is the net base amount bought by the strategy's fills
+	Position          float64
+	AverageEntryPrice float64
+	RealisedPNL       float64
+	UnrealisedPNL     float64
+	BuyVolume         float64
+	SellVolume        float64
+	Fills             int64
+	Requotes          int64
+	LastFill          time.Time
+}
+
+// marketMakingOrderKey identifies a resting order on an exchange
+type marketMakingOrderKey struct {
+	Exchange string
+	OrderID  string
+}
+
+// quoteSlot identifies a quote by its side and layer
+type quoteSlot struct {
+	side  order.Side
+	layer int64
+}
@@ -0,0 +1,418 @@
+package engine
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var metricLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// SetupMetricsManager applies configuration parameters before running
+func SetupMetricsManager(exchangeManager iExchangeManager, cfg *config.MetricsManager) (*MetricsManager, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", MetricsManagerName, errNilConfig)
+	}
+	if cfg.ListenAddress == "" {
+		return nil, fmt.Errorf("%s %w", MetricsManagerName, errMetricsListenAddressUnset)
+	}
+	path := cfg.Path
+	if path == "" {
+		path = config.DefaultMetricsPath
+	}
+	return &MetricsManager{
+		listenAddress:   cfg.ListenAddress,
+		path:            path,
+		exchangeManager: exchangeManager,
+		requests:        make(map[requestMetricKey]*latencySummary),
+		rateLimitWaits:  make(map[string]*latencySummary),
+		websocket:       make(map[string]*latencySummary),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MetricsManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem and begins serving metrics
+func (m *MetricsManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.APIServerMgr, "Metrics manager %s", MsgSubSystemStarting)
+	listener, err := net.Listen("tcp", m.listenAddress)
+	if err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(m.path, m.handleScrape)
+	m.listener = listener
+	m.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: metricsReadHeaderTimeout,
+	}
+	m.wg.Add(1)
+	go func(srv *http.Server, l net.Listener) {
+		defer m.wg.Done()
+		if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.APIServerMgr, "Metrics manager server error: %v", err)
+		}
+	}(m.server, listener)
+	log.Debugf(log.APIServerMgr, "Metrics manager serving metrics at http://%s%s", listener.Addr(), m.path)
+	log.Debugf(log.APIServerMgr, "Metrics manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *MetricsManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.APIServerMgr, "Metrics manager %s", MsgSubSystemShuttingDown)
+	ctx, cancel := context.WithTimeout(context.Background(), metricsShutdownTimeout)
+	defer cancel()
+	err := m.server.Shutdown(ctx)
+	m.wg.Wait()
+	m.server = nil
+	m.listener = nil
+	atomic.StoreInt32(&m.started, 0)
+	if err != nil {
+		return err
+	}
+	log.Debugf(log.APIServerMgr, "Metrics manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// Address returns the address the metrics listener is bound to
+func (m *MetricsManager) Address() string {
+	if !m.IsRunning() || m.listener == nil {
+		return ""
+	}
+	return m.listener.Addr().String()
+}
+
+// SetOrderManager sets the order manager order counts are collected from
+func (m *MetricsManager) SetOrderManager(orderManager iMetricsOrderManager) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if orderManager == nil {
+		return errNilOrderManager
+	}
+	m.sourceMtx.Lock()
+	m.orderManager = orderManager
+	m.sourceMtx.Unlock()
+	return nil
+}
+
+// SetSyncManager sets the sync manager orderbook staleness is collected from
+func (m *MetricsManager) SetSyncManager(syncManager iMetricsSyncManager) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if syncManager == nil {
+		return fmt.Errorf("sync manager %w", ErrNilSubsystem)
+	}
+	m.sourceMtx.Lock()
+	m.syncManager = syncManager
+	m.sourceMtx.Unlock()
+	return nil
+}
+
+// HTTPReporter returns a request.Reporter which records exchange HTTP request
+// latencies and rate limiter waits. Requesters capture the global reporter
+// when they are created, so it must be set up before exchanges are loaded
+func (m *MetricsManager) HTTPReporter() request.Reporter {
+	return &httpMetricsReporter{m: m}
+}
+
+// WebsocketReporter returns a stream.Reporter which records exchange
+// websocket request latencies
+func (m *MetricsManager) WebsocketReporter() stream.Reporter {
+	return &websocketMetricsReporter{m: m}
+}
+
+// Latency records the latency of an exchange HTTP request
+func (r *httpMetricsReporter) Latency(name, method, _ string, t time.Duration) {
+	r.m.m.Lock()
+	observe(r.m.requests, requestMetricKey{exchange: name, method: method}, t)
+	r.m.m.Unlock()
+}
+
+// RateLimitWait records the time an exchange HTTP request waited on its rate
+// limiter
+func (r *httpMetricsReporter) RateLimitWait(name string, t time.Duration) {
+	r.m.m.Lock()
+	observe(r.m.rateLimitWaits, name, t)
+	r.m.m.Unlock()
+}
+
+// Latency records the latency of an exchange websocket request
+func (r *websocketMetricsReporter) Latency(name string, _ []byte, t time.Duration) {
+	r.m.m.Lock()
+	observe(r.m.websocket, name, t)
+	r.m.m.Unlock()
+}
+
+func observe[K comparable](summaries map[K]*latencySummary, key K, t time.Duration) {
+	s, ok := summaries[key]
+	if !ok {
+		s = &latencySummary{}
+		summaries[key] = s
+	}
+	s.count++
+	s.sum += t
+}
+
+// handleScrape writes all metrics in the Prometheus text exposition format
+func (m *MetricsManager) handleScrape(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := writeMetricFamilies(w, m.collect()); err != nil {
+		log.Errorf(log.APIServerMgr, "Metrics manager unable to write metrics: %v", err)
+	}
+}
+
+// collect gathers every metric family
+func (m *MetricsManager) collect() []metricFamily {
+	families := m.collectReported()
+	families = append(families, collectDispatch()...)
+	families = append(families, m.collectWebsocketConnections())
+	m.sourceMtx.RLock()
+	orderManager, syncManager := m.orderManager, m.syncManager
+	m.sourceMtx.RUnlock()
+	if syncManager != nil && syncManager.IsRunning() {
+		families = append(families, collectOrderbookSync(syncManager)...)
+	}
+	if orderManager != nil && orderManager.IsRunning() {
+		families = append(families, collectOrders(orderManager))
+	}
+	return families
+}
+
+// collectReported converts the latencies pushed by exchange reporters into
+// metric families
+func (m *MetricsManager) collectReported() []metricFamily {
+	requests := metricFamily{
+		name: "gct_http_requests_total",
+		help: "Total number of exchange HTTP requests",
+		kind: "counter",
+	}
+	requestLatency := metricFamily{
+		name: "gct_http_request_latency_seconds",
+		help: "Latency of exchange HTTP requests",
+		kind: "summary",
+	}
+	rateLimitWaits := metricFamily{
+		name: "gct_rate_limiter_wait_seconds",
+		help: "Time exchange HTTP requests spent waiting on rate limiters",
+		kind: "summary",
+	}
+	websocketLatency := metricFamily{
+		name: "gct_websocket_request_latency_seconds",
+		help: "Latency of exchange websocket requests",
+		kind: "summary",
+	}
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	for k, s := range m.requests {
+		labels := []metricLabel{{"exchange", k.exchange}, {"method", k.method}}
+		requests.samples = append(requests.samples, metricSample{labels: labels, value: float64(s.count)})
+		requestLatency.samples = append(requestLatency.samples, summarySamples(labels, s)...)
+	}
+	for exch, s := range m.rateLimitWaits {
+		rateLimitWaits.samples = append(rateLimitWaits.samples, summarySamples([]metricLabel{{"exchange", exch}}, s)...)
+	}
+	for exch, s := range m.websocket {
+		websocketLatency.samples = append(websocketLatency.samples, summarySamples([]metricLabel{{"exchange", exch}}, s)...)
+	}
+	return []metricFamily{requests, requestLatency, rateLimitWaits, websocketLatency}
+}
+
+func summarySamples(labels []metricLabel, s *latencySummary) []metricSample {
+	return []metricSample{
+		{suffix: "_sum", labels: labels, value: s.sum.Seconds()},
+		{suffix: "_count", labels: labels, value: float64(s.count)},
+	}
+}
+
+// collectDispatch reports the depth of the dispatch job queue
+func collectDispatch() []metricFamily {
+	depth, capacity := dispatch.QueueDepth()
+	return []metricFamily{
+		{
+			name:    "gct_dispatch_queue_depth",
+			help:    "Number of jobs waiting in the dispatch queue",
+			kind:    "gauge",
+			samples: []metricSample{{value: float64(depth)}},
+		},
+		{
+			name:    "gct_dispatch_queue_capacity",
+			help:    "Maximum number of jobs the dispatch queue can hold",
+			kind:    "gauge",
+			samples: []metricSample{{value: float64(capacity)}},
+		},
+	}
+}
+
+// collectWebsocketConnections reports the connection state of every enabled
+// exchange websocket
+func (m *MetricsManager) collectWebsocketConnections() metricFamily {
+	family := metricFamily{
+		name: "gct_exchange_websocket_connected",
+		help: "Whether an exchange websocket is connected",
+		kind: "gauge",
+	}
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return family
+	}
+	for _, exch := range exchanges {
+		if !exch.IsWebsocketEnabled() {
+			continue
+		}
+		ws, err := exch.GetWebsocket()
+		if err != nil {
+			continue
+		}
+		var connected float64
+		if ws.IsConnected() {
+			connected = 1
+		}
+		family.samples = append(family.samples, metricSample{
+			labels: []metricLabel{{"exchange", exch.GetName()}},
+			value:  connected,
+		})
+	}
+	return family
+}
+
+// collectOrderbookSync reports how long it has been since each synced
+// orderbook was updated
+func collectOrderbookSync(syncManager iMetricsSyncManager) []metricFamily {
+	staleness := metricFamily{
+		name: "gct_orderbook_staleness_seconds",
+		help: "Seconds since a synced orderbook was last updated",
+		kind: "gauge",
+	}
+	syncErrors := metricFamily{
+		name: "gct_orderbook_sync_errors_total",
+		help: "Total number of orderbook sync errors",
+		kind: "counter",
+	}
+	statuses, err := syncManager.GetSyncItemStatuses(SyncItemOrderbook)
+	if err != nil {
+		return nil
+	}
+	now := time.Now()
+	for i := range statuses {
+		labels := []metricLabel{
+			{"exchange", statuses[i].Exchange},
+			{"asset", statuses[i].Asset.String()},
+			{"pair", statuses[i].Pair.String()},
+		}
+		syncErrors.samples = append(syncErrors.samples, metricSample{labels: labels, value: float64(statuses[i].NumErrors)})
+		if !statuses[i].HaveData {
+			continue
+		}
+		staleness.samples = append(staleness.samples, metricSample{labels: labels, value: now.Sub(statuses[i].LastUpdated).Seconds()})
+	}
+	return []metricFamily{staleness, syncErrors}
+}
+
+// collectOrders reports the number of orders held by the order manager
+func collectOrders(orderManager iMetricsOrderManager) metricFamily {
+	type orderKey struct {
+		exchange, asset, pair, status string
+	}
+	counts := make(map[orderKey]int)
+	orders := orderManager.GetOrdersSnapshot(order.AnyStatus)
+	for i := range orders {
+		counts[orderKey{
+			exchange: orders[i].Exchange,
+			asset:    orders[i].AssetType.String(),
+			pair:     orders[i].Pair.String(),
+			status:   orders[i].Status.String(),
+		}]++
+	}
+	family := metricFamily{
+		name: "gct_orders",
+		help: "Number of orders tracked by the order manager",
+		kind: "gauge",
+	}
+	for k, count := range counts {
+		family.samples = append(family.samples, metricSample{
+			labels: []metricLabel{{"exchange", k.exchange}, {"asset", k.asset}, {"pair", k.pair}, {"status", k.status}},
+			value:  float64(count),
+		})
+	}
+	return family
+}
+
+// writeMetricFamilies writes metric families in the Prometheus text
+// exposition format. Samples are sorted so output is deterministic
+func writeMetricFamilies(w io.Writer, families []metricFamily) error {
+	buf := bufio.NewWriter(w)
+	for i := range families {
+		samples := make([]string, len(families[i].samples))
+		for j := range families[i].samples {
+			samples[j] = formatMetricSample(families[i].name, &families[i].samples[j])
+		}
+		sort.Strings(samples)
+		fmt.Fprintf(buf, "# HELP %s %s\n# TYPE %s %s\n", families[i].name, families[i].help, families[i].name, families[i].kind)
+		for j := range samples {
+			buf.WriteString(samples[j])
+			buf.WriteByte('\n')
+		}
+	}
+	return buf.Flush()
+}
+
+func formatMetricSample(name string, s *metricSample) string {
+	var sb strings.Builder
+	sb.WriteString(name)
+	sb.WriteString(s.suffix)
+	if len(s.labels) > 0 {
+		sb.WriteByte('{')
+		for i := range s.labels {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(s.labels[i].name)
+			sb.WriteString(`="`)
+			sb.WriteString(metricLabelEscaper.Replace(s.labels[i].value))
+			sb.WriteByte('"')
+		}
+		sb.WriteByte('}')
+	}
+	sb.WriteByte(' ')
+	sb.WriteString(strconv.FormatFloat(s.value, 'g', -1, 64))
+	return sb.String()
+}
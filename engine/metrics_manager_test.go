@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+type fakeMetricsSyncManager struct {
+	statuses []SyncItemStatus
+}
+
+func (f *fakeMetricsSyncManager) IsRunning() bool { return true }
+
+func (f *fakeMetricsSyncManager) GetSyncItemStatuses(syncItemType) ([]SyncItemStatus, error) {
+	return f.statuses, nil
+}
+
+func setupMetricsTest(t *testing.T) *MetricsManager {
+	t.Helper()
+	m, err := SetupMetricsManager(NewExchangeManager(), &config.MetricsManager{ListenAddress: "127.0.0.1:0", Path: config.DefaultMetricsPath})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() {
+		if m.IsRunning() {
+			assert.NoError(t, m.Stop(), "Stop should not error")
+		}
+	})
+	return m
+}
+
+func scrapeMetrics(t *testing.T, m *MetricsManager) string {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://"+m.Address()+config.DefaultMetricsPath, http.NoBody)
+	require.NoError(t, err, "NewRequestWithContext must not error")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "scrape must not error")
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode, "scrape must return status OK")
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/plain", "Content-Type should be Prometheus text format")
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err, "ReadAll must not error")
+	return string(body)
+}
+
+func TestSetupMetricsManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMetricsManager(nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+
+	_, err = SetupMetricsManager(NewExchangeManager(), nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = SetupMetricsManager(NewExchangeManager(), &config.MetricsManager{})
+	assert.ErrorIs(t, err, errMetricsListenAddressUnset)
+
+	m, err := SetupMetricsManager(NewExchangeManager(), &config.MetricsManager{ListenAddress: "127.0.0.1:0"})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.Equal(t, config.DefaultMetricsPath, m.path, "path should default")
+}
+
+func TestMetricsManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *MetricsManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false on a nil manager")
+
+	m = setupMetricsTest(t)
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.NotEmpty(t, m.Address(), "Address should return the bound listener")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+	assert.Empty(t, m.Address(), "Address should be empty when stopped")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+
+	require.NoError(t, m.Start(), "Start must not error after Stop")
+}
+
+func TestMetricsManagerSetters(t *testing.T) {
+	t.Parallel()
+	var m *MetricsManager
+	assert.ErrorIs(t, m.SetOrderManager(nil), ErrNilSubsystem)
+	assert.ErrorIs(t, m.SetSyncManager(nil), ErrNilSubsystem)
+
+	m, err := SetupMetricsManager(NewExchangeManager(), &config.MetricsManager{ListenAddress: "127.0.0.1:0"})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.ErrorIs(t, m.SetOrderManager(nil), errNilOrderManager)
+	assert.ErrorIs(t, m.SetSyncManager(nil), ErrNilSubsystem)
+	assert.NoError(t, m.SetOrderManager(&OrderManager{}), "SetOrderManager should not error")
+	assert.NoError(t, m.SetSyncManager(&fakeMetricsSyncManager{}), "SetSyncManager should not error")
+}
+
+func TestMetricsManagerScrape(t *testing.T) {
+	t.Parallel()
+	m := setupMetricsTest(t)
+
+	httpReporter := m.HTTPReporter()
+	httpReporter.Latency(testExchange, http.MethodGet, "/api/v3/ticker", time.Millisecond*250)
+	httpReporter.Latency(testExchange, http.MethodGet, "/api/v3/depth", time.Millisecond*750)
+	rl, ok := httpReporter.(request.RateLimitReporter)
+	require.True(t, ok, "HTTPReporter must implement request.RateLimitReporter")
+	rl.RateLimitWait(testExchange, time.Millisecond*500)
+	m.WebsocketReporter().Latency(`quote"d`, nil, time.Second)
+
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	require.NoError(t, m.SetSyncManager(&fakeMetricsSyncManager{statuses: []SyncItemStatus{
+		{Exchange: testExchange, Asset: asset.Spot, Pair: pair, LastUpdated: time.Now().Add(-time.Minute), HaveData: true, NumErrors: 2},
+		{Exchange: testExchange, Asset: asset.Margin, Pair: pair},
+	}}), "SetSyncManager must not error")
+
+	om := &OrderManager{started: 1, orderStore: store{Orders: map[string][]*order.Detail{
+		testExchange: {
+			{Exchange: testExchange, AssetType: asset.Spot, Pair: pair, Status: order.Active, OrderID: "1"},
+			{Exchange: testExchange, AssetType: asset.Spot, Pair: pair, Status: order.Active, OrderID: "2"},
+			{Exchange: testExchange, AssetType: asset.Spot, Pair: pair, Status: order.Filled, OrderID: "3"},
+		},
+	}}}
+	require.NoError(t, m.SetOrderManager(om), "SetOrderManager must not error")
+
+	body := scrapeMetrics(t, m)
+	for _, line := range []string{
+		"# TYPE gct_http_requests_total counter",
+		`gct_http_requests_total{exchange="Bitstamp",method="GET"} 2`,
+		"# TYPE gct_http_request_latency_seconds summary",
+		`gct_http_request_latency_seconds_sum{exchange="Bitstamp",method="GET"} 1`,
+		`gct_http_request_latency_seconds_count{exchange="Bitstamp",method="GET"} 2`,
+		`gct_rate_limiter_wait_seconds_sum{exchange="Bitstamp"} 0.5`,
+		`gct_rate_limiter_wait_seconds_count{exchange="Bitstamp"} 1`,
+		`gct_websocket_request_latency_seconds_sum{exchange="quote\"d"} 1`,
+		"# TYPE gct_dispatch_queue_depth gauge",
+		"# TYPE gct_dispatch_queue_capacity gauge",
+		`gct_orderbook_sync_errors_total{exchange="Bitstamp",asset="spot",pair="BTCUSDT"} 2`,
+		`gct_orderbook_sync_errors_total{exchange="Bitstamp",asset="margin",pair="BTCUSDT"} 0`,
+		`gct_orders{exchange="Bitstamp",asset="spot",pair="BTCUSDT",status="ACTIVE"} 2`,
+		`gct_orders{exchange="Bitstamp",asset="spot",pair="BTCUSDT",status="FILLED"} 1`,
+	} {
+		assert.Contains(t, body, line+"\n", "scrape should contain metric")
+	}
+	assert.Contains(t, body, `gct_orderbook_staleness_seconds{exchange="Bitstamp",asset="spot",pair="BTCUSDT"} 6`, "staleness should be reported for synced orderbooks")
+	assert.NotContains(t, body, `gct_orderbook_staleness_seconds{exchange="Bitstamp",asset="margin"`, "staleness should not be reported for orderbooks without data")
+}
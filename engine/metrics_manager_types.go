@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// MetricsManagerName is an exported subsystem name
+const MetricsManagerName = "metrics_manager"
+
+var (
+	errMetricsListenAddressUnset = errors.New("metrics listen address unset")
+	metricsShutdownTimeout       = time.Second * 5
+	metricsReadHeaderTimeout     = time.Second * 10
+)
+
+// iMetricsOrderManager limits exposure of accessible functions to the order
+// manager for the metrics manager
+type iMetricsOrderManager interface {
+	IsRunning() bool
+	GetOrdersSnapshot(order.Status) []order.Detail
+}
+
+// iMetricsSyncManager limits exposure of accessible functions to the sync
+// manager for the metrics manager
+type iMetricsSyncManager interface {
+	IsRunning() bool
+	GetSyncItemStatuses(syncItemType) ([]SyncItemStatus, error)
+}
+
+// MetricsManager exposes engine and exchange health metrics in the Prometheus
+// text exposition format over its own HTTP listener. Request, rate limiter
+// and websocket latencies are pushed to it by the exchange reporters, while
+// dispatch, orderbook sync and order state is collected when scraped
+type MetricsManager struct {
+	started         int32
+	listenAddress   string
+	path            string
+	listener        net.Listener
+	server          *http.Server
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iMetricsOrderManager
+	syncManager     iMetricsSyncManager
+	sourceMtx       sync.RWMutex
+	requests        map[requestMetricKey]*latencySummary
+	rateLimitWaits  map[string]*latencySummary
+	websocket       map[string]*latencySummary
+	m               sync.Mutex
+}
+
+// requestMetricKey groups HTTP request metrics by exchange and method. The
+// request path is deliberately left out to keep label cardinality low
+type requestMetricKey struct {
+	exchange string
+	method   string
+}
+
+// latencySummary accumulates observed durations
+type latencySummary struct {
+	count uint64
+	sum   time.Duration
+}
+
+// metricFamily is a named group of samples sharing the same type
+type metricFamily struct {
+	name    string
+	help    string
+	kind    string
+	samples []metricSample
+}
+
+// metricSample is a single labelled value of a metric family
+type metricSample struct {
+	suffix string
+	labels []metricLabel
+	value  float64
+}
+
+// metricLabel is a name value pair attached to a sample
+type metricLabel struct {
+	name  string
+	value string
+}
+
+// httpMetricsReporter implements request.Reporter and
+// request.RateLimitReporter for the metrics manager
+type httpMetricsReporter struct {
+	m *MetricsManager
+}
+
+// websocketMetricsReporter implements stream.Reporter for the metrics manager
+type websocketMetricsReporter struct {
+	m *MetricsManager
+}
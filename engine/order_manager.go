@@ -13,15 +13,21 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderhistory"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/feeschedule"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -86,6 +92,9 @@ func (m *OrderManager) Start() error {
 		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
 	}
 	log.Debugln(log.OrderMgr, "Order manager starting...")
+	if err := m.loadActiveOrders(); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to restore active orders: %v", err)
+	}
 	m.shutdown = make(chan struct{})
 	m.orderStore.wg.Add(1)
 	go m.run()
@@ -123,6 +132,7 @@ func (m *OrderManager) gracefulShutdown() {
 // run will periodically process orders
 func (m *OrderManager) run() {
 	log.Debugln(log.OrderMgr, "Order manager started.")
+	m.reconcileExchanges(ReconcileOnStartup)
 	m.processOrders()
 	for {
 		select {
@@ -230,6 +240,7 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 		err = fmt.Errorf("%v - Failed to update existing order when cancelled: %w", cancel.Exchange, err)
 		return err
 	}
+	m.recordOrderHistory(od, &orderhistory.Event{Event: OrderEventCancelled})
 
 	msg := fmt.Sprintf("Exchange %s order ID=%v cancelled.",
 		od.Exchange, od.OrderID)
@@ -351,6 +362,903 @@ func (m *OrderManager) GetOrderInfo(ctx context.Context, exchangeName, orderID s
 	return upsertResponse.OrderDetails, nil
 }
 
+// SetRiskManager attaches a risk manager which all order submissions and
+// modifications must pass before reaching an exchange. A nil risk manager
+// removes any existing checks
+func (m *OrderManager) SetRiskManager(r *RiskManager) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	m.riskMtx.Lock()
+	defer m.riskMtx.Unlock()
+	if r == nil {
+		m.riskManager = nil
+		return nil
+	}
+	m.riskManager = r
+	return nil
+}
+
+// checkSubmitRisk runs the order submission through the risk manager if
+// one is attached
+func (m *OrderManager) checkSubmitRisk(ctx context.Context, s *order.Submit) error {
+	m.riskMtx.RLock()
+	r := m.riskManager
+	m.riskMtx.RUnlock()
+	if r == nil {
+		return nil
+	}
+	return r.CheckSubmit(ctx, s)
+}
+
+// checkModifyRisk runs the order modification through the risk manager if
+// one is attached
+func (m *OrderManager) checkModifyRisk(ctx context.Context, d *order.Detail, mod *order.Modify) error {
+	m.riskMtx.RLock()
+	r := m.riskManager
+	m.riskMtx.RUnlock()
+	if r == nil {
+		return nil
+	}
+	return r.CheckModify(ctx, d, mod)
+}
+
+// SetDatabaseManager attaches the database which order lifecycle events are
+// recorded to and active orders are restored from on start. It must be set
+// before the order manager is started, a nil database manager disables
+// recording
+func (m *OrderManager) SetDatabaseManager(dbm iDatabaseConnectionManager) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	m.db = nil
+	if dbm == nil {
+		return nil
+	}
+	db, err := orderhistory.Setup(dbm.GetInstance())
+	if err != nil {
+		return err
+	}
+	if db != nil {
+		m.db = db
+	}
+	return nil
+}
+
+// SearchOrderHistory returns orders recorded to the order history database
+// which match the filter
+func (m *OrderManager) SearchOrderHistory(f *orderhistory.Filter) ([]orderhistory.Order, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if m.db == nil {
+		return nil, errOrderHistoryDisabled
+	}
+	return m.db.Search(f)
+}
+
+// GetOrderAuditTrail returns an order recorded to the order history database
+// along with its lifecycle events by its internal order ID
+func (m *OrderManager) GetOrderAuditTrail(internalOrderID string) (*orderhistory.Order, []orderhistory.Event, error) {
+	if m == nil {
+		return nil, nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if m.db == nil {
+		return nil, nil, errOrderHistoryDisabled
+	}
+	o, err := m.db.GetByID(internalOrderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	events, err := m.db.GetEvents(internalOrderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return o, events, nil
+}
+
+// loadActiveOrders restores orders which were active when the order manager
+// last ran so their lifecycle continues to be tracked. Orders for exchanges
+// which are no longer loaded are skipped
+func (m *OrderManager) loadActiveOrders() error {
+	if m.db == nil {
+		return nil
+	}
+	statuses := make([]string, len(rehydratedOrderStatuses))
+	for i := range rehydratedOrderStatuses {
+		statuses[i] = rehydratedOrderStatuses[i].String()
+	}
+	orders, err := m.db.Search(&orderhistory.Filter{Statuses: statuses})
+	if err != nil {
+		return err
+	}
+	var restored int
+	for i := range orders {
+		var exch exchange.IBotExchange
+		exch, err = m.orderStore.exchangeManager.GetExchangeByName(orders[i].Exchange)
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Order manager unable to restore %v order %v: %v", orders[i].Exchange, orders[i].OrderID, err)
+			continue
+		}
+		var det *order.Detail
+		det, err = orderFromHistory(&orders[i])
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Order manager unable to restore %v order %v: %v", orders[i].Exchange, orders[i].OrderID, err)
+			continue
+		}
+		det.Exchange = exch.GetName()
+		err = m.orderStore.add(det)
+		if err != nil {
+			if !errors.Is(err, ErrOrdersAlreadyExists) {
+				log.Warnf(log.OrderMgr, "Order manager unable to restore %v order %v: %v", orders[i].Exchange, orders[i].OrderID, err)
+			}
+			continue
+		}
+		restored++
+	}
+	log.Debugf(log.OrderMgr, "Order manager restored %v active orders", restored)
+	return nil
+}
+
+// recordOrderHistory stores an order along with its lifecycle events when a
+// database is attached. Failures are logged rather than returned as the order
+// has already been processed by the exchange
+func (m *OrderManager) recordOrderHistory(d *order.Detail, events ...*orderhistory.Event) {
+	if m.db == nil || d == nil || len(events) == 0 {
+		return
+	}
+	now := time.Now()
+	for i := range events {
+		events[i].Status = d.Status.String()
+		events[i].Price = d.Price
+		events[i].Amount = d.Amount
+		events[i].ExecutedAmount = d.ExecutedAmount
+		events[i].CreatedDate = now
+	}
+	err := m.db.Record(orderToHistory(d), events...)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to record %v order %v history: %v", d.Exchange, d.OrderID, err)
+	}
+}
+
+// orderChangeEvents returns the lifecycle events which occurred between the
+// previously stored state of an order and its update. A nil previous order
+// indicates the order was not tracked before
+func orderChangeEvents(previous, current *order.Detail) []*orderhistory.Event {
+	if previous == nil {
+		return []*orderhistory.Event{{Event: OrderEventAdded}}
+	}
+	var events []*orderhistory.Event
+	if current.ExecutedAmount != previous.ExecutedAmount || len(current.Trades) != len(previous.Trades) {
+		events = append(events, &orderhistory.Event{
+			Event:   OrderEventFill,
+			Message: fmt.Sprintf("executed amount %v -> %v", previous.ExecutedAmount, current.ExecutedAmount),
+		})
+	}
+	if current.Status != previous.Status {
+		events = append(events, &orderhistory.Event{
+			Event:   OrderEventStatusChange,
+			Message: fmt.Sprintf("%v -> %v", previous.Status, current.Status),
+		})
+	}
+	return events
+}
+
+// orderToHistory converts an order to its order history database DTO
+func orderToHistory(d *order.Detail) *orderhistory.Order {
+	o := &orderhistory.Order{
+		Exchange:             d.Exchange,
+		Asset:                d.AssetType.String(),
+		Base:                 d.Pair.Base.String(),
+		Quote:                d.Pair.Quote.String(),
+		OrderID:              d.OrderID,
+		ClientOrderID:        d.ClientOrderID,
+		Type:                 d.Type.String(),
+		Side:                 d.Side.String(),
+		Status:               d.Status.String(),
+		Price:                d.Price,
+		Amount:               d.Amount,
+		ExecutedAmount:       d.ExecutedAmount,
+		RemainingAmount:      d.RemainingAmount,
+		AverageExecutedPrice: d.AverageExecutedPrice,
+		Fee:                  d.Fee,
+		Cost:                 d.Cost,
+		CreatedDate:          d.Date,
+		UpdatedDate:          d.LastUpdated,
+		Trades:               make([]orderhistory.Trade, len(d.Trades)),
+	}
+	if !d.InternalOrderID.IsNil() {
+		o.ID = d.InternalOrderID.String()
+	}
+	if o.CreatedDate.IsZero() {
+		o.CreatedDate = time.Now()
+	}
+	if o.UpdatedDate.IsZero() {
+		o.UpdatedDate = o.CreatedDate
+	}
+	for i := range d.Trades {
+		o.Trades[i] = orderhistory.Trade{
+			TID:       d.Trades[i].TID,
+			Price:     d.Trades[i].Price,
+			Amount:    d.Trades[i].Amount,
+			Fee:       d.Trades[i].Fee,
+			Timestamp: d.Trades[i].Timestamp,
+		}
+		if d.Trades[i].Side != order.UnknownSide {
+			o.Trades[i].Side = d.Trades[i].Side.String()
+		}
+	}
+	return o
+}
+
+// orderFromHistory converts an order history database DTO to an order
+func orderFromHistory(o *orderhistory.Order) (*order.Detail, error) {
+	a, err := asset.New(o.Asset)
+	if err != nil {
+		return nil, err
+	}
+	oType, err := order.StringToOrderType(o.Type)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(o.Side)
+	if err != nil {
+		return nil, err
+	}
+	status, err := order.StringToOrderStatus(o.Status)
+	if err != nil {
+		return nil, err
+	}
+	internalID, err := uuid.FromString(o.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &order.Detail{
+		Exchange:             o.Exchange,
+		AssetType:            a,
+		Pair:                 currency.NewPair(currency.NewCode(o.Base), currency.NewCode(o.Quote)),
+		OrderID:              o.OrderID,
+		ClientOrderID:        o.ClientOrderID,
+		InternalOrderID:      internalID,
+		Type:                 oType,
+		Side:                 side,
+		Status:               status,
+		Price:                o.Price,
+		Amount:               o.Amount,
+		ExecutedAmount:       o.ExecutedAmount,
+		RemainingAmount:      o.RemainingAmount,
+		AverageExecutedPrice: o.AverageExecutedPrice,
+		Fee:                  o.Fee,
+		Cost:                 o.Cost,
+		Date:                 o.CreatedDate,
+		LastUpdated:          o.UpdatedDate,
+		Trades:               make([]order.TradeHistory, len(o.Trades)),
+	}
+	for i := range o.Trades {
+		resp.Trades[i] = order.TradeHistory{
+			Price:     o.Trades[i].Price,
+			Amount:    o.Trades[i].Amount,
+			Fee:       o.Trades[i].Fee,
+			Exchange:  o.Exchange,
+			TID:       o.Trades[i].TID,
+			Timestamp: o.Trades[i].Timestamp,
+		}
+		if o.Trades[i].Side == "" {
+			continue
+		}
+		resp.Trades[i].Side, err = order.StringToOrderSide(o.Trades[i].Side)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// ReconcileOrders diffs the orders held by the order manager against the
+// active orders and order history of an exchange, updating any orders which
+// changed and reporting discrepancies
+func (m *OrderManager) ReconcileOrders(ctx context.Context, exchangeName string) (*ReconciliationReport, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	if !exch.IsRESTAuthenticationSupported() {
+		return nil, fmt.Errorf("%s %w", exch.GetName(), exchange.ErrAuthenticationSupportNotEnabled)
+	}
+	return m.reconcile(ctx, exch, ReconcileOnRequest, time.Time{})
+}
+
+// GetReconciliationReports returns the most recent reconciliation report for
+// each exchange, an empty exchange name returns all exchanges
+func (m *OrderManager) GetReconciliationReports(exchangeName string) ([]ReconciliationReport, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	m.reconcileMtx.Lock()
+	defer m.reconcileMtx.Unlock()
+	resp := make([]ReconciliationReport, 0, len(m.reconciliations))
+	for k, r := range m.reconciliations {
+		if exchangeName != "" && !strings.EqualFold(k, exchangeName) {
+			continue
+		}
+		resp = append(resp, *r)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Exchange < resp[j].Exchange
+	})
+	return resp, nil
+}
+
+// websocketDataHandler reconciles orders with an exchange when its websocket
+// reconnects so changes missed while disconnected are picked up
+func (m *OrderManager) websocketDataHandler(_ string, data interface{}) error {
+	r, ok := data.(stream.Reconnected)
+	if !ok {
+		return nil
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return err
+	}
+	if !canReconcileOrders(exch) {
+		return nil
+	}
+	ctx, cancel := m.shutdownContext()
+	go func() {
+		defer cancel()
+		if _, rErr := m.reconcile(ctx, exch, ReconcileOnReconnect, r.DisconnectedAt); rErr != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to reconcile %s orders after reconnect: %v", r.Exchange, rErr)
+		}
+	}()
+	return nil
+}
+
+// shutdownContext returns a context which is cancelled when the order manager
+// shuts down so that background requests do not outlive it
+func (m *OrderManager) shutdownContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func(shutdown <-chan struct{}) {
+		select {
+		case <-shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}(m.shutdown)
+	return ctx, cancel
+}
+
+// reconcileExchanges reconciles orders with every exchange which can return
+// authenticated order data
+func (m *OrderManager) reconcileExchanges(trigger string) {
+	exchanges, err := m.orderStore.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager cannot get exchanges: %v", err)
+		return
+	}
+	ctx, cancel := m.shutdownContext()
+	defer cancel()
+	for x := range exchanges {
+		if !canReconcileOrders(exchanges[x]) {
+			continue
+		}
+		if _, err = m.reconcile(ctx, exchanges[x], trigger, time.Time{}); err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to reconcile %s orders: %v", exchanges[x].GetName(), err)
+		}
+	}
+}
+
+// canReconcileOrders returns whether an exchange is able to return
+// authenticated order data
+func canReconcileOrders(exch exchange.IBotExchange) bool {
+	return exch.IsRESTAuthenticationSupported() && exch.GetBase().AreCredentialsValid(context.TODO())
+}
+
+// reconcile diffs locally tracked orders against an exchange. Orders returned
+// as active are compared with the store, the order history of the gap window
+// starting at since is checked for orders which completed while disconnected
+// and any remaining active orders are queried individually. A zero since
+// starts the gap window at the oldest update of the locally active orders
+func (m *OrderManager) reconcile(ctx context.Context, exch exchange.IBotExchange, trigger string, since time.Time) (*ReconciliationReport, error) {
+	exchName := exch.GetName()
+	if !m.setReconciling(exchName, true) {
+		return nil, fmt.Errorf("%s %w", exchName, errReconciliationInProgress)
+	}
+	defer m.setReconciling(exchName, false)
+
+	now := time.Now()
+	local := m.orderStore.getActiveOrders(&order.Filter{Exchange: exchName})
+	if since.IsZero() {
+		since = reconciliationWindowStart(local, now)
+	}
+	report := &ReconciliationReport{
+		Exchange:      exchName,
+		Trigger:       trigger,
+		StartTime:     since,
+		EndTime:       now,
+		OrdersChecked: len(local),
+	}
+	seen := make(map[string]bool)
+	for _, a := range exch.GetAssetTypes(true) {
+		pairs, err := exch.GetEnabledPairs(a)
+		if err != nil {
+			report.Errors = append(report.Errors, err.Error())
+			continue
+		}
+		if len(pairs) == 0 {
+			continue
+		}
+		req := &order.MultiOrderRequest{
+			Pairs:     pairs,
+			AssetType: a,
+			Side:      order.AnySide,
+			Type:      order.AnyType,
+		}
+		active, err := exch.GetActiveOrders(ctx, req)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s active orders: %v", a, err))
+			continue
+		}
+		for i := range active {
+			seen[active[i].OrderID] = true
+			m.reconcileOrder(report, &active[i], DiscrepancyOrphanOrder)
+		}
+		historyReq := *req
+		historyReq.StartTime = since
+		historyReq.EndTime = now
+		history, err := exch.GetOrderHistory(ctx, &historyReq)
+		if err != nil {
+			if !errors.Is(err, common.ErrNotYetImplemented) && !errors.Is(err, common.ErrFunctionNotSupported) {
+				report.Errors = append(report.Errors, fmt.Sprintf("%s order history: %v", a, err))
+			}
+			continue
+		}
+		for i := range history {
+			if seen[history[i].OrderID] {
+				continue
+			}
+			seen[history[i].OrderID] = true
+			var unknown string
+			if history[i].ExecutedAmount > 0 {
+				unknown = DiscrepancyUnknownFill
+			}
+			m.reconcileOrder(report, &history[i], unknown)
+		}
+	}
+
+	canGetOrder := exch.GetSupportedFeatures().RESTCapabilities.GetOrder
+	for i := range local {
+		if seen[local[i].OrderID] {
+			continue
+		}
+		if canGetOrder {
+			d, err := exch.GetOrderInfo(ctx, local[i].OrderID, local[i].Pair, local[i].AssetType)
+			if err == nil && d != nil {
+				m.reconcileOrder(report, d, "")
+				continue
+			}
+		}
+		report.Discrepancies = append(report.Discrepancies, ReconciliationDiscrepancy{
+			Type:            DiscrepancyMissingOrder,
+			InternalOrderID: local[i].InternalOrderID.String(),
+			OrderID:         local[i].OrderID,
+			Asset:           local[i].AssetType,
+			Pair:            local[i].Pair,
+			Status:          local[i].Status,
+			ExecutedAmount:  local[i].ExecutedAmount,
+		})
+	}
+
+	for i := range report.Discrepancies {
+		d := &report.Discrepancies[i]
+		m.orderStore.commsManager.PushEvent(base.Event{
+			Type: "order",
+			Message: fmt.Sprintf("Exchange %s order reconciliation %s: order ID=%v internal ID=%v pair=%v asset=%v status=%v executed amount=%v",
+				exchName, d.Type, d.OrderID, d.InternalOrderID, d.Pair, d.Asset, d.Status, d.ExecutedAmount),
+		})
+	}
+	log.Infof(log.OrderMgr, "Order manager reconciled %s orders on %s between %s and %s: %d checked, %d changed, %d discrepancies, %d errors",
+		exchName, strings.ToLower(trigger), since.Format(time.DateTime), now.Format(time.DateTime),
+		report.OrdersChecked, len(report.Changes), len(report.Discrepancies), len(report.Errors))
+
+	m.reconcileMtx.Lock()
+	if m.reconciliations == nil {
+		m.reconciliations = make(map[string]*ReconciliationReport)
+	}
+	m.reconciliations[strings.ToLower(exchName)] = report
+	m.reconcileMtx.Unlock()
+	return report, nil
+}
+
+// reconcileOrder compares an order returned by an exchange with the store.
+// Changed orders are upserted, which records status change and fill events,
+// untracked orders are added and reported with the discrepancy type provided.
+// An empty discrepancy type ignores untracked orders
+func (m *OrderManager) reconcileOrder(report *ReconciliationReport, d *order.Detail, discrepancy string) {
+	if d.Exchange == "" {
+		d.Exchange = report.Exchange
+	}
+	previous, err := m.orderStore.getByExchangeAndID(d.Exchange, d.OrderID)
+	if err != nil {
+		if discrepancy == "" {
+			return
+		}
+		var resp *OrderUpsertResponse
+		resp, err = m.UpsertOrder(d)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("order %s: %v", d.OrderID, err))
+			return
+		}
+		m.recordOrderHistory(&resp.OrderDetails, &orderhistory.Event{
+			Event:   OrderEventReconciled,
+			Message: fmt.Sprintf("%s reconciliation on %s", discrepancy, strings.ToLower(report.Trigger)),
+		})
+		report.Discrepancies = append(report.Discrepancies, ReconciliationDiscrepancy{
+			Type:            discrepancy,
+			InternalOrderID: resp.OrderDetails.InternalOrderID.String(),
+			OrderID:         resp.OrderDetails.OrderID,
+			Asset:           resp.OrderDetails.AssetType,
+			Pair:            resp.OrderDetails.Pair,
+			Status:          resp.OrderDetails.Status,
+			ExecutedAmount:  resp.OrderDetails.ExecutedAmount,
+		})
+		return
+	}
+	// Exchanges do not always populate every field, only move orders forward
+	statusChanged := d.Status != order.UnknownStatus && d.Status != previous.Status
+	if !statusChanged && d.ExecutedAmount <= previous.ExecutedAmount {
+		return
+	}
+	resp, err := m.UpsertOrder(d)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("order %s: %v", d.OrderID, err))
+		return
+	}
+	m.recordOrderHistory(&resp.OrderDetails, &orderhistory.Event{
+		Event:   OrderEventReconciled,
+		Message: fmt.Sprintf("updated by reconciliation on %s", strings.ToLower(report.Trigger)),
+	})
+	report.Changes = append(report.Changes, ReconciliationChange{
+		InternalOrderID:        resp.OrderDetails.InternalOrderID.String(),
+		OrderID:                resp.OrderDetails.OrderID,
+		Asset:                  resp.OrderDetails.AssetType,
+		Pair:                   resp.OrderDetails.Pair,
+		PreviousStatus:         previous.Status,
+		Status:                 resp.OrderDetails.Status,
+		PreviousExecutedAmount: previous.ExecutedAmount,
+		ExecutedAmount:         resp.OrderDetails.ExecutedAmount,
+	})
+}
+
+// setReconciling flags whether an exchange is being reconciled, returning
+// false if the flag is already in the requested state
+func (m *OrderManager) setReconciling(exchangeName string, reconciling bool) bool {
+	m.reconcileMtx.Lock()
+	defer m.reconcileMtx.Unlock()
+	if m.reconciling == nil {
+		m.reconciling = make(map[string]bool)
+	}
+	key := strings.ToLower(exchangeName)
+	if m.reconciling[key] == reconciling {
+		return false
+	}
+	m.reconciling[key] = reconciling
+	return true
+}
+
+// reconciliationWindowStart returns the oldest update time of the active
+// orders, falling back to the default reconciliation window
+func reconciliationWindowStart(active []order.Detail, now time.Time) time.Time {
+	start := now.Add(-defaultReconciliationWindow)
+	var oldest time.Time
+	for i := range active {
+		updated := active[i].LastUpdated
+		if updated.IsZero() {
+			updated = active[i].Date
+		}
+		if updated.IsZero() {
+			continue
+		}
+		if oldest.IsZero() || updated.Before(oldest) {
+			oldest = updated
+		}
+	}
+	if oldest.IsZero() {
+		return start
+	}
+	return oldest
+}
+
+// getFeeAccount returns the fee schedule and volume tracker of an exchange
+// account for the asset type, fetching the schedule on first use. Exchanges
+// and asset types without a fee schedule return an account without one. The
+// schedule is fetched once per exchange and asset type without holding the
+// lock, and failures are cached with a backoff before they are retried
+func (m *OrderManager) getFeeAccount(ctx context.Context, exch exchange.IBotExchange, a asset.Item) (*feeAccount, error) {
+	k := key.ExchangeAsset{Exchange: strings.ToLower(exch.GetName()), Asset: a}
+	m.feeMtx.Lock()
+	if acc, ok := m.feeAccounts[k]; ok {
+		m.feeMtx.Unlock()
+		return acc, nil
+	}
+	f, ok := m.feeFetches[k]
+	if ok {
+		select {
+		case <-f.done:
+			if time.Now().Before(f.retry) {
+				m.feeMtx.Unlock()
+				return nil, f.err
+			}
+			ok = false
+		default:
+		}
+	}
+	if ok {
+		m.feeMtx.Unlock()
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		m.feeMtx.Lock()
+		acc, ok := m.feeAccounts[k]
+		m.feeMtx.Unlock()
+		if ok {
+			return acc, nil
+		}
+		if f.err != nil {
+			return nil, f.err
+		}
+		// The request was abandoned by its caller before completing
+		return m.getFeeAccount(ctx, exch, a)
+	}
+	var failures int
+	if f != nil {
+		failures = f.failures
+	}
+	f = &feeFetch{done: make(chan struct{}), failures: failures}
+	if m.feeFetches == nil {
+		m.feeFetches = make(map[key.ExchangeAsset]*feeFetch)
+	}
+	m.feeFetches[k] = f
+	m.feeMtx.Unlock()
+
+	acc, err := fetchFeeAccount(ctx, exch, a)
+
+	m.feeMtx.Lock()
+	defer m.feeMtx.Unlock()
+	switch {
+	case err == nil:
+		if m.feeAccounts == nil {
+			m.feeAccounts = make(map[key.ExchangeAsset]*feeAccount)
+		}
+		m.feeAccounts[k] = acc
+		delete(m.feeFetches, k)
+	case ctx.Err() != nil:
+		// The caller gave up on the request, the next caller retries it
+		delete(m.feeFetches, k)
+	default:
+		delay := feeScheduleMaxRetryDelay
+		if d := feeScheduleRetryDelay << f.failures; d < delay {
+			delay = d
+			f.failures++
+		}
+		f.err = err
+		f.retry = time.Now().Add(delay)
+	}
+	close(f.done)
+	return acc, err
+}
+
+// fetchFeeAccount requests the fee schedule of an exchange account for the
+// asset type
+func fetchFeeAccount(ctx context.Context, exch exchange.IBotExchange, a asset.Item) (*feeAccount, error) {
+	s, err := exch.GetFeeSchedule(ctx, a)
+	if err != nil && !errors.Is(err, common.ErrFunctionNotSupported) && !errors.Is(err, common.ErrNotYetImplemented) && !errors.Is(err, asset.ErrNotSupported) {
+		return nil, err
+	}
+	acc := &feeAccount{}
+	if err == nil {
+		if err = s.Validate(); err != nil {
+			return nil, err
+		}
+		acc.schedule = s
+		acc.tracker = feeschedule.NewTracker(s.Window())
+	}
+	return acc, nil
+}
+
+// trackFeeVolume records the amount executed between the previous and current
+// state of an order towards the exchange account's fee tier. Volume which
+// cannot be measured in the schedule's volume currency is not tracked
+func (m *OrderManager) trackFeeVolume(previous, current *order.Detail) {
+	executed := executedAmount(current)
+	if previous != nil {
+		executed -= executedAmount(previous)
+	}
+	if executed <= 0 {
+		return
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(current.Exchange)
+	if err != nil {
+		return
+	}
+	acc, err := m.getFeeAccount(context.TODO(), exch, current.AssetType)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to get %v %v fee schedule: %v", current.Exchange, current.AssetType, err)
+		return
+	}
+	if acc.schedule == nil {
+		return
+	}
+	price := current.AverageExecutedPrice
+	if price == 0 {
+		price = current.Price
+	}
+	volume, ok := volumeInCurrency(current.Exchange, current.AssetType, current.Pair, price, executed, acc.schedule.VolumeCurrency)
+	if !ok {
+		if m.verbose {
+			log.Debugf(log.OrderMgr, "Order manager unable to measure %v order %v volume in %v", current.Exchange, current.OrderID, acc.schedule.VolumeCurrency)
+		}
+		return
+	}
+	at := current.LastUpdated
+	if at.IsZero() {
+		at = time.Now()
+	}
+	acc.tracker.Prune(time.Now())
+	if err = acc.tracker.Add(at, volume); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to track %v order %v volume: %v", current.Exchange, current.OrderID, err)
+	}
+}
+
+// executedAmount returns the amount of an order which has been executed
+func executedAmount(d *order.Detail) float64 {
+	if d.ExecutedAmount == 0 && d.Status == order.Filled {
+		return d.Amount
+	}
+	return d.ExecutedAmount
+}
+
+// volumeInCurrency converts an amount of a pair's base currency traded at the
+// price into the volume currency, using cached tickers when the volume
+// currency is neither the base nor the quote currency
+func volumeInCurrency(exchName string, a asset.Item, pair currency.Pair, price, amount float64, c currency.Code) (float64, bool) {
+	switch {
+	case pair.Quote.Equal(c):
+		return price * amount, price > 0
+	case pair.Base.Equal(c):
+		return amount, true
+	}
+	if price <= 0 {
+		return 0, false
+	}
+	t, err := ticker.GetTicker(exchName, currency.NewPair(pair.Quote, c), a)
+	if err != nil || t.Last <= 0 {
+		return 0, false
+	}
+	return price * amount * t.Last, true
+}
+
+// GetFeeVolume returns the trade volume tracked towards an exchange
+// account's fee tier for the asset type, measured in the schedule's volume
+// currency over its window
+func (m *OrderManager) GetFeeVolume(ctx context.Context, exchangeName string, a asset.Item) (float64, *feeschedule.Schedule, error) {
+	if m == nil {
+		return 0, nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(exchangeName)
+	if err != nil {
+		return 0, nil, err
+	}
+	acc, err := m.getFeeAccount(ctx, exch, a)
+	if err != nil {
+		return 0, nil, err
+	}
+	if acc.schedule == nil {
+		return 0, nil, fmt.Errorf("%s %w", exchangeName, common.ErrFunctionNotSupported)
+	}
+	return acc.tracker.Volume(time.Now()), acc.schedule, nil
+}
+
+// EstimateOrderCost returns the expected fee and total cost of an order. When
+// the exchange supports fee schedules the tier is predicted from the volume
+// tracked by the order manager, otherwise the exchange's trading fee is used.
+// Market orders are priced from the exchange's ticker
+func (m *OrderManager) EstimateOrderCost(ctx context.Context, s *order.Submit) (*OrderCostEstimate, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if s == nil {
+		return nil, errNilOrder
+	}
+	if s.Amount <= 0 {
+		return nil, errInvalidEstimateAmount
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(s.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	price := s.Price
+	if price <= 0 {
+		t, tErr := exch.FetchTicker(ctx, s.Pair, s.AssetType)
+		if tErr != nil || t.Last <= 0 {
+			return nil, fmt.Errorf("%w: %v", errNoEstimatePrice, tErr)
+		}
+		price = t.Last
+	}
+	resp := &OrderCostEstimate{
+		Exchange: exch.GetName(),
+		Asset:    s.AssetType,
+		Pair:     s.Pair,
+		Side:     s.Side,
+		IsMaker:  s.PostOnly,
+		Price:    price,
+		Amount:   s.Amount,
+		Notional: price * s.Amount,
+	}
+	acc, err := m.getFeeAccount(ctx, exch, s.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	if acc.schedule != nil {
+		resp.VolumeCurrency = acc.schedule.VolumeCurrency
+		resp.TrailingVolume = acc.tracker.Volume(time.Now())
+		tier, _, tierErr := acc.schedule.GetTier(resp.TrailingVolume)
+		if tierErr != nil {
+			return nil, tierErr
+		}
+		resp.Tier = &tier
+		next, remaining, ok, tierErr := acc.schedule.NextTier(resp.TrailingVolume)
+		if tierErr != nil {
+			return nil, tierErr
+		}
+		if ok {
+			resp.NextTier = &next
+			resp.VolumeToNextTier = remaining
+		}
+		if resp.FeeRate, err = acc.schedule.Rate(resp.TrailingVolume, resp.IsMaker, false); err != nil {
+			return nil, err
+		}
+		resp.Fee = resp.FeeRate * resp.Notional
+		resp.TokenDiscountFee = resp.Fee
+		if acc.schedule.TokenDiscount != nil {
+			var discounted float64
+			if discounted, err = acc.schedule.Rate(resp.TrailingVolume, resp.IsMaker, true); err != nil {
+				return nil, err
+			}
+			resp.TokenDiscountFee = discounted * resp.Notional
+			resp.TokenDiscountCurrency = acc.schedule.TokenDiscount.Currency
+		}
+	} else {
+		resp.Fee, err = exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+			FeeType:       exchange.CryptocurrencyTradeFee,
+			Pair:          s.Pair,
+			IsMaker:       resp.IsMaker,
+			PurchasePrice: price,
+			Amount:        s.Amount,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.FeeRate = resp.Fee / resp.Notional
+		resp.TokenDiscountFee = resp.Fee
+	}
+	resp.Total = resp.Notional + resp.Fee
+	if s.Side.IsShort() {
+		resp.Total = resp.Notional - resp.Fee
+	}
+	return resp, nil
+}
+
 // validate ensures a submitted order is valid before adding to the manager
 func (m *OrderManager) validate(newOrder *order.Submit) error {
 	if newOrder == nil {
@@ -418,6 +1326,11 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 		mod.Price = det.Price
 	}
 
+	err = m.checkModifyRisk(ctx, det, mod)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get exchange instance and submit order modification request.
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(mod.Exchange)
 	if err != nil {
@@ -442,6 +1355,14 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	// XXX: This comes with a race condition, because [request -> changes] are not
 	// atomic.
 	err = m.orderStore.modifyExisting(mod.OrderID, res)
+	if err == nil {
+		if det, errGet := m.orderStore.getByExchangeAndID(mod.Exchange, res.OrderID); errGet == nil {
+			m.recordOrderHistory(det, &orderhistory.Event{
+				Event:   OrderEventModified,
+				Message: fmt.Sprintf("price=%v amount=%v", res.Price, res.Amount),
+			})
+		}
+	}
 
 	// Notify observers.
 	var message string
@@ -498,6 +1419,11 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 			err)
 	}
 
+	err = m.checkSubmitRisk(ctx, newOrder)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := exch.SubmitOrder(ctx, newOrder)
 	if err != nil {
 		return nil, err
@@ -539,6 +1465,10 @@ func (m *OrderManager) SubmitFakeOrder(newOrder *order.Submit, resultingOrder *o
 				err)
 		}
 	}
+	err = m.checkSubmitRisk(context.TODO(), newOrder)
+	if err != nil {
+		return nil, err
+	}
 	return m.processSubmittedOrder(resultingOrder)
 }
 
@@ -608,7 +1538,10 @@ func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse)
 	} else if err != nil {
 		// Non-fatal error: Unable to store order, but error does not need to be returned to caller
 		log.Errorf(log.OrderMgr, "Unable to add %v order %v to orderStore: %s", detail.Exchange, detail.OrderID, err)
+	} else {
+		m.trackFeeVolume(nil, detail)
 	}
+	m.recordOrderHistory(detail, &orderhistory.Event{Event: OrderEventSubmitted})
 
 	msg := fmt.Sprintf("Exchange %s submitted order ID=%v [Ours: %v] pair=%v price=%v amount=%v quoteAmount=%v side=%v type=%v for time %v.",
 		detail.Exchange,
@@ -888,7 +1821,18 @@ func (m *OrderManager) UpdateExistingOrder(od *order.Detail) error {
 	if atomic.LoadInt32(&m.started) == 0 {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
-	return m.orderStore.updateExisting(od)
+	if m.db == nil {
+		return m.orderStore.updateExisting(od)
+	}
+	previous := m.orderStore.getByDetail(od)
+	err := m.orderStore.updateExisting(od)
+	if err != nil {
+		return err
+	}
+	if current := m.orderStore.getByDetail(od); previous != nil && current != nil {
+		m.recordOrderHistory(current, orderChangeEvents(previous, current)...)
+	}
+	return nil
 }
 
 // UpsertOrder updates an existing order or adds a new one to the orderstore
@@ -914,6 +1858,7 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 		})
 	}(&msg)
 
+	previous := m.orderStore.getByDetail(od)
 	upsertResponse, err := m.orderStore.upsert(od)
 	if err != nil {
 		msg = fmt.Sprintf(
@@ -922,6 +1867,9 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 		return nil, err
 	}
 
+	m.recordOrderHistory(&upsertResponse.OrderDetails, orderChangeEvents(previous, &upsertResponse.OrderDetails)...)
+	m.trackFeeVolume(previous, &upsertResponse.OrderDetails)
+
 	status := "updated"
 	if upsertResponse.IsNewOrder {
 		status = "added"
@@ -986,13 +1934,13 @@ func (s *store) updateExisting(od *order.Detail) error {
 		if err != nil {
 			return err
 		}
-		if !r[x].AssetType.IsFutures() {
-			return nil
-		}
-		err = s.futuresPositionController.TrackNewOrder(r[x])
-		if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
-			return err
+		if r[x].AssetType.IsFutures() {
+			err = s.futuresPositionController.TrackNewOrder(r[x])
+			if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
+				return err
+			}
 		}
+		s.publishOrder(r[x])
 		return nil
 	}
 	return ErrOrderNotFound
@@ -1012,13 +1960,13 @@ func (s *store) modifyExisting(id string, mod *order.ModifyResponse) error {
 			continue
 		}
 		r[x].UpdateOrderFromModifyResponse(mod)
-		if !r[x].AssetType.IsFutures() {
-			return nil
-		}
-		err := s.futuresPositionController.TrackNewOrder(r[x])
-		if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
-			return err
+		if r[x].AssetType.IsFutures() {
+			err := s.futuresPositionController.TrackNewOrder(r[x])
+			if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
+				return err
+			}
 		}
+		s.publishOrder(r[x])
 		return nil
 	}
 	return ErrOrderNotFound
@@ -1054,6 +2002,7 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 		if err != nil {
 			return nil, err
 		}
+		s.publishOrder(exchangeOrders[x])
 		return &OrderUpsertResponse{
 			OrderDetails: exchangeOrders[x].Copy(),
 			IsNewOrder:   false,
@@ -1062,6 +2011,7 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 	// Untracked websocket orders will not have internalIDs yet
 	od.GenerateInternalOrderID()
 	s.Orders[lName] = append(s.Orders[lName], od)
+	s.publishOrder(od)
 	return &OrderUpsertResponse{OrderDetails: od.Copy(), IsNewOrder: true}, nil
 }
 
@@ -1111,10 +2061,27 @@ func (s *store) add(det *order.Detail) error {
 	// Untracked websocket orders will not have internalIDs yet
 	det.GenerateInternalOrderID()
 	s.Orders[name] = append(s.Orders[name], det)
-	if !det.AssetType.IsFutures() {
-		return nil
+	if det.AssetType.IsFutures() {
+		if err := s.futuresPositionController.TrackNewOrder(det); err != nil {
+			return err
+		}
+	}
+	s.publishOrder(det)
+	return nil
+}
+
+// publishOrder publishes an order change on the event bus along with the
+// futures position it affects
+func (s *store) publishOrder(d *order.Detail) {
+	publishEvent(eventbus.PublishOrder(d))
+	if !d.AssetType.IsFutures() || !eventbus.HasSubscribers(eventbus.PositionTopic) {
+		return
+	}
+	positions, err := s.futuresPositionController.GetPositionsForExchange(d.Exchange, d.AssetType, d.Pair)
+	if err != nil || len(positions) == 0 {
+		return
 	}
-	return s.futuresPositionController.TrackNewOrder(det)
+	publishEvent(eventbus.PublishPosition(&positions[len(positions)-1]))
 }
 
 // getFilteredOrders returns a filtered copy of the orders
@@ -5,21 +5,28 @@ import (
 	"errors"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderhistory"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/feeschedule"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
@@ -1728,3 +1735,644 @@ func TestGetByDetail(t *testing.T) {
 		assert.Equal(t, od.ClientOrderID, byID.ClientOrderID, "Retrieve by id pointer should contain the correct ClientOrderID")
 	}
 }
+
+// fakeOrderHistoryDB stores order history in memory
+type fakeOrderHistoryDB struct {
+	m      sync.Mutex
+	orders map[string]orderhistory.Order
+	events []orderhistory.Event
+}
+
+func (f *fakeOrderHistoryDB) Record(o *orderhistory.Order, events ...*orderhistory.Event) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.orders[o.ID] = *o
+	for i := range events {
+		events[i].OrderID = o.ID
+		f.events = append(f.events, *events[i])
+	}
+	return nil
+}
+
+func (f *fakeOrderHistoryDB) GetByID(id string) (*orderhistory.Order, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	o, ok := f.orders[id]
+	if !ok {
+		return nil, ErrOrderNotFound
+	}
+	return &o, nil
+}
+
+func (f *fakeOrderHistoryDB) Search(filter *orderhistory.Filter) ([]orderhistory.Order, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	var resp []orderhistory.Order
+	for _, o := range f.orders {
+		if len(filter.Statuses) > 0 && !common.StringDataCompare(filter.Statuses, o.Status) {
+			continue
+		}
+		resp = append(resp, o)
+	}
+	return resp, nil
+}
+
+func (f *fakeOrderHistoryDB) GetEvents(id string) ([]orderhistory.Event, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	var resp []orderhistory.Event
+	for i := range f.events {
+		if f.events[i].OrderID == id {
+			resp = append(resp, f.events[i])
+		}
+	}
+	return resp, nil
+}
+
+// eventNames returns the lifecycle event names recorded for an order
+func (f *fakeOrderHistoryDB) eventNames(id string) []string {
+	events, _ := f.GetEvents(id)
+	resp := make([]string, len(events))
+	for i := range events {
+		resp[i] = events[i].Event
+	}
+	return resp
+}
+
+// setupOrderHistoryTest returns a started order manager recording to an in
+// memory order history database without requiring exchange API access
+func setupOrderHistoryTest(t *testing.T) (*OrderManager, *fakeOrderHistoryDB) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(omfExchange{IBotExchange: exch}), "Add must not error")
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	db := &fakeOrderHistoryDB{orders: make(map[string]orderhistory.Order)}
+	m.db = db
+	m.started = 1
+	return m, db
+}
+
+func TestSetDatabaseManager(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	assert.ErrorIs(t, m.SetDatabaseManager(nil), ErrNilSubsystem, "SetDatabaseManager should error on nil order manager")
+
+	m, _ = setupOrderHistoryTest(t)
+	assert.ErrorIs(t, m.SetDatabaseManager(nil), ErrSubSystemAlreadyStarted, "SetDatabaseManager should error when started")
+
+	m.started = 0
+	require.NoError(t, m.SetDatabaseManager(nil), "SetDatabaseManager must not error")
+	assert.Nil(t, m.db, "SetDatabaseManager should remove the database")
+
+	require.NoError(t, m.SetDatabaseManager(&DatabaseConnectionManager{}), "SetDatabaseManager must not error")
+	assert.Nil(t, m.db, "SetDatabaseManager should not attach a disconnected database")
+}
+
+func TestOrderManagerRecordsHistory(t *testing.T) {
+	t.Parallel()
+	m, db := setupOrderHistoryTest(t)
+
+	resp, err := m.SubmitFakeOrder(&order.Submit{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Price:     100,
+		Amount:    2,
+	}, &order.SubmitResponse{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Price:     100,
+		Amount:    2,
+		OrderID:   "history",
+		Status:    order.New,
+	}, false)
+	require.NoError(t, err, "SubmitFakeOrder must not error")
+	id := resp.InternalOrderID
+	assert.Equal(t, []string{OrderEventSubmitted}, db.eventNames(id), "Submission should be recorded")
+
+	upd := resp.Detail.Copy()
+	upd.Status = order.PartiallyFilled
+	upd.ExecutedAmount = 1
+	upd.RemainingAmount = 1
+	upd.Trades = []order.TradeHistory{{TID: "1", Price: 100, Amount: 1, Side: order.Buy, Timestamp: time.Now()}}
+	_, err = m.UpsertOrder(&upd)
+	require.NoError(t, err, "UpsertOrder must not error")
+	assert.Equal(t, []string{OrderEventSubmitted, OrderEventFill, OrderEventStatusChange}, db.eventNames(id), "Fill and status change should be recorded")
+
+	_, err = m.UpsertOrder(&upd)
+	require.NoError(t, err, "UpsertOrder must not error")
+	assert.Len(t, db.eventNames(id), 3, "An unchanged order should not record events")
+
+	_, err = m.Modify(context.Background(), &order.Modify{Exchange: testExchange, OrderID: "history", AssetType: asset.Spot, Pair: btcusdPair, Price: 101})
+	require.NoError(t, err, "Modify must not error")
+	require.NoError(t, m.Cancel(context.Background(), &order.Cancel{Exchange: testExchange, OrderID: "modified_order_id", AssetType: asset.Spot, Pair: btcusdPair}), "Cancel must not error")
+	assert.Equal(t, []string{OrderEventSubmitted, OrderEventFill, OrderEventStatusChange, OrderEventModified, OrderEventCancelled}, db.eventNames(id), "Modification and cancellation should be recorded")
+
+	stored, err := db.GetByID(id)
+	require.NoError(t, err, "GetByID must not error")
+	assert.Equal(t, "modified_order_id", stored.OrderID, "Stored order ID should follow the modification")
+	assert.Equal(t, order.Cancelled.String(), stored.Status, "Stored status should be cancelled")
+	require.Len(t, stored.Trades, 1, "Stored trades must contain the fill")
+	assert.Equal(t, "BUY", stored.Trades[0].Side, "Stored trade side should be correct")
+
+	_, err = m.UpsertOrder(&order.Detail{Exchange: testExchange, Pair: btcusdPair, AssetType: asset.Spot, OrderID: "tracked", Amount: 1, Status: order.Open})
+	require.NoError(t, err, "UpsertOrder must not error")
+	tracked := m.orderStore.getByDetail(&order.Detail{Exchange: testExchange, OrderID: "tracked"})
+	require.NotNil(t, tracked, "Upserted order must be stored")
+	assert.Equal(t, []string{OrderEventAdded}, db.eventNames(tracked.InternalOrderID.String()), "Newly tracked orders should be recorded")
+}
+
+func TestLoadActiveOrders(t *testing.T) {
+	t.Parallel()
+	m, db := setupOrderHistoryTest(t)
+	id, err := uuid.NewV4()
+	require.NoError(t, err, "NewV4 must not error")
+	now := time.Now().Truncate(time.Second)
+	db.orders[id.String()] = orderhistory.Order{
+		ID:             id.String(),
+		Exchange:       strings.ToLower(testExchange),
+		Asset:          "spot",
+		Base:           "BTC",
+		Quote:          "USD",
+		OrderID:        "restored",
+		Type:           "LIMIT",
+		Side:           "SELL",
+		Status:         "PARTIALLY_FILLED",
+		Price:          100,
+		Amount:         2,
+		ExecutedAmount: 1,
+		CreatedDate:    now,
+		UpdatedDate:    now,
+		Trades:         []orderhistory.Trade{{TID: "1", Price: 100, Amount: 1, Side: "SELL", Timestamp: now}},
+	}
+	db.orders["filled"] = orderhistory.Order{ID: "filled", Exchange: testExchange, OrderID: "filled", Status: "FILLED"}
+	db.orders["unknown exchange"] = orderhistory.Order{ID: "unknown exchange", Exchange: "fake", OrderID: "fake", Status: "NEW"}
+
+	require.NoError(t, m.loadActiveOrders(), "loadActiveOrders must not error")
+	restored, err := m.orderStore.getByExchangeAndID(testExchange, "restored")
+	require.NoError(t, err, "getByExchangeAndID must not error")
+	assert.Equal(t, id, restored.InternalOrderID, "Internal order ID should be restored")
+	assert.Equal(t, testExchange, restored.Exchange, "Exchange name should be restored")
+	assert.True(t, restored.Pair.Equal(btcusdPair), "Pair should be restored")
+	assert.Equal(t, order.PartiallyFilled, restored.Status, "Status should be restored")
+	assert.Equal(t, order.Sell, restored.Side, "Side should be restored")
+	assert.True(t, restored.Date.Equal(now), "Date should be restored")
+	require.Len(t, restored.Trades, 1, "Trades must be restored")
+	assert.Equal(t, order.Sell, restored.Trades[0].Side, "Trade side should be restored")
+
+	_, err = m.orderStore.getByExchangeAndID(testExchange, "filled")
+	assert.ErrorIs(t, err, ErrOrderNotFound, "Inactive orders should not be restored")
+
+	require.NoError(t, m.loadActiveOrders(), "loadActiveOrders must not error on already restored orders")
+	assert.Len(t, m.orderStore.get()[strings.ToLower(testExchange)], 1, "Restoring again should not duplicate orders")
+}
+
+// reconcileTestExchange returns fixed exchange order state for reconciliation
+type reconcileTestExchange struct {
+	exchange.IBotExchange
+	active  []order.Detail
+	history []order.Detail
+	info    map[string]*order.Detail
+}
+
+func (e *reconcileTestExchange) IsRESTAuthenticationSupported() bool {
+	return true
+}
+
+func (e *reconcileTestExchange) GetAssetTypes(bool) asset.Items {
+	return asset.Items{asset.Spot}
+}
+
+func (e *reconcileTestExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return currency.Pairs{btcusdPair}, nil
+}
+
+func (e *reconcileTestExchange) GetActiveOrders(context.Context, *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	return append([]order.Detail(nil), e.active...), nil
+}
+
+func (e *reconcileTestExchange) GetOrderHistory(context.Context, *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	return append([]order.Detail(nil), e.history...), nil
+}
+
+func (e *reconcileTestExchange) GetOrderInfo(_ context.Context, orderID string, _ currency.Pair, _ asset.Item) (*order.Detail, error) {
+	d, ok := e.info[orderID]
+	if !ok {
+		return nil, ErrOrderNotFound
+	}
+	return d, nil
+}
+
+func setupReconciliationTest(t *testing.T) (*OrderManager, *reconcileTestExchange, *fakeOrderHistoryDB) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	fake := &reconcileTestExchange{IBotExchange: exch}
+	require.NoError(t, em.Add(fake), "Add must not error")
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	db := &fakeOrderHistoryDB{orders: make(map[string]orderhistory.Order)}
+	m.db = db
+	m.started = 1
+	return m, fake, db
+}
+
+func TestReconcileOrders(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.ReconcileOrders(context.Background(), testExchange)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, fake, db := setupReconciliationTest(t)
+	_, err = m.ReconcileOrders(context.Background(), "fake")
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	now := time.Now()
+	gapStart := now.Add(-time.Hour)
+	for _, d := range []*order.Detail{
+		{OrderID: "filled in gap", Status: order.Active, Amount: 1, LastUpdated: gapStart},
+		{OrderID: "still active", Status: order.Active, Amount: 1, LastUpdated: now},
+		{OrderID: "cancelled in gap", Status: order.Active, Amount: 1, LastUpdated: now},
+		{OrderID: "missing", Status: order.Active, Amount: 1, LastUpdated: now},
+	} {
+		d.Exchange = testExchange
+		d.AssetType = asset.Spot
+		d.Pair = btcusdPair
+		require.NoError(t, m.orderStore.add(d), "add must not error")
+	}
+	remote := func(id string, status order.Status, executed float64) order.Detail {
+		return order.Detail{Exchange: testExchange, AssetType: asset.Spot, Pair: btcusdPair, OrderID: id, Status: status, Amount: 1, ExecutedAmount: executed}
+	}
+	fake.active = []order.Detail{
+		remote("still active", order.Active, 0),
+		remote("orphan", order.Active, 0),
+	}
+	fake.history = []order.Detail{
+		remote("filled in gap", order.Filled, 1),
+		remote("unknown fill", order.Filled, 1),
+		remote("unknown cancel", order.Cancelled, 0),
+	}
+	cancelled := remote("cancelled in gap", order.Cancelled, 0)
+	fake.info = map[string]*order.Detail{"cancelled in gap": &cancelled}
+
+	require.True(t, m.setReconciling(testExchange, true), "setReconciling must flag the exchange")
+	_, err = m.ReconcileOrders(context.Background(), testExchange)
+	assert.ErrorIs(t, err, errReconciliationInProgress)
+	require.True(t, m.setReconciling(testExchange, false), "setReconciling must clear the flag")
+
+	report, err := m.ReconcileOrders(context.Background(), testExchange)
+	require.NoError(t, err, "ReconcileOrders must not error")
+	assert.Equal(t, ReconcileOnRequest, report.Trigger, "Trigger should be set")
+	assert.True(t, report.StartTime.Equal(gapStart), "StartTime should be the oldest active order update")
+	assert.Equal(t, 4, report.OrdersChecked, "OrdersChecked should be the number of locally active orders")
+	assert.Empty(t, report.Errors, "Errors should be empty")
+
+	changes := make(map[string]ReconciliationChange)
+	for i := range report.Changes {
+		changes[report.Changes[i].OrderID] = report.Changes[i]
+	}
+	require.Len(t, changes, 2, "Changes must contain the orders which changed in the gap")
+	assert.Equal(t, order.Active, changes["filled in gap"].PreviousStatus, "PreviousStatus should be the stored status")
+	assert.Equal(t, order.Filled, changes["filled in gap"].Status, "Status should be the exchange status")
+	assert.Equal(t, 1.0, changes["filled in gap"].ExecutedAmount, "ExecutedAmount should be the exchange amount")
+	assert.Equal(t, order.Cancelled, changes["cancelled in gap"].Status, "Orders not returned in bulk should be queried individually")
+
+	discrepancies := make(map[string]string)
+	for i := range report.Discrepancies {
+		discrepancies[report.Discrepancies[i].OrderID] = report.Discrepancies[i].Type
+	}
+	assert.Equal(t, map[string]string{
+		"orphan":       DiscrepancyOrphanOrder,
+		"unknown fill": DiscrepancyUnknownFill,
+		"missing":      DiscrepancyMissingOrder,
+	}, discrepancies, "Discrepancies should contain untracked and missing orders")
+
+	stored, err := m.orderStore.getByExchangeAndID(testExchange, "filled in gap")
+	require.NoError(t, err, "getByExchangeAndID must not error")
+	assert.Equal(t, order.Filled, stored.Status, "Stored order should be updated")
+	_, err = m.orderStore.getByExchangeAndID(testExchange, "orphan")
+	assert.NoError(t, err, "Orphan orders should be tracked")
+	_, err = m.orderStore.getByExchangeAndID(testExchange, "unknown cancel")
+	assert.ErrorIs(t, err, ErrOrderNotFound, "Untracked orders without fills should be ignored")
+
+	events := make(map[string][]string)
+	for i := range db.events {
+		events[db.events[i].Event] = append(events[db.events[i].Event], db.events[i].Message)
+	}
+	assert.Len(t, events[OrderEventReconciled], 4, "Reconciled events should be recorded for updated and added orders")
+	assert.Contains(t, events[OrderEventStatusChange], "ACTIVE -> FILLED", "Status change events should be recorded")
+
+	reports, err := m.GetReconciliationReports("")
+	require.NoError(t, err, "GetReconciliationReports must not error")
+	require.Len(t, reports, 1, "GetReconciliationReports must return the report")
+	assert.Equal(t, testExchange, reports[0].Exchange, "Exchange should be set")
+	reports, err = m.GetReconciliationReports("fake")
+	require.NoError(t, err, "GetReconciliationReports must not error")
+	assert.Empty(t, reports, "GetReconciliationReports should filter by exchange")
+
+	report, err = m.ReconcileOrders(context.Background(), testExchange)
+	require.NoError(t, err, "ReconcileOrders must not error")
+	assert.Empty(t, report.Changes, "Reconciling again should not report changes")
+	require.Len(t, report.Discrepancies, 1, "Reconciling again must only report the missing order")
+	assert.Equal(t, DiscrepancyMissingOrder, report.Discrepancies[0].Type, "Discrepancy should be the missing order")
+}
+
+func TestReconciliationWindowStart(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	assert.True(t, reconciliationWindowStart(nil, now).Equal(now.Add(-defaultReconciliationWindow)), "should default without active orders")
+	assert.True(t, reconciliationWindowStart([]order.Detail{
+		{LastUpdated: now.Add(-time.Minute)},
+		{Date: now.Add(-time.Hour)},
+		{},
+	}, now).Equal(now.Add(-time.Hour)), "should return the oldest update")
+}
+
+func TestOrderManagerWebsocketDataHandler(t *testing.T) {
+	t.Parallel()
+	m, _, _ := setupReconciliationTest(t)
+	assert.NoError(t, m.websocketDataHandler(testExchange, "not a reconnect"), "websocketDataHandler should ignore other data")
+	assert.ErrorIs(t, m.websocketDataHandler(testExchange, stream.Reconnected{Exchange: "fake"}), ErrExchangeNotFound)
+	assert.NoError(t, m.websocketDataHandler(testExchange, stream.Reconnected{Exchange: testExchange}), "websocketDataHandler should not error")
+	reports, err := m.GetReconciliationReports("")
+	require.NoError(t, err, "GetReconciliationReports must not error")
+	assert.Empty(t, reports, "exchanges without valid credentials should not be reconciled")
+}
+
+func TestOrderManagerShutdownContext(t *testing.T) {
+	t.Parallel()
+	m := &OrderManager{shutdown: make(chan struct{})}
+	ctx, cancel := m.shutdownContext()
+	assert.NoError(t, ctx.Err(), "context should not be cancelled while running")
+	cancel()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled, "context should be cancelled by its cancel func")
+
+	ctx, cancel = m.shutdownContext()
+	defer cancel()
+	close(m.shutdown)
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("context must be cancelled on shutdown")
+	}
+}
+
+// feeScheduleTestExchange returns a fixed fee schedule and ticker
+type feeScheduleTestExchange struct {
+	exchange.IBotExchange
+	schedule *feeschedule.Schedule
+	err      error
+	last     float64
+	// block holds schedule requests until it is closed when set
+	block    chan struct{}
+	requests int32
+}
+
+func (e *feeScheduleTestExchange) GetFeeSchedule(context.Context, asset.Item) (*feeschedule.Schedule, error) {
+	atomic.AddInt32(&e.requests, 1)
+	if e.block != nil {
+		<-e.block
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+	if e.schedule == nil {
+		return nil, common.ErrFunctionNotSupported
+	}
+	return e.schedule, nil
+}
+
+func (e *feeScheduleTestExchange) FetchTicker(_ context.Context, p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	return &ticker.Price{Pair: p, AssetType: a, Last: e.last}, nil
+}
+
+func setupFeeScheduleTest(t *testing.T) (*OrderManager, *feeScheduleTestExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	fake := &feeScheduleTestExchange{
+		IBotExchange: exch,
+		last:         100,
+		schedule: &feeschedule.Schedule{
+			Exchange:       testExchange,
+			Asset:          asset.Spot,
+			VolumeCurrency: currency.USD,
+			Tiers: []feeschedule.Tier{
+				{Name: "VIP0", Maker: 0.001, Taker: 0.002},
+				{Name: "VIP1", MinimumVolume: 1000, Maker: -0.0001, Taker: 0.001},
+			},
+			TokenDiscount: &feeschedule.TokenDiscount{Currency: currency.BNB, Rate: 0.5},
+		},
+	}
+	require.NoError(t, em.Add(fake), "Add must not error")
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	m.started = 1
+	return m, fake
+}
+
+func TestEstimateOrderCost(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.EstimateOrderCost(context.Background(), &order.Submit{})
+	assert.ErrorIs(t, err, ErrNilSubsystem, "EstimateOrderCost should error on a nil order manager")
+
+	m, fake := setupFeeScheduleTest(t)
+	_, err = m.EstimateOrderCost(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilOrder, "EstimateOrderCost should error on a nil order")
+	_, err = m.EstimateOrderCost(context.Background(), &order.Submit{Exchange: testExchange})
+	assert.ErrorIs(t, err, errInvalidEstimateAmount, "EstimateOrderCost should error without an amount")
+	_, err = m.EstimateOrderCost(context.Background(), &order.Submit{Exchange: "meow", Amount: 1})
+	assert.ErrorIs(t, err, ErrExchangeNotFound, "EstimateOrderCost should error on an unknown exchange")
+
+	s := &order.Submit{Exchange: testExchange, AssetType: asset.Spot, Pair: btcusdPair, Side: order.Buy, Type: order.Market, Amount: 2}
+	est, err := m.EstimateOrderCost(context.Background(), s)
+	require.NoError(t, err, "EstimateOrderCost must not error")
+	assert.Equal(t, 100.0, est.Price, "Price should use the ticker for market orders")
+	assert.Equal(t, 200.0, est.Notional, "Notional should be correct")
+	require.NotNil(t, est.Tier, "Tier must be set")
+	assert.Equal(t, "VIP0", est.Tier.Name, "Tier should be the lowest tier without volume")
+	require.NotNil(t, est.NextTier, "NextTier must be set")
+	assert.Equal(t, 1000.0, est.VolumeToNextTier, "VolumeToNextTier should be correct")
+	assert.InDelta(t, 0.4, est.Fee, 1e-9, "Fee should use the taker rate")
+	assert.InDelta(t, 0.2, est.TokenDiscountFee, 1e-9, "TokenDiscountFee should apply the discount")
+	assert.InDelta(t, 200.4, est.Total, 1e-9, "Total should add the fee to buys")
+
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:    testExchange,
+		OrderID:     "1",
+		AssetType:   asset.Spot,
+		Pair:        btcusdPair,
+		Side:        order.Buy,
+		Status:      order.Active,
+		Amount:      20,
+		Price:       100,
+		LastUpdated: time.Now(),
+	}), "add must not error")
+	_, err = m.UpsertOrder(&order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "1",
+		AssetType:      asset.Spot,
+		Pair:           btcusdPair,
+		Side:           order.Buy,
+		Status:         order.PartiallyFilled,
+		Amount:         20,
+		ExecutedAmount: 15,
+		Price:          100,
+		LastUpdated:    time.Now(),
+	})
+	require.NoError(t, err, "UpsertOrder must not error")
+	volume, _, err := m.GetFeeVolume(context.Background(), testExchange, asset.Spot)
+	require.NoError(t, err, "GetFeeVolume must not error")
+	assert.Equal(t, 1500.0, volume, "GetFeeVolume should track the executed notional")
+
+	s.Type = order.Limit
+	s.Side = order.Sell
+	s.PostOnly = true
+	s.Price = 110
+	est, err = m.EstimateOrderCost(context.Background(), s)
+	require.NoError(t, err, "EstimateOrderCost must not error")
+	assert.Equal(t, "VIP1", est.Tier.Name, "Tier should be predicted from tracked volume")
+	assert.Nil(t, est.NextTier, "NextTier should not be set in the highest tier")
+	assert.InDelta(t, -0.022, est.Fee, 1e-9, "Fee should be a maker rebate")
+	assert.InDelta(t, -0.022, est.TokenDiscountFee, 1e-9, "TokenDiscountFee should not discount a rebate")
+	assert.InDelta(t, 220.022, est.Total, 1e-9, "Total should include the rebate for sells")
+
+	fake.schedule = nil
+	m.feeAccounts = nil
+	_, _, err = m.GetFeeVolume(context.Background(), testExchange, asset.Spot)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "GetFeeVolume should error without a fee schedule")
+
+	fake.err = asset.ErrNotSupported
+	m.feeAccounts = nil
+	acc, err := m.getFeeAccount(context.Background(), fake, asset.Options)
+	require.NoError(t, err, "getFeeAccount must not error on an asset without a fee schedule")
+	assert.Nil(t, acc.schedule, "schedule should not be set for an asset without a fee schedule")
+}
+
+func TestGetFeeAccount(t *testing.T) {
+	t.Parallel()
+	m, fake := setupFeeScheduleTest(t)
+	fake.block = make(chan struct{})
+	var wg sync.WaitGroup
+	accounts := make([]*feeAccount, 3)
+	for i := range accounts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			accounts[i], err = m.getFeeAccount(context.Background(), fake, asset.Spot)
+			assert.NoError(t, err, "getFeeAccount should not error")
+		}(i)
+	}
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&fake.requests) == 1
+	}, time.Second*5, time.Millisecond*10, "Schedule should be requested")
+	assert.True(t, m.feeMtx.TryLock(), "Lock should not be held while the schedule is requested")
+	m.feeMtx.Unlock()
+	close(fake.block)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fake.requests), "Concurrent callers should share a single request")
+	for i := range accounts {
+		assert.Same(t, accounts[0], accounts[i], "Concurrent callers should receive the same account")
+	}
+
+	errFeeSchedule := errors.New("fee schedule unavailable")
+	fake.block = nil
+	fake.err = errFeeSchedule
+	_, err := m.getFeeAccount(context.Background(), fake, asset.Margin)
+	assert.ErrorIs(t, err, errFeeSchedule, "getFeeAccount should return the request error")
+	_, err = m.getFeeAccount(context.Background(), fake, asset.Margin)
+	assert.ErrorIs(t, err, errFeeSchedule, "getFeeAccount should return the cached error")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fake.requests), "Failure should not be requested again until its retry time")
+
+	k := key.ExchangeAsset{Exchange: strings.ToLower(testExchange), Asset: asset.Margin}
+	m.feeMtx.Lock()
+	f := m.feeFetches[k]
+	assert.WithinDuration(t, time.Now().Add(feeScheduleRetryDelay), f.retry, time.Second, "First failure should be retried after the base delay")
+	f.retry = time.Now()
+	m.feeMtx.Unlock()
+	_, err = m.getFeeAccount(context.Background(), fake, asset.Margin)
+	assert.ErrorIs(t, err, errFeeSchedule, "getFeeAccount should retry once the retry time has passed")
+	m.feeMtx.Lock()
+	f = m.feeFetches[k]
+	assert.WithinDuration(t, time.Now().Add(feeScheduleRetryDelay*2), f.retry, time.Second, "Consecutive failures should back off")
+	f.retry = time.Now()
+	m.feeMtx.Unlock()
+
+	fake.err = nil
+	acc, err := m.getFeeAccount(context.Background(), fake, asset.Margin)
+	require.NoError(t, err, "getFeeAccount must not error once the request succeeds")
+	assert.NotNil(t, acc.schedule, "schedule should be set")
+	m.feeMtx.Lock()
+	assert.NotContains(t, m.feeFetches, k, "Successful request should clear the failure")
+	m.feeMtx.Unlock()
+}
+
+func TestVolumeInCurrency(t *testing.T) {
+	t.Parallel()
+	v, ok := volumeInCurrency(testExchange, asset.Spot, btcusdPair, 100, 2, currency.USD)
+	assert.True(t, ok, "quote volume should be measured")
+	assert.Equal(t, 200.0, v, "quote volume should be the notional")
+	v, ok = volumeInCurrency(testExchange, asset.Spot, btcusdPair, 100, 2, currency.BTC)
+	assert.True(t, ok, "base volume should be measured")
+	assert.Equal(t, 2.0, v, "base volume should be the amount")
+	_, ok = volumeInCurrency("feevolumeexch", asset.Spot, btcusdPair, 100, 2, currency.USDT)
+	assert.False(t, ok, "volume should not be measured without a ticker")
+}
+
+func TestOrderStorePublishesEvents(t *testing.T) {
+	startEventBus(t)
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	s := &store{
+		Orders:                    make(map[string][]*order.Detail),
+		exchangeManager:           em,
+		futuresPositionController: futures.SetupPositionController(),
+	}
+	orders, err := eventbus.Subscribe(eventbus.OrderTopic, &eventbus.SubscribeOptions{Filter: eventbus.ExchangeFilter(testExchange)})
+	require.NoError(t, err, "Subscribe must not error")
+	defer func() { assert.NoError(t, orders.Unsubscribe(), "Unsubscribe should not error") }()
+	positions, err := eventbus.Subscribe(eventbus.PositionTopic, &eventbus.SubscribeOptions{Filter: eventbus.ExchangeFilter(testExchange)})
+	require.NoError(t, err, "Subscribe must not error")
+	defer func() { assert.NoError(t, positions.Unsubscribe(), "Unsubscribe should not error") }()
+
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	err = s.add(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "eventbus",
+		Date:      time.Now(),
+		AssetType: asset.Futures,
+		Pair:      cp,
+		Side:      order.Buy,
+		Amount:    1,
+		Price:     1337,
+	})
+	require.NoError(t, err, "add must not error")
+	e := receiveEvent(t, orders)
+	assert.Equal(t, "eventbus", e.Order.OrderID, "Added order should be published")
+	e = receiveEvent(t, positions)
+	assert.True(t, e.Pair.Equal(cp), "Position should be published for the order's pair")
+	assert.Equal(t, asset.Futures, e.Position.Asset, "Position asset should be correct")
+
+	err = s.updateExisting(&order.Detail{Exchange: testExchange, OrderID: "eventbus", Status: order.Filled})
+	require.NoError(t, err, "updateExisting must not error")
+	e = receiveEvent(t, orders)
+	assert.Equal(t, order.Filled, e.Order.Status, "Updated order should be published")
+}
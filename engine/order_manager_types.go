@@ -5,7 +5,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderhistory"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/feeschedule"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -13,6 +17,37 @@ import (
 // OrderManagerName is an exported subsystem name
 const OrderManagerName = "orders"
 
+// Order lifecycle events recorded to the order history database
+const (
+	OrderEventSubmitted    = "SUBMITTED"
+	OrderEventAdded        = "ADDED"
+	OrderEventModified     = "MODIFIED"
+	OrderEventCancelled    = "CANCELLED"
+	OrderEventStatusChange = "STATUS_CHANGE"
+	OrderEventFill         = "FILL"
+	OrderEventReconciled   = "RECONCILED"
+)
+
+// Order reconciliation triggers
+const (
+	ReconcileOnStartup   = "STARTUP"
+	ReconcileOnReconnect = "RECONNECT"
+	ReconcileOnRequest   = "REQUEST"
+)
+
+// Order reconciliation discrepancy types
+const (
+	// DiscrepancyOrphanOrder is an order active on the exchange which was not
+	// tracked by the order manager
+	DiscrepancyOrphanOrder = "ORPHAN_ORDER"
+	// DiscrepancyUnknownFill is an order filled on the exchange during the gap
+	// window which was not tracked by the order manager
+	DiscrepancyUnknownFill = "UNKNOWN_FILL"
+	// DiscrepancyMissingOrder is an order active in the order manager which
+	// the exchange did not return
+	DiscrepancyMissingOrder = "MISSING_ORDER"
+)
+
 // vars for the fund manager package
 var (
 	// ErrOrdersAlreadyExists occurs when the order already exists in the manager
@@ -25,8 +60,23 @@ var (
 	errNilCommunicationsManager = errors.New("cannot start with nil communications manager")
 	errNilOrder                 = errors.New("nil order received")
 	errFuturesTrackingDisabled  = errors.New("tracking futures positions disabled. enable it via config under orderManager activelyTrackFuturesPositions")
+	errOrderHistoryDisabled     = errors.New("order history database is not connected")
+	errReconciliationInProgress = errors.New("order reconciliation already in progress")
+	errInvalidEstimateAmount    = errors.New("order amount must be greater than zero to estimate its cost")
+	errNoEstimatePrice          = errors.New("order price is not set and no ticker is available to estimate its cost")
 	orderManagerInterval        = time.Second * 10
 	defaultOrderSeekTime        = -time.Hour * 24 * 365
+	// defaultReconciliationWindow is how far back order history is checked
+	// when the start of the gap window is unknown
+	defaultReconciliationWindow = time.Hour * 24
+	// feeScheduleRetryDelay is how long a failed fee schedule request is
+	// cached before it is retried, doubling with each consecutive failure up
+	// to feeScheduleMaxRetryDelay
+	feeScheduleRetryDelay    = time.Second * 10
+	feeScheduleMaxRetryDelay = time.Minute * 10
+	// rehydratedOrderStatuses are the active statuses of orders restored from
+	// the order history database on start
+	rehydratedOrderStatuses = []order.Status{order.New, order.Active, order.PartiallyFilled, order.PendingCancel, order.Hidden, order.Open, order.Pending}
 )
 
 type orderManagerConfig struct {
@@ -50,6 +100,64 @@ type OrderManager struct {
 	activelyTrackFuturesPositions bool
 	futuresPositionSeekDuration   time.Duration
 	respectOrderHistoryLimits     bool
+	riskManager                   iRiskManager
+	riskMtx                       sync.RWMutex
+	db                            orderhistory.IDBService
+	reconcileMtx                  sync.Mutex
+	reconciling                   map[string]bool
+	reconciliations               map[string]*ReconciliationReport
+	feeMtx                        sync.Mutex
+	feeAccounts                   map[key.ExchangeAsset]*feeAccount
+	feeFetches                    map[key.ExchangeAsset]*feeFetch
+}
+
+// feeAccount holds an exchange account's fee schedule for an asset type and
+// the trade volume tracked towards its tiers. A nil schedule indicates the
+// exchange does not support fee schedules
+type feeAccount struct {
+	schedule *feeschedule.Schedule
+	tracker  *feeschedule.Tracker
+}
+
+// feeFetch is an in flight or failed fee schedule request. Concurrent callers
+// wait on done rather than requesting the schedule again, and a failure is
+// returned to callers until retry has passed
+type feeFetch struct {
+	done     chan struct{}
+	err      error
+	retry    time.Time
+	failures int
+}
+
+// OrderCostEstimate is the expected fee of an order under the exchange
+// account's fee schedule given the trade volume tracked over the schedule's
+// window. Tier fields are only set when the exchange supports fee schedules,
+// otherwise the fee is the exchange's flat trading fee
+type OrderCostEstimate struct {
+	Exchange       string
+	Asset          asset.Item
+	Pair           currency.Pair
+	Side           order.Side
+	IsMaker        bool
+	Price          float64
+	Amount         float64
+	Notional       float64
+	VolumeCurrency currency.Code
+	TrailingVolume float64
+	Tier           *feeschedule.Tier
+	// NextTier and VolumeToNextTier are set when a higher tier exists
+	NextTier         *feeschedule.Tier
+	VolumeToNextTier float64
+	FeeRate          float64
+	// Fee is negative when the order earns a maker rebate
+	Fee float64
+	// TokenDiscountFee is the fee when paid in the discount token and equals
+	// Fee when the schedule has no token discount
+	TokenDiscountFee      float64
+	TokenDiscountCurrency currency.Code
+	// Total is the notional plus the fee for buys and the notional less the
+	// fee for sells
+	Total float64
 }
 
 // store holds all orders by exchange
@@ -74,3 +182,41 @@ type OrderUpsertResponse struct {
 	OrderDetails order.Detail
 	IsNewOrder   bool
 }
+
+// ReconciliationReport is the outcome of diffing the orders held by the order
+// manager against the state reported by an exchange. StartTime and EndTime
+// define the gap window checked for orders which changed while disconnected
+type ReconciliationReport struct {
+	Exchange      string
+	Trigger       string
+	StartTime     time.Time
+	EndTime       time.Time
+	OrdersChecked int
+	Changes       []ReconciliationChange
+	Discrepancies []ReconciliationDiscrepancy
+	Errors        []string
+}
+
+// ReconciliationChange is a tracked order whose state changed on the exchange
+type ReconciliationChange struct {
+	InternalOrderID        string
+	OrderID                string
+	Asset                  asset.Item
+	Pair                   currency.Pair
+	PreviousStatus         order.Status
+	Status                 order.Status
+	PreviousExecutedAmount float64
+	ExecutedAmount         float64
+}
+
+// ReconciliationDiscrepancy is an order whose existence differs between the
+// order manager and the exchange
+type ReconciliationDiscrepancy struct {
+	Type            string
+	InternalOrderID string
+	OrderID         string
+	Asset           asset.Item
+	Pair            currency.Pair
+	Status          order.Status
+	ExecutedAmount  float64
+}
@@ -0,0 +1,691 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupPortfolioRebalancer applies configuration parameters before running
+func SetupPortfolioRebalancer(exchangeManager iExchangeManager, orderManager iOrderSubmitter, cfg *config.PortfolioRebalancer) (*PortfolioRebalancer, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", PortfolioRebalancerName, errNilConfig)
+	}
+	checkInterval := cfg.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = config.DefaultRebalancerCheckInterval
+	}
+	return &PortfolioRebalancer{
+		shutdown:        make(chan struct{}),
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		strategies:      make(map[string]*RebalanceStrategy),
+		checkInterval:   checkInterval,
+		verbose:         cfg.Verbose,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *PortfolioRebalancer) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *PortfolioRebalancer) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", PortfolioRebalancerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", PortfolioRebalancerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.PortfolioMgr, "Portfolio rebalancer %s", MsgSubSystemStarting)
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.PortfolioMgr, "Portfolio rebalancer %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem. Strategies are retained and resume
+// when the subsystem is started again
+func (m *PortfolioRebalancer) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", PortfolioRebalancerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("%s %w", PortfolioRebalancerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.PortfolioMgr, "Portfolio rebalancer %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	atomic.StoreInt32(&m.started, 0)
+	log.Debugf(log.PortfolioMgr, "Portfolio rebalancer %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run checks strategies for scheduled or drift triggered rebalances every
+// check interval
+func (m *PortfolioRebalancer) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.checkStrategies(context.TODO())
+		}
+	}
+}
+
+// Add validates a rebalance strategy and begins monitoring it. Returns the
+// strategy ID
+func (m *PortfolioRebalancer) Add(s *RebalanceStrategy) (string, error) {
+	if !m.IsRunning() {
+		return "", fmt.Errorf("%s %w", PortfolioRebalancerName, ErrSubSystemNotStarted)
+	}
+	strategy, err := m.prepare(s)
+	if err != nil {
+		return "", err
+	}
+	m.m.Lock()
+	m.strategies[strategy.ID] = strategy
+	m.m.Unlock()
+	log.Infof(log.PortfolioMgr, "Portfolio rebalancer added %s strategy %s across %v settling in %s with %v%% drift tolerance",
+		strategy.Trigger, strategy.ID, strategy.Exchanges, strategy.SettlementCurrency, strategy.DriftTolerance*100)
+	return strategy.ID, nil
+}
+
+// Remove stops a rebalance strategy
+func (m *PortfolioRebalancer) Remove(id string) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", PortfolioRebalancerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	s, ok := m.strategies[id]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrRebalanceStrategyNotFound, id)
+	}
+	if s.Status != RebalanceActive {
+		return fmt.Errorf("%w %s status %s", errRebalanceStrategyStopped, id, s.Status)
+	}
+	s.Status = RebalanceStopped
+	s.Reason = "stopped by user"
+	s.UpdatedDate = time.Now()
+	return nil
+}
+
+// GetStrategy returns a copy of a rebalance strategy by its ID
+func (m *PortfolioRebalancer) GetStrategy(id string) (*RebalanceStrategy, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", PortfolioRebalancerName, ErrNilSubsystem)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	s, ok := m.strategies[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrRebalanceStrategyNotFound, id)
+	}
+	return s.copy(), nil
+}
+
+// GetStrategies returns copies of all rebalance strategies ordered by
+// creation date
+func (m *PortfolioRebalancer) GetStrategies() ([]RebalanceStrategy, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", PortfolioRebalancerName, ErrNilSubsystem)
+	}
+	m.m.Lock()
+	resp := make([]RebalanceStrategy, 0, len(m.strategies))
+	for _, s := range m.strategies {
+		resp = append(resp, *s.copy())
+	}
+	m.m.Unlock()
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CreatedDate.Before(resp[j].CreatedDate)
+	})
+	return resp, nil
+}
+
+// Plan values a strategy's holdings and returns the trades required to
+// rebalance it without placing them
+func (m *PortfolioRebalancer) Plan(ctx context.Context, id string) (*RebalancePlan, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", PortfolioRebalancerName, ErrSubSystemNotStarted)
+	}
+	s, err := m.GetStrategy(id)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := m.plan(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	plan.DryRun = true
+	return plan, nil
+}
+
+// Rebalance immediately trades a strategy's holdings back to their target
+// weights regardless of its trigger
+func (m *PortfolioRebalancer) Rebalance(ctx context.Context, id string) (*RebalancePlan, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", PortfolioRebalancerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	stored, ok := m.strategies[id]
+	if !ok {
+		m.m.Unlock()
+		return nil, fmt.Errorf("%w %s", ErrRebalanceStrategyNotFound, id)
+	}
+	if stored.Status != RebalanceActive {
+		m.m.Unlock()
+		return nil, fmt.Errorf("%w %s status %s", errRebalanceStrategyStopped, id, stored.Status)
+	}
+	if stored.rebalancing {
+		m.m.Unlock()
+		return nil, fmt.Errorf("%w %s", errRebalanceInProgress, id)
+	}
+	s := begin(stored)
+	m.m.Unlock()
+
+	plan, err := m.plan(ctx, s)
+	if err != nil {
+		m.finish(id, nil)
+		return nil, err
+	}
+	plan.RebalanceRequired = true
+	m.execute(ctx, s, plan)
+	m.finish(id, plan)
+	return plan.copy(), nil
+}
+
+// checkStrategies rebalances strategies which are due on their schedule or
+// have drifted beyond their tolerance. Strategies are planned and traded
+// outside of the lock so that exchange requests do not block other calls
+func (m *PortfolioRebalancer) checkStrategies(ctx context.Context) {
+	m.m.Lock()
+	now := time.Now()
+	var due []*RebalanceStrategy
+	for _, s := range m.strategies {
+		if s.Status != RebalanceActive || s.rebalancing {
+			continue
+		}
+		if s.Trigger == RebalanceScheduled && s.LastPlan != nil && now.Sub(s.LastPlan.Timestamp) < s.Interval {
+			continue
+		}
+		due = append(due, begin(s))
+	}
+	m.m.Unlock()
+
+	for _, s := range due {
+		plan, err := m.plan(ctx, s)
+		if err != nil {
+			log.Errorf(log.PortfolioMgr, "Portfolio rebalancer unable to plan strategy %s: %v", s.ID, err)
+			m.finish(s.ID, nil)
+			continue
+		}
+		if plan.RebalanceRequired {
+			m.execute(ctx, s, plan)
+		} else if m.verbose {
+			log.Debugf(log.PortfolioMgr, "Portfolio rebalancer strategy %s max drift %.4f within tolerance %.4f",
+				s.ID, plan.MaxDrift, s.DriftTolerance)
+		}
+		m.finish(s.ID, plan)
+	}
+}
+
+// begin marks a strategy as rebalancing and returns a copy of it which can be
+// planned and traded without holding the lock. The lock must be held
+func begin(s *RebalanceStrategy) *RebalanceStrategy {
+	s.rebalancing = true
+	return s.copy()
+}
+
+// finish stores the plan of a strategy marked by begin and allows it to be
+// rebalanced again. A nil plan is not stored
+func (m *PortfolioRebalancer) finish(id string, plan *RebalancePlan) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	s, ok := m.strategies[id]
+	if !ok {
+		return
+	}
+	s.rebalancing = false
+	if plan == nil {
+		return
+	}
+	s.LastPlan = plan
+	if plan.Executed {
+		s.LastRebalanced = plan.Timestamp
+		s.UpdatedDate = plan.Timestamp
+	}
+}
+
+// execute places the trades of a plan through the order manager. Sells are
+// placed before buys so their proceeds can fund the buys
+func (m *PortfolioRebalancer) execute(ctx context.Context, s *RebalanceStrategy, plan *RebalancePlan) {
+	for i := range plan.Trades {
+		t := &plan.Trades[i]
+		if t.Skipped != "" {
+			continue
+		}
+		resp, err := m.orderManager.Submit(ctx, &order.Submit{
+			Exchange:  t.Exchange,
+			Pair:      t.Pair,
+			AssetType: s.Asset,
+			Side:      t.Side,
+			Type:      order.Market,
+			Amount:    t.Amount,
+		})
+		if err != nil {
+			t.Error = err.Error()
+			log.Errorf(log.PortfolioMgr, "Portfolio rebalancer strategy %s unable to %s %v %s on %s: %v",
+				s.ID, t.Side, t.Amount, t.Pair, t.Exchange, err)
+			continue
+		}
+		t.OrderID = resp.OrderID
+		log.Infof(log.PortfolioMgr, "Portfolio rebalancer strategy %s placed %s of %v %s on %s valued at %v %s",
+			s.ID, t.Side, t.Amount, t.Pair, t.Exchange, t.Value, s.SettlementCurrency)
+	}
+	plan.Executed = true
+}
+
+// plan values the holdings of a strategy's target currencies across its
+// exchanges and calculates the trades required to return each to its target
+// weight. Currencies without a target are not valued or traded
+func (m *PortfolioRebalancer) plan(ctx context.Context, s *RebalanceStrategy) (*RebalancePlan, error) {
+	exchanges := make([]exchange.IBotExchange, len(s.Exchanges))
+	for i := range s.Exchanges {
+		exch, err := m.exchangeManager.GetExchangeByName(s.Exchanges[i])
+		if err != nil {
+			return nil, err
+		}
+		exchanges[i] = exch
+	}
+
+	codes := make([]currency.Code, 0, len(s.Targets)+1)
+	weights := make(map[*currency.Item]float64, len(s.Targets)+1)
+	for i := range s.Targets {
+		codes = append(codes, s.Targets[i].Currency)
+		weights[s.Targets[i].Currency.Item] = s.Targets[i].Weight
+	}
+	if _, ok := weights[s.SettlementCurrency.Item]; !ok {
+		codes = append(codes, s.SettlementCurrency)
+		weights[s.SettlementCurrency.Item] = 0
+	}
+
+	balances := make(map[*currency.Item][]rebalanceBalance, len(codes))
+	for i := range exchanges {
+		holdings, err := exchanges[i].UpdateAccountInfo(ctx, s.Asset)
+		if err != nil {
+			return nil, fmt.Errorf("%s unable to retrieve holdings: %w", exchanges[i].GetName(), err)
+		}
+		for _, code := range codes {
+			var b rebalanceBalance
+			for j := range holdings.Accounts {
+				if holdings.Accounts[j].AssetType != s.Asset {
+					continue
+				}
+				for k := range holdings.Accounts[j].Currencies {
+					if holdings.Accounts[j].Currencies[k].Currency.Equal(code) {
+						b.total += holdings.Accounts[j].Currencies[k].Total
+						b.free += holdings.Accounts[j].Currencies[k].Free
+					}
+				}
+			}
+			balances[code.Item] = append(balances[code.Item], b)
+		}
+	}
+
+	plan := &RebalancePlan{
+		StrategyID:         s.ID,
+		SettlementCurrency: s.SettlementCurrency,
+		Holdings:           make([]RebalanceHolding, len(codes)),
+		Timestamp:          time.Now(),
+	}
+	for i, code := range codes {
+		h := &plan.Holdings[i]
+		h.Currency = code
+		h.TargetWeight = weights[code.Item]
+		for _, b := range balances[code.Item] {
+			h.Amount += b.total
+		}
+		price, err := priceInCurrency(ctx, exchanges, s.Asset, code, s.SettlementCurrency)
+		if err != nil {
+			if h.Amount == 0 && h.TargetWeight == 0 {
+				continue
+			}
+			return nil, err
+		}
+		h.Price = price
+		h.Value = h.Amount * price
+		plan.TotalValue += h.Value
+	}
+	if plan.TotalValue <= 0 {
+		return nil, fmt.Errorf("%w for strategy %s", errNoRebalanceHoldings, s.ID)
+	}
+	for i := range plan.Holdings {
+		h := &plan.Holdings[i]
+		h.Weight = h.Value / plan.TotalValue
+		h.Drift = h.Weight - h.TargetWeight
+		plan.MaxDrift = math.Max(plan.MaxDrift, math.Abs(h.Drift))
+	}
+	plan.RebalanceRequired = plan.MaxDrift > s.DriftTolerance
+
+	// Sells are planned first and their expected proceeds are added to the
+	// free settlement balance of their exchange so that they can fund buys.
+	// Each planned buy spends the free settlement balance available to the
+	// next
+	settlement := balances[s.SettlementCurrency.Item]
+	for _, sells := range []bool{true, false} {
+		for i := range plan.Holdings {
+			h := &plan.Holdings[i]
+			if h.Currency.Equal(s.SettlementCurrency) {
+				continue
+			}
+			difference := h.TargetWeight*plan.TotalValue - h.Value
+			if difference == 0 || h.Price <= 0 || (difference < 0) != sells {
+				continue
+			}
+			t := rebalanceTrade(exchanges, s, h, difference, balances[h.Currency.Item], settlement)
+			if t.Skipped == "" {
+				for j := range exchanges {
+					if exchanges[j].GetName() != t.Exchange {
+						continue
+					}
+					if sells {
+						settlement[j].free += t.Value
+					} else {
+						settlement[j].free = math.Max(0, settlement[j].free-t.Value)
+					}
+					break
+				}
+			}
+			plan.Trades = append(plan.Trades, t)
+		}
+	}
+	return plan, nil
+}
+
+// rebalanceTrade returns the order which moves a holding's value by the
+// difference provided, trading against the settlement currency on the
+// exchange with the most of the currency being sold. The amount is capped to
+// the free balance on that exchange, including the expected proceeds of
+// planned sells less the value of planned buys, and conformed to its
+// execution limits
+func rebalanceTrade(exchanges []exchange.IBotExchange, s *RebalanceStrategy, h *RebalanceHolding, difference float64, holding, settlement []rebalanceBalance) RebalanceTrade {
+	sellHolding := difference < 0
+	value := math.Abs(difference)
+	var (
+		t         RebalanceTrade
+		available float64
+		inverse   bool
+		found     bool
+	)
+	for i := range exchanges {
+		pair, inv, err := findRebalancePair(exchanges[i], s.Asset, h.Currency, s.SettlementCurrency)
+		if err != nil {
+			continue
+		}
+		// Value of the currency being sold which is free to trade
+		free := settlement[i].free
+		if sellHolding {
+			free = holding[i].free * h.Price
+		}
+		if found && free <= available {
+			continue
+		}
+		found = true
+		available = free
+		inverse = inv
+		t.Exchange = exchanges[i].GetName()
+		t.Pair = pair
+	}
+	if !found {
+		return RebalanceTrade{
+			Side:    rebalanceSide(sellHolding, false),
+			Value:   value,
+			Pair:    currency.NewPair(h.Currency, s.SettlementCurrency),
+			Skipped: "no enabled pair against the settlement currency",
+		}
+	}
+	t.Side = rebalanceSide(sellHolding, inverse)
+	value = math.Min(value, available)
+	if inverse {
+		// The base of the pair is the settlement currency
+		t.Price = 1 / h.Price
+		t.Amount = value
+	} else {
+		t.Price = h.Price
+		t.Amount = value / h.Price
+	}
+
+	exch := exchanges[0]
+	for i := range exchanges {
+		if exchanges[i].GetName() == t.Exchange {
+			exch = exchanges[i]
+			break
+		}
+	}
+	limits, err := exch.GetOrderExecutionLimits(s.Asset, t.Pair)
+	if err == nil {
+		if limits.MaximumBaseAmount > 0 && t.Amount > limits.MaximumBaseAmount {
+			t.Amount = limits.MaximumBaseAmount
+		}
+		t.Amount = conformRebalanceAmount(t.Amount, limits.AmountStepIncrementSize)
+	}
+	t.Value = t.Amount * t.Price
+	if inverse {
+		t.Value = t.Amount
+	}
+	switch {
+	case t.Amount <= 0:
+		t.Skipped = "no free balance to trade"
+	case t.Amount < limits.MinimumBaseAmount:
+		t.Skipped = fmt.Sprintf("amount %v below minimum %v", t.Amount, limits.MinimumBaseAmount)
+	case limits.MinNotional > 0 && t.Amount*t.Price < limits.MinNotional:
+		t.Skipped = fmt.Sprintf("notional %v below minimum %v", t.Amount*t.Price, limits.MinNotional)
+	}
+	return t
+}
+
+// rebalanceSide returns the order side which sells or buys a holding
+// depending on whether it is the base or quote of the traded pair
+func rebalanceSide(sellHolding, inverse bool) order.Side {
+	if sellHolding != inverse {
+		return order.Sell
+	}
+	return order.Buy
+}
+
+// findRebalancePair returns the enabled pair trading a currency against the
+// settlement currency and whether the settlement currency is its base
+func findRebalancePair(exch exchange.IBotExchange, a asset.Item, code, settlement currency.Code) (currency.Pair, bool, error) {
+	pairs, err := exch.GetEnabledPairs(a)
+	if err != nil {
+		return currency.EMPTYPAIR, false, err
+	}
+	for i := range pairs {
+		if pairs[i].Base.Equal(code) && pairs[i].Quote.Equal(settlement) {
+			return pairs[i], false, nil
+		}
+		if pairs[i].Base.Equal(settlement) && pairs[i].Quote.Equal(code) {
+			return pairs[i], true, nil
+		}
+	}
+	return currency.EMPTYPAIR, false, currency.ErrPairNotFound
+}
+
+// priceInCurrency values one unit of a currency in the settlement currency.
+// A ticker for a pair of the two currencies is used when one is enabled on
+// any of the exchanges, otherwise fiat currencies and pairs quoted in fiat
+// are converted using foreign exchange rates
+func priceInCurrency(ctx context.Context, exchanges []exchange.IBotExchange, a asset.Item, code, settlement currency.Code) (float64, error) {
+	if code.Equal(settlement) {
+		return 1, nil
+	}
+	for i := range exchanges {
+		pair, inverse, err := findRebalancePair(exchanges[i], a, code, settlement)
+		if err != nil {
+			continue
+		}
+		tick, err := exchanges[i].FetchTicker(ctx, pair, a)
+		if err != nil || tick.Last <= 0 {
+			continue
+		}
+		if inverse {
+			return 1 / tick.Last, nil
+		}
+		return tick.Last, nil
+	}
+	if !settlement.IsFiatCurrency() {
+		return 0, fmt.Errorf("%w %s in %s", errNoRebalancePrice, code, settlement)
+	}
+	if code.IsFiatCurrency() {
+		rate, err := currency.ConvertFiat(1, code, settlement)
+		if err != nil {
+			return 0, fmt.Errorf("%w %s in %s: %v", errNoRebalancePrice, code, settlement, err)
+		}
+		return rate, nil
+	}
+	for i := range exchanges {
+		pairs, err := exchanges[i].GetEnabledPairs(a)
+		if err != nil {
+			continue
+		}
+		for j := range pairs {
+			if !pairs[j].Base.Equal(code) || !pairs[j].Quote.IsFiatCurrency() {
+				continue
+			}
+			tick, err := exchanges[i].FetchTicker(ctx, pairs[j], a)
+			if err != nil || tick.Last <= 0 {
+				continue
+			}
+			rate, err := currency.ConvertFiat(tick.Last, pairs[j].Quote, settlement)
+			if err != nil {
+				continue
+			}
+			return rate, nil
+		}
+	}
+	return 0, fmt.Errorf("%w %s in %s", errNoRebalancePrice, code, settlement)
+}
+
+// conformRebalanceAmount rounds an amount down to the exchange's amount step
+// so that it never exceeds the balance it was derived from
+func conformRebalanceAmount(amount, step float64) float64 {
+	if step <= 0 {
+		return amount
+	}
+	f, _ := decimal.NewFromFloat(amount).Div(decimal.NewFromFloat(step)).Floor().Mul(decimal.NewFromFloat(step)).Float64()
+	return f
+}
+
+// prepare validates a strategy and assigns its defaults
+func (m *PortfolioRebalancer) prepare(s *RebalanceStrategy) (*RebalanceStrategy, error) {
+	if s == nil {
+		return nil, errNilRebalanceStrategy
+	}
+	strategy := s.copy()
+	if len(strategy.Exchanges) == 0 {
+		return nil, errNoRebalanceExchanges
+	}
+	for i := range strategy.Exchanges {
+		exch, err := m.exchangeManager.GetExchangeByName(strategy.Exchanges[i])
+		if err != nil {
+			return nil, err
+		}
+		strategy.Exchanges[i] = exch.GetName()
+	}
+	if strategy.Asset == asset.Empty {
+		strategy.Asset = asset.Spot
+	}
+	if strategy.SettlementCurrency.IsEmpty() {
+		return nil, fmt.Errorf("settlement %w", currency.ErrCurrencyCodeEmpty)
+	}
+	if len(strategy.Targets) == 0 {
+		return nil, errNoRebalanceTargets
+	}
+	var sum float64
+	seen := make(map[*currency.Item]bool, len(strategy.Targets))
+	for i := range strategy.Targets {
+		if strategy.Targets[i].Currency.IsEmpty() {
+			return nil, fmt.Errorf("target %w", currency.ErrCurrencyCodeEmpty)
+		}
+		if strategy.Targets[i].Weight <= 0 {
+			return nil, fmt.Errorf("%w %s: %v", errInvalidTargetWeight, strategy.Targets[i].Currency, strategy.Targets[i].Weight)
+		}
+		if seen[strategy.Targets[i].Currency.Item] {
+			return nil, fmt.Errorf("%w %s", errDuplicateTargetCurrency, strategy.Targets[i].Currency)
+		}
+		seen[strategy.Targets[i].Currency.Item] = true
+		sum += strategy.Targets[i].Weight
+	}
+	if math.Abs(sum-1) > rebalanceWeightTolerance {
+		return nil, fmt.Errorf("%w, received %v", errTargetWeightsSum, sum)
+	}
+	if strategy.DriftTolerance < 0 || strategy.DriftTolerance >= 1 {
+		return nil, fmt.Errorf("%w, received %v", errInvalidDriftTolerance, strategy.DriftTolerance)
+	}
+	switch strategy.Trigger {
+	case RebalanceThreshold:
+	case RebalanceScheduled:
+		if strategy.Interval <= 0 {
+			return nil, errInvalidRebalanceInterval
+		}
+	default:
+		return nil, fmt.Errorf("%w, received %q", errInvalidRebalanceTrigger, strategy.Trigger)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	strategy.ID = id.String()
+	strategy.Status = RebalanceActive
+	strategy.Reason = ""
+	strategy.LastPlan = nil
+	strategy.LastRebalanced = time.Time{}
+	strategy.CreatedDate = now
+	strategy.UpdatedDate = now
+	return strategy, nil
+}
+
+// copy returns a deep copy of the strategy
+func (s *RebalanceStrategy) copy() *RebalanceStrategy {
+	c := *s
+	c.Exchanges = append([]string(nil), s.Exchanges...)
+	c.Targets = append([]RebalanceTarget(nil), s.Targets...)
+	if s.LastPlan != nil {
+		c.LastPlan = s.LastPlan.copy()
+	}
+	return &c
+}
+
+// copy returns a deep copy of the plan
+func (p *RebalancePlan) copy() *RebalancePlan {
+	c := *p
+	c.Holdings = append([]RebalanceHolding(nil), p.Holdings...)
+	c.Trades = append([]RebalanceTrade(nil), p.Trades...)
+	return &c
+}
@@ -0,0 +1,422 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var (
+	btcusdtPair = currency.NewPair(currency.BTC, currency.USDT)
+	ethusdtPair = currency.NewPair(currency.ETH, currency.USDT)
+)
+
+// rebalanceTestExchange overrides holdings, tickers, enabled pairs and
+// execution limits
+type rebalanceTestExchange struct {
+	exchange.IBotExchange
+	name     string
+	m        sync.Mutex
+	balances []account.Balance
+	prices   map[currency.Pair]float64
+	limits   order.MinMaxLevel
+}
+
+func (e *rebalanceTestExchange) GetName() string {
+	return e.name
+}
+
+func (e *rebalanceTestExchange) UpdateAccountInfo(context.Context, asset.Item) (account.Holdings, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return account.Holdings{
+		Exchange: e.name,
+		Accounts: []account.SubAccount{{
+			AssetType:  asset.Spot,
+			Currencies: append([]account.Balance(nil), e.balances...),
+		}},
+	}, nil
+}
+
+func (e *rebalanceTestExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	pairs := make(currency.Pairs, 0, len(e.prices))
+	for p := range e.prices {
+		pairs = append(pairs, p)
+	}
+	return pairs, nil
+}
+
+func (e *rebalanceTestExchange) FetchTicker(_ context.Context, p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	price, ok := e.prices[p]
+	if !ok {
+		return nil, currency.ErrPairNotFound
+	}
+	return &ticker.Price{ExchangeName: e.name, Pair: p, AssetType: a, Last: price}, nil
+}
+
+func (e *rebalanceTestExchange) GetOrderExecutionLimits(asset.Item, currency.Pair) (order.MinMaxLevel, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.limits, nil
+}
+
+func (e *rebalanceTestExchange) setLimits(l order.MinMaxLevel) {
+	e.m.Lock()
+	e.limits = l
+	e.m.Unlock()
+}
+
+// setupRebalanceTest returns a running rebalancer with an exchange holding
+// 1 BTC at 30000, 10 ETH at 2000 and 1000 USDT for a total of 51000 USDT
+func setupRebalanceTest(t *testing.T, name string) (*PortfolioRebalancer, *fakeAlgoOrderManager, *rebalanceTestExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	wrapped := &rebalanceTestExchange{
+		IBotExchange: exch,
+		name:         name,
+		balances: []account.Balance{
+			{Currency: currency.BTC, Total: 1, Free: 1},
+			{Currency: currency.ETH, Total: 10, Free: 10},
+			{Currency: currency.USDT, Total: 1000, Free: 1000},
+		},
+		prices: map[currency.Pair]float64{
+			btcusdtPair: 30000,
+			ethusdtPair: 2000,
+		},
+		limits: order.MinMaxLevel{AmountStepIncrementSize: 0.001},
+	}
+	require.NoError(t, em.Add(wrapped), "Add must not error")
+	om := &fakeAlgoOrderManager{orders: make(map[string]*order.Detail)}
+	m, err := SetupPortfolioRebalancer(em, om, &config.PortfolioRebalancer{CheckInterval: time.Hour})
+	require.NoError(t, err, "SetupPortfolioRebalancer must not error")
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() {
+		if m.IsRunning() {
+			assert.NoError(t, m.Stop(), "Stop should not error")
+		}
+	})
+	return m, om, wrapped
+}
+
+func rebalanceTestStrategy(exch string) *RebalanceStrategy {
+	return &RebalanceStrategy{
+		Exchanges:          []string{exch},
+		SettlementCurrency: currency.USDT,
+		Targets: []RebalanceTarget{
+			{Currency: currency.BTC, Weight: 0.5},
+			{Currency: currency.ETH, Weight: 0.4},
+			{Currency: currency.USDT, Weight: 0.1},
+		},
+		DriftTolerance: 0.05,
+		Trigger:        RebalanceThreshold,
+	}
+}
+
+func TestSetupPortfolioRebalancer(t *testing.T) {
+	t.Parallel()
+	_, err := SetupPortfolioRebalancer(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupPortfolioRebalancer should error on nil exchange manager")
+	_, err = SetupPortfolioRebalancer(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager, "SetupPortfolioRebalancer should error on nil order manager")
+	_, err = SetupPortfolioRebalancer(NewExchangeManager(), &OrderManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig, "SetupPortfolioRebalancer should error on nil config")
+	m, err := SetupPortfolioRebalancer(NewExchangeManager(), &OrderManager{}, &config.PortfolioRebalancer{})
+	require.NoError(t, err, "SetupPortfolioRebalancer must not error")
+	assert.Equal(t, config.DefaultRebalancerCheckInterval, m.checkInterval, "checkInterval should default")
+}
+
+func TestPortfolioRebalancerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *PortfolioRebalancer
+	assert.False(t, m.IsRunning(), "IsRunning should return false on nil rebalancer")
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem, "Start should error on nil rebalancer")
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem, "Stop should error on nil rebalancer")
+
+	m, err := SetupPortfolioRebalancer(NewExchangeManager(), &OrderManager{}, &config.PortfolioRebalancer{})
+	require.NoError(t, err, "SetupPortfolioRebalancer must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted, "Stop should error when not started")
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted, "Start should error when already started")
+	assert.NoError(t, m.Stop(), "Stop should not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false after Stop")
+}
+
+func TestPortfolioRebalancerAdd(t *testing.T) {
+	t.Parallel()
+	m, _, _ := setupRebalanceTest(t, "rebaladd")
+
+	_, err := m.Add(nil)
+	assert.ErrorIs(t, err, errNilRebalanceStrategy, "Add should error on nil strategy")
+	for _, tc := range []struct {
+		name   string
+		modify func(*RebalanceStrategy)
+		err    error
+	}{
+		{"exchanges", func(s *RebalanceStrategy) { s.Exchanges = nil }, errNoRebalanceExchanges},
+		{"exchange", func(s *RebalanceStrategy) { s.Exchanges = []string{"meow"} }, ErrExchangeNotFound},
+		{"settlement", func(s *RebalanceStrategy) { s.SettlementCurrency = currency.EMPTYCODE }, currency.ErrCurrencyCodeEmpty},
+		{"targets", func(s *RebalanceStrategy) { s.Targets = nil }, errNoRebalanceTargets},
+		{"target currency", func(s *RebalanceStrategy) { s.Targets[0].Currency = currency.EMPTYCODE }, currency.ErrCurrencyCodeEmpty},
+		{"weight", func(s *RebalanceStrategy) { s.Targets[0].Weight = 0 }, errInvalidTargetWeight},
+		{"duplicate", func(s *RebalanceStrategy) { s.Targets[1].Currency = currency.BTC }, errDuplicateTargetCurrency},
+		{"sum", func(s *RebalanceStrategy) { s.Targets[0].Weight = 0.6 }, errTargetWeightsSum},
+		{"tolerance", func(s *RebalanceStrategy) { s.DriftTolerance = 1 }, errInvalidDriftTolerance},
+		{"trigger", func(s *RebalanceStrategy) { s.Trigger = "meow" }, errInvalidRebalanceTrigger},
+		{"interval", func(s *RebalanceStrategy) { s.Trigger = RebalanceScheduled }, errInvalidRebalanceInterval},
+	} {
+		s := rebalanceTestStrategy("rebaladd")
+		tc.modify(s)
+		_, err = m.Add(s)
+		assert.ErrorIsf(t, err, tc.err, "Add should error on invalid %s", tc.name)
+	}
+
+	id, err := m.Add(rebalanceTestStrategy("rebaladd"))
+	require.NoError(t, err, "Add must not error")
+	s, err := m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	assert.Equal(t, RebalanceActive, s.Status, "Status should be active")
+	assert.Equal(t, asset.Spot, s.Asset, "Asset should default to spot")
+	assert.False(t, s.CreatedDate.IsZero(), "CreatedDate should be set")
+
+	_, err = m.GetStrategy("1337")
+	assert.ErrorIs(t, err, ErrRebalanceStrategyNotFound, "GetStrategy should error on unknown id")
+	strategies, err := m.GetStrategies()
+	require.NoError(t, err, "GetStrategies must not error")
+	assert.Len(t, strategies, 1, "GetStrategies should return the strategy")
+
+	require.NoError(t, m.Stop(), "Stop must not error")
+	_, err = m.Add(rebalanceTestStrategy("rebaladd"))
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "Add should error when not started")
+}
+
+func TestPortfolioRebalancerPlan(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupRebalanceTest(t, "rebalplan")
+	id, err := m.Add(rebalanceTestStrategy("rebalplan"))
+	require.NoError(t, err, "Add must not error")
+
+	_, err = m.Plan(context.Background(), "1337")
+	assert.ErrorIs(t, err, ErrRebalanceStrategyNotFound, "Plan should error on unknown id")
+	plan, err := m.Plan(context.Background(), id)
+	require.NoError(t, err, "Plan must not error")
+	assert.True(t, plan.DryRun, "DryRun should be set")
+	assert.False(t, plan.Executed, "Plan should not be executed")
+	assert.Equal(t, 51000.0, plan.TotalValue, "TotalValue should sum the valued holdings")
+	require.Len(t, plan.Holdings, 3, "Holdings must include each target currency")
+	assert.InDelta(t, 30000.0/51000, plan.Holdings[0].Weight, 1e-9, "BTC weight should be correct")
+	assert.InDelta(t, 30000.0/51000-0.5, plan.MaxDrift, 1e-9, "MaxDrift should be the largest absolute drift")
+	assert.True(t, plan.RebalanceRequired, "RebalanceRequired should be set when drift exceeds the tolerance")
+	require.Len(t, plan.Trades, 2, "Trades must be planned for each non settlement holding")
+	assert.Equal(t, order.Sell, plan.Trades[0].Side, "Sells should be ordered before buys")
+	assert.True(t, plan.Trades[0].Pair.Equal(btcusdtPair), "Overweight BTC should be sold")
+	assert.InDelta(t, 0.15, plan.Trades[0].Amount, 1e-9, "BTC sell amount should return it to its target value")
+	assert.Equal(t, order.Buy, plan.Trades[1].Side, "Underweight ETH should be bought")
+	assert.InDelta(t, 0.2, plan.Trades[1].Amount, 1e-9, "ETH buy amount should return it to its target value")
+	assert.Empty(t, om.getSubmitted(), "Plan should not submit orders")
+	s, err := m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	assert.Nil(t, s.LastPlan, "Dry runs should not be stored")
+}
+
+func TestPortfolioRebalancerRebalance(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupRebalanceTest(t, "rebalexec")
+	id, err := m.Add(rebalanceTestStrategy("rebalexec"))
+	require.NoError(t, err, "Add must not error")
+
+	_, err = m.Rebalance(context.Background(), "1337")
+	assert.ErrorIs(t, err, ErrRebalanceStrategyNotFound, "Rebalance should error on unknown id")
+	plan, err := m.Rebalance(context.Background(), id)
+	require.NoError(t, err, "Rebalance must not error")
+	assert.True(t, plan.Executed, "Plan should be executed")
+	submitted := om.getSubmitted()
+	require.Len(t, submitted, 2, "Submit must be called for each trade")
+	assert.Equal(t, order.Sell, submitted[0].Side, "Sells should be submitted first")
+	assert.Equal(t, order.Market, submitted[0].Type, "Rebalance orders should be market orders")
+	assert.Equal(t, "1", plan.Trades[0].OrderID, "OrderID should be recorded")
+
+	s, err := m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	require.NotNil(t, s.LastPlan, "LastPlan must be stored")
+	assert.Equal(t, plan.Timestamp, s.LastRebalanced, "LastRebalanced should be the plan time")
+
+	om.m.Lock()
+	om.fail = true
+	om.m.Unlock()
+	plan, err = m.Rebalance(context.Background(), id)
+	require.NoError(t, err, "Rebalance must not error when orders fail")
+	assert.NotEmpty(t, plan.Trades[0].Error, "Failed orders should record their error")
+
+	om.m.Lock()
+	om.fail = false
+	om.m.Unlock()
+	m.m.Lock()
+	m.strategies[id].rebalancing = true
+	m.m.Unlock()
+	_, err = m.Rebalance(context.Background(), id)
+	assert.ErrorIs(t, err, errRebalanceInProgress, "Rebalance should error when the strategy is already rebalancing")
+	m.checkStrategies(context.Background())
+	assert.Len(t, om.getSubmitted(), 2, "checkStrategies should skip strategies which are already rebalancing")
+}
+
+func TestPortfolioRebalancerSellProceeds(t *testing.T) {
+	t.Parallel()
+	m, _, exch := setupRebalanceTest(t, "rebalproceeds")
+	exch.m.Lock()
+	exch.balances[2].Free = 0
+	exch.m.Unlock()
+	id, err := m.Add(rebalanceTestStrategy("rebalproceeds"))
+	require.NoError(t, err, "Add must not error")
+
+	plan, err := m.Plan(context.Background(), id)
+	require.NoError(t, err, "Plan must not error")
+	require.Len(t, plan.Trades, 2, "Trades must be planned")
+	assert.Equal(t, order.Sell, plan.Trades[0].Side, "Sells should be planned before buys")
+	assert.Empty(t, plan.Trades[1].Skipped, "Buys should be funded by the proceeds of sells")
+	assert.InDelta(t, 0.2, plan.Trades[1].Amount, 1e-9, "ETH buy amount should not be capped by the free settlement balance")
+
+	s := rebalanceTestStrategy("rebalproceeds")
+	s.Targets = []RebalanceTarget{
+		{Currency: currency.BTC, Weight: 0.4},
+		{Currency: currency.ETH, Weight: 0.6},
+	}
+	id, err = m.Add(s)
+	require.NoError(t, err, "Add must not error")
+	plan, err = m.Plan(context.Background(), id)
+	require.NoError(t, err, "Plan must not error")
+	require.Len(t, plan.Trades, 2, "Trades must be planned")
+	assert.InDelta(t, 9600, plan.Trades[0].Value, 1e-9, "BTC sell value should match")
+	assert.InDelta(t, 9600.0/2000, plan.Trades[1].Amount, 1e-9, "ETH buy should be capped to the sell proceeds")
+}
+
+func TestPortfolioRebalancerBuysShareSettlement(t *testing.T) {
+	t.Parallel()
+	m, _, exch := setupRebalanceTest(t, "rebalshared")
+	exch.m.Lock()
+	exch.balances[2].Total = 10000
+	exch.balances[2].Free = 8000
+	exch.m.Unlock()
+	s := rebalanceTestStrategy("rebalshared")
+	s.Targets = []RebalanceTarget{
+		{Currency: currency.BTC, Weight: 0.6},
+		{Currency: currency.ETH, Weight: 0.4},
+	}
+	id, err := m.Add(s)
+	require.NoError(t, err, "Add must not error")
+
+	plan, err := m.Plan(context.Background(), id)
+	require.NoError(t, err, "Plan must not error")
+	require.Len(t, plan.Trades, 2, "Trades must be planned")
+	assert.Equal(t, order.Buy, plan.Trades[0].Side, "BTC should be bought")
+	assert.InDelta(t, 0.2, plan.Trades[0].Amount, 1e-9, "BTC buy should not be capped")
+	assert.Equal(t, order.Buy, plan.Trades[1].Side, "ETH should be bought")
+	assert.InDelta(t, 1, plan.Trades[1].Amount, 1e-9, "ETH buy should be capped to the settlement balance left by the BTC buy")
+}
+
+func TestPortfolioRebalancerCheckStrategies(t *testing.T) {
+	t.Parallel()
+	m, om, _ := setupRebalanceTest(t, "rebalcheck")
+	s := rebalanceTestStrategy("rebalcheck")
+	s.DriftTolerance = 0.1
+	_, err := m.Add(s)
+	require.NoError(t, err, "Add must not error")
+	m.checkStrategies(context.Background())
+	assert.Empty(t, om.getSubmitted(), "Drift within the tolerance should not rebalance")
+
+	s.Trigger = RebalanceScheduled
+	s.Interval = time.Hour
+	id, err := m.Add(s)
+	require.NoError(t, err, "Add must not error")
+	m.checkStrategies(context.Background())
+	assert.Empty(t, om.getSubmitted(), "Scheduled strategies should not rebalance drift within the tolerance")
+	stored, err := m.GetStrategy(id)
+	require.NoError(t, err, "GetStrategy must not error")
+	require.NotNil(t, stored.LastPlan, "LastPlan must be stored when drift is checked")
+	assert.True(t, stored.LastRebalanced.IsZero(), "LastRebalanced should not be set without trading")
+
+	s.DriftTolerance = 0.05
+	id, err = m.Add(s)
+	require.NoError(t, err, "Add must not error")
+	m.checkStrategies(context.Background())
+	assert.Len(t, om.getSubmitted(), 2, "Scheduled strategies should rebalance when due and drifted")
+	m.checkStrategies(context.Background())
+	assert.Len(t, om.getSubmitted(), 2, "Scheduled strategies should not rebalance before the interval elapses")
+
+	require.NoError(t, m.Remove(id), "Remove must not error")
+	assert.ErrorIs(t, m.Remove(id), errRebalanceStrategyStopped, "Remove should error on a stopped strategy")
+	assert.ErrorIs(t, m.Remove("1337"), ErrRebalanceStrategyNotFound, "Remove should error on unknown id")
+	_, err = m.Rebalance(context.Background(), id)
+	assert.ErrorIs(t, err, errRebalanceStrategyStopped, "Rebalance should error on a stopped strategy")
+}
+
+func TestPortfolioRebalancerLimits(t *testing.T) {
+	t.Parallel()
+	m, _, exch := setupRebalanceTest(t, "reballimits")
+	id, err := m.Add(rebalanceTestStrategy("reballimits"))
+	require.NoError(t, err, "Add must not error")
+
+	exch.setLimits(order.MinMaxLevel{MinimumBaseAmount: 0.18, MaximumBaseAmount: 0.1})
+	plan, err := m.Plan(context.Background(), id)
+	require.NoError(t, err, "Plan must not error")
+	require.Len(t, plan.Trades, 2, "Trades must be planned")
+	assert.Equal(t, 0.1, plan.Trades[0].Amount, "Amount should be capped to the maximum")
+	assert.NotEmpty(t, plan.Trades[0].Skipped, "Trades below the minimum amount should be skipped")
+
+	exch.setLimits(order.MinMaxLevel{MinNotional: 1000})
+	plan, err = m.Plan(context.Background(), id)
+	require.NoError(t, err, "Plan must not error")
+	assert.Empty(t, plan.Trades[0].Skipped, "Trades above the minimum notional should not be skipped")
+	assert.NotEmpty(t, plan.Trades[1].Skipped, "Trades below the minimum notional should be skipped")
+
+	s := rebalanceTestStrategy("reballimits")
+	s.Targets = []RebalanceTarget{{Currency: currency.LTC, Weight: 1}}
+	id, err = m.Add(s)
+	require.NoError(t, err, "Add must not error")
+	_, err = m.Plan(context.Background(), id)
+	assert.ErrorIs(t, err, errNoRebalancePrice, "Plan should error when a target cannot be valued")
+}
+
+func TestFindRebalancePair(t *testing.T) {
+	t.Parallel()
+	exch := &rebalanceTestExchange{prices: map[currency.Pair]float64{
+		currency.NewPair(currency.USDT, currency.EUR): 0.9,
+	}}
+	p, inverse, err := findRebalancePair(exch, asset.Spot, currency.EUR, currency.USDT)
+	require.NoError(t, err, "findRebalancePair must not error")
+	assert.True(t, inverse, "Pairs based in the settlement currency should be inverse")
+	assert.Equal(t, currency.USDT, p.Base, "Pair should be returned")
+	_, _, err = findRebalancePair(exch, asset.Spot, currency.BTC, currency.USDT)
+	assert.ErrorIs(t, err, currency.ErrPairNotFound, "findRebalancePair should error without a pair")
+
+	price, err := priceInCurrency(context.Background(), []exchange.IBotExchange{exch}, asset.Spot, currency.EUR, currency.USDT)
+	require.NoError(t, err, "priceInCurrency must not error")
+	assert.InDelta(t, 1/0.9, price, 1e-9, "Inverse pairs should be priced by their reciprocal")
+	assert.Equal(t, order.Buy, rebalanceSide(true, true), "Selling the quote should buy an inverse pair")
+	assert.Equal(t, order.Sell, rebalanceSide(true, false), "Selling the base should sell the pair")
+}
+
+func TestConformRebalanceAmount(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 0.123456, conformRebalanceAmount(0.123456, 0), "Amount should be unchanged without a step")
+	assert.Equal(t, 0.3, conformRebalanceAmount(0.3, 0.1), "Amounts on a step should be unchanged")
+	assert.Equal(t, 0.12, conformRebalanceAmount(0.12999, 0.01), "Amounts should be rounded down")
+}
@@ -0,0 +1,158 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// PortfolioRebalancerName is an exported subsystem name
+const PortfolioRebalancerName = "portfolio_rebalancer"
+
+// rebalanceWeightTolerance is the allowed rounding error when target weights
+// are summed
+const rebalanceWeightTolerance = 1e-9
+
+var (
+	// ErrRebalanceStrategyNotFound is returned when a rebalance strategy does
+	// not exist
+	ErrRebalanceStrategyNotFound = errors.New("rebalance strategy not found")
+
+	errNilRebalanceStrategy     = errors.New("nil rebalance strategy received")
+	errNoRebalanceExchanges     = errors.New("at least one exchange is required")
+	errNoRebalanceTargets       = errors.New("at least one target allocation is required")
+	errInvalidTargetWeight      = errors.New("target weights must be greater than zero")
+	errDuplicateTargetCurrency  = errors.New("target currency is duplicated")
+	errTargetWeightsSum         = errors.New("target weights must sum to one")
+	errInvalidDriftTolerance    = errors.New("drift tolerance must be between zero and one")
+	errInvalidRebalanceTrigger  = errors.New("rebalance trigger is not supported, supported triggers: SCHEDULED, THRESHOLD")
+	errInvalidRebalanceInterval = errors.New("scheduled rebalancing requires an interval greater than zero")
+	errNoRebalancePrice         = errors.New("unable to value currency")
+	errNoRebalanceHoldings      = errors.New("no holdings to rebalance")
+	errRebalanceStrategyStopped = errors.New("rebalance strategy is not active")
+	errRebalanceInProgress      = errors.New("rebalance strategy is already rebalancing")
+)
+
+// RebalanceTrigger defines when a strategy rebalances
+type RebalanceTrigger string
+
+// Supported rebalance triggers
+const (
+	// RebalanceScheduled checks drift every interval and rebalances when any
+	// holding has drifted by more than the drift tolerance
+	RebalanceScheduled RebalanceTrigger = "SCHEDULED"
+	// RebalanceThreshold rebalances when any holding drifts from its target
+	// weight by more than the drift tolerance
+	RebalanceThreshold RebalanceTrigger = "THRESHOLD"
+)
+
+// RebalanceStatus defines the lifecycle state of a rebalance strategy
+type RebalanceStatus string
+
+// Rebalance strategy statuses
+const (
+	RebalanceActive  RebalanceStatus = "ACTIVE"
+	RebalanceStopped RebalanceStatus = "STOPPED"
+)
+
+// PortfolioRebalancer values holdings across exchanges and trades them back
+// towards target allocations when they drift, either on a schedule or when
+// drift exceeds a tolerance band
+type PortfolioRebalancer struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iOrderSubmitter
+	strategies      map[string]*RebalanceStrategy
+	checkInterval   time.Duration
+	verbose         bool
+	m               sync.Mutex
+}
+
+// RebalanceStrategy defines target allocations for holdings of an asset
+// across one or more exchanges
+type RebalanceStrategy struct {
+	ID        string
+	Exchanges []string
+	Asset     asset.Item
+	// SettlementCurrency values holdings and is the currency every trade is
+	// placed against
+	SettlementCurrency currency.Code
+	Targets            []RebalanceTarget
+	// DriftTolerance is the absolute difference between a holding's weight
+	// and its target weight allowed before a rebalance is triggered, for
+	// example 0.05 allows a 50% target to range from 45% to 55%
+	DriftTolerance float64
+	Trigger        RebalanceTrigger
+	// Interval is the duration between scheduled drift checks
+	Interval       time.Duration
+	Status         RebalanceStatus
+	Reason         string
+	LastPlan       *RebalancePlan
+	LastRebalanced time.Time
+	CreatedDate    time.Time
+	UpdatedDate    time.Time
+	rebalancing    bool
+}
+
+// RebalanceTarget is the portion of a portfolio's value to hold in a currency
+type RebalanceTarget struct {
+	Currency currency.Code
+	Weight   float64
+}
+
+// RebalancePlan is the valuation of a strategy's holdings and the trades
+// required to return them to their target weights
+type RebalancePlan struct {
+	StrategyID         string
+	SettlementCurrency currency.Code
+	TotalValue         float64
+	Holdings           []RebalanceHolding
+	// MaxDrift is the largest absolute difference between a holding's weight
+	// and its target weight
+	MaxDrift float64
+	// RebalanceRequired is set when the strategy's trigger conditions are met
+	RebalanceRequired bool
+	Trades            []RebalanceTrade
+	DryRun            bool
+	Executed          bool
+	Timestamp         time.Time
+}
+
+// RebalanceHolding is the value of a currency held across a strategy's
+// exchanges
+type RebalanceHolding struct {
+	Currency     currency.Code
+	Amount       float64
+	Price        float64
+	Value        float64
+	Weight       float64
+	TargetWeight float64
+	Drift        float64
+}
+
+// RebalanceTrade is an order required to move a holding towards its target.
+// Trades which cannot be placed record why they were skipped
+type RebalanceTrade struct {
+	Exchange string
+	Pair     currency.Pair
+	Side     order.Side
+	Amount   float64
+	Price    float64
+	// Value is the trade's value in the settlement currency
+	Value   float64
+	OrderID string
+	Skipped string
+	Error   string
+}
+
+// rebalanceBalance is a currency's holdings on a single exchange
+type rebalanceBalance struct {
+	total float64
+	free  float64
+}
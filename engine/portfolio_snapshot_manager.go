@@ -0,0 +1,434 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+// SetupPortfolioSnapshotManager applies configuration parameters before
+// running. The portfolio manager is optional and provides offline addresses
+func SetupPortfolioSnapshotManager(exchangeManager iExchangeManager, portfolioManager iPortfolioAddresses, databaseManager iDatabaseConnectionManager, cfg *config.PortfolioSnapshot) (*PortfolioSnapshotManager, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if databaseManager == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", PortfolioSnapshotManagerName, errNilConfig)
+	}
+	snapshotInterval := cfg.SnapshotInterval
+	if snapshotInterval <= 0 {
+		snapshotInterval = config.DefaultPortfolioSnapshotInterval
+	}
+	fiatCurrency := cfg.FiatCurrency
+	if fiatCurrency.IsEmpty() {
+		fiatCurrency = currency.USD
+	}
+	return &PortfolioSnapshotManager{
+		shutdown:         make(chan struct{}),
+		exchangeManager:  exchangeManager,
+		portfolioManager: portfolioManager,
+		databaseManager:  databaseManager,
+		snapshotInterval: snapshotInterval,
+		fiatCurrency:     fiatCurrency.Upper(),
+		verbose:          cfg.Verbose,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *PortfolioSnapshotManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem. A snapshot is taken immediately and then every
+// snapshot interval
+func (m *PortfolioSnapshotManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", PortfolioSnapshotManagerName, ErrNilSubsystem)
+	}
+	if m.db == nil {
+		db, err := portfoliosnapshot.Setup(m.databaseManager.GetInstance())
+		if err != nil {
+			return err
+		}
+		if db == nil {
+			return errPortfolioSnapshotDatabase
+		}
+		m.db = db
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", PortfolioSnapshotManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.PortfolioMgr, "Portfolio snapshot manager %s", MsgSubSystemStarting)
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.PortfolioMgr, "Portfolio snapshot manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *PortfolioSnapshotManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", PortfolioSnapshotManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("%s %w", PortfolioSnapshotManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.PortfolioMgr, "Portfolio snapshot manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	atomic.StoreInt32(&m.started, 0)
+	log.Debugf(log.PortfolioMgr, "Portfolio snapshot manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run takes a snapshot every snapshot interval
+func (m *PortfolioSnapshotManager) run() {
+	defer m.wg.Done()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-timer.C:
+			s, err := m.snapshot(context.TODO())
+			if err != nil {
+				log.Errorf(log.PortfolioMgr, "Portfolio snapshot manager unable to take snapshot: %v", err)
+			} else if m.verbose {
+				log.Debugf(log.PortfolioMgr, "Portfolio snapshot manager stored snapshot %s of %d holdings valued at %v %s",
+					s.ID, len(s.Holdings), s.TotalValue, s.FiatCurrency)
+			}
+			timer.Reset(m.snapshotInterval)
+		}
+	}
+}
+
+// GetSnapshots returns the snapshots taken between the start and end dates
+// ordered by time. A zero start or end date is not filtered on
+func (m *PortfolioSnapshotManager) GetSnapshots(start, end time.Time) ([]PortfolioSnapshot, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", PortfolioSnapshotManagerName, ErrSubSystemNotStarted)
+	}
+	if !start.IsZero() && !end.IsZero() {
+		if err := common.StartEndTimeCheck(start, end); err != nil {
+			return nil, err
+		}
+	}
+	stored, err := m.db.GetInRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]PortfolioSnapshot, len(stored))
+	for i := range stored {
+		resp[i] = PortfolioSnapshot{
+			ID:           stored[i].ID,
+			FiatCurrency: currency.NewCode(stored[i].FiatCurrency),
+			TotalValue:   stored[i].TotalValue,
+			Holdings:     make([]PortfolioSnapshotHolding, len(stored[i].Holdings)),
+			Timestamp:    stored[i].CreatedDate,
+		}
+		for j := range stored[i].Holdings {
+			h := &stored[i].Holdings[j]
+			var a asset.Item
+			if h.Asset != "" {
+				a, err = asset.New(h.Asset)
+				if err != nil {
+					return nil, err
+				}
+			}
+			resp[i].Holdings[j] = PortfolioSnapshotHolding{
+				Source:   h.Source,
+				Asset:    a,
+				Currency: currency.NewCode(h.Currency),
+				Amount:   h.Amount,
+				Price:    h.Price,
+				Value:    h.Value,
+			}
+		}
+		sortSnapshotHoldings(resp[i].Holdings)
+	}
+	return resp, nil
+}
+
+// GetPerformance returns the equity curve, per currency contributions and
+// drawdown of the snapshots taken between the start and end dates
+func (m *PortfolioSnapshotManager) GetPerformance(start, end time.Time) (*PortfolioPerformance, error) {
+	snapshots, err := m.GetSnapshots(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return CalculatePortfolioPerformance(snapshots)
+}
+
+// snapshot values the holdings of every enabled exchange with authenticated
+// API support and any offline addresses, then stores them in the database.
+// Snapshots missing holdings or prices are not stored so that they do not
+// appear as losses in the equity curve and drawdown
+func (m *PortfolioSnapshotManager) snapshot(ctx context.Context) (*PortfolioSnapshot, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return nil, err
+	}
+	enabled := make([]exchange.IBotExchange, 0, len(exchanges))
+	for i := range exchanges {
+		if exchanges[i].IsEnabled() {
+			enabled = append(enabled, exchanges[i])
+		}
+	}
+
+	s := &PortfolioSnapshot{
+		FiatCurrency: m.fiatCurrency,
+		Timestamp:    time.Now(),
+	}
+	var errs error
+	for i := range enabled {
+		holdings, err := m.exchangeHoldings(ctx, enabled[i])
+		if err != nil {
+			errs = common.AppendError(errs, err)
+			continue
+		}
+		s.Holdings = append(s.Holdings, holdings...)
+	}
+	s.Holdings = append(s.Holdings, m.offlineHoldings()...)
+	sortSnapshotHoldings(s.Holdings)
+
+	prices := make(map[*currency.Item]float64)
+	for i := range s.Holdings {
+		h := &s.Holdings[i]
+		price, ok := prices[h.Currency.Item]
+		if !ok {
+			price, err = priceInCurrency(ctx, enabled, asset.Spot, h.Currency, m.fiatCurrency)
+			if err != nil {
+				errs = common.AppendError(errs, fmt.Errorf("unable to value %s: %w", h.Currency, err))
+			}
+			prices[h.Currency.Item] = price
+		}
+		h.Price = price
+		h.Value = h.Amount * price
+		s.TotalValue += h.Value
+	}
+	if errs != nil {
+		return nil, fmt.Errorf("%w: %w", errIncompletePortfolioSnapshot, errs)
+	}
+
+	dbSnapshot := &portfoliosnapshot.Snapshot{
+		FiatCurrency: s.FiatCurrency.String(),
+		TotalValue:   s.TotalValue,
+		CreatedDate:  s.Timestamp,
+		Holdings:     make([]portfoliosnapshot.Holding, len(s.Holdings)),
+	}
+	for i := range s.Holdings {
+		dbSnapshot.Holdings[i] = portfoliosnapshot.Holding{
+			Source:   s.Holdings[i].Source,
+			Asset:    s.Holdings[i].Asset.String(),
+			Currency: s.Holdings[i].Currency.String(),
+			Amount:   s.Holdings[i].Amount,
+			Price:    s.Holdings[i].Price,
+			Value:    s.Holdings[i].Value,
+		}
+	}
+	if err = m.db.Insert(dbSnapshot); err != nil {
+		return nil, err
+	}
+	s.ID = dbSnapshot.ID
+	return s, nil
+}
+
+// exchangeHoldings returns the total of each currency held in an exchange's
+// accounts, summing sub accounts of the same asset type
+func (m *PortfolioSnapshotManager) exchangeHoldings(ctx context.Context, exch exchange.IBotExchange) ([]PortfolioSnapshotHolding, error) {
+	if !exch.IsRESTAuthenticationSupported() {
+		return nil, nil
+	}
+	assetTypes := asset.Items{asset.Spot}
+	if exch.HasAssetTypeAccountSegregation() {
+		assetTypes = exch.GetAssetTypes(true)
+	}
+	var resp []PortfolioSnapshotHolding
+	for _, a := range assetTypes {
+		holdings, err := exch.UpdateAccountInfo(ctx, a)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve %s %s holdings: %w", exch.GetName(), a, err)
+		}
+		totals := make(map[*currency.Item]float64)
+		var codes []currency.Code
+		for i := range holdings.Accounts {
+			if holdings.Accounts[i].AssetType != a {
+				continue
+			}
+			for j := range holdings.Accounts[i].Currencies {
+				b := &holdings.Accounts[i].Currencies[j]
+				if b.Total == 0 {
+					continue
+				}
+				if _, ok := totals[b.Currency.Item]; !ok {
+					codes = append(codes, b.Currency)
+				}
+				totals[b.Currency.Item] += b.Total
+			}
+		}
+		for _, code := range codes {
+			resp = append(resp, PortfolioSnapshotHolding{
+				Source:   exch.GetName(),
+				Asset:    a,
+				Currency: code.Upper(),
+				Amount:   totals[code.Item],
+			})
+		}
+	}
+	return resp, nil
+}
+
+// offlineHoldings returns the total of each currency held in addresses which
+// are not exchange balances
+func (m *PortfolioSnapshotManager) offlineHoldings() []PortfolioSnapshotHolding {
+	if m.portfolioManager == nil {
+		return nil
+	}
+	addresses := m.portfolioManager.GetAddresses()
+	totals := make(map[*currency.Item]float64)
+	var codes []currency.Code
+	for i := range addresses {
+		if strings.EqualFold(addresses[i].Description, portfolio.ExchangeAddress) || addresses[i].Balance == 0 {
+			continue
+		}
+		if _, ok := totals[addresses[i].CoinType.Item]; !ok {
+			codes = append(codes, addresses[i].CoinType)
+		}
+		totals[addresses[i].CoinType.Item] += addresses[i].Balance
+	}
+	resp := make([]PortfolioSnapshotHolding, len(codes))
+	for i, code := range codes {
+		resp[i] = PortfolioSnapshotHolding{
+			Source:   OfflineSnapshotSource,
+			Currency: code.Upper(),
+			Amount:   totals[code.Item],
+		}
+	}
+	return resp
+}
+
+// sortSnapshotHoldings orders holdings by source, asset and currency
+func sortSnapshotHoldings(h []PortfolioSnapshotHolding) {
+	sort.Slice(h, func(i, j int) bool {
+		if !strings.EqualFold(h[i].Source, h[j].Source) {
+			return strings.ToLower(h[i].Source) < strings.ToLower(h[j].Source)
+		}
+		if h[i].Asset != h[j].Asset {
+			return h[i].Asset.String() < h[j].Asset.String()
+		}
+		return h[i].Currency.String() < h[j].Currency.String()
+	})
+}
+
+// CalculatePortfolioPerformance returns the equity curve, drawdown and per
+// currency contributions to PnL between the first and last snapshot. Snapshots
+// must be ordered by time
+func CalculatePortfolioPerformance(snapshots []PortfolioSnapshot) (*PortfolioPerformance, error) {
+	if len(snapshots) == 0 {
+		return nil, errNoPortfolioSnapshots
+	}
+	first, last := &snapshots[0], &snapshots[len(snapshots)-1]
+	resp := &PortfolioPerformance{
+		FiatCurrency: first.FiatCurrency,
+		StartTime:    first.Timestamp,
+		EndTime:      last.Timestamp,
+		StartValue:   first.TotalValue,
+		EndValue:     last.TotalValue,
+		PnL:          last.TotalValue - first.TotalValue,
+		EquityCurve:  make([]EquityPoint, len(snapshots)),
+	}
+	if first.TotalValue > 0 {
+		resp.Return = resp.PnL / first.TotalValue
+	}
+
+	var peak float64
+	var peakTime time.Time
+	for i := range snapshots {
+		value := snapshots[i].TotalValue
+		if value > peak || i == 0 {
+			peak = value
+			peakTime = snapshots[i].Timestamp
+		}
+		p := EquityPoint{
+			Timestamp:     snapshots[i].Timestamp,
+			Value:         value,
+			CumulativePnL: value - first.TotalValue,
+			Peak:          peak,
+		}
+		if i > 0 {
+			p.PnL = value - snapshots[i-1].TotalValue
+		}
+		if peak > 0 {
+			p.Drawdown = (peak - value) / peak
+		}
+		if p.Drawdown > resp.MaxDrawdown {
+			resp.MaxDrawdown = p.Drawdown
+			resp.MaxDrawdownValue = peak - value
+			resp.PeakTime = peakTime
+			resp.TroughTime = p.Timestamp
+		}
+		resp.EquityCurve[i] = p
+	}
+	resp.CurrentDrawdown = resp.EquityCurve[len(resp.EquityCurve)-1].Drawdown
+
+	startValues := snapshotCurrencyValues(first)
+	endValues := snapshotCurrencyValues(last)
+	codes := make(map[*currency.Item]currency.Code)
+	for i := range first.Holdings {
+		codes[first.Holdings[i].Currency.Item] = first.Holdings[i].Currency
+	}
+	for i := range last.Holdings {
+		codes[last.Holdings[i].Currency.Item] = last.Holdings[i].Currency
+	}
+	resp.Contributions = make([]AssetContribution, 0, len(codes))
+	for item, code := range codes {
+		c := AssetContribution{
+			Currency:   code,
+			StartValue: startValues[item],
+			EndValue:   endValues[item],
+		}
+		c.PnL = c.EndValue - c.StartValue
+		if first.TotalValue > 0 {
+			c.Contribution = c.PnL / first.TotalValue
+		}
+		if last.TotalValue > 0 {
+			c.Weight = c.EndValue / last.TotalValue
+		}
+		resp.Contributions = append(resp.Contributions, c)
+	}
+	sort.Slice(resp.Contributions, func(i, j int) bool {
+		if resp.Contributions[i].PnL != resp.Contributions[j].PnL {
+			return resp.Contributions[i].PnL > resp.Contributions[j].PnL
+		}
+		return resp.Contributions[i].Currency.String() < resp.Contributions[j].Currency.String()
+	})
+	return resp, nil
+}
+
+// snapshotCurrencyValues sums the value of each currency across sources
+func snapshotCurrencyValues(s *PortfolioSnapshot) map[*currency.Item]float64 {
+	resp := make(map[*currency.Item]float64, len(s.Holdings))
+	for i := range s.Holdings {
+		resp[s.Holdings[i].Currency.Item] += s.Holdings[i].Value
+	}
+	return resp
+}
@@ -0,0 +1,312 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+var errFakeAccount = errors.New("fake account failure")
+
+type fakePortfolioSnapshotDB struct {
+	m         sync.Mutex
+	snapshots []portfoliosnapshot.Snapshot
+}
+
+func (f *fakePortfolioSnapshotDB) Insert(s *portfoliosnapshot.Snapshot) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if s.ID == "" {
+		s.ID = time.Now().String()
+	}
+	c := *s
+	c.Holdings = append([]portfoliosnapshot.Holding(nil), s.Holdings...)
+	f.snapshots = append(f.snapshots, c)
+	return nil
+}
+
+func (f *fakePortfolioSnapshotDB) GetInRange(start, end time.Time) ([]portfoliosnapshot.Snapshot, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	var resp []portfoliosnapshot.Snapshot
+	for i := range f.snapshots {
+		if !start.IsZero() && f.snapshots[i].CreatedDate.Before(start) {
+			continue
+		}
+		if !end.IsZero() && f.snapshots[i].CreatedDate.After(end) {
+			continue
+		}
+		resp = append(resp, f.snapshots[i])
+	}
+	return resp, nil
+}
+
+type fakePortfolioAddresses []portfolio.Address
+
+func (f fakePortfolioAddresses) GetAddresses() []portfolio.Address {
+	return f
+}
+
+// snapshotTestExchange is an enabled, authenticated exchange without
+// segregated asset accounts
+type snapshotTestExchange struct {
+	*rebalanceTestExchange
+	accountErr error
+}
+
+func (e *snapshotTestExchange) IsEnabled() bool {
+	return true
+}
+
+func (e *snapshotTestExchange) IsRESTAuthenticationSupported() bool {
+	return true
+}
+
+func (e *snapshotTestExchange) HasAssetTypeAccountSegregation() bool {
+	return false
+}
+
+func (e *snapshotTestExchange) UpdateAccountInfo(ctx context.Context, a asset.Item) (account.Holdings, error) {
+	if e.accountErr != nil {
+		return account.Holdings{}, e.accountErr
+	}
+	return e.rebalanceTestExchange.UpdateAccountInfo(ctx, a)
+}
+
+// setupPortfolioSnapshotTest returns a manager valuing holdings in USDT of an
+// exchange holding 1 BTC at 30000, 10 ETH at 2000 and 1000 USDT alongside
+// offline addresses holding 0.5 BTC
+func setupPortfolioSnapshotTest(t *testing.T) (*PortfolioSnapshotManager, *fakePortfolioSnapshotDB, *snapshotTestExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	e := &snapshotTestExchange{rebalanceTestExchange: &rebalanceTestExchange{
+		IBotExchange: exch,
+		name:         "snapshotexch",
+		balances: []account.Balance{
+			{Currency: currency.BTC, Total: 1},
+			{Currency: currency.ETH, Total: 10},
+			{Currency: currency.USDT, Total: 1000},
+			{Currency: currency.XRP},
+		},
+		prices: map[currency.Pair]float64{
+			btcusdtPair: 30000,
+			ethusdtPair: 2000,
+		},
+	}}
+	require.NoError(t, em.Add(e), "Add must not error")
+	addresses := fakePortfolioAddresses{
+		{Address: "cold", CoinType: currency.BTC, Balance: 0.5, Description: "cold wallet"},
+		{Address: "exch", CoinType: currency.BTC, Balance: 2, Description: portfolio.ExchangeAddress},
+		{Address: "empty", CoinType: currency.LTC},
+	}
+	m, err := SetupPortfolioSnapshotManager(em, addresses, &DatabaseConnectionManager{}, &config.PortfolioSnapshot{
+		SnapshotInterval: time.Hour,
+		FiatCurrency:     currency.USDT,
+	})
+	require.NoError(t, err, "SetupPortfolioSnapshotManager must not error")
+	db := &fakePortfolioSnapshotDB{}
+	m.db = db
+	return m, db, e
+}
+
+func TestSetupPortfolioSnapshotManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupPortfolioSnapshotManager(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupPortfolioSnapshotManager should error on nil exchange manager")
+	_, err = SetupPortfolioSnapshotManager(NewExchangeManager(), nil, nil, nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager, "SetupPortfolioSnapshotManager should error on nil database manager")
+	_, err = SetupPortfolioSnapshotManager(NewExchangeManager(), nil, &DatabaseConnectionManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig, "SetupPortfolioSnapshotManager should error on nil config")
+	m, err := SetupPortfolioSnapshotManager(NewExchangeManager(), nil, &DatabaseConnectionManager{}, &config.PortfolioSnapshot{FiatCurrency: currency.NewCode("aud")})
+	require.NoError(t, err, "SetupPortfolioSnapshotManager must not error")
+	assert.Equal(t, config.DefaultPortfolioSnapshotInterval, m.snapshotInterval, "snapshotInterval should default")
+	assert.Equal(t, "AUD", m.fiatCurrency.String(), "fiatCurrency should be upper case")
+	m, err = SetupPortfolioSnapshotManager(NewExchangeManager(), nil, &DatabaseConnectionManager{}, &config.PortfolioSnapshot{})
+	require.NoError(t, err, "SetupPortfolioSnapshotManager must not error")
+	assert.True(t, m.fiatCurrency.Equal(currency.USD), "fiatCurrency should default to USD")
+}
+
+func TestPortfolioSnapshotManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *PortfolioSnapshotManager
+	assert.False(t, m.IsRunning(), "IsRunning should return false on nil subsystem")
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem, "Start should error on nil subsystem")
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem, "Stop should error on nil subsystem")
+
+	m, err := SetupPortfolioSnapshotManager(NewExchangeManager(), nil, &DatabaseConnectionManager{}, &config.PortfolioSnapshot{})
+	require.NoError(t, err, "SetupPortfolioSnapshotManager must not error")
+	assert.ErrorIs(t, m.Start(), errPortfolioSnapshotDatabase, "Start should error without a connected database")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted, "Stop should error when not started")
+	_, err = m.GetSnapshots(time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetSnapshots should error when not started")
+
+	m.db = &fakePortfolioSnapshotDB{}
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted, "Start should error when already started")
+	assert.NoError(t, m.Stop(), "Stop should not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false after Stop")
+}
+
+func TestPortfolioSnapshotManagerSnapshot(t *testing.T) {
+	t.Parallel()
+	m, db, _ := setupPortfolioSnapshotTest(t)
+
+	s, err := m.snapshot(context.Background())
+	require.NoError(t, err, "snapshot must not error")
+	assert.NotEmpty(t, s.ID, "ID should be set")
+	assert.True(t, s.FiatCurrency.Equal(currency.USDT), "FiatCurrency should be USDT")
+	assert.Equal(t, 66000.0, s.TotalValue, "TotalValue should include exchange and offline holdings")
+	exp := []PortfolioSnapshotHolding{
+		{Source: OfflineSnapshotSource, Currency: currency.BTC, Amount: 0.5, Price: 30000, Value: 15000},
+		{Source: "snapshotexch", Asset: asset.Spot, Currency: currency.BTC, Amount: 1, Price: 30000, Value: 30000},
+		{Source: "snapshotexch", Asset: asset.Spot, Currency: currency.ETH, Amount: 10, Price: 2000, Value: 20000},
+		{Source: "snapshotexch", Asset: asset.Spot, Currency: currency.USDT, Amount: 1000, Price: 1, Value: 1000},
+	}
+	require.Len(t, s.Holdings, len(exp), "Holdings must exclude empty balances and exchange addresses")
+	for i := range exp {
+		assert.Equal(t, exp[i].Source, s.Holdings[i].Source, "Source should match")
+		assert.Equal(t, exp[i].Asset, s.Holdings[i].Asset, "Asset should match")
+		assert.True(t, exp[i].Currency.Equal(s.Holdings[i].Currency), "Currency should match")
+		assert.Equal(t, exp[i].Amount, s.Holdings[i].Amount, "Amount should match")
+		assert.Equal(t, exp[i].Price, s.Holdings[i].Price, "Price should match")
+		assert.Equal(t, exp[i].Value, s.Holdings[i].Value, "Value should match")
+	}
+
+	require.Len(t, db.snapshots, 1, "snapshot must be stored")
+	stored := db.snapshots[0]
+	assert.Equal(t, s.ID, stored.ID, "stored ID should match")
+	assert.Equal(t, "USDT", stored.FiatCurrency, "stored FiatCurrency should match")
+	assert.Equal(t, 66000.0, stored.TotalValue, "stored TotalValue should match")
+	require.Len(t, stored.Holdings, len(exp), "stored Holdings must match")
+	assert.Empty(t, stored.Holdings[0].Asset, "offline holdings should have no asset")
+	assert.Equal(t, "spot", stored.Holdings[1].Asset, "exchange holdings should store their asset")
+}
+
+func TestPortfolioSnapshotManagerSnapshotIncomplete(t *testing.T) {
+	t.Parallel()
+	m, db, e := setupPortfolioSnapshotTest(t)
+	m.portfolioManager = fakePortfolioAddresses{{Address: "ltc", CoinType: currency.LTC, Balance: 3}}
+	_, err := m.snapshot(context.Background())
+	assert.ErrorIs(t, err, errIncompletePortfolioSnapshot, "snapshot should error when a holding cannot be valued")
+	assert.ErrorIs(t, err, errNoRebalancePrice, "snapshot should return the pricing error")
+
+	m.portfolioManager = nil
+	e.accountErr = errFakeAccount
+	_, err = m.snapshot(context.Background())
+	assert.ErrorIs(t, err, errIncompletePortfolioSnapshot, "snapshot should error when holdings cannot be retrieved")
+	assert.ErrorIs(t, err, errFakeAccount, "snapshot should return the holdings error")
+	assert.Empty(t, db.snapshots, "incomplete snapshots must not be stored")
+}
+
+func TestPortfolioSnapshotManagerGetSnapshots(t *testing.T) {
+	t.Parallel()
+	m, db, _ := setupPortfolioSnapshotTest(t)
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, db.Insert(&portfoliosnapshot.Snapshot{
+			FiatCurrency: "USDT",
+			TotalValue:   float64(100 + i),
+			CreatedDate:  start.Add(time.Duration(i) * time.Hour),
+			Holdings: []portfoliosnapshot.Holding{
+				{Source: "snapshotexch", Asset: "spot", Currency: "ETH", Amount: 1, Price: 50, Value: 50},
+				{Source: "snapshotexch", Asset: "spot", Currency: "BTC", Amount: 1, Price: float64(50 + i), Value: float64(50 + i)},
+			},
+		}), "Insert must not error")
+	}
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() {
+		assert.NoError(t, m.Stop(), "Stop should not error")
+	})
+
+	_, err := m.GetSnapshots(start.Add(time.Hour), start)
+	assert.ErrorIs(t, err, common.ErrStartAfterEnd, "GetSnapshots should error when start is after end")
+
+	snapshots, err := m.GetSnapshots(start.Add(time.Hour), start.Add(2*time.Hour))
+	require.NoError(t, err, "GetSnapshots must not error")
+	require.Len(t, snapshots, 2, "GetSnapshots must return snapshots in range")
+	assert.Equal(t, 101.0, snapshots[0].TotalValue, "TotalValue should match")
+	assert.True(t, snapshots[0].FiatCurrency.Equal(currency.USDT), "FiatCurrency should match")
+	require.Len(t, snapshots[0].Holdings, 2, "Holdings must be returned")
+	assert.True(t, snapshots[0].Holdings[0].Currency.Equal(currency.BTC), "Holdings should be sorted by currency")
+	assert.Equal(t, asset.Spot, snapshots[0].Holdings[0].Asset, "Asset should be parsed")
+
+	perf, err := m.GetPerformance(start, start.Add(2*time.Hour))
+	require.NoError(t, err, "GetPerformance must not error")
+	assert.Equal(t, 2.0, perf.PnL, "PnL should match")
+	_, err = m.GetPerformance(start.Add(-2*time.Hour), start.Add(-time.Hour))
+	assert.ErrorIs(t, err, errNoPortfolioSnapshots, "GetPerformance should error without snapshots")
+}
+
+func TestCalculatePortfolioPerformance(t *testing.T) {
+	t.Parallel()
+	_, err := CalculatePortfolioPerformance(nil)
+	assert.ErrorIs(t, err, errNoPortfolioSnapshots, "CalculatePortfolioPerformance should error without snapshots")
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := func(i int, values map[currency.Code]float64) PortfolioSnapshot {
+		s := PortfolioSnapshot{FiatCurrency: currency.USD, Timestamp: start.Add(time.Duration(i) * time.Hour)}
+		for code, v := range values {
+			s.Holdings = append(s.Holdings, PortfolioSnapshotHolding{Source: "snapshotexch", Asset: asset.Spot, Currency: code, Value: v})
+			s.TotalValue += v
+		}
+		return s
+	}
+	snapshots := []PortfolioSnapshot{
+		snapshot(0, map[currency.Code]float64{currency.BTC: 60, currency.ETH: 40}),
+		snapshot(1, map[currency.Code]float64{currency.BTC: 80, currency.ETH: 40}),
+		snapshot(2, map[currency.Code]float64{currency.BTC: 55, currency.ETH: 35}),
+		snapshot(3, map[currency.Code]float64{currency.BTC: 70, currency.ETH: 30, currency.USDT: 10}),
+	}
+	perf, err := CalculatePortfolioPerformance(snapshots)
+	require.NoError(t, err, "CalculatePortfolioPerformance must not error")
+	assert.Equal(t, start, perf.StartTime, "StartTime should match")
+	assert.Equal(t, start.Add(3*time.Hour), perf.EndTime, "EndTime should match")
+	assert.Equal(t, 100.0, perf.StartValue, "StartValue should match")
+	assert.Equal(t, 110.0, perf.EndValue, "EndValue should match")
+	assert.Equal(t, 10.0, perf.PnL, "PnL should match")
+	assert.InDelta(t, 0.1, perf.Return, 1e-9, "Return should match")
+	assert.InDelta(t, 0.25, perf.MaxDrawdown, 1e-9, "MaxDrawdown should be measured from the peak")
+	assert.Equal(t, 30.0, perf.MaxDrawdownValue, "MaxDrawdownValue should match")
+	assert.Equal(t, start.Add(time.Hour), perf.PeakTime, "PeakTime should match")
+	assert.Equal(t, start.Add(2*time.Hour), perf.TroughTime, "TroughTime should match")
+	assert.InDelta(t, 10.0/120, perf.CurrentDrawdown, 1e-9, "CurrentDrawdown should match")
+
+	require.Len(t, perf.EquityCurve, 4, "EquityCurve must contain every snapshot")
+	assert.Equal(t, -30.0, perf.EquityCurve[2].PnL, "PnL should be the change since the previous snapshot")
+	assert.Equal(t, -10.0, perf.EquityCurve[2].CumulativePnL, "CumulativePnL should be the change since the first snapshot")
+	assert.Equal(t, 120.0, perf.EquityCurve[3].Peak, "Peak should be retained")
+
+	require.Len(t, perf.Contributions, 3, "Contributions must contain every currency")
+	for i, exp := range []struct {
+		code         currency.Code
+		pnl          float64
+		contribution float64
+		weight       float64
+	}{
+		{currency.BTC, 10, 0.1, 70.0 / 110},
+		{currency.USDT, 10, 0.1, 10.0 / 110},
+		{currency.ETH, -10, -0.1, 30.0 / 110},
+	} {
+		c := perf.Contributions[i]
+		assert.Truef(t, exp.code.Equal(c.Currency), "Contributions[%d] should be %s", i, exp.code)
+		assert.Equalf(t, exp.pnl, c.PnL, "Contributions[%d] PnL should match", i)
+		assert.InDeltaf(t, exp.contribution, c.Contribution, 1e-9, "Contributions[%d] Contribution should match", i)
+		assert.InDeltaf(t, exp.weight, c.Weight, 1e-9, "Contributions[%d] Weight should match", i)
+	}
+}
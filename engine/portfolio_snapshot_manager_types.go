@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+// PortfolioSnapshotManagerName is an exported subsystem name
+const PortfolioSnapshotManagerName = "portfolio_snapshot_manager"
+
+// OfflineSnapshotSource is the source of holdings in offline addresses
+const OfflineSnapshotSource = "offline"
+
+var (
+	errPortfolioSnapshotDatabase   = errors.New("portfolio snapshots require a connected database")
+	errNoPortfolioSnapshots        = errors.New("no portfolio snapshots found")
+	errIncompletePortfolioSnapshot = errors.New("portfolio snapshot is incomplete and was not stored")
+)
+
+// iPortfolioAddresses limits exposure of the portfolio manager to the
+// addresses it tracks
+type iPortfolioAddresses interface {
+	GetAddresses() []portfolio.Address
+}
+
+// PortfolioSnapshotManager periodically values exchange holdings and offline
+// addresses in a fiat currency and stores them in the database so that net
+// worth and PnL can be reviewed over time
+type PortfolioSnapshotManager struct {
+	started          int32
+	shutdown         chan struct{}
+	wg               sync.WaitGroup
+	exchangeManager  iExchangeManager
+	portfolioManager iPortfolioAddresses
+	databaseManager  iDatabaseConnectionManager
+	db               portfoliosnapshot.IDBService
+	snapshotInterval time.Duration
+	fiatCurrency     currency.Code
+	verbose          bool
+	m                sync.Mutex
+}
+
+// PortfolioSnapshot is the fiat valuation of all holdings at a point in time
+type PortfolioSnapshot struct {
+	ID           string
+	FiatCurrency currency.Code
+	TotalValue   float64
+	Holdings     []PortfolioSnapshotHolding
+	Timestamp    time.Time
+}
+
+// PortfolioSnapshotHolding is the value of a currency held by an exchange
+// account or offline addresses
+type PortfolioSnapshotHolding struct {
+	// Source is the exchange name or OfflineSnapshotSource
+	Source   string
+	Asset    asset.Item
+	Currency currency.Code
+	Amount   float64
+	Price    float64
+	Value    float64
+}
+
+// PortfolioPerformance is the equity curve, PnL and drawdown of the portfolio
+// between two snapshots. Changes in value include deposits and withdrawals
+type PortfolioPerformance struct {
+	FiatCurrency currency.Code
+	StartTime    time.Time
+	EndTime      time.Time
+	StartValue   float64
+	EndValue     float64
+	PnL          float64
+	// Return is the PnL as a fraction of the starting value
+	Return float64
+	// MaxDrawdown is the largest fall from a peak as a fraction of the peak
+	MaxDrawdown      float64
+	MaxDrawdownValue float64
+	PeakTime         time.Time
+	TroughTime       time.Time
+	CurrentDrawdown  float64
+	EquityCurve      []EquityPoint
+	Contributions    []AssetContribution
+}
+
+// EquityPoint is the portfolio value at a snapshot
+type EquityPoint struct {
+	Timestamp time.Time
+	Value     float64
+	// PnL is the change in value since the previous snapshot
+	PnL           float64
+	CumulativePnL float64
+	Peak          float64
+	// Drawdown is the fall from the peak as a fraction of the peak
+	Drawdown float64
+}
+
+// AssetContribution is a currency's share of the portfolio PnL over a period
+type AssetContribution struct {
+	Currency   currency.Code
+	StartValue float64
+	EndValue   float64
+	PnL        float64
+	// Contribution is the currency's PnL as a fraction of the portfolio's
+	// starting value
+	Contribution float64
+	// Weight is the currency's share of the portfolio's ending value
+	Weight float64
+}
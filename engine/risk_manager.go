@@ -0,0 +1,448 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupRiskManager applies configuration parameters before running
+func SetupRiskManager(exchangeManager iExchangeManager, orderManager iOrderStateManager, communicationsManager iCommsManager, cfg *config.RiskManager) (*RiskManager, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	if communicationsManager == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%s %w", RiskManagerName, errNilConfig)
+	}
+	checkInterval := cfg.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = config.DefaultRiskManagerCheckInterval
+	}
+	limits := make([]config.RiskLimit, len(cfg.Limits))
+	copy(limits, cfg.Limits)
+	return &RiskManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		commsManager:    communicationsManager,
+		limits:          limits,
+		killSwitch:      cfg.KillSwitch,
+		checkInterval:   checkInterval,
+		verbose:         cfg.Verbose,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (r *RiskManager) IsRunning() bool {
+	return r != nil && atomic.LoadInt32(&r.started) == 1
+}
+
+// Start runs the subsystem. Starting the subsystem disengages the kill switch
+func (r *RiskManager) Start() error {
+	if r == nil {
+		return fmt.Errorf("%s %w", RiskManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 0, 1) {
+		return fmt.Errorf("%s %w", RiskManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Risk manager %s", MsgSubSystemStarting)
+	r.m.Lock()
+	r.halted = false
+	r.haltReason = nil
+	r.m.Unlock()
+	r.shutdown = make(chan struct{})
+	r.wg.Add(1)
+	go r.run()
+	log.Debugf(log.OrderMgr, "Risk manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (r *RiskManager) Stop() error {
+	if r == nil {
+		return fmt.Errorf("%s %w", RiskManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&r.started) == 0 {
+		return fmt.Errorf("%s %w", RiskManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Risk manager %s", MsgSubSystemShuttingDown)
+	close(r.shutdown)
+	r.wg.Wait()
+	atomic.StoreInt32(&r.started, 0)
+	log.Debugf(log.OrderMgr, "Risk manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsHalted returns whether the kill switch has been engaged and the breach
+// which engaged it
+func (r *RiskManager) IsHalted() (bool, error) {
+	if r == nil {
+		return false, fmt.Errorf("%s %w", RiskManagerName, ErrNilSubsystem)
+	}
+	r.m.RLock()
+	defer r.m.RUnlock()
+	return r.halted, r.haltReason
+}
+
+// ResetKillSwitch disengages the kill switch allowing orders to be submitted
+// again
+func (r *RiskManager) ResetKillSwitch() error {
+	if r == nil {
+		return fmt.Errorf("%s %w", RiskManagerName, ErrNilSubsystem)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if !r.halted {
+		return errKillSwitchNotActive
+	}
+	r.halted = false
+	r.haltReason = nil
+	r.commsManager.PushEvent(base.Event{Type: "risk", Message: "Risk manager kill switch reset, order submission resumed"})
+	log.Warnln(log.OrderMgr, "Risk manager kill switch reset, order submission resumed")
+	return nil
+}
+
+// CheckSubmit ensures a new order does not breach any configured risk limits.
+// Rejections are pushed through the communications manager and engage the
+// kill switch if configured
+func (r *RiskManager) CheckSubmit(ctx context.Context, s *order.Submit) error {
+	if !r.IsRunning() {
+		return nil
+	}
+	if s == nil {
+		return errNilOrder
+	}
+	err := r.checkOrder(s.Exchange, s.AssetType, s.Pair, s.Side, s.Price, s.Amount, s.QuoteAmount, true)
+	if err != nil {
+		r.reject(ctx, err)
+	}
+	return err
+}
+
+// CheckModify ensures a modification to an existing order does not breach any
+// configured risk limits
+func (r *RiskManager) CheckModify(ctx context.Context, d *order.Detail, mod *order.Modify) error {
+	if !r.IsRunning() {
+		return nil
+	}
+	if d == nil {
+		return errNilOrder
+	}
+	if mod == nil {
+		return errNilModify
+	}
+	price, amount := mod.Price, mod.Amount
+	if price == 0 {
+		price = d.Price
+	}
+	if amount == 0 {
+		amount = d.Amount
+	}
+	err := r.checkOrder(d.Exchange, d.AssetType, d.Pair, d.Side, price, amount-d.ExecutedAmount, 0, false)
+	if err != nil {
+		r.reject(ctx, err)
+	}
+	return err
+}
+
+// checkOrder evaluates all applicable limits against an order
+func (r *RiskManager) checkOrder(exch string, a asset.Item, pair currency.Pair, side order.Side, price, amount, quoteAmount float64, isNew bool) error {
+	r.m.RLock()
+	halted, reason := r.halted, r.haltReason
+	r.m.RUnlock()
+	if halted {
+		return &RiskBreach{Exchange: exch, Asset: a, Pair: pair, Err: fmt.Errorf("%w: %v", ErrKillSwitchEngaged, reason)}
+	}
+
+	for i := range r.limits {
+		l := &r.limits[i]
+		if !limitApplies(l, exch, a, pair) {
+			continue
+		}
+		if l.MaxOrderNotional > 0 {
+			notional := quoteAmount
+			if notional == 0 {
+				p := price
+				if p == 0 {
+					ref, err := referencePrice(exch, pair, a)
+					if err != nil {
+						return fmt.Errorf("%s %s %s cannot value order: %w", exch, a, pair, err)
+					}
+					p = ref
+				}
+				notional = p * amount
+			}
+			if notional > l.MaxOrderNotional {
+				return &RiskBreach{Exchange: exch, Asset: a, Pair: pair, Value: notional, Limit: l.MaxOrderNotional, Err: ErrMaxOrderNotionalExceeded}
+			}
+		}
+		if l.PriceBandPercentage > 0 && price > 0 {
+			ref, err := referencePrice(exch, pair, a)
+			if err != nil {
+				return fmt.Errorf("%s %s %s cannot check price band: %w", exch, a, pair, err)
+			}
+			deviation := math.Abs(price-ref) / ref * 100
+			if deviation > l.PriceBandPercentage {
+				return &RiskBreach{Exchange: exch, Asset: a, Pair: pair, Value: deviation, Limit: l.PriceBandPercentage, Err: ErrPriceOutsideBand}
+			}
+		}
+		if l.MaxOpenOrders > 0 && isNew {
+			active, err := r.orderManager.GetOrdersActive(limitFilter(l))
+			if err != nil {
+				return err
+			}
+			if int64(len(active)+1) > l.MaxOpenOrders {
+				return &RiskBreach{Exchange: exch, Asset: a, Pair: pair, Value: float64(len(active) + 1), Limit: float64(l.MaxOpenOrders), Err: ErrMaxOpenOrdersExceeded}
+			}
+		}
+		if l.MaxPositionSize > 0 {
+			positions, err := r.getPositions(&order.Filter{Exchange: exch, AssetType: a, Pair: pair}, time.Time{})
+			if err != nil {
+				return err
+			}
+			var current float64
+			if len(positions) > 0 {
+				current = positions[0].amount
+			}
+			projected := current
+			if side.IsShort() {
+				projected -= amount
+			} else {
+				projected += amount
+			}
+			if math.Abs(projected) > l.MaxPositionSize && math.Abs(projected) > math.Abs(current) {
+				return &RiskBreach{Exchange: exch, Asset: a, Pair: pair, Value: math.Abs(projected), Limit: l.MaxPositionSize, Err: ErrMaxPositionSizeExceeded}
+			}
+		}
+		if l.MaxDailyLoss > 0 {
+			if err := r.checkDailyLoss(l); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkDailyLoss returns a breach if the realised and unrealised loss of
+// trades executed since the start of the UTC day reaches the limit
+func (r *RiskManager) checkDailyLoss(l *config.RiskLimit) error {
+	now := time.Now().UTC()
+	positions, err := r.getPositions(limitFilter(l), time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return err
+	}
+	var pnl float64
+	for i := range positions {
+		pnl += positions[i].cash
+		if positions[i].amount == 0 {
+			continue
+		}
+		ref, err := referencePrice(positions[i].exchange, positions[i].pair, positions[i].asset)
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Risk manager cannot value %s %s %s position: %v", positions[i].exchange, positions[i].asset, positions[i].pair, err)
+			continue
+		}
+		pnl += positions[i].amount * ref
+	}
+	if -pnl >= l.MaxDailyLoss {
+		return &RiskBreach{Exchange: l.Exchange, Asset: l.Asset, Pair: l.Pair, Value: -pnl, Limit: l.MaxDailyLoss, Err: ErrDailyLossLimitExceeded}
+	}
+	return nil
+}
+
+// getPositions aggregates the executed amounts of orders matching the filter
+// into net positions per exchange, asset and pair. When since is set, only
+// trades executed at or after that time are counted. Orders without trade
+// history fall back to their last updated time
+func (r *RiskManager) getPositions(f *order.Filter, since time.Time) ([]pairPosition, error) {
+	orders, err := r.orderManager.GetOrdersFiltered(f)
+	if err != nil {
+		return nil, err
+	}
+	var positions []pairPosition
+	for i := range orders {
+		amount, value, fee := executedSince(&orders[i], since)
+		if amount <= 0 {
+			continue
+		}
+		var pos *pairPosition
+		for j := range positions {
+			if strings.EqualFold(positions[j].exchange, orders[i].Exchange) &&
+				positions[j].asset == orders[i].AssetType &&
+				positions[j].pair.Equal(orders[i].Pair) {
+				pos = &positions[j]
+				break
+			}
+		}
+		if pos == nil {
+			positions = append(positions, pairPosition{
+				exchange: orders[i].Exchange,
+				asset:    orders[i].AssetType,
+				pair:     orders[i].Pair,
+			})
+			pos = &positions[len(positions)-1]
+		}
+		if orders[i].Side.IsShort() {
+			pos.amount -= amount
+			pos.cash += value
+		} else {
+			pos.amount += amount
+			pos.cash -= value
+		}
+		pos.cash -= fee
+	}
+	return positions, nil
+}
+
+// executedSince returns the amount, quote value and fee of an order executed
+// at or after the since time
+func executedSince(d *order.Detail, since time.Time) (amount, value, fee float64) {
+	if d.ExecutedAmount <= 0 {
+		return 0, 0, 0
+	}
+	if since.IsZero() || len(d.Trades) == 0 {
+		updated := d.LastUpdated
+		if updated.IsZero() {
+			updated = d.Date
+		}
+		if updated.Before(since) {
+			return 0, 0, 0
+		}
+		price := d.AverageExecutedPrice
+		if price == 0 {
+			price = d.Price
+		}
+		return d.ExecutedAmount, d.ExecutedAmount * price, d.Fee
+	}
+	for i := range d.Trades {
+		if d.Trades[i].Timestamp.Before(since) {
+			continue
+		}
+		amount += d.Trades[i].Amount
+		value += d.Trades[i].Amount * d.Trades[i].Price
+		fee += d.Trades[i].Fee
+	}
+	return amount, value, fee
+}
+
+// run periodically checks daily loss limits so the kill switch can be engaged
+// without waiting for the next order submission
+func (r *RiskManager) run() {
+	defer r.wg.Done()
+	tick := time.NewTicker(r.checkInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-tick.C:
+			r.monitor()
+		}
+	}
+}
+
+// monitor checks all daily loss limits
+func (r *RiskManager) monitor() {
+	if halted, _ := r.IsHalted(); halted {
+		return
+	}
+	for i := range r.limits {
+		if r.limits[i].MaxDailyLoss <= 0 {
+			continue
+		}
+		err := r.checkDailyLoss(&r.limits[i])
+		if err != nil {
+			r.reject(context.TODO(), err)
+			return
+		}
+	}
+	if r.verbose {
+		log.Debugf(log.OrderMgr, "Risk manager checked %d limits", len(r.limits))
+	}
+}
+
+// reject notifies of a rejection and engages the kill switch if configured.
+// Errors which are not risk limit breaches, such as a missing reference price,
+// never engage the kill switch
+func (r *RiskManager) reject(ctx context.Context, err error) {
+	msg := fmt.Sprintf("Risk manager rejected order: %v", err)
+	log.Warnln(log.OrderMgr, msg)
+	r.commsManager.PushEvent(base.Event{Type: "risk", Message: msg})
+	if r.killSwitch && errors.Is(err, ErrRiskLimitBreached) {
+		r.engageKillSwitch(ctx, err)
+	}
+}
+
+// engageKillSwitch halts further order submission and cancels all orders
+// across all exchanges
+func (r *RiskManager) engageKillSwitch(ctx context.Context, reason error) {
+	r.m.Lock()
+	if r.halted {
+		r.m.Unlock()
+		return
+	}
+	r.halted = true
+	r.haltReason = reason
+	r.m.Unlock()
+
+	msg := fmt.Sprintf("Risk manager kill switch engaged, cancelling all orders: %v", reason)
+	log.Errorln(log.OrderMgr, msg)
+	r.commsManager.PushEvent(base.Event{Type: "risk", Message: msg})
+	exchanges, err := r.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Risk manager cannot get exchanges: %v", err)
+		return
+	}
+	r.orderManager.CancelAllOrders(ctx, exchanges)
+}
+
+// limitApplies returns whether a limit is scoped to the exchange, asset and
+// pair
+func limitApplies(l *config.RiskLimit, exch string, a asset.Item, pair currency.Pair) bool {
+	return (l.Exchange == "" || strings.EqualFold(l.Exchange, exch)) &&
+		(l.Asset == asset.Empty || l.Asset == a) &&
+		(l.Pair.IsEmpty() || l.Pair.Equal(pair))
+}
+
+// limitFilter returns an order filter matching the scope of a limit
+func limitFilter(l *config.RiskLimit) *order.Filter {
+	return &order.Filter{
+		Exchange:  l.Exchange,
+		AssetType: l.Asset,
+		Pair:      l.Pair,
+	}
+}
+
+// referencePrice returns the last traded price, or the mid price if no trade
+// price is available
+func referencePrice(exch string, pair currency.Pair, a asset.Item) (float64, error) {
+	t, err := ticker.GetTicker(exch, pair, a)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errNoReferencePrice, err)
+	}
+	if t.Last > 0 {
+		return t.Last, nil
+	}
+	if t.Bid > 0 && t.Ask > 0 {
+		return (t.Bid + t.Ask) / 2, nil
+	}
+	return 0, errNoReferencePrice
+}
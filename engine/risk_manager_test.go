@@ -0,0 +1,278 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+const riskTestExchange = "risktest"
+
+// fakeOrderStateManager returns stored orders and records kill switch
+// cancellations
+type fakeOrderStateManager struct {
+	orders    []order.Detail
+	cancelled int
+}
+
+func (f *fakeOrderStateManager) GetOrdersFiltered(fil *order.Filter) ([]order.Detail, error) {
+	var resp []order.Detail
+	for i := range f.orders {
+		if f.orders[i].MatchFilter(fil) {
+			resp = append(resp, f.orders[i])
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeOrderStateManager) GetOrdersActive(fil *order.Filter) ([]order.Detail, error) {
+	var resp []order.Detail
+	for i := range f.orders {
+		if f.orders[i].IsActive() && f.orders[i].MatchFilter(fil) {
+			resp = append(resp, f.orders[i])
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeOrderStateManager) CancelAllOrders(context.Context, []exchange.IBotExchange) {
+	f.cancelled++
+}
+
+func setupRiskTest(t *testing.T, om iOrderStateManager, cfg *config.RiskManager) *RiskManager {
+	t.Helper()
+	err := ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: riskTestExchange,
+		Pair:         btcusdPair,
+		AssetType:    asset.Spot,
+		Last:         100,
+	})
+	require.NoError(t, err, "ProcessTicker must not error")
+	r, err := SetupRiskManager(NewExchangeManager(), om, &CommunicationManager{}, cfg)
+	require.NoError(t, err, "SetupRiskManager must not error")
+	require.NoError(t, r.Start(), "Start must not error")
+	t.Cleanup(func() {
+		assert.NoError(t, r.Stop(), "Stop should not error")
+	})
+	return r
+}
+
+func riskTestSubmit(price, amount float64) *order.Submit {
+	return &order.Submit{
+		Exchange:  riskTestExchange,
+		Type:      order.Limit,
+		Side:      order.Buy,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Price:     price,
+		Amount:    amount,
+	}
+}
+
+func TestSetupRiskManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupRiskManager(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupRiskManager should error on nil exchange manager")
+	_, err = SetupRiskManager(NewExchangeManager(), nil, nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager, "SetupRiskManager should error on nil order manager")
+	_, err = SetupRiskManager(NewExchangeManager(), &fakeOrderStateManager{}, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager, "SetupRiskManager should error on nil comms manager")
+	_, err = SetupRiskManager(NewExchangeManager(), &fakeOrderStateManager{}, &CommunicationManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig, "SetupRiskManager should error on nil config")
+	r, err := SetupRiskManager(NewExchangeManager(), &fakeOrderStateManager{}, &CommunicationManager{}, &config.RiskManager{})
+	require.NoError(t, err, "SetupRiskManager must not error")
+	assert.Equal(t, config.DefaultRiskManagerCheckInterval, r.checkInterval, "checkInterval should default")
+}
+
+func TestRiskManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var r *RiskManager
+	assert.ErrorIs(t, r.Start(), ErrNilSubsystem, "Start should error on nil subsystem")
+	assert.ErrorIs(t, r.Stop(), ErrNilSubsystem, "Stop should error on nil subsystem")
+	assert.False(t, r.IsRunning(), "IsRunning should return false")
+
+	r, err := SetupRiskManager(NewExchangeManager(), &fakeOrderStateManager{}, &CommunicationManager{}, &config.RiskManager{})
+	require.NoError(t, err, "SetupRiskManager must not error")
+	assert.ErrorIs(t, r.Stop(), ErrSubSystemNotStarted, "Stop should error when not started")
+	require.NoError(t, r.Start(), "Start must not error")
+	assert.ErrorIs(t, r.Start(), ErrSubSystemAlreadyStarted, "Start should error when already started")
+	assert.True(t, r.IsRunning(), "IsRunning should return true")
+	require.NoError(t, r.Stop(), "Stop must not error")
+}
+
+func TestRiskManagerCheckSubmit(t *testing.T) {
+	t.Parallel()
+	om := &fakeOrderStateManager{
+		orders: []order.Detail{
+			{Exchange: riskTestExchange, Pair: btcusdPair, AssetType: asset.Spot, Side: order.Buy, Status: order.Active, Price: 90, Amount: 1},
+			{Exchange: riskTestExchange, Pair: btcusdPair, AssetType: asset.Spot, Side: order.Buy, Status: order.Filled, Price: 100, Amount: 4, ExecutedAmount: 4, LastUpdated: time.Now()},
+		},
+	}
+	r := setupRiskTest(t, om, &config.RiskManager{
+		Limits: []config.RiskLimit{
+			{Exchange: riskTestExchange, MaxOrderNotional: 500, MaxOpenOrders: 2, PriceBandPercentage: 10},
+			{Exchange: riskTestExchange, Asset: asset.Spot, Pair: btcusdPair, MaxPositionSize: 6},
+			{Exchange: "someotherexchange", MaxOrderNotional: 1},
+		},
+	})
+
+	assert.NoError(t, r.CheckSubmit(context.Background(), riskTestSubmit(100, 1)), "CheckSubmit should not error")
+
+	var breach *RiskBreach
+	err := r.CheckSubmit(context.Background(), riskTestSubmit(100, 5.1))
+	assert.ErrorIs(t, err, ErrMaxOrderNotionalExceeded, "CheckSubmit should error on notional")
+	assert.ErrorIs(t, err, ErrRiskLimitBreached, "CheckSubmit should error with a risk breach")
+	require.ErrorAs(t, err, &breach, "CheckSubmit must return a RiskBreach")
+	assert.InDelta(t, 510.0, breach.Value, 1e-9, "RiskBreach value should be the order notional")
+
+	market := riskTestSubmit(0, 6)
+	market.Type = order.Market
+	assert.ErrorIs(t, r.CheckSubmit(context.Background(), market), ErrMaxOrderNotionalExceeded, "CheckSubmit should value market orders at the ticker price")
+
+	assert.ErrorIs(t, r.CheckSubmit(context.Background(), riskTestSubmit(111, 1)), ErrPriceOutsideBand, "CheckSubmit should error on price band")
+
+	assert.ErrorIs(t, r.CheckSubmit(context.Background(), riskTestSubmit(100, 3)), ErrMaxPositionSizeExceeded, "CheckSubmit should error on position size")
+	sell := riskTestSubmit(100, 3)
+	sell.Side = order.Sell
+	assert.NoError(t, r.CheckSubmit(context.Background(), sell), "CheckSubmit should allow reducing a position")
+
+	om.orders = append(om.orders, order.Detail{Exchange: riskTestExchange, Pair: btcusdPair, AssetType: asset.Spot, Side: order.Sell, Status: order.New, Price: 110, Amount: 1})
+	assert.ErrorIs(t, r.CheckSubmit(context.Background(), riskTestSubmit(100, 1)), ErrMaxOpenOrdersExceeded, "CheckSubmit should error on open orders")
+	assert.Zero(t, om.cancelled, "Kill switch should not be engaged when disabled")
+}
+
+func TestRiskManagerCheckModify(t *testing.T) {
+	t.Parallel()
+	om := &fakeOrderStateManager{}
+	r := setupRiskTest(t, om, &config.RiskManager{
+		Limits: []config.RiskLimit{{MaxOrderNotional: 500, MaxOpenOrders: 1}},
+	})
+	d := &order.Detail{Exchange: riskTestExchange, Pair: btcusdPair, AssetType: asset.Spot, Side: order.Buy, Status: order.Active, Price: 100, Amount: 2, ExecutedAmount: 1}
+	om.orders = append(om.orders, *d)
+	assert.ErrorIs(t, r.CheckModify(context.Background(), nil, nil), errNilOrder, "CheckModify should error on nil order")
+	assert.ErrorIs(t, r.CheckModify(context.Background(), d, nil), errNilModify, "CheckModify should error on nil modify")
+	assert.NoError(t, r.CheckModify(context.Background(), d, &order.Modify{Price: 101}), "CheckModify should not count the order being modified")
+	assert.ErrorIs(t, r.CheckModify(context.Background(), d, &order.Modify{Amount: 7}), ErrMaxOrderNotionalExceeded, "CheckModify should error on notional of the remaining amount")
+}
+
+func TestRiskManagerKillSwitch(t *testing.T) {
+	t.Parallel()
+	om := &fakeOrderStateManager{
+		orders: []order.Detail{
+			{Exchange: riskTestExchange, Pair: btcusdPair, AssetType: asset.Spot, Side: order.Buy, Status: order.Filled, Price: 150, Amount: 2, ExecutedAmount: 2, LastUpdated: time.Now()},
+		},
+	}
+	r := setupRiskTest(t, om, &config.RiskManager{
+		KillSwitch: true,
+		Limits:     []config.RiskLimit{{Exchange: riskTestExchange, MaxDailyLoss: 100}},
+	})
+
+	err := r.CheckSubmit(context.Background(), riskTestSubmit(100, 1))
+	assert.ErrorIs(t, err, ErrDailyLossLimitExceeded, "CheckSubmit should error on daily loss")
+	assert.Equal(t, 1, om.cancelled, "Kill switch should cancel all orders")
+	halted, reason := r.IsHalted()
+	assert.True(t, halted, "IsHalted should return true")
+	assert.ErrorIs(t, reason, ErrDailyLossLimitExceeded, "IsHalted should return the breach")
+
+	om.orders = nil
+	assert.ErrorIs(t, r.CheckSubmit(context.Background(), riskTestSubmit(100, 1)), ErrKillSwitchEngaged, "CheckSubmit should error when halted")
+	assert.Equal(t, 1, om.cancelled, "Kill switch should only cancel orders once")
+
+	require.NoError(t, r.ResetKillSwitch(), "ResetKillSwitch must not error")
+	assert.ErrorIs(t, r.ResetKillSwitch(), errKillSwitchNotActive, "ResetKillSwitch should error when not halted")
+	assert.NoError(t, r.CheckSubmit(context.Background(), riskTestSubmit(100, 1)), "CheckSubmit should not error after reset")
+}
+
+func TestRiskManagerDailyLossTrades(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	om := &fakeOrderStateManager{
+		orders: []order.Detail{
+			{
+				Exchange: riskTestExchange, Pair: btcusdPair, AssetType: asset.Spot, Side: order.Buy, Status: order.PartiallyFilled,
+				Price: 150, Amount: 3, ExecutedAmount: 2, LastUpdated: now,
+				Trades: []order.TradeHistory{
+					{Price: 150, Amount: 1, Timestamp: now.Add(-time.Hour * 48)},
+					{Price: 150, Amount: 1, Timestamp: now},
+				},
+			},
+		},
+	}
+	r := setupRiskTest(t, om, &config.RiskManager{
+		KillSwitch: true,
+		Limits:     []config.RiskLimit{{Exchange: riskTestExchange, MaxDailyLoss: 60}},
+	})
+	assert.NoError(t, r.CheckSubmit(context.Background(), riskTestSubmit(100, 1)), "CheckSubmit should only count trades executed today")
+
+	om.orders[0].Trades[0].Timestamp = now
+	assert.ErrorIs(t, r.CheckSubmit(context.Background(), riskTestSubmit(100, 1)), ErrDailyLossLimitExceeded, "CheckSubmit should count all trades executed today")
+}
+
+func TestRiskManagerNoReferencePrice(t *testing.T) {
+	t.Parallel()
+	om := &fakeOrderStateManager{}
+	r := setupRiskTest(t, om, &config.RiskManager{
+		KillSwitch: true,
+		Limits:     []config.RiskLimit{{Exchange: riskTestExchange, MaxOrderNotional: 500, PriceBandPercentage: 10}},
+	})
+	s := riskTestSubmit(100, 1)
+	s.Pair = currency.NewPair(currency.XRP, currency.USD)
+	err := r.CheckSubmit(context.Background(), s)
+	assert.ErrorIs(t, err, errNoReferencePrice, "CheckSubmit should error without a reference price")
+	assert.NotErrorIs(t, err, ErrRiskLimitBreached, "CheckSubmit should not report a missing reference price as a breach")
+
+	s.Price = 0
+	assert.ErrorIs(t, r.CheckSubmit(context.Background(), s), errNoReferencePrice, "CheckSubmit should error valuing a market order without a reference price")
+	assert.Zero(t, om.cancelled, "Kill switch should not cancel orders without a breach")
+	halted, _ := r.IsHalted()
+	assert.False(t, halted, "IsHalted should return false without a breach")
+}
+
+func TestOrderManagerRiskManager(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(omfExchange{IBotExchange: exch}), "Add must not error")
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	m.started = 1
+
+	r, err := SetupRiskManager(em, m, &CommunicationManager{}, &config.RiskManager{
+		Limits: []config.RiskLimit{{MaxOrderNotional: 1}},
+	})
+	require.NoError(t, err, "SetupRiskManager must not error")
+	require.NoError(t, r.Start(), "Start must not error")
+	defer func() {
+		assert.NoError(t, r.Stop(), "Stop should not error")
+	}()
+
+	var nilManager *OrderManager
+	assert.ErrorIs(t, nilManager.SetRiskManager(r), ErrNilSubsystem, "SetRiskManager should error on nil order manager")
+	require.NoError(t, m.SetRiskManager(r), "SetRiskManager must not error")
+	submit := &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Limit,
+		Side:      order.Buy,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Price:     100,
+		Amount:    1,
+	}
+	_, err = m.SubmitFakeOrder(submit, &order.SubmitResponse{}, false)
+	assert.ErrorIs(t, err, ErrMaxOrderNotionalExceeded, "SubmitFakeOrder should be rejected by the risk manager")
+
+	require.NoError(t, m.SetRiskManager(nil), "SetRiskManager must not error")
+	assert.NoError(t, m.checkSubmitRisk(context.Background(), &order.Submit{}), "checkSubmitRisk should not error without a risk manager")
+}
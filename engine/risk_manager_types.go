@@ -0,0 +1,92 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// RiskManagerName is an exported subsystem name
+const RiskManagerName = "risk_manager"
+
+var (
+	// ErrRiskLimitBreached is returned when an order is rejected by the risk
+	// manager, all specific risk errors wrap this error
+	ErrRiskLimitBreached = errors.New("risk limit breached")
+	// ErrMaxOrderNotionalExceeded is returned when an order value exceeds the
+	// configured maximum notional
+	ErrMaxOrderNotionalExceeded = fmt.Errorf("%w: max order notional exceeded", ErrRiskLimitBreached)
+	// ErrMaxOpenOrdersExceeded is returned when an order would exceed the
+	// configured number of active orders
+	ErrMaxOpenOrdersExceeded = fmt.Errorf("%w: max open orders exceeded", ErrRiskLimitBreached)
+	// ErrMaxPositionSizeExceeded is returned when an order would increase a
+	// position beyond the configured maximum
+	ErrMaxPositionSizeExceeded = fmt.Errorf("%w: max position size exceeded", ErrRiskLimitBreached)
+	// ErrDailyLossLimitExceeded is returned when the loss for the day has
+	// reached the configured maximum
+	ErrDailyLossLimitExceeded = fmt.Errorf("%w: daily loss limit exceeded", ErrRiskLimitBreached)
+	// ErrPriceOutsideBand is returned when an order price deviates too far
+	// from the last ticker price
+	ErrPriceOutsideBand = fmt.Errorf("%w: price outside of allowed band", ErrRiskLimitBreached)
+	// ErrKillSwitchEngaged is returned when trading has been halted after a
+	// risk limit breach
+	ErrKillSwitchEngaged = fmt.Errorf("%w: kill switch engaged", ErrRiskLimitBreached)
+
+	errNilOrderManager     = errors.New("cannot start with nil order manager")
+	errNilModify           = errors.New("nil order modify received")
+	errNoReferencePrice    = errors.New("no reference price available")
+	errKillSwitchNotActive = errors.New("kill switch is not engaged")
+)
+
+// RiskManager enforces pre-trade risk limits on all orders submitted or
+// modified through the order manager
+type RiskManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iOrderStateManager
+	commsManager    iCommsManager
+	limits          []config.RiskLimit
+	killSwitch      bool
+	checkInterval   time.Duration
+	verbose         bool
+	halted          bool
+	haltReason      error
+	m               sync.RWMutex
+}
+
+// RiskBreach details an order rejection or limit breach raised by the risk
+// manager
+type RiskBreach struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Value    float64
+	Limit    float64
+	Err      error
+}
+
+// Error implements the error interface
+func (r *RiskBreach) Error() string {
+	return fmt.Sprintf("%v %v %v: %v, value %v limit %v", r.Exchange, r.Asset, r.Pair, r.Err, r.Value, r.Limit)
+}
+
+// Unwrap returns the underlying risk error
+func (r *RiskBreach) Unwrap() error {
+	return r.Err
+}
+
+// pairPosition tracks the net executed amount and cash flow for a pair
+type pairPosition struct {
+	exchange string
+	asset    asset.Item
+	pair     currency.Pair
+	amount   float64
+	cash     float64
+}
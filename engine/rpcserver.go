@@ -32,24 +32,31 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderhistory"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/feeschedule"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/triangular"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/accounting"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
@@ -80,6 +87,9 @@ var (
 	errInvalidStrategy         = errors.New("invalid strategy")
 	errSpecificPairNotEnabled  = errors.New("specified pair is not enabled")
 	errPairNotEnabled          = errors.New("pair is not enabled")
+	errOptionNotFound          = errors.New("option not found in the option chain")
+	errNoOrderbookMid          = errors.New("orderbook requires a bid and an ask to derive a mid price")
+	errNoUnderlyingPrice       = errors.New("underlying price must be greater than zero")
 )
 
 // RPCServer struct
@@ -6016,3 +6026,2121 @@ func (s *RPCServer) GetOpenInterest(ctx context.Context, r *gctrpc.GetOpenIntere
 		Data: resp,
 	}, nil
 }
+
+// CreateExecutionOrder creates a TWAP, VWAP or iceberg parent order which is
+// executed as a series of child orders by the algo execution manager
+func (s *RPCServer) CreateExecutionOrder(ctx context.Context, r *gctrpc.CreateExecutionOrderRequest) (*gctrpc.ExecutionOrder, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if r.StartTime != "" {
+		start, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+		}
+	}
+	if r.EndTime != "" {
+		end, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.EndTime)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+		}
+	}
+
+	id, err := s.algoExecutionManager.Create(ctx, &ParentOrder{
+		Exchange:       r.Exchange,
+		Asset:          a,
+		Pair:           p,
+		Side:           side,
+		Strategy:       ExecutionStrategy(strings.ToUpper(r.Strategy)),
+		Amount:         r.Amount,
+		LimitPrice:     r.LimitPrice,
+		StartTime:      start,
+		EndTime:        end,
+		Slices:         r.Slices,
+		VisibleAmount:  r.VisibleAmount,
+		VolumeInterval: kline.Interval(r.VolumeInterval),
+		VolumeLookback: time.Duration(r.VolumeLookback),
+	})
+	if err != nil {
+		return nil, err
+	}
+	parent, err := s.algoExecutionManager.GetOrder(id)
+	if err != nil {
+		return nil, err
+	}
+	return parentOrderToRPC(parent), nil
+}
+
+// PauseExecutionOrder stops new child orders being submitted for a parent
+// order
+func (s *RPCServer) PauseExecutionOrder(_ context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if err := s.algoExecutionManager.Pause(r.Id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "paused execution order " + r.Id}, nil
+}
+
+// ResumeExecutionOrder continues a paused parent order
+func (s *RPCServer) ResumeExecutionOrder(_ context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if err := s.algoExecutionManager.Resume(r.Id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "resumed execution order " + r.Id}, nil
+}
+
+// CancelExecutionOrder cancels a parent order along with its resting child
+// orders
+func (s *RPCServer) CancelExecutionOrder(ctx context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if err := s.algoExecutionManager.Cancel(ctx, r.Id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "cancelled execution order " + r.Id}, nil
+}
+
+// GetExecutionOrder returns the status, child orders and slippage summary of
+// a parent order
+func (s *RPCServer) GetExecutionOrder(_ context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.ExecutionOrder, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	parent, err := s.algoExecutionManager.GetOrder(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return parentOrderToRPC(parent), nil
+}
+
+// GetExecutionOrders returns all parent orders handled by the algo execution
+// manager
+func (s *RPCServer) GetExecutionOrders(_ context.Context, _ *gctrpc.GetExecutionOrdersRequest) (*gctrpc.GetExecutionOrdersResponse, error) {
+	parents, err := s.algoExecutionManager.GetOrders()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetExecutionOrdersResponse{
+		Orders: make([]*gctrpc.ExecutionOrder, len(parents)),
+	}
+	for i := range parents {
+		resp.Orders[i] = parentOrderToRPC(&parents[i])
+	}
+	return resp, nil
+}
+
+// parentOrderToRPC converts a parent order to its gRPC representation
+func parentOrderToRPC(p *ParentOrder) *gctrpc.ExecutionOrder {
+	resp := &gctrpc.ExecutionOrder{
+		Id:       p.ID,
+		Exchange: p.Exchange,
+		Asset:    p.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Pair.Delimiter,
+			Base:      p.Pair.Base.String(),
+			Quote:     p.Pair.Quote.String(),
+		},
+		Side:          p.Side.String(),
+		Strategy:      string(p.Strategy),
+		Amount:        p.Amount,
+		LimitPrice:    p.LimitPrice,
+		StartTime:     p.StartTime.Format(common.SimpleTimeFormatWithTimezone),
+		Slices:        p.Slices,
+		VisibleAmount: p.VisibleAmount,
+		Status:        string(p.Status),
+		Reason:        p.Reason,
+		Summary: &gctrpc.ExecutionSummary{
+			ArrivalPrice:        p.ArrivalPrice,
+			FilledAmount:        p.FilledAmount,
+			RemainingAmount:     p.Amount - p.FilledAmount,
+			AverageFillPrice:    p.AverageFillPrice,
+			SlippageBasisPoints: p.SlippageBasisPoints,
+			SlippageCost:        p.SlippageCost,
+		},
+		ChildOrders: make([]*gctrpc.ExecutionChildOrder, len(p.Children)),
+		CreatedAt:   p.CreatedDate.Format(common.SimpleTimeFormatWithTimezone),
+		UpdatedAt:   p.UpdatedDate.Format(common.SimpleTimeFormatWithTimezone),
+	}
+	if !p.EndTime.IsZero() {
+		resp.EndTime = p.EndTime.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	for i := range p.Children {
+		resp.ChildOrders[i] = &gctrpc.ExecutionChildOrder{
+			OrderId:      p.Children[i].OrderID,
+			Amount:       p.Children[i].Amount,
+			FilledAmount: p.Children[i].FilledAmount,
+			AveragePrice: p.Children[i].AveragePrice,
+			Status:       p.Children[i].Status.String(),
+			SubmittedAt:  p.Children[i].SubmittedDate.Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp
+}
+
+// RouteOrder splits an order across exchanges using their consolidated
+// orderbook depth, taker fees and available balances. A dry run returns the
+// routing plan without submitting any orders
+func (s *RPCServer) RouteOrder(ctx context.Context, r *gctrpc.RouteOrderRequest) (*gctrpc.RouteOrderResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	report, err := s.smartOrderRouter.Route(ctx, &RouteRequest{
+		Exchanges: r.Exchanges,
+		Pair: currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		},
+		Asset:      a,
+		Side:       side,
+		Amount:     r.Amount,
+		LimitPrice: r.LimitPrice,
+		DryRun:     r.DryRun,
+	})
+	if err != nil {
+		if report == nil || len(report.Excluded) == 0 {
+			return nil, err
+		}
+		reasons := make([]string, len(report.Excluded))
+		for i := range report.Excluded {
+			reasons[i] = report.Excluded[i].Exchange + ": " + report.Excluded[i].Reason
+		}
+		return nil, fmt.Errorf("%w, excluded %s", err, strings.Join(reasons, ", "))
+	}
+
+	resp := &gctrpc.RouteOrderResponse{
+		Asset: report.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: report.Pair.Delimiter,
+			Base:      report.Pair.Base.String(),
+			Quote:     report.Pair.Quote.String(),
+		},
+		Side:                  report.Side.String(),
+		RequestedAmount:       report.RequestedAmount,
+		AllocatedAmount:       report.AllocatedAmount,
+		UnallocatedAmount:     report.UnallocatedAmount,
+		EstimatedAveragePrice: report.EstimatedAveragePrice,
+		EstimatedFees:         report.EstimatedFees,
+		FilledAmount:          report.FilledAmount,
+		AverageFillPrice:      report.AverageFillPrice,
+		DryRun:                report.DryRun,
+		Allocations:           make([]*gctrpc.RouteOrderAllocation, len(report.Allocations)),
+		Excluded:              make([]*gctrpc.RouteOrderExclusion, len(report.Excluded)),
+	}
+	for i := range report.Allocations {
+		resp.Allocations[i] = &gctrpc.RouteOrderAllocation{
+			Exchange:     report.Allocations[i].Exchange,
+			Amount:       report.Allocations[i].Amount,
+			AveragePrice: report.Allocations[i].AveragePrice,
+			WorstPrice:   report.Allocations[i].WorstPrice,
+			FeeRate:      report.Allocations[i].FeeRate,
+			EstimatedFee: report.Allocations[i].EstimatedFee,
+			OrderId:      report.Allocations[i].OrderID,
+			FilledAmount: report.Allocations[i].FilledAmount,
+			FillPrice:    report.Allocations[i].FillPrice,
+		}
+		if !report.DryRun {
+			resp.Allocations[i].Status = report.Allocations[i].Status.String()
+		}
+		if report.Allocations[i].Err != nil {
+			resp.Allocations[i].Error = report.Allocations[i].Err.Error()
+		}
+	}
+	for i := range report.Excluded {
+		resp.Excluded[i] = &gctrpc.RouteOrderExclusion{
+			Exchange: report.Excluded[i].Exchange,
+			Reason:   report.Excluded[i].Reason,
+		}
+	}
+	return resp, nil
+}
+
+// GetArbitrageOpportunityStream streams cross-exchange arbitrage opportunities
+// found by the arbitrage scanner, starting with all current opportunities
+// which match the request filters
+func (s *RPCServer) GetArbitrageOpportunityStream(r *gctrpc.GetArbitrageOpportunityStreamRequest, stream gctrpc.GoCryptoTraderService_GetArbitrageOpportunityStreamServer) error {
+	if r == nil {
+		return errNilRequestData
+	}
+	var a asset.Item
+	if r.Asset != "" {
+		var err error
+		a, err = asset.New(r.Asset)
+		if err != nil {
+			return err
+		}
+	}
+	var pair currency.Pair
+	if r.Pair != nil {
+		pair = currency.NewPair(currency.NewCode(r.Pair.Base), currency.NewCode(r.Pair.Quote))
+		k := normaliseArbitragePair(pair, a)
+		pair = k.Pair()
+	}
+	matches := func(o *ArbitrageOpportunity) bool {
+		if o.NetSpreadBasisPoints < r.MinimumSpreadBasisPoints ||
+			(a != asset.Empty && o.Asset != a) ||
+			(!pair.IsEmpty() && !o.Pair.Equal(pair)) {
+			return false
+		}
+		if len(r.Exchanges) == 0 {
+			return true
+		}
+		var buy, sell bool
+		for i := range r.Exchanges {
+			buy = buy || strings.EqualFold(r.Exchanges[i], o.BuyExchange)
+			sell = sell || strings.EqualFold(r.Exchanges[i], o.SellExchange)
+		}
+		return buy && sell
+	}
+
+	id, ch, err := s.arbitrageScanner.Subscribe()
+	if err != nil {
+		return err
+	}
+	defer s.arbitrageScanner.Unsubscribe(id)
+
+	current, err := s.arbitrageScanner.GetOpportunities()
+	if err != nil {
+		return err
+	}
+	for i := range current {
+		if !matches(&current[i]) {
+			continue
+		}
+		if err = stream.Send(arbitrageOpportunityToRPC(&current[i])); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case o, ok := <-ch:
+			if !ok {
+				return fmt.Errorf("%s %w", ArbitrageScannerName, ErrSubSystemNotStarted)
+			}
+			if !matches(&o) {
+				continue
+			}
+			if err = stream.Send(arbitrageOpportunityToRPC(&o)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// arbitrageOpportunityToRPC converts an arbitrage opportunity to its RPC
+// representation
+func arbitrageOpportunityToRPC(o *ArbitrageOpportunity) *gctrpc.ArbitrageOpportunity {
+	return &gctrpc.ArbitrageOpportunity{
+		Asset: o.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: o.Pair.Delimiter,
+			Base:      o.Pair.Base.String(),
+			Quote:     o.Pair.Quote.String(),
+		},
+		BuyExchange:          o.BuyExchange,
+		SellExchange:         o.SellExchange,
+		BuyPrice:             o.BuyPrice,
+		SellPrice:            o.SellPrice,
+		Amount:               o.Amount,
+		AverageBuyPrice:      o.AverageBuyPrice,
+		AverageSellPrice:     o.AverageSellPrice,
+		GrossProfit:          o.GrossProfit,
+		TradingFees:          o.TradingFees,
+		WithdrawalFee:        o.WithdrawalFee,
+		NetProfit:            o.NetProfit,
+		NetSpreadBasisPoints: o.NetSpreadBasisPoints,
+		Time:                 o.Time.Format(common.SimpleTimeFormatWithTimezone),
+	}
+}
+
+// GetTriangularArbitrageStream streams profitable triangular cycles between
+// the enabled pairs of an exchange. All cycles are evaluated on request, after
+// which only the cycles trading a pair are re-evaluated when its orderbook
+// updates
+func (s *RPCServer) GetTriangularArbitrageStream(r *gctrpc.GetTriangularArbitrageStreamRequest, stream gctrpc.GoCryptoTraderService_GetTriangularArbitrageStreamServer) error {
+	if r == nil {
+		return errNilRequestData
+	}
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	a := asset.Spot
+	if r.Asset != "" {
+		var err error
+		a, err = asset.New(r.Asset)
+		if err != nil {
+			return err
+		}
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return err
+	}
+	pairs, err := exch.GetEnabledPairs(a)
+	if err != nil {
+		return err
+	}
+	graph, err := triangular.NewGraph(pairs)
+	if err != nil {
+		return err
+	}
+	// Fees are retrieved for a single unit at a price of one so that the fee
+	// returned is the rate applied to every leg
+	feeRate, err := exch.GetFeeByType(stream.Context(), &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          pairs[0],
+		PurchasePrice: 1,
+		Amount:        1,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to retrieve taker fee: %w", err)
+	}
+	params := &triangular.Params{
+		FeeRate:                  feeRate,
+		MinimumProfitBasisPoints: r.MinimumProfitBasisPoints,
+		MaximumAmount:            r.MaximumAmount,
+	}
+	if r.StartCurrency != "" {
+		params.Start = currency.NewCode(r.StartCurrency)
+	}
+	name := exch.GetName()
+	depth := func(p currency.Pair) (*orderbook.Depth, error) {
+		return orderbook.GetDepth(name, p, a)
+	}
+	send := func(cycles []triangular.Cycle) error {
+		opps, err := triangular.Evaluate(cycles, depth, params)
+		if err != nil {
+			return err
+		}
+		for i := range opps {
+			if err := stream.Send(triangularOpportunityToRPC(name, a, &opps[i])); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	pipe, err := orderbook.SubscribeToExchangeOrderbooks(name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		pipeErr := pipe.Release()
+		if pipeErr != nil {
+			log.Errorln(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	if err = send(graph.Cycles()); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return errDispatchSystem
+			}
+			d, ok := data.(orderbook.Outbound)
+			if !ok {
+				return common.GetTypeAssertError("orderbook.Outbound", data)
+			}
+			ob, err := d.Retrieve()
+			if err != nil || ob.Asset != a {
+				continue
+			}
+			if err = send(graph.CyclesWithPair(ob.Pair)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// triangularOpportunityToRPC converts a triangular arbitrage opportunity to
+// its RPC representation
+func triangularOpportunityToRPC(exch string, a asset.Item, o *triangular.Opportunity) *gctrpc.TriangularArbitrageOpportunity {
+	resp := &gctrpc.TriangularArbitrageOpportunity{
+		Exchange:          exch,
+		Asset:             a.String(),
+		Cycle:             o.Cycle.String(),
+		StartCurrency:     o.Cycle[0].From.String(),
+		StartAmount:       o.StartAmount,
+		EndAmount:         o.EndAmount,
+		Profit:            o.Profit,
+		ProfitBasisPoints: o.ProfitBasisPoints,
+		Legs:              make([]*gctrpc.TriangularArbitrageLeg, len(o.Legs)),
+		Time:              time.Now().Format(common.SimpleTimeFormatWithTimezone),
+	}
+	for i := range o.Legs {
+		resp.Legs[i] = &gctrpc.TriangularArbitrageLeg{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: o.Legs[i].Pair.Delimiter,
+				Base:      o.Legs[i].Pair.Base.String(),
+				Quote:     o.Legs[i].Pair.Quote.String(),
+			},
+			Side:         o.Legs[i].Side.String(),
+			From:         o.Legs[i].From.String(),
+			To:           o.Legs[i].To.String(),
+			AmountIn:     o.Legs[i].AmountIn,
+			AmountOut:    o.Legs[i].AmountOut,
+			BaseAmount:   o.Legs[i].BaseAmount,
+			AveragePrice: o.Legs[i].AveragePrice,
+			Fee:          o.Legs[i].Fee,
+		}
+	}
+	return resp
+}
+
+// AddMarketMakingStrategy starts continuously quoting a pair with layers of
+// bids and asks around an inventory skewed fair value
+func (s *RPCServer) AddMarketMakingStrategy(ctx context.Context, r *gctrpc.AddMarketMakingStrategyRequest) (*gctrpc.MarketMakingStrategy, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.marketMakingManager.Add(ctx, &MarketMakingStrategy{
+		Exchange:                    r.Exchange,
+		Asset:                       a,
+		Pair:                        p,
+		SpreadBasisPoints:           r.SpreadBasisPoints,
+		Layers:                      r.Layers,
+		LayerSpacingBasisPoints:     r.LayerSpacingBasisPoints,
+		OrderAmount:                 r.OrderAmount,
+		TargetInventory:             r.TargetInventory,
+		MaxInventory:                r.MaxInventory,
+		InventorySkewBasisPoints:    r.InventorySkewBasisPoints,
+		RequoteThresholdBasisPoints: r.RequoteThresholdBasisPoints,
+		PostOnly:                    r.PostOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := s.marketMakingManager.GetStrategy(id)
+	if err != nil {
+		return nil, err
+	}
+	return marketMakingStrategyToRPC(strategy), nil
+}
+
+// RemoveMarketMakingStrategy stops a market making strategy and cancels its
+// quotes
+func (s *RPCServer) RemoveMarketMakingStrategy(ctx context.Context, r *gctrpc.MarketMakingStrategyRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if err := s.marketMakingManager.Remove(ctx, r.Id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "removed market making strategy " + r.Id}, nil
+}
+
+// GetMarketMakingStrategy returns the quotes, inventory and profit and loss
+// of a market making strategy
+func (s *RPCServer) GetMarketMakingStrategy(_ context.Context, r *gctrpc.MarketMakingStrategyRequest) (*gctrpc.MarketMakingStrategy, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	strategy, err := s.marketMakingManager.GetStrategy(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return marketMakingStrategyToRPC(strategy), nil
+}
+
+// GetMarketMakingStrategies returns all strategies handled by the market
+// making manager
+func (s *RPCServer) GetMarketMakingStrategies(_ context.Context, _ *gctrpc.GetMarketMakingStrategiesRequest) (*gctrpc.GetMarketMakingStrategiesResponse, error) {
+	strategies, err := s.marketMakingManager.GetStrategies()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetMarketMakingStrategiesResponse{
+		Strategies: make([]*gctrpc.MarketMakingStrategy, len(strategies)),
+	}
+	for i := range strategies {
+		resp.Strategies[i] = marketMakingStrategyToRPC(&strategies[i])
+	}
+	return resp, nil
+}
+
+// marketMakingStrategyToRPC converts a market making strategy to its gRPC
+// representation
+func marketMakingStrategyToRPC(m *MarketMakingStrategy) *gctrpc.MarketMakingStrategy {
+	resp := &gctrpc.MarketMakingStrategy{
+		Id:       m.ID,
+		Exchange: m.Exchange,
+		Asset:    m.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: m.Pair.Delimiter,
+			Base:      m.Pair.Base.String(),
+			Quote:     m.Pair.Quote.String(),
+		},
+		SpreadBasisPoints:           m.SpreadBasisPoints,
+		Layers:                      m.Layers,
+		LayerSpacingBasisPoints:     m.LayerSpacingBasisPoints,
+		OrderAmount:                 m.OrderAmount,
+		TargetInventory:             m.TargetInventory,
+		MaxInventory:                m.MaxInventory,
+		InventorySkewBasisPoints:    m.InventorySkewBasisPoints,
+		RequoteThresholdBasisPoints: m.RequoteThresholdBasisPoints,
+		PostOnly:                    m.PostOnly,
+		Status:                      string(m.Status),
+		Reason:                      m.Reason,
+		Quotes:                      make([]*gctrpc.MarketMakingQuote, len(m.Quotes)),
+		Metrics: &gctrpc.MarketMakingMetrics{
+			MidPrice:          m.Metrics.MidPrice,
+			FairValue:         m.Metrics.FairValue,
+			Inventory:         m.Metrics.Inventory,
+			Position:          m.Metrics.Position,
+			AverageEntryPrice: m.Metrics.AverageEntryPrice,
+			RealisedPnl:       m.Metrics.RealisedPNL,
+			UnrealisedPnl:     m.Metrics.UnrealisedPNL,
+			BuyVolume:         m.Metrics.BuyVolume,
+			SellVolume:        m.Metrics.SellVolume,
+			Fills:             m.Metrics.Fills,
+			Requotes:          m.Metrics.Requotes,
+		},
+		CreatedAt: m.CreatedDate.Format(common.SimpleTimeFormatWithTimezone),
+		UpdatedAt: m.UpdatedDate.Format(common.SimpleTimeFormatWithTimezone),
+	}
+	if !m.Metrics.LastFill.IsZero() {
+		resp.Metrics.LastFill = m.Metrics.LastFill.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	for i := range m.Quotes {
+		resp.Quotes[i] = &gctrpc.MarketMakingQuote{
+			OrderId:      m.Quotes[i].OrderID,
+			Side:         m.Quotes[i].Side.String(),
+			Layer:        m.Quotes[i].Layer,
+			Price:        m.Quotes[i].Price,
+			Amount:       m.Quotes[i].Amount,
+			FilledAmount: m.Quotes[i].FilledAmount,
+		}
+	}
+	return resp
+}
+
+// CreateGrid creates a grid of limit orders between a lower and upper price
+func (s *RPCServer) CreateGrid(ctx context.Context, r *gctrpc.CreateGridRequest) (*gctrpc.Grid, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.gridTradingManager.Create(ctx, &Grid{
+		Exchange:       r.Exchange,
+		Asset:          a,
+		Pair:           p,
+		LowerPrice:     r.LowerPrice,
+		UpperPrice:     r.UpperPrice,
+		Levels:         r.Levels,
+		Spacing:        GridSpacing(r.Spacing),
+		AmountPerLevel: r.AmountPerLevel,
+	})
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.gridTradingManager.GetGrid(id)
+	if err != nil {
+		return nil, err
+	}
+	return gridToRPC(g), nil
+}
+
+// StopGrid stops a grid and cancels its resting orders
+func (s *RPCServer) StopGrid(ctx context.Context, r *gctrpc.GridRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if err := s.gridTradingManager.StopGrid(ctx, r.Id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "stopped grid " + r.Id}, nil
+}
+
+// GetGrid returns the levels and realised profit of a grid
+func (s *RPCServer) GetGrid(_ context.Context, r *gctrpc.GridRequest) (*gctrpc.Grid, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	g, err := s.gridTradingManager.GetGrid(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return gridToRPC(g), nil
+}
+
+// GetGrids returns all grids handled by the grid trading manager
+func (s *RPCServer) GetGrids(_ context.Context, _ *gctrpc.GetGridsRequest) (*gctrpc.GetGridsResponse, error) {
+	grids, err := s.gridTradingManager.GetGrids()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetGridsResponse{
+		Grids: make([]*gctrpc.Grid, len(grids)),
+	}
+	for i := range grids {
+		resp.Grids[i] = gridToRPC(&grids[i])
+	}
+	return resp, nil
+}
+
+// gridToRPC converts a grid to its gRPC representation
+func gridToRPC(g *Grid) *gctrpc.Grid {
+	resp := &gctrpc.Grid{
+		Id:       g.ID,
+		Exchange: g.Exchange,
+		Asset:    g.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: g.Pair.Delimiter,
+			Base:      g.Pair.Base.String(),
+			Quote:     g.Pair.Quote.String(),
+		},
+		LowerPrice:     g.LowerPrice,
+		UpperPrice:     g.UpperPrice,
+		Levels:         g.Levels,
+		Spacing:        string(g.Spacing),
+		AmountPerLevel: g.AmountPerLevel,
+		Status:         string(g.Status),
+		Reason:         g.Reason,
+		RealisedProfit: g.RealisedProfit,
+		RoundTrips:     g.RoundTrips,
+		GridLevels:     make([]*gctrpc.GridLevel, len(g.GridLevels)),
+		CreatedAt:      g.CreatedDate.Format(common.SimpleTimeFormatWithTimezone),
+		UpdatedAt:      g.UpdatedDate.Format(common.SimpleTimeFormatWithTimezone),
+	}
+	for i := range g.GridLevels {
+		resp.GridLevels[i] = &gctrpc.GridLevel{
+			Level:      g.GridLevels[i].Level,
+			Price:      g.GridLevels[i].Price,
+			OrderId:    g.GridLevels[i].OrderID,
+			EntryPrice: g.GridLevels[i].EntryPrice,
+		}
+		if g.GridLevels[i].Side != order.UnknownSide {
+			resp.GridLevels[i].Side = g.GridLevels[i].Side.String()
+		}
+	}
+	return resp
+}
+
+// SearchOrderHistory returns orders recorded to the order history database
+// filtered by exchange, asset, pair, status and creation date
+func (s *RPCServer) SearchOrderHistory(_ context.Context, r *gctrpc.SearchOrderHistoryRequest) (*gctrpc.SearchOrderHistoryResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	f := &orderhistory.Filter{
+		Exchange: r.Exchange,
+		Limit:    int(r.Limit),
+	}
+	if r.Asset != "" {
+		a, err := asset.New(r.Asset)
+		if err != nil {
+			return nil, err
+		}
+		f.Asset = a.String()
+	}
+	if r.Pair != nil {
+		f.Base = r.Pair.Base
+		f.Quote = r.Pair.Quote
+	}
+	for i := range r.Statuses {
+		status, err := order.StringToOrderStatus(r.Statuses[i])
+		if err != nil {
+			return nil, err
+		}
+		f.Statuses = append(f.Statuses, status.String())
+	}
+	var err error
+	if r.StartDate != "" {
+		f.StartDate, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+		}
+	}
+	if r.EndDate != "" {
+		f.EndDate, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+		}
+	}
+	if !f.StartDate.IsZero() && !f.EndDate.IsZero() {
+		err = common.StartEndTimeCheck(f.StartDate, f.EndDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	orders, err := s.OrderManager.SearchOrderHistory(f)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.SearchOrderHistoryResponse{
+		Orders: make([]*gctrpc.HistoricalOrder, len(orders)),
+	}
+	for i := range orders {
+		resp.Orders[i] = historicalOrderToRPC(&orders[i])
+	}
+	return resp, nil
+}
+
+// GetOrderAuditTrail returns an order recorded to the order history database
+// along with every lifecycle event recorded for it
+func (s *RPCServer) GetOrderAuditTrail(_ context.Context, r *gctrpc.GetOrderAuditTrailRequest) (*gctrpc.GetOrderAuditTrailResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if r.InternalOrderId == "" {
+		return nil, ErrOrderIDCannotBeEmpty
+	}
+	o, events, err := s.OrderManager.GetOrderAuditTrail(r.InternalOrderId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetOrderAuditTrailResponse{
+		Order:  historicalOrderToRPC(o),
+		Events: make([]*gctrpc.OrderAuditEvent, len(events)),
+	}
+	for i := range events {
+		resp.Events[i] = &gctrpc.OrderAuditEvent{
+			Event:          events[i].Event,
+			Status:         events[i].Status,
+			Price:          events[i].Price,
+			Amount:         events[i].Amount,
+			ExecutedAmount: events[i].ExecutedAmount,
+			Message:        events[i].Message,
+			Timestamp:      events[i].CreatedDate.Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
+// historicalOrderToRPC converts an order history database order to its gRPC
+// representation
+func historicalOrderToRPC(o *orderhistory.Order) *gctrpc.HistoricalOrder {
+	resp := &gctrpc.HistoricalOrder{
+		InternalOrderId: o.ID,
+		Order: &gctrpc.OrderDetails{
+			Exchange:      o.Exchange,
+			Id:            o.OrderID,
+			ClientOrderId: o.ClientOrderID,
+			BaseCurrency:  o.Base,
+			QuoteCurrency: o.Quote,
+			AssetType:     o.Asset,
+			OrderSide:     o.Side,
+			OrderType:     o.Type,
+			CreationTime:  o.CreatedDate.Format(common.SimpleTimeFormatWithTimezone),
+			UpdateTime:    o.UpdatedDate.Format(common.SimpleTimeFormatWithTimezone),
+			Status:        o.Status,
+			Price:         o.Price,
+			Amount:        o.Amount,
+			OpenVolume:    o.RemainingAmount,
+			Fee:           o.Fee,
+			Cost:          o.Cost,
+			Trades:        make([]*gctrpc.TradeHistory, len(o.Trades)),
+		},
+	}
+	for i := range o.Trades {
+		resp.Order.Trades[i] = &gctrpc.TradeHistory{
+			CreationTime: o.Trades[i].Timestamp.Unix(),
+			Id:           o.Trades[i].TID,
+			Price:        o.Trades[i].Price,
+			Amount:       o.Trades[i].Amount,
+			Exchange:     o.Exchange,
+			AssetType:    o.Asset,
+			OrderSide:    o.Trades[i].Side,
+			Fee:          o.Trades[i].Fee,
+		}
+	}
+	return resp
+}
+
+// ReconcileOrders diffs the orders tracked by the order manager against an
+// exchange and returns the resulting report
+func (s *RPCServer) ReconcileOrders(ctx context.Context, r *gctrpc.ReconcileOrdersRequest) (*gctrpc.OrderReconciliationReport, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	report, err := s.OrderManager.ReconcileOrders(ctx, r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	return reconciliationReportToRPC(report), nil
+}
+
+// GetOrderReconciliations returns the most recent order reconciliation report
+// for each exchange
+func (s *RPCServer) GetOrderReconciliations(_ context.Context, r *gctrpc.GetOrderReconciliationsRequest) (*gctrpc.GetOrderReconciliationsResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	reports, err := s.OrderManager.GetReconciliationReports(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetOrderReconciliationsResponse{
+		Reports: make([]*gctrpc.OrderReconciliationReport, len(reports)),
+	}
+	for i := range reports {
+		resp.Reports[i] = reconciliationReportToRPC(&reports[i])
+	}
+	return resp, nil
+}
+
+// reconciliationReportToRPC converts an order reconciliation report to its
+// gRPC representation
+func reconciliationReportToRPC(r *ReconciliationReport) *gctrpc.OrderReconciliationReport {
+	resp := &gctrpc.OrderReconciliationReport{
+		Exchange:      r.Exchange,
+		Trigger:       r.Trigger,
+		StartTime:     r.StartTime.Format(common.SimpleTimeFormatWithTimezone),
+		EndTime:       r.EndTime.Format(common.SimpleTimeFormatWithTimezone),
+		OrdersChecked: int64(r.OrdersChecked),
+		Changes:       make([]*gctrpc.OrderReconciliationChange, len(r.Changes)),
+		Discrepancies: make([]*gctrpc.OrderReconciliationDiscrepancy, len(r.Discrepancies)),
+		Errors:        r.Errors,
+	}
+	for i := range r.Changes {
+		resp.Changes[i] = &gctrpc.OrderReconciliationChange{
+			InternalOrderId: r.Changes[i].InternalOrderID,
+			OrderId:         r.Changes[i].OrderID,
+			Asset:           r.Changes[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: r.Changes[i].Pair.Delimiter,
+				Base:      r.Changes[i].Pair.Base.String(),
+				Quote:     r.Changes[i].Pair.Quote.String(),
+			},
+			PreviousStatus:         r.Changes[i].PreviousStatus.String(),
+			Status:                 r.Changes[i].Status.String(),
+			PreviousExecutedAmount: r.Changes[i].PreviousExecutedAmount,
+			ExecutedAmount:         r.Changes[i].ExecutedAmount,
+		}
+	}
+	for i := range r.Discrepancies {
+		resp.Discrepancies[i] = &gctrpc.OrderReconciliationDiscrepancy{
+			Type:            r.Discrepancies[i].Type,
+			InternalOrderId: r.Discrepancies[i].InternalOrderID,
+			OrderId:         r.Discrepancies[i].OrderID,
+			Asset:           r.Discrepancies[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: r.Discrepancies[i].Pair.Delimiter,
+				Base:      r.Discrepancies[i].Pair.Base.String(),
+				Quote:     r.Discrepancies[i].Pair.Quote.String(),
+			},
+			Status:         r.Discrepancies[i].Status.String(),
+			ExecutedAmount: r.Discrepancies[i].ExecutedAmount,
+		}
+	}
+	return resp
+}
+
+// AddRebalanceStrategy begins trading holdings across exchanges back towards
+// target weights
+func (s *RPCServer) AddRebalanceStrategy(_ context.Context, r *gctrpc.AddRebalanceStrategyRequest) (*gctrpc.RebalanceStrategy, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	var a asset.Item
+	if r.Asset != "" {
+		var err error
+		a, err = asset.New(r.Asset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	targets := make([]RebalanceTarget, len(r.Targets))
+	for i := range r.Targets {
+		if r.Targets[i] == nil {
+			return nil, errNilRequestData
+		}
+		targets[i] = RebalanceTarget{
+			Currency: currency.NewCode(r.Targets[i].Currency),
+			Weight:   r.Targets[i].Weight,
+		}
+	}
+	id, err := s.portfolioRebalancer.Add(&RebalanceStrategy{
+		Exchanges:          r.Exchanges,
+		Asset:              a,
+		SettlementCurrency: currency.NewCode(r.SettlementCurrency),
+		Targets:            targets,
+		DriftTolerance:     r.DriftTolerance,
+		Trigger:            RebalanceTrigger(strings.ToUpper(r.Trigger)),
+		Interval:           time.Duration(r.Interval),
+	})
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := s.portfolioRebalancer.GetStrategy(id)
+	if err != nil {
+		return nil, err
+	}
+	return rebalanceStrategyToRPC(strategy), nil
+}
+
+// RemoveRebalanceStrategy stops a rebalance strategy
+func (s *RPCServer) RemoveRebalanceStrategy(_ context.Context, r *gctrpc.RebalanceStrategyRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if err := s.portfolioRebalancer.Remove(r.Id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "removed rebalance strategy " + r.Id}, nil
+}
+
+// GetRebalanceStrategies returns all strategies handled by the portfolio
+// rebalancer
+func (s *RPCServer) GetRebalanceStrategies(_ context.Context, _ *gctrpc.GetRebalanceStrategiesRequest) (*gctrpc.GetRebalanceStrategiesResponse, error) {
+	strategies, err := s.portfolioRebalancer.GetStrategies()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetRebalanceStrategiesResponse{
+		Strategies: make([]*gctrpc.RebalanceStrategy, len(strategies)),
+	}
+	for i := range strategies {
+		resp.Strategies[i] = rebalanceStrategyToRPC(&strategies[i])
+	}
+	return resp, nil
+}
+
+// GetRebalancePlan values a strategy's holdings and returns the trades
+// required to rebalance it without placing them
+func (s *RPCServer) GetRebalancePlan(ctx context.Context, r *gctrpc.RebalanceStrategyRequest) (*gctrpc.RebalancePlan, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	plan, err := s.portfolioRebalancer.Plan(ctx, r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return rebalancePlanToRPC(plan), nil
+}
+
+// ExecuteRebalance immediately trades a strategy's holdings back to their
+// target weights
+func (s *RPCServer) ExecuteRebalance(ctx context.Context, r *gctrpc.RebalanceStrategyRequest) (*gctrpc.RebalancePlan, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	plan, err := s.portfolioRebalancer.Rebalance(ctx, r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return rebalancePlanToRPC(plan), nil
+}
+
+// rebalanceStrategyToRPC converts a rebalance strategy to its gRPC
+// representation
+func rebalanceStrategyToRPC(r *RebalanceStrategy) *gctrpc.RebalanceStrategy {
+	resp := &gctrpc.RebalanceStrategy{
+		Id:                 r.ID,
+		Exchanges:          r.Exchanges,
+		Asset:              r.Asset.String(),
+		SettlementCurrency: r.SettlementCurrency.String(),
+		Targets:            make([]*gctrpc.RebalanceTarget, len(r.Targets)),
+		DriftTolerance:     r.DriftTolerance,
+		Trigger:            string(r.Trigger),
+		Interval:           int64(r.Interval),
+		Status:             string(r.Status),
+		Reason:             r.Reason,
+		CreatedAt:          r.CreatedDate.Format(common.SimpleTimeFormatWithTimezone),
+		UpdatedAt:          r.UpdatedDate.Format(common.SimpleTimeFormatWithTimezone),
+	}
+	for i := range r.Targets {
+		resp.Targets[i] = &gctrpc.RebalanceTarget{
+			Currency: r.Targets[i].Currency.String(),
+			Weight:   r.Targets[i].Weight,
+		}
+	}
+	if r.LastPlan != nil {
+		resp.LastPlan = rebalancePlanToRPC(r.LastPlan)
+	}
+	if !r.LastRebalanced.IsZero() {
+		resp.LastRebalanced = r.LastRebalanced.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return resp
+}
+
+// rebalancePlanToRPC converts a rebalance plan to its gRPC representation
+func rebalancePlanToRPC(p *RebalancePlan) *gctrpc.RebalancePlan {
+	resp := &gctrpc.RebalancePlan{
+		StrategyId:         p.StrategyID,
+		SettlementCurrency: p.SettlementCurrency.String(),
+		TotalValue:         p.TotalValue,
+		Holdings:           make([]*gctrpc.RebalanceHolding, len(p.Holdings)),
+		MaxDrift:           p.MaxDrift,
+		RebalanceRequired:  p.RebalanceRequired,
+		Trades:             make([]*gctrpc.RebalanceTrade, len(p.Trades)),
+		DryRun:             p.DryRun,
+		Executed:           p.Executed,
+		Timestamp:          p.Timestamp.Format(common.SimpleTimeFormatWithTimezone),
+	}
+	for i := range p.Holdings {
+		resp.Holdings[i] = &gctrpc.RebalanceHolding{
+			Currency:     p.Holdings[i].Currency.String(),
+			Amount:       p.Holdings[i].Amount,
+			Price:        p.Holdings[i].Price,
+			Value:        p.Holdings[i].Value,
+			Weight:       p.Holdings[i].Weight,
+			TargetWeight: p.Holdings[i].TargetWeight,
+			Drift:        p.Holdings[i].Drift,
+		}
+	}
+	for i := range p.Trades {
+		resp.Trades[i] = &gctrpc.RebalanceTrade{
+			Exchange: p.Trades[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Trades[i].Pair.Delimiter,
+				Base:      p.Trades[i].Pair.Base.String(),
+				Quote:     p.Trades[i].Pair.Quote.String(),
+			},
+			Side:    p.Trades[i].Side.String(),
+			Amount:  p.Trades[i].Amount,
+			Price:   p.Trades[i].Price,
+			Value:   p.Trades[i].Value,
+			OrderId: p.Trades[i].OrderID,
+			Skipped: p.Trades[i].Skipped,
+			Error:   p.Trades[i].Error,
+		}
+	}
+	return resp
+}
+
+// GetPortfolioSnapshots returns the portfolio valuations stored between the
+// start and end dates
+func (s *RPCServer) GetPortfolioSnapshots(_ context.Context, r *gctrpc.PortfolioDateRangeRequest) (*gctrpc.GetPortfolioSnapshotsResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	start, end, err := parsePortfolioDateRange(r)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := s.portfolioSnapshots.GetSnapshots(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPortfolioSnapshotsResponse{
+		Snapshots: make([]*gctrpc.PortfolioSnapshot, len(snapshots)),
+	}
+	for i := range snapshots {
+		resp.Snapshots[i] = &gctrpc.PortfolioSnapshot{
+			Id:           snapshots[i].ID,
+			FiatCurrency: snapshots[i].FiatCurrency.String(),
+			TotalValue:   snapshots[i].TotalValue,
+			Holdings:     make([]*gctrpc.PortfolioSnapshotHolding, len(snapshots[i].Holdings)),
+			Timestamp:    snapshots[i].Timestamp.Format(common.SimpleTimeFormatWithTimezone),
+		}
+		for j := range snapshots[i].Holdings {
+			h := &snapshots[i].Holdings[j]
+			resp.Snapshots[i].Holdings[j] = &gctrpc.PortfolioSnapshotHolding{
+				Source:   h.Source,
+				Asset:    h.Asset.String(),
+				Currency: h.Currency.String(),
+				Amount:   h.Amount,
+				Price:    h.Price,
+				Value:    h.Value,
+			}
+		}
+	}
+	return resp, nil
+}
+
+// GetPortfolioPerformance returns the equity curve, drawdown and per currency
+// PnL contributions of the portfolio between the start and end dates
+func (s *RPCServer) GetPortfolioPerformance(_ context.Context, r *gctrpc.PortfolioDateRangeRequest) (*gctrpc.PortfolioPerformance, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	start, end, err := parsePortfolioDateRange(r)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.portfolioSnapshots.GetPerformance(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.PortfolioPerformance{
+		FiatCurrency:     p.FiatCurrency.String(),
+		StartTime:        p.StartTime.Format(common.SimpleTimeFormatWithTimezone),
+		EndTime:          p.EndTime.Format(common.SimpleTimeFormatWithTimezone),
+		StartValue:       p.StartValue,
+		EndValue:         p.EndValue,
+		Pnl:              p.PnL,
+		Return:           p.Return,
+		MaxDrawdown:      p.MaxDrawdown,
+		MaxDrawdownValue: p.MaxDrawdownValue,
+		CurrentDrawdown:  p.CurrentDrawdown,
+		EquityCurve:      make([]*gctrpc.PortfolioEquityPoint, len(p.EquityCurve)),
+		Contributions:    make([]*gctrpc.PortfolioAssetContribution, len(p.Contributions)),
+	}
+	if !p.PeakTime.IsZero() {
+		resp.PeakTime = p.PeakTime.Format(common.SimpleTimeFormatWithTimezone)
+		resp.TroughTime = p.TroughTime.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	for i := range p.EquityCurve {
+		resp.EquityCurve[i] = &gctrpc.PortfolioEquityPoint{
+			Timestamp:     p.EquityCurve[i].Timestamp.Format(common.SimpleTimeFormatWithTimezone),
+			Value:         p.EquityCurve[i].Value,
+			Pnl:           p.EquityCurve[i].PnL,
+			CumulativePnl: p.EquityCurve[i].CumulativePnL,
+			Peak:          p.EquityCurve[i].Peak,
+			Drawdown:      p.EquityCurve[i].Drawdown,
+		}
+	}
+	for i := range p.Contributions {
+		resp.Contributions[i] = &gctrpc.PortfolioAssetContribution{
+			Currency:     p.Contributions[i].Currency.String(),
+			StartValue:   p.Contributions[i].StartValue,
+			EndValue:     p.Contributions[i].EndValue,
+			Pnl:          p.Contributions[i].PnL,
+			Contribution: p.Contributions[i].Contribution,
+			Weight:       p.Contributions[i].Weight,
+		}
+	}
+	return resp, nil
+}
+
+// parsePortfolioDateRange parses the optional start and end dates of a
+// portfolio request
+func parsePortfolioDateRange(r *gctrpc.PortfolioDateRangeRequest) (start, end time.Time, err error) {
+	if r.StartDate != "" {
+		start, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.StartDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+		}
+	}
+	if r.EndDate != "" {
+		end, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.EndDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+		}
+	}
+	return start, end, nil
+}
+
+// GetAccountingFills returns the executed trades of an exchange from its order
+// history and the order manager, for use in tax lot accounting
+func (s *RPCServer) GetAccountingFills(ctx context.Context, r *gctrpc.GetAccountingFillsRequest) (*gctrpc.GetAccountingFillsResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	a := asset.Spot
+	if r.Asset != "" {
+		a, err = asset.New(r.Asset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var start, end time.Time
+	if r.StartDate != "" {
+		start, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+		}
+	}
+	if r.EndDate != "" {
+		end, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+		}
+	}
+	if !start.IsZero() && !end.IsZero() {
+		err = common.StartEndTimeCheck(start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var pairs currency.Pairs
+	if len(r.Pairs) > 0 {
+		pairs = make(currency.Pairs, len(r.Pairs))
+		for i := range r.Pairs {
+			pairs[i], err = currency.NewPairFromString(r.Pairs[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		pairs, err = exch.GetEnabledPairs(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	history, err := exch.GetOrderHistory(ctx, &order.MultiOrderRequest{
+		Pairs:     pairs,
+		AssetType: a,
+		Type:      order.AnyType,
+		Side:      order.AnySide,
+		StartTime: start,
+		EndTime:   end,
+	})
+	if err != nil && !errors.Is(err, common.ErrFunctionNotSupported) && !errors.Is(err, common.ErrNotYetImplemented) {
+		return nil, err
+	}
+	// Orders tracked by the order manager fill any gaps in the exchange's
+	// history, with whichever copy holds individual trades preferred
+	orders := make(map[string]*order.Detail, len(history))
+	for i := range history {
+		orders[history[i].OrderID] = &history[i]
+	}
+	if s.OrderManager != nil && s.OrderManager.IsRunning() {
+		tracked := s.OrderManager.GetOrdersSnapshot(order.AnyStatus)
+		for i := range tracked {
+			if !strings.EqualFold(tracked[i].Exchange, exch.GetName()) ||
+				tracked[i].AssetType != a ||
+				!pairs.Contains(tracked[i].Pair, true) {
+				continue
+			}
+			if existing, ok := orders[tracked[i].OrderID]; ok && (len(existing.Trades) > 0 || len(tracked[i].Trades) == 0) {
+				continue
+			}
+			orders[tracked[i].OrderID] = &tracked[i]
+		}
+	}
+
+	var fills []accounting.Fill
+	for _, d := range orders {
+		orderFills := accounting.FillsFromOrder(d)
+		for i := range orderFills {
+			if (!start.IsZero() && orderFills[i].Timestamp.Before(start)) ||
+				(!end.IsZero() && !orderFills[i].Timestamp.Before(end)) {
+				continue
+			}
+			fills = append(fills, orderFills[i])
+		}
+	}
+	sort.Slice(fills, func(i, j int) bool {
+		if fills[i].Timestamp.Equal(fills[j].Timestamp) {
+			return fills[i].ID < fills[j].ID
+		}
+		return fills[i].Timestamp.Before(fills[j].Timestamp)
+	})
+
+	resp := &gctrpc.GetAccountingFillsResponse{
+		Fills: make([]*gctrpc.AccountingFill, len(fills)),
+	}
+	for i := range fills {
+		resp.Fills[i] = &gctrpc.AccountingFill{
+			Id:       fills[i].ID,
+			Exchange: fills[i].Exchange,
+			Asset:    fills[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: fills[i].Pair.Delimiter,
+				Base:      fills[i].Pair.Base.String(),
+				Quote:     fills[i].Pair.Quote.String(),
+			},
+			Side:      fills[i].Side.String(),
+			Amount:    fills[i].Amount,
+			Price:     fills[i].Price,
+			Fee:       fills[i].Fee,
+			FeeAsset:  fills[i].FeeAsset.String(),
+			Timestamp: fills[i].Timestamp.UTC().Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
+// GetFeeSchedule returns the volume tiered fee schedule of an exchange account
+// for an asset type and the tier predicted from the volume tracked by the
+// order manager
+func (s *RPCServer) GetFeeSchedule(ctx context.Context, r *gctrpc.GetFeeScheduleRequest) (*gctrpc.GetFeeScheduleResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(r.Exchange, exch, a, currency.EMPTYPAIR)
+	if err != nil {
+		return nil, err
+	}
+	volume, schedule, err := s.OrderManager.GetFeeVolume(ctx, r.Exchange, a)
+	if err != nil {
+		return nil, err
+	}
+	tier, _, err := schedule.GetTier(volume)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetFeeScheduleResponse{
+		Exchange:            exch.GetName(),
+		Asset:               a.String(),
+		VolumeCurrency:      schedule.VolumeCurrency.String(),
+		VolumeWindowSeconds: int64(schedule.Window().Seconds()),
+		Tiers:               make([]*gctrpc.FeeTier, len(schedule.Tiers)),
+		TrailingVolume:      volume,
+		CurrentTier:         feeTierToRPC(&tier),
+	}
+	for i := range schedule.Tiers {
+		resp.Tiers[i] = feeTierToRPC(&schedule.Tiers[i])
+	}
+	if schedule.TokenDiscount != nil {
+		resp.TokenDiscountCurrency = schedule.TokenDiscount.Currency.String()
+		resp.TokenDiscountRate = schedule.TokenDiscount.Rate
+	}
+	return resp, nil
+}
+
+// EstimateOrderCost returns the expected fee and total cost of an order under
+// the exchange account's fee schedule
+func (s *RPCServer) EstimateOrderCost(ctx context.Context, r *gctrpc.EstimateOrderCostRequest) (*gctrpc.EstimateOrderCostResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	p := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	oType, err := order.StringToOrderType(r.OrderType)
+	if err != nil {
+		return nil, err
+	}
+	est, err := s.OrderManager.EstimateOrderCost(ctx, &order.Submit{
+		Exchange:  exch.GetName(),
+		Pair:      p,
+		AssetType: a,
+		Side:      side,
+		Type:      oType,
+		Amount:    r.Amount,
+		Price:     r.Price,
+		PostOnly:  r.PostOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.EstimateOrderCostResponse{
+		Exchange: est.Exchange,
+		Asset:    est.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: est.Pair.Delimiter,
+			Base:      est.Pair.Base.String(),
+			Quote:     est.Pair.Quote.String(),
+		},
+		Side:                  est.Side.String(),
+		IsMaker:               est.IsMaker,
+		Price:                 est.Price,
+		Amount:                est.Amount,
+		Notional:              est.Notional,
+		VolumeCurrency:        est.VolumeCurrency.String(),
+		TrailingVolume:        est.TrailingVolume,
+		VolumeToNextTier:      est.VolumeToNextTier,
+		FeeRate:               est.FeeRate,
+		Fee:                   est.Fee,
+		TokenDiscountFee:      est.TokenDiscountFee,
+		TokenDiscountCurrency: est.TokenDiscountCurrency.String(),
+		Total:                 est.Total,
+	}
+	if est.Tier != nil {
+		resp.Tier = feeTierToRPC(est.Tier)
+	}
+	if est.NextTier != nil {
+		resp.NextTier = feeTierToRPC(est.NextTier)
+	}
+	return resp, nil
+}
+
+// feeTierToRPC converts a fee tier to its RPC representation
+func feeTierToRPC(t *feeschedule.Tier) *gctrpc.FeeTier {
+	return &gctrpc.FeeTier{
+		Name:          t.Name,
+		MinimumVolume: t.MinimumVolume,
+		Maker:         t.Maker,
+		Taker:         t.Taker,
+	}
+}
+
+// GetFundingRateMonitorRates returns the latest funding rates retrieved by
+// the funding rate monitor ordered by annualised rate
+func (s *RPCServer) GetFundingRateMonitorRates(_ context.Context, r *gctrpc.GetFundingRateMonitorRatesRequest) (*gctrpc.GetFundingRateMonitorRatesResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	rates, err := s.fundingRateMonitor.GetFundingRates()
+	if err != nil {
+		return nil, err
+	}
+	var underlying currency.Code
+	if r.Underlying != "" {
+		underlying = fundingUnderlying(currency.NewPair(currency.NewCode(r.Underlying), currency.EMPTYCODE))
+	}
+	resp := &gctrpc.GetFundingRateMonitorRatesResponse{}
+	for i := range rates {
+		if (!underlying.IsEmpty() && !rates[i].Underlying.Equal(underlying)) ||
+			(len(r.Exchanges) > 0 && !common.StringDataCompareInsensitive(r.Exchanges, rates[i].Exchange)) {
+			continue
+		}
+		rate := &gctrpc.MonitoredFundingRate{
+			Exchange: rates[i].Exchange,
+			Asset:    rates[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: rates[i].Pair.Delimiter,
+				Base:      rates[i].Pair.Base.String(),
+				Quote:     rates[i].Pair.Quote.String(),
+			},
+			Underlying:     rates[i].Underlying.String(),
+			Rate:           rates[i].Rate,
+			Interval:       rates[i].Interval.String(),
+			AnnualisedRate: rates[i].AnnualisedRate,
+			Price:          rates[i].Price,
+			SpotPrice:      rates[i].SpotPrice,
+			BasisPercent:   rates[i].BasisPercent,
+			Time:           rates[i].Time.Format(common.SimpleTimeFormatWithTimezone),
+		}
+		if !rates[i].TimeOfNextRate.IsZero() {
+			rate.TimeOfNextRate = rates[i].TimeOfNextRate.Format(common.SimpleTimeFormatWithTimezone)
+		}
+		if !rates[i].SpotPair.IsEmpty() {
+			rate.SpotPair = &gctrpc.CurrencyPair{
+				Delimiter: rates[i].SpotPair.Delimiter,
+				Base:      rates[i].SpotPair.Base.String(),
+				Quote:     rates[i].SpotPair.Quote.String(),
+			}
+		}
+		resp.Rates = append(resp.Rates, rate)
+	}
+	return resp, nil
+}
+
+// GetFundingRateOpportunityStream streams cash and carry and funding spread
+// opportunities found by the funding rate monitor, starting with the current
+// opportunities
+func (s *RPCServer) GetFundingRateOpportunityStream(r *gctrpc.GetFundingRateOpportunityStreamRequest, stream gctrpc.GoCryptoTraderService_GetFundingRateOpportunityStreamServer) error {
+	if r == nil {
+		return errNilRequestData
+	}
+	var typ FundingOpportunityType
+	if r.Type != "" {
+		var err error
+		typ, err = fundingOpportunityTypeFromString(r.Type)
+		if err != nil {
+			return err
+		}
+	}
+	var underlying currency.Code
+	if r.Underlying != "" {
+		underlying = fundingUnderlying(currency.NewPair(currency.NewCode(r.Underlying), currency.EMPTYCODE))
+	}
+	matches := func(o *FundingRateOpportunity) bool {
+		if o.AnnualisedCarry < r.MinimumAnnualisedCarry ||
+			(typ != UnknownFundingOpportunity && o.Type != typ) ||
+			(!underlying.IsEmpty() && !o.Underlying.Equal(underlying)) {
+			return false
+		}
+		if len(r.Exchanges) == 0 {
+			return true
+		}
+		return common.StringDataCompareInsensitive(r.Exchanges, o.LongExchange) &&
+			common.StringDataCompareInsensitive(r.Exchanges, o.ShortExchange)
+	}
+
+	id, ch, err := s.fundingRateMonitor.Subscribe()
+	if err != nil {
+		return err
+	}
+	defer s.fundingRateMonitor.Unsubscribe(id)
+
+	current, err := s.fundingRateMonitor.GetOpportunities()
+	if err != nil {
+		return err
+	}
+	for i := range current {
+		if !matches(&current[i]) {
+			continue
+		}
+		if err = stream.Send(fundingRateOpportunityToRPC(&current[i])); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case o, ok := <-ch:
+			if !ok {
+				return fmt.Errorf("%s %w", FundingRateMonitorName, ErrSubSystemNotStarted)
+			}
+			if !matches(&o) {
+				continue
+			}
+			if err = stream.Send(fundingRateOpportunityToRPC(&o)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// fundingRateOpportunityToRPC converts a funding rate opportunity to its RPC
+// representation
+func fundingRateOpportunityToRPC(o *FundingRateOpportunity) *gctrpc.FundingRateOpportunity {
+	resp := &gctrpc.FundingRateOpportunity{
+		Type:          o.Type.String(),
+		Underlying:    o.Underlying.String(),
+		LongExchange:  o.LongExchange,
+		LongAsset:     o.LongAsset.String(),
+		ShortExchange: o.ShortExchange,
+		ShortAsset:    o.ShortAsset.String(),
+		LongPair: &gctrpc.CurrencyPair{
+			Delimiter: o.LongPair.Delimiter,
+			Base:      o.LongPair.Base.String(),
+			Quote:     o.LongPair.Quote.String(),
+		},
+		LongPrice:          o.LongPrice,
+		LongAnnualisedRate: o.LongAnnualisedRate,
+		ShortPair: &gctrpc.CurrencyPair{
+			Delimiter: o.ShortPair.Delimiter,
+			Base:      o.ShortPair.Base.String(),
+			Quote:     o.ShortPair.Quote.String(),
+		},
+		ShortPrice:          o.ShortPrice,
+		ShortAnnualisedRate: o.ShortAnnualisedRate,
+		AnnualisedCarry:     o.AnnualisedCarry,
+		BasisPercent:        o.BasisPercent,
+		Time:                o.Time.Format(common.SimpleTimeFormatWithTimezone),
+	}
+	if !o.TimeOfNextRate.IsZero() {
+		resp.TimeOfNextRate = o.TimeOfNextRate.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return resp
+}
+
+// GetLiquidationMonitorPositions returns the estimated liquidation price and
+// margin health of each open futures position monitored by the liquidation
+// monitor, ordered by margin ratio
+func (s *RPCServer) GetLiquidationMonitorPositions(_ context.Context, r *gctrpc.GetLiquidationMonitorPositionsRequest) (*gctrpc.GetLiquidationMonitorPositionsResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	minimum := HealthyPosition
+	if r.MinimumLevel != "" {
+		var err error
+		minimum, err = liquidationRiskLevelFromString(r.MinimumLevel)
+		if err != nil {
+			return nil, err
+		}
+	}
+	health, err := s.liquidationMonitor.GetPositionHealth()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetLiquidationMonitorPositionsResponse{}
+	for i := range health {
+		if health[i].Level < minimum ||
+			(len(r.Exchanges) > 0 && !common.StringDataCompareInsensitive(r.Exchanges, health[i].Exchange)) {
+			continue
+		}
+		resp.Positions = append(resp.Positions, positionHealthToRPC(&health[i]))
+	}
+	return resp, nil
+}
+
+// GetLiquidationMonitorStream streams the health of monitored futures
+// positions each time they are evaluated, starting with their current health
+func (s *RPCServer) GetLiquidationMonitorStream(r *gctrpc.GetLiquidationMonitorStreamRequest, stream gctrpc.GoCryptoTraderService_GetLiquidationMonitorStreamServer) error {
+	if r == nil {
+		return errNilRequestData
+	}
+	minimum := HealthyPosition
+	if r.MinimumLevel != "" {
+		var err error
+		minimum, err = liquidationRiskLevelFromString(r.MinimumLevel)
+		if err != nil {
+			return err
+		}
+	}
+	matches := func(h *PositionHealth) bool {
+		return h.Level >= minimum &&
+			(len(r.Exchanges) == 0 || common.StringDataCompareInsensitive(r.Exchanges, h.Exchange))
+	}
+
+	id, ch, err := s.liquidationMonitor.Subscribe()
+	if err != nil {
+		return err
+	}
+	defer s.liquidationMonitor.Unsubscribe(id)
+
+	current, err := s.liquidationMonitor.GetPositionHealth()
+	if err != nil {
+		return err
+	}
+	for i := range current {
+		if !matches(&current[i]) {
+			continue
+		}
+		if err = stream.Send(positionHealthToRPC(&current[i])); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case h, ok := <-ch:
+			if !ok {
+				return fmt.Errorf("%s %w", LiquidationMonitorName, ErrSubSystemNotStarted)
+			}
+			if !matches(&h) {
+				continue
+			}
+			if err = stream.Send(positionHealthToRPC(&h)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// positionHealthToRPC converts a position's health to its RPC representation
+func positionHealthToRPC(h *PositionHealth) *gctrpc.LiquidationPositionHealth {
+	return &gctrpc.LiquidationPositionHealth{
+		Exchange: h.Exchange,
+		Asset:    h.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: h.Pair.Delimiter,
+			Base:      h.Pair.Base.String(),
+			Quote:     h.Pair.Quote.String(),
+		},
+		Side:                      h.Side.String(),
+		Size:                      h.Size,
+		EntryPrice:                h.EntryPrice,
+		MarkPrice:                 h.MarkPrice,
+		Leverage:                  h.Leverage,
+		MarginType:                h.MarginType.String(),
+		Margin:                    h.Margin,
+		UnrealisedPnl:             h.UnrealisedPNL,
+		MaintenanceMargin:         h.MaintenanceMargin,
+		MarginRatio:               h.MarginRatio,
+		EstimatedLiquidationPrice: h.EstimatedLiquidationPrice,
+		DistanceToLiquidation:     h.DistanceToLiquidation,
+		Level:                     h.Level.String(),
+		Time:                      h.Time.Format(common.SimpleTimeFormatWithTimezone),
+	}
+}
+
+// GetOptionChain returns the option instruments listed by an exchange for an
+// underlying, optionally limited to a single expiry date
+func (s *RPCServer) GetOptionChain(ctx context.Context, r *gctrpc.GetOptionChainRequest) (*gctrpc.GetOptionChainResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if r.Underlying == nil {
+		return nil, errCurrencyPairUnset
+	}
+	req := &options.ChainRequest{
+		Asset: a,
+		Underlying: currency.Pair{
+			Delimiter: r.Underlying.Delimiter,
+			Base:      currency.NewCode(r.Underlying.Base),
+			Quote:     currency.NewCode(r.Underlying.Quote),
+		},
+	}
+	if r.Expiry != "" {
+		req.Expiry, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.Expiry)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse expiry %v", errInvalidTimes, err)
+		}
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(r.Exchange, exch, a, currency.EMPTYPAIR)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := exch.GetOptionContracts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetOptionChainResponse{
+		Instruments: make([]*gctrpc.OptionInstrument, len(chain)),
+	}
+	for i := range chain {
+		resp.Instruments[i] = optionInstrumentToRPC(&chain[i])
+	}
+	return resp, nil
+}
+
+// GetOptionGreeks derives the implied volatility and greeks of an option from
+// its orderbook mid price. The underlying price defaults to the exchange's
+// forward price, priced with Black-76, or else the underlying's spot ticker,
+// priced with Black-Scholes
+func (s *RPCServer) GetOptionGreeks(ctx context.Context, r *gctrpc.GetOptionGreeksRequest) (*gctrpc.GetOptionGreeksResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if r.Underlying == nil || r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	underlying := currency.Pair{
+		Delimiter: r.Underlying.Delimiter,
+		Base:      currency.NewCode(r.Underlying.Base),
+		Quote:     currency.NewCode(r.Underlying.Quote),
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := exch.GetOptionContracts(ctx, &options.ChainRequest{Asset: a, Underlying: underlying})
+	if err != nil {
+		return nil, err
+	}
+	var inst *options.Instrument
+	for i := range chain {
+		if chain[i].Pair.Equal(p) {
+			inst = &chain[i]
+			break
+		}
+	}
+	if inst == nil {
+		return nil, fmt.Errorf("%w %v", errOptionNotFound, p)
+	}
+	timeToExpiry, err := inst.TimeToExpiry(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	ob, err := exch.FetchOrderbook(ctx, p, a)
+	if err != nil {
+		return nil, err
+	}
+	if len(ob.Bids) == 0 || len(ob.Asks) == 0 {
+		return nil, fmt.Errorf("%w %v", errNoOrderbookMid, p)
+	}
+	bid, ask := ob.Bids[0].Price, ob.Asks[0].Price
+
+	model := options.BlackScholes
+	if inst.ForwardPrice > 0 {
+		model = options.Black76
+	}
+	if r.Model != "" {
+		model, err = options.StringToModel(r.Model)
+		if err != nil {
+			return nil, err
+		}
+	}
+	underlyingPrice := r.UnderlyingPrice
+	if underlyingPrice == 0 {
+		if model == options.Black76 && inst.ForwardPrice > 0 {
+			underlyingPrice = inst.ForwardPrice
+		} else {
+			var tick *ticker.Price
+			tick, err = exch.FetchTicker(ctx, underlying, asset.Spot)
+			if err != nil {
+				return nil, err
+			}
+			underlyingPrice = tick.Last
+		}
+	}
+	if underlyingPrice <= 0 {
+		return nil, errNoUnderlyingPrice
+	}
+	if inst.PremiumInUnderlying {
+		bid *= underlyingPrice
+		ask *= underlyingPrice
+	}
+	mid := (bid + ask) / 2
+	in := &options.PricingInput{
+		Model:        model,
+		Type:         inst.Type,
+		Underlying:   underlyingPrice,
+		Strike:       inst.Strike,
+		TimeToExpiry: timeToExpiry,
+		RiskFreeRate: r.RiskFreeRate,
+	}
+	in.Volatility, err = options.ImpliedVolatility(in, mid)
+	if err != nil {
+		return nil, err
+	}
+	greeks, err := options.CalculateGreeks(in)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetOptionGreeksResponse{
+		Instrument:        optionInstrumentToRPC(inst),
+		Model:             model.String(),
+		UnderlyingPrice:   underlyingPrice,
+		TimeToExpiry:      timeToExpiry,
+		Bid:               bid,
+		Ask:               ask,
+		Mid:               mid,
+		ImpliedVolatility: in.Volatility,
+		Greeks: &gctrpc.OptionGreeks{
+			Price: greeks.Price,
+			Delta: greeks.Delta,
+			Gamma: greeks.Gamma,
+			Vega:  greeks.Vega,
+			Theta: greeks.Theta,
+			Rho:   greeks.Rho,
+		},
+	}, nil
+}
+
+// optionInstrumentToRPC converts an option instrument to its RPC
+// representation
+func optionInstrumentToRPC(i *options.Instrument) *gctrpc.OptionInstrument {
+	return &gctrpc.OptionInstrument{
+		Exchange: i.Exchange,
+		Asset:    i.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: i.Pair.Delimiter,
+			Base:      i.Pair.Base.String(),
+			Quote:     i.Pair.Quote.String(),
+		},
+		Underlying: &gctrpc.CurrencyPair{
+			Delimiter: i.Underlying.Delimiter,
+			Base:      i.Underlying.Base.String(),
+			Quote:     i.Underlying.Quote.String(),
+		},
+		Strike:              i.Strike,
+		Expiry:              i.Expiry.Format(common.SimpleTimeFormatWithTimezone),
+		Type:                i.Type.String(),
+		ContractSize:        i.ContractSize,
+		SettlementCurrency:  i.SettlementCurrency.String(),
+		PremiumInUnderlying: i.PremiumInUnderlying,
+		ForwardPrice:        i.ForwardPrice,
+	}
+}
+
+// GetEventStream streams events published on an event bus topic, optionally
+// filtered by exchange, asset and pair. Event payloads are JSON encoded
+func (s *RPCServer) GetEventStream(r *gctrpc.GetEventStreamRequest, stream gctrpc.GoCryptoTraderService_GetEventStreamServer) error {
+	if r == nil {
+		return errNilRequestData
+	}
+	topic, err := eventbus.StringToTopic(r.Topic)
+	if err != nil {
+		return err
+	}
+	policy, err := eventbus.StringToDropPolicy(r.DropPolicy)
+	if err != nil {
+		return err
+	}
+	var filters []eventbus.Filter
+	if r.Exchange != "" {
+		filters = append(filters, eventbus.ExchangeFilter(r.Exchange))
+	}
+	if r.Asset != "" {
+		var a asset.Item
+		a, err = asset.New(r.Asset)
+		if err != nil {
+			return err
+		}
+		filters = append(filters, eventbus.AssetFilter(a))
+	}
+	if r.Pair != nil {
+		filters = append(filters, eventbus.PairFilter(currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		}))
+	}
+	sub, err := eventbus.Subscribe(topic, &eventbus.SubscribeOptions{
+		BufferSize: int(r.BufferSize),
+		DropPolicy: policy,
+		Filter:     eventbus.AllFilters(filters...),
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if uErr := sub.Unsubscribe(); uErr != nil {
+			log.Errorf(log.GRPCSys, "Unable to unsubscribe from %s events: %v", topic, uErr)
+		}
+	}()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case e, ok := <-sub.C():
+			if !ok {
+				return fmt.Errorf("%s %w", dispatch.Name, ErrSubSystemNotStarted)
+			}
+			var data []byte
+			data, err = json.Marshal(e.Data())
+			if err != nil {
+				return err
+			}
+			err = stream.Send(&gctrpc.BusEvent{
+				Topic:    e.Topic.String(),
+				Exchange: e.Exchange,
+				Asset:    e.Asset.String(),
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: e.Pair.Delimiter,
+					Base:      e.Pair.Base.String(),
+					Quote:     e.Pair.Quote.String(),
+				},
+				Published: e.Published.Format(common.SimpleTimeFormatWithTimezone),
+				Data:      data,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetEventBusMetrics returns the publish counters of every event bus topic and
+// the delivery lag of every subscriber
+func (s *RPCServer) GetEventBusMetrics(_ context.Context, r *gctrpc.GetEventBusMetricsRequest) (*gctrpc.GetEventBusMetricsResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	metrics, err := eventbus.GetMetrics()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetEventBusMetricsResponse{
+		Topics:                make([]*gctrpc.EventBusTopicMetrics, len(metrics.Topics)),
+		Subscriptions:         make([]*gctrpc.EventBusSubscriptionMetrics, len(metrics.Subscriptions)),
+		DispatchQueueDepth:    int64(metrics.DispatchQueueDepth),
+		DispatchQueueCapacity: int64(metrics.DispatchQueueCapacity),
+	}
+	for i := range metrics.Topics {
+		resp.Topics[i] = &gctrpc.EventBusTopicMetrics{
+			Topic:           metrics.Topics[i].Topic.String(),
+			Subscribers:     metrics.Topics[i].Subscribers,
+			Published:       metrics.Topics[i].Published,
+			PublishFailures: metrics.Topics[i].PublishFailures,
+		}
+	}
+	for i := range metrics.Subscriptions {
+		m := &metrics.Subscriptions[i]
+		resp.Subscriptions[i] = &gctrpc.EventBusSubscriptionMetrics{
+			Topic:      m.Topic.String(),
+			DropPolicy: m.DropPolicy.String(),
+			Delivered:  m.Delivered,
+			Dropped:    m.Dropped,
+			Filtered:   m.Filtered,
+			Pending:    int64(m.Pending),
+			Capacity:   int64(m.Capacity),
+			LastLag:    m.LastLag.String(),
+			MaxLag:     m.MaxLag.String(),
+			AverageLag: m.AverageLag.String(),
+		}
+	}
+	return resp, nil
+}
@@ -34,7 +34,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliosnapshot"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -45,14 +47,17 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/triangular"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/goose"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -4290,3 +4295,921 @@ func TestRPCProxyAuthClient(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutionOrderRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.CreateExecutionOrder(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "CreateExecutionOrder should error on nil request")
+	_, err = s.PauseExecutionOrder(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "PauseExecutionOrder should error on nil request")
+	_, err = s.ResumeExecutionOrder(context.Background(), &gctrpc.ExecutionOrderRequest{Id: "1337"})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "ResumeExecutionOrder should error when the subsystem is not started")
+	_, err = s.CancelExecutionOrder(context.Background(), &gctrpc.ExecutionOrderRequest{Id: "1337"})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "CancelExecutionOrder should error when the subsystem is not started")
+	_, err = s.GetExecutionOrders(context.Background(), &gctrpc.GetExecutionOrdersRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem, "GetExecutionOrders should error on nil subsystem")
+
+	m, _, _ := setupAlgoExecutionTest(t)
+	s.algoExecutionManager = m
+	_, err = s.GetExecutionOrder(context.Background(), &gctrpc.ExecutionOrderRequest{Id: "1337"})
+	assert.ErrorIs(t, err, ErrParentOrderNotFound, "GetExecutionOrder should error on unknown id")
+	id, err := m.Create(context.Background(), algoTestOrder(TWAP))
+	require.NoError(t, err, "Create must not error")
+	resp, err := s.GetExecutionOrder(context.Background(), &gctrpc.ExecutionOrderRequest{Id: id})
+	require.NoError(t, err, "GetExecutionOrder must not error")
+	assert.Equal(t, "TWAP", resp.Strategy, "Strategy should be correct")
+	assert.Equal(t, 100.0, resp.Summary.ArrivalPrice, "ArrivalPrice should be correct")
+	assert.Equal(t, 4.0, resp.Summary.RemainingAmount, "RemainingAmount should be correct")
+	require.Len(t, resp.ChildOrders, 1, "ChildOrders must contain the first slice")
+	assert.Equal(t, "1", resp.ChildOrders[0].OrderId, "OrderId should be correct")
+	_, err = s.PauseExecutionOrder(context.Background(), &gctrpc.ExecutionOrderRequest{Id: id})
+	assert.NoError(t, err, "PauseExecutionOrder should not error")
+	orders, err := s.GetExecutionOrders(context.Background(), &gctrpc.GetExecutionOrdersRequest{})
+	require.NoError(t, err, "GetExecutionOrders must not error")
+	require.Len(t, orders.Orders, 1, "GetExecutionOrders must return the parent order")
+	assert.Equal(t, string(ParentOrderPaused), orders.Orders[0].Status, "Status should be paused")
+}
+
+func TestRouteOrder(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.RouteOrder(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "RouteOrder should error on nil request")
+	req := &gctrpc.RouteOrderRequest{Asset: "spot", Side: "buy", Amount: 1, DryRun: true}
+	_, err = s.RouteOrder(context.Background(), req)
+	assert.ErrorIs(t, err, errCurrencyPairUnset, "RouteOrder should error on unset pair")
+	req.Pair = &gctrpc.CurrencyPair{Base: "btc", Quote: "usd"}
+	_, err = s.RouteOrder(context.Background(), req)
+	assert.ErrorIs(t, err, ErrNilSubsystem, "RouteOrder should error on nil router")
+
+	s.smartOrderRouter = setupSmartOrderRouterTest(t, &fakeOrderSubmitter{},
+		routeTestVenue{
+			name:  "sorrpc",
+			asks:  orderbook.Items{{Price: 100, Amount: 1}},
+			bids:  orderbook.Items{{Price: 99, Amount: 1}},
+			quote: 1000,
+		},
+		routeTestVenue{name: "sorrpcexcluded"},
+	)
+	resp, err := s.RouteOrder(context.Background(), req)
+	require.NoError(t, err, "RouteOrder must not error")
+	assert.True(t, resp.DryRun, "DryRun should be set")
+	require.Len(t, resp.Allocations, 1, "Allocations must contain the routable exchange")
+	assert.Equal(t, "sorrpc", resp.Allocations[0].Exchange, "Exchange should be correct")
+	assert.Empty(t, resp.Allocations[0].Status, "Status should not be set for a dry run")
+	require.Len(t, resp.Excluded, 1, "Excluded must contain the unroutable exchange")
+	assert.Equal(t, "sorrpcexcluded", resp.Excluded[0].Exchange, "Exchange should be correct")
+
+	req.Exchanges = []string{"sorrpcexcluded"}
+	_, err = s.RouteOrder(context.Background(), req)
+	assert.ErrorIs(t, err, errNoRoutableExchanges, "RouteOrder should error when no exchange is routable")
+	assert.ErrorContains(t, err, "sorrpcexcluded", "RouteOrder error should contain the excluded exchange")
+}
+
+// arbitrageStream captures opportunities sent over a gRPC stream
+type arbitrageStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   []*gctrpc.ArbitrageOpportunity
+}
+
+func (a *arbitrageStream) Context() context.Context {
+	return a.ctx
+}
+
+func (a *arbitrageStream) Send(o *gctrpc.ArbitrageOpportunity) error {
+	a.sent = append(a.sent, o)
+	a.cancel()
+	return nil
+}
+
+func TestGetArbitrageOpportunityStream(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	err := s.GetArbitrageOpportunityStream(nil, nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetArbitrageOpportunityStream should error on nil request")
+	err = s.GetArbitrageOpportunityStream(&gctrpc.GetArbitrageOpportunityStreamRequest{Asset: "meow"}, nil)
+	assert.ErrorIs(t, err, asset.ErrNotSupported, "GetArbitrageOpportunityStream should error on invalid asset")
+	err = s.GetArbitrageOpportunityStream(&gctrpc.GetArbitrageOpportunityStreamRequest{}, nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetArbitrageOpportunityStream should error when not started")
+
+	s.arbitrageScanner, _ = setupArbitrageScannerTest(t, &config.ArbitrageScanner{},
+		&arbitrageTestExchange{routeTestExchange: routeTestExchange{name: "arbrpcbuy"}},
+		&arbitrageTestExchange{routeTestExchange: routeTestExchange{name: "arbrpcsell"}},
+	)
+	s.arbitrageScanner.processOrderbook(context.Background(), arbitrageTestBook("arbrpcbuy", btcusdPair,
+		orderbook.Items{{Price: 99, Amount: 1}},
+		orderbook.Items{{Price: 100, Amount: 1}}))
+	s.arbitrageScanner.processOrderbook(context.Background(), arbitrageTestBook("arbrpcsell", btcusdPair,
+		orderbook.Items{{Price: 102, Amount: 1}},
+		orderbook.Items{{Price: 103, Amount: 1}}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &arbitrageStream{ctx: ctx, cancel: cancel}
+	err = s.GetArbitrageOpportunityStream(&gctrpc.GetArbitrageOpportunityStreamRequest{
+		Exchanges: []string{"ARBRPCBUY", "arbrpcsell"},
+		Asset:     "spot",
+		Pair:      &gctrpc.CurrencyPair{Base: "xbt", Quote: "usd"},
+	}, stream)
+	assert.ErrorIs(t, err, context.Canceled, "GetArbitrageOpportunityStream should return the stream context error")
+	require.Len(t, stream.sent, 1, "Current opportunity must be sent")
+	assert.Equal(t, "arbrpcbuy", stream.sent[0].BuyExchange, "BuyExchange should be correct")
+	assert.Equal(t, 200.0, stream.sent[0].NetSpreadBasisPoints, "NetSpreadBasisPoints should be correct")
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	stream = &arbitrageStream{ctx: ctx, cancel: cancel}
+	err = s.GetArbitrageOpportunityStream(&gctrpc.GetArbitrageOpportunityStreamRequest{MinimumSpreadBasisPoints: 500}, stream)
+	assert.ErrorIs(t, err, context.Canceled, "GetArbitrageOpportunityStream should return the stream context error")
+	assert.Empty(t, stream.sent, "Opportunities below the minimum spread should not be sent")
+}
+
+// triangularTestExchange overrides the pairs and fees of an exchange so that
+// they form a triangular cycle
+type triangularTestExchange struct {
+	exchange.IBotExchange
+}
+
+func (t *triangularTestExchange) GetName() string {
+	return "triangularrpc"
+}
+
+func (t *triangularTestExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return currency.Pairs{
+		currency.NewPair(currency.BTC, currency.USDT),
+		currency.NewPair(currency.ETH, currency.USDT),
+		currency.NewPair(currency.ETH, currency.BTC),
+	}, nil
+}
+
+func (t *triangularTestExchange) GetFeeByType(_ context.Context, f *exchange.FeeBuilder) (float64, error) {
+	return 0.001 * f.PurchasePrice * f.Amount, nil
+}
+
+func TestGetTriangularArbitrageStream(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(&triangularTestExchange{IBotExchange: exch}), "Add must not error")
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	err = s.GetTriangularArbitrageStream(nil, nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetTriangularArbitrageStream should error on nil request")
+	err = s.GetTriangularArbitrageStream(&gctrpc.GetTriangularArbitrageStreamRequest{}, nil)
+	assert.ErrorIs(t, err, errExchangeNameUnset, "GetTriangularArbitrageStream should error on unset exchange")
+	err = s.GetTriangularArbitrageStream(&gctrpc.GetTriangularArbitrageStreamRequest{Exchange: "triangularrpc", Asset: "meow"}, nil)
+	assert.ErrorIs(t, err, asset.ErrNotSupported, "GetTriangularArbitrageStream should error on invalid asset")
+	err = s.GetTriangularArbitrageStream(&gctrpc.GetTriangularArbitrageStreamRequest{Exchange: "meow"}, nil)
+	assert.ErrorIs(t, err, ErrExchangeNotFound, "GetTriangularArbitrageStream should error on unknown exchange")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = s.GetTriangularArbitrageStream(&gctrpc.GetTriangularArbitrageStreamRequest{Exchange: "triangularrpc"}, &triangularStream{ctx: ctx})
+	assert.Error(t, err, "GetTriangularArbitrageStream should error without orderbooks to subscribe to")
+}
+
+// triangularStream provides a context for a triangular arbitrage stream
+type triangularStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (t *triangularStream) Context() context.Context {
+	return t.ctx
+}
+
+func (t *triangularStream) Send(*gctrpc.TriangularArbitrageOpportunity) error {
+	return nil
+}
+
+func TestTriangularOpportunityToRPC(t *testing.T) {
+	t.Parallel()
+	g, err := triangular.NewGraph(currency.Pairs{
+		currency.NewPair(currency.BTC, currency.USDT),
+		currency.NewPair(currency.ETH, currency.USDT),
+		currency.NewPair(currency.ETH, currency.BTC),
+	})
+	require.NoError(t, err, "NewGraph must not error")
+	c := g.Cycles()[0]
+	o := &triangular.Opportunity{
+		Cycle:             c,
+		StartAmount:       1,
+		EndAmount:         1.1,
+		Profit:            0.1,
+		ProfitBasisPoints: 1000,
+		Legs: []triangular.LegResult{
+			{Leg: c[0], AmountIn: 1, AmountOut: 10, BaseAmount: 10, AveragePrice: 0.1},
+			{Leg: c[1], AmountIn: 10, AmountOut: 110, BaseAmount: 10, AveragePrice: 11},
+			{Leg: c[2], AmountIn: 110, AmountOut: 1.1, BaseAmount: 1.1, AveragePrice: 100},
+		},
+	}
+	resp := triangularOpportunityToRPC("meow", asset.Spot, o)
+	assert.Equal(t, "meow", resp.Exchange, "Exchange should be correct")
+	assert.Equal(t, "spot", resp.Asset, "Asset should be correct")
+	assert.Equal(t, "BTC -> ETH -> USDT -> BTC", resp.Cycle, "Cycle should be correct")
+	assert.Equal(t, "BTC", resp.StartCurrency, "StartCurrency should be correct")
+	assert.Equal(t, 1000.0, resp.ProfitBasisPoints, "ProfitBasisPoints should be correct")
+	require.Len(t, resp.Legs, 3, "Legs must be converted")
+	assert.Equal(t, "BUY", resp.Legs[0].Side, "Side should be correct")
+	assert.Equal(t, "ETH", resp.Legs[0].Pair.Base, "Pair should be correct")
+	assert.Equal(t, "USDT", resp.Legs[1].To, "To should be correct")
+}
+
+func TestMarketMakingRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.AddMarketMakingStrategy(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "AddMarketMakingStrategy should error on nil request")
+	_, err = s.AddMarketMakingStrategy(context.Background(), &gctrpc.AddMarketMakingStrategyRequest{Asset: "spot"})
+	assert.ErrorIs(t, err, errCurrencyPairUnset, "AddMarketMakingStrategy should error on unset pair")
+	_, err = s.RemoveMarketMakingStrategy(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "RemoveMarketMakingStrategy should error on nil request")
+	_, err = s.RemoveMarketMakingStrategy(context.Background(), &gctrpc.MarketMakingStrategyRequest{Id: "1337"})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "RemoveMarketMakingStrategy should error when the subsystem is not started")
+	_, err = s.GetMarketMakingStrategies(context.Background(), &gctrpc.GetMarketMakingStrategiesRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem, "GetMarketMakingStrategies should error on nil subsystem")
+
+	m, _, _ := setupMarketMakingTest(t, "mmrpc")
+	s.marketMakingManager = m
+	_, err = s.GetMarketMakingStrategy(context.Background(), &gctrpc.MarketMakingStrategyRequest{Id: "1337"})
+	assert.ErrorIs(t, err, ErrMarketMakingStrategyNotFound, "GetMarketMakingStrategy should error on unknown id")
+	id, err := m.Add(context.Background(), marketMakingTestStrategy("mmrpc"))
+	require.NoError(t, err, "Add must not error")
+	resp, err := s.GetMarketMakingStrategy(context.Background(), &gctrpc.MarketMakingStrategyRequest{Id: id})
+	require.NoError(t, err, "GetMarketMakingStrategy must not error")
+	assert.Equal(t, string(MarketMakingActive), resp.Status, "Status should be active")
+	assert.Equal(t, 100.0, resp.Metrics.MidPrice, "MidPrice should be correct")
+	assert.Equal(t, 1.0, resp.Metrics.Inventory, "Inventory should be correct")
+	assert.Empty(t, resp.Metrics.LastFill, "LastFill should be empty without fills")
+	require.Len(t, resp.Quotes, 4, "Quotes must be converted")
+	_, err = s.RemoveMarketMakingStrategy(context.Background(), &gctrpc.MarketMakingStrategyRequest{Id: id})
+	assert.NoError(t, err, "RemoveMarketMakingStrategy should not error")
+	strategies, err := s.GetMarketMakingStrategies(context.Background(), &gctrpc.GetMarketMakingStrategiesRequest{})
+	require.NoError(t, err, "GetMarketMakingStrategies must not error")
+	require.Len(t, strategies.Strategies, 1, "GetMarketMakingStrategies must return the strategy")
+	assert.Equal(t, string(MarketMakingStopped), strategies.Strategies[0].Status, "Status should be stopped")
+	assert.Empty(t, strategies.Strategies[0].Quotes, "Quotes should be cancelled")
+}
+
+func TestGridRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.CreateGrid(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "CreateGrid should error on nil request")
+	_, err = s.CreateGrid(context.Background(), &gctrpc.CreateGridRequest{Asset: "spot"})
+	assert.ErrorIs(t, err, errCurrencyPairUnset, "CreateGrid should error on unset pair")
+	_, err = s.StopGrid(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "StopGrid should error on nil request")
+	_, err = s.StopGrid(context.Background(), &gctrpc.GridRequest{Id: "1337"})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "StopGrid should error when the subsystem is not started")
+	_, err = s.GetGrid(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetGrid should error on nil request")
+	_, err = s.GetGrids(context.Background(), &gctrpc.GetGridsRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem, "GetGrids should error on nil subsystem")
+
+	m, _, _ := setupGridTradingTest(t)
+	s.gridTradingManager = m
+	_, err = s.GetGrid(context.Background(), &gctrpc.GridRequest{Id: "1337"})
+	assert.ErrorIs(t, err, ErrGridNotFound, "GetGrid should error on unknown id")
+	id, err := m.Create(context.Background(), gridTestGrid())
+	require.NoError(t, err, "Create must not error")
+	resp, err := s.GetGrid(context.Background(), &gctrpc.GridRequest{Id: id})
+	require.NoError(t, err, "GetGrid must not error")
+	assert.Equal(t, string(GridActive), resp.Status, "Status should be active")
+	assert.Equal(t, "ARITHMETIC", resp.Spacing, "Spacing should be converted")
+	require.Len(t, resp.GridLevels, 5, "GridLevels must be converted")
+	assert.Equal(t, "BUY", resp.GridLevels[0].Side, "Side should be converted")
+	assert.Empty(t, resp.GridLevels[2].Side, "empty level should not have a side")
+	_, err = s.StopGrid(context.Background(), &gctrpc.GridRequest{Id: id})
+	assert.NoError(t, err, "StopGrid should not error")
+	grids, err := s.GetGrids(context.Background(), &gctrpc.GetGridsRequest{})
+	require.NoError(t, err, "GetGrids must not error")
+	require.Len(t, grids.Grids, 1, "GetGrids must return the grid")
+	assert.Equal(t, string(GridStopped), grids.Grids[0].Status, "Status should be stopped")
+}
+
+func TestOrderHistoryRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.SearchOrderHistory(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "SearchOrderHistory should error on nil request")
+	_, err = s.SearchOrderHistory(context.Background(), &gctrpc.SearchOrderHistoryRequest{Asset: "meow"})
+	assert.ErrorIs(t, err, asset.ErrNotSupported, "SearchOrderHistory should error on invalid asset")
+	_, err = s.SearchOrderHistory(context.Background(), &gctrpc.SearchOrderHistoryRequest{StartDate: "meow"})
+	assert.ErrorIs(t, err, errInvalidTimes, "SearchOrderHistory should error on invalid start date")
+	_, err = s.SearchOrderHistory(context.Background(), &gctrpc.SearchOrderHistoryRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem, "SearchOrderHistory should error on nil subsystem")
+	_, err = s.GetOrderAuditTrail(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetOrderAuditTrail should error on nil request")
+	_, err = s.GetOrderAuditTrail(context.Background(), &gctrpc.GetOrderAuditTrailRequest{})
+	assert.ErrorIs(t, err, ErrOrderIDCannotBeEmpty, "GetOrderAuditTrail should error on empty id")
+
+	m, db := setupOrderHistoryTest(t)
+	s.OrderManager = m
+	m.db = nil
+	_, err = s.SearchOrderHistory(context.Background(), &gctrpc.SearchOrderHistoryRequest{})
+	assert.ErrorIs(t, err, errOrderHistoryDisabled, "SearchOrderHistory should error without a database")
+	m.db = db
+
+	resp, err := m.SubmitFakeOrder(&order.Submit{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Type:      order.Limit,
+		Price:     100,
+		Amount:    1,
+	}, &order.SubmitResponse{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Type:      order.Limit,
+		Price:     100,
+		Amount:    1,
+		OrderID:   "rpc",
+		Status:    order.New,
+	}, false)
+	require.NoError(t, err, "SubmitFakeOrder must not error")
+
+	orders, err := s.SearchOrderHistory(context.Background(), &gctrpc.SearchOrderHistoryRequest{Statuses: []string{"placed"}})
+	require.NoError(t, err, "SearchOrderHistory must not error")
+	require.Len(t, orders.Orders, 1, "SearchOrderHistory must return the order")
+	assert.Equal(t, resp.InternalOrderID, orders.Orders[0].InternalOrderId, "Internal order ID should be returned")
+	assert.Equal(t, "rpc", orders.Orders[0].Order.Id, "Order ID should be returned")
+	assert.Equal(t, "SELL", orders.Orders[0].Order.OrderSide, "Side should be returned")
+
+	trail, err := s.GetOrderAuditTrail(context.Background(), &gctrpc.GetOrderAuditTrailRequest{InternalOrderId: resp.InternalOrderID})
+	require.NoError(t, err, "GetOrderAuditTrail must not error")
+	assert.Equal(t, "rpc", trail.Order.Order.Id, "Order ID should be returned")
+	require.Len(t, trail.Events, 1, "GetOrderAuditTrail must return the submission")
+	assert.Equal(t, OrderEventSubmitted, trail.Events[0].Event, "Event should be returned")
+	assert.Equal(t, "NEW", trail.Events[0].Status, "Event status should be returned")
+}
+
+func TestOrderReconciliationRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.ReconcileOrders(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "ReconcileOrders should error on nil request")
+	_, err = s.ReconcileOrders(context.Background(), &gctrpc.ReconcileOrdersRequest{Exchange: testExchange})
+	assert.ErrorIs(t, err, ErrNilSubsystem, "ReconcileOrders should error on nil subsystem")
+	_, err = s.GetOrderReconciliations(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetOrderReconciliations should error on nil request")
+
+	m, fake, _ := setupReconciliationTest(t)
+	s.OrderManager = m
+	fake.active = []order.Detail{{Exchange: testExchange, AssetType: asset.Spot, Pair: btcusdPair, OrderID: "orphan", Status: order.Active, Amount: 1}}
+	report, err := s.ReconcileOrders(context.Background(), &gctrpc.ReconcileOrdersRequest{Exchange: testExchange})
+	require.NoError(t, err, "ReconcileOrders must not error")
+	assert.Equal(t, ReconcileOnRequest, report.Trigger, "Trigger should be set")
+	require.Len(t, report.Discrepancies, 1, "Discrepancies must contain the orphan order")
+	assert.Equal(t, DiscrepancyOrphanOrder, report.Discrepancies[0].Type, "Discrepancy type should be set")
+	assert.Equal(t, "orphan", report.Discrepancies[0].OrderId, "Discrepancy order ID should be set")
+	assert.Equal(t, "ACTIVE", report.Discrepancies[0].Status, "Discrepancy status should be set")
+	assert.NotEmpty(t, report.Discrepancies[0].InternalOrderId, "Discrepancy internal order ID should be set")
+
+	reports, err := s.GetOrderReconciliations(context.Background(), &gctrpc.GetOrderReconciliationsRequest{})
+	require.NoError(t, err, "GetOrderReconciliations must not error")
+	require.Len(t, reports.Reports, 1, "GetOrderReconciliations must return the report")
+	assert.Equal(t, testExchange, reports.Reports[0].Exchange, "Exchange should be set")
+}
+
+func TestPortfolioRebalancerRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.AddRebalanceStrategy(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "AddRebalanceStrategy should error on nil request")
+	_, err = s.AddRebalanceStrategy(context.Background(), &gctrpc.AddRebalanceStrategyRequest{Asset: "meow"})
+	assert.ErrorIs(t, err, asset.ErrNotSupported, "AddRebalanceStrategy should error on invalid asset")
+	_, err = s.AddRebalanceStrategy(context.Background(), &gctrpc.AddRebalanceStrategyRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "AddRebalanceStrategy should error when the subsystem is not started")
+	_, err = s.RemoveRebalanceStrategy(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "RemoveRebalanceStrategy should error on nil request")
+	_, err = s.GetRebalancePlan(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetRebalancePlan should error on nil request")
+	_, err = s.ExecuteRebalance(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "ExecuteRebalance should error on nil request")
+	_, err = s.GetRebalanceStrategies(context.Background(), &gctrpc.GetRebalanceStrategiesRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem, "GetRebalanceStrategies should error on nil subsystem")
+
+	m, _, _ := setupRebalanceTest(t, "rebalrpc")
+	s.portfolioRebalancer = m
+	strategy, err := s.AddRebalanceStrategy(context.Background(), &gctrpc.AddRebalanceStrategyRequest{
+		Exchanges:          []string{"rebalrpc"},
+		SettlementCurrency: "usdt",
+		Targets: []*gctrpc.RebalanceTarget{
+			{Currency: "btc", Weight: 0.5},
+			{Currency: "eth", Weight: 0.5},
+		},
+		DriftTolerance: 0.05,
+		Trigger:        "threshold",
+	})
+	require.NoError(t, err, "AddRebalanceStrategy must not error")
+	assert.Equal(t, string(RebalanceActive), strategy.Status, "Status should be active")
+	assert.Equal(t, "spot", strategy.Asset, "Asset should default to spot")
+	assert.Empty(t, strategy.LastRebalanced, "LastRebalanced should be empty before rebalancing")
+
+	plan, err := s.GetRebalancePlan(context.Background(), &gctrpc.RebalanceStrategyRequest{Id: strategy.Id})
+	require.NoError(t, err, "GetRebalancePlan must not error")
+	assert.True(t, plan.DryRun, "DryRun should be set")
+	require.Len(t, plan.Trades, 2, "Trades must be converted")
+	assert.Equal(t, "SELL", plan.Trades[0].Side, "Side should be converted")
+
+	plan, err = s.ExecuteRebalance(context.Background(), &gctrpc.RebalanceStrategyRequest{Id: strategy.Id})
+	require.NoError(t, err, "ExecuteRebalance must not error")
+	assert.True(t, plan.Executed, "Executed should be set")
+	assert.NotEmpty(t, plan.Trades[0].OrderId, "OrderId should be converted")
+
+	_, err = s.RemoveRebalanceStrategy(context.Background(), &gctrpc.RebalanceStrategyRequest{Id: strategy.Id})
+	assert.NoError(t, err, "RemoveRebalanceStrategy should not error")
+	strategies, err := s.GetRebalanceStrategies(context.Background(), &gctrpc.GetRebalanceStrategiesRequest{})
+	require.NoError(t, err, "GetRebalanceStrategies must not error")
+	require.Len(t, strategies.Strategies, 1, "GetRebalanceStrategies must return the strategy")
+	assert.Equal(t, string(RebalanceStopped), strategies.Strategies[0].Status, "Status should be stopped")
+	assert.NotNil(t, strategies.Strategies[0].LastPlan, "LastPlan should be converted")
+	assert.NotEmpty(t, strategies.Strategies[0].LastRebalanced, "LastRebalanced should be converted")
+}
+
+func TestPortfolioSnapshotRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetPortfolioSnapshots(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetPortfolioSnapshots should error on nil request")
+	_, err = s.GetPortfolioPerformance(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetPortfolioPerformance should error on nil request")
+	_, err = s.GetPortfolioSnapshots(context.Background(), &gctrpc.PortfolioDateRangeRequest{StartDate: "meow"})
+	assert.ErrorIs(t, err, errInvalidTimes, "GetPortfolioSnapshots should error on invalid start date")
+	_, err = s.GetPortfolioPerformance(context.Background(), &gctrpc.PortfolioDateRangeRequest{EndDate: "meow"})
+	assert.ErrorIs(t, err, errInvalidTimes, "GetPortfolioPerformance should error on invalid end date")
+	_, err = s.GetPortfolioSnapshots(context.Background(), &gctrpc.PortfolioDateRangeRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetPortfolioSnapshots should error when the subsystem is not started")
+
+	m, db, _ := setupPortfolioSnapshotTest(t)
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, v := range []float64{100, 80, 120} {
+		require.NoError(t, db.Insert(&portfoliosnapshot.Snapshot{
+			FiatCurrency: "USDT",
+			TotalValue:   v,
+			CreatedDate:  start.Add(time.Duration(i) * time.Hour),
+			Holdings: []portfoliosnapshot.Holding{
+				{Source: "snapshotexch", Asset: "spot", Currency: "BTC", Amount: 1, Price: v, Value: v},
+			},
+		}), "Insert must not error")
+	}
+	require.NoError(t, m.Start(), "Start must not error")
+	t.Cleanup(func() {
+		assert.NoError(t, m.Stop(), "Stop should not error")
+	})
+	s.portfolioSnapshots = m
+
+	dateRange := &gctrpc.PortfolioDateRangeRequest{
+		StartDate: start.Format(common.SimpleTimeFormatWithTimezone),
+		EndDate:   start.Add(2 * time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+	}
+	snapshots, err := s.GetPortfolioSnapshots(context.Background(), dateRange)
+	require.NoError(t, err, "GetPortfolioSnapshots must not error")
+	require.Len(t, snapshots.Snapshots, 3, "Snapshots must be returned")
+	require.Len(t, snapshots.Snapshots[0].Holdings, 1, "Holdings must be converted")
+	assert.Equal(t, "spot", snapshots.Snapshots[0].Holdings[0].Asset, "Asset should be converted")
+	assert.Equal(t, "BTC", snapshots.Snapshots[0].Holdings[0].Currency, "Currency should be converted")
+
+	perf, err := s.GetPortfolioPerformance(context.Background(), dateRange)
+	require.NoError(t, err, "GetPortfolioPerformance must not error")
+	assert.Equal(t, "USDT", perf.FiatCurrency, "FiatCurrency should be converted")
+	assert.Equal(t, 20.0, perf.Pnl, "Pnl should be converted")
+	assert.InDelta(t, 0.2, perf.MaxDrawdown, 1e-9, "MaxDrawdown should be converted")
+	assert.Equal(t, dateRange.StartDate, perf.PeakTime, "PeakTime should be converted")
+	require.Len(t, perf.EquityCurve, 3, "EquityCurve must be converted")
+	require.Len(t, perf.Contributions, 1, "Contributions must be converted")
+	assert.Equal(t, 20.0, perf.Contributions[0].Pnl, "Contribution Pnl should be converted")
+}
+
+func TestGetAccountingFills(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	fake := &reconcileTestExchange{IBotExchange: exch}
+	require.NoError(t, em.Add(fake), "Add must not error")
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	m.started = 1
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, OrderManager: m}}
+
+	_, err = s.GetAccountingFills(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetAccountingFills should error on nil request")
+	_, err = s.GetAccountingFills(context.Background(), &gctrpc.GetAccountingFillsRequest{Exchange: "meow"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound, "GetAccountingFills should error on an unknown exchange")
+	_, err = s.GetAccountingFills(context.Background(), &gctrpc.GetAccountingFillsRequest{Exchange: testExchange, StartDate: "meow"})
+	assert.ErrorIs(t, err, errInvalidTimes, "GetAccountingFills should error on an invalid start date")
+
+	ts := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake.history = []order.Detail{
+		{Exchange: testExchange, OrderID: "1", AssetType: asset.Spot, Pair: btcusdPair, Side: order.Buy, Status: order.Filled, Amount: 1, Price: 100, LastUpdated: ts},
+		{Exchange: testExchange, OrderID: "2", AssetType: asset.Spot, Pair: btcusdPair, Side: order.Sell, Status: order.Filled, Amount: 1, Price: 150, LastUpdated: ts.Add(time.Hour)},
+	}
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:    testExchange,
+		OrderID:     "2",
+		AssetType:   asset.Spot,
+		Pair:        btcusdPair,
+		Side:        order.Sell,
+		Status:      order.Filled,
+		Amount:      1,
+		Price:       150,
+		LastUpdated: ts.Add(time.Hour),
+		Trades: []order.TradeHistory{
+			{TID: "a", Amount: 0.5, Price: 150, Timestamp: ts.Add(time.Hour)},
+			{TID: "b", Amount: 0.5, Price: 151, Timestamp: ts.Add(2 * time.Hour)},
+		},
+	}), "add must not error")
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:    testExchange,
+		OrderID:     "3",
+		AssetType:   asset.Spot,
+		Pair:        btcusdPair,
+		Side:        order.Buy,
+		Status:      order.Filled,
+		Amount:      1,
+		Price:       90,
+		LastUpdated: ts.Add(-time.Hour),
+	}), "add must not error")
+
+	resp, err := s.GetAccountingFills(context.Background(), &gctrpc.GetAccountingFillsRequest{Exchange: testExchange})
+	require.NoError(t, err, "GetAccountingFills must not error")
+	require.Len(t, resp.Fills, 4, "GetAccountingFills must merge exchange history and tracked orders")
+	assert.Equal(t, "3", resp.Fills[0].Id, "Fills should be sorted by time")
+	assert.Equal(t, "2-a", resp.Fills[2].Id, "Tracked trades should be preferred over the exchange order")
+	assert.Equal(t, "SELL", resp.Fills[3].Side, "Side should be converted")
+
+	resp, err = s.GetAccountingFills(context.Background(), &gctrpc.GetAccountingFillsRequest{
+		Exchange:  testExchange,
+		Pairs:     []string{btcusdPair.String()},
+		StartDate: ts.Format(common.SimpleTimeFormatWithTimezone),
+		EndDate:   ts.Add(2 * time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+	})
+	require.NoError(t, err, "GetAccountingFills must not error")
+	assert.Len(t, resp.Fills, 2, "GetAccountingFills should filter fills by date")
+}
+
+func TestFeeScheduleRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetFeeSchedule(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetFeeSchedule should error on nil request")
+	_, err = s.EstimateOrderCost(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "EstimateOrderCost should error on nil request")
+
+	m, fake := setupFeeScheduleTest(t)
+	b := fake.GetBase()
+	b.Enabled = true
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			AssetEnabled:  convert.BoolPtr(true),
+			Enabled:       currency.Pairs{btcusdPair},
+			Available:     currency.Pairs{btcusdPair},
+			RequestFormat: &currency.PairFormat{Uppercase: true},
+			ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		},
+	}
+	s.ExchangeManager = m.orderStore.exchangeManager.(*ExchangeManager)
+	s.OrderManager = m
+	_, err = s.EstimateOrderCost(context.Background(), &gctrpc.EstimateOrderCostRequest{Exchange: testExchange, AssetType: "spot"})
+	assert.ErrorIs(t, err, errCurrencyPairUnset, "EstimateOrderCost should error without a pair")
+
+	schedule, err := s.GetFeeSchedule(context.Background(), &gctrpc.GetFeeScheduleRequest{Exchange: testExchange, Asset: "spot"})
+	require.NoError(t, err, "GetFeeSchedule must not error")
+	assert.Len(t, schedule.Tiers, 2, "Tiers should be converted")
+	assert.Equal(t, "VIP0", schedule.CurrentTier.Name, "CurrentTier should be the lowest tier")
+	assert.Equal(t, "BNB", schedule.TokenDiscountCurrency, "TokenDiscountCurrency should be converted")
+
+	est, err := s.EstimateOrderCost(context.Background(), &gctrpc.EstimateOrderCostRequest{
+		Exchange:  testExchange,
+		AssetType: "spot",
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		Side:      "BUY",
+		OrderType: "LIMIT",
+		Amount:    1,
+		Price:     100,
+		PostOnly:  true,
+	})
+	require.NoError(t, err, "EstimateOrderCost must not error")
+	assert.InDelta(t, 0.1, est.Fee, 1e-9, "Fee should use the maker rate")
+	assert.Equal(t, "VIP1", est.NextTier.Name, "NextTier should be converted")
+}
+
+type fundingRateStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   []*gctrpc.FundingRateOpportunity
+}
+
+func (f *fundingRateStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fundingRateStream) Send(o *gctrpc.FundingRateOpportunity) error {
+	f.sent = append(f.sent, o)
+	f.cancel()
+	return nil
+}
+
+func TestFundingRateMonitorRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetFundingRateMonitorRates(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetFundingRateMonitorRates should error on nil request")
+	_, err = s.GetFundingRateMonitorRates(context.Background(), &gctrpc.GetFundingRateMonitorRatesRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetFundingRateMonitorRates should error when not started")
+	err = s.GetFundingRateOpportunityStream(nil, nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetFundingRateOpportunityStream should error on nil request")
+	err = s.GetFundingRateOpportunityStream(&gctrpc.GetFundingRateOpportunityStreamRequest{Type: "meow"}, nil)
+	assert.ErrorIs(t, err, errUnknownFundingOpportunityType, "GetFundingRateOpportunityStream should error on invalid type")
+	err = s.GetFundingRateOpportunityStream(&gctrpc.GetFundingRateOpportunityStreamRequest{}, nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetFundingRateOpportunityStream should error when not started")
+
+	s.fundingRateMonitor, _ = setupFundingRateMonitorTest(t, &config.FundingRateMonitor{},
+		&fundingTestExchange{name: "fundingrpca", rate: 0.0003, perpPrice: 101, spotPrice: 100},
+		&fundingTestExchange{name: "fundingrpcb", rate: -0.0001, perpPrice: 100},
+	)
+	s.fundingRateMonitor.poll(context.Background())
+
+	rates, err := s.GetFundingRateMonitorRates(context.Background(), &gctrpc.GetFundingRateMonitorRatesRequest{
+		Exchanges:  []string{"FUNDINGRPCA"},
+		Underlying: "xbt",
+	})
+	require.NoError(t, err, "GetFundingRateMonitorRates must not error")
+	require.Len(t, rates.Rates, 1, "Rates must be filtered by exchange")
+	assert.Equal(t, "BTC", rates.Rates[0].Underlying, "Underlying should be correct")
+	assert.NotNil(t, rates.Rates[0].SpotPair, "SpotPair should be set")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fundingRateStream{ctx: ctx, cancel: cancel}
+	err = s.GetFundingRateOpportunityStream(&gctrpc.GetFundingRateOpportunityStreamRequest{
+		Exchanges: []string{"fundingrpca"},
+		Type:      "cash and carry",
+	}, stream)
+	assert.ErrorIs(t, err, context.Canceled, "GetFundingRateOpportunityStream should return the stream context error")
+	require.Len(t, stream.sent, 1, "Current opportunity must be sent")
+	assert.Equal(t, "cash and carry", stream.sent[0].Type, "Type should be correct")
+	assert.Equal(t, "spot", stream.sent[0].LongAsset, "LongAsset should be correct")
+	assert.NotEmpty(t, stream.sent[0].TimeOfNextRate, "TimeOfNextRate should be set")
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	stream = &fundingRateStream{ctx: ctx, cancel: cancel}
+	err = s.GetFundingRateOpportunityStream(&gctrpc.GetFundingRateOpportunityStreamRequest{MinimumAnnualisedCarry: 100}, stream)
+	assert.ErrorIs(t, err, context.Canceled, "GetFundingRateOpportunityStream should return the stream context error")
+	assert.Empty(t, stream.sent, "Opportunities below the minimum carry should not be sent")
+}
+
+type liquidationHealthStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   []*gctrpc.LiquidationPositionHealth
+}
+
+func (l *liquidationHealthStream) Context() context.Context {
+	return l.ctx
+}
+
+func (l *liquidationHealthStream) Send(h *gctrpc.LiquidationPositionHealth) error {
+	l.sent = append(l.sent, h)
+	l.cancel()
+	return nil
+}
+
+func TestLiquidationMonitorRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetLiquidationMonitorPositions(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetLiquidationMonitorPositions should error on nil request")
+	_, err = s.GetLiquidationMonitorPositions(context.Background(), &gctrpc.GetLiquidationMonitorPositionsRequest{MinimumLevel: "meow"})
+	assert.ErrorIs(t, err, errUnknownLiquidationRiskLevel, "GetLiquidationMonitorPositions should error on invalid level")
+	_, err = s.GetLiquidationMonitorPositions(context.Background(), &gctrpc.GetLiquidationMonitorPositionsRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetLiquidationMonitorPositions should error when not started")
+	err = s.GetLiquidationMonitorStream(nil, nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetLiquidationMonitorStream should error on nil request")
+	err = s.GetLiquidationMonitorStream(&gctrpc.GetLiquidationMonitorStreamRequest{}, nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted, "GetLiquidationMonitorStream should error when not started")
+
+	exch := &liquidationTestExchange{name: "liquidationrpc", leverage: 10}
+	s.liquidationMonitor, _, _ = setupLiquidationMonitorTest(t, &config.LiquidationMonitor{}, exch, liquidationTestPosition(exch.name, order.Long))
+	s.liquidationMonitor.refresh(context.Background())
+
+	resp, err := s.GetLiquidationMonitorPositions(context.Background(), &gctrpc.GetLiquidationMonitorPositionsRequest{Exchanges: []string{"LIQUIDATIONRPC"}})
+	require.NoError(t, err, "GetLiquidationMonitorPositions must not error")
+	require.Len(t, resp.Positions, 1, "Position must be returned")
+	assert.Equal(t, "healthy", resp.Positions[0].Level, "Level should be correct")
+	assert.Equal(t, "isolated", resp.Positions[0].MarginType, "MarginType should be correct")
+	assert.InDelta(t, 90/0.995, resp.Positions[0].EstimatedLiquidationPrice, 1e-9, "EstimatedLiquidationPrice should be correct")
+	resp, err = s.GetLiquidationMonitorPositions(context.Background(), &gctrpc.GetLiquidationMonitorPositionsRequest{MinimumLevel: "Warning"})
+	require.NoError(t, err, "GetLiquidationMonitorPositions must not error")
+	assert.Empty(t, resp.Positions, "Positions below the minimum level should be excluded")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &liquidationHealthStream{ctx: ctx, cancel: cancel}
+	err = s.GetLiquidationMonitorStream(&gctrpc.GetLiquidationMonitorStreamRequest{Exchanges: []string{"liquidationrpc"}}, stream)
+	assert.ErrorIs(t, err, context.Canceled, "GetLiquidationMonitorStream should return the stream context error")
+	require.Len(t, stream.sent, 1, "Current position health must be sent")
+	assert.Equal(t, "liquidationrpc", stream.sent[0].Exchange, "Exchange should be correct")
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	stream = &liquidationHealthStream{ctx: ctx, cancel: cancel}
+	err = s.GetLiquidationMonitorStream(&gctrpc.GetLiquidationMonitorStreamRequest{MinimumLevel: "critical"}, stream)
+	assert.ErrorIs(t, err, context.Canceled, "GetLiquidationMonitorStream should return the stream context error")
+	assert.Empty(t, stream.sent, "Positions below the minimum level should not be sent")
+}
+
+// optionsTestExchange is a fake exchange listing a single option
+type optionsTestExchange struct {
+	fExchange
+	instrument options.Instrument
+	bid, ask   float64
+}
+
+func (o *optionsTestExchange) GetOptionContracts(_ context.Context, r *options.ChainRequest) ([]options.Instrument, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return []options.Instrument{o.instrument}, nil
+}
+
+func (o *optionsTestExchange) FetchOrderbook(_ context.Context, p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+	ob := &orderbook.Base{Exchange: o.GetName(), Pair: p, Asset: a}
+	if o.bid > 0 {
+		ob.Bids = orderbook.Items{{Price: o.bid, Amount: 1}}
+	}
+	if o.ask > 0 {
+		ob.Asks = orderbook.Items{{Price: o.ask, Amount: 1}}
+	}
+	return ob, nil
+}
+
+func TestOptionRPCs(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = fakeExchangeName
+	b.Enabled = true
+	optionPair, err := currency.NewPairDelimiter("BTC-USD-261231-1300-C", currency.DashDelimiter)
+	require.NoError(t, err, "NewPairDelimiter must not error")
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Options: {
+			AssetEnabled:  convert.BoolPtr(true),
+			Available:     currency.Pairs{optionPair},
+			Enabled:       currency.Pairs{optionPair},
+			RequestFormat: &currency.PairFormat{Delimiter: currency.DashDelimiter, Uppercase: true},
+			ConfigFormat:  &currency.PairFormat{Delimiter: currency.DashDelimiter, Uppercase: true},
+		},
+	}
+	underlying := currency.NewPair(currency.BTC, currency.USD)
+	fake := &optionsTestExchange{
+		fExchange: fExchange{IBotExchange: exch},
+		instrument: options.Instrument{
+			Exchange:   fakeExchangeName,
+			Asset:      asset.Options,
+			Pair:       optionPair,
+			Underlying: underlying,
+			Strike:     1300,
+			Expiry:     time.Now().Add(time.Hour * 24 * 30),
+			Type:       options.Call,
+		},
+	}
+	require.NoError(t, em.Add(fake), "Add must not error")
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	_, err = s.GetOptionChain(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetOptionChain should error on nil request")
+	_, err = s.GetOptionChain(context.Background(), &gctrpc.GetOptionChainRequest{Exchange: fakeExchangeName, Asset: "options"})
+	assert.ErrorIs(t, err, errCurrencyPairUnset, "GetOptionChain should error without an underlying")
+	underlyingRPC := &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"}
+	_, err = s.GetOptionChain(context.Background(), &gctrpc.GetOptionChainRequest{Exchange: fakeExchangeName, Asset: "options", Underlying: underlyingRPC, Expiry: "tomorrow"})
+	assert.ErrorIs(t, err, errInvalidTimes, "GetOptionChain should error on an invalid expiry")
+	chain, err := s.GetOptionChain(context.Background(), &gctrpc.GetOptionChainRequest{Exchange: fakeExchangeName, Asset: "options", Underlying: underlyingRPC})
+	require.NoError(t, err, "GetOptionChain must not error")
+	require.Len(t, chain.Instruments, 1, "GetOptionChain must return the instrument")
+	assert.Equal(t, "call", chain.Instruments[0].Type, "Type should be correct")
+	assert.Equal(t, 1300.0, chain.Instruments[0].Strike, "Strike should be correct")
+
+	_, err = s.GetOptionGreeks(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetOptionGreeks should error on nil request")
+	req := &gctrpc.GetOptionGreeksRequest{Exchange: fakeExchangeName, Asset: "options", Underlying: underlyingRPC}
+	_, err = s.GetOptionGreeks(context.Background(), req)
+	assert.ErrorIs(t, err, errCurrencyPairUnset, "GetOptionGreeks should error without a pair")
+	req.Pair = &gctrpc.CurrencyPair{Delimiter: optionPair.Delimiter, Base: optionPair.Base.String(), Quote: optionPair.Quote.String()}
+	_, err = s.GetOptionGreeks(context.Background(), req)
+	assert.ErrorIs(t, err, errNoOrderbookMid, "GetOptionGreeks should error on an empty orderbook")
+
+	// Spot priced from the fake ticker's last price of 1337
+	timeToExpiry := 30.0 / 365
+	price, err := options.Price(&options.PricingInput{Model: options.BlackScholes, Type: options.Call, Underlying: 1337, Strike: 1300, TimeToExpiry: timeToExpiry, Volatility: 0.8, RiskFreeRate: 0.05})
+	require.NoError(t, err, "Price must not error")
+	fake.bid, fake.ask = price-0.5, price+0.5
+	req.RiskFreeRate = 0.05
+	resp, err := s.GetOptionGreeks(context.Background(), req)
+	require.NoError(t, err, "GetOptionGreeks must not error")
+	assert.Equal(t, "black-scholes", resp.Model, "Model should default to Black-Scholes without a forward price")
+	assert.Equal(t, 1337.0, resp.UnderlyingPrice, "UnderlyingPrice should be the spot ticker's last price")
+	assert.InDelta(t, price, resp.Mid, 1e-9, "Mid should be correct")
+	assert.InDelta(t, 0.8, resp.ImpliedVolatility, 1e-4, "ImpliedVolatility should be correct")
+	assert.InDelta(t, price, resp.Greeks.Price, 1e-6, "Greeks should be priced at the mid")
+	assert.Positive(t, resp.Greeks.Delta, "Delta should be positive for a call")
+
+	// Premium quoted in the underlying and priced from the forward
+	fake.instrument.ForwardPrice = 1400
+	fake.instrument.PremiumInUnderlying = true
+	price, err = options.Price(&options.PricingInput{Model: options.Black76, Type: options.Call, Underlying: 1400, Strike: 1300, TimeToExpiry: timeToExpiry, Volatility: 0.6})
+	require.NoError(t, err, "Price must not error")
+	fake.bid, fake.ask = price/1400, price/1400
+	req.RiskFreeRate = 0
+	resp, err = s.GetOptionGreeks(context.Background(), req)
+	require.NoError(t, err, "GetOptionGreeks must not error")
+	assert.Equal(t, "black-76", resp.Model, "Model should default to Black-76 with a forward price")
+	assert.Equal(t, 1400.0, resp.UnderlyingPrice, "UnderlyingPrice should be the forward price")
+	assert.InDelta(t, price, resp.Mid, 1e-9, "Mid should be converted to the quote currency")
+	assert.InDelta(t, 0.6, resp.ImpliedVolatility, 1e-4, "ImpliedVolatility should be correct")
+
+	req.Model = "binomial"
+	_, err = s.GetOptionGreeks(context.Background(), req)
+	assert.ErrorContains(t, err, "unknown pricing model", "GetOptionGreeks should error on an unknown model")
+}
+
+type eventBusStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	mu     sync.Mutex
+	sent   []*gctrpc.BusEvent
+}
+
+func (e *eventBusStream) Context() context.Context {
+	return e.ctx
+}
+
+func (e *eventBusStream) Send(b *gctrpc.BusEvent) error {
+	e.mu.Lock()
+	e.sent = append(e.sent, b)
+	e.mu.Unlock()
+	e.cancel()
+	return nil
+}
+
+func TestEventBusRPCs(t *testing.T) {
+	startEventBus(t)
+	s := RPCServer{Engine: &Engine{}}
+	err := s.GetEventStream(nil, nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetEventStream should error on nil request")
+	err = s.GetEventStream(&gctrpc.GetEventStreamRequest{Topic: "meow"}, nil)
+	assert.ErrorIs(t, err, eventbus.ErrUnknownTopic, "GetEventStream should error on unknown topic")
+	err = s.GetEventStream(&gctrpc.GetEventStreamRequest{Topic: "ticker", DropPolicy: "meow"}, nil)
+	assert.Error(t, err, "GetEventStream should error on unknown drop policy")
+	err = s.GetEventStream(&gctrpc.GetEventStreamRequest{Topic: "ticker", Asset: "meow"}, nil)
+	assert.ErrorIs(t, err, asset.ErrNotSupported, "GetEventStream should error on unknown asset")
+
+	_, err = s.GetEventBusMetrics(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRequestData, "GetEventBusMetrics should error on nil request")
+	metrics, err := s.GetEventBusMetrics(context.Background(), &gctrpc.GetEventBusMetricsRequest{})
+	require.NoError(t, err, "GetEventBusMetrics must not error")
+	assert.Len(t, metrics.Topics, len(eventbus.Topics), "Every topic should be reported")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &eventBusStream{ctx: ctx, cancel: cancel}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.GetEventStream(&gctrpc.GetEventStreamRequest{
+			Topic:    "ticker",
+			Exchange: "eventbusrpc",
+			Asset:    "spot",
+			Pair:     &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USD"},
+		}, stream)
+	}()
+	pair := currency.NewPairWithDelimiter("BTC", "USD", "-")
+	assert.Eventually(t, func() bool {
+		// The subscription is made inside the stream handler so publish
+		// until it is in place to receive the ticker
+		assert.NoError(t, eventbus.PublishTicker(&ticker.Price{ExchangeName: "eventbusrpc", AssetType: asset.Spot, Pair: pair, Last: 1337}), "PublishTicker should not error")
+		return ctx.Err() != nil
+	}, time.Second, time.Millisecond*10, "Ticker should be streamed")
+	assert.ErrorIs(t, <-errs, context.Canceled, "GetEventStream should return the stream context error")
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+	require.NotEmpty(t, stream.sent, "Ticker must be sent")
+	assert.Equal(t, "ticker", stream.sent[0].Topic, "Topic should be correct")
+	assert.Equal(t, "eventbusrpc", stream.sent[0].Exchange, "Exchange should be correct")
+	var tick ticker.Price
+	require.NoError(t, json.Unmarshal(stream.sent[0].Data, &tick), "Unmarshal must not error")
+	assert.Equal(t, 1337.0, tick.Last, "Ticker data should be correct")
+}
@@ -0,0 +1,323 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupSmartOrderRouter creates a new smart order router
+func SetupSmartOrderRouter(exchangeManager iExchangeManager, orderManager iOrderSubmitter) (*SmartOrderRouter, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	return &SmartOrderRouter{
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+	}, nil
+}
+
+// Route builds a consolidated orderbook for a pair from every eligible
+// exchange and allocates the order to the cheapest liquidity after taker fees,
+// limited by each exchange's available balance. Unless a dry run is
+// requested, a child order is then submitted to each allocated exchange
+// concurrently and their fills are consolidated into the returned report
+func (s *SmartOrderRouter) Route(ctx context.Context, r *RouteRequest) (*RouteReport, error) {
+	if s == nil {
+		return nil, fmt.Errorf("smart order router %w", ErrNilSubsystem)
+	}
+	if r == nil {
+		return nil, errNilRouteRequest
+	}
+	if r.Pair.IsEmpty() {
+		return nil, order.ErrPairIsEmpty
+	}
+	if !r.Asset.IsValid() {
+		return nil, fmt.Errorf("'%s' %w", r.Asset, asset.ErrNotSupported)
+	}
+	if !r.Side.IsLong() && !r.Side.IsShort() {
+		return nil, fmt.Errorf("%w %v", order.ErrSideIsInvalid, r.Side)
+	}
+	if r.Amount <= 0 {
+		return nil, fmt.Errorf("%w %v", order.ErrAmountIsInvalid, r.Amount)
+	}
+	if r.LimitPrice < 0 {
+		return nil, fmt.Errorf("%w %v", order.ErrPriceBelowMin, r.LimitPrice)
+	}
+	if !r.DryRun && !s.orderManager.IsRunning() {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+
+	exchanges, err := s.getExchanges(r.Exchanges)
+	if err != nil {
+		return nil, err
+	}
+	report := &RouteReport{
+		Pair:            r.Pair,
+		Asset:           r.Asset,
+		Side:            r.Side,
+		RequestedAmount: r.Amount,
+		DryRun:          r.DryRun,
+	}
+	venues := make([]*routeVenue, 0, len(exchanges))
+	for i := range exchanges {
+		if err = checkRoutable(exchanges[i], r); err != nil {
+			// Exchanges which do not trade the pair are only reported when
+			// explicitly requested
+			if len(r.Exchanges) > 0 {
+				report.Excluded = append(report.Excluded, RouteExclusion{
+					Exchange: exchanges[i].GetName(),
+					Reason:   err.Error(),
+				})
+			}
+			continue
+		}
+		var v *routeVenue
+		v, err = loadVenue(ctx, exchanges[i], r)
+		if err != nil {
+			report.Excluded = append(report.Excluded, RouteExclusion{
+				Exchange: exchanges[i].GetName(),
+				Reason:   err.Error(),
+			})
+			continue
+		}
+		venues = append(venues, v)
+	}
+	if len(venues) == 0 {
+		return report, errNoRoutableExchanges
+	}
+
+	allocate(venues, r)
+	for _, v := range venues {
+		if v.amount <= 0 {
+			continue
+		}
+		a := RouteAllocation{
+			Exchange:     v.name,
+			Amount:       v.amount,
+			AveragePrice: v.notional / v.amount,
+			WorstPrice:   v.worstPrice,
+			FeeRate:      v.feeRate,
+			EstimatedFee: v.notional * v.feeRate,
+		}
+		report.Allocations = append(report.Allocations, a)
+		report.AllocatedAmount += a.Amount
+		report.EstimatedFees += a.EstimatedFee
+		report.EstimatedAveragePrice += v.notional
+	}
+	if len(report.Allocations) == 0 {
+		return report, errNothingAllocated
+	}
+	report.EstimatedAveragePrice /= report.AllocatedAmount
+	report.UnallocatedAmount = math.Max(r.Amount-report.AllocatedAmount, 0)
+	sort.Slice(report.Allocations, func(i, j int) bool {
+		return report.Allocations[i].Amount > report.Allocations[j].Amount
+	})
+	if r.DryRun {
+		return report, nil
+	}
+
+	s.submit(ctx, r, report)
+	return report, nil
+}
+
+// getExchanges returns the named exchanges, or every loaded exchange when no
+// names are supplied
+func (s *SmartOrderRouter) getExchanges(names []string) ([]exchange.IBotExchange, error) {
+	if len(names) == 0 {
+		return s.exchangeManager.GetExchanges()
+	}
+	exchanges := make([]exchange.IBotExchange, 0, len(names))
+	for i := range names {
+		exch, err := s.exchangeManager.GetExchangeByName(names[i])
+		if err != nil {
+			return nil, err
+		}
+		exchanges = append(exchanges, exch)
+	}
+	return exchanges, nil
+}
+
+// checkRoutable returns an error when an exchange is not enabled or does not
+// trade the requested pair
+func checkRoutable(exch exchange.IBotExchange, r *RouteRequest) error {
+	if !exch.IsEnabled() {
+		return errExchangeNotEnabled
+	}
+	pairs, err := exch.GetEnabledPairs(r.Asset)
+	if err != nil {
+		return err
+	}
+	if !pairs.Contains(r.Pair, true) {
+		return fmt.Errorf("%v %w", r.Pair, errPairNotEnabled)
+	}
+	return nil
+}
+
+// loadVenue retrieves the orderbook depth, taker fee rate and available
+// balance required to route an order to an exchange
+func loadVenue(ctx context.Context, exch exchange.IBotExchange, r *RouteRequest) (*routeVenue, error) {
+	v := &routeVenue{name: exch.GetName()}
+	depth, err := orderbook.GetDepth(v.name, r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	book, err := depth.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	balanceCode := r.Pair.Quote
+	if r.Side.IsLong() {
+		v.levels = book.Asks
+	} else {
+		v.levels = book.Bids
+		balanceCode = r.Pair.Base
+	}
+	if len(v.levels) == 0 {
+		return nil, fmt.Errorf("%w for %s", errNoLiquidity, r.Side)
+	}
+
+	// Fees are retrieved for a single unit at the best price so that a rate
+	// can be applied to every level
+	fee, err := exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          r.Pair,
+		PurchasePrice: v.levels[0].Price,
+		Amount:        1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve taker fee: %w", err)
+	}
+	v.feeRate = fee / v.levels[0].Price
+
+	creds, err := exch.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := account.GetBalance(v.name, creds.SubAccount, creds, r.Asset, balanceCode)
+	if err != nil {
+		return nil, err
+	}
+	v.capacity = balance.GetFree()
+	if v.capacity <= 0 {
+		return nil, fmt.Errorf("%w %s", errNoAvailableBalance, balanceCode)
+	}
+	return v, nil
+}
+
+// allocate walks the consolidated orderbook from the best price after fees,
+// taking liquidity from each exchange until the order is filled or the
+// exchange's available balance is exhausted
+func allocate(venues []*routeVenue, r *RouteRequest) {
+	var levels []routeLevel
+	for _, v := range venues {
+		for i := range v.levels {
+			if r.LimitPrice > 0 &&
+				((r.Side.IsLong() && v.levels[i].Price > r.LimitPrice) ||
+					(r.Side.IsShort() && v.levels[i].Price < r.LimitPrice)) {
+				continue
+			}
+			l := routeLevel{
+				venue:  v,
+				price:  v.levels[i].Price,
+				amount: v.levels[i].Amount,
+			}
+			if r.Side.IsLong() {
+				l.effectivePrice = l.price * (1 + v.feeRate)
+			} else {
+				l.effectivePrice = l.price * (1 - v.feeRate)
+			}
+			levels = append(levels, l)
+		}
+	}
+	sort.SliceStable(levels, func(i, j int) bool {
+		if levels[i].effectivePrice == levels[j].effectivePrice {
+			return levels[i].venue.name < levels[j].venue.name
+		}
+		if r.Side.IsLong() {
+			return levels[i].effectivePrice < levels[j].effectivePrice
+		}
+		return levels[i].effectivePrice > levels[j].effectivePrice
+	})
+
+	remaining := r.Amount
+	for i := range levels {
+		if remaining <= r.Amount*amountTolerance {
+			return
+		}
+		v := levels[i].venue
+		take := math.Min(levels[i].amount, remaining)
+		if r.Side.IsLong() {
+			// Buying is limited by the quote balance including fees
+			take = math.Min(take, (v.capacity-v.spent)/levels[i].effectivePrice)
+		} else {
+			take = math.Min(take, v.capacity-v.amount)
+		}
+		if take <= 0 {
+			continue
+		}
+		v.amount += take
+		v.notional += take * levels[i].price
+		v.spent += take * levels[i].effectivePrice
+		v.worstPrice = levels[i].price
+		remaining -= take
+	}
+}
+
+// submit concurrently submits a child order for each allocation and
+// consolidates their fills into the report
+func (s *SmartOrderRouter) submit(ctx context.Context, r *RouteRequest, report *RouteReport) {
+	var wg sync.WaitGroup
+	for i := range report.Allocations {
+		wg.Add(1)
+		go func(a *RouteAllocation) {
+			defer wg.Done()
+			sub := &order.Submit{
+				Exchange:  a.Exchange,
+				Type:      order.Market,
+				Side:      r.Side,
+				Pair:      r.Pair,
+				AssetType: r.Asset,
+				Amount:    a.Amount,
+			}
+			if r.LimitPrice > 0 {
+				sub.Type = order.Limit
+				sub.Price = r.LimitPrice
+			}
+			resp, err := s.orderManager.Submit(ctx, sub)
+			if err != nil {
+				a.Err = err
+				log.Errorf(log.OrderMgr, "Smart order router failed to submit %s %s %s child order to %s: %v",
+					r.Side, r.Asset, r.Pair, a.Exchange, err)
+				return
+			}
+			if resp != nil && resp.Detail != nil {
+				a.OrderID = resp.OrderID
+				a.Status = resp.Status
+				a.FilledAmount, a.FillPrice = filledFromDetail(resp.Detail)
+			}
+		}(&report.Allocations[i])
+	}
+	wg.Wait()
+
+	var notional float64
+	for i := range report.Allocations {
+		report.FilledAmount += report.Allocations[i].FilledAmount
+		notional += report.Allocations[i].FilledAmount * report.Allocations[i].FillPrice
+	}
+	if report.FilledAmount > 0 {
+		report.AverageFillPrice = notional / report.FilledAmount
+	}
+}
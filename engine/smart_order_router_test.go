@@ -0,0 +1,323 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var errFakeFee = errors.New("fake fee failure")
+
+// routeTestExchange overrides the name, pairs, fees and credentials of an
+// exchange so that multiple venues can be routed to
+type routeTestExchange struct {
+	exchange.IBotExchange
+	name    string
+	feeRate float64
+	feeErr  error
+}
+
+func (r *routeTestExchange) GetName() string {
+	return r.name
+}
+
+func (r *routeTestExchange) IsEnabled() bool {
+	return true
+}
+
+func (r *routeTestExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return currency.Pairs{btcusdPair}, nil
+}
+
+func (r *routeTestExchange) GetFeeByType(_ context.Context, f *exchange.FeeBuilder) (float64, error) {
+	if r.feeErr != nil {
+		return 0, r.feeErr
+	}
+	return r.feeRate * f.PurchasePrice * f.Amount, nil
+}
+
+func (r *routeTestExchange) GetCredentials(context.Context) (*account.Credentials, error) {
+	return &account.Credentials{Key: r.name}, nil
+}
+
+// routeTestVenue defines the market data and balances of a test exchange
+type routeTestVenue struct {
+	name    string
+	feeRate float64
+	feeErr  error
+	bids    orderbook.Items
+	asks    orderbook.Items
+	base    float64
+	quote   float64
+}
+
+func setupSmartOrderRouterTest(t *testing.T, om iOrderSubmitter, venues ...routeTestVenue) *SmartOrderRouter {
+	t.Helper()
+	em := NewExchangeManager()
+	for i := range venues {
+		exch, err := em.NewExchangeByName(testExchange)
+		require.NoError(t, err, "NewExchangeByName must not error")
+		exch.SetDefaults()
+		require.NoError(t, em.Add(&routeTestExchange{
+			IBotExchange: exch,
+			name:         venues[i].name,
+			feeRate:      venues[i].feeRate,
+			feeErr:       venues[i].feeErr,
+		}), "Add must not error")
+		if venues[i].bids != nil || venues[i].asks != nil {
+			require.NoError(t, (&orderbook.Base{
+				Exchange: venues[i].name,
+				Pair:     btcusdPair,
+				Asset:    asset.Spot,
+				Bids:     venues[i].bids,
+				Asks:     venues[i].asks,
+			}).Process(), "Process must not error")
+		}
+		require.NoError(t, account.Process(&account.Holdings{
+			Exchange: venues[i].name,
+			Accounts: []account.SubAccount{{
+				AssetType: asset.Spot,
+				Currencies: []account.Balance{
+					{Currency: currency.BTC, Total: venues[i].base, Free: venues[i].base},
+					{Currency: currency.USD, Total: venues[i].quote, Free: venues[i].quote},
+				},
+			}},
+		}, &account.Credentials{Key: venues[i].name}), "account Process must not error")
+	}
+	r, err := SetupSmartOrderRouter(em, om)
+	require.NoError(t, err, "SetupSmartOrderRouter must not error")
+	return r
+}
+
+func routeTestRequest(side order.Side, amount float64) *RouteRequest {
+	return &RouteRequest{
+		Pair:   btcusdPair,
+		Asset:  asset.Spot,
+		Side:   side,
+		Amount: amount,
+		DryRun: true,
+	}
+}
+
+func TestSetupSmartOrderRouter(t *testing.T) {
+	t.Parallel()
+	_, err := SetupSmartOrderRouter(nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager, "SetupSmartOrderRouter should error on nil exchange manager")
+	_, err = SetupSmartOrderRouter(NewExchangeManager(), nil)
+	assert.ErrorIs(t, err, errNilOrderManager, "SetupSmartOrderRouter should error on nil order manager")
+	_, err = SetupSmartOrderRouter(NewExchangeManager(), &fakeOrderSubmitter{})
+	assert.NoError(t, err, "SetupSmartOrderRouter should not error")
+}
+
+func TestSmartOrderRouterRouteValidation(t *testing.T) {
+	t.Parallel()
+	var r *SmartOrderRouter
+	_, err := r.Route(context.Background(), routeTestRequest(order.Buy, 1))
+	assert.ErrorIs(t, err, ErrNilSubsystem, "Route should error on nil router")
+
+	r, err = SetupSmartOrderRouter(NewExchangeManager(), &OrderManager{})
+	require.NoError(t, err, "SetupSmartOrderRouter must not error")
+	_, err = r.Route(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilRouteRequest, "Route should error on nil request")
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*RouteRequest)
+		err    error
+	}{
+		{"pair", func(req *RouteRequest) { req.Pair = currency.EMPTYPAIR }, order.ErrPairIsEmpty},
+		{"asset", func(req *RouteRequest) { req.Asset = asset.Empty }, asset.ErrNotSupported},
+		{"side", func(req *RouteRequest) { req.Side = order.AnySide }, order.ErrSideIsInvalid},
+		{"amount", func(req *RouteRequest) { req.Amount = 0 }, order.ErrAmountIsInvalid},
+		{"price", func(req *RouteRequest) { req.LimitPrice = -1 }, order.ErrPriceBelowMin},
+		{"order manager", func(req *RouteRequest) { req.DryRun = false }, ErrSubSystemNotStarted},
+		{"exchange", func(req *RouteRequest) { req.Exchanges = []string{"bruh"} }, ErrExchangeNotFound},
+	} {
+		req := routeTestRequest(order.Buy, 1)
+		tc.modify(req)
+		_, err = r.Route(context.Background(), req)
+		assert.ErrorIsf(t, err, tc.err, "Route should error on invalid %s", tc.name)
+	}
+	_, err = r.Route(context.Background(), routeTestRequest(order.Buy, 1))
+	assert.ErrorIs(t, err, errNoRoutableExchanges, "Route should error without any exchanges")
+}
+
+func TestSmartOrderRouterRouteBuy(t *testing.T) {
+	t.Parallel()
+	om := &fakeAlgoOrderManager{orders: make(map[string]*order.Detail)}
+	r := setupSmartOrderRouterTest(t, om,
+		routeTestVenue{
+			name:    "sorbuya",
+			feeRate: 0.001,
+			asks:    orderbook.Items{{Price: 100, Amount: 1}, {Price: 101, Amount: 2}},
+			bids:    orderbook.Items{{Price: 99, Amount: 1}},
+			quote:   1000,
+		},
+		routeTestVenue{
+			name:    "sorbuyb",
+			feeRate: 0.005,
+			asks:    orderbook.Items{{Price: 100.2, Amount: 1}, {Price: 100.5, Amount: 5}},
+			bids:    orderbook.Items{{Price: 99, Amount: 1}},
+			quote:   1000,
+		},
+	)
+
+	report, err := r.Route(context.Background(), routeTestRequest(order.Buy, 3))
+	require.NoError(t, err, "Route must not error")
+	assert.Empty(t, om.getSubmitted(), "a dry run should not submit orders")
+	assert.Equal(t, 3.0, report.AllocatedAmount, "AllocatedAmount should be correct")
+	assert.Zero(t, report.UnallocatedAmount, "UnallocatedAmount should be zero")
+	require.Len(t, report.Allocations, 2, "Allocations must contain both exchanges")
+	// sorbuyb's fees make its levels more expensive than sorbuya's best
+	// level but cheaper than its second
+	assert.Equal(t, "sorbuyb", report.Allocations[0].Exchange, "largest allocation should be first")
+	assert.Equal(t, 2.0, report.Allocations[0].Amount, "sorbuyb allocation should be correct")
+	assert.InDelta(t, 100.35, report.Allocations[0].AveragePrice, 1e-9, "sorbuyb average price should be correct")
+	assert.Equal(t, 100.5, report.Allocations[0].WorstPrice, "sorbuyb worst price should be correct")
+	assert.InDelta(t, 0.005, report.Allocations[0].FeeRate, 1e-12, "sorbuyb fee rate should be correct")
+	assert.Equal(t, "sorbuya", report.Allocations[1].Exchange, "smallest allocation should be last")
+	assert.Equal(t, 1.0, report.Allocations[1].Amount, "sorbuya allocation should be correct")
+	assert.InDelta(t, 1.1035, report.EstimatedFees, 1e-9, "EstimatedFees should be correct")
+	assert.InDelta(t, 100.2333333333, report.EstimatedAveragePrice, 1e-9, "EstimatedAveragePrice should be correct")
+
+	req := routeTestRequest(order.Buy, 10)
+	req.LimitPrice = 100.2
+	report, err = r.Route(context.Background(), req)
+	require.NoError(t, err, "Route must not error")
+	assert.Equal(t, 2.0, report.AllocatedAmount, "AllocatedAmount should exclude levels above the limit price")
+	assert.Equal(t, 8.0, report.UnallocatedAmount, "UnallocatedAmount should be correct")
+
+	req.Exchanges = []string{"sorbuya"}
+	report, err = r.Route(context.Background(), req)
+	require.NoError(t, err, "Route must not error")
+	require.Len(t, report.Allocations, 1, "Allocations must be limited to the requested exchanges")
+	assert.Equal(t, "sorbuya", report.Allocations[0].Exchange, "allocation should be to the requested exchange")
+}
+
+func TestSmartOrderRouterRouteBalance(t *testing.T) {
+	t.Parallel()
+	r := setupSmartOrderRouterTest(t, &fakeOrderSubmitter{},
+		routeTestVenue{
+			name:  "sorbalancea",
+			asks:  orderbook.Items{{Price: 100, Amount: 5}},
+			bids:  orderbook.Items{{Price: 99, Amount: 5}},
+			base:  1,
+			quote: 150,
+		},
+		routeTestVenue{
+			name:  "sorbalanceb",
+			asks:  orderbook.Items{{Price: 110, Amount: 5}},
+			bids:  orderbook.Items{{Price: 90, Amount: 5}},
+			base:  5,
+			quote: 1000,
+		},
+	)
+	report, err := r.Route(context.Background(), routeTestRequest(order.Buy, 2))
+	require.NoError(t, err, "Route must not error")
+	require.Len(t, report.Allocations, 2, "Allocations must contain both exchanges")
+	assert.Equal(t, "sorbalancea", report.Allocations[0].Exchange, "cheapest exchange should have the largest allocation")
+	assert.InDelta(t, 1.5, report.Allocations[0].Amount, 1e-9, "sorbalancea allocation should be limited by its quote balance")
+	assert.InDelta(t, 0.5, report.Allocations[1].Amount, 1e-9, "sorbalanceb allocation should take the remainder")
+
+	report, err = r.Route(context.Background(), routeTestRequest(order.Sell, 2))
+	require.NoError(t, err, "Route must not error")
+	require.Len(t, report.Allocations, 2, "Allocations must contain both exchanges")
+	assert.Equal(t, 1.0, report.Allocations[0].Amount, "sell allocations should be limited by the base balance")
+	assert.Equal(t, 1.0, report.Allocations[1].Amount, "sell allocations should be limited by the base balance")
+	assert.Equal(t, 94.5, report.EstimatedAveragePrice, "EstimatedAveragePrice should be correct")
+}
+
+func TestSmartOrderRouterRouteExclusions(t *testing.T) {
+	t.Parallel()
+	r := setupSmartOrderRouterTest(t, &fakeOrderSubmitter{},
+		routeTestVenue{name: "sorexcludeddepth", quote: 1000},
+		routeTestVenue{
+			name:   "sorexcludedfee",
+			feeErr: errFakeFee,
+			asks:   orderbook.Items{{Price: 100, Amount: 5}},
+			bids:   orderbook.Items{{Price: 99, Amount: 5}},
+			quote:  1000,
+		},
+		routeTestVenue{
+			name: "sorexcludedbalance",
+			asks: orderbook.Items{{Price: 100, Amount: 5}},
+			bids: orderbook.Items{{Price: 99, Amount: 5}},
+		},
+		routeTestVenue{
+			name:  "sorincluded",
+			asks:  orderbook.Items{{Price: 100, Amount: 5}},
+			bids:  orderbook.Items{{Price: 99, Amount: 5}},
+			quote: 1000,
+		},
+	)
+	report, err := r.Route(context.Background(), routeTestRequest(order.Buy, 1))
+	require.NoError(t, err, "Route must not error")
+	require.Len(t, report.Allocations, 1, "Allocations must only contain the routable exchange")
+	assert.Equal(t, "sorincluded", report.Allocations[0].Exchange, "allocation should be to the routable exchange")
+	assert.Len(t, report.Excluded, 3, "Excluded should contain each unroutable exchange")
+
+	req := routeTestRequest(order.Buy, 1)
+	req.Exchanges = []string{"sorexcludedfee"}
+	report, err = r.Route(context.Background(), req)
+	assert.ErrorIs(t, err, errNoRoutableExchanges, "Route should error when no exchange is routable")
+	require.Len(t, report.Excluded, 1, "Excluded must contain the requested exchange")
+	assert.Contains(t, report.Excluded[0].Reason, errFakeFee.Error(), "Reason should contain the fee error")
+
+	req.Exchanges = []string{"sorincluded"}
+	req.LimitPrice = 50
+	_, err = r.Route(context.Background(), req)
+	assert.ErrorIs(t, err, errNothingAllocated, "Route should error when no liquidity is within the limit price")
+}
+
+func TestSmartOrderRouterRouteSubmit(t *testing.T) {
+	t.Parallel()
+	om := &fakeAlgoOrderManager{orders: make(map[string]*order.Detail), fillPrice: 100}
+	r := setupSmartOrderRouterTest(t, om,
+		routeTestVenue{
+			name:  "sorsubmita",
+			asks:  orderbook.Items{{Price: 100, Amount: 1}},
+			bids:  orderbook.Items{{Price: 99, Amount: 1}},
+			quote: 1000,
+		},
+		routeTestVenue{
+			name:  "sorsubmitb",
+			asks:  orderbook.Items{{Price: 101, Amount: 2}},
+			bids:  orderbook.Items{{Price: 99, Amount: 1}},
+			quote: 1000,
+		},
+	)
+	req := routeTestRequest(order.Buy, 3)
+	req.DryRun = false
+	report, err := r.Route(context.Background(), req)
+	require.NoError(t, err, "Route must not error")
+	submitted := om.getSubmitted()
+	require.Len(t, submitted, 2, "a child order must be submitted to each allocated exchange")
+	for i := range submitted {
+		assert.Equal(t, order.Market, submitted[i].Type, "child orders should be market orders without a limit price")
+	}
+	assert.Equal(t, 3.0, report.FilledAmount, "FilledAmount should be consolidated across child orders")
+	assert.Equal(t, 100.0, report.AverageFillPrice, "AverageFillPrice should be consolidated across child orders")
+	for i := range report.Allocations {
+		assert.NotEmpty(t, report.Allocations[i].OrderID, "OrderID should be set")
+		assert.Equal(t, order.Filled, report.Allocations[i].Status, "Status should be set")
+		assert.NoError(t, report.Allocations[i].Err, "Err should not be set")
+	}
+
+	om.fail = true
+	req.LimitPrice = 101
+	report, err = r.Route(context.Background(), req)
+	require.NoError(t, err, "Route must not error when child orders fail")
+	for i := range report.Allocations {
+		assert.ErrorIs(t, report.Allocations[i].Err, errFakeSubmit, "Err should be set when submission fails")
+	}
+	assert.Zero(t, report.FilledAmount, "FilledAmount should be zero when submissions fail")
+}
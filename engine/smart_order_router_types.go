@@ -0,0 +1,109 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var (
+	errNilRouteRequest     = errors.New("nil route request received")
+	errNoRoutableExchanges = errors.New("no exchanges have depth, fees and balance available to route the order")
+	errNothingAllocated    = errors.New("no liquidity available to route the order within balance and price limits")
+	errNoLiquidity         = errors.New("orderbook has no liquidity")
+	errNoAvailableBalance  = errors.New("no available balance")
+)
+
+// SmartOrderRouter splits an order across every enabled exchange trading a
+// pair by walking a consolidated orderbook built from each exchange's depth,
+// taking the cheapest liquidity after taker fees within available balances
+type SmartOrderRouter struct {
+	exchangeManager iExchangeManager
+	orderManager    iOrderSubmitter
+}
+
+// RouteRequest defines an order to be split across exchanges
+type RouteRequest struct {
+	// Exchanges limits routing to the named exchanges, all enabled exchanges
+	// are considered when empty
+	Exchanges []string
+	Pair      currency.Pair
+	Asset     asset.Item
+	Side      order.Side
+	Amount    float64
+	// LimitPrice excludes orderbook levels priced worse than it when set and
+	// child orders are submitted as limit orders at this price, otherwise
+	// market orders are used
+	LimitPrice float64
+	// DryRun returns the routing plan without submitting any orders
+	DryRun bool
+}
+
+// RouteReport holds the routing plan for an order and, when submitted, the
+// consolidated fills of its child orders
+type RouteReport struct {
+	Pair              currency.Pair
+	Asset             asset.Item
+	Side              order.Side
+	RequestedAmount   float64
+	AllocatedAmount   float64
+	UnallocatedAmount float64
+	// EstimatedAveragePrice is the volume weighted orderbook price of all
+	// allocations excluding fees
+	EstimatedAveragePrice float64
+	EstimatedFees         float64
+	FilledAmount          float64
+	AverageFillPrice      float64
+	DryRun                bool
+	Allocations           []RouteAllocation
+	Excluded              []RouteExclusion
+}
+
+// RouteAllocation defines the amount routed to a single exchange along with
+// the result of its child order submission
+type RouteAllocation struct {
+	Exchange     string
+	Amount       float64
+	AveragePrice float64
+	// WorstPrice is the price of the deepest orderbook level consumed
+	WorstPrice   float64
+	FeeRate      float64
+	EstimatedFee float64
+	OrderID      string
+	Status       order.Status
+	FilledAmount float64
+	FillPrice    float64
+	Err          error
+}
+
+// RouteExclusion records why an exchange was not considered for routing
+type RouteExclusion struct {
+	Exchange string
+	Reason   string
+}
+
+// routeVenue holds the details of an exchange which liquidity can be taken
+// from
+type routeVenue struct {
+	name     string
+	feeRate  float64
+	capacity float64
+	levels   orderbook.Items
+	// amount, notional and spent accumulate the liquidity allocated to the
+	// exchange, spent includes fees
+	amount     float64
+	notional   float64
+	spent      float64
+	worstPrice float64
+}
+
+// routeLevel is a single price level in the consolidated orderbook
+type routeLevel struct {
+	venue          *routeVenue
+	price          float64
+	effectivePrice float64
+	amount         float64
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -61,6 +62,50 @@ type iOrderManager interface {
 	UpdateExistingOrder(*order.Detail) error
 }
 
+// iOrderStateManager limits exposure of order manager functions required to
+// evaluate and enforce risk limits
+type iOrderStateManager interface {
+	GetOrdersFiltered(*order.Filter) ([]order.Detail, error)
+	GetOrdersActive(*order.Filter) ([]order.Detail, error)
+	CancelAllOrders(context.Context, []exchange.IBotExchange)
+}
+
+// iOrderSubmitter limits exposure of order manager functions required by
+// subsystems which submit orders on behalf of the user
+type iOrderSubmitter interface {
+	IsRunning() bool
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+}
+
+// iFuturesPositionManager limits exposure of order manager functions required
+// to monitor and reduce open futures positions
+type iFuturesPositionManager interface {
+	iOrderSubmitter
+	GetAllOpenFuturesPositions() ([]futures.Position, error)
+}
+
+// iAlgoOrderManager limits exposure of order manager functions required to
+// submit, cancel and track the fills of child orders
+type iAlgoOrderManager interface {
+	iOrderSubmitter
+	Cancel(context.Context, *order.Cancel) error
+	GetByExchangeAndID(string, string) (*order.Detail, error)
+}
+
+// iMarketMakingOrderManager limits exposure of order manager functions
+// required to submit, replace and cancel quotes
+type iMarketMakingOrderManager interface {
+	iAlgoOrderManager
+	Modify(context.Context, *order.Modify) (*order.ModifyResponse, error)
+}
+
+// iRiskManager defines a limited scoped risk manager which orders pass through
+// before being sent to an exchange
+type iRiskManager interface {
+	CheckSubmit(context.Context, *order.Submit) error
+	CheckModify(context.Context, *order.Detail, *order.Modify) error
+}
+
 // iPortfolioManager limits exposure of accessible functions to portfolio manager
 type iPortfolioManager interface {
 	GetPortfolioSummary() portfolio.Summary
@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -613,6 +614,7 @@ func (m *SyncManager) syncTicker(c *currencyPairSyncAgent, e exchange.IBotExchan
 		}
 		m.PrintTickerSummary(result, "REST", err)
 		if err == nil {
+			publishEvent(eventbus.PublishTicker(result))
 			if m.remoteConfig.WebsocketRPC.Enabled {
 				relayWebsocketEvent(result, "ticker_update", c.Key.Asset.String(), exchangeName)
 			}
@@ -662,6 +664,7 @@ func (m *SyncManager) syncOrderbook(c *currencyPairSyncAgent, e exchange.IBotExc
 			c.Key.Asset)
 		m.PrintOrderbookSummary(result, "REST", err)
 		if err == nil {
+			publishEvent(eventbus.PublishOrderbook(result))
 			if m.remoteConfig.WebsocketRPC.Enabled {
 				relayWebsocketEvent(result, "orderbook_update", c.Key.Asset.String(), e.GetName())
 			}
@@ -906,6 +909,41 @@ func (m *SyncManager) WaitForInitialSync() error {
 	return nil
 }
 
+// GetSyncItemStatuses returns the synchronisation state of a sync item type
+// for every exchange asset pair being synced
+func (m *SyncManager) GetSyncItemStatuses(syncType syncItemType) ([]SyncItemStatus, error) {
+	if m == nil {
+		return nil, fmt.Errorf("sync manager %w", ErrNilSubsystem)
+	}
+	if syncType < SyncItemTicker || syncType > SyncItemTrade {
+		return nil, fmt.Errorf("%v %w", syncType, errUnknownSyncItem)
+	}
+	m.mux.Lock()
+	agents := make([]*currencyPairSyncAgent, 0, len(m.currencyPairs))
+	for _, c := range m.currencyPairs {
+		agents = append(agents, c)
+	}
+	m.mux.Unlock()
+
+	resp := make([]SyncItemStatus, 0, len(agents))
+	for _, c := range agents {
+		c.locks[syncType].Lock()
+		if s := c.trackers[syncType]; s != nil {
+			resp = append(resp, SyncItemStatus{
+				Exchange:         c.Key.Exchange,
+				Asset:            c.Key.Asset,
+				Pair:             c.Pair,
+				LastUpdated:      s.LastUpdated,
+				HaveData:         s.HaveData,
+				IsUsingWebsocket: s.IsUsingWebsocket,
+				NumErrors:        s.NumErrors,
+			})
+		}
+		c.locks[syncType].Unlock()
+	}
+	return resp, nil
+}
+
 func relayWebsocketEvent(result interface{}, event, assetType, exchangeName string) {
 	evt := WebsocketEvent{
 		Data:      result,
@@ -337,3 +337,49 @@ func TestSyncManagerWebsocketUpdate(t *testing.T) {
 		t.Fatalf("received %v, but expected: %v", err, nil)
 	}
 }
+
+func TestGetSyncItemStatuses(t *testing.T) {
+	t.Parallel()
+	var m *SyncManager
+	_, err := m.GetSyncItemStatuses(SyncItemOrderbook)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received %v, but expected: %v", err, ErrNilSubsystem)
+	}
+
+	m = &SyncManager{
+		currencyPairs: make(map[key.ExchangePairAsset]*currencyPairSyncAgent),
+		config:        config.SyncManagerConfig{SynchronizeOrderbook: true},
+	}
+	_, err = m.GetSyncItemStatuses(1336)
+	if !errors.Is(err, errUnknownSyncItem) {
+		t.Fatalf("received %v, but expected: %v", err, errUnknownSyncItem)
+	}
+
+	m.add(key.ExchangePairAsset{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Base:     currency.BTC.Item,
+		Quote:    currency.USD.Item,
+	}, syncBase{IsUsingWebsocket: true})
+	statuses, err := m.GetSyncItemStatuses(SyncItemTicker)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v, but expected: %v", err, nil)
+	}
+	if len(statuses) != 0 {
+		t.Fatalf("received %v ticker statuses, but expected: %v", len(statuses), 0)
+	}
+
+	statuses, err = m.GetSyncItemStatuses(SyncItemOrderbook)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v, but expected: %v", err, nil)
+	}
+	if len(statuses) != 1 {
+		t.Fatalf("received %v orderbook statuses, but expected: %v", len(statuses), 1)
+	}
+	if statuses[0].Exchange != testExchange || statuses[0].Asset != asset.Spot || !statuses[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USD)) {
+		t.Errorf("received unexpected status %+v", statuses[0])
+	}
+	if !statuses[0].IsUsingWebsocket || statuses[0].HaveData {
+		t.Errorf("received unexpected status %+v", statuses[0])
+	}
+}
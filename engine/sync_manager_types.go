@@ -7,6 +7,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 // syncBase stores information
@@ -18,6 +19,18 @@ type syncBase struct {
 	NumErrors        int
 }
 
+// SyncItemStatus is the synchronisation state of a sync item for an exchange
+// asset pair
+type SyncItemStatus struct {
+	Exchange         string
+	Asset            asset.Item
+	Pair             currency.Pair
+	LastUpdated      time.Time
+	HaveData         bool
+	IsUsingWebsocket bool
+	NumErrors        int
+}
+
 // currencyPairSyncAgent stores the sync agent info
 type currencyPairSyncAgent struct {
 	Key      key.ExchangePairAsset
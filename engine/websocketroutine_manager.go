@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -224,6 +226,7 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data int
 			return err
 		}
 		m.syncer.PrintTickerSummary(d, "websocket", err)
+		publishEvent(eventbus.PublishTicker(d))
 	case []ticker.Price:
 		for x := range d {
 			if m.syncer.IsRunning() {
@@ -241,6 +244,7 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data int
 				return err
 			}
 			m.syncer.PrintTickerSummary(&d[x], "websocket", err)
+			publishEvent(eventbus.PublishTicker(&d[x]))
 		}
 	case order.Detail,
 		ticker.Price,
@@ -280,6 +284,7 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data int
 			}
 		}
 		m.syncer.PrintOrderbookSummary(base, "websocket", nil)
+		publishEvent(eventbus.PublishOrderbook(base))
 	case *order.Detail:
 		if !m.orderManager.IsRunning() {
 			return nil
@@ -335,26 +340,32 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data int
 		}
 	case order.ClassificationError:
 		return fmt.Errorf("%w %s", d.Err, d.Error())
+	case stream.Reconnected:
+		log.Infof(log.WebsocketMgr, "%s websocket reconnected after being disconnected for %s", exchName, d.Timestamp.Sub(d.DisconnectedAt).Round(time.Second))
 	case stream.UnhandledMessageWarning:
 		log.Warnln(log.WebsocketMgr, d.Message)
 	case account.Change:
 		if m.verbose {
 			m.printAccountHoldingsChangeSummary(d)
 		}
+		publishEvent(eventbus.PublishAccountChange(&d))
 	case []account.Change:
-		if m.verbose {
-			for x := range d {
+		for x := range d {
+			if m.verbose {
 				m.printAccountHoldingsChangeSummary(d[x])
 			}
+			publishEvent(eventbus.PublishAccountChange(&d[x]))
 		}
 	case []trade.Data:
 		if m.verbose {
 			log.Infof(log.Trade, "%+v", d)
 		}
+		publishEvent(eventbus.PublishTrades(d))
 	case []fill.Data:
 		if m.verbose {
 			log.Infof(log.Fill, "%+v", d)
 		}
+		publishEvent(eventbus.PublishFills(d))
 	default:
 		if m.verbose {
 			log.Warnf(log.WebsocketMgr,
@@ -7,13 +7,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/dispatch/eventbus"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 func TestWebsocketRoutineManagerSetup(t *testing.T) {
@@ -357,3 +364,58 @@ func TestSetWebsocketDataHandler(t *testing.T) {
 		t.Fatal("unexpected data handler count")
 	}
 }
+
+// startEventBus starts the dispatch system the event bus relays through for
+// the duration of a test. Tests using it must not run in parallel as the
+// dispatch system is global
+func startEventBus(t *testing.T) {
+	t.Helper()
+	if dispatch.IsRunning() {
+		return
+	}
+	require.NoError(t, dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.Start must not error")
+	t.Cleanup(func() {
+		assert.NoError(t, dispatch.Stop(), "dispatch.Stop should not error")
+	})
+}
+
+// receiveEvent waits for an event to be delivered to an event bus subscription
+func receiveEvent(t *testing.T, s *eventbus.Subscription) *eventbus.Event {
+	t.Helper()
+	select {
+	case e := <-s.C():
+		return e
+	case <-time.After(time.Second):
+		require.FailNowf(t, "event must be delivered", "no %s event received", s.Topic())
+	}
+	return nil
+}
+
+func TestWebsocketRoutineManagerPublishesEvents(t *testing.T) {
+	startEventBus(t)
+	const exchName = "eventbusws"
+	m, err := setupWebsocketRoutineManager(NewExchangeManager(), &OrderManager{}, &SyncManager{}, &currency.Config{CurrencyPairFormat: &currency.PairFormat{Delimiter: "-"}}, false)
+	require.NoError(t, err, "setupWebsocketRoutineManager must not error")
+
+	subs := make(map[eventbus.Topic]*eventbus.Subscription)
+	for _, topic := range []eventbus.Topic{eventbus.TickerTopic, eventbus.TradeTopic, eventbus.FillTopic, eventbus.AccountTopic} {
+		subs[topic], err = eventbus.Subscribe(topic, &eventbus.SubscribeOptions{Filter: eventbus.ExchangeFilter(exchName)})
+		require.NoError(t, err, "Subscribe must not error")
+		defer func(s *eventbus.Subscription) {
+			assert.NoError(t, s.Unsubscribe(), "Unsubscribe should not error")
+		}(subs[topic])
+	}
+
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	require.NoError(t, m.websocketDataHandler(exchName, &ticker.Price{ExchangeName: exchName, Pair: pair, AssetType: asset.Spot, Last: 1337}), "websocketDataHandler must not error")
+	assert.Equal(t, 1337.0, receiveEvent(t, subs[eventbus.TickerTopic]).Ticker.Last, "Ticker should be published")
+
+	require.NoError(t, m.websocketDataHandler(exchName, []trade.Data{{Exchange: exchName, CurrencyPair: pair, AssetType: asset.Spot, TID: "1"}}), "websocketDataHandler must not error")
+	assert.Equal(t, "1", receiveEvent(t, subs[eventbus.TradeTopic]).Trade.TID, "Trade should be published")
+
+	require.NoError(t, m.websocketDataHandler(exchName, []fill.Data{{Exchange: exchName, CurrencyPair: pair, AssetType: asset.Spot, TradeID: "2"}}), "websocketDataHandler must not error")
+	assert.Equal(t, "2", receiveEvent(t, subs[eventbus.FillTopic]).Fill.TradeID, "Fill should be published")
+
+	require.NoError(t, m.websocketDataHandler(exchName, []account.Change{{Exchange: exchName, Currency: currency.BTC, Asset: asset.Spot, Amount: 3}}), "websocketDataHandler must not error")
+	assert.Equal(t, 3.0, receiveEvent(t, subs[eventbus.AccountTopic]).Account.Amount, "Account change should be published")
+}
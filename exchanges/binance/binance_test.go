@@ -1349,6 +1349,20 @@ func TestGetFee(t *testing.T) {
 	}
 }
 
+func TestGetFeeSchedule(t *testing.T) {
+	t.Parallel()
+	for _, a := range []asset.Item{asset.Spot, asset.Margin, asset.USDTMarginedFutures} {
+		s, err := b.GetFeeSchedule(context.Background(), a)
+		require.NoError(t, err, "GetFeeSchedule must not error")
+		assert.NoError(t, s.Validate(), "fee schedule should be valid")
+		assert.Equal(t, a, s.Asset, "Asset should match")
+		require.NotNil(t, s.TokenDiscount, "TokenDiscount must be set")
+		assert.True(t, s.TokenDiscount.Currency.Equal(currency.BNB), "TokenDiscount currency should be BNB")
+	}
+	_, err := b.GetFeeSchedule(context.Background(), asset.CoinMarginedFutures)
+	assert.ErrorIs(t, err, asset.ErrNotSupported, "GetFeeSchedule should error on an unsupported asset")
+}
+
 func TestFormatWithdrawPermissions(t *testing.T) {
 	t.Parallel()
 
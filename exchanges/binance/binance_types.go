@@ -7,6 +7,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/feeschedule"
 	"github.com/thrasher-corp/gocryptotrader/types"
 )
 
@@ -534,6 +535,38 @@ type KlinesRequestParams struct {
 	EndTime   time.Time
 }
 
+// spotFeeTiers are the published spot and margin VIP tiers by trailing
+// thirty day volume
+// Prone to change
+var spotFeeTiers = []feeschedule.Tier{
+	{Name: "VIP0", MinimumVolume: 0, Maker: 0.001, Taker: 0.001},
+	{Name: "VIP1", MinimumVolume: 1_000_000, Maker: 0.0009, Taker: 0.001},
+	{Name: "VIP2", MinimumVolume: 5_000_000, Maker: 0.0008, Taker: 0.001},
+	{Name: "VIP3", MinimumVolume: 20_000_000, Maker: 0.00042, Taker: 0.0006},
+	{Name: "VIP4", MinimumVolume: 100_000_000, Maker: 0.00042, Taker: 0.00054},
+	{Name: "VIP5", MinimumVolume: 150_000_000, Maker: 0.00036, Taker: 0.00048},
+	{Name: "VIP6", MinimumVolume: 400_000_000, Maker: 0.0003, Taker: 0.00042},
+	{Name: "VIP7", MinimumVolume: 800_000_000, Maker: 0.00024, Taker: 0.00036},
+	{Name: "VIP8", MinimumVolume: 2_000_000_000, Maker: 0.00018, Taker: 0.0003},
+	{Name: "VIP9", MinimumVolume: 4_000_000_000, Maker: 0.00012, Taker: 0.00024},
+}
+
+// usdtFuturesFeeTiers are the published USDT margined futures VIP tiers by
+// trailing thirty day volume
+// Prone to change
+var usdtFuturesFeeTiers = []feeschedule.Tier{
+	{Name: "VIP0", MinimumVolume: 0, Maker: 0.0002, Taker: 0.0005},
+	{Name: "VIP1", MinimumVolume: 15_000_000, Maker: 0.00016, Taker: 0.0004},
+	{Name: "VIP2", MinimumVolume: 50_000_000, Maker: 0.00014, Taker: 0.00035},
+	{Name: "VIP3", MinimumVolume: 100_000_000, Maker: 0.00012, Taker: 0.00032},
+	{Name: "VIP4", MinimumVolume: 600_000_000, Maker: 0.0001, Taker: 0.0003},
+	{Name: "VIP5", MinimumVolume: 1_000_000_000, Maker: 0.00008, Taker: 0.00027},
+	{Name: "VIP6", MinimumVolume: 2_500_000_000, Maker: 0.00006, Taker: 0.00025},
+	{Name: "VIP7", MinimumVolume: 5_000_000_000, Maker: 0.00004, Taker: 0.00022},
+	{Name: "VIP8", MinimumVolume: 12_500_000_000, Maker: 0.00002, Taker: 0.0002},
+	{Name: "VIP9", MinimumVolume: 25_000_000_000, Maker: 0, Taker: 0.00017},
+}
+
 // WithdrawalFees the large list of predefined withdrawal fees
 // Prone to change
 var WithdrawalFees = map[currency.Code]float64{
@@ -19,6 +19,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/feeschedule"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
@@ -1350,6 +1351,28 @@ func (b *Binance) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuil
 	return b.GetFee(ctx, feeBuilder)
 }
 
+// GetFeeSchedule returns Binance's published VIP fee tiers for the asset
+// type. Spot tiers are discounted when fees are paid in BNB
+func (b *Binance) GetFeeSchedule(_ context.Context, a asset.Item) (*feeschedule.Schedule, error) {
+	s := &feeschedule.Schedule{
+		Exchange:       b.Name,
+		Asset:          a,
+		VolumeCurrency: currency.USDT,
+		VolumeWindow:   feeschedule.DefaultVolumeWindow,
+	}
+	switch a {
+	case asset.Spot, asset.Margin:
+		s.Tiers = spotFeeTiers
+		s.TokenDiscount = &feeschedule.TokenDiscount{Currency: currency.BNB, Rate: 0.25}
+	case asset.USDTMarginedFutures:
+		s.Tiers = usdtFuturesFeeTiers
+		s.TokenDiscount = &feeschedule.TokenDiscount{Currency: currency.BNB, Rate: 0.1}
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return s, nil
+}
+
 // GetActiveOrders retrieves any orders that are active/open
 func (b *Binance) GetActiveOrders(ctx context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
 	err := req.Validate()
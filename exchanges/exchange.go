@@ -21,10 +21,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/feeschedule"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
@@ -1841,6 +1843,17 @@ func (b *Base) GetOpenInterest(context.Context, ...key.PairAsset) ([]futures.Ope
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFeeSchedule returns the volume tiered trading fee schedule of the
+// account for the asset type
+func (b *Base) GetFeeSchedule(context.Context, asset.Item) (*feeschedule.Schedule, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetOptionContracts returns the option chain listed for an underlying
+func (b *Base) GetOptionContracts(context.Context, *options.ChainRequest) ([]options.Instrument, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
 // ParallelChanOp performs a single method call in parallel across streams and waits to return any errors
 func (b *Base) ParallelChanOp(channels []subscription.Subscription, m func([]subscription.Subscription) error, batchSize int) error {
 	wg := sync.WaitGroup{}
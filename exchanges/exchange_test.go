@@ -18,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
@@ -3330,3 +3331,17 @@ func TestParallelChanOp(t *testing.T) {
 	assert.EventuallyWithT(t, f, 500*time.Millisecond, 50*time.Millisecond, "ParallelChanOp should complete within 500ms not 5*300ms")
 	assert.Len(t, run, len(c), "Every channel was run to completion")
 }
+
+func TestGetFeeSchedule(t *testing.T) {
+	t.Parallel()
+	var b Base
+	_, err := b.GetFeeSchedule(context.Background(), asset.Spot)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "GetFeeSchedule should error when not supported")
+}
+
+func TestGetOptionContracts(t *testing.T) {
+	t.Parallel()
+	var b Base
+	_, err := b.GetOptionContracts(context.Background(), &options.ChainRequest{Asset: asset.Options})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "GetOptionContracts should error when not supported")
+}
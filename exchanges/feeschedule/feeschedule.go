@@ -0,0 +1,191 @@
+package feeschedule
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+// Validate checks that the schedule's tiers are in ascending order of
+// minimum volume, start at zero volume and have valid rates
+func (s *Schedule) Validate() error {
+	if s == nil {
+		return ErrNilSchedule
+	}
+	if len(s.Tiers) == 0 {
+		return ErrNoTiers
+	}
+	if s.VolumeCurrency.IsEmpty() {
+		return errVolumeCurrencyUnset
+	}
+	if s.VolumeWindow < 0 {
+		return errNegativeVolumeWindow
+	}
+	if s.Tiers[0].MinimumVolume != 0 {
+		return errFirstTierMinimum
+	}
+	for i := range s.Tiers {
+		if i > 0 && s.Tiers[i].MinimumVolume <= s.Tiers[i-1].MinimumVolume {
+			return fmt.Errorf("%w: tier %d", errTiersNotAscending, i)
+		}
+		if s.Tiers[i].Taker < 0 {
+			return fmt.Errorf("%w: tier %d", errInvalidTakerRate, i)
+		}
+		if s.Tiers[i].Maker > s.Tiers[i].Taker {
+			return fmt.Errorf("%w: tier %d", errMakerExceedsTaker, i)
+		}
+	}
+	if s.TokenDiscount != nil {
+		if s.TokenDiscount.Currency.IsEmpty() {
+			return errDiscountCurrencyUnset
+		}
+		if s.TokenDiscount.Rate < 0 || s.TokenDiscount.Rate >= 1 {
+			return errInvalidDiscountRate
+		}
+	}
+	return nil
+}
+
+// Window returns the trailing period volume is accumulated over
+func (s *Schedule) Window() time.Duration {
+	if s == nil || s.VolumeWindow == 0 {
+		return DefaultVolumeWindow
+	}
+	return s.VolumeWindow
+}
+
+// GetTier returns the tier which applies to the trailing volume and its index
+func (s *Schedule) GetTier(volume float64) (Tier, int, error) {
+	if s == nil {
+		return Tier{}, 0, ErrNilSchedule
+	}
+	if len(s.Tiers) == 0 {
+		return Tier{}, 0, ErrNoTiers
+	}
+	i := sort.Search(len(s.Tiers), func(i int) bool {
+		return s.Tiers[i].MinimumVolume > volume
+	}) - 1
+	if i < 0 {
+		i = 0
+	}
+	return s.Tiers[i], i, nil
+}
+
+// NextTier returns the tier after the one which applies to the trailing volume
+// and the additional volume needed to reach it. False is returned when the
+// volume is already in the highest tier
+func (s *Schedule) NextTier(volume float64) (next Tier, remaining float64, ok bool, err error) {
+	_, i, err := s.GetTier(volume)
+	if err != nil {
+		return Tier{}, 0, false, err
+	}
+	if i == len(s.Tiers)-1 {
+		return Tier{}, 0, false, nil
+	}
+	return s.Tiers[i+1], s.Tiers[i+1].MinimumVolume - volume, true, nil
+}
+
+// Rate returns the maker or taker rate which applies to the trailing volume.
+// The token discount reduces fees when useToken is set, rebates are not
+// affected
+func (s *Schedule) Rate(volume float64, isMaker, useToken bool) (float64, error) {
+	tier, _, err := s.GetTier(volume)
+	if err != nil {
+		return 0, err
+	}
+	rate := tier.Taker
+	if isMaker {
+		rate = tier.Maker
+	}
+	if useToken && s.TokenDiscount != nil && rate > 0 {
+		rate *= 1 - s.TokenDiscount.Rate
+	}
+	return rate, nil
+}
+
+// Calculate returns the fee of trading the amount at the price given the
+// trailing volume. A negative fee is a rebate
+func (s *Schedule) Calculate(volume, price, amount float64, isMaker, useToken bool) (float64, error) {
+	rate, err := s.Rate(volume, isMaker, useToken)
+	if err != nil {
+		return 0, err
+	}
+	return rate * price * amount, nil
+}
+
+// NewTracker returns a tracker which accumulates volume over the trailing
+// window, defaulting to thirty days
+func NewTracker(window time.Duration) *Tracker {
+	if window <= 0 {
+		window = DefaultVolumeWindow
+	}
+	return &Tracker{window: window}
+}
+
+// Add records volume traded at a point in time. Volume must be measured in
+// the schedule's volume currency
+func (t *Tracker) Add(at time.Time, volume float64) error {
+	if t == nil {
+		return fmt.Errorf("%T %w", t, common.ErrNilPointer)
+	}
+	if at.IsZero() {
+		return errTimestampUnset
+	}
+	if volume <= 0 {
+		return errInvalidVolume
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	i := sort.Search(len(t.entries), func(i int) bool {
+		return t.entries[i].time.After(at)
+	})
+	t.entries = append(t.entries, volumeEntry{})
+	copy(t.entries[i+1:], t.entries[i:])
+	t.entries[i] = volumeEntry{time: at, volume: volume}
+	return nil
+}
+
+// Volume returns the volume traded in the window ending at the time
+func (t *Tracker) Volume(at time.Time) float64 {
+	if t == nil {
+		return 0
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	start := at.Add(-t.window)
+	var volume float64
+	for i := range t.entries {
+		if !t.entries[i].time.After(start) || t.entries[i].time.After(at) {
+			continue
+		}
+		volume += t.entries[i].volume
+	}
+	return volume
+}
+
+// Prune discards volume which falls outside every window ending at or after
+// the time
+func (t *Tracker) Prune(at time.Time) {
+	if t == nil {
+		return
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	start := at.Add(-t.window)
+	i := sort.Search(len(t.entries), func(i int) bool {
+		return t.entries[i].time.After(start)
+	})
+	t.entries = t.entries[i:]
+}
+
+// PredictTier returns the tier the schedule applies to the volume traded in
+// the window ending at the time
+func (t *Tracker) PredictTier(s *Schedule, at time.Time) (Tier, error) {
+	if t == nil {
+		return Tier{}, fmt.Errorf("%T %w", t, common.ErrNilPointer)
+	}
+	tier, _, err := s.GetTier(t.Volume(at))
+	return tier, err
+}
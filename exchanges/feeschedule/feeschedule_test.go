@@ -0,0 +1,195 @@
+package feeschedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func testSchedule() *Schedule {
+	return &Schedule{
+		Exchange:       "test",
+		Asset:          asset.Spot,
+		VolumeCurrency: currency.USDT,
+		Tiers: []Tier{
+			{Name: "VIP0", Maker: 0.001, Taker: 0.001},
+			{Name: "VIP1", MinimumVolume: 1000, Maker: 0.0008, Taker: 0.0009},
+			{Name: "VIP2", MinimumVolume: 5000, Maker: -0.0001, Taker: 0.0005},
+		},
+		TokenDiscount: &TokenDiscount{Currency: currency.BNB, Rate: 0.25},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	var s *Schedule
+	assert.ErrorIs(t, s.Validate(), ErrNilSchedule)
+	assert.ErrorIs(t, (&Schedule{}).Validate(), ErrNoTiers)
+	s = testSchedule()
+	assert.NoError(t, s.Validate())
+
+	s.VolumeCurrency = currency.EMPTYCODE
+	assert.ErrorIs(t, s.Validate(), errVolumeCurrencyUnset)
+	s = testSchedule()
+	s.VolumeWindow = -1
+	assert.ErrorIs(t, s.Validate(), errNegativeVolumeWindow)
+	s = testSchedule()
+	s.Tiers[0].MinimumVolume = 1
+	assert.ErrorIs(t, s.Validate(), errFirstTierMinimum)
+	s = testSchedule()
+	s.Tiers[2].MinimumVolume = 1000
+	assert.ErrorIs(t, s.Validate(), errTiersNotAscending)
+	s = testSchedule()
+	s.Tiers[1].Taker = -1
+	assert.ErrorIs(t, s.Validate(), errInvalidTakerRate)
+	s = testSchedule()
+	s.Tiers[1].Maker = 0.01
+	assert.ErrorIs(t, s.Validate(), errMakerExceedsTaker)
+	s = testSchedule()
+	s.TokenDiscount.Currency = currency.EMPTYCODE
+	assert.ErrorIs(t, s.Validate(), errDiscountCurrencyUnset)
+	s = testSchedule()
+	s.TokenDiscount.Rate = 1
+	assert.ErrorIs(t, s.Validate(), errInvalidDiscountRate)
+}
+
+func TestWindow(t *testing.T) {
+	t.Parallel()
+	var s *Schedule
+	assert.Equal(t, DefaultVolumeWindow, s.Window())
+	s = testSchedule()
+	assert.Equal(t, DefaultVolumeWindow, s.Window())
+	s.VolumeWindow = time.Hour
+	assert.Equal(t, time.Hour, s.Window())
+}
+
+func TestGetTier(t *testing.T) {
+	t.Parallel()
+	var s *Schedule
+	_, _, err := s.GetTier(0)
+	assert.ErrorIs(t, err, ErrNilSchedule)
+	_, _, err = (&Schedule{}).GetTier(0)
+	assert.ErrorIs(t, err, ErrNoTiers)
+
+	s = testSchedule()
+	for _, tc := range []struct {
+		volume float64
+		name   string
+		index  int
+	}{
+		{-1, "VIP0", 0},
+		{0, "VIP0", 0},
+		{999, "VIP0", 0},
+		{1000, "VIP1", 1},
+		{4999.99, "VIP1", 1},
+		{1e9, "VIP2", 2},
+	} {
+		tier, i, err := s.GetTier(tc.volume)
+		require.NoError(t, err)
+		assert.Equal(t, tc.name, tier.Name, "volume %v", tc.volume)
+		assert.Equal(t, tc.index, i, "volume %v", tc.volume)
+	}
+}
+
+func TestNextTier(t *testing.T) {
+	t.Parallel()
+	var s *Schedule
+	_, _, _, err := s.NextTier(0)
+	assert.ErrorIs(t, err, ErrNilSchedule)
+
+	s = testSchedule()
+	next, remaining, ok, err := s.NextTier(250)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "VIP1", next.Name)
+	assert.Equal(t, 750.0, remaining)
+
+	_, _, ok, err = s.NextTier(5000)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestRate(t *testing.T) {
+	t.Parallel()
+	var s *Schedule
+	_, err := s.Rate(0, false, false)
+	assert.ErrorIs(t, err, ErrNilSchedule)
+
+	s = testSchedule()
+	rate, err := s.Rate(0, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0.001, rate)
+
+	rate, err = s.Rate(0, false, true)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.00075, rate, 1e-12, "token discount should reduce the rate")
+
+	rate, err = s.Rate(5000, true, true)
+	require.NoError(t, err)
+	assert.Equal(t, -0.0001, rate, "token discount should not reduce a rebate")
+
+	s.TokenDiscount = nil
+	rate, err = s.Rate(1000, true, true)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0008, rate)
+}
+
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+	var s *Schedule
+	_, err := s.Calculate(0, 1, 1, false, false)
+	assert.ErrorIs(t, err, ErrNilSchedule)
+
+	s = testSchedule()
+	f, err := s.Calculate(0, 100, 2, false, false)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.2, f, 1e-12)
+
+	f, err = s.Calculate(5000, 100, 2, true, false)
+	require.NoError(t, err)
+	assert.InDelta(t, -0.02, f, 1e-12, "maker rebate should be negative")
+}
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+	var tr *Tracker
+	assert.ErrorIs(t, tr.Add(time.Now(), 1), common.ErrNilPointer)
+	assert.Zero(t, tr.Volume(time.Now()))
+	tr.Prune(time.Now())
+	_, err := tr.PredictTier(testSchedule(), time.Now())
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	tr = NewTracker(0)
+	assert.Equal(t, DefaultVolumeWindow, tr.window)
+	tr = NewTracker(time.Hour)
+	assert.ErrorIs(t, tr.Add(time.Time{}, 1), errTimestampUnset)
+	assert.ErrorIs(t, tr.Add(time.Now(), 0), errInvalidVolume)
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, tr.Add(start.Add(30*time.Minute), 600))
+	require.NoError(t, tr.Add(start, 500))
+	require.NoError(t, tr.Add(start.Add(45*time.Minute), 4000))
+	assert.Equal(t, start, tr.entries[0].time, "entries should be sorted by time")
+
+	assert.Equal(t, 500.0, tr.Volume(start))
+	assert.Equal(t, 1100.0, tr.Volume(start.Add(30*time.Minute)))
+	assert.Equal(t, 5100.0, tr.Volume(start.Add(59*time.Minute)))
+	assert.Equal(t, 4600.0, tr.Volume(start.Add(time.Hour)), "volume at the window start should be excluded")
+
+	tier, err := tr.PredictTier(testSchedule(), start.Add(59*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, "VIP2", tier.Name)
+	tier, err = tr.PredictTier(testSchedule(), start.Add(time.Hour+31*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, "VIP1", tier.Name, "tier should fall as volume leaves the window")
+	_, err = tr.PredictTier(nil, start)
+	assert.ErrorIs(t, err, ErrNilSchedule)
+
+	tr.Prune(start.Add(time.Hour + 30*time.Minute))
+	assert.Len(t, tr.entries, 1, "Prune should discard volume outside the window")
+}
@@ -0,0 +1,75 @@
+package feeschedule
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// DefaultVolumeWindow is the trailing period volume is accumulated over when
+// a schedule does not define one
+const DefaultVolumeWindow = 30 * 24 * time.Hour
+
+var (
+	// ErrNilSchedule is returned when a nil schedule is used
+	ErrNilSchedule = errors.New("fee schedule is nil")
+	// ErrNoTiers is returned when a schedule has no fee tiers
+	ErrNoTiers = errors.New("fee schedule has no tiers")
+
+	errTiersNotAscending     = errors.New("fee tiers must be in ascending order of minimum volume")
+	errFirstTierMinimum      = errors.New("first fee tier must have a minimum volume of zero")
+	errInvalidTakerRate      = errors.New("taker rate must not be negative")
+	errMakerExceedsTaker     = errors.New("maker rate must not exceed taker rate")
+	errInvalidDiscountRate   = errors.New("token discount rate must be between zero and one")
+	errDiscountCurrencyUnset = errors.New("token discount currency must be set")
+	errVolumeCurrencyUnset   = errors.New("fee schedule volume currency must be set")
+	errInvalidVolume         = errors.New("volume must be greater than zero")
+	errTimestampUnset        = errors.New("timestamp must be set")
+	errNegativeVolumeWindow  = errors.New("volume window must not be negative")
+)
+
+// Tier is a fee level which applies once trailing trade volume reaches its
+// minimum volume. Rates are fractions of the traded notional, a negative
+// maker rate is a rebate paid to the account
+type Tier struct {
+	Name          string  `json:"name"`
+	MinimumVolume float64 `json:"minimumVolume"`
+	Maker         float64 `json:"maker"`
+	Taker         float64 `json:"taker"`
+}
+
+// TokenDiscount reduces fees when they are paid in an exchange token, such as
+// BNB on Binance. Rate is the fraction taken off fees e.g. 0.25 for 25%
+type TokenDiscount struct {
+	Currency currency.Code `json:"currency"`
+	Rate     float64       `json:"rate"`
+}
+
+// Schedule is the trading fee structure of an exchange account for an asset
+// type. Tiers are selected by trailing trade volume measured in the volume
+// currency over the volume window
+type Schedule struct {
+	Exchange       string         `json:"exchange"`
+	Asset          asset.Item     `json:"asset"`
+	VolumeCurrency currency.Code  `json:"volumeCurrency"`
+	VolumeWindow   time.Duration  `json:"volumeWindow"`
+	Tiers          []Tier         `json:"tiers"`
+	TokenDiscount  *TokenDiscount `json:"tokenDiscount,omitempty"`
+}
+
+// Tracker accumulates trade volume to predict an account's current fee tier
+// from its trailing volume
+type Tracker struct {
+	m       sync.Mutex
+	window  time.Duration
+	entries []volumeEntry
+}
+
+// volumeEntry is the notional traded at a point in time
+type volumeEntry struct {
+	time   time.Time
+	volume float64
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
@@ -3698,6 +3699,36 @@ func TestGetFuturesContractDetails(t *testing.T) {
 	}
 }
 
+func TestGetOptionContracts(t *testing.T) {
+	t.Parallel()
+	_, err := ok.GetOptionContracts(context.Background(), nil)
+	if !errors.Is(err, common.ErrNilPointer) {
+		t.Error(err)
+	}
+	_, err = ok.GetOptionContracts(context.Background(), &options.ChainRequest{Asset: asset.Spot})
+	if !errors.Is(err, options.ErrNotOptionsAsset) {
+		t.Error(err)
+	}
+	chain, err := ok.GetOptionContracts(context.Background(), &options.ChainRequest{
+		Asset:      asset.Options,
+		Underlying: currency.NewPair(currency.BTC, currency.USD),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatal(err)
+	}
+	if len(chain) == 0 {
+		t.Fatal("expected option contracts")
+	}
+	_, err = ok.GetOptionContracts(context.Background(), &options.ChainRequest{
+		Asset:      asset.Options,
+		Underlying: currency.NewPair(currency.BTC, currency.USD),
+		Expiry:     chain[0].Expiry,
+	})
+	if !errors.Is(err, nil) {
+		t.Error(err)
+	}
+}
+
 func TestWsProcessOrderbook5(t *testing.T) {
 	t.Parallel()
 
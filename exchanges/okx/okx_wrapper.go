@@ -24,6 +24,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -2208,6 +2209,81 @@ func (ok *Okx) GetFuturesContractDetails(ctx context.Context, item asset.Item) (
 	return resp, nil
 }
 
+// GetOptionContracts returns the option chain listed for an underlying
+func (ok *Okx) GetOptionContracts(ctx context.Context, r *options.ChainRequest) ([]options.Instrument, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w options.ChainRequest", common.ErrNilPointer)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if !ok.SupportsAsset(r.Asset) {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, r.Asset)
+	}
+	uly := r.Underlying.Format(currency.PairFormat{Delimiter: currency.DashDelimiter, Uppercase: true}).String()
+	insts, err := ok.GetInstruments(ctx, &InstrumentsFetchParams{
+		InstrumentType: okxInstTypeOption,
+		Underlying:     uly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	marketData, err := ok.GetOptionMarketData(ctx, uly, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	forwardPrices := make(map[string]float64, len(marketData))
+	for i := range marketData {
+		if marketData[i].ForwardPrice == "" {
+			continue
+		}
+		forwardPrices[marketData[i].InstrumentID], err = strconv.ParseFloat(marketData[i].ForwardPrice, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	expYear, expMonth, expDay := r.Expiry.UTC().Date()
+	resp := make([]options.Instrument, 0, len(insts))
+	for i := range insts {
+		if !r.Expiry.IsZero() {
+			year, month, day := insts[i].ExpTime.Time.UTC().Date()
+			if year != expYear || month != expMonth || day != expDay {
+				continue
+			}
+		}
+		var cp currency.Pair
+		cp, err = currency.NewPairDelimiter(insts[i].InstrumentID, ok.CurrencyPairs.ConfigFormat.Delimiter)
+		if err != nil {
+			return nil, err
+		}
+		var optType options.Type
+		optType, err = options.StringToType(insts[i].OptionType)
+		if err != nil {
+			return nil, err
+		}
+		var strike float64
+		strike, err = strconv.ParseFloat(insts[i].StrikePrice, 64)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, options.Instrument{
+			Exchange:            ok.Name,
+			Asset:               r.Asset,
+			Pair:                cp,
+			Underlying:          r.Underlying,
+			Strike:              strike,
+			Expiry:              insts[i].ExpTime.Time,
+			Type:                optType,
+			ContractSize:        insts[i].ContractValue.Float64(),
+			SettlementCurrency:  currency.NewCode(insts[i].SettlementCurrency),
+			PremiumInUnderlying: insts[i].SettlementCurrency == r.Underlying.Base.String(),
+			ForwardPrice:        forwardPrices[insts[i].InstrumentID],
+		})
+	}
+	options.SortChain(resp)
+	return resp, nil
+}
+
 // GetOpenInterest returns the open interest rate for a given asset pair
 func (ok *Okx) GetOpenInterest(ctx context.Context, k ...key.PairAsset) ([]futures.OpenInterest, error) {
 	for i := range k {
@@ -0,0 +1,221 @@
+package options
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// String implements the stringer interface
+func (t Type) String() string {
+	switch t {
+	case Call:
+		return "call"
+	case Put:
+		return "put"
+	default:
+		return "unknown"
+	}
+}
+
+// StringToType returns the option type matching the string, accepting the
+// single letter abbreviations used by exchanges
+func StringToType(s string) (Type, error) {
+	switch strings.ToLower(s) {
+	case "call", "c":
+		return Call, nil
+	case "put", "p":
+		return Put, nil
+	}
+	return UnknownType, fmt.Errorf("%w %q", errUnknownOptionType, s)
+}
+
+// String implements the stringer interface
+func (m Model) String() string {
+	switch m {
+	case BlackScholes:
+		return "black-scholes"
+	case Black76:
+		return "black-76"
+	default:
+		return "unknown"
+	}
+}
+
+// StringToModel returns the pricing model matching the string, ignoring case
+// and separators
+func StringToModel(s string) (Model, error) {
+	normalised := strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(s))
+	for _, m := range []Model{BlackScholes, Black76} {
+		if strings.ReplaceAll(m.String(), "-", "") == normalised {
+			return m, nil
+		}
+	}
+	return UnknownModel, fmt.Errorf("%w %q", errUnknownModel, s)
+}
+
+// Validate checks the request has an options asset and underlying pair
+func (r *ChainRequest) Validate() error {
+	if r.Asset != asset.Options {
+		return fmt.Errorf("%w %v", ErrNotOptionsAsset, r.Asset)
+	}
+	if r.Underlying.IsEmpty() {
+		return errUnderlyingPairEmpty
+	}
+	return nil
+}
+
+// TimeToExpiry returns the years remaining until the instrument expires
+func (i *Instrument) TimeToExpiry(now time.Time) (float64, error) {
+	if !i.Expiry.After(now) {
+		return 0, fmt.Errorf("%w %v", errOptionExpired, i.Expiry)
+	}
+	return i.Expiry.Sub(now).Hours() / 24 / daysPerYear, nil
+}
+
+// SortChain orders instruments by expiry, strike and then calls before puts
+func SortChain(instruments []Instrument) {
+	sort.Slice(instruments, func(i, j int) bool {
+		if !instruments[i].Expiry.Equal(instruments[j].Expiry) {
+			return instruments[i].Expiry.Before(instruments[j].Expiry)
+		}
+		if instruments[i].Strike != instruments[j].Strike {
+			return instruments[i].Strike < instruments[j].Strike
+		}
+		return instruments[i].Type < instruments[j].Type
+	})
+}
+
+// validate checks the pricing input can be priced for any volatility
+func (p *PricingInput) validate() error {
+	if p.Model != BlackScholes && p.Model != Black76 {
+		return fmt.Errorf("%w %v", errUnknownModel, p.Model)
+	}
+	if p.Type != Call && p.Type != Put {
+		return fmt.Errorf("%w %v", errUnknownOptionType, p.Type)
+	}
+	if p.Underlying <= 0 {
+		return errInvalidUnderlying
+	}
+	if p.Strike <= 0 {
+		return errInvalidStrike
+	}
+	if p.TimeToExpiry <= 0 {
+		return errOptionExpired
+	}
+	return nil
+}
+
+// Price returns the theoretical price of an option
+func Price(p *PricingInput) (float64, error) {
+	g, err := CalculateGreeks(p)
+	if err != nil {
+		return 0, err
+	}
+	return g.Price, nil
+}
+
+// CalculateGreeks returns the theoretical price and greeks of an option
+func CalculateGreeks(p *PricingInput) (*Greeks, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if p.Volatility <= 0 {
+		return nil, errInvalidVolatility
+	}
+	sqrtT := math.Sqrt(p.TimeToExpiry)
+	volT := p.Volatility * sqrtT
+	discount := math.Exp(-p.RiskFreeRate * p.TimeToExpiry)
+	var d1 float64
+	if p.Model == BlackScholes {
+		d1 = (math.Log(p.Underlying/p.Strike) + (p.RiskFreeRate+p.Volatility*p.Volatility/2)*p.TimeToExpiry) / volT
+	} else {
+		d1 = (math.Log(p.Underlying/p.Strike) + p.Volatility*p.Volatility/2*p.TimeToExpiry) / volT
+	}
+	d2 := d1 - volT
+	pdf := normalPDF(d1)
+	g := &Greeks{}
+	if p.Model == BlackScholes {
+		g.Gamma = pdf / (p.Underlying * volT)
+		g.Vega = p.Underlying * pdf * sqrtT
+		decay := -p.Underlying * pdf * p.Volatility / (2 * sqrtT)
+		if p.Type == Call {
+			g.Price = p.Underlying*normalCDF(d1) - p.Strike*discount*normalCDF(d2)
+			g.Delta = normalCDF(d1)
+			g.Theta = decay - p.RiskFreeRate*p.Strike*discount*normalCDF(d2)
+			g.Rho = p.Strike * p.TimeToExpiry * discount * normalCDF(d2)
+		} else {
+			g.Price = p.Strike*discount*normalCDF(-d2) - p.Underlying*normalCDF(-d1)
+			g.Delta = normalCDF(d1) - 1
+			g.Theta = decay + p.RiskFreeRate*p.Strike*discount*normalCDF(-d2)
+			g.Rho = -p.Strike * p.TimeToExpiry * discount * normalCDF(-d2)
+		}
+	} else {
+		g.Gamma = discount * pdf / (p.Underlying * volT)
+		g.Vega = p.Underlying * discount * pdf * sqrtT
+		decay := -p.Underlying * discount * pdf * p.Volatility / (2 * sqrtT)
+		if p.Type == Call {
+			g.Price = discount * (p.Underlying*normalCDF(d1) - p.Strike*normalCDF(d2))
+			g.Delta = discount * normalCDF(d1)
+			g.Theta = decay + p.RiskFreeRate*p.Underlying*discount*normalCDF(d1) - p.RiskFreeRate*p.Strike*discount*normalCDF(d2)
+		} else {
+			g.Price = discount * (p.Strike*normalCDF(-d2) - p.Underlying*normalCDF(-d1))
+			g.Delta = -discount * normalCDF(-d1)
+			g.Theta = decay - p.RiskFreeRate*p.Underlying*discount*normalCDF(-d1) + p.RiskFreeRate*p.Strike*discount*normalCDF(-d2)
+		}
+		// The forward price is held constant so rates only affect discounting
+		g.Rho = -p.TimeToExpiry * g.Price
+	}
+	g.Vega /= 100
+	g.Rho /= 100
+	g.Theta /= daysPerYear
+	return g, nil
+}
+
+// ImpliedVolatility returns the volatility at which the model prices the
+// option at the supplied price. The input's volatility is ignored
+func ImpliedVolatility(p *PricingInput, price float64) (float64, error) {
+	if err := p.validate(); err != nil {
+		return 0, err
+	}
+	if price <= 0 {
+		return 0, errInvalidPrice
+	}
+	in := *p
+	priceAt := func(vol float64) float64 {
+		in.Volatility = vol
+		g, _ := CalculateGreeks(&in) // Inputs are validated above
+		return g.Price
+	}
+	low, high := minimumVolatility, maximumVolatility
+	if price < priceAt(low) || price > priceAt(high) {
+		return 0, fmt.Errorf("%w %v", ErrNoImpliedVolatility, price)
+	}
+	// Price increases monotonically with volatility so bisection always
+	// converges
+	for i := 0; i < maximumIterations && high-low > volatilityTolerance; i++ {
+		mid := (low + high) / 2
+		if priceAt(mid) < price {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2, nil
+}
+
+// normalCDF is the cumulative distribution function of the standard normal
+// distribution
+func normalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+// normalPDF is the probability density function of the standard normal
+// distribution
+func normalPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
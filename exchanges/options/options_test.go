@@ -0,0 +1,140 @@
+package options
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestStringToType(t *testing.T) {
+	t.Parallel()
+	for s, expected := range map[string]Type{"call": Call, "C": Call, "Put": Put, "p": Put} {
+		typ, err := StringToType(s)
+		require.NoError(t, err, "StringToType must not error")
+		assert.Equalf(t, expected, typ, "StringToType should return the correct type for %q", s)
+	}
+	_, err := StringToType("straddle")
+	assert.ErrorIs(t, err, errUnknownOptionType, "StringToType should error on an unknown type")
+}
+
+func TestStringToModel(t *testing.T) {
+	t.Parallel()
+	for s, expected := range map[string]Model{"black-scholes": BlackScholes, "BlackScholes": BlackScholes, "black76": Black76, "Black_76": Black76} {
+		m, err := StringToModel(s)
+		require.NoError(t, err, "StringToModel must not error")
+		assert.Equalf(t, expected, m, "StringToModel should return the correct model for %q", s)
+	}
+	_, err := StringToModel("binomial")
+	assert.ErrorIs(t, err, errUnknownModel, "StringToModel should error on an unknown model")
+}
+
+func TestChainRequestValidate(t *testing.T) {
+	t.Parallel()
+	r := &ChainRequest{Asset: asset.Spot}
+	assert.ErrorIs(t, r.Validate(), ErrNotOptionsAsset, "Validate should error on a non options asset")
+	r.Asset = asset.Options
+	assert.ErrorIs(t, r.Validate(), errUnderlyingPairEmpty, "Validate should error without an underlying")
+	r.Underlying = currency.NewPair(currency.BTC, currency.USD)
+	assert.NoError(t, r.Validate(), "Validate should not error")
+}
+
+func TestTimeToExpiry(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	i := &Instrument{Expiry: now.AddDate(0, 0, 73)}
+	years, err := i.TimeToExpiry(now)
+	require.NoError(t, err, "TimeToExpiry must not error")
+	assert.InDelta(t, 0.2, years, 1e-12, "TimeToExpiry should return the years until expiry")
+	_, err = i.TimeToExpiry(i.Expiry)
+	assert.ErrorIs(t, err, errOptionExpired, "TimeToExpiry should error on an expired option")
+}
+
+func TestSortChain(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	chain := []Instrument{
+		{Strike: 200, Expiry: now, Type: Call},
+		{Strike: 100, Expiry: now.Add(time.Hour), Type: Call},
+		{Strike: 100, Expiry: now, Type: Put},
+		{Strike: 100, Expiry: now, Type: Call},
+	}
+	SortChain(chain)
+	assert.Equal(t, []Instrument{
+		{Strike: 100, Expiry: now, Type: Call},
+		{Strike: 100, Expiry: now, Type: Put},
+		{Strike: 200, Expiry: now, Type: Call},
+		{Strike: 100, Expiry: now.Add(time.Hour), Type: Call},
+	}, chain, "SortChain should order by expiry, strike and type")
+}
+
+func TestCalculateGreeks(t *testing.T) {
+	t.Parallel()
+	_, err := CalculateGreeks(&PricingInput{})
+	assert.ErrorIs(t, err, errUnknownModel, "CalculateGreeks should error on an unknown model")
+	_, err = CalculateGreeks(&PricingInput{Model: BlackScholes})
+	assert.ErrorIs(t, err, errUnknownOptionType, "CalculateGreeks should error on an unknown type")
+	_, err = CalculateGreeks(&PricingInput{Model: BlackScholes, Type: Call})
+	assert.ErrorIs(t, err, errInvalidUnderlying, "CalculateGreeks should error on an invalid underlying price")
+	_, err = CalculateGreeks(&PricingInput{Model: BlackScholes, Type: Call, Underlying: 100})
+	assert.ErrorIs(t, err, errInvalidStrike, "CalculateGreeks should error on an invalid strike")
+	_, err = CalculateGreeks(&PricingInput{Model: BlackScholes, Type: Call, Underlying: 100, Strike: 100})
+	assert.ErrorIs(t, err, errOptionExpired, "CalculateGreeks should error on an expired option")
+	_, err = CalculateGreeks(&PricingInput{Model: BlackScholes, Type: Call, Underlying: 100, Strike: 100, TimeToExpiry: 1})
+	assert.ErrorIs(t, err, errInvalidVolatility, "CalculateGreeks should error on an invalid volatility")
+
+	in := &PricingInput{Model: BlackScholes, Type: Call, Underlying: 100, Strike: 100, TimeToExpiry: 1, Volatility: 0.2, RiskFreeRate: 0.05}
+	g, err := CalculateGreeks(in)
+	require.NoError(t, err, "CalculateGreeks must not error")
+	assert.InDelta(t, 10.4506, g.Price, 1e-4, "Price should be correct")
+	assert.InDelta(t, 0.6368, g.Delta, 1e-4, "Delta should be correct")
+	assert.InDelta(t, 0.01876, g.Gamma, 1e-5, "Gamma should be correct")
+	assert.InDelta(t, 0.3752, g.Vega, 1e-4, "Vega should be correct")
+	assert.InDelta(t, -6.4140/365, g.Theta, 1e-5, "Theta should be correct")
+	assert.InDelta(t, 0.5323, g.Rho, 1e-4, "Rho should be correct")
+
+	in.Type = Put
+	p, err := CalculateGreeks(in)
+	require.NoError(t, err, "CalculateGreeks must not error")
+	assert.InDelta(t, 5.5735, p.Price, 1e-4, "Price should be correct")
+	assert.InDelta(t, g.Delta-1, p.Delta, 1e-12, "Delta should differ from the call by one")
+	assert.InDelta(t, g.Price-p.Price, in.Underlying-in.Strike*math.Exp(-in.RiskFreeRate), 1e-9, "Put call parity should hold")
+
+	in.Model = Black76
+	p, err = CalculateGreeks(in)
+	require.NoError(t, err, "CalculateGreeks must not error")
+	in.Type = Call
+	g, err = CalculateGreeks(in)
+	require.NoError(t, err, "CalculateGreeks must not error")
+	assert.InDelta(t, 7.5771, g.Price, 1e-4, "Price should be correct")
+	assert.InDelta(t, g.Price, p.Price, 1e-9, "At the money forward calls and puts should be priced equally")
+	assert.InDelta(t, g.Delta-p.Delta, math.Exp(-in.RiskFreeRate), 1e-9, "Deltas should differ by the discount factor")
+	assert.InDelta(t, -g.Price/100, g.Rho, 1e-12, "Rho should be correct")
+}
+
+func TestImpliedVolatility(t *testing.T) {
+	t.Parallel()
+	_, err := ImpliedVolatility(&PricingInput{}, 1)
+	assert.ErrorIs(t, err, errUnknownModel, "ImpliedVolatility should error on invalid input")
+	in := &PricingInput{Model: BlackScholes, Type: Put, Underlying: 100, Strike: 110, TimeToExpiry: 0.5, RiskFreeRate: 0.03}
+	_, err = ImpliedVolatility(in, 0)
+	assert.ErrorIs(t, err, errInvalidPrice, "ImpliedVolatility should error on an invalid price")
+	_, err = ImpliedVolatility(in, 1)
+	assert.ErrorIs(t, err, ErrNoImpliedVolatility, "ImpliedVolatility should error on a price below intrinsic value")
+	_, err = ImpliedVolatility(in, 200)
+	assert.ErrorIs(t, err, ErrNoImpliedVolatility, "ImpliedVolatility should error on a price above the strike")
+
+	for _, m := range []Model{BlackScholes, Black76} {
+		in.Model = m
+		in.Volatility = 0.65
+		price, err := Price(in)
+		require.NoError(t, err, "Price must not error")
+		vol, err := ImpliedVolatility(in, price)
+		require.NoErrorf(t, err, "ImpliedVolatility must not error for %s", m)
+		assert.InDeltaf(t, 0.65, vol, 1e-8, "ImpliedVolatility should recover the volatility for %s", m)
+	}
+}
@@ -0,0 +1,120 @@
+package options
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+const (
+	// daysPerYear is used to express time to expiry in years and theta per
+	// day
+	daysPerYear = 365
+	// minimumVolatility and maximumVolatility bound the implied volatility
+	// search
+	minimumVolatility = 1e-6
+	maximumVolatility = 10.0
+	// volatilityTolerance is the precision implied volatility is solved to
+	volatilityTolerance = 1e-10
+	// maximumIterations limits the implied volatility search
+	maximumIterations = 200
+)
+
+var (
+	// ErrNotOptionsAsset is returned when an option chain is requested for a
+	// non options asset
+	ErrNotOptionsAsset = errors.New("asset is not an options asset")
+	// ErrNoImpliedVolatility is returned when a price lies outside the range
+	// an option can be priced at for any volatility
+	ErrNoImpliedVolatility = errors.New("price is outside the bounds of any implied volatility")
+
+	errUnknownOptionType   = errors.New("unknown option type")
+	errUnknownModel        = errors.New("unknown pricing model")
+	errInvalidStrike       = errors.New("strike price must be greater than zero")
+	errInvalidUnderlying   = errors.New("underlying price must be greater than zero")
+	errInvalidVolatility   = errors.New("volatility must be greater than zero")
+	errInvalidPrice        = errors.New("option price must be greater than zero")
+	errOptionExpired       = errors.New("option has expired")
+	errUnderlyingPairEmpty = errors.New("underlying pair must be set")
+)
+
+// Type is the right an option grants its holder
+type Type uint8
+
+// Option types
+const (
+	UnknownType Type = iota
+	// Call grants the right to buy the underlying at the strike price
+	Call
+	// Put grants the right to sell the underlying at the strike price
+	Put
+)
+
+// Model is the model used to price an option
+type Model uint8
+
+// Pricing models
+const (
+	UnknownModel Model = iota
+	// BlackScholes prices European options from the spot price of the
+	// underlying
+	BlackScholes
+	// Black76 prices European options from the forward price of the
+	// underlying at expiry
+	Black76
+)
+
+// Instrument is a European option contract on an exchange
+type Instrument struct {
+	Exchange   string
+	Asset      asset.Item
+	Pair       currency.Pair
+	Underlying currency.Pair
+	Strike     float64
+	Expiry     time.Time
+	Type       Type
+	// ContractSize is the quantity of the underlying each contract covers
+	ContractSize       float64
+	SettlementCurrency currency.Code
+	// PremiumInUnderlying is set when the option is priced in units of the
+	// underlying's base currency rather than its quote currency
+	PremiumInUnderlying bool
+	// ForwardPrice is the exchange's forward price of the underlying at
+	// expiry, it is zero when the exchange does not provide one
+	ForwardPrice float64
+}
+
+// ChainRequest defines the option chain to retrieve
+type ChainRequest struct {
+	Asset      asset.Item
+	Underlying currency.Pair
+	// Expiry limits the chain to options expiring on the same day, all
+	// expiries are returned when it is zero
+	Expiry time.Time
+}
+
+// PricingInput holds the parameters required to price an option. Underlying
+// is the spot price for Black-Scholes and the forward price for Black-76.
+// TimeToExpiry is in years and rates and volatility are annualised fractions
+type PricingInput struct {
+	Model        Model
+	Type         Type
+	Underlying   float64
+	Strike       float64
+	TimeToExpiry float64
+	Volatility   float64
+	RiskFreeRate float64
+}
+
+// Greeks are an option's price and its sensitivities. Vega and Rho are per
+// one percentage point change in volatility and rates, Theta is per day
+type Greeks struct {
+	Price float64
+	Delta float64
+	Gamma float64
+	Vega  float64
+	Theta float64
+	Rho   float64
+}
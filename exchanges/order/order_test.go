@@ -873,6 +873,9 @@ func TestStringToOrderType(t *testing.T) {
 		{"TRAILING_STOP", TrailingStop, nil},
 		{"tRaIlInG_sToP", TrailingStop, nil},
 		{"tRaIlInG sToP", TrailingStop, nil},
+		{"take profit", TakeProfit, nil},
+		{"TAKE_PROFIT", TakeProfit, nil},
+		{"take profit market", TakeProfitMarket, nil},
 		{"fOk", FillOrKill, nil},
 		{"exchange fOk", FillOrKill, nil},
 		{"ios", IOS, nil},
@@ -1099,6 +1099,10 @@ func StringToOrderType(oType string) (Type, error) {
 		return StopMarket, nil
 	case TrailingStop.String(), "TRAILING STOP", "EXCHANGE TRAILING STOP":
 		return TrailingStop, nil
+	case TakeProfit.String(), "TAKE_PROFIT":
+		return TakeProfit, nil
+	case TakeProfitMarket.String(), "TAKE_PROFIT_MARKET":
+		return TakeProfitMarket, nil
 	case FillOrKill.String(), "EXCHANGE FOK":
 		return FillOrKill, nil
 	case IOS.String():
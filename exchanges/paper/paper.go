@@ -0,0 +1,934 @@
+package paper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// New wraps an exchange with a paper trading simulator. The match interval
+// defines how often resting limit orders are checked against the orderbook.
+func New(exch exchange.IBotExchange, matchInterval time.Duration) (*Exchange, error) {
+	if exch == nil {
+		return nil, fmt.Errorf("%w IBotExchange", common.ErrNilPointer)
+	}
+	if matchInterval <= 0 {
+		matchInterval = DefaultMatchInterval
+	}
+	return &Exchange{
+		IBotExchange:  exch,
+		matchInterval: matchInterval,
+		orders:        make(map[string]*order.Detail),
+		holdings:      make(map[asset.Item]map[*currency.Item]*balance),
+		reserved:      make(map[string]float64),
+		liquidity:     make(map[key.PairAsset]*bookLiquidity),
+		shutdown:      make(chan struct{}),
+	}, nil
+}
+
+// Start begins matching resting limit orders against the live orderbook
+func (e *Exchange) Start() error {
+	if e == nil {
+		return fmt.Errorf("%w Exchange", common.ErrNilPointer)
+	}
+	if !atomic.CompareAndSwapInt32(&e.started, 0, 1) {
+		return errAlreadyStarted
+	}
+	e.shutdown = make(chan struct{})
+	e.wg.Add(1)
+	go e.run()
+	log.Debugf(log.ExchangeSys, "%s paper trading simulator started.", e.GetName())
+	return nil
+}
+
+// Stop halts the matching of resting limit orders
+func (e *Exchange) Stop() error {
+	if e == nil {
+		return fmt.Errorf("%w Exchange", common.ErrNilPointer)
+	}
+	if !atomic.CompareAndSwapInt32(&e.started, 1, 0) {
+		return errNotStarted
+	}
+	close(e.shutdown)
+	e.wg.Wait()
+	log.Debugf(log.ExchangeSys, "%s paper trading simulator stopped.", e.GetName())
+	return nil
+}
+
+// IsRunning returns whether the simulator is matching resting orders
+func (e *Exchange) IsRunning() bool {
+	return e != nil && atomic.LoadInt32(&e.started) == 1
+}
+
+// Shutdown stops the simulator and then shuts down the underlying exchange
+func (e *Exchange) Shutdown() error {
+	if e.IsRunning() {
+		if err := e.Stop(); err != nil {
+			return err
+		}
+	}
+	return e.IBotExchange.Shutdown()
+}
+
+// IsRESTAuthenticationSupported always returns true so that order and account
+// management subsystems will interact with the simulated account
+func (e *Exchange) IsRESTAuthenticationSupported() bool {
+	return true
+}
+
+// Deposit credits the simulated holdings for the asset and currency
+func (e *Exchange) Deposit(a asset.Item, c currency.Code, amount float64) error {
+	if !a.IsValid() {
+		return fmt.Errorf("%v %w", a, asset.ErrNotSupported)
+	}
+	if c.IsEmpty() {
+		return currency.ErrCurrencyCodeEmpty
+	}
+	if amount <= 0 {
+		return errInvalidAmount
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.getBalance(a, c).total += amount
+	e.publish(account.Change{
+		Exchange: e.GetName(),
+		Currency: c,
+		Asset:    a,
+		Amount:   amount,
+	})
+	return nil
+}
+
+// UpdateAccountInfo returns the simulated account holdings
+func (e *Exchange) UpdateAccountInfo(_ context.Context, a asset.Item) (account.Holdings, error) {
+	return e.getHoldings(a), nil
+}
+
+// FetchAccountInfo returns the simulated account holdings
+func (e *Exchange) FetchAccountInfo(_ context.Context, a asset.Item) (account.Holdings, error) {
+	return e.getHoldings(a), nil
+}
+
+// SubmitOrder matches the order against the live orderbook. Market orders are
+// filled immediately by walking the depth, limit orders fill any crossing
+// liquidity and the remainder rests until the book moves through its price.
+func (e *Exchange) SubmitOrder(ctx context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	err := s.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if s.AssetType.IsFutures() {
+		return nil, fmt.Errorf("%w %v", errFuturesNotSimulated, s.AssetType)
+	}
+	if s.Type == order.Limit && s.Amount == 0 {
+		s.Amount = s.QuoteAmount / s.Price
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.DeriveSubmitResponse(id.String())
+	if err != nil {
+		return nil, err
+	}
+	resp.Status = order.New
+	d, err := resp.DeriveDetail(uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	d.MarginType = s.MarginType
+	d.RemainingAmount = d.Amount
+
+	e.m.Lock()
+	defer e.m.Unlock()
+	switch s.Type {
+	case order.Market:
+		err = e.executeMarket(ctx, d)
+	case order.Limit:
+		err = e.executeLimit(ctx, d)
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.orders[d.OrderID] = d
+	e.publish(d.CopyToPointer())
+
+	resp.Status = d.Status
+	resp.Amount = d.Amount
+	resp.Price = d.Price
+	resp.Trades = append([]order.TradeHistory(nil), d.Trades...)
+	resp.Fee = d.Fee
+	resp.FeeAsset = d.FeeAsset
+	resp.Cost = d.Cost
+	resp.LastUpdated = d.LastUpdated
+	return resp, nil
+}
+
+// ModifyOrder amends the price and amount of a resting limit order
+func (e *Exchange) ModifyOrder(ctx context.Context, m *order.Modify) (*order.ModifyResponse, error) {
+	err := m.Validate()
+	if err != nil {
+		return nil, err
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	d, ok := e.orders[m.OrderID]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrOrderNotFound, m.OrderID)
+	}
+	if !d.IsActive() {
+		return nil, fmt.Errorf("%w %s", errOrderNotActive, m.OrderID)
+	}
+	if d.Type != order.Limit {
+		return nil, errCannotModifyOrder
+	}
+	price, amount := m.Price, m.Amount
+	if price <= 0 {
+		price = d.Price
+	}
+	if amount <= 0 {
+		amount = d.Amount
+	}
+	if amount <= d.ExecutedAmount {
+		return nil, errAmountBelowExecuted
+	}
+
+	e.release(d)
+	oldPrice, oldAmount := d.Price, d.Amount
+	d.Price, d.Amount = price, amount
+	d.RemainingAmount = d.Amount - d.ExecutedAmount
+	if err = e.reserve(ctx, d); err != nil {
+		d.Price, d.Amount = oldPrice, oldAmount
+		d.RemainingAmount = d.Amount - d.ExecutedAmount
+		if rErr := e.reserve(ctx, d); rErr != nil {
+			return nil, common.AppendError(err, rErr)
+		}
+		return nil, err
+	}
+	d.LastUpdated = time.Now()
+	err = e.matchLimit(ctx, d, false)
+	if err != nil && !errors.Is(err, errNoLiquidity) {
+		return nil, err
+	}
+	e.publish(d.CopyToPointer())
+
+	resp, err := m.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	resp.Exchange = d.Exchange
+	resp.Pair = d.Pair
+	resp.Side = d.Side
+	resp.Type = d.Type
+	resp.AssetType = d.AssetType
+	resp.Price = d.Price
+	resp.Amount = d.Amount
+	resp.Status = d.Status
+	resp.RemainingAmount = d.RemainingAmount
+	resp.Date = d.Date
+	resp.LastUpdated = d.LastUpdated
+	return resp, nil
+}
+
+// CancelOrder cancels a resting order and releases its reserved funds
+func (e *Exchange) CancelOrder(_ context.Context, c *order.Cancel) error {
+	if c == nil {
+		return order.ErrCancelOrderIsNil
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.cancel(c.OrderID)
+}
+
+// CancelBatchOrders cancels the supplied resting orders
+func (e *Exchange) CancelBatchOrders(_ context.Context, o []order.Cancel) (*order.CancelBatchResponse, error) {
+	resp := &order.CancelBatchResponse{Status: make(map[string]string)}
+	e.m.Lock()
+	defer e.m.Unlock()
+	for i := range o {
+		if err := e.cancel(o[i].OrderID); err != nil {
+			resp.Status[o[i].OrderID] = err.Error()
+			continue
+		}
+		resp.Status[o[i].OrderID] = order.Cancelled.String()
+	}
+	return resp, nil
+}
+
+// CancelAllOrders cancels all resting orders, optionally filtered by the
+// pair and asset of the supplied cancel request
+func (e *Exchange) CancelAllOrders(_ context.Context, c *order.Cancel) (order.CancelAllResponse, error) {
+	resp := order.CancelAllResponse{Status: make(map[string]string)}
+	e.m.Lock()
+	defer e.m.Unlock()
+	for id, d := range e.orders {
+		if !d.IsActive() {
+			continue
+		}
+		if c != nil {
+			if c.AssetType != asset.Empty && c.AssetType != d.AssetType {
+				continue
+			}
+			if !c.Pair.IsEmpty() && !c.Pair.Equal(d.Pair) {
+				continue
+			}
+		}
+		if err := e.cancel(id); err != nil {
+			resp.Status[id] = err.Error()
+			continue
+		}
+		resp.Status[id] = order.Cancelled.String()
+		resp.Count++
+	}
+	return resp, nil
+}
+
+// GetOrderInfo returns a simulated order
+func (e *Exchange) GetOrderInfo(_ context.Context, orderID string, _ currency.Pair, _ asset.Item) (*order.Detail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	d, ok := e.orders[orderID]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrOrderNotFound, orderID)
+	}
+	return d.CopyToPointer(), nil
+}
+
+// GetActiveOrders returns all resting simulated orders
+func (e *Exchange) GetActiveOrders(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return req.Filter(e.GetName(), e.getOrders(req.AssetType, true)), nil
+}
+
+// GetOrderHistory returns all simulated orders that are no longer active
+func (e *Exchange) GetOrderHistory(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return req.Filter(e.GetName(), e.getOrders(req.AssetType, false)), nil
+}
+
+// WithdrawCryptocurrencyFunds is not simulated and never reaches the
+// underlying exchange in paper trading mode
+func (e *Exchange) WithdrawCryptocurrencyFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, e.notSimulated("WithdrawCryptocurrencyFunds")
+}
+
+// WithdrawFiatFunds is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) WithdrawFiatFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, e.notSimulated("WithdrawFiatFunds")
+}
+
+// WithdrawFiatFundsToInternationalBank is not simulated and never reaches the
+// underlying exchange in paper trading mode
+func (e *Exchange) WithdrawFiatFundsToInternationalBank(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, e.notSimulated("WithdrawFiatFundsToInternationalBank")
+}
+
+// GetDepositAddress is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) GetDepositAddress(context.Context, currency.Code, string, string) (*deposit.Address, error) {
+	return nil, e.notSimulated("GetDepositAddress")
+}
+
+// GetAccountFundingHistory is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) GetAccountFundingHistory(context.Context) ([]exchange.FundingHistory, error) {
+	return nil, e.notSimulated("GetAccountFundingHistory")
+}
+
+// GetWithdrawalsHistory is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) GetWithdrawalsHistory(context.Context, currency.Code, asset.Item) ([]exchange.WithdrawalHistory, error) {
+	return nil, e.notSimulated("GetWithdrawalsHistory")
+}
+
+// SetLeverage is not simulated and never reaches the underlying exchange in
+// paper trading mode
+func (e *Exchange) SetLeverage(context.Context, asset.Item, currency.Pair, margin.Type, float64, order.Side) error {
+	return e.notSimulated("SetLeverage")
+}
+
+// GetLeverage is not simulated and never reaches the underlying exchange in
+// paper trading mode
+func (e *Exchange) GetLeverage(context.Context, asset.Item, currency.Pair, margin.Type, order.Side) (float64, error) {
+	return 0, e.notSimulated("GetLeverage")
+}
+
+// SetMarginType is not simulated and never reaches the underlying exchange in
+// paper trading mode
+func (e *Exchange) SetMarginType(context.Context, asset.Item, currency.Pair, margin.Type) error {
+	return e.notSimulated("SetMarginType")
+}
+
+// ChangePositionMargin is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) ChangePositionMargin(context.Context, *margin.PositionChangeRequest) (*margin.PositionChangeResponse, error) {
+	return nil, e.notSimulated("ChangePositionMargin")
+}
+
+// SetCollateralMode is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) SetCollateralMode(context.Context, asset.Item, collateral.Mode) error {
+	return e.notSimulated("SetCollateralMode")
+}
+
+// GetCollateralMode is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) GetCollateralMode(context.Context, asset.Item) (collateral.Mode, error) {
+	return collateral.UnsetMode, e.notSimulated("GetCollateralMode")
+}
+
+// GetFuturesPositions is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) GetFuturesPositions(context.Context, *futures.PositionsRequest) ([]futures.PositionDetails, error) {
+	return nil, e.notSimulated("GetFuturesPositions")
+}
+
+// GetFuturesPositionOrders is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) GetFuturesPositionOrders(context.Context, *futures.PositionsRequest) ([]futures.PositionResponse, error) {
+	return nil, e.notSimulated("GetFuturesPositionOrders")
+}
+
+// GetFuturesPositionSummary is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) GetFuturesPositionSummary(context.Context, *futures.PositionSummaryRequest) (*futures.PositionSummary, error) {
+	return nil, e.notSimulated("GetFuturesPositionSummary")
+}
+
+// GetPositionSummary is not simulated and never reaches the underlying
+// exchange in paper trading mode
+func (e *Exchange) GetPositionSummary(context.Context, *futures.PositionSummaryRequest) (*futures.PositionSummary, error) {
+	return nil, e.notSimulated("GetPositionSummary")
+}
+
+// notSimulated returns the error for account functions which would otherwise
+// act on the live account with real credentials
+func (e *Exchange) notSimulated(method string) error {
+	return fmt.Errorf("%s %s %w: %w", e.GetName(), method, errNotSimulated, common.ErrFunctionNotSupported)
+}
+
+// MatchRestingOrders checks every resting limit order against the current
+// orderbook depth and fills any the book has crossed as a maker
+func (e *Exchange) MatchRestingOrders(ctx context.Context) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	active := make([]*order.Detail, 0, len(e.orders))
+	for _, d := range e.orders {
+		if d.IsActive() {
+			active = append(active, d)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].Date.Before(active[j].Date)
+	})
+	for i := range active {
+		err := e.matchLimit(ctx, active[i], true)
+		if err != nil {
+			if !errors.Is(err, errNoLiquidity) {
+				log.Errorf(log.ExchangeSys, "%s paper trading unable to match order %s: %v",
+					e.GetName(),
+					active[i].OrderID,
+					err)
+			}
+			continue
+		}
+		e.publish(active[i].CopyToPointer())
+	}
+}
+
+// run periodically matches resting orders until shutdown
+func (e *Exchange) run() {
+	defer e.wg.Done()
+	t := time.NewTicker(e.matchInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-e.shutdown:
+			return
+		case <-t.C:
+			e.MatchRestingOrders(context.TODO())
+		}
+	}
+}
+
+// executeMarket walks the orderbook depth to fill a market order in full or
+// until the available book side is exhausted. NOTE: This requires locking.
+func (e *Exchange) executeMarket(ctx context.Context, d *order.Detail) error {
+	l, err := e.getLiquidity(ctx, d.Pair, d.AssetType)
+	if err != nil {
+		return err
+	}
+	limit := marketLimit(d.Side)
+	var f execution
+	var value float64
+	if d.Amount > 0 {
+		f.amount, value = l.walk(d.Side, limit, d.Amount, false)
+	} else {
+		f.amount, value = l.walk(d.Side, limit, d.QuoteAmount, true)
+	}
+	if f.amount <= 0 {
+		return errNoLiquidity
+	}
+	f.price = value / f.amount
+	if d.Amount == 0 {
+		d.Amount = f.amount
+	}
+	f.fee = e.getFee(ctx, d.Pair, f.price, f.amount, false)
+	if err = e.canAfford(d, &f); err != nil {
+		return err
+	}
+	l.consume(d.Side, limit, f.amount)
+	e.applyFill(d, &f)
+	if d.Status != order.Filled {
+		// Market orders do not rest, any amount the book could not cover
+		// is cancelled.
+		d.Status = order.PartiallyFilledCancelled
+		d.CloseTime = d.LastUpdated
+	}
+	return nil
+}
+
+// executeLimit fills any immediately crossing liquidity as a taker, then
+// applies time in force rules and reserves funds for the resting remainder.
+// NOTE: This requires locking.
+func (e *Exchange) executeLimit(ctx context.Context, d *order.Detail) error {
+	l, err := e.getLiquidity(ctx, d.Pair, d.AssetType)
+	if err != nil {
+		return err
+	}
+	available, _ := l.walk(d.Side, d.Price, math.Inf(1), false)
+	switch {
+	case d.PostOnly && available > 0:
+		return fmt.Errorf("%w at price %v", errPostOnlyWouldCross, d.Price)
+	case d.FillOrKill && available < d.Amount:
+		d.Status = order.Cancelled
+		d.CloseTime = time.Now()
+		return nil
+	}
+	if err = e.reserve(ctx, d); err != nil {
+		return err
+	}
+	if available > 0 {
+		if err = e.matchLimit(ctx, d, false); err != nil && !errors.Is(err, errNoLiquidity) {
+			e.release(d)
+			return err
+		}
+	}
+	if d.ImmediateOrCancel && d.IsActive() {
+		e.release(d)
+		d.Status = order.Cancelled
+		if d.ExecutedAmount > 0 {
+			d.Status = order.PartiallyFilledCancelled
+		}
+		d.CloseTime = time.Now()
+	}
+	return nil
+}
+
+// matchLimit fills as much of a limit order as the orderbook allows at or
+// better than its price. Resting orders fill at their limit price as a maker,
+// otherwise the depth is walked and the order fills as a taker. Liquidity
+// already taken by simulated fills is not matched again until the book is
+// updated. NOTE: This requires locking.
+func (e *Exchange) matchLimit(ctx context.Context, d *order.Detail, resting bool) error {
+	l, err := e.getLiquidity(ctx, d.Pair, d.AssetType)
+	if err != nil {
+		return err
+	}
+	f := execution{price: d.Price, isMaker: resting}
+	var value float64
+	f.amount, value = l.walk(d.Side, d.Price, d.Amount-d.ExecutedAmount, false)
+	if f.amount <= 0 {
+		return errNoLiquidity
+	}
+	if !resting {
+		f.price = value / f.amount
+	}
+	l.consume(d.Side, d.Price, f.amount)
+	f.fee = e.getFee(ctx, d.Pair, f.price, f.amount, f.isMaker)
+	e.applyFill(d, &f)
+	return nil
+}
+
+// getLiquidity returns the liquidity of the current orderbook update which
+// has not yet been taken by simulated fills. Taken liquidity is restored
+// once the book is updated. NOTE: This requires locking.
+func (e *Exchange) getLiquidity(ctx context.Context, p currency.Pair, a asset.Item) (*bookLiquidity, error) {
+	depth, err := e.getDepth(ctx, p, a)
+	if err != nil {
+		return nil, err
+	}
+	book, err := depth.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	k := key.PairAsset{Base: p.Base.Item, Quote: p.Quote.Item, Asset: a}
+	l, ok := e.liquidity[k]
+	if !ok || !l.lastUpdated.Equal(book.LastUpdated) || l.lastUpdateID != book.LastUpdateID {
+		l = &bookLiquidity{
+			lastUpdated:  book.LastUpdated,
+			lastUpdateID: book.LastUpdateID,
+			asks:         book.Asks,
+			bids:         book.Bids,
+		}
+		e.liquidity[k] = l
+	}
+	return l, nil
+}
+
+// walk returns the base amount and quote value an order would fill from the
+// best untaken price up to its limit price. The amount is denominated in the
+// quote currency when byQuote is set
+func (l *bookLiquidity) walk(side order.Side, limit, amount float64, byQuote bool) (base, value float64) {
+	levels := l.side(side)
+	for i := range levels {
+		if amount <= 0 || !crosses(side, levels[i].Price, limit) {
+			break
+		}
+		size := levels[i].Amount
+		if byQuote {
+			if size*levels[i].Price >= amount {
+				size = amount / levels[i].Price
+			}
+			amount -= size * levels[i].Price
+		} else {
+			size = math.Min(size, amount)
+			amount -= size
+		}
+		base += size
+		value += size * levels[i].Price
+	}
+	return base, value
+}
+
+// consume takes the filled base amount from the best untaken price levels
+// up to the limit price
+func (l *bookLiquidity) consume(side order.Side, limit, amount float64) {
+	levels := l.side(side)
+	for i := range levels {
+		if amount <= 0 || !crosses(side, levels[i].Price, limit) {
+			break
+		}
+		size := math.Min(levels[i].Amount, amount)
+		levels[i].Amount -= size
+		amount -= size
+	}
+}
+
+// side returns the side of the book an order on the side executes against
+func (l *bookLiquidity) side(side order.Side) orderbook.Items {
+	if side.IsLong() {
+		return l.asks
+	}
+	return l.bids
+}
+
+// crosses returns whether a book price is at or better than the limit price
+// for an order on the side
+func crosses(side order.Side, price, limit float64) bool {
+	if side.IsLong() {
+		return price <= limit
+	}
+	return price >= limit
+}
+
+// marketLimit returns a limit price which crosses every level of the book
+// for a market order on the side
+func marketLimit(side order.Side) float64 {
+	if side.IsLong() {
+		return math.Inf(1)
+	}
+	return 0
+}
+
+// getDepth returns the live orderbook depth, fetching it via REST if it has
+// not been synced
+func (e *Exchange) getDepth(ctx context.Context, p currency.Pair, a asset.Item) (*orderbook.Depth, error) {
+	depth, err := orderbook.GetDepth(e.GetName(), p, a)
+	if err == nil && depth.IsValid() {
+		return depth, nil
+	}
+	if _, err = e.IBotExchange.UpdateOrderbook(ctx, p, a); err != nil {
+		return nil, err
+	}
+	return orderbook.GetDepth(e.GetName(), p, a)
+}
+
+// getFee returns the exchange trading fee for the fill, falling back to the
+// offline fee schedule when the account fee cannot be retrieved
+func (e *Exchange) getFee(ctx context.Context, p currency.Pair, price, amount float64, isMaker bool) float64 {
+	feeBuilder := &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          p,
+		IsMaker:       isMaker,
+		PurchasePrice: price,
+		Amount:        amount,
+	}
+	fee, err := e.IBotExchange.GetFeeByType(ctx, feeBuilder)
+	if err == nil {
+		return fee
+	}
+	feeBuilder.FeeType = exchange.OfflineTradeFee
+	fee, offlineErr := e.IBotExchange.GetFeeByType(ctx, feeBuilder)
+	if offlineErr == nil {
+		return fee
+	}
+	log.Warnf(log.ExchangeSys, "%s paper trading unable to determine fee, defaulting to zero: %v",
+		e.GetName(),
+		common.AppendError(err, offlineErr))
+	return 0
+}
+
+// canAfford checks the free simulated balance covers a fill.
+// NOTE: This requires locking.
+func (e *Exchange) canAfford(d *order.Detail, f *execution) error {
+	if d.Side.IsLong() {
+		b := e.getBalance(d.AssetType, d.Pair.Quote)
+		if required := f.price*f.amount + f.fee; b.total-b.hold < required {
+			return fmt.Errorf("%w %v %v required %v available", ErrInsufficientBalance, required, d.Pair.Quote, b.total-b.hold)
+		}
+		return nil
+	}
+	b := e.getBalance(d.AssetType, d.Pair.Base)
+	if b.total-b.hold < f.amount {
+		return fmt.Errorf("%w %v %v required %v available", ErrInsufficientBalance, f.amount, d.Pair.Base, b.total-b.hold)
+	}
+	return nil
+}
+
+// reserve places a hold on the funds required by the order's remaining
+// amount. Buy orders also hold the estimated taker fee so the quote balance
+// covers the fee of any fill. NOTE: This requires locking.
+func (e *Exchange) reserve(ctx context.Context, d *order.Detail) error {
+	c, amount := d.Pair.Base, d.RemainingAmount
+	if d.Side.IsLong() {
+		c = d.Pair.Quote
+		amount = d.Price*d.RemainingAmount + e.getFee(ctx, d.Pair, d.Price, d.RemainingAmount, false)
+	}
+	if err := e.hold(d.AssetType, c, amount); err != nil {
+		return err
+	}
+	e.reserved[d.OrderID] = amount
+	return nil
+}
+
+// release removes the hold on the funds reserved for the order's remaining
+// amount. NOTE: This requires locking.
+func (e *Exchange) release(d *order.Detail) {
+	e.getBalance(d.AssetType, reservedCurrency(d)).hold -= e.reserved[d.OrderID]
+	delete(e.reserved, d.OrderID)
+}
+
+// releaseFilled removes the portion of the order's hold covering a fill.
+// NOTE: This requires locking.
+func (e *Exchange) releaseFilled(d *order.Detail, amount float64) {
+	held, ok := e.reserved[d.OrderID]
+	if !ok {
+		return
+	}
+	if amount >= d.RemainingAmount {
+		e.release(d)
+		return
+	}
+	portion := held * amount / d.RemainingAmount
+	e.getBalance(d.AssetType, reservedCurrency(d)).hold -= portion
+	e.reserved[d.OrderID] = held - portion
+}
+
+// reservedCurrency returns the currency an order's funds are held in
+func reservedCurrency(d *order.Detail) currency.Code {
+	if d.Side.IsLong() {
+		return d.Pair.Quote
+	}
+	return d.Pair.Base
+}
+
+// hold reserves an amount of free balance. NOTE: This requires locking.
+func (e *Exchange) hold(a asset.Item, c currency.Code, amount float64) error {
+	b := e.getBalance(a, c)
+	if free := b.total - b.hold; free < amount {
+		return fmt.Errorf("%w %v %v required %v available", ErrInsufficientBalance, amount, c, free)
+	}
+	b.hold += amount
+	return nil
+}
+
+// applyFill updates the order and simulated holdings with an execution.
+// NOTE: This requires locking.
+func (e *Exchange) applyFill(d *order.Detail, f *execution) {
+	now := time.Now()
+	cost := f.price * f.amount
+	base := e.getBalance(d.AssetType, d.Pair.Base)
+	quote := e.getBalance(d.AssetType, d.Pair.Quote)
+	e.releaseFilled(d, f.amount)
+	if d.Side.IsLong() {
+		quote.total -= cost + f.fee
+		base.total += f.amount
+	} else {
+		base.total -= f.amount
+		quote.total += cost - f.fee
+	}
+
+	tid, err := uuid.NewV4()
+	if err != nil {
+		log.Warnf(log.ExchangeSys, "%s paper trading unable to generate trade ID: %v", e.GetName(), err)
+	}
+	d.Trades = append(d.Trades, order.TradeHistory{
+		Price:     f.price,
+		Amount:    f.amount,
+		Fee:       f.fee,
+		Exchange:  d.Exchange,
+		TID:       tid.String(),
+		Type:      d.Type,
+		Side:      d.Side,
+		Timestamp: now,
+		IsMaker:   f.isMaker,
+		FeeAsset:  d.Pair.Quote.String(),
+		Total:     cost,
+	})
+	d.AverageExecutedPrice = (d.AverageExecutedPrice*d.ExecutedAmount + cost) / (d.ExecutedAmount + f.amount)
+	d.ExecutedAmount += f.amount
+	d.RemainingAmount = d.Amount - d.ExecutedAmount
+	d.Cost += cost
+	d.CostAsset = d.Pair.Quote
+	d.Fee += f.fee
+	d.FeeAsset = d.Pair.Quote
+	d.LastUpdated = now
+	if d.RemainingAmount <= 0 {
+		d.RemainingAmount = 0
+		d.Status = order.Filled
+		d.CloseTime = now
+	} else {
+		d.Status = order.PartiallyFilled
+	}
+
+	e.publish([]fill.Data{{
+		ID:            tid.String(),
+		Timestamp:     now,
+		Exchange:      d.Exchange,
+		AssetType:     d.AssetType,
+		CurrencyPair:  d.Pair,
+		Side:          d.Side,
+		OrderID:       d.OrderID,
+		ClientOrderID: d.ClientOrderID,
+		TradeID:       tid.String(),
+		Price:         f.price,
+		Amount:        f.amount,
+	}})
+}
+
+// cancel cancels an active order. NOTE: This requires locking.
+func (e *Exchange) cancel(orderID string) error {
+	d, ok := e.orders[orderID]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrOrderNotFound, orderID)
+	}
+	if !d.IsActive() {
+		return fmt.Errorf("%w %s", errOrderNotActive, orderID)
+	}
+	e.release(d)
+	d.Status = order.Cancelled
+	if d.ExecutedAmount > 0 {
+		d.Status = order.PartiallyFilledCancelled
+	}
+	d.LastUpdated = time.Now()
+	d.CloseTime = d.LastUpdated
+	e.publish(d.CopyToPointer())
+	return nil
+}
+
+// getOrders returns copies of active or inactive orders for an asset
+func (e *Exchange) getOrders(a asset.Item, active bool) []order.Detail {
+	e.m.Lock()
+	defer e.m.Unlock()
+	orders := make([]order.Detail, 0, len(e.orders))
+	for _, d := range e.orders {
+		if d.AssetType != a || d.IsActive() != active {
+			continue
+		}
+		orders = append(orders, d.Copy())
+	}
+	return orders
+}
+
+// getBalance returns the simulated balance, creating it if it does not exist.
+// NOTE: This requires locking.
+func (e *Exchange) getBalance(a asset.Item, c currency.Code) *balance {
+	assetHoldings, ok := e.holdings[a]
+	if !ok {
+		assetHoldings = make(map[*currency.Item]*balance)
+		e.holdings[a] = assetHoldings
+	}
+	b, ok := assetHoldings[c.Item]
+	if !ok {
+		b = &balance{}
+		assetHoldings[c.Item] = b
+	}
+	return b
+}
+
+// getHoldings returns the simulated holdings for an asset
+func (e *Exchange) getHoldings(a asset.Item) account.Holdings {
+	e.m.Lock()
+	defer e.m.Unlock()
+	sub := account.SubAccount{AssetType: a}
+	for item, b := range e.holdings[a] {
+		sub.Currencies = append(sub.Currencies, account.Balance{
+			Currency:               currency.Code{Item: item, UpperCase: true},
+			Total:                  b.total,
+			Hold:                   b.hold,
+			Free:                   b.total - b.hold,
+			AvailableWithoutBorrow: b.total - b.hold,
+		})
+	}
+	sort.Slice(sub.Currencies, func(i, j int) bool {
+		return sub.Currencies[i].Currency.String() < sub.Currencies[j].Currency.String()
+	})
+	return account.Holdings{
+		Exchange: e.GetName(),
+		Accounts: []account.SubAccount{sub},
+	}
+}
+
+// publish sends simulated updates through the exchange websocket data handler
+// when available so the engine can process them as it would live updates
+func (e *Exchange) publish(data interface{}) {
+	ws, err := e.IBotExchange.GetWebsocket()
+	if err != nil || !ws.IsEnabled() {
+		return
+	}
+	select {
+	case ws.DataHandler <- data:
+	default:
+		log.Warnf(log.ExchangeSys, "%s paper trading websocket data handler full, dropping update", e.GetName())
+	}
+}
@@ -0,0 +1,447 @@
+package paper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+)
+
+const testExchange = "papertest"
+
+var btcusd = currency.NewPair(currency.BTC, currency.USD)
+
+var errNoWebsocket = errors.New("no websocket")
+
+// fakeExchange overrides the exchange functions used by the simulator so no
+// API calls or credentials are required
+type fakeExchange struct {
+	exchange.IBotExchange
+}
+
+func (f fakeExchange) GetName() string {
+	return testExchange
+}
+
+func (f fakeExchange) GetFeeByType(_ context.Context, b *exchange.FeeBuilder) (float64, error) {
+	if b.IsMaker {
+		return b.PurchasePrice * b.Amount * 0.001, nil
+	}
+	return b.PurchasePrice * b.Amount * 0.002, nil
+}
+
+func (f fakeExchange) UpdateOrderbook(context.Context, currency.Pair, asset.Item) (*orderbook.Base, error) {
+	return nil, orderbook.ErrOrderbookInvalid
+}
+
+func (f fakeExchange) GetWebsocket() (*stream.Websocket, error) {
+	return nil, errNoWebsocket
+}
+
+func (f fakeExchange) Shutdown() error {
+	return nil
+}
+
+func loadBook(t *testing.T, bids, asks orderbook.Items) {
+	t.Helper()
+	err := (&orderbook.Base{
+		Exchange:    testExchange,
+		Pair:        btcusd,
+		Asset:       asset.Spot,
+		Bids:        bids,
+		Asks:        asks,
+		LastUpdated: time.Now(),
+	}).Process()
+	require.NoError(t, err, "Process must not error")
+}
+
+func setupPaper(t *testing.T) *Exchange {
+	t.Helper()
+	loadBook(t,
+		orderbook.Items{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		orderbook.Items{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}})
+	e, err := New(fakeExchange{}, 0)
+	require.NoError(t, err, "New must not error")
+	require.NoError(t, e.Deposit(asset.Spot, currency.USD, 10000), "Deposit must not error")
+	require.NoError(t, e.Deposit(asset.Spot, currency.BTC, 10), "Deposit must not error")
+	return e
+}
+
+func freeBalance(t *testing.T, e *Exchange, c currency.Code) float64 {
+	t.Helper()
+	h, err := e.UpdateAccountInfo(context.Background(), asset.Spot)
+	require.NoError(t, err, "UpdateAccountInfo must not error")
+	for _, b := range h.Accounts[0].Currencies {
+		if b.Currency.Equal(c) {
+			return b.Free
+		}
+	}
+	return 0
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(nil, 0)
+	assert.ErrorIs(t, err, common.ErrNilPointer, "New should error on nil exchange")
+
+	e, err := New(fakeExchange{}, 0)
+	require.NoError(t, err, "New must not error")
+	assert.Equal(t, DefaultMatchInterval, e.matchInterval, "matchInterval should default")
+}
+
+func TestStartStop(t *testing.T) {
+	t.Parallel()
+	e, err := New(fakeExchange{}, time.Millisecond)
+	require.NoError(t, err, "New must not error")
+	assert.ErrorIs(t, e.Stop(), errNotStarted, "Stop should error when not started")
+	require.NoError(t, e.Start(), "Start must not error")
+	assert.ErrorIs(t, e.Start(), errAlreadyStarted, "Start should error when already started")
+	assert.True(t, e.IsRunning(), "IsRunning should return true")
+	require.NoError(t, e.Shutdown(), "Shutdown must not error")
+	assert.False(t, e.IsRunning(), "IsRunning should return false")
+}
+
+func TestDeposit(t *testing.T) {
+	t.Parallel()
+	e, err := New(fakeExchange{}, 0)
+	require.NoError(t, err, "New must not error")
+	assert.ErrorIs(t, e.Deposit(asset.Empty, currency.BTC, 1), asset.ErrNotSupported, "Deposit should error on invalid asset")
+	assert.ErrorIs(t, e.Deposit(asset.Spot, currency.EMPTYCODE, 1), currency.ErrCurrencyCodeEmpty, "Deposit should error on empty code")
+	assert.ErrorIs(t, e.Deposit(asset.Spot, currency.BTC, 0), errInvalidAmount, "Deposit should error on zero amount")
+	require.NoError(t, e.Deposit(asset.Spot, currency.BTC, 1.5), "Deposit must not error")
+	assert.Equal(t, 1.5, freeBalance(t, e, currency.BTC), "Free balance should be correct")
+}
+
+func TestSubmitMarketOrder(t *testing.T) {
+	e := setupPaper(t)
+	ctx := context.Background()
+	resp, err := e.SubmitOrder(ctx, &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Market,
+		Side:      order.Buy,
+		Pair:      btcusd,
+		AssetType: asset.Spot,
+		Amount:    2,
+	})
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Filled, resp.Status, "Market order should fill")
+	require.Len(t, resp.Trades, 1, "Market order must have one trade")
+	assert.Equal(t, 101.5, resp.Trades[0].Price, "Market order should walk the asks")
+	assert.Equal(t, 203*0.002, resp.Fee, "Market order should pay the taker fee")
+	assert.Equal(t, 12.0, freeBalance(t, e, currency.BTC), "BTC balance should increase")
+	assert.Equal(t, 10000-203-203*0.002, freeBalance(t, e, currency.USD), "USD balance should decrease by cost and fee")
+
+	resp, err = e.SubmitOrder(ctx, &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Market,
+		Side:      order.Sell,
+		Pair:      btcusd,
+		AssetType: asset.Spot,
+		Amount:    5,
+	})
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.PartiallyFilledCancelled, resp.Status, "Market order exceeding liquidity should be partially filled")
+	assert.Equal(t, 3.0, resp.Trades[0].Amount, "Market order should consume all bid liquidity")
+
+	_, err = e.SubmitOrder(ctx, &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Market,
+		Side:      order.Buy,
+		Pair:      btcusd,
+		AssetType: asset.Spot,
+		Amount:    3,
+	})
+	require.NoError(t, err, "SubmitOrder must not error")
+	_, err = e.SubmitOrder(ctx, &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Limit,
+		Side:      order.Buy,
+		Pair:      btcusd,
+		AssetType: asset.Spot,
+		Price:     100,
+		Amount:    1000,
+	})
+	assert.ErrorIs(t, err, ErrInsufficientBalance, "SubmitOrder should error when balance is insufficient")
+
+	_, err = e.SubmitOrder(ctx, &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Market,
+		Side:      order.Buy,
+		Pair:      btcusd,
+		AssetType: asset.Futures,
+		Amount:    1,
+	})
+	assert.ErrorIs(t, err, errFuturesNotSimulated, "SubmitOrder should error on futures")
+}
+
+func TestSubmitLimitOrder(t *testing.T) {
+	e := setupPaper(t)
+	ctx := context.Background()
+	resp, err := e.SubmitOrder(ctx, &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Limit,
+		Side:      order.Buy,
+		Pair:      btcusd,
+		AssetType: asset.Spot,
+		Price:     101,
+		Amount:    3,
+	})
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.PartiallyFilled, resp.Status, "Crossing limit order should partially fill")
+	assert.InDelta(t, 10000-303-303*0.002, freeBalance(t, e, currency.USD), 1e-9, "USD should be spent and reserved with the estimated fee")
+
+	d, err := e.GetOrderInfo(ctx, resp.OrderID, btcusd, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, 2.0, d.RemainingAmount, "Remaining amount should rest on the book")
+
+	active, err := e.GetActiveOrders(ctx, &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err, "GetActiveOrders must not error")
+	assert.Len(t, active, 1, "GetActiveOrders should return the resting order")
+
+	_, err = e.SubmitOrder(ctx, &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Limit,
+		Side:      order.Sell,
+		Pair:      btcusd,
+		AssetType: asset.Spot,
+		Price:     99,
+		Amount:    1,
+		PostOnly:  true,
+	})
+	assert.ErrorIs(t, err, errPostOnlyWouldCross, "SubmitOrder should error when post only crosses")
+
+	resp, err = e.SubmitOrder(ctx, &order.Submit{
+		Exchange:   testExchange,
+		Type:       order.Limit,
+		Side:       order.Sell,
+		Pair:       btcusd,
+		AssetType:  asset.Spot,
+		Price:      99,
+		Amount:     2,
+		FillOrKill: true,
+	})
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Cancelled, resp.Status, "Fill or kill order should be cancelled without enough liquidity")
+
+	resp, err = e.SubmitOrder(ctx, &order.Submit{
+		Exchange:          testExchange,
+		Type:              order.Limit,
+		Side:              order.Sell,
+		Pair:              btcusd,
+		AssetType:         asset.Spot,
+		Price:             99,
+		Amount:            2,
+		ImmediateOrCancel: true,
+	})
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.PartiallyFilledCancelled, resp.Status, "Immediate or cancel order should cancel the remainder")
+	assert.Equal(t, 10.0, freeBalance(t, e, currency.BTC), "No BTC should remain on hold")
+}
+
+func TestMatchRestingOrders(t *testing.T) {
+	e := setupPaper(t)
+	ctx := context.Background()
+	resp, err := e.SubmitOrder(ctx, &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Limit,
+		Side:      order.Buy,
+		Pair:      btcusd,
+		AssetType: asset.Spot,
+		Price:     100,
+		Amount:    1,
+	})
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.New, resp.Status, "Non crossing limit order should rest")
+	assert.InDelta(t, 10000-100-100*0.002, freeBalance(t, e, currency.USD), 1e-9, "USD should be reserved with the estimated taker fee")
+
+	e.MatchRestingOrders(ctx)
+	d, err := e.GetOrderInfo(ctx, resp.OrderID, btcusd, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.New, d.Status, "Order should not fill when book has not crossed")
+
+	loadBook(t,
+		orderbook.Items{{Price: 98, Amount: 1}},
+		orderbook.Items{{Price: 99.5, Amount: 5}})
+	e.MatchRestingOrders(ctx)
+	d, err = e.GetOrderInfo(ctx, resp.OrderID, btcusd, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.Filled, d.Status, "Order should fill when the book crosses")
+	require.Len(t, d.Trades, 1, "Order must have one trade")
+	assert.True(t, d.Trades[0].IsMaker, "Resting fill should be a maker")
+	assert.Equal(t, 100.0, d.Trades[0].Price, "Resting fill should be at the limit price")
+	assert.InDelta(t, 10000-100-100*0.001, freeBalance(t, e, currency.USD), 1e-9, "USD should be spent with maker fee and the remaining hold released")
+
+	history, err := e.GetOrderHistory(ctx, &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err, "GetOrderHistory must not error")
+	assert.Len(t, history, 1, "GetOrderHistory should return the filled order")
+}
+
+func TestMatchRestingOrdersLiquidity(t *testing.T) {
+	e := setupPaper(t)
+	ctx := context.Background()
+	ids := make([]string, 2)
+	for i := range ids {
+		resp, err := e.SubmitOrder(ctx, &order.Submit{
+			Exchange:  testExchange,
+			Type:      order.Limit,
+			Side:      order.Buy,
+			Pair:      btcusd,
+			AssetType: asset.Spot,
+			Price:     100,
+			Amount:    1,
+		})
+		require.NoError(t, err, "SubmitOrder must not error")
+		ids[i] = resp.OrderID
+	}
+
+	loadBook(t,
+		orderbook.Items{{Price: 98, Amount: 1}},
+		orderbook.Items{{Price: 99.5, Amount: 1.5}})
+	for i := 0; i < 2; i++ {
+		e.MatchRestingOrders(ctx)
+	}
+	first, err := e.GetOrderInfo(ctx, ids[0], btcusd, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.Filled, first.Status, "Oldest order should fill first")
+	second, err := e.GetOrderInfo(ctx, ids[1], btcusd, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.PartiallyFilled, second.Status, "Second order should only fill the remaining liquidity")
+	assert.InDelta(t, 0.5, second.ExecutedAmount, 1e-9, "Repeated matching should not refill against the same book update")
+
+	_, err = e.SubmitOrder(ctx, &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Market,
+		Side:      order.Buy,
+		Pair:      btcusd,
+		AssetType: asset.Spot,
+		Amount:    1,
+	})
+	assert.ErrorIs(t, err, errNoLiquidity, "Market order should not fill against liquidity already taken")
+
+	loadBook(t,
+		orderbook.Items{{Price: 98, Amount: 1}},
+		orderbook.Items{{Price: 99.5, Amount: 1.5}})
+	e.MatchRestingOrders(ctx)
+	second, err = e.GetOrderInfo(ctx, ids[1], btcusd, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.Filled, second.Status, "Order should fill against a new book update")
+}
+
+func TestModifyOrder(t *testing.T) {
+	e := setupPaper(t)
+	ctx := context.Background()
+	resp, err := e.SubmitOrder(ctx, &order.Submit{
+		Exchange:  testExchange,
+		Type:      order.Limit,
+		Side:      order.Sell,
+		Pair:      btcusd,
+		AssetType: asset.Spot,
+		Price:     110,
+		Amount:    2,
+	})
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, 8.0, freeBalance(t, e, currency.BTC), "BTC should be reserved")
+
+	_, err = e.ModifyOrder(ctx, &order.Modify{OrderID: "bad", Pair: btcusd, AssetType: asset.Spot})
+	assert.ErrorIs(t, err, ErrOrderNotFound, "ModifyOrder should error on unknown order")
+
+	_, err = e.ModifyOrder(ctx, &order.Modify{OrderID: resp.OrderID, Pair: btcusd, AssetType: asset.Spot, Amount: 20})
+	assert.ErrorIs(t, err, ErrInsufficientBalance, "ModifyOrder should error when balance is insufficient")
+	assert.Equal(t, 8.0, freeBalance(t, e, currency.BTC), "Reservation should be restored")
+
+	mod, err := e.ModifyOrder(ctx, &order.Modify{OrderID: resp.OrderID, Pair: btcusd, AssetType: asset.Spot, Price: 105, Amount: 3})
+	require.NoError(t, err, "ModifyOrder must not error")
+	assert.Equal(t, 105.0, mod.Price, "Price should be modified")
+	assert.Equal(t, 7.0, freeBalance(t, e, currency.BTC), "Reservation should be updated")
+
+	mod, err = e.ModifyOrder(ctx, &order.Modify{OrderID: resp.OrderID, Pair: btcusd, AssetType: asset.Spot, Price: 98})
+	require.NoError(t, err, "ModifyOrder must not error")
+	assert.Equal(t, order.Filled, mod.Status, "Modifying through the book should fill")
+	assert.Zero(t, mod.RemainingAmount, "Remaining amount should be zero")
+}
+
+func TestCancelOrders(t *testing.T) {
+	e := setupPaper(t)
+	ctx := context.Background()
+	var ids []string
+	for i := 0; i < 3; i++ {
+		resp, err := e.SubmitOrder(ctx, &order.Submit{
+			Exchange:  testExchange,
+			Type:      order.Limit,
+			Side:      order.Buy,
+			Pair:      btcusd,
+			AssetType: asset.Spot,
+			Price:     90,
+			Amount:    1,
+		})
+		require.NoError(t, err, "SubmitOrder must not error")
+		ids = append(ids, resp.OrderID)
+	}
+	assert.InDelta(t, 10000-270-270*0.002, freeBalance(t, e, currency.USD), 1e-9, "USD should be reserved with the estimated fee")
+
+	assert.ErrorIs(t, e.CancelOrder(ctx, nil), order.ErrCancelOrderIsNil, "CancelOrder should error on nil")
+	assert.ErrorIs(t, e.CancelOrder(ctx, &order.Cancel{OrderID: "bad"}), ErrOrderNotFound, "CancelOrder should error on unknown order")
+	require.NoError(t, e.CancelOrder(ctx, &order.Cancel{OrderID: ids[0]}), "CancelOrder must not error")
+	assert.ErrorIs(t, e.CancelOrder(ctx, &order.Cancel{OrderID: ids[0]}), errOrderNotActive, "CancelOrder should error on inactive order")
+
+	batch, err := e.CancelBatchOrders(ctx, []order.Cancel{{OrderID: ids[0]}, {OrderID: ids[1]}})
+	require.NoError(t, err, "CancelBatchOrders must not error")
+	assert.Equal(t, order.Cancelled.String(), batch.Status[ids[1]], "Active order should be cancelled")
+	assert.NotEqual(t, order.Cancelled.String(), batch.Status[ids[0]], "Inactive order should not be cancelled")
+
+	all, err := e.CancelAllOrders(ctx, &order.Cancel{AssetType: asset.Spot, Pair: btcusd})
+	require.NoError(t, err, "CancelAllOrders must not error")
+	assert.Equal(t, int64(1), all.Count, "CancelAllOrders should cancel the remaining order")
+	assert.InDelta(t, 10000.0, freeBalance(t, e, currency.USD), 1e-9, "All reserved USD should be released")
+}
+
+func TestAccountFunctionsNotSimulated(t *testing.T) {
+	t.Parallel()
+	e, err := New(fakeExchange{}, 0)
+	require.NoError(t, err, "New must not error")
+	ctx := context.Background()
+	_, err = e.WithdrawCryptocurrencyFunds(ctx, nil)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "WithdrawCryptocurrencyFunds should not reach the live exchange")
+	_, err = e.WithdrawFiatFunds(ctx, nil)
+	assert.ErrorIs(t, err, errNotSimulated, "WithdrawFiatFunds should not reach the live exchange")
+	_, err = e.WithdrawFiatFundsToInternationalBank(ctx, nil)
+	assert.ErrorIs(t, err, errNotSimulated, "WithdrawFiatFundsToInternationalBank should not reach the live exchange")
+	_, err = e.GetDepositAddress(ctx, currency.BTC, "", "")
+	assert.ErrorIs(t, err, errNotSimulated, "GetDepositAddress should not reach the live exchange")
+	_, err = e.GetAccountFundingHistory(ctx)
+	assert.ErrorIs(t, err, errNotSimulated, "GetAccountFundingHistory should not reach the live exchange")
+	_, err = e.GetWithdrawalsHistory(ctx, currency.BTC, asset.Spot)
+	assert.ErrorIs(t, err, errNotSimulated, "GetWithdrawalsHistory should not reach the live exchange")
+	err = e.SetLeverage(ctx, asset.Futures, btcusd, margin.Isolated, 2, order.Long)
+	assert.ErrorIs(t, err, errNotSimulated, "SetLeverage should not reach the live exchange")
+	_, err = e.GetLeverage(ctx, asset.Futures, btcusd, margin.Isolated, order.Long)
+	assert.ErrorIs(t, err, errNotSimulated, "GetLeverage should not reach the live exchange")
+	err = e.SetMarginType(ctx, asset.Futures, btcusd, margin.Isolated)
+	assert.ErrorIs(t, err, errNotSimulated, "SetMarginType should not reach the live exchange")
+	_, err = e.ChangePositionMargin(ctx, nil)
+	assert.ErrorIs(t, err, errNotSimulated, "ChangePositionMargin should not reach the live exchange")
+	err = e.SetCollateralMode(ctx, asset.Futures, collateral.MultiMode)
+	assert.ErrorIs(t, err, errNotSimulated, "SetCollateralMode should not reach the live exchange")
+	_, err = e.GetCollateralMode(ctx, asset.Futures)
+	assert.ErrorIs(t, err, errNotSimulated, "GetCollateralMode should not reach the live exchange")
+	_, err = e.GetFuturesPositions(ctx, nil)
+	assert.ErrorIs(t, err, errNotSimulated, "GetFuturesPositions should not reach the live exchange")
+	_, err = e.GetFuturesPositionOrders(ctx, nil)
+	assert.ErrorIs(t, err, errNotSimulated, "GetFuturesPositionOrders should not reach the live exchange")
+	_, err = e.GetFuturesPositionSummary(ctx, nil)
+	assert.ErrorIs(t, err, errNotSimulated, "GetFuturesPositionSummary should not reach the live exchange")
+	_, err = e.GetPositionSummary(ctx, nil)
+	assert.ErrorIs(t, err, errNotSimulated, "GetPositionSummary should not reach the live exchange")
+}
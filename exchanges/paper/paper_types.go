@@ -0,0 +1,79 @@
+package paper
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// DefaultMatchInterval defines the default duration between checks of resting
+// limit orders against the live orderbook depth
+const DefaultMatchInterval = time.Second
+
+var (
+	// ErrInsufficientBalance is returned when the simulated holdings cannot
+	// cover an order
+	ErrInsufficientBalance = errors.New("insufficient simulated balance")
+	// ErrOrderNotFound is returned when a simulated order does not exist
+	ErrOrderNotFound = errors.New("paper order not found")
+
+	errOrderNotActive      = errors.New("paper order is not active")
+	errPostOnlyWouldCross  = errors.New("post only order would cross the orderbook")
+	errCannotModifyOrder   = errors.New("only resting limit orders can be modified")
+	errInvalidAmount       = errors.New("amount must be greater than zero")
+	errAmountBelowExecuted = errors.New("modified amount is below the already executed amount")
+	errNoLiquidity         = errors.New("no orderbook liquidity available to fill order")
+	errAlreadyStarted      = errors.New("paper trading simulator already started")
+	errNotStarted          = errors.New("paper trading simulator not started")
+	errFuturesNotSimulated = errors.New("futures order simulation is not supported")
+	errNotSimulated        = errors.New("is not simulated in paper trading mode")
+)
+
+// Exchange wraps a live exchange and routes order and account management into
+// a local matching simulator. Market data is passed straight through to the
+// underlying exchange so orders are matched against the real orderbook depth.
+type Exchange struct {
+	exchange.IBotExchange
+	matchInterval time.Duration
+	orders        map[string]*order.Detail
+	holdings      map[asset.Item]map[*currency.Item]*balance
+	reserved      map[string]float64
+	// liquidity tracks the orderbook liquidity taken by simulated fills so
+	// that resting orders cannot repeatedly fill against the same update
+	liquidity map[key.PairAsset]*bookLiquidity
+	started   int32
+	shutdown  chan struct{}
+	wg        sync.WaitGroup
+	m         sync.Mutex
+}
+
+// balance defines a simulated currency balance, hold is the amount reserved
+// by resting orders
+type balance struct {
+	total float64
+	hold  float64
+}
+
+// bookLiquidity defines the price levels of an orderbook update with the
+// amount of each level which has not been taken by simulated fills
+type bookLiquidity struct {
+	lastUpdated  time.Time
+	lastUpdateID int64
+	asks         orderbook.Items
+	bids         orderbook.Items
+}
+
+// execution defines a single simulated execution against the orderbook
+type execution struct {
+	price   float64
+	amount  float64
+	fee     float64
+	isMaker bool
+}
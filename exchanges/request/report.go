@@ -10,6 +10,12 @@ type Reporter interface {
 	Latency(name, method, path string, t time.Duration)
 }
 
+// RateLimitReporter is an optional extension of Reporter which is notified of
+// the time each request spent waiting on the rate limiter
+type RateLimitReporter interface {
+	RateLimitWait(name string, t time.Duration)
+}
+
 // SetupGlobalReporter sets a reporter interface to be used
 // for all exchange requests
 func SetupGlobalReporter(r Reporter) {
@@ -148,10 +148,14 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 		}
 
 		// Initiate a rate limit reservation and sleep on requested endpoint
+		limitStart := time.Now()
 		err := r.InitiateRateLimit(ctx, endpoint)
 		if err != nil {
 			return fmt.Errorf("failed to rate limit HTTP request: %w", err)
 		}
+		if rl, ok := r.reporter.(RateLimitReporter); ok {
+			rl.RateLimitWait(r.name, time.Since(limitStart))
+		}
 
 		p, err := newRequest()
 		if err != nil {
@@ -765,3 +765,53 @@ func TestContextVerbosity(t *testing.T) {
 		t.Fatal("unexpected value")
 	}
 }
+
+// testReporter records reported request latencies and rate limit waits
+type testReporter struct {
+	m         sync.Mutex
+	latencies int
+	waits     []time.Duration
+}
+
+func (r *testReporter) Latency(_, _, _ string, _ time.Duration) {
+	r.m.Lock()
+	r.latencies++
+	r.m.Unlock()
+}
+
+func (r *testReporter) RateLimitWait(_ string, t time.Duration) {
+	r.m.Lock()
+	r.waits = append(r.waits, t)
+	r.m.Unlock()
+}
+
+func TestRateLimitReporter(t *testing.T) {
+	t.Parallel()
+	rep := &testReporter{}
+	r, err := New("TestRateLimitReporter",
+		new(http.Client),
+		WithLimiter(NewBasicRateLimit(time.Millisecond*100, 1)),
+		WithReporter(rep))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		err = r.SendPayload(context.Background(), Unset, func() (*Item, error) {
+			return &Item{Method: http.MethodGet, Path: testURL}, nil
+		}, UnauthenticatedRequest)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	rep.m.Lock()
+	defer rep.m.Unlock()
+	if rep.latencies != 2 {
+		t.Errorf("received %v latencies expected %v", rep.latencies, 2)
+	}
+	if len(rep.waits) != 2 {
+		t.Fatalf("received %v rate limit waits expected %v", len(rep.waits), 2)
+	}
+	if rep.waits[1] < time.Millisecond*50 {
+		t.Errorf("expected second request to wait on the rate limiter, waited %v", rep.waits[1])
+	}
+}
@@ -244,7 +244,12 @@ func (w *Orderbook) processBufferUpdate(o *orderbookHolder, u *orderbook.Update)
 // price level
 func (w *Orderbook) processObUpdate(o *orderbookHolder, u *orderbook.Update) error {
 	if w.updateEntriesByID {
-		return o.updateByIDAndAction(u)
+		err := o.updateByIDAndAction(u)
+		if err != nil {
+			return err
+		}
+		w.recordUpdate(u)
+		return nil
 	}
 	err := o.updateByPrice(u)
 	if err != nil {
@@ -261,6 +266,7 @@ func (w *Orderbook) processObUpdate(o *orderbookHolder, u *orderbook.Update) err
 		}
 		o.updateID = u.UpdateID
 	}
+	w.recordUpdate(u)
 	return nil
 }
 
@@ -356,11 +362,36 @@ func (w *Orderbook) LoadSnapshot(book *orderbook.Base) error {
 		}
 	}
 
+	if w.recorder != nil {
+		if err = w.recorder.RecordSnapshot(book); err != nil {
+			log.Errorf(log.WebsocketMgr, "%s %s %s cannot record orderbook snapshot: %v", w.exchangeName, book.Pair, book.Asset, err)
+		}
+	}
+
 	holder.ob.Publish()
 	w.dataHandler <- holder.ob
 	return nil
 }
 
+// recordUpdate passes an applied update to the recorder if one is set. A
+// failure to record is logged as it should not stop the book from syncing
+func (w *Orderbook) recordUpdate(u *orderbook.Update) {
+	if w.recorder == nil {
+		return
+	}
+	if err := w.recorder.RecordUpdate(u, w.updateEntriesByID); err != nil {
+		log.Errorf(log.WebsocketMgr, "%s %s %s cannot record orderbook update: %v", w.exchangeName, u.Pair, u.Asset, err)
+	}
+}
+
+// SetRecorder sets a recorder to receive every orderbook snapshot and
+// successfully applied update. A nil recorder stops recording
+func (w *Orderbook) SetRecorder(r Recorder) {
+	w.mtx.Lock()
+	w.recorder = r
+	w.mtx.Unlock()
+}
+
 // GetOrderbook returns an orderbook copy as orderbook.Base
 func (w *Orderbook) GetOrderbook(p currency.Pair, a asset.Item) (*orderbook.Base, error) {
 	w.mtx.Lock()
@@ -1218,3 +1218,78 @@ func TestFlushOrderbook(t *testing.T) {
 		t.Fatalf("received: '%v' but expected: '%v'", err, orderbook.ErrOrderbookInvalid)
 	}
 }
+
+type testRecorder struct {
+	snapshots int
+	updates   int
+	byID      bool
+	err       error
+}
+
+func (r *testRecorder) RecordSnapshot(*orderbook.Base) error {
+	r.snapshots++
+	return r.err
+}
+
+func (r *testRecorder) RecordUpdate(_ *orderbook.Update, updateByID bool) error {
+	r.updates++
+	r.byID = updateByID
+	return r.err
+}
+
+func TestSetRecorder(t *testing.T) {
+	t.Parallel()
+	holder, _, _, err := createSnapshot()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	rec := &testRecorder{}
+	holder.SetRecorder(rec)
+
+	err = holder.LoadSnapshot(&orderbook.Base{
+		Exchange:    exchangeName,
+		Asks:        orderbook.Items{{Price: 4001, Amount: 1}},
+		Bids:        orderbook.Items{{Price: 3999, Amount: 1}},
+		Asset:       asset.Spot,
+		Pair:        cp,
+		LastUpdated: time.Now(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if rec.snapshots != 1 {
+		t.Fatalf("received: '%v' but expected: '%v'", rec.snapshots, 1)
+	}
+
+	// recording failures should not stop updates from being applied
+	rec.err = errors.New("disk full")
+	err = holder.Update(&orderbook.Update{
+		Bids:       itemArray[0],
+		Pair:       cp,
+		UpdateTime: time.Now(),
+		Asset:      asset.Spot,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if rec.updates != 1 {
+		t.Fatalf("received: '%v' but expected: '%v'", rec.updates, 1)
+	}
+	if rec.byID {
+		t.Error("expected update to be recorded by price")
+	}
+
+	holder.SetRecorder(nil)
+	err = holder.Update(&orderbook.Update{
+		Bids:       itemArray[1],
+		Pair:       cp,
+		UpdateTime: time.Now(),
+		Asset:      asset.Spot,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if rec.updates != 1 {
+		t.Errorf("received: '%v' but expected: '%v'", rec.updates, 1)
+	}
+}
@@ -47,6 +47,9 @@ type Orderbook struct {
 
 	publishPeriod time.Duration
 
+	// recorder receives snapshots and applied updates for later replay
+	recorder Recorder
+
 	// TODO: sync.RWMutex. For the moment we process the orderbook in a single
 	// thread. In future when there are workers directly involved this can be
 	// can be improved with RW mechanics which will allow updates to occur at
@@ -54,6 +57,13 @@ type Orderbook struct {
 	mtx sync.Mutex
 }
 
+// Recorder defines a store for orderbook snapshots and the updates applied to
+// them so that books can be replayed at a later stage
+type Recorder interface {
+	RecordSnapshot(book *orderbook.Base) error
+	RecordUpdate(u *orderbook.Update, updateByID bool) error
+}
+
 // orderbookHolder defines a store of pending updates and a pointer to the
 // orderbook depth
 type orderbookHolder struct {
@@ -86,6 +86,15 @@ type WebsocketPositionUpdated struct {
 	Exchange  string
 }
 
+// Reconnected is sent to the data handler when a websocket connects after
+// having previously been connected. DisconnectedAt is when the previous
+// connection was lost, data may have been missed between it and Timestamp
+type Reconnected struct {
+	Exchange       string
+	DisconnectedAt time.Time
+	Timestamp      time.Time
+}
+
 // UnhandledMessageWarning defines a container for unhandled message warnings
 type UnhandledMessageWarning struct {
 	Message string
@@ -288,6 +288,14 @@ func (w *Websocket) Connect() error {
 	w.setConnectingStatus(false)
 	w.setInit(true)
 
+	if reconnected, disconnectedAt := w.markConnected(); reconnected {
+		select {
+		case w.DataHandler <- Reconnected{Exchange: w.exchangeName, DisconnectedAt: disconnectedAt, Timestamp: time.Now()}:
+		default:
+			log.Warnf(log.WebsocketMgr, "%s websocket: unable to send reconnection notice, data handler is full", w.exchangeName)
+		}
+	}
+
 	if !w.IsConnectionMonitorRunning() {
 		err = w.connectionMonitor()
 		if err != nil {
@@ -645,10 +653,23 @@ func (w *Websocket) trafficMonitor() {
 
 func (w *Websocket) setConnectedStatus(b bool) {
 	w.fieldMutex.Lock()
+	if w.connected && !b {
+		w.disconnectedAt = time.Now()
+	}
 	w.connected = b
 	w.fieldMutex.Unlock()
 }
 
+// markConnected records a successful connection and returns whether the
+// websocket had connected before along with when it was last disconnected
+func (w *Websocket) markConnected() (reconnected bool, disconnectedAt time.Time) {
+	w.fieldMutex.Lock()
+	defer w.fieldMutex.Unlock()
+	reconnected = w.hasConnected
+	w.hasConnected = true
+	return reconnected, w.disconnectedAt
+}
+
 // IsConnected returns status of connection
 func (w *Websocket) IsConnected() bool {
 	w.fieldMutex.RLock()
@@ -1299,6 +1299,50 @@ func TestEnable(t *testing.T) {
 	fmt.Print()
 }
 
+func TestReconnectedNotice(t *testing.T) {
+	t.Parallel()
+	web := Websocket{
+		enabled:                true,
+		exchangeName:           "test",
+		connector:              connect,
+		Wg:                     new(sync.WaitGroup),
+		ShutdownC:              make(chan struct{}),
+		DataHandler:            make(chan interface{}, 1),
+		ToRoutine:              make(chan interface{}, 1),
+		trafficTimeout:         time.Minute,
+		connectionMonitorDelay: time.Minute,
+		GenerateSubs:           func() ([]subscription.Subscription, error) { return nil, nil },
+	}
+	defer close(web.ShutdownC)
+
+	assert.NoError(t, web.Connect(), "Connect should not error")
+	select {
+	case d := <-web.ToRoutine:
+		t.Fatalf("received unexpected data on first connection: %v", d)
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	web.setConnectedStatus(false)
+	web.fieldMutex.RLock()
+	disconnectedAt := web.disconnectedAt
+	web.fieldMutex.RUnlock()
+	assert.False(t, disconnectedAt.IsZero(), "disconnectedAt should be set when the connection is lost")
+
+	assert.NoError(t, web.Connect(), "Connect should not error")
+	select {
+	case d := <-web.ToRoutine:
+		r, ok := d.(Reconnected)
+		if !ok {
+			t.Fatalf("received %T, expected Reconnected", d)
+		}
+		assert.Equal(t, "test", r.Exchange, "Exchange should be set")
+		assert.Equal(t, disconnectedAt, r.DisconnectedAt, "DisconnectedAt should be when the connection was lost")
+		assert.False(t, r.Timestamp.Before(disconnectedAt), "Timestamp should not be before DisconnectedAt")
+	case <-time.After(time.Second):
+		t.Fatal("expected reconnection notice")
+	}
+}
+
 func TestSetupNewConnection(t *testing.T) {
 	t.Parallel()
 	var nonsenseWebsock *Websocket
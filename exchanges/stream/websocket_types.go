@@ -33,6 +33,8 @@ type Websocket struct {
 	Init                         bool
 	connected                    bool
 	connecting                   bool
+	hasConnected                 bool
+	disconnectedAt               time.Time
 	verbose                      bool
 	connectionMonitorRunning     bool
 	trafficMonitorRunning        bool
@@ -0,0 +1,341 @@
+package triangular
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// NewGraph builds the currency graph formed by a set of pairs and finds every
+// triangular cycle within it. Each cycle is found in both directions
+func NewGraph(pairs currency.Pairs) (*Graph, error) {
+	if len(pairs) == 0 {
+		return nil, errNoPairs
+	}
+	edges := make(map[*currency.Item]map[*currency.Item]Leg)
+	codes := make(map[*currency.Item]currency.Code)
+	addEdge := func(l Leg) {
+		to, ok := edges[l.From.Item]
+		if !ok {
+			to = make(map[*currency.Item]Leg)
+			edges[l.From.Item] = to
+		}
+		if _, ok = to[l.To.Item]; !ok {
+			to[l.To.Item] = l
+		}
+		codes[l.From.Item] = l.From
+	}
+	for i := range pairs {
+		if pairs[i].IsEmpty() || pairs[i].Base.Equal(pairs[i].Quote) {
+			continue
+		}
+		addEdge(Leg{Pair: pairs[i], Side: order.Buy, From: pairs[i].Quote, To: pairs[i].Base})
+		addEdge(Leg{Pair: pairs[i], Side: order.Sell, From: pairs[i].Base, To: pairs[i].Quote})
+	}
+
+	// Currencies are visited in order so that cycles are found
+	// deterministically, each cycle is only recorded from its lowest currency
+	// to avoid duplicate rotations
+	sorted := make([]currency.Code, 0, len(codes))
+	for _, c := range codes {
+		sorted = append(sorted, c)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].String() < sorted[j].String()
+	})
+	g := &Graph{byPair: make(map[pairKey][]int)}
+	for _, a := range sorted {
+		for _, b := range sorted {
+			ab, ok := edges[a.Item][b.Item]
+			if !ok || b.String() <= a.String() {
+				continue
+			}
+			for _, c := range sorted {
+				if c.String() <= a.String() || c.Item == b.Item {
+					continue
+				}
+				bc, ok := edges[b.Item][c.Item]
+				if !ok {
+					continue
+				}
+				ca, ok := edges[c.Item][a.Item]
+				if !ok {
+					continue
+				}
+				g.add(Cycle{ab, bc, ca})
+			}
+		}
+	}
+	if len(g.cycles) == 0 {
+		return nil, errNoCycles
+	}
+	return g, nil
+}
+
+// add records a cycle and indexes it by each of its pairs
+func (g *Graph) add(c Cycle) {
+	idx := len(g.cycles)
+	g.cycles = append(g.cycles, c)
+	for i := range c {
+		k := newPairKey(c[i].Pair)
+		g.byPair[k] = append(g.byPair[k], idx)
+	}
+}
+
+// newPairKey returns the key of a pair
+func newPairKey(p currency.Pair) pairKey {
+	return pairKey{base: p.Base.Item, quote: p.Quote.Item}
+}
+
+// Cycles returns every cycle in the graph
+func (g *Graph) Cycles() []Cycle {
+	resp := make([]Cycle, len(g.cycles))
+	copy(resp, g.cycles)
+	return resp
+}
+
+// CyclesWithPair returns the cycles which trade a pair, allowing only the
+// cycles affected by an orderbook update to be evaluated
+func (g *Graph) CyclesWithPair(p currency.Pair) []Cycle {
+	idx := g.byPair[newPairKey(p)]
+	resp := make([]Cycle, len(idx))
+	for i := range idx {
+		resp[i] = g.cycles[idx[i]]
+	}
+	return resp
+}
+
+// Pairs returns every pair used by the graph's cycles
+func (g *Graph) Pairs() currency.Pairs {
+	resp := make(currency.Pairs, 0, len(g.byPair))
+	for i := range g.cycles {
+		for j := range g.cycles[i] {
+			if !resp.Contains(g.cycles[i][j].Pair, true) {
+				resp = append(resp, g.cycles[i][j].Pair)
+			}
+		}
+	}
+	return resp
+}
+
+// String returns the currencies of the cycle in the order they are converted
+func (c Cycle) String() string {
+	if len(c) == 0 {
+		return ""
+	}
+	codes := make([]string, 0, len(c)+1)
+	for i := range c {
+		codes = append(codes, c[i].From.String())
+	}
+	codes = append(codes, c[len(c)-1].To.String())
+	return strings.Join(codes, " -> ")
+}
+
+// Rotate returns the cycle starting and ending in the supplied currency
+func (c Cycle) Rotate(start currency.Code) (Cycle, error) {
+	for i := range c {
+		if !c[i].From.Equal(start) {
+			continue
+		}
+		resp := make(Cycle, 0, len(c))
+		resp = append(resp, c[i:]...)
+		return append(resp, c[:i]...), nil
+	}
+	return nil, fmt.Errorf("%w %s: %s", errCurrencyNotInCycle, start, c)
+}
+
+// Evaluate converts an amount of the cycle's starting currency through each
+// leg by walking the orderbook depth, charging the fee rate on the amount
+// purchased by each leg
+func (c Cycle) Evaluate(depth DepthFunc, feeRate, amount float64) (*Opportunity, error) {
+	if len(c) != 3 {
+		return nil, errInvalidCycle
+	}
+	if depth == nil {
+		return nil, errNilDepthFunc
+	}
+	if feeRate < 0 || feeRate >= 1 {
+		return nil, fmt.Errorf("%w: %v", errInvalidFeeRate, feeRate)
+	}
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w: %v", errInvalidAmount, amount)
+	}
+	o := &Opportunity{
+		Cycle:       c,
+		StartAmount: amount,
+		Legs:        make([]LegResult, len(c)),
+	}
+	for i := range c {
+		d, err := depth(c[i].Pair)
+		if err != nil {
+			return nil, err
+		}
+		l := LegResult{Leg: c[i], AmountIn: amount}
+		if c[i].Side == order.Buy {
+			m, err := d.LiftTheAsksFromBest(amount, false)
+			if err != nil {
+				return nil, fmt.Errorf("%s %w", c[i].Pair, err)
+			}
+			if m.Sold < amount*(1-consumedTolerance) {
+				return nil, fmt.Errorf("%s %w", c[i].Pair, ErrInsufficientLiquidity)
+			}
+			l.BaseAmount = m.Purchased
+			l.AveragePrice = amount / m.Purchased
+			l.Fee = m.Purchased * feeRate
+			l.AmountOut = m.Purchased - l.Fee
+		} else {
+			m, err := d.HitTheBidsFromBest(amount, false)
+			if err != nil {
+				return nil, fmt.Errorf("%s %w", c[i].Pair, err)
+			}
+			if m.Sold < amount*(1-consumedTolerance) {
+				return nil, fmt.Errorf("%s %w", c[i].Pair, ErrInsufficientLiquidity)
+			}
+			l.BaseAmount = amount
+			l.AveragePrice = m.Purchased / amount
+			l.Fee = m.Purchased * feeRate
+			l.AmountOut = m.Purchased - l.Fee
+		}
+		o.Legs[i] = l
+		amount = l.AmountOut
+	}
+	o.EndAmount = amount
+	o.Profit = o.EndAmount - o.StartAmount
+	o.ProfitBasisPoints = o.Profit / o.StartAmount * 10000
+	return o, nil
+}
+
+// FindBest returns the most profitable executable size of the cycle up to
+// maxAmount of its starting currency, or up to the depth of the first leg
+// when maxAmount is zero. As each leg returns less per unit the deeper it
+// walks the orderbook, profit is concave in the starting amount and is
+// maximised with a ternary search
+func (c Cycle) FindBest(depth DepthFunc, feeRate, maxAmount float64) (*Opportunity, error) {
+	if len(c) != 3 {
+		return nil, errInvalidCycle
+	}
+	if depth == nil {
+		return nil, errNilDepthFunc
+	}
+	if feeRate < 0 || feeRate >= 1 {
+		return nil, fmt.Errorf("%w: %v", errInvalidFeeRate, feeRate)
+	}
+
+	// The best price of each leg gives the most favourable rate, if the cycle
+	// is not profitable at the top of the book it cannot be at any size
+	rate := 1.0
+	for i := range c {
+		d, err := depth(c[i].Pair)
+		if err != nil {
+			return nil, err
+		}
+		if c[i].Side == order.Buy {
+			ask, err := d.GetBestAsk()
+			if err != nil {
+				return nil, fmt.Errorf("%s %w", c[i].Pair, err)
+			}
+			rate /= ask
+		} else {
+			bid, err := d.GetBestBid()
+			if err != nil {
+				return nil, fmt.Errorf("%s %w", c[i].Pair, err)
+			}
+			rate *= bid
+		}
+		rate *= 1 - feeRate
+	}
+	if rate <= 1 {
+		return nil, ErrNotProfitable
+	}
+
+	d, err := depth(c[0].Pair)
+	if err != nil {
+		return nil, err
+	}
+	var capacity float64
+	if c[0].Side == order.Buy {
+		_, capacity, err = d.TotalAskAmounts()
+	} else {
+		capacity, _, err = d.TotalBidAmounts()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if maxAmount > 0 && maxAmount < capacity {
+		capacity = maxAmount
+	}
+	if capacity <= 0 {
+		return nil, fmt.Errorf("%s %w", c[0].Pair, ErrInsufficientLiquidity)
+	}
+
+	profit := func(amount float64) float64 {
+		o, err := c.Evaluate(depth, feeRate, amount)
+		if err != nil {
+			// Amounts which exhaust the liquidity of a later leg cannot be
+			// executed
+			return math.Inf(-1)
+		}
+		return o.Profit
+	}
+	low, high := 0.0, capacity
+	for i := 0; i < searchIterations; i++ {
+		m1 := low + (high-low)/3
+		m2 := high - (high-low)/3
+		if profit(m1) < profit(m2) {
+			low = m1
+		} else {
+			high = m2
+		}
+	}
+	o, err := c.Evaluate(depth, feeRate, (low+high)/2)
+	if err != nil {
+		return nil, err
+	}
+	if o.Profit <= 0 {
+		return nil, ErrNotProfitable
+	}
+	return o, nil
+}
+
+// Evaluate finds the most profitable executable size of each cycle, returning
+// the opportunities which meet the parameters ordered by their return
+func Evaluate(cycles []Cycle, depth DepthFunc, p *Params) ([]Opportunity, error) {
+	if depth == nil {
+		return nil, errNilDepthFunc
+	}
+	if p == nil {
+		return nil, errNilParams
+	}
+	if p.FeeRate < 0 || p.FeeRate >= 1 {
+		return nil, fmt.Errorf("%w: %v", errInvalidFeeRate, p.FeeRate)
+	}
+	var resp []Opportunity
+	for i := range cycles {
+		c := cycles[i]
+		maxAmount := 0.0
+		if !p.Start.IsEmpty() {
+			var err error
+			c, err = c.Rotate(p.Start)
+			if err != nil {
+				continue
+			}
+			maxAmount = p.MaximumAmount
+		}
+		o, err := c.FindBest(depth, p.FeeRate, maxAmount)
+		if err != nil {
+			continue
+		}
+		if o.ProfitBasisPoints < p.MinimumProfitBasisPoints {
+			continue
+		}
+		resp = append(resp, *o)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].ProfitBasisPoints > resp[j].ProfitBasisPoints
+	})
+	return resp, nil
+}
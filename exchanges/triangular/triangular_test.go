@@ -0,0 +1,173 @@
+package triangular
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var (
+	btcusdt = currency.NewPair(currency.BTC, currency.USDT)
+	ethusdt = currency.NewPair(currency.ETH, currency.USDT)
+	ethbtc  = currency.NewPair(currency.ETH, currency.BTC)
+)
+
+func newTestDepth(t *testing.T, bids, asks orderbook.Items) *orderbook.Depth {
+	t.Helper()
+	id, err := uuid.NewV4()
+	require.NoError(t, err, "NewV4 must not error")
+	d := orderbook.NewDepth(id)
+	require.NoError(t, d.LoadSnapshot(bids, asks, 0, time.Now(), true), "LoadSnapshot must not error")
+	return d
+}
+
+// newTestMarket returns depth where buying ETH with BTC, selling it for USDT
+// and buying BTC back returns 10% at the top of the book
+func newTestMarket(t *testing.T) DepthFunc {
+	t.Helper()
+	depths := map[currency.Pair]*orderbook.Depth{
+		btcusdt: newTestDepth(t, orderbook.Items{{Price: 99.9, Amount: 100}}, orderbook.Items{{Price: 100, Amount: 100}}),
+		ethusdt: newTestDepth(t, orderbook.Items{{Price: 11, Amount: 100}}, orderbook.Items{{Price: 11.1, Amount: 100}}),
+		ethbtc:  newTestDepth(t, orderbook.Items{{Price: 0.099, Amount: 100}}, orderbook.Items{{Price: 0.1, Amount: 5}, {Price: 0.105, Amount: 100}}),
+	}
+	return func(p currency.Pair) (*orderbook.Depth, error) {
+		d, ok := depths[p]
+		if !ok {
+			return nil, errors.New("depth not found")
+		}
+		return d, nil
+	}
+}
+
+func TestNewGraph(t *testing.T) {
+	t.Parallel()
+	_, err := NewGraph(nil)
+	assert.ErrorIs(t, err, errNoPairs, "NewGraph should error without pairs")
+	_, err = NewGraph(currency.Pairs{btcusdt, ethusdt})
+	assert.ErrorIs(t, err, errNoCycles, "NewGraph should error when pairs do not form a cycle")
+
+	g, err := NewGraph(currency.Pairs{btcusdt, ethusdt, ethbtc, currency.NewPair(currency.LTC, currency.USDT)})
+	require.NoError(t, err, "NewGraph must not error")
+	cycles := g.Cycles()
+	require.Len(t, cycles, 2, "Cycles must return both directions of the triangle")
+	assert.Equal(t, "BTC -> ETH -> USDT -> BTC", cycles[0].String(), "Cycle should be correct")
+	assert.Equal(t, "BTC -> USDT -> ETH -> BTC", cycles[1].String(), "Cycle should be correct")
+	assert.Equal(t, order.Buy, cycles[0][0].Side, "Buying ETH with BTC should lift the asks")
+	assert.Equal(t, order.Sell, cycles[0][1].Side, "Selling ETH for USDT should hit the bids")
+	assert.Len(t, g.CyclesWithPair(ethbtc), 2, "CyclesWithPair should return cycles trading the pair")
+	assert.Empty(t, g.CyclesWithPair(currency.NewPair(currency.LTC, currency.USDT)), "CyclesWithPair should not return cycles for unused pairs")
+	assert.Len(t, g.Pairs(), 3, "Pairs should only return pairs used by cycles")
+}
+
+func TestCycleRotate(t *testing.T) {
+	t.Parallel()
+	g, err := NewGraph(currency.Pairs{btcusdt, ethusdt, ethbtc})
+	require.NoError(t, err, "NewGraph must not error")
+	c, err := g.Cycles()[0].Rotate(currency.USDT)
+	require.NoError(t, err, "Rotate must not error")
+	assert.Equal(t, "USDT -> BTC -> ETH -> USDT", c.String(), "Rotate should start the cycle in the currency")
+	_, err = c.Rotate(currency.LTC)
+	assert.ErrorIs(t, err, errCurrencyNotInCycle, "Rotate should error for a currency outside the cycle")
+	assert.Empty(t, Cycle{}.String(), "String should be empty for an empty cycle")
+}
+
+func TestCycleEvaluate(t *testing.T) {
+	t.Parallel()
+	depth := newTestMarket(t)
+	g, err := NewGraph(currency.Pairs{btcusdt, ethusdt, ethbtc})
+	require.NoError(t, err, "NewGraph must not error")
+	c := g.Cycles()[0]
+
+	_, err = Cycle{}.Evaluate(depth, 0, 1)
+	assert.ErrorIs(t, err, errInvalidCycle, "Evaluate should error on an invalid cycle")
+	_, err = c.Evaluate(nil, 0, 1)
+	assert.ErrorIs(t, err, errNilDepthFunc, "Evaluate should error on nil depth func")
+	_, err = c.Evaluate(depth, 1, 1)
+	assert.ErrorIs(t, err, errInvalidFeeRate, "Evaluate should error on an invalid fee rate")
+	_, err = c.Evaluate(depth, 0, 0)
+	assert.ErrorIs(t, err, errInvalidAmount, "Evaluate should error on an invalid amount")
+	_, err = c.Evaluate(depth, 0, 20)
+	assert.ErrorIs(t, err, ErrInsufficientLiquidity, "Evaluate should error when depth is exhausted")
+
+	o, err := c.Evaluate(depth, 0.001, 1)
+	require.NoError(t, err, "Evaluate must not error")
+	require.Len(t, o.Legs, 3, "Legs must be returned")
+	// 0.5 BTC buys 5 ETH at 0.1, the remaining 0.5 BTC buys at 0.105
+	eth := 5 + 0.5/0.105
+	assert.InDelta(t, eth, o.Legs[0].BaseAmount, 1e-9, "BaseAmount should walk the depth")
+	assert.InDelta(t, 1/eth, o.Legs[0].AveragePrice, 1e-9, "AveragePrice should be correct")
+	assert.InDelta(t, eth*0.001, o.Legs[0].Fee, 1e-9, "Fee should be charged on the amount purchased")
+	eth *= 0.999
+	assert.InDelta(t, eth, o.Legs[1].AmountIn, 1e-9, "AmountIn should be the previous leg's output")
+	assert.InDelta(t, eth, o.Legs[1].BaseAmount, 1e-9, "BaseAmount should be the amount sold")
+	usdt := eth * 11 * 0.999
+	assert.InDelta(t, usdt, o.Legs[2].AmountIn, 1e-9, "AmountIn should be the previous leg's output")
+	assert.InDelta(t, usdt/100*0.999, o.EndAmount, 1e-9, "EndAmount should be correct")
+	assert.InDelta(t, o.EndAmount-1, o.Profit, 1e-9, "Profit should be correct")
+	assert.InDelta(t, o.Profit*10000, o.ProfitBasisPoints, 1e-9, "ProfitBasisPoints should be correct")
+}
+
+func TestCycleFindBest(t *testing.T) {
+	t.Parallel()
+	depth := newTestMarket(t)
+	g, err := NewGraph(currency.Pairs{btcusdt, ethusdt, ethbtc})
+	require.NoError(t, err, "NewGraph must not error")
+	cycles := g.Cycles()
+
+	_, err = cycles[0].FindBest(depth, 0.04, 0)
+	assert.ErrorIs(t, err, ErrNotProfitable, "FindBest should error when fees exceed the top of book return")
+	_, err = cycles[1].FindBest(depth, 0, 0)
+	assert.ErrorIs(t, err, ErrNotProfitable, "FindBest should error for the unprofitable direction")
+
+	// Profit grows until the 100 ETH of ETH-USDT bids are exhausted
+	o, err := cycles[0].FindBest(depth, 0, 0)
+	require.NoError(t, err, "FindBest must not error")
+	assert.InDelta(t, 0.5+95*0.105, o.StartAmount, 1e-6, "StartAmount should be limited by the second leg's depth")
+	assert.InDelta(t, 100, o.Legs[1].BaseAmount, 1e-6, "Second leg should consume the bids")
+	assert.InDelta(t, 11-(0.5+95*0.105), o.Profit, 1e-6, "Profit should be correct")
+
+	o, err = cycles[0].FindBest(depth, 0, 0.25)
+	require.NoError(t, err, "FindBest must not error")
+	assert.InDelta(t, 0.25, o.StartAmount, 1e-6, "StartAmount should be limited by the maximum amount")
+	assert.InDelta(t, 0.025, o.Profit, 1e-6, "Profit should be correct")
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	depth := newTestMarket(t)
+	g, err := NewGraph(currency.Pairs{btcusdt, ethusdt, ethbtc})
+	require.NoError(t, err, "NewGraph must not error")
+
+	_, err = Evaluate(g.Cycles(), nil, &Params{})
+	assert.ErrorIs(t, err, errNilDepthFunc, "Evaluate should error on nil depth func")
+	_, err = Evaluate(g.Cycles(), depth, nil)
+	assert.ErrorIs(t, err, errNilParams, "Evaluate should error on nil params")
+	_, err = Evaluate(g.Cycles(), depth, &Params{FeeRate: -1})
+	assert.ErrorIs(t, err, errInvalidFeeRate, "Evaluate should error on an invalid fee rate")
+
+	opps, err := Evaluate(g.Cycles(), depth, &Params{FeeRate: 0.001})
+	require.NoError(t, err, "Evaluate must not error")
+	require.Len(t, opps, 1, "Evaluate must only return profitable cycles")
+	assert.Equal(t, "BTC -> ETH -> USDT -> BTC", opps[0].Cycle.String(), "Cycle should be correct")
+
+	opps, err = Evaluate(g.Cycles(), depth, &Params{Start: currency.USDT, MaximumAmount: 10})
+	require.NoError(t, err, "Evaluate must not error")
+	require.Len(t, opps, 1, "Evaluate must return the rotated cycle")
+	assert.Equal(t, "USDT -> BTC -> ETH -> USDT", opps[0].Cycle.String(), "Cycle should start in the requested currency")
+	assert.InDelta(t, 10, opps[0].StartAmount, 1e-6, "StartAmount should be limited by the maximum amount")
+	assert.InDelta(t, 1000, opps[0].ProfitBasisPoints, 1e-6, "ProfitBasisPoints should be correct")
+
+	opps, err = Evaluate(g.Cycles(), depth, &Params{MinimumProfitBasisPoints: 10000})
+	require.NoError(t, err, "Evaluate must not error")
+	assert.Empty(t, opps, "Evaluate should exclude opportunities below the minimum return")
+	opps, err = Evaluate(g.Cycles(), depth, &Params{Start: currency.LTC})
+	require.NoError(t, err, "Evaluate must not error")
+	assert.Empty(t, opps, "Evaluate should exclude cycles without the start currency")
+}
@@ -0,0 +1,103 @@
+package triangular
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const (
+	// searchIterations is the number of ternary search iterations used to
+	// find the most profitable starting amount of a cycle
+	searchIterations = 100
+	// consumedTolerance allows for floating point error when checking whether
+	// a leg consumed its full input amount
+	consumedTolerance = 1e-9
+)
+
+var (
+	// ErrNotProfitable is returned when a cycle cannot be executed at a
+	// profit at any size after fees
+	ErrNotProfitable = errors.New("cycle is not profitable after fees")
+	// ErrInsufficientLiquidity is returned when a leg of a cycle does not have
+	// enough orderbook depth to convert its full input amount
+	ErrInsufficientLiquidity = errors.New("insufficient orderbook liquidity")
+
+	errNoPairs            = errors.New("no pairs supplied")
+	errNoCycles           = errors.New("pairs do not form any triangular cycles")
+	errNilDepthFunc       = errors.New("nil depth function")
+	errNilParams          = errors.New("nil scan parameters")
+	errInvalidFeeRate     = errors.New("fee rate must be between 0 and 1")
+	errInvalidAmount      = errors.New("amount must be greater than zero")
+	errInvalidCycle       = errors.New("cycle must contain three legs")
+	errCurrencyNotInCycle = errors.New("currency is not part of the cycle")
+)
+
+// DepthFunc returns the orderbook depth of a pair used to evaluate a leg
+type DepthFunc func(currency.Pair) (*orderbook.Depth, error)
+
+// Graph holds every triangular cycle which can be formed from a set of pairs
+// traded on a single exchange
+type Graph struct {
+	cycles []Cycle
+	byPair map[pairKey][]int
+}
+
+// pairKey identifies a pair regardless of formatting
+type pairKey struct {
+	base  *currency.Item
+	quote *currency.Item
+}
+
+// Leg converts one currency into another by trading a pair. Buying spends the
+// quote currency to purchase the base currency and selling spends the base
+// currency to purchase the quote currency
+type Leg struct {
+	Pair currency.Pair
+	Side order.Side
+	From currency.Code
+	To   currency.Code
+}
+
+// Cycle is a series of three legs which starts and ends in the same currency
+type Cycle []Leg
+
+// Params defines how cycles are evaluated
+type Params struct {
+	// FeeRate is the taker fee rate charged on the amount purchased by each
+	// leg
+	FeeRate float64
+	// MinimumProfitBasisPoints excludes opportunities which return less than
+	// it relative to the starting amount
+	MinimumProfitBasisPoints float64
+	// Start only evaluates cycles which include the currency, rotated so that
+	// they start and end in it
+	Start currency.Code
+	// MaximumAmount limits the starting amount of a cycle, it is ignored
+	// unless Start is set as each cycle would otherwise start in a different
+	// currency
+	MaximumAmount float64
+}
+
+// Opportunity is the most profitable executable size of a cycle
+type Opportunity struct {
+	Cycle             Cycle
+	StartAmount       float64
+	EndAmount         float64
+	Profit            float64
+	ProfitBasisPoints float64
+	Legs              []LegResult
+}
+
+// LegResult holds the amounts converted by a leg of a cycle
+type LegResult struct {
+	Leg
+	AmountIn  float64
+	AmountOut float64
+	// BaseAmount is the amount of the pair's base currency traded
+	BaseAmount   float64
+	AveragePrice float64
+	Fee          float64
+}
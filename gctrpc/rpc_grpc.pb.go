@@ -133,6 +133,45 @@ const (
 	GoCryptoTraderService_SetLeverage_FullMethodName                       = "/gctrpc.GoCryptoTraderService/SetLeverage"
 	GoCryptoTraderService_ChangePositionMargin_FullMethodName              = "/gctrpc.GoCryptoTraderService/ChangePositionMargin"
 	GoCryptoTraderService_GetOpenInterest_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetOpenInterest"
+	GoCryptoTraderService_CreateExecutionOrder_FullMethodName              = "/gctrpc.GoCryptoTraderService/CreateExecutionOrder"
+	GoCryptoTraderService_PauseExecutionOrder_FullMethodName               = "/gctrpc.GoCryptoTraderService/PauseExecutionOrder"
+	GoCryptoTraderService_ResumeExecutionOrder_FullMethodName              = "/gctrpc.GoCryptoTraderService/ResumeExecutionOrder"
+	GoCryptoTraderService_CancelExecutionOrder_FullMethodName              = "/gctrpc.GoCryptoTraderService/CancelExecutionOrder"
+	GoCryptoTraderService_GetExecutionOrder_FullMethodName                 = "/gctrpc.GoCryptoTraderService/GetExecutionOrder"
+	GoCryptoTraderService_GetExecutionOrders_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetExecutionOrders"
+	GoCryptoTraderService_RouteOrder_FullMethodName                        = "/gctrpc.GoCryptoTraderService/RouteOrder"
+	GoCryptoTraderService_GetArbitrageOpportunityStream_FullMethodName     = "/gctrpc.GoCryptoTraderService/GetArbitrageOpportunityStream"
+	GoCryptoTraderService_GetTriangularArbitrageStream_FullMethodName      = "/gctrpc.GoCryptoTraderService/GetTriangularArbitrageStream"
+	GoCryptoTraderService_AddMarketMakingStrategy_FullMethodName           = "/gctrpc.GoCryptoTraderService/AddMarketMakingStrategy"
+	GoCryptoTraderService_RemoveMarketMakingStrategy_FullMethodName        = "/gctrpc.GoCryptoTraderService/RemoveMarketMakingStrategy"
+	GoCryptoTraderService_GetMarketMakingStrategy_FullMethodName           = "/gctrpc.GoCryptoTraderService/GetMarketMakingStrategy"
+	GoCryptoTraderService_GetMarketMakingStrategies_FullMethodName         = "/gctrpc.GoCryptoTraderService/GetMarketMakingStrategies"
+	GoCryptoTraderService_CreateGrid_FullMethodName                        = "/gctrpc.GoCryptoTraderService/CreateGrid"
+	GoCryptoTraderService_StopGrid_FullMethodName                          = "/gctrpc.GoCryptoTraderService/StopGrid"
+	GoCryptoTraderService_GetGrid_FullMethodName                           = "/gctrpc.GoCryptoTraderService/GetGrid"
+	GoCryptoTraderService_GetGrids_FullMethodName                          = "/gctrpc.GoCryptoTraderService/GetGrids"
+	GoCryptoTraderService_SearchOrderHistory_FullMethodName                = "/gctrpc.GoCryptoTraderService/SearchOrderHistory"
+	GoCryptoTraderService_GetOrderAuditTrail_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetOrderAuditTrail"
+	GoCryptoTraderService_ReconcileOrders_FullMethodName                   = "/gctrpc.GoCryptoTraderService/ReconcileOrders"
+	GoCryptoTraderService_GetOrderReconciliations_FullMethodName           = "/gctrpc.GoCryptoTraderService/GetOrderReconciliations"
+	GoCryptoTraderService_AddRebalanceStrategy_FullMethodName              = "/gctrpc.GoCryptoTraderService/AddRebalanceStrategy"
+	GoCryptoTraderService_RemoveRebalanceStrategy_FullMethodName           = "/gctrpc.GoCryptoTraderService/RemoveRebalanceStrategy"
+	GoCryptoTraderService_GetRebalanceStrategies_FullMethodName            = "/gctrpc.GoCryptoTraderService/GetRebalanceStrategies"
+	GoCryptoTraderService_GetRebalancePlan_FullMethodName                  = "/gctrpc.GoCryptoTraderService/GetRebalancePlan"
+	GoCryptoTraderService_ExecuteRebalance_FullMethodName                  = "/gctrpc.GoCryptoTraderService/ExecuteRebalance"
+	GoCryptoTraderService_GetPortfolioSnapshots_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetPortfolioSnapshots"
+	GoCryptoTraderService_GetPortfolioPerformance_FullMethodName           = "/gctrpc.GoCryptoTraderService/GetPortfolioPerformance"
+	GoCryptoTraderService_GetAccountingFills_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetAccountingFills"
+	GoCryptoTraderService_GetFeeSchedule_FullMethodName                    = "/gctrpc.GoCryptoTraderService/GetFeeSchedule"
+	GoCryptoTraderService_EstimateOrderCost_FullMethodName                 = "/gctrpc.GoCryptoTraderService/EstimateOrderCost"
+	GoCryptoTraderService_GetFundingRateMonitorRates_FullMethodName        = "/gctrpc.GoCryptoTraderService/GetFundingRateMonitorRates"
+	GoCryptoTraderService_GetFundingRateOpportunityStream_FullMethodName   = "/gctrpc.GoCryptoTraderService/GetFundingRateOpportunityStream"
+	GoCryptoTraderService_GetLiquidationMonitorPositions_FullMethodName    = "/gctrpc.GoCryptoTraderService/GetLiquidationMonitorPositions"
+	GoCryptoTraderService_GetLiquidationMonitorStream_FullMethodName       = "/gctrpc.GoCryptoTraderService/GetLiquidationMonitorStream"
+	GoCryptoTraderService_GetOptionChain_FullMethodName                    = "/gctrpc.GoCryptoTraderService/GetOptionChain"
+	GoCryptoTraderService_GetOptionGreeks_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetOptionGreeks"
+	GoCryptoTraderService_GetEventStream_FullMethodName                    = "/gctrpc.GoCryptoTraderService/GetEventStream"
+	GoCryptoTraderService_GetEventBusMetrics_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetEventBusMetrics"
 )
 
 // GoCryptoTraderServiceClient is the client API for GoCryptoTraderService service.
@@ -253,6 +292,45 @@ type GoCryptoTraderServiceClient interface {
 	SetLeverage(ctx context.Context, in *SetLeverageRequest, opts ...grpc.CallOption) (*SetLeverageResponse, error)
 	ChangePositionMargin(ctx context.Context, in *ChangePositionMarginRequest, opts ...grpc.CallOption) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(ctx context.Context, in *GetOpenInterestRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error)
+	CreateExecutionOrder(ctx context.Context, in *CreateExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error)
+	PauseExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ResumeExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CancelExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error)
+	GetExecutionOrders(ctx context.Context, in *GetExecutionOrdersRequest, opts ...grpc.CallOption) (*GetExecutionOrdersResponse, error)
+	RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteOrderResponse, error)
+	GetArbitrageOpportunityStream(ctx context.Context, in *GetArbitrageOpportunityStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetArbitrageOpportunityStreamClient, error)
+	GetTriangularArbitrageStream(ctx context.Context, in *GetTriangularArbitrageStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetTriangularArbitrageStreamClient, error)
+	AddMarketMakingStrategy(ctx context.Context, in *AddMarketMakingStrategyRequest, opts ...grpc.CallOption) (*MarketMakingStrategy, error)
+	RemoveMarketMakingStrategy(ctx context.Context, in *MarketMakingStrategyRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetMarketMakingStrategy(ctx context.Context, in *MarketMakingStrategyRequest, opts ...grpc.CallOption) (*MarketMakingStrategy, error)
+	GetMarketMakingStrategies(ctx context.Context, in *GetMarketMakingStrategiesRequest, opts ...grpc.CallOption) (*GetMarketMakingStrategiesResponse, error)
+	CreateGrid(ctx context.Context, in *CreateGridRequest, opts ...grpc.CallOption) (*Grid, error)
+	StopGrid(ctx context.Context, in *GridRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetGrid(ctx context.Context, in *GridRequest, opts ...grpc.CallOption) (*Grid, error)
+	GetGrids(ctx context.Context, in *GetGridsRequest, opts ...grpc.CallOption) (*GetGridsResponse, error)
+	SearchOrderHistory(ctx context.Context, in *SearchOrderHistoryRequest, opts ...grpc.CallOption) (*SearchOrderHistoryResponse, error)
+	GetOrderAuditTrail(ctx context.Context, in *GetOrderAuditTrailRequest, opts ...grpc.CallOption) (*GetOrderAuditTrailResponse, error)
+	ReconcileOrders(ctx context.Context, in *ReconcileOrdersRequest, opts ...grpc.CallOption) (*OrderReconciliationReport, error)
+	GetOrderReconciliations(ctx context.Context, in *GetOrderReconciliationsRequest, opts ...grpc.CallOption) (*GetOrderReconciliationsResponse, error)
+	AddRebalanceStrategy(ctx context.Context, in *AddRebalanceStrategyRequest, opts ...grpc.CallOption) (*RebalanceStrategy, error)
+	RemoveRebalanceStrategy(ctx context.Context, in *RebalanceStrategyRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetRebalanceStrategies(ctx context.Context, in *GetRebalanceStrategiesRequest, opts ...grpc.CallOption) (*GetRebalanceStrategiesResponse, error)
+	GetRebalancePlan(ctx context.Context, in *RebalanceStrategyRequest, opts ...grpc.CallOption) (*RebalancePlan, error)
+	ExecuteRebalance(ctx context.Context, in *RebalanceStrategyRequest, opts ...grpc.CallOption) (*RebalancePlan, error)
+	GetPortfolioSnapshots(ctx context.Context, in *PortfolioDateRangeRequest, opts ...grpc.CallOption) (*GetPortfolioSnapshotsResponse, error)
+	GetPortfolioPerformance(ctx context.Context, in *PortfolioDateRangeRequest, opts ...grpc.CallOption) (*PortfolioPerformance, error)
+	GetAccountingFills(ctx context.Context, in *GetAccountingFillsRequest, opts ...grpc.CallOption) (*GetAccountingFillsResponse, error)
+	GetFeeSchedule(ctx context.Context, in *GetFeeScheduleRequest, opts ...grpc.CallOption) (*GetFeeScheduleResponse, error)
+	EstimateOrderCost(ctx context.Context, in *EstimateOrderCostRequest, opts ...grpc.CallOption) (*EstimateOrderCostResponse, error)
+	GetFundingRateMonitorRates(ctx context.Context, in *GetFundingRateMonitorRatesRequest, opts ...grpc.CallOption) (*GetFundingRateMonitorRatesResponse, error)
+	GetFundingRateOpportunityStream(ctx context.Context, in *GetFundingRateOpportunityStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetFundingRateOpportunityStreamClient, error)
+	GetLiquidationMonitorPositions(ctx context.Context, in *GetLiquidationMonitorPositionsRequest, opts ...grpc.CallOption) (*GetLiquidationMonitorPositionsResponse, error)
+	GetLiquidationMonitorStream(ctx context.Context, in *GetLiquidationMonitorStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetLiquidationMonitorStreamClient, error)
+	GetOptionChain(ctx context.Context, in *GetOptionChainRequest, opts ...grpc.CallOption) (*GetOptionChainResponse, error)
+	GetOptionGreeks(ctx context.Context, in *GetOptionGreeksRequest, opts ...grpc.CallOption) (*GetOptionGreeksResponse, error)
+	GetEventStream(ctx context.Context, in *GetEventStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetEventStreamClient, error)
+	GetEventBusMetrics(ctx context.Context, in *GetEventBusMetricsRequest, opts ...grpc.CallOption) (*GetEventBusMetricsResponse, error)
 }
 
 type goCryptoTraderServiceClient struct {
@@ -1427,403 +1505,908 @@ func (c *goCryptoTraderServiceClient) GetOpenInterest(ctx context.Context, in *G
 	return out, nil
 }
 
-// GoCryptoTraderServiceServer is the server API for GoCryptoTraderService service.
-// All implementations must embed UnimplementedGoCryptoTraderServiceServer
-// for forward compatibility
-type GoCryptoTraderServiceServer interface {
-	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
-	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
-	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
-	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
-	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
-	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
-	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
-	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
-	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
-	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPResponse, error)
-	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
-	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
-	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
-	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
-	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
-	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
-	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
-	UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
-	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTraderService_GetAccountInfoStreamServer) error
-	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
-	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
-	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
-	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error)
-	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error)
-	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
-	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
-	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
-	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
-	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
-	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
-	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
-	CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error)
-	CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error)
-	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
-	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
-	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
-	RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error)
-	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
-	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
-	GetAvailableTransferChains(context.Context, *GetAvailableTransferChainsRequest) (*GetAvailableTransferChainsResponse, error)
-	WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error)
-	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error)
-	WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error)
-	WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error)
-	WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error)
-	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
-	SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error)
-	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTraderService_GetOrderbookStreamServer) error
-	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTraderService_GetExchangeOrderbookStreamServer) error
-	GetTickerStream(*GetTickerStreamRequest, GoCryptoTraderService_GetTickerStreamServer) error
-	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTraderService_GetExchangeTickerStreamServer) error
-	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
-	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error)
-	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error)
-	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GenericResponse, error)
-	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error)
-	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error)
-	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
-	SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error)
-	SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error)
-	UpdateExchangeSupportedPairs(context.Context, *UpdateExchangeSupportedPairsRequest) (*GenericResponse, error)
-	GetExchangeAssets(context.Context, *GetExchangeAssetsRequest) (*GetExchangeAssetsResponse, error)
-	WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error)
-	WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error)
-	WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error)
-	WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error)
-	WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error)
-	GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
-	GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTraderService_GetHistoricTradesServer) error
-	GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
-	ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error)
-	FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error)
-	FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error)
-	SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error)
-	UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error)
-	GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
-	GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error)
-	GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error)
-	GetDataHistoryJobSummary(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
-	SetDataHistoryJobStatus(context.Context, *SetDataHistoryJobStatusRequest) (*GenericResponse, error)
-	UpdateDataHistoryJobPrerequisite(context.Context, *UpdateDataHistoryJobPrerequisiteRequest) (*GenericResponse, error)
-	GetManagedOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
-	ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error)
-	CurrencyStateGetAll(context.Context, *CurrencyStateGetAllRequest) (*CurrencyStateResponse, error)
-	CurrencyStateTrading(context.Context, *CurrencyStateTradingRequest) (*GenericResponse, error)
-	CurrencyStateDeposit(context.Context, *CurrencyStateDepositRequest) (*GenericResponse, error)
-	CurrencyStateWithdraw(context.Context, *CurrencyStateWithdrawRequest) (*GenericResponse, error)
-	CurrencyStateTradingPair(context.Context, *CurrencyStateTradingPairRequest) (*GenericResponse, error)
-	GetFuturesPositionsSummary(context.Context, *GetFuturesPositionsSummaryRequest) (*GetFuturesPositionsSummaryResponse, error)
-	GetFuturesPositionsOrders(context.Context, *GetFuturesPositionsOrdersRequest) (*GetFuturesPositionsOrdersResponse, error)
-	GetCollateral(context.Context, *GetCollateralRequest) (*GetCollateralResponse, error)
-	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
-	GetTechnicalAnalysis(context.Context, *GetTechnicalAnalysisRequest) (*GetTechnicalAnalysisResponse, error)
-	GetMarginRatesHistory(context.Context, *GetMarginRatesHistoryRequest) (*GetMarginRatesHistoryResponse, error)
-	GetManagedPosition(context.Context, *GetManagedPositionRequest) (*GetManagedPositionsResponse, error)
-	GetAllManagedPositions(context.Context, *GetAllManagedPositionsRequest) (*GetManagedPositionsResponse, error)
-	GetFundingRates(context.Context, *GetFundingRatesRequest) (*GetFundingRatesResponse, error)
-	GetLatestFundingRate(context.Context, *GetLatestFundingRateRequest) (*GetLatestFundingRateResponse, error)
-	GetOrderbookMovement(context.Context, *GetOrderbookMovementRequest) (*GetOrderbookMovementResponse, error)
-	GetOrderbookAmountByNominal(context.Context, *GetOrderbookAmountByNominalRequest) (*GetOrderbookAmountByNominalResponse, error)
-	GetOrderbookAmountByImpact(context.Context, *GetOrderbookAmountByImpactRequest) (*GetOrderbookAmountByImpactResponse, error)
-	GetCollateralMode(context.Context, *GetCollateralModeRequest) (*GetCollateralModeResponse, error)
-	GetLeverage(context.Context, *GetLeverageRequest) (*GetLeverageResponse, error)
-	SetCollateralMode(context.Context, *SetCollateralModeRequest) (*SetCollateralModeResponse, error)
-	SetMarginType(context.Context, *SetMarginTypeRequest) (*SetMarginTypeResponse, error)
-	SetLeverage(context.Context, *SetLeverageRequest) (*SetLeverageResponse, error)
-	ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error)
-	GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error)
-	mustEmbedUnimplementedGoCryptoTraderServiceServer()
+func (c *goCryptoTraderServiceClient) CreateExecutionOrder(ctx context.Context, in *CreateExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error) {
+	out := new(ExecutionOrder)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CreateExecutionOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedGoCryptoTraderServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedGoCryptoTraderServiceServer struct {
+func (c *goCryptoTraderServiceClient) PauseExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_PauseExecutionOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedGoCryptoTraderServiceServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
-}
-func (UnimplementedGoCryptoTraderServiceServer) GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
+func (c *goCryptoTraderServiceClient) ResumeExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ResumeExecutionOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
+
+func (c *goCryptoTraderServiceClient) CancelExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CancelExecutionOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
+
+func (c *goCryptoTraderServiceClient) GetExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrder, error) {
+	out := new(ExecutionOrder)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetExecutionOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
+
+func (c *goCryptoTraderServiceClient) GetExecutionOrders(ctx context.Context, in *GetExecutionOrdersRequest, opts ...grpc.CallOption) (*GetExecutionOrdersResponse, error) {
+	out := new(GetExecutionOrdersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetExecutionOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+
+func (c *goCryptoTraderServiceClient) RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteOrderResponse, error) {
+	out := new(RouteOrderResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RouteOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+
+func (c *goCryptoTraderServiceClient) GetArbitrageOpportunityStream(ctx context.Context, in *GetArbitrageOpportunityStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetArbitrageOpportunityStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[6], GoCryptoTraderService_GetArbitrageOpportunityStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetArbitrageOpportunityStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+
+type GoCryptoTraderService_GetArbitrageOpportunityStreamClient interface {
+	Recv() (*ArbitrageOpportunity, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+
+type goCryptoTraderServiceGetArbitrageOpportunityStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+
+func (x *goCryptoTraderServiceGetArbitrageOpportunityStreamClient) Recv() (*ArbitrageOpportunity, error) {
+	m := new(ArbitrageOpportunity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+
+func (c *goCryptoTraderServiceClient) GetTriangularArbitrageStream(ctx context.Context, in *GetTriangularArbitrageStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetTriangularArbitrageStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[7], GoCryptoTraderService_GetTriangularArbitrageStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetTriangularArbitrageStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+
+type GoCryptoTraderService_GetTriangularArbitrageStreamClient interface {
+	Recv() (*TriangularArbitrageOpportunity, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+
+type goCryptoTraderServiceGetTriangularArbitrageStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+
+func (x *goCryptoTraderServiceGetTriangularArbitrageStreamClient) Recv() (*TriangularArbitrageOpportunity, error) {
+	m := new(TriangularArbitrageOpportunity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+
+func (c *goCryptoTraderServiceClient) AddMarketMakingStrategy(ctx context.Context, in *AddMarketMakingStrategyRequest, opts ...grpc.CallOption) (*MarketMakingStrategy, error) {
+	out := new(MarketMakingStrategy)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_AddMarketMakingStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+
+func (c *goCryptoTraderServiceClient) RemoveMarketMakingStrategy(ctx context.Context, in *MarketMakingStrategyRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RemoveMarketMakingStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+
+func (c *goCryptoTraderServiceClient) GetMarketMakingStrategy(ctx context.Context, in *MarketMakingStrategyRequest, opts ...grpc.CallOption) (*MarketMakingStrategy, error) {
+	out := new(MarketMakingStrategy)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetMarketMakingStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountInfo not implemented")
+
+func (c *goCryptoTraderServiceClient) GetMarketMakingStrategies(ctx context.Context, in *GetMarketMakingStrategiesRequest, opts ...grpc.CallOption) (*GetMarketMakingStrategiesResponse, error) {
+	out := new(GetMarketMakingStrategiesResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetMarketMakingStrategies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTraderService_GetAccountInfoStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
-}
-func (UnimplementedGoCryptoTraderServiceServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
-}
-func (UnimplementedGoCryptoTraderServiceServer) GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
-}
-func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+
+func (c *goCryptoTraderServiceClient) CreateGrid(ctx context.Context, in *CreateGridRequest, opts ...grpc.CallOption) (*Grid, error) {
+	out := new(Grid)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CreateGrid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+
+func (c *goCryptoTraderServiceClient) StopGrid(ctx context.Context, in *GridRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_StopGrid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
+
+func (c *goCryptoTraderServiceClient) GetGrid(ctx context.Context, in *GridRequest, opts ...grpc.CallOption) (*Grid, error) {
+	out := new(Grid)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetGrid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
+
+func (c *goCryptoTraderServiceClient) GetGrids(ctx context.Context, in *GetGridsRequest, opts ...grpc.CallOption) (*GetGridsResponse, error) {
+	out := new(GetGridsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetGrids_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
+
+func (c *goCryptoTraderServiceClient) SearchOrderHistory(ctx context.Context, in *SearchOrderHistoryRequest, opts ...grpc.CallOption) (*SearchOrderHistoryResponse, error) {
+	out := new(SearchOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_SearchOrderHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+
+func (c *goCryptoTraderServiceClient) GetOrderAuditTrail(ctx context.Context, in *GetOrderAuditTrailRequest, opts ...grpc.CallOption) (*GetOrderAuditTrailResponse, error) {
+	out := new(GetOrderAuditTrailResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetOrderAuditTrail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+
+func (c *goCryptoTraderServiceClient) ReconcileOrders(ctx context.Context, in *ReconcileOrdersRequest, opts ...grpc.CallOption) (*OrderReconciliationReport, error) {
+	out := new(OrderReconciliationReport)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ReconcileOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
+
+func (c *goCryptoTraderServiceClient) GetOrderReconciliations(ctx context.Context, in *GetOrderReconciliationsRequest, opts ...grpc.CallOption) (*GetOrderReconciliationsResponse, error) {
+	out := new(GetOrderReconciliationsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetOrderReconciliations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+
+func (c *goCryptoTraderServiceClient) AddRebalanceStrategy(ctx context.Context, in *AddRebalanceStrategyRequest, opts ...grpc.CallOption) (*RebalanceStrategy, error) {
+	out := new(RebalanceStrategy)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_AddRebalanceStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
+
+func (c *goCryptoTraderServiceClient) RemoveRebalanceStrategy(ctx context.Context, in *RebalanceStrategyRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RemoveRebalanceStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+
+func (c *goCryptoTraderServiceClient) GetRebalanceStrategies(ctx context.Context, in *GetRebalanceStrategiesRequest, opts ...grpc.CallOption) (*GetRebalanceStrategiesResponse, error) {
+	out := new(GetRebalanceStrategiesResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetRebalanceStrategies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelBatchOrders not implemented")
+
+func (c *goCryptoTraderServiceClient) GetRebalancePlan(ctx context.Context, in *RebalanceStrategyRequest, opts ...grpc.CallOption) (*RebalancePlan, error) {
+	out := new(RebalancePlan)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetRebalancePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+
+func (c *goCryptoTraderServiceClient) ExecuteRebalance(ctx context.Context, in *RebalanceStrategyRequest, opts ...grpc.CallOption) (*RebalancePlan, error) {
+	out := new(RebalancePlan)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ExecuteRebalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+
+func (c *goCryptoTraderServiceClient) GetPortfolioSnapshots(ctx context.Context, in *PortfolioDateRangeRequest, opts ...grpc.CallOption) (*GetPortfolioSnapshotsResponse, error) {
+	out := new(GetPortfolioSnapshotsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPortfolioSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+
+func (c *goCryptoTraderServiceClient) GetPortfolioPerformance(ctx context.Context, in *PortfolioDateRangeRequest, opts ...grpc.CallOption) (*PortfolioPerformance, error) {
+	out := new(PortfolioPerformance)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPortfolioPerformance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+
+func (c *goCryptoTraderServiceClient) GetAccountingFills(ctx context.Context, in *GetAccountingFillsRequest, opts ...grpc.CallOption) (*GetAccountingFillsResponse, error) {
+	out := new(GetAccountingFillsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetAccountingFills_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+
+func (c *goCryptoTraderServiceClient) GetFeeSchedule(ctx context.Context, in *GetFeeScheduleRequest, opts ...grpc.CallOption) (*GetFeeScheduleResponse, error) {
+	out := new(GetFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetFeeSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+
+func (c *goCryptoTraderServiceClient) EstimateOrderCost(ctx context.Context, in *EstimateOrderCostRequest, opts ...grpc.CallOption) (*EstimateOrderCostResponse, error) {
+	out := new(EstimateOrderCostResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_EstimateOrderCost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetAvailableTransferChains(context.Context, *GetAvailableTransferChainsRequest) (*GetAvailableTransferChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableTransferChains not implemented")
+
+func (c *goCryptoTraderServiceClient) GetFundingRateMonitorRates(ctx context.Context, in *GetFundingRateMonitorRatesRequest, opts ...grpc.CallOption) (*GetFundingRateMonitorRatesResponse, error) {
+	out := new(GetFundingRateMonitorRatesResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetFundingRateMonitorRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+
+func (c *goCryptoTraderServiceClient) GetFundingRateOpportunityStream(ctx context.Context, in *GetFundingRateOpportunityStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetFundingRateOpportunityStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[8], GoCryptoTraderService_GetFundingRateOpportunityStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetFundingRateOpportunityStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+
+type GoCryptoTraderService_GetFundingRateOpportunityStreamClient interface {
+	Recv() (*FundingRateOpportunity, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServiceServer) WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventByID not implemented")
+
+type goCryptoTraderServiceGetFundingRateOpportunityStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServiceServer) WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByExchange not implemented")
+
+func (x *goCryptoTraderServiceGetFundingRateOpportunityStreamClient) Recv() (*FundingRateOpportunity, error) {
+	m := new(FundingRateOpportunity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByDate not implemented")
+
+func (c *goCryptoTraderServiceClient) GetLiquidationMonitorPositions(ctx context.Context, in *GetLiquidationMonitorPositionsRequest, opts ...grpc.CallOption) (*GetLiquidationMonitorPositionsResponse, error) {
+	out := new(GetLiquidationMonitorPositionsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetLiquidationMonitorPositions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+
+func (c *goCryptoTraderServiceClient) GetLiquidationMonitorStream(ctx context.Context, in *GetLiquidationMonitorStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetLiquidationMonitorStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[9], GoCryptoTraderService_GetLiquidationMonitorStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetLiquidationMonitorStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+
+type GoCryptoTraderService_GetLiquidationMonitorStreamClient interface {
+	Recv() (*LiquidationPositionHealth, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+
+type goCryptoTraderServiceGetLiquidationMonitorStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServiceServer) SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExchangePair not implemented")
+
+func (x *goCryptoTraderServiceGetLiquidationMonitorStreamClient) Recv() (*LiquidationPositionHealth, error) {
+	m := new(LiquidationPositionHealth)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTraderService_GetOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+
+func (c *goCryptoTraderServiceClient) GetOptionChain(ctx context.Context, in *GetOptionChainRequest, opts ...grpc.CallOption) (*GetOptionChainResponse, error) {
+	out := new(GetOptionChainResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetOptionChain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTraderService_GetExchangeOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+
+func (c *goCryptoTraderServiceClient) GetOptionGreeks(ctx context.Context, in *GetOptionGreeksRequest, opts ...grpc.CallOption) (*GetOptionGreeksResponse, error) {
+	out := new(GetOptionGreeksResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetOptionGreeks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetTickerStream(*GetTickerStreamRequest, GoCryptoTraderService_GetTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+
+func (c *goCryptoTraderServiceClient) GetEventStream(ctx context.Context, in *GetEventStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetEventStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[10], GoCryptoTraderService_GetEventStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetEventStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTraderService_GetExchangeTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+
+type GoCryptoTraderService_GetEventStreamClient interface {
+	Recv() (*BusEvent, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+
+type goCryptoTraderServiceGetEventStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServiceServer) GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
+
+func (x *goCryptoTraderServiceGetEventStreamClient) Recv() (*BusEvent, error) {
+	m := new(BusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptUpload not implemented")
+
+func (c *goCryptoTraderServiceClient) GetEventBusMetrics(ctx context.Context, in *GetEventBusMetricsRequest, opts ...grpc.CallOption) (*GetEventBusMetricsResponse, error) {
+	out := new(GetEventBusMetricsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetEventBusMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServiceServer) GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptReadScript not implemented")
+
+// GoCryptoTraderServiceServer is the server API for GoCryptoTraderService service.
+// All implementations must embed UnimplementedGoCryptoTraderServiceServer
+// for forward compatibility
+type GoCryptoTraderServiceServer interface {
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
+	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
+	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
+	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
+	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
+	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
+	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
+	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
+	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPResponse, error)
+	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
+	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
+	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
+	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
+	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
+	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
+	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTraderService_GetAccountInfoStreamServer) error
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error)
+	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error)
+	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
+	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
+	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
+	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
+	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error)
+	CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error)
+	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
+	RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error)
+	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
+	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
+	GetAvailableTransferChains(context.Context, *GetAvailableTransferChainsRequest) (*GetAvailableTransferChainsResponse, error)
+	WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error)
+	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error)
+	WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error)
+	WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error)
+	WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error)
+	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
+	SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error)
+	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTraderService_GetOrderbookStreamServer) error
+	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTraderService_GetExchangeOrderbookStreamServer) error
+	GetTickerStream(*GetTickerStreamRequest, GoCryptoTraderService_GetTickerStreamServer) error
+	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTraderService_GetExchangeTickerStreamServer) error
+	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
+	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error)
+	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error)
+	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GenericResponse, error)
+	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error)
+	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error)
+	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
+	SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error)
+	SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error)
+	UpdateExchangeSupportedPairs(context.Context, *UpdateExchangeSupportedPairsRequest) (*GenericResponse, error)
+	GetExchangeAssets(context.Context, *GetExchangeAssetsRequest) (*GetExchangeAssetsResponse, error)
+	WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error)
+	WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error)
+	WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error)
+	WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error)
+	WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error)
+	GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
+	GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTraderService_GetHistoricTradesServer) error
+	GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
+	ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error)
+	FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error)
+	FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error)
+	SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error)
+	UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error)
+	GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
+	GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error)
+	GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error)
+	GetDataHistoryJobSummary(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
+	SetDataHistoryJobStatus(context.Context, *SetDataHistoryJobStatusRequest) (*GenericResponse, error)
+	UpdateDataHistoryJobPrerequisite(context.Context, *UpdateDataHistoryJobPrerequisiteRequest) (*GenericResponse, error)
+	GetManagedOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error)
+	CurrencyStateGetAll(context.Context, *CurrencyStateGetAllRequest) (*CurrencyStateResponse, error)
+	CurrencyStateTrading(context.Context, *CurrencyStateTradingRequest) (*GenericResponse, error)
+	CurrencyStateDeposit(context.Context, *CurrencyStateDepositRequest) (*GenericResponse, error)
+	CurrencyStateWithdraw(context.Context, *CurrencyStateWithdrawRequest) (*GenericResponse, error)
+	CurrencyStateTradingPair(context.Context, *CurrencyStateTradingPairRequest) (*GenericResponse, error)
+	GetFuturesPositionsSummary(context.Context, *GetFuturesPositionsSummaryRequest) (*GetFuturesPositionsSummaryResponse, error)
+	GetFuturesPositionsOrders(context.Context, *GetFuturesPositionsOrdersRequest) (*GetFuturesPositionsOrdersResponse, error)
+	GetCollateral(context.Context, *GetCollateralRequest) (*GetCollateralResponse, error)
+	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
+	GetTechnicalAnalysis(context.Context, *GetTechnicalAnalysisRequest) (*GetTechnicalAnalysisResponse, error)
+	GetMarginRatesHistory(context.Context, *GetMarginRatesHistoryRequest) (*GetMarginRatesHistoryResponse, error)
+	GetManagedPosition(context.Context, *GetManagedPositionRequest) (*GetManagedPositionsResponse, error)
+	GetAllManagedPositions(context.Context, *GetAllManagedPositionsRequest) (*GetManagedPositionsResponse, error)
+	GetFundingRates(context.Context, *GetFundingRatesRequest) (*GetFundingRatesResponse, error)
+	GetLatestFundingRate(context.Context, *GetLatestFundingRateRequest) (*GetLatestFundingRateResponse, error)
+	GetOrderbookMovement(context.Context, *GetOrderbookMovementRequest) (*GetOrderbookMovementResponse, error)
+	GetOrderbookAmountByNominal(context.Context, *GetOrderbookAmountByNominalRequest) (*GetOrderbookAmountByNominalResponse, error)
+	GetOrderbookAmountByImpact(context.Context, *GetOrderbookAmountByImpactRequest) (*GetOrderbookAmountByImpactResponse, error)
+	GetCollateralMode(context.Context, *GetCollateralModeRequest) (*GetCollateralModeResponse, error)
+	GetLeverage(context.Context, *GetLeverageRequest) (*GetLeverageResponse, error)
+	SetCollateralMode(context.Context, *SetCollateralModeRequest) (*SetCollateralModeResponse, error)
+	SetMarginType(context.Context, *SetMarginTypeRequest) (*SetMarginTypeResponse, error)
+	SetLeverage(context.Context, *SetLeverageRequest) (*SetLeverageResponse, error)
+	ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error)
+	GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error)
+	CreateExecutionOrder(context.Context, *CreateExecutionOrderRequest) (*ExecutionOrder, error)
+	PauseExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error)
+	ResumeExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error)
+	CancelExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error)
+	GetExecutionOrder(context.Context, *ExecutionOrderRequest) (*ExecutionOrder, error)
+	GetExecutionOrders(context.Context, *GetExecutionOrdersRequest) (*GetExecutionOrdersResponse, error)
+	RouteOrder(context.Context, *RouteOrderRequest) (*RouteOrderResponse, error)
+	GetArbitrageOpportunityStream(*GetArbitrageOpportunityStreamRequest, GoCryptoTraderService_GetArbitrageOpportunityStreamServer) error
+	GetTriangularArbitrageStream(*GetTriangularArbitrageStreamRequest, GoCryptoTraderService_GetTriangularArbitrageStreamServer) error
+	AddMarketMakingStrategy(context.Context, *AddMarketMakingStrategyRequest) (*MarketMakingStrategy, error)
+	RemoveMarketMakingStrategy(context.Context, *MarketMakingStrategyRequest) (*GenericResponse, error)
+	GetMarketMakingStrategy(context.Context, *MarketMakingStrategyRequest) (*MarketMakingStrategy, error)
+	GetMarketMakingStrategies(context.Context, *GetMarketMakingStrategiesRequest) (*GetMarketMakingStrategiesResponse, error)
+	CreateGrid(context.Context, *CreateGridRequest) (*Grid, error)
+	StopGrid(context.Context, *GridRequest) (*GenericResponse, error)
+	GetGrid(context.Context, *GridRequest) (*Grid, error)
+	GetGrids(context.Context, *GetGridsRequest) (*GetGridsResponse, error)
+	SearchOrderHistory(context.Context, *SearchOrderHistoryRequest) (*SearchOrderHistoryResponse, error)
+	GetOrderAuditTrail(context.Context, *GetOrderAuditTrailRequest) (*GetOrderAuditTrailResponse, error)
+	ReconcileOrders(context.Context, *ReconcileOrdersRequest) (*OrderReconciliationReport, error)
+	GetOrderReconciliations(context.Context, *GetOrderReconciliationsRequest) (*GetOrderReconciliationsResponse, error)
+	AddRebalanceStrategy(context.Context, *AddRebalanceStrategyRequest) (*RebalanceStrategy, error)
+	RemoveRebalanceStrategy(context.Context, *RebalanceStrategyRequest) (*GenericResponse, error)
+	GetRebalanceStrategies(context.Context, *GetRebalanceStrategiesRequest) (*GetRebalanceStrategiesResponse, error)
+	GetRebalancePlan(context.Context, *RebalanceStrategyRequest) (*RebalancePlan, error)
+	ExecuteRebalance(context.Context, *RebalanceStrategyRequest) (*RebalancePlan, error)
+	GetPortfolioSnapshots(context.Context, *PortfolioDateRangeRequest) (*GetPortfolioSnapshotsResponse, error)
+	GetPortfolioPerformance(context.Context, *PortfolioDateRangeRequest) (*PortfolioPerformance, error)
+	GetAccountingFills(context.Context, *GetAccountingFillsRequest) (*GetAccountingFillsResponse, error)
+	GetFeeSchedule(context.Context, *GetFeeScheduleRequest) (*GetFeeScheduleResponse, error)
+	EstimateOrderCost(context.Context, *EstimateOrderCostRequest) (*EstimateOrderCostResponse, error)
+	GetFundingRateMonitorRates(context.Context, *GetFundingRateMonitorRatesRequest) (*GetFundingRateMonitorRatesResponse, error)
+	GetFundingRateOpportunityStream(*GetFundingRateOpportunityStreamRequest, GoCryptoTraderService_GetFundingRateOpportunityStreamServer) error
+	GetLiquidationMonitorPositions(context.Context, *GetLiquidationMonitorPositionsRequest) (*GetLiquidationMonitorPositionsResponse, error)
+	GetLiquidationMonitorStream(*GetLiquidationMonitorStreamRequest, GoCryptoTraderService_GetLiquidationMonitorStreamServer) error
+	GetOptionChain(context.Context, *GetOptionChainRequest) (*GetOptionChainResponse, error)
+	GetOptionGreeks(context.Context, *GetOptionGreeksRequest) (*GetOptionGreeksResponse, error)
+	GetEventStream(*GetEventStreamRequest, GoCryptoTraderService_GetEventStreamServer) error
+	GetEventBusMetrics(context.Context, *GetEventBusMetricsRequest) (*GetEventBusMetricsResponse, error)
+	mustEmbedUnimplementedGoCryptoTraderServiceServer()
 }
-func (UnimplementedGoCryptoTraderServiceServer) GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStatus not implemented")
+
+// UnimplementedGoCryptoTraderServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGoCryptoTraderServiceServer struct {
 }
-func (UnimplementedGoCryptoTraderServiceServer) GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptQuery not implemented")
+
+func (UnimplementedGoCryptoTraderServiceServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStop not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStopAll not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListAll not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeAsset not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAllExchangePairs not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) UpdateExchangeSupportedPairs(context.Context, *UpdateExchangeSupportedPairsRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateExchangeSupportedPairs not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetExchangeAssets(context.Context, *GetExchangeAssetsRequest) (*GetExchangeAssetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeAssets not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebsocketGetInfo not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetEnabled not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebsocketGetSubscriptions not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetProxy not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetURL not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecentTrades not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTraderService_GetHistoricTradesServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetHistoricTrades not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSavedTrades not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountInfo not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertTradesToCandles not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTraderService_GetAccountInfoStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedCandleIntervals not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedTradeIntervals not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeTradeProcessing not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertDataHistoryJob not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobDetails not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetActiveDataHistoryJobs not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobsBetween not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetDataHistoryJobSummary(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobSummary not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) SetDataHistoryJobStatus(context.Context, *SetDataHistoryJobStatusRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDataHistoryJobStatus not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) UpdateDataHistoryJobPrerequisite(context.Context, *UpdateDataHistoryJobPrerequisiteRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDataHistoryJobPrerequisite not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetManagedOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetManagedOrders not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyOrder not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) CurrencyStateGetAll(context.Context, *CurrencyStateGetAllRequest) (*CurrencyStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateGetAll not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) CurrencyStateTrading(context.Context, *CurrencyStateTradingRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateTrading not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBatchOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetAvailableTransferChains(context.Context, *GetAvailableTransferChainsRequest) (*GetAvailableTransferChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableTransferChains not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventByID not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByExchange not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByDate not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangePair not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTraderService_GetOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTraderService_GetExchangeOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetTickerStream(*GetTickerStreamRequest, GoCryptoTraderService_GetTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTraderService_GetExchangeTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptUpload not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptReadScript not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStatus not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptQuery not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStop not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStopAll not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListAll not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeAsset not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllExchangePairs not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) UpdateExchangeSupportedPairs(context.Context, *UpdateExchangeSupportedPairsRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExchangeSupportedPairs not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetExchangeAssets(context.Context, *GetExchangeAssetsRequest) (*GetExchangeAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeAssets not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketGetInfo not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetEnabled not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketGetSubscriptions not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetProxy not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetURL not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentTrades not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTraderService_GetHistoricTradesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetHistoricTrades not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedTrades not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertTradesToCandles not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedCandleIntervals not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedTradeIntervals not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeTradeProcessing not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDataHistoryJob not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobDetails not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveDataHistoryJobs not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobsBetween not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetDataHistoryJobSummary(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobSummary not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetDataHistoryJobStatus(context.Context, *SetDataHistoryJobStatusRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDataHistoryJobStatus not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) UpdateDataHistoryJobPrerequisite(context.Context, *UpdateDataHistoryJobPrerequisiteRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDataHistoryJobPrerequisite not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetManagedOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManagedOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CurrencyStateGetAll(context.Context, *CurrencyStateGetAllRequest) (*CurrencyStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateGetAll not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CurrencyStateTrading(context.Context, *CurrencyStateTradingRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateTrading not implemented")
 }
 func (UnimplementedGoCryptoTraderServiceServer) CurrencyStateDeposit(context.Context, *CurrencyStateDepositRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateDeposit not implemented")
@@ -1864,2115 +2447,2949 @@ func (UnimplementedGoCryptoTraderServiceServer) GetFundingRates(context.Context,
 func (UnimplementedGoCryptoTraderServiceServer) GetLatestFundingRate(context.Context, *GetLatestFundingRateRequest) (*GetLatestFundingRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLatestFundingRate not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookMovement(context.Context, *GetOrderbookMovementRequest) (*GetOrderbookMovementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookMovement not implemented")
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookMovement(context.Context, *GetOrderbookMovementRequest) (*GetOrderbookMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookMovement not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookAmountByNominal(context.Context, *GetOrderbookAmountByNominalRequest) (*GetOrderbookAmountByNominalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookAmountByNominal not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookAmountByImpact(context.Context, *GetOrderbookAmountByImpactRequest) (*GetOrderbookAmountByImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookAmountByImpact not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetCollateralMode(context.Context, *GetCollateralModeRequest) (*GetCollateralModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralMode not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetLeverage(context.Context, *GetLeverageRequest) (*GetLeverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeverage not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetCollateralMode(context.Context, *SetCollateralModeRequest) (*SetCollateralModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollateralMode not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetMarginType(context.Context, *SetMarginTypeRequest) (*SetMarginTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarginType not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetLeverage(context.Context, *SetLeverageRequest) (*SetLeverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLeverage not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePositionMargin not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenInterest not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CreateExecutionOrder(context.Context, *CreateExecutionOrderRequest) (*ExecutionOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) PauseExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ResumeExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CancelExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetExecutionOrder(context.Context, *ExecutionOrderRequest) (*ExecutionOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetExecutionOrders(context.Context, *GetExecutionOrdersRequest) (*GetExecutionOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RouteOrder(context.Context, *RouteOrderRequest) (*RouteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetArbitrageOpportunityStream(*GetArbitrageOpportunityStreamRequest, GoCryptoTraderService_GetArbitrageOpportunityStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunityStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetTriangularArbitrageStream(*GetTriangularArbitrageStreamRequest, GoCryptoTraderService_GetTriangularArbitrageStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTriangularArbitrageStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) AddMarketMakingStrategy(context.Context, *AddMarketMakingStrategyRequest) (*MarketMakingStrategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMarketMakingStrategy not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RemoveMarketMakingStrategy(context.Context, *MarketMakingStrategyRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarketMakingStrategy not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetMarketMakingStrategy(context.Context, *MarketMakingStrategyRequest) (*MarketMakingStrategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketMakingStrategy not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetMarketMakingStrategies(context.Context, *GetMarketMakingStrategiesRequest) (*GetMarketMakingStrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketMakingStrategies not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CreateGrid(context.Context, *CreateGridRequest) (*Grid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGrid not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) StopGrid(context.Context, *GridRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopGrid not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetGrid(context.Context, *GridRequest) (*Grid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGrid not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetGrids(context.Context, *GetGridsRequest) (*GetGridsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGrids not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SearchOrderHistory(context.Context, *SearchOrderHistoryRequest) (*SearchOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOrderHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderAuditTrail(context.Context, *GetOrderAuditTrailRequest) (*GetOrderAuditTrailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderAuditTrail not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ReconcileOrders(context.Context, *ReconcileOrdersRequest) (*OrderReconciliationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderReconciliations(context.Context, *GetOrderReconciliationsRequest) (*GetOrderReconciliationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderReconciliations not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) AddRebalanceStrategy(context.Context, *AddRebalanceStrategyRequest) (*RebalanceStrategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRebalanceStrategy not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RemoveRebalanceStrategy(context.Context, *RebalanceStrategyRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRebalanceStrategy not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetRebalanceStrategies(context.Context, *GetRebalanceStrategiesRequest) (*GetRebalanceStrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRebalanceStrategies not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetRebalancePlan(context.Context, *RebalanceStrategyRequest) (*RebalancePlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRebalancePlan not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ExecuteRebalance(context.Context, *RebalanceStrategyRequest) (*RebalancePlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRebalance not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioSnapshots(context.Context, *PortfolioDateRangeRequest) (*GetPortfolioSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSnapshots not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioPerformance(context.Context, *PortfolioDateRangeRequest) (*PortfolioPerformance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioPerformance not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetAccountingFills(context.Context, *GetAccountingFillsRequest) (*GetAccountingFillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountingFills not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetFeeSchedule(context.Context, *GetFeeScheduleRequest) (*GetFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeSchedule not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) EstimateOrderCost(context.Context, *EstimateOrderCostRequest) (*EstimateOrderCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateOrderCost not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetFundingRateMonitorRates(context.Context, *GetFundingRateMonitorRatesRequest) (*GetFundingRateMonitorRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingRateMonitorRates not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetFundingRateOpportunityStream(*GetFundingRateOpportunityStreamRequest, GoCryptoTraderService_GetFundingRateOpportunityStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFundingRateOpportunityStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetLiquidationMonitorPositions(context.Context, *GetLiquidationMonitorPositionsRequest) (*GetLiquidationMonitorPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidationMonitorPositions not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetLiquidationMonitorStream(*GetLiquidationMonitorStreamRequest, GoCryptoTraderService_GetLiquidationMonitorStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLiquidationMonitorStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOptionChain(context.Context, *GetOptionChainRequest) (*GetOptionChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOptionChain not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOptionGreeks(context.Context, *GetOptionGreeksRequest) (*GetOptionGreeksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOptionGreeks not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetEventStream(*GetEventStreamRequest, GoCryptoTraderService_GetEventStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetEventStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetEventBusMetrics(context.Context, *GetEventBusMetricsRequest) (*GetEventBusMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventBusMetrics not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) mustEmbedUnimplementedGoCryptoTraderServiceServer() {}
+
+// UnsafeGoCryptoTraderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoCryptoTraderServiceServer will
+// result in compilation errors.
+type UnsafeGoCryptoTraderServiceServer interface {
+	mustEmbedUnimplementedGoCryptoTraderServiceServer()
+}
+
+func RegisterGoCryptoTraderServiceServer(s grpc.ServiceRegistrar, srv GoCryptoTraderServiceServer) {
+	s.RegisterService(&GoCryptoTraderService_ServiceDesc, srv)
+}
+
+func _GoCryptoTraderService_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubsystemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetSubsystems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetSubsystems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).EnableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_EnableSubsystem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).DisableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_DisableSubsystem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRPCEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetRPCEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetRPCEndpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunicationRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetCommunicationRelayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetCommunicationRelayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetExchanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetExchanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).DisableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_DisableExchange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetExchangeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetExchangeInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetExchangeOTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetExchangeOTPCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeOTPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetExchangeOTPCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetExchangeOTPCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).EnableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_EnableExchange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetTicker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetTicker(ctx, req.(*GetTickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetTickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetTickers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetTickers(ctx, req.(*GetTickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetOrderbook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetOrderbooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetOrderbooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetAccountInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_UpdateAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).UpdateAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_UpdateAccountInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).UpdateAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAccountInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetAccountInfoStream(m, &goCryptoTraderServiceGetAccountInfoStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetAccountInfoStreamServer interface {
+	Send(*GetAccountInfoResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetAccountInfoStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPortfolioSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).AddPortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_AddPortfolioAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RemovePortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RemovePortfolioAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookAmountByNominal(context.Context, *GetOrderbookAmountByNominalRequest) (*GetOrderbookAmountByNominalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookAmountByNominal not implemented")
+
+func _GoCryptoTraderService_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetForexProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetForexProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookAmountByImpact(context.Context, *GetOrderbookAmountByImpactRequest) (*GetOrderbookAmountByImpactResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookAmountByImpact not implemented")
+
+func _GoCryptoTraderService_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetForexRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetForexRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetCollateralMode(context.Context, *GetCollateralModeRequest) (*GetCollateralModeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralMode not implemented")
+
+func _GoCryptoTraderService_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetOrders(ctx, req.(*GetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetLeverage(context.Context, *GetLeverageRequest) (*GetLeverageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLeverage not implemented")
+
+func _GoCryptoTraderService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServiceServer) SetCollateralMode(context.Context, *SetCollateralModeRequest) (*SetCollateralModeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetCollateralMode not implemented")
+
+func _GoCryptoTraderService_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SubmitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SubmitOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServiceServer) SetMarginType(context.Context, *SetMarginTypeRequest) (*SetMarginTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetMarginType not implemented")
+
+func _GoCryptoTraderService_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SimulateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SimulateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServiceServer) SetLeverage(context.Context, *SetLeverageRequest) (*SetLeverageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLeverage not implemented")
+
+func _GoCryptoTraderService_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhaleBombRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).WhaleBomb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_WhaleBomb_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServiceServer) ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePositionMargin not implemented")
+
+func _GoCryptoTraderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServiceServer) GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOpenInterest not implemented")
+
+func _GoCryptoTraderService_CancelBatchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBatchOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CancelBatchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CancelBatchOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CancelBatchOrders(ctx, req.(*CancelBatchOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServiceServer) mustEmbedUnimplementedGoCryptoTraderServiceServer() {}
 
-// UnsafeGoCryptoTraderServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GoCryptoTraderServiceServer will
-// result in compilation errors.
-type UnsafeGoCryptoTraderServiceServer interface {
-	mustEmbedUnimplementedGoCryptoTraderServiceServer()
+func _GoCryptoTraderService_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CancelAllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CancelAllOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGoCryptoTraderServiceServer(s grpc.ServiceRegistrar, srv GoCryptoTraderServiceServer) {
-	s.RegisterService(&GoCryptoTraderService_ServiceDesc, srv)
+func _GoCryptoTraderService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _GoCryptoTraderService_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetInfo(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).AddEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetInfo_FullMethodName,
+		FullMethod: GoCryptoTraderService_AddEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetInfo(ctx, req.(*GetInfoRequest))
+		return srv.(GoCryptoTraderServiceServer).AddEvent(ctx, req.(*AddEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubsystemsRequest)
+func _GoCryptoTraderService_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetSubsystems(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).RemoveEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetSubsystems_FullMethodName,
+		FullMethod: GoCryptoTraderService_RemoveEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+		return srv.(GoCryptoTraderServiceServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTraderService_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).EnableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetCryptocurrencyDepositAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_EnableSubsystem_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetCryptocurrencyDepositAddresses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServiceServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTraderService_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).DisableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetCryptocurrencyDepositAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_DisableSubsystem_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetCryptocurrencyDepositAddress_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServiceServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRPCEndpointsRequest)
+func _GoCryptoTraderService_GetAvailableTransferChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableTransferChainsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetRPCEndpoints(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetAvailableTransferChains(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetRPCEndpoints_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetAvailableTransferChains_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+		return srv.(GoCryptoTraderServiceServer).GetAvailableTransferChains(ctx, req.(*GetAvailableTransferChainsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommunicationRelayersRequest)
+func _GoCryptoTraderService_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawFiatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetCommunicationRelayers(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).WithdrawFiatFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetCommunicationRelayers_FullMethodName,
+		FullMethod: GoCryptoTraderService_WithdrawFiatFunds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+		return srv.(GoCryptoTraderServiceServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangesRequest)
+func _GoCryptoTraderService_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCryptoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetExchanges(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).WithdrawCryptocurrencyFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetExchanges_FullMethodName,
+		FullMethod: GoCryptoTraderService_WithdrawCryptocurrencyFunds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+		return srv.(GoCryptoTraderServiceServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTraderService_WithdrawalEventByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).DisableExchange(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).WithdrawalEventByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_DisableExchange_FullMethodName,
+		FullMethod: GoCryptoTraderService_WithdrawalEventByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServiceServer).WithdrawalEventByID(ctx, req.(*WithdrawalEventByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTraderService_WithdrawalEventsByExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventsByExchangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetExchangeInfo(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).WithdrawalEventsByExchange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetExchangeInfo_FullMethodName,
+		FullMethod: GoCryptoTraderService_WithdrawalEventsByExchange_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServiceServer).WithdrawalEventsByExchange(ctx, req.(*WithdrawalEventsByExchangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTraderService_WithdrawalEventsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventsByDateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetExchangeOTPCode(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).WithdrawalEventsByDate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetExchangeOTPCode_FullMethodName,
+		FullMethod: GoCryptoTraderService_WithdrawalEventsByDate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServiceServer).WithdrawalEventsByDate(ctx, req.(*WithdrawalEventsByDateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangeOTPsRequest)
+func _GoCryptoTraderService_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetExchangeOTPCodes(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetExchangeOTPCodes_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetLoggerDetails_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+		return srv.(GoCryptoTraderServiceServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTraderService_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).EnableExchange(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).SetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_EnableExchange_FullMethodName,
+		FullMethod: GoCryptoTraderService_SetLoggerDetails_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServiceServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickerRequest)
+func _GoCryptoTraderService_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangePairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetTicker(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetExchangePairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetTicker_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetExchangePairs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetTicker(ctx, req.(*GetTickerRequest))
+		return srv.(GoCryptoTraderServiceServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickersRequest)
+func _GoCryptoTraderService_SetExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangePairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetTickers(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).SetExchangePair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetTickers_FullMethodName,
+		FullMethod: GoCryptoTraderService_SetExchangePair_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetTickers(ctx, req.(*GetTickersRequest))
+		return srv.(GoCryptoTraderServiceServer).SetExchangePair(ctx, req.(*SetExchangePairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbookRequest)
+func _GoCryptoTraderService_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetOrderbookStream(m, &goCryptoTraderServiceGetOrderbookStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetExchangeOrderbookStream(m, &goCryptoTraderServiceGetExchangeOrderbookStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetExchangeOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetExchangeOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetTickerStream(m, &goCryptoTraderServiceGetTickerStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetExchangeTickerStream(m, &goCryptoTraderServiceGetExchangeTickerStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetExchangeTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetExchangeTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetOrderbook(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetAuditEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetOrderbook_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetAuditEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+		return srv.(GoCryptoTraderServiceServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbooksRequest)
+func _GoCryptoTraderService_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptExecuteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetOrderbooks(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GCTScriptExecute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetOrderbooks_FullMethodName,
+		FullMethod: GoCryptoTraderService_GCTScriptExecute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+		return srv.(GoCryptoTraderServiceServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountInfoRequest)
+func _GoCryptoTraderService_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetAccountInfo(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GCTScriptUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetAccountInfo_FullMethodName,
+		FullMethod: GoCryptoTraderService_GCTScriptUpload_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+		return srv.(GoCryptoTraderServiceServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_UpdateAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountInfoRequest)
+func _GoCryptoTraderService_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptReadScriptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).UpdateAccountInfo(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GCTScriptReadScript(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_UpdateAccountInfo_FullMethodName,
+		FullMethod: GoCryptoTraderService_GCTScriptReadScript_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).UpdateAccountInfo(ctx, req.(*GetAccountInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GoCryptoTraderService_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetAccountInfoRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+		return srv.(GoCryptoTraderServiceServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
 	}
-	return srv.(GoCryptoTraderServiceServer).GetAccountInfoStream(m, &goCryptoTraderServiceGetAccountInfoStreamServer{stream})
-}
-
-type GoCryptoTraderService_GetAccountInfoStreamServer interface {
-	Send(*GetAccountInfoResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderServiceGetAccountInfoStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderServiceGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
-	return x.ServerStream.SendMsg(m)
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigRequest)
+func _GoCryptoTraderService_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetConfig(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GCTScriptStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetConfig_FullMethodName,
+		FullMethod: GoCryptoTraderService_GCTScriptStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetConfig(ctx, req.(*GetConfigRequest))
+		return srv.(GoCryptoTraderServiceServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioRequest)
+func _GoCryptoTraderService_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetPortfolio(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GCTScriptQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetPortfolio_FullMethodName,
+		FullMethod: GoCryptoTraderService_GCTScriptQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+		return srv.(GoCryptoTraderServiceServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioSummaryRequest)
+func _GoCryptoTraderService_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetPortfolioSummary(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GCTScriptStop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetPortfolioSummary_FullMethodName,
+		FullMethod: GoCryptoTraderService_GCTScriptStop_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+		return srv.(GoCryptoTraderServiceServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPortfolioAddressRequest)
+func _GoCryptoTraderService_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).AddPortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GCTScriptStopAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_AddPortfolioAddress_FullMethodName,
+		FullMethod: GoCryptoTraderService_GCTScriptStopAll_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+		return srv.(GoCryptoTraderServiceServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemovePortfolioAddressRequest)
+func _GoCryptoTraderService_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptListAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).RemovePortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GCTScriptListAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_RemovePortfolioAddress_FullMethodName,
+		FullMethod: GoCryptoTraderService_GCTScriptListAll_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+		return srv.(GoCryptoTraderServiceServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexProvidersRequest)
+func _GoCryptoTraderService_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptAutoLoadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetForexProviders(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GCTScriptAutoLoadToggle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetForexProviders_FullMethodName,
+		FullMethod: GoCryptoTraderService_GCTScriptAutoLoadToggle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+		return srv.(GoCryptoTraderServiceServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexRatesRequest)
+func _GoCryptoTraderService_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoricCandlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetForexRates(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetHistoricCandles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetForexRates_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetHistoricCandles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+		return srv.(GoCryptoTraderServiceServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrdersRequest)
+func _GoCryptoTraderService_SetExchangeAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeAssetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetOrders(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).SetExchangeAsset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetOrders_FullMethodName,
+		FullMethod: GoCryptoTraderService_SetExchangeAsset_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetOrders(ctx, req.(*GetOrdersRequest))
+		return srv.(GoCryptoTraderServiceServer).SetExchangeAsset(ctx, req.(*SetExchangeAssetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderRequest)
+func _GoCryptoTraderService_SetAllExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeAllPairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetOrder(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).SetAllExchangePairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetOrder_FullMethodName,
+		FullMethod: GoCryptoTraderService_SetAllExchangePairs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetOrder(ctx, req.(*GetOrderRequest))
+		return srv.(GoCryptoTraderServiceServer).SetAllExchangePairs(ctx, req.(*SetExchangeAllPairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitOrderRequest)
+func _GoCryptoTraderService_UpdateExchangeSupportedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExchangeSupportedPairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SubmitOrder(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).UpdateExchangeSupportedPairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SubmitOrder_FullMethodName,
+		FullMethod: GoCryptoTraderService_UpdateExchangeSupportedPairs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+		return srv.(GoCryptoTraderServiceServer).UpdateExchangeSupportedPairs(ctx, req.(*UpdateExchangeSupportedPairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulateOrderRequest)
+func _GoCryptoTraderService_GetExchangeAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeAssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SimulateOrder(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetExchangeAssets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SimulateOrder_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetExchangeAssets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+		return srv.(GoCryptoTraderServiceServer).GetExchangeAssets(ctx, req.(*GetExchangeAssetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WhaleBombRequest)
+func _GoCryptoTraderService_WebsocketGetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketGetInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WhaleBomb(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).WebsocketGetInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WhaleBomb_FullMethodName,
+		FullMethod: GoCryptoTraderService_WebsocketGetInfo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+		return srv.(GoCryptoTraderServiceServer).WebsocketGetInfo(ctx, req.(*WebsocketGetInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelOrderRequest)
+func _GoCryptoTraderService_WebsocketSetEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSetEnabledRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).CancelOrder(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).WebsocketSetEnabled(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_CancelOrder_FullMethodName,
+		FullMethod: GoCryptoTraderService_WebsocketSetEnabled_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+		return srv.(GoCryptoTraderServiceServer).WebsocketSetEnabled(ctx, req.(*WebsocketSetEnabledRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_CancelBatchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelBatchOrdersRequest)
+func _GoCryptoTraderService_WebsocketGetSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketGetSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).CancelBatchOrders(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).WebsocketGetSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_CancelBatchOrders_FullMethodName,
+		FullMethod: GoCryptoTraderService_WebsocketGetSubscriptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).CancelBatchOrders(ctx, req.(*CancelBatchOrdersRequest))
+		return srv.(GoCryptoTraderServiceServer).WebsocketGetSubscriptions(ctx, req.(*WebsocketGetSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelAllOrdersRequest)
+func _GoCryptoTraderService_WebsocketSetProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSetProxyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).CancelAllOrders(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).WebsocketSetProxy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_CancelAllOrders_FullMethodName,
+		FullMethod: GoCryptoTraderService_WebsocketSetProxy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+		return srv.(GoCryptoTraderServiceServer).WebsocketSetProxy(ctx, req.(*WebsocketSetProxyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventsRequest)
+func _GoCryptoTraderService_WebsocketSetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSetURLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetEvents(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).WebsocketSetURL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetEvents_FullMethodName,
+		FullMethod: GoCryptoTraderService_WebsocketSetURL_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetEvents(ctx, req.(*GetEventsRequest))
+		return srv.(GoCryptoTraderServiceServer).WebsocketSetURL(ctx, req.(*WebsocketSetURLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddEventRequest)
+func _GoCryptoTraderService_GetRecentTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedTradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).AddEvent(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetRecentTrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_AddEvent_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetRecentTrades_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).AddEvent(ctx, req.(*AddEventRequest))
+		return srv.(GoCryptoTraderServiceServer).GetRecentTrades(ctx, req.(*GetSavedTradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveEventRequest)
+func _GoCryptoTraderService_GetHistoricTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSavedTradesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetHistoricTrades(m, &goCryptoTraderServiceGetHistoricTradesServer{stream})
+}
+
+type GoCryptoTraderService_GetHistoricTradesServer interface {
+	Send(*SavedTradesResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetHistoricTradesServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetHistoricTradesServer) Send(m *SavedTradesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetSavedTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedTradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).RemoveEvent(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetSavedTrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_RemoveEvent_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetSavedTrades_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
+		return srv.(GoCryptoTraderServiceServer).GetSavedTrades(ctx, req.(*GetSavedTradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressesRequest)
+func _GoCryptoTraderService_ConvertTradesToCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertTradesToCandlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetCryptocurrencyDepositAddresses(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).ConvertTradesToCandles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetCryptocurrencyDepositAddresses_FullMethodName,
+		FullMethod: GoCryptoTraderService_ConvertTradesToCandles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
+		return srv.(GoCryptoTraderServiceServer).ConvertTradesToCandles(ctx, req.(*ConvertTradesToCandlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressRequest)
+func _GoCryptoTraderService_FindMissingSavedCandleIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMissingCandlePeriodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetCryptocurrencyDepositAddress(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).FindMissingSavedCandleIntervals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetCryptocurrencyDepositAddress_FullMethodName,
+		FullMethod: GoCryptoTraderService_FindMissingSavedCandleIntervals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
+		return srv.(GoCryptoTraderServiceServer).FindMissingSavedCandleIntervals(ctx, req.(*FindMissingCandlePeriodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetAvailableTransferChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAvailableTransferChainsRequest)
+func _GoCryptoTraderService_FindMissingSavedTradeIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMissingTradePeriodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetAvailableTransferChains(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).FindMissingSavedTradeIntervals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetAvailableTransferChains_FullMethodName,
+		FullMethod: GoCryptoTraderService_FindMissingSavedTradeIntervals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetAvailableTransferChains(ctx, req.(*GetAvailableTransferChainsRequest))
+		return srv.(GoCryptoTraderServiceServer).FindMissingSavedTradeIntervals(ctx, req.(*FindMissingTradePeriodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawFiatRequest)
+func _GoCryptoTraderService_SetExchangeTradeProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeTradeProcessingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WithdrawFiatFunds(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).SetExchangeTradeProcessing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WithdrawFiatFunds_FullMethodName,
+		FullMethod: GoCryptoTraderService_SetExchangeTradeProcessing_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
+		return srv.(GoCryptoTraderServiceServer).SetExchangeTradeProcessing(ctx, req.(*SetExchangeTradeProcessingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCryptoRequest)
+func _GoCryptoTraderService_UpsertDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDataHistoryJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WithdrawCryptocurrencyFunds(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).UpsertDataHistoryJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WithdrawCryptocurrencyFunds_FullMethodName,
+		FullMethod: GoCryptoTraderService_UpsertDataHistoryJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
+		return srv.(GoCryptoTraderServiceServer).UpsertDataHistoryJob(ctx, req.(*UpsertDataHistoryJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WithdrawalEventByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventByIDRequest)
+func _GoCryptoTraderService_GetDataHistoryJobDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WithdrawalEventByID(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WithdrawalEventByID_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetDataHistoryJobDetails_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WithdrawalEventByID(ctx, req.(*WithdrawalEventByIDRequest))
+		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobDetails(ctx, req.(*GetDataHistoryJobDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WithdrawalEventsByExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventsByExchangeRequest)
+func _GoCryptoTraderService_GetActiveDataHistoryJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WithdrawalEventsByExchange(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetActiveDataHistoryJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WithdrawalEventsByExchange_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetActiveDataHistoryJobs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WithdrawalEventsByExchange(ctx, req.(*WithdrawalEventsByExchangeRequest))
+		return srv.(GoCryptoTraderServiceServer).GetActiveDataHistoryJobs(ctx, req.(*GetInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WithdrawalEventsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventsByDateRequest)
+func _GoCryptoTraderService_GetDataHistoryJobsBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobsBetweenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WithdrawalEventsByDate(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobsBetween(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WithdrawalEventsByDate_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetDataHistoryJobsBetween_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WithdrawalEventsByDate(ctx, req.(*WithdrawalEventsByDateRequest))
+		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobsBetween(ctx, req.(*GetDataHistoryJobsBetweenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoggerDetailsRequest)
+func _GoCryptoTraderService_GetDataHistoryJobSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetLoggerDetails_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetDataHistoryJobSummary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobSummary(ctx, req.(*GetDataHistoryJobDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLoggerDetailsRequest)
+func _GoCryptoTraderService_SetDataHistoryJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDataHistoryJobStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).SetDataHistoryJobStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SetLoggerDetails_FullMethodName,
+		FullMethod: GoCryptoTraderService_SetDataHistoryJobStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServiceServer).SetDataHistoryJobStatus(ctx, req.(*SetDataHistoryJobStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangePairsRequest)
+func _GoCryptoTraderService_UpdateDataHistoryJobPrerequisite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDataHistoryJobPrerequisiteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).UpdateDataHistoryJobPrerequisite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetExchangePairs_FullMethodName,
+		FullMethod: GoCryptoTraderService_UpdateDataHistoryJobPrerequisite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
+		return srv.(GoCryptoTraderServiceServer).UpdateDataHistoryJobPrerequisite(ctx, req.(*UpdateDataHistoryJobPrerequisiteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SetExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangePairRequest)
+func _GoCryptoTraderService_GetManagedOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SetExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetManagedOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SetExchangePair_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetManagedOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SetExchangePair(ctx, req.(*SetExchangePairRequest))
+		return srv.(GoCryptoTraderServiceServer).GetManagedOrders(ctx, req.(*GetOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServiceServer).GetOrderbookStream(m, &goCryptoTraderServiceGetOrderbookStreamServer{stream})
-}
-
-type GoCryptoTraderService_GetOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderServiceGetOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderServiceGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTraderService_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServiceServer).GetExchangeOrderbookStream(m, &goCryptoTraderServiceGetExchangeOrderbookStreamServer{stream})
-}
-
-type GoCryptoTraderService_GetExchangeOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderServiceGetExchangeOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderServiceGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTraderService_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServiceServer).GetTickerStream(m, &goCryptoTraderServiceGetTickerStreamServer{stream})
-}
-
-type GoCryptoTraderService_GetTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderServiceGetTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderServiceGetTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTraderService_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServiceServer).GetExchangeTickerStream(m, &goCryptoTraderServiceGetExchangeTickerStreamServer{stream})
-}
-
-type GoCryptoTraderService_GetExchangeTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderServiceGetExchangeTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderServiceGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTraderService_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAuditEventRequest)
+func _GoCryptoTraderService_ModifyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetAuditEvent(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).ModifyOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetAuditEvent_FullMethodName,
+		FullMethod: GoCryptoTraderService_ModifyOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
+		return srv.(GoCryptoTraderServiceServer).ModifyOrder(ctx, req.(*ModifyOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptExecuteRequest)
+func _GoCryptoTraderService_CurrencyStateGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyStateGetAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptExecute(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).CurrencyStateGetAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GCTScriptExecute_FullMethodName,
+		FullMethod: GoCryptoTraderService_CurrencyStateGetAll_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
+		return srv.(GoCryptoTraderServiceServer).CurrencyStateGetAll(ctx, req.(*CurrencyStateGetAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptUploadRequest)
+func _GoCryptoTraderService_CurrencyStateTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyStateTradingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptUpload(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).CurrencyStateTrading(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GCTScriptUpload_FullMethodName,
+		FullMethod: GoCryptoTraderService_CurrencyStateTrading_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
+		return srv.(GoCryptoTraderServiceServer).CurrencyStateTrading(ctx, req.(*CurrencyStateTradingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptReadScriptRequest)
+func _GoCryptoTraderService_CurrencyStateDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyStateDepositRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptReadScript(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).CurrencyStateDeposit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GCTScriptReadScript_FullMethodName,
+		FullMethod: GoCryptoTraderService_CurrencyStateDeposit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
+		return srv.(GoCryptoTraderServiceServer).CurrencyStateDeposit(ctx, req.(*CurrencyStateDepositRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStatusRequest)
+func _GoCryptoTraderService_CurrencyStateWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyStateWithdrawRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptStatus(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).CurrencyStateWithdraw(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GCTScriptStatus_FullMethodName,
+		FullMethod: GoCryptoTraderService_CurrencyStateWithdraw_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
+		return srv.(GoCryptoTraderServiceServer).CurrencyStateWithdraw(ctx, req.(*CurrencyStateWithdrawRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptQueryRequest)
+func _GoCryptoTraderService_CurrencyStateTradingPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyStateTradingPairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptQuery(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).CurrencyStateTradingPair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GCTScriptQuery_FullMethodName,
+		FullMethod: GoCryptoTraderService_CurrencyStateTradingPair_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
+		return srv.(GoCryptoTraderServiceServer).CurrencyStateTradingPair(ctx, req.(*CurrencyStateTradingPairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopRequest)
+func _GoCryptoTraderService_GetFuturesPositionsSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFuturesPositionsSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptStop(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetFuturesPositionsSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GCTScriptStop_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetFuturesPositionsSummary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
+		return srv.(GoCryptoTraderServiceServer).GetFuturesPositionsSummary(ctx, req.(*GetFuturesPositionsSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopAllRequest)
+func _GoCryptoTraderService_GetFuturesPositionsOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFuturesPositionsOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptStopAll(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetFuturesPositionsOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GCTScriptStopAll_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetFuturesPositionsOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
+		return srv.(GoCryptoTraderServiceServer).GetFuturesPositionsOrders(ctx, req.(*GetFuturesPositionsOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptListAllRequest)
+func _GoCryptoTraderService_GetCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollateralRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptListAll(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetCollateral(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GCTScriptListAll_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetCollateral_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
+		return srv.(GoCryptoTraderServiceServer).GetCollateral(ctx, req.(*GetCollateralRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptAutoLoadRequest)
+func _GoCryptoTraderService_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptAutoLoadToggle(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).Shutdown(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GCTScriptAutoLoadToggle_FullMethodName,
+		FullMethod: GoCryptoTraderService_Shutdown_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
+		return srv.(GoCryptoTraderServiceServer).Shutdown(ctx, req.(*ShutdownRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHistoricCandlesRequest)
+func _GoCryptoTraderService_GetTechnicalAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTechnicalAnalysisRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetHistoricCandles(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetTechnicalAnalysis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetHistoricCandles_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetTechnicalAnalysis_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
+		return srv.(GoCryptoTraderServiceServer).GetTechnicalAnalysis(ctx, req.(*GetTechnicalAnalysisRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SetExchangeAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangeAssetRequest)
+func _GoCryptoTraderService_GetMarginRatesHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarginRatesHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SetExchangeAsset(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetMarginRatesHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SetExchangeAsset_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetMarginRatesHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SetExchangeAsset(ctx, req.(*SetExchangeAssetRequest))
+		return srv.(GoCryptoTraderServiceServer).GetMarginRatesHistory(ctx, req.(*GetMarginRatesHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SetAllExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangeAllPairsRequest)
+func _GoCryptoTraderService_GetManagedPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManagedPositionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SetAllExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetManagedPosition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SetAllExchangePairs_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetManagedPosition_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SetAllExchangePairs(ctx, req.(*SetExchangeAllPairsRequest))
+		return srv.(GoCryptoTraderServiceServer).GetManagedPosition(ctx, req.(*GetManagedPositionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_UpdateExchangeSupportedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateExchangeSupportedPairsRequest)
+func _GoCryptoTraderService_GetAllManagedPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllManagedPositionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).UpdateExchangeSupportedPairs(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetAllManagedPositions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_UpdateExchangeSupportedPairs_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetAllManagedPositions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).UpdateExchangeSupportedPairs(ctx, req.(*UpdateExchangeSupportedPairsRequest))
+		return srv.(GoCryptoTraderServiceServer).GetAllManagedPositions(ctx, req.(*GetAllManagedPositionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetExchangeAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangeAssetsRequest)
+func _GoCryptoTraderService_GetFundingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetExchangeAssets(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetFundingRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetExchangeAssets_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetFundingRates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetExchangeAssets(ctx, req.(*GetExchangeAssetsRequest))
+		return srv.(GoCryptoTraderServiceServer).GetFundingRates(ctx, req.(*GetFundingRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WebsocketGetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketGetInfoRequest)
+func _GoCryptoTraderService_GetLatestFundingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestFundingRateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WebsocketGetInfo(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetLatestFundingRate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WebsocketGetInfo_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetLatestFundingRate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WebsocketGetInfo(ctx, req.(*WebsocketGetInfoRequest))
+		return srv.(GoCryptoTraderServiceServer).GetLatestFundingRate(ctx, req.(*GetLatestFundingRateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WebsocketSetEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketSetEnabledRequest)
+func _GoCryptoTraderService_GetOrderbookMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookMovementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WebsocketSetEnabled(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetOrderbookMovement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WebsocketSetEnabled_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetOrderbookMovement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WebsocketSetEnabled(ctx, req.(*WebsocketSetEnabledRequest))
+		return srv.(GoCryptoTraderServiceServer).GetOrderbookMovement(ctx, req.(*GetOrderbookMovementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WebsocketGetSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketGetSubscriptionsRequest)
+func _GoCryptoTraderService_GetOrderbookAmountByNominal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookAmountByNominalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WebsocketGetSubscriptions(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetOrderbookAmountByNominal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WebsocketGetSubscriptions_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetOrderbookAmountByNominal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WebsocketGetSubscriptions(ctx, req.(*WebsocketGetSubscriptionsRequest))
+		return srv.(GoCryptoTraderServiceServer).GetOrderbookAmountByNominal(ctx, req.(*GetOrderbookAmountByNominalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WebsocketSetProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketSetProxyRequest)
+func _GoCryptoTraderService_GetOrderbookAmountByImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookAmountByImpactRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WebsocketSetProxy(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetOrderbookAmountByImpact(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WebsocketSetProxy_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetOrderbookAmountByImpact_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WebsocketSetProxy(ctx, req.(*WebsocketSetProxyRequest))
+		return srv.(GoCryptoTraderServiceServer).GetOrderbookAmountByImpact(ctx, req.(*GetOrderbookAmountByImpactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_WebsocketSetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketSetURLRequest)
+func _GoCryptoTraderService_GetCollateralMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollateralModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).WebsocketSetURL(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetCollateralMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_WebsocketSetURL_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetCollateralMode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).WebsocketSetURL(ctx, req.(*WebsocketSetURLRequest))
+		return srv.(GoCryptoTraderServiceServer).GetCollateralMode(ctx, req.(*GetCollateralModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetRecentTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSavedTradesRequest)
+func _GoCryptoTraderService_GetLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeverageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetRecentTrades(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetLeverage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetRecentTrades_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetLeverage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetRecentTrades(ctx, req.(*GetSavedTradesRequest))
+		return srv.(GoCryptoTraderServiceServer).GetLeverage(ctx, req.(*GetLeverageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetHistoricTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetSavedTradesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServiceServer).GetHistoricTrades(m, &goCryptoTraderServiceGetHistoricTradesServer{stream})
-}
-
-type GoCryptoTraderService_GetHistoricTradesServer interface {
-	Send(*SavedTradesResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderServiceGetHistoricTradesServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderServiceGetHistoricTradesServer) Send(m *SavedTradesResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTraderService_GetSavedTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSavedTradesRequest)
+func _GoCryptoTraderService_SetCollateralMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollateralModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetSavedTrades(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).SetCollateralMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetSavedTrades_FullMethodName,
+		FullMethod: GoCryptoTraderService_SetCollateralMode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetSavedTrades(ctx, req.(*GetSavedTradesRequest))
+		return srv.(GoCryptoTraderServiceServer).SetCollateralMode(ctx, req.(*SetCollateralModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_ConvertTradesToCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConvertTradesToCandlesRequest)
+func _GoCryptoTraderService_SetMarginType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMarginTypeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).ConvertTradesToCandles(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).SetMarginType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_ConvertTradesToCandles_FullMethodName,
+		FullMethod: GoCryptoTraderService_SetMarginType_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).ConvertTradesToCandles(ctx, req.(*ConvertTradesToCandlesRequest))
+		return srv.(GoCryptoTraderServiceServer).SetMarginType(ctx, req.(*SetMarginTypeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_FindMissingSavedCandleIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindMissingCandlePeriodsRequest)
+func _GoCryptoTraderService_SetLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLeverageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).FindMissingSavedCandleIntervals(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).SetLeverage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_FindMissingSavedCandleIntervals_FullMethodName,
+		FullMethod: GoCryptoTraderService_SetLeverage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).FindMissingSavedCandleIntervals(ctx, req.(*FindMissingCandlePeriodsRequest))
+		return srv.(GoCryptoTraderServiceServer).SetLeverage(ctx, req.(*SetLeverageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_FindMissingSavedTradeIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindMissingTradePeriodsRequest)
+func _GoCryptoTraderService_ChangePositionMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePositionMarginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).FindMissingSavedTradeIntervals(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).ChangePositionMargin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_FindMissingSavedTradeIntervals_FullMethodName,
+		FullMethod: GoCryptoTraderService_ChangePositionMargin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).FindMissingSavedTradeIntervals(ctx, req.(*FindMissingTradePeriodsRequest))
+		return srv.(GoCryptoTraderServiceServer).ChangePositionMargin(ctx, req.(*ChangePositionMarginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SetExchangeTradeProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangeTradeProcessingRequest)
+func _GoCryptoTraderService_GetOpenInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpenInterestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SetExchangeTradeProcessing(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetOpenInterest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SetExchangeTradeProcessing_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetOpenInterest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SetExchangeTradeProcessing(ctx, req.(*SetExchangeTradeProcessingRequest))
+		return srv.(GoCryptoTraderServiceServer).GetOpenInterest(ctx, req.(*GetOpenInterestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_UpsertDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertDataHistoryJobRequest)
+func _GoCryptoTraderService_CreateExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExecutionOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).UpsertDataHistoryJob(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).CreateExecutionOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_UpsertDataHistoryJob_FullMethodName,
+		FullMethod: GoCryptoTraderService_CreateExecutionOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).UpsertDataHistoryJob(ctx, req.(*UpsertDataHistoryJobRequest))
+		return srv.(GoCryptoTraderServiceServer).CreateExecutionOrder(ctx, req.(*CreateExecutionOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetDataHistoryJobDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataHistoryJobDetailsRequest)
+func _GoCryptoTraderService_PauseExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobDetails(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).PauseExecutionOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetDataHistoryJobDetails_FullMethodName,
+		FullMethod: GoCryptoTraderService_PauseExecutionOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobDetails(ctx, req.(*GetDataHistoryJobDetailsRequest))
+		return srv.(GoCryptoTraderServiceServer).PauseExecutionOrder(ctx, req.(*ExecutionOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetActiveDataHistoryJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _GoCryptoTraderService_ResumeExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetActiveDataHistoryJobs(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).ResumeExecutionOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetActiveDataHistoryJobs_FullMethodName,
+		FullMethod: GoCryptoTraderService_ResumeExecutionOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetActiveDataHistoryJobs(ctx, req.(*GetInfoRequest))
+		return srv.(GoCryptoTraderServiceServer).ResumeExecutionOrder(ctx, req.(*ExecutionOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetDataHistoryJobsBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataHistoryJobsBetweenRequest)
+func _GoCryptoTraderService_CancelExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobsBetween(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).CancelExecutionOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetDataHistoryJobsBetween_FullMethodName,
+		FullMethod: GoCryptoTraderService_CancelExecutionOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobsBetween(ctx, req.(*GetDataHistoryJobsBetweenRequest))
+		return srv.(GoCryptoTraderServiceServer).CancelExecutionOrder(ctx, req.(*ExecutionOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetDataHistoryJobSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataHistoryJobDetailsRequest)
+func _GoCryptoTraderService_GetExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobSummary(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetExecutionOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetDataHistoryJobSummary_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetExecutionOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetDataHistoryJobSummary(ctx, req.(*GetDataHistoryJobDetailsRequest))
+		return srv.(GoCryptoTraderServiceServer).GetExecutionOrder(ctx, req.(*ExecutionOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SetDataHistoryJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetDataHistoryJobStatusRequest)
+func _GoCryptoTraderService_GetExecutionOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SetDataHistoryJobStatus(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetExecutionOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SetDataHistoryJobStatus_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetExecutionOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SetDataHistoryJobStatus(ctx, req.(*SetDataHistoryJobStatusRequest))
+		return srv.(GoCryptoTraderServiceServer).GetExecutionOrders(ctx, req.(*GetExecutionOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_UpdateDataHistoryJobPrerequisite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDataHistoryJobPrerequisiteRequest)
+func _GoCryptoTraderService_RouteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).UpdateDataHistoryJobPrerequisite(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).RouteOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_UpdateDataHistoryJobPrerequisite_FullMethodName,
+		FullMethod: GoCryptoTraderService_RouteOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).UpdateDataHistoryJobPrerequisite(ctx, req.(*UpdateDataHistoryJobPrerequisiteRequest))
+		return srv.(GoCryptoTraderServiceServer).RouteOrder(ctx, req.(*RouteOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetManagedOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrdersRequest)
+func _GoCryptoTraderService_GetArbitrageOpportunityStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetArbitrageOpportunityStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetArbitrageOpportunityStream(m, &goCryptoTraderServiceGetArbitrageOpportunityStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetArbitrageOpportunityStreamServer interface {
+	Send(*ArbitrageOpportunity) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetArbitrageOpportunityStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetArbitrageOpportunityStreamServer) Send(m *ArbitrageOpportunity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetTriangularArbitrageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTriangularArbitrageStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetTriangularArbitrageStream(m, &goCryptoTraderServiceGetTriangularArbitrageStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetTriangularArbitrageStreamServer interface {
+	Send(*TriangularArbitrageOpportunity) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetTriangularArbitrageStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetTriangularArbitrageStreamServer) Send(m *TriangularArbitrageOpportunity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_AddMarketMakingStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMarketMakingStrategyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetManagedOrders(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).AddMarketMakingStrategy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetManagedOrders_FullMethodName,
+		FullMethod: GoCryptoTraderService_AddMarketMakingStrategy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetManagedOrders(ctx, req.(*GetOrdersRequest))
+		return srv.(GoCryptoTraderServiceServer).AddMarketMakingStrategy(ctx, req.(*AddMarketMakingStrategyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_ModifyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyOrderRequest)
+func _GoCryptoTraderService_RemoveMarketMakingStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMakingStrategyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).ModifyOrder(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).RemoveMarketMakingStrategy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_ModifyOrder_FullMethodName,
+		FullMethod: GoCryptoTraderService_RemoveMarketMakingStrategy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).ModifyOrder(ctx, req.(*ModifyOrderRequest))
+		return srv.(GoCryptoTraderServiceServer).RemoveMarketMakingStrategy(ctx, req.(*MarketMakingStrategyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_CurrencyStateGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrencyStateGetAllRequest)
+func _GoCryptoTraderService_GetMarketMakingStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMakingStrategyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).CurrencyStateGetAll(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetMarketMakingStrategy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_CurrencyStateGetAll_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetMarketMakingStrategy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).CurrencyStateGetAll(ctx, req.(*CurrencyStateGetAllRequest))
+		return srv.(GoCryptoTraderServiceServer).GetMarketMakingStrategy(ctx, req.(*MarketMakingStrategyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_CurrencyStateTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrencyStateTradingRequest)
+func _GoCryptoTraderService_GetMarketMakingStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketMakingStrategiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).CurrencyStateTrading(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetMarketMakingStrategies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_CurrencyStateTrading_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetMarketMakingStrategies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).CurrencyStateTrading(ctx, req.(*CurrencyStateTradingRequest))
+		return srv.(GoCryptoTraderServiceServer).GetMarketMakingStrategies(ctx, req.(*GetMarketMakingStrategiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_CurrencyStateDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrencyStateDepositRequest)
+func _GoCryptoTraderService_CreateGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGridRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).CurrencyStateDeposit(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).CreateGrid(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_CurrencyStateDeposit_FullMethodName,
+		FullMethod: GoCryptoTraderService_CreateGrid_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).CurrencyStateDeposit(ctx, req.(*CurrencyStateDepositRequest))
+		return srv.(GoCryptoTraderServiceServer).CreateGrid(ctx, req.(*CreateGridRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_CurrencyStateWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrencyStateWithdrawRequest)
+func _GoCryptoTraderService_StopGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GridRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).CurrencyStateWithdraw(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).StopGrid(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_CurrencyStateWithdraw_FullMethodName,
+		FullMethod: GoCryptoTraderService_StopGrid_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).CurrencyStateWithdraw(ctx, req.(*CurrencyStateWithdrawRequest))
+		return srv.(GoCryptoTraderServiceServer).StopGrid(ctx, req.(*GridRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_CurrencyStateTradingPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrencyStateTradingPairRequest)
+func _GoCryptoTraderService_GetGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GridRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).CurrencyStateTradingPair(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetGrid(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_CurrencyStateTradingPair_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetGrid_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).CurrencyStateTradingPair(ctx, req.(*CurrencyStateTradingPairRequest))
+		return srv.(GoCryptoTraderServiceServer).GetGrid(ctx, req.(*GridRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetFuturesPositionsSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFuturesPositionsSummaryRequest)
+func _GoCryptoTraderService_GetGrids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGridsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetFuturesPositionsSummary(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetGrids(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetFuturesPositionsSummary_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetGrids_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetFuturesPositionsSummary(ctx, req.(*GetFuturesPositionsSummaryRequest))
+		return srv.(GoCryptoTraderServiceServer).GetGrids(ctx, req.(*GetGridsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetFuturesPositionsOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFuturesPositionsOrdersRequest)
+func _GoCryptoTraderService_SearchOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOrderHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetFuturesPositionsOrders(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).SearchOrderHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetFuturesPositionsOrders_FullMethodName,
+		FullMethod: GoCryptoTraderService_SearchOrderHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetFuturesPositionsOrders(ctx, req.(*GetFuturesPositionsOrdersRequest))
+		return srv.(GoCryptoTraderServiceServer).SearchOrderHistory(ctx, req.(*SearchOrderHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollateralRequest)
+func _GoCryptoTraderService_GetOrderAuditTrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderAuditTrailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetCollateral(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetOrderAuditTrail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetCollateral_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetOrderAuditTrail_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetCollateral(ctx, req.(*GetCollateralRequest))
+		return srv.(GoCryptoTraderServiceServer).GetOrderAuditTrail(ctx, req.(*GetOrderAuditTrailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShutdownRequest)
+func _GoCryptoTraderService_ReconcileOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).Shutdown(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).ReconcileOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_Shutdown_FullMethodName,
+		FullMethod: GoCryptoTraderService_ReconcileOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).Shutdown(ctx, req.(*ShutdownRequest))
+		return srv.(GoCryptoTraderServiceServer).ReconcileOrders(ctx, req.(*ReconcileOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetTechnicalAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTechnicalAnalysisRequest)
+func _GoCryptoTraderService_GetOrderReconciliations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderReconciliationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetTechnicalAnalysis(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetOrderReconciliations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetTechnicalAnalysis_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetOrderReconciliations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetTechnicalAnalysis(ctx, req.(*GetTechnicalAnalysisRequest))
+		return srv.(GoCryptoTraderServiceServer).GetOrderReconciliations(ctx, req.(*GetOrderReconciliationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetMarginRatesHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMarginRatesHistoryRequest)
+func _GoCryptoTraderService_AddRebalanceStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRebalanceStrategyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetMarginRatesHistory(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).AddRebalanceStrategy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetMarginRatesHistory_FullMethodName,
+		FullMethod: GoCryptoTraderService_AddRebalanceStrategy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetMarginRatesHistory(ctx, req.(*GetMarginRatesHistoryRequest))
+		return srv.(GoCryptoTraderServiceServer).AddRebalanceStrategy(ctx, req.(*AddRebalanceStrategyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetManagedPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetManagedPositionRequest)
+func _GoCryptoTraderService_RemoveRebalanceStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceStrategyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetManagedPosition(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).RemoveRebalanceStrategy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetManagedPosition_FullMethodName,
+		FullMethod: GoCryptoTraderService_RemoveRebalanceStrategy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetManagedPosition(ctx, req.(*GetManagedPositionRequest))
+		return srv.(GoCryptoTraderServiceServer).RemoveRebalanceStrategy(ctx, req.(*RebalanceStrategyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetAllManagedPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllManagedPositionsRequest)
+func _GoCryptoTraderService_GetRebalanceStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRebalanceStrategiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetAllManagedPositions(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetRebalanceStrategies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetAllManagedPositions_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetRebalanceStrategies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetAllManagedPositions(ctx, req.(*GetAllManagedPositionsRequest))
+		return srv.(GoCryptoTraderServiceServer).GetRebalanceStrategies(ctx, req.(*GetRebalanceStrategiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetFundingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFundingRatesRequest)
+func _GoCryptoTraderService_GetRebalancePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceStrategyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetFundingRates(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetRebalancePlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetFundingRates_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetRebalancePlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetFundingRates(ctx, req.(*GetFundingRatesRequest))
+		return srv.(GoCryptoTraderServiceServer).GetRebalancePlan(ctx, req.(*RebalanceStrategyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetLatestFundingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLatestFundingRateRequest)
+func _GoCryptoTraderService_ExecuteRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceStrategyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetLatestFundingRate(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).ExecuteRebalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetLatestFundingRate_FullMethodName,
+		FullMethod: GoCryptoTraderService_ExecuteRebalance_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetLatestFundingRate(ctx, req.(*GetLatestFundingRateRequest))
+		return srv.(GoCryptoTraderServiceServer).ExecuteRebalance(ctx, req.(*RebalanceStrategyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetOrderbookMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbookMovementRequest)
+func _GoCryptoTraderService_GetPortfolioSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortfolioDateRangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetOrderbookMovement(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetOrderbookMovement_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetPortfolioSnapshots_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetOrderbookMovement(ctx, req.(*GetOrderbookMovementRequest))
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioSnapshots(ctx, req.(*PortfolioDateRangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetOrderbookAmountByNominal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbookAmountByNominalRequest)
+func _GoCryptoTraderService_GetPortfolioPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortfolioDateRangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetOrderbookAmountByNominal(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioPerformance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetOrderbookAmountByNominal_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetPortfolioPerformance_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetOrderbookAmountByNominal(ctx, req.(*GetOrderbookAmountByNominalRequest))
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioPerformance(ctx, req.(*PortfolioDateRangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetOrderbookAmountByImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbookAmountByImpactRequest)
+func _GoCryptoTraderService_GetAccountingFills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountingFillsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetOrderbookAmountByImpact(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetAccountingFills(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetOrderbookAmountByImpact_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetAccountingFills_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetOrderbookAmountByImpact(ctx, req.(*GetOrderbookAmountByImpactRequest))
+		return srv.(GoCryptoTraderServiceServer).GetAccountingFills(ctx, req.(*GetAccountingFillsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetCollateralMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollateralModeRequest)
+func _GoCryptoTraderService_GetFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeeScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetCollateralMode(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetFeeSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetCollateralMode_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetFeeSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetCollateralMode(ctx, req.(*GetCollateralModeRequest))
+		return srv.(GoCryptoTraderServiceServer).GetFeeSchedule(ctx, req.(*GetFeeScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLeverageRequest)
+func _GoCryptoTraderService_EstimateOrderCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateOrderCostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetLeverage(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).EstimateOrderCost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetLeverage_FullMethodName,
+		FullMethod: GoCryptoTraderService_EstimateOrderCost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetLeverage(ctx, req.(*GetLeverageRequest))
+		return srv.(GoCryptoTraderServiceServer).EstimateOrderCost(ctx, req.(*EstimateOrderCostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SetCollateralMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetCollateralModeRequest)
+func _GoCryptoTraderService_GetFundingRateMonitorRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingRateMonitorRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SetCollateralMode(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetFundingRateMonitorRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SetCollateralMode_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetFundingRateMonitorRates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SetCollateralMode(ctx, req.(*SetCollateralModeRequest))
+		return srv.(GoCryptoTraderServiceServer).GetFundingRateMonitorRates(ctx, req.(*GetFundingRateMonitorRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SetMarginType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetMarginTypeRequest)
+func _GoCryptoTraderService_GetFundingRateOpportunityStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFundingRateOpportunityStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetFundingRateOpportunityStream(m, &goCryptoTraderServiceGetFundingRateOpportunityStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetFundingRateOpportunityStreamServer interface {
+	Send(*FundingRateOpportunity) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetFundingRateOpportunityStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetFundingRateOpportunityStreamServer) Send(m *FundingRateOpportunity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetLiquidationMonitorPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidationMonitorPositionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SetMarginType(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetLiquidationMonitorPositions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SetMarginType_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetLiquidationMonitorPositions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SetMarginType(ctx, req.(*SetMarginTypeRequest))
+		return srv.(GoCryptoTraderServiceServer).GetLiquidationMonitorPositions(ctx, req.(*GetLiquidationMonitorPositionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_SetLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLeverageRequest)
+func _GoCryptoTraderService_GetLiquidationMonitorStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLiquidationMonitorStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetLiquidationMonitorStream(m, &goCryptoTraderServiceGetLiquidationMonitorStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetLiquidationMonitorStreamServer interface {
+	Send(*LiquidationPositionHealth) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetLiquidationMonitorStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetLiquidationMonitorStreamServer) Send(m *LiquidationPositionHealth) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetOptionChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOptionChainRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).SetLeverage(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetOptionChain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_SetLeverage_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetOptionChain_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).SetLeverage(ctx, req.(*SetLeverageRequest))
+		return srv.(GoCryptoTraderServiceServer).GetOptionChain(ctx, req.(*GetOptionChainRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_ChangePositionMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePositionMarginRequest)
+func _GoCryptoTraderService_GetOptionGreeks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOptionGreeksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).ChangePositionMargin(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetOptionGreeks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_ChangePositionMargin_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetOptionGreeks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).ChangePositionMargin(ctx, req.(*ChangePositionMarginRequest))
+		return srv.(GoCryptoTraderServiceServer).GetOptionGreeks(ctx, req.(*GetOptionGreeksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTraderService_GetOpenInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOpenInterestRequest)
+func _GoCryptoTraderService_GetEventStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetEventStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetEventStream(m, &goCryptoTraderServiceGetEventStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetEventStreamServer interface {
+	Send(*BusEvent) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetEventStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetEventStreamServer) Send(m *BusEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetEventBusMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventBusMetricsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServiceServer).GetOpenInterest(ctx, in)
+		return srv.(GoCryptoTraderServiceServer).GetEventBusMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoCryptoTraderService_GetOpenInterest_FullMethodName,
+		FullMethod: GoCryptoTraderService_GetEventBusMetrics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServiceServer).GetOpenInterest(ctx, req.(*GetOpenInterestRequest))
+		return srv.(GoCryptoTraderServiceServer).GetEventBusMetrics(ctx, req.(*GetEventBusMetricsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4416,6 +5833,142 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOpenInterest",
 			Handler:    _GoCryptoTraderService_GetOpenInterest_Handler,
 		},
+		{
+			MethodName: "CreateExecutionOrder",
+			Handler:    _GoCryptoTraderService_CreateExecutionOrder_Handler,
+		},
+		{
+			MethodName: "PauseExecutionOrder",
+			Handler:    _GoCryptoTraderService_PauseExecutionOrder_Handler,
+		},
+		{
+			MethodName: "ResumeExecutionOrder",
+			Handler:    _GoCryptoTraderService_ResumeExecutionOrder_Handler,
+		},
+		{
+			MethodName: "CancelExecutionOrder",
+			Handler:    _GoCryptoTraderService_CancelExecutionOrder_Handler,
+		},
+		{
+			MethodName: "GetExecutionOrder",
+			Handler:    _GoCryptoTraderService_GetExecutionOrder_Handler,
+		},
+		{
+			MethodName: "GetExecutionOrders",
+			Handler:    _GoCryptoTraderService_GetExecutionOrders_Handler,
+		},
+		{
+			MethodName: "RouteOrder",
+			Handler:    _GoCryptoTraderService_RouteOrder_Handler,
+		},
+		{
+			MethodName: "AddMarketMakingStrategy",
+			Handler:    _GoCryptoTraderService_AddMarketMakingStrategy_Handler,
+		},
+		{
+			MethodName: "RemoveMarketMakingStrategy",
+			Handler:    _GoCryptoTraderService_RemoveMarketMakingStrategy_Handler,
+		},
+		{
+			MethodName: "GetMarketMakingStrategy",
+			Handler:    _GoCryptoTraderService_GetMarketMakingStrategy_Handler,
+		},
+		{
+			MethodName: "GetMarketMakingStrategies",
+			Handler:    _GoCryptoTraderService_GetMarketMakingStrategies_Handler,
+		},
+		{
+			MethodName: "CreateGrid",
+			Handler:    _GoCryptoTraderService_CreateGrid_Handler,
+		},
+		{
+			MethodName: "StopGrid",
+			Handler:    _GoCryptoTraderService_StopGrid_Handler,
+		},
+		{
+			MethodName: "GetGrid",
+			Handler:    _GoCryptoTraderService_GetGrid_Handler,
+		},
+		{
+			MethodName: "GetGrids",
+			Handler:    _GoCryptoTraderService_GetGrids_Handler,
+		},
+		{
+			MethodName: "SearchOrderHistory",
+			Handler:    _GoCryptoTraderService_SearchOrderHistory_Handler,
+		},
+		{
+			MethodName: "GetOrderAuditTrail",
+			Handler:    _GoCryptoTraderService_GetOrderAuditTrail_Handler,
+		},
+		{
+			MethodName: "ReconcileOrders",
+			Handler:    _GoCryptoTraderService_ReconcileOrders_Handler,
+		},
+		{
+			MethodName: "GetOrderReconciliations",
+			Handler:    _GoCryptoTraderService_GetOrderReconciliations_Handler,
+		},
+		{
+			MethodName: "AddRebalanceStrategy",
+			Handler:    _GoCryptoTraderService_AddRebalanceStrategy_Handler,
+		},
+		{
+			MethodName: "RemoveRebalanceStrategy",
+			Handler:    _GoCryptoTraderService_RemoveRebalanceStrategy_Handler,
+		},
+		{
+			MethodName: "GetRebalanceStrategies",
+			Handler:    _GoCryptoTraderService_GetRebalanceStrategies_Handler,
+		},
+		{
+			MethodName: "GetRebalancePlan",
+			Handler:    _GoCryptoTraderService_GetRebalancePlan_Handler,
+		},
+		{
+			MethodName: "ExecuteRebalance",
+			Handler:    _GoCryptoTraderService_ExecuteRebalance_Handler,
+		},
+		{
+			MethodName: "GetPortfolioSnapshots",
+			Handler:    _GoCryptoTraderService_GetPortfolioSnapshots_Handler,
+		},
+		{
+			MethodName: "GetPortfolioPerformance",
+			Handler:    _GoCryptoTraderService_GetPortfolioPerformance_Handler,
+		},
+		{
+			MethodName: "GetAccountingFills",
+			Handler:    _GoCryptoTraderService_GetAccountingFills_Handler,
+		},
+		{
+			MethodName: "GetFeeSchedule",
+			Handler:    _GoCryptoTraderService_GetFeeSchedule_Handler,
+		},
+		{
+			MethodName: "EstimateOrderCost",
+			Handler:    _GoCryptoTraderService_EstimateOrderCost_Handler,
+		},
+		{
+			MethodName: "GetFundingRateMonitorRates",
+			Handler:    _GoCryptoTraderService_GetFundingRateMonitorRates_Handler,
+		},
+		{
+			MethodName: "GetLiquidationMonitorPositions",
+			Handler:    _GoCryptoTraderService_GetLiquidationMonitorPositions_Handler,
+		},
+		{
+			MethodName: "GetOptionChain",
+			Handler:    _GoCryptoTraderService_GetOptionChain_Handler,
+		},
+		{
+			MethodName: "GetOptionGreeks",
+			Handler:    _GoCryptoTraderService_GetOptionGreeks_Handler,
+		},
+		{
+			MethodName: "GetEventBusMetrics",
+			Handler:    _GoCryptoTraderService_GetEventBusMetrics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4448,6 +6001,31 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTraderService_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetArbitrageOpportunityStream",
+			Handler:       _GoCryptoTraderService_GetArbitrageOpportunityStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetTriangularArbitrageStream",
+			Handler:       _GoCryptoTraderService_GetTriangularArbitrageStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetFundingRateOpportunityStream",
+			Handler:       _GoCryptoTraderService_GetFundingRateOpportunityStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLiquidationMonitorStream",
+			Handler:       _GoCryptoTraderService_GetLiquidationMonitorStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetEventStream",
+			Handler:       _GoCryptoTraderService_GetEventStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -59,6 +59,19 @@ func main() {
 	flag.BoolVar(&settings.EnableNTPClient, "ntpclient", true, "enables the NTP client to check system clock drift")
 	flag.BoolVar(&settings.EnableDispatcher, "dispatch", true, "enables the dispatch system")
 	flag.BoolVar(&settings.EnableCurrencyStateManager, "currencystatemanager", true, "enables the currency state manager")
+	flag.BoolVar(&settings.EnableRiskManager, "riskmanager", false, "enables the pre-trade risk manager")
+	flag.BoolVar(&settings.EnableConditionalOrders, "conditionalordermanager", false, "enables engine side stop, trailing stop, take profit and OCO orders")
+	flag.BoolVar(&settings.EnableAlgoExecution, "algoexecutionmanager", false, "enables TWAP, VWAP and iceberg parent order execution")
+	flag.BoolVar(&settings.EnableArbitrageScanner, "arbitragescanner", false, "enables scanning orderbooks across exchanges for arbitrage opportunities")
+	flag.BoolVar(&settings.EnableMarketMaking, "marketmakingmanager", false, "enables continuously quoting two-sided markets around an inventory skewed fair value")
+	flag.BoolVar(&settings.EnableGridTrading, "gridtradingmanager", false, "enables placing ladders of limit orders between a lower and upper price which are replaced on the opposite side as they fill")
+	flag.BoolVar(&settings.EnableMetricsManager, "metricsmanager", false, "enables serving engine and exchange health metrics in the Prometheus text format")
+	flag.BoolVar(&settings.EnablePortfolioRebalancer, "portfoliorebalancer", false, "enables trading holdings across exchanges back towards target weights on a schedule or when they drift beyond a tolerance")
+	flag.BoolVar(&settings.EnablePortfolioSnapshots, "portfoliosnapshots", false, "enables periodically storing the fiat valuation of exchange holdings and offline addresses in the database")
+	flag.BoolVar(&settings.EnableFundingRateMonitor, "fundingratemonitor", false, "enables ranking cash and carry and cross-exchange funding rate opportunities for perpetual futures")
+	flag.BoolVar(&settings.EnableLiquidationMonitor, "liquidationmonitor", false, "enables estimating liquidation prices and margin health of open futures positions with alerts and optional auto deleveraging")
+	flag.BoolVar(&settings.EnablePaperTrading, "papertrading", false, "simulates order execution against live orderbook depth instead of submitting orders to exchanges")
+	flag.BoolVar(&settings.EnableOrderbookRecording, "orderbookrecording", false, "records websocket orderbooks of enabled exchanges for replay by the backtester")
 	flag.IntVar(&settings.DispatchMaxWorkerAmount, "dispatchworkers", dispatch.DefaultMaxWorkers, "sets the dispatch package max worker generation limit")
 	flag.IntVar(&settings.DispatchJobsLimit, "dispatchjobslimit", dispatch.DefaultJobsLimit, "sets the dispatch package max jobs limit")
 
@@ -0,0 +1,451 @@
+package accounting
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// dustTolerance is the fraction of a matched amount below which remaining lot
+// or disposal amounts are treated as floating point error
+const dustTolerance = 1e-9
+
+// ParseMethod returns the lot matching method of a case insensitive string
+func ParseMethod(s string) (Method, error) {
+	m := Method(strings.ToUpper(s))
+	switch m {
+	case FIFO, LIFO, HIFO:
+		return m, nil
+	}
+	return "", fmt.Errorf("%w, received %q", errInvalidMethod, s)
+}
+
+// ParseFiscalYearStart parses the first day of a fiscal year formatted as
+// MM-DD e.g. 07-01
+func ParseFiscalYearStart(s string) (month time.Month, day int, err error) {
+	t, err := time.Parse("01-02", s)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w, received %q: %v", errInvalidFiscalYearStart, s, err)
+	}
+	return t.Month(), t.Day(), nil
+}
+
+// NewReport matches fills against tax lots in time order and returns the
+// realised gains of each fiscal year and the unrealised gains of open lots.
+// Lots are pooled across exchanges and trading pairs by currency, with cost
+// basis, proceeds and gains measured in the reporting currency. Both legs of a
+// trade are recorded, so spending a currency other than the reporting
+// currency disposes of its lots
+func NewReport(fills []Fill, opts *Options) (*Report, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	method := FIFO
+	if opts.Method != "" {
+		var err error
+		method, err = ParseMethod(string(opts.Method))
+		if err != nil {
+			return nil, err
+		}
+	}
+	month, day := opts.FiscalYearStartMonth, opts.FiscalYearStartDay
+	if month == 0 {
+		month = time.January
+	}
+	if day == 0 {
+		day = 1
+	}
+	if month < time.January || month > time.December || day < 1 || day > 31 {
+		return nil, fmt.Errorf("%w, received month %d day %d", errInvalidFiscalYearStart, month, day)
+	}
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	at := opts.Time
+	if at.IsZero() {
+		at = time.Now()
+	}
+	reporting := opts.ReportingCurrency
+	if reporting.IsEmpty() {
+		reporting = currency.USD
+	}
+
+	sorted := make([]Fill, len(fills))
+	copy(sorted, fills)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+	l := &ledger{
+		method:    method,
+		reporting: reporting.Upper(),
+		rates:     opts.Rates,
+		lots:      make(map[*currency.Item][]*Lot),
+	}
+	for i := range sorted {
+		if err := l.process(&sorted[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	r := &Report{
+		Method:             method,
+		ReportingCurrency:  l.reporting,
+		Time:               at,
+		FiscalYears:        fiscalYears(l.disposals, month, day, loc),
+		UnvaluedFees:       l.unvaluedFees,
+		UnmatchedDisposals: l.unmatched,
+	}
+	r.OpenLots, r.UnrealisedTotals = l.openLots(opts.Prices, at)
+	return r, nil
+}
+
+// validate checks a fill can be matched against tax lots
+func (f *Fill) validate() error {
+	switch {
+	case f.Pair.Base.IsEmpty() || f.Pair.Quote.IsEmpty():
+		return fmt.Errorf("%w %s: %w", errInvalidFill, f.ID, currency.ErrCurrencyPairEmpty)
+	case !f.Side.IsLong() && !f.Side.IsShort():
+		return fmt.Errorf("%w %s: side %s is not a buy or sell", errInvalidFill, f.ID, f.Side)
+	case f.Amount <= 0:
+		return fmt.Errorf("%w %s: amount must be greater than zero", errInvalidFill, f.ID)
+	case f.Price < 0:
+		return fmt.Errorf("%w %s: price must not be negative", errInvalidFill, f.ID)
+	case f.Timestamp.IsZero():
+		return fmt.Errorf("%w %s: timestamp must be set", errInvalidFill, f.ID)
+	}
+	return nil
+}
+
+// process records both legs of a fill. A buy acquires the base currency and
+// disposes of the quote currency, a sell disposes of the base currency and
+// acquires the quote currency. The reporting currency is not held as lots
+func (l *ledger) process(f *Fill) error {
+	if err := f.validate(); err != nil {
+		return err
+	}
+	quoteRate, err := l.rate(f.Pair.Quote, f.Timestamp)
+	if err != nil {
+		return fmt.Errorf("fill %s: %w", f.ID, err)
+	}
+	fee, baseFee, quoteFee, otherFee := l.fees(f, quoteRate)
+	quoteAmount := f.Amount * f.Price
+	if f.Side.IsLong() {
+		amount := f.Amount - baseFee
+		if amount <= 0 {
+			return fmt.Errorf("%w %s: fee exceeds the amount acquired", errInvalidFill, f.ID)
+		}
+		l.acquire(f.Pair.Base, f, amount, quoteAmount*quoteRate+fee)
+		if spent := quoteAmount + quoteFee; spent > 0 {
+			l.dispose(f.Pair.Quote, f, spent, spent*quoteRate)
+		}
+	} else {
+		l.dispose(f.Pair.Base, f, f.Amount+baseFee, quoteAmount*quoteRate-fee)
+		if received := quoteAmount - quoteFee; received > 0 {
+			l.acquire(f.Pair.Quote, f, received, received*quoteRate)
+		}
+	}
+	if otherFee > 0 {
+		// Paying a fee in another currency disposes of it at the fee's value
+		l.dispose(f.FeeAsset, f, otherFee, fee)
+	}
+	return nil
+}
+
+// rate returns the value of one unit of a currency in the reporting currency
+func (l *ledger) rate(c currency.Code, at time.Time) (float64, error) {
+	if c.Equal(l.reporting) {
+		return 1, nil
+	}
+	if l.rates == nil {
+		return 0, fmt.Errorf("%w for %s in %s", errNoRate, c, l.reporting)
+	}
+	r, err := l.rates(c, l.reporting, at)
+	if err != nil {
+		return 0, err
+	}
+	if r <= 0 {
+		return 0, fmt.Errorf("%w for %s in %s", errNoRate, c, l.reporting)
+	}
+	return r, nil
+}
+
+// fees returns a fill's fee valued in the reporting currency, along with the
+// amount of the base, quote or other currency it was paid in. A base currency
+// fee reduces the amount acquired by a buy and is disposed of alongside a
+// sell, a quote currency fee is spent alongside a buy and reduces the amount
+// received by a sell. A fee paid in another currency is disposed of
+// separately, fees which cannot be valued are reported and not disposed of
+func (l *ledger) fees(f *Fill, quoteRate float64) (value, baseFee, quoteFee, otherFee float64) {
+	switch {
+	case f.Fee == 0:
+		return 0, 0, 0, 0
+	case f.FeeAsset.IsEmpty(), f.FeeAsset.Equal(f.Pair.Quote):
+		return f.Fee * quoteRate, 0, f.Fee, 0
+	case f.FeeAsset.Equal(f.Pair.Base):
+		return 0, f.Fee, 0, 0
+	}
+	rate, err := l.rate(f.FeeAsset, f.Timestamp)
+	if err == nil {
+		return f.Fee * rate, 0, 0, f.Fee
+	}
+	l.unvaluedFees = append(l.unvaluedFees, UnvaluedFee{
+		FillID:   f.ID,
+		Currency: f.FeeAsset.Upper(),
+		Amount:   f.Fee,
+		Reason:   fmt.Sprintf("unable to value %s in %s: %v", f.FeeAsset, l.reporting, err),
+	})
+	return 0, 0, 0, 0
+}
+
+// acquire opens a lot of a currency unless it is the reporting currency
+func (l *ledger) acquire(c currency.Code, f *Fill, amount, cost float64) {
+	if c.Equal(l.reporting) {
+		return
+	}
+	l.lotSeq++
+	l.lots[c.Item] = append(l.lots[c.Item], &Lot{
+		ID:           strconv.Itoa(l.lotSeq),
+		FillID:       f.ID,
+		Exchange:     f.Exchange,
+		Currency:     c.Upper(),
+		CostCurrency: l.reporting,
+		Amount:       amount,
+		CostBasis:    cost,
+		Acquired:     f.Timestamp,
+		seq:          l.lotSeq,
+	})
+}
+
+// dispose matches an amount of a currency against held lots in method order,
+// splitting proceeds evenly across the amount. Any amount exceeding held lots
+// is disposed of without a cost basis and reported as unmatched. Disposals of
+// the reporting currency are ignored
+func (l *ledger) dispose(c currency.Code, f *Fill, amount, proceeds float64) {
+	if c.Equal(l.reporting) {
+		return
+	}
+	unitProceeds := proceeds / amount
+	lots := l.lots[c.Item]
+	remaining := amount
+	for remaining > amount*dustTolerance && len(lots) > 0 {
+		i := l.next(lots)
+		lot := lots[i]
+		matched := math.Min(remaining, lot.Amount)
+		cost := lot.CostBasis * matched / lot.Amount
+		d := Disposal{
+			LotID:        lot.ID,
+			FillID:       f.ID,
+			Exchange:     f.Exchange,
+			Currency:     lot.Currency,
+			CostCurrency: lot.CostCurrency,
+			Amount:       matched,
+			Acquired:     lot.Acquired,
+			Disposed:     f.Timestamp,
+			Proceeds:     unitProceeds * matched,
+			CostBasis:    cost,
+		}
+		d.Gain = d.Proceeds - d.CostBasis
+		l.disposals = append(l.disposals, d)
+		lot.Amount -= matched
+		lot.CostBasis -= cost
+		remaining -= matched
+		if lot.Amount <= matched*dustTolerance {
+			lots = append(lots[:i], lots[i+1:]...)
+		}
+	}
+	l.lots[c.Item] = lots
+	if remaining > amount*dustTolerance {
+		d := Disposal{
+			FillID:       f.ID,
+			Exchange:     f.Exchange,
+			Currency:     c.Upper(),
+			CostCurrency: l.reporting,
+			Amount:       remaining,
+			Disposed:     f.Timestamp,
+			Proceeds:     unitProceeds * remaining,
+		}
+		d.Gain = d.Proceeds
+		l.disposals = append(l.disposals, d)
+		l.unmatched = append(l.unmatched, UnmatchedDisposal{
+			FillID:   f.ID,
+			Exchange: f.Exchange,
+			Currency: c.Upper(),
+			Amount:   remaining,
+			Disposed: f.Timestamp,
+		})
+	}
+}
+
+// next returns the index of the lot to dispose of first
+func (l *ledger) next(lots []*Lot) int {
+	switch l.method {
+	case LIFO:
+		return len(lots) - 1
+	case HIFO:
+		var highest int
+		for i := 1; i < len(lots); i++ {
+			if lots[i].CostBasis/lots[i].Amount > lots[highest].CostBasis/lots[highest].Amount {
+				highest = i
+			}
+		}
+		return highest
+	default:
+		return 0
+	}
+}
+
+// openLots returns held lots ordered by acquisition, valued when a price is
+// available, and the totals of the valued lots by cost currency
+func (l *ledger) openLots(prices RateFunc, at time.Time) ([]Lot, []UnrealisedTotal) {
+	var lots []Lot
+	for _, held := range l.lots {
+		for i := range held {
+			lots = append(lots, *held[i])
+		}
+	}
+	sort.Slice(lots, func(i, j int) bool {
+		return lots[i].seq < lots[j].seq
+	})
+
+	totals := make(map[*currency.Item]*UnrealisedTotal)
+	if prices != nil {
+		cache := make(map[pairKey]float64)
+		for i := range lots {
+			key := pairKey{base: lots[i].Currency.Item, quote: lots[i].CostCurrency.Item}
+			price, ok := cache[key]
+			if !ok {
+				var err error
+				price, err = prices(lots[i].Currency, lots[i].CostCurrency, at)
+				if err != nil {
+					price = 0
+				}
+				cache[key] = price
+			}
+			if price <= 0 {
+				continue
+			}
+			lots[i].Price = price
+			lots[i].MarketValue = lots[i].Amount * price
+			lots[i].UnrealisedGain = lots[i].MarketValue - lots[i].CostBasis
+			lots[i].Valued = true
+			t, ok := totals[key.quote]
+			if !ok {
+				t = &UnrealisedTotal{CostCurrency: lots[i].CostCurrency}
+				totals[key.quote] = t
+			}
+			t.MarketValue += lots[i].MarketValue
+			t.CostBasis += lots[i].CostBasis
+			t.Gain += lots[i].UnrealisedGain
+		}
+	}
+
+	resp := make([]UnrealisedTotal, 0, len(totals))
+	for _, t := range totals {
+		resp = append(resp, *t)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CostCurrency.String() < resp[j].CostCurrency.String()
+	})
+	return lots, resp
+}
+
+// fiscalYears groups disposals by the fiscal year they were made in
+func fiscalYears(disposals []Disposal, month time.Month, day int, loc *time.Location) []FiscalYear {
+	var resp []FiscalYear
+	for i := range disposals {
+		start := fiscalYearStart(disposals[i].Disposed, month, day, loc)
+		if len(resp) == 0 || !resp[len(resp)-1].Start.Equal(start) {
+			resp = append(resp, FiscalYear{
+				Year:  start.Year(),
+				Start: start,
+				End:   start.AddDate(1, 0, 0),
+			})
+		}
+		resp[len(resp)-1].Disposals = append(resp[len(resp)-1].Disposals, disposals[i])
+	}
+	for i := range resp {
+		resp[i].Totals = realisedTotals(resp[i].Disposals)
+	}
+	return resp
+}
+
+// fiscalYearStart returns the start of the fiscal year a time falls within
+func fiscalYearStart(t time.Time, month time.Month, day int, loc *time.Location) time.Time {
+	t = t.In(loc)
+	start := time.Date(t.Year(), month, day, 0, 0, 0, 0, loc)
+	if t.Before(start) {
+		start = time.Date(t.Year()-1, month, day, 0, 0, 0, 0, loc)
+	}
+	return start
+}
+
+// realisedTotals sums disposals by cost currency
+func realisedTotals(disposals []Disposal) []RealisedTotal {
+	totals := make(map[*currency.Item]*RealisedTotal)
+	for i := range disposals {
+		t, ok := totals[disposals[i].CostCurrency.Item]
+		if !ok {
+			t = &RealisedTotal{CostCurrency: disposals[i].CostCurrency}
+			totals[disposals[i].CostCurrency.Item] = t
+		}
+		t.Proceeds += disposals[i].Proceeds
+		t.CostBasis += disposals[i].CostBasis
+		t.Gain += disposals[i].Gain
+	}
+	resp := make([]RealisedTotal, 0, len(totals))
+	for _, t := range totals {
+		resp = append(resp, *t)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CostCurrency.String() < resp[j].CostCurrency.String()
+	})
+	return resp
+}
+
+// ParseStaticRates parses rates formatted as base-quote:rate e.g.
+// BNB-USDT:300
+func ParseStaticRates(args []string) (StaticRates, error) {
+	resp := make(StaticRates, len(args))
+	for i := range args {
+		pair, rate, ok := strings.Cut(args[i], ":")
+		if !ok {
+			return nil, fmt.Errorf("%w, received %q", errInvalidRate, args[i])
+		}
+		p, err := currency.NewPairFromString(pair)
+		if err != nil {
+			return nil, fmt.Errorf("%w, received %q: %v", errInvalidRate, args[i], err)
+		}
+		r, err := strconv.ParseFloat(rate, 64)
+		if err != nil || r <= 0 {
+			return nil, fmt.Errorf("%w, received %q: rate must be greater than zero", errInvalidRate, args[i])
+		}
+		resp.Set(p.Base, p.Quote, r)
+	}
+	return resp, nil
+}
+
+// Set stores the value of one unit of from in to
+func (s StaticRates) Set(from, to currency.Code, rate float64) {
+	s[pairKey{base: from.Item, quote: to.Item}] = rate
+}
+
+// Rate returns the value of one unit of from in to, using the inverse of the
+// opposite rate when only it is set. It satisfies RateFunc, ignoring time
+func (s StaticRates) Rate(from, to currency.Code, _ time.Time) (float64, error) {
+	if from.Equal(to) {
+		return 1, nil
+	}
+	if r, ok := s[pairKey{base: from.Item, quote: to.Item}]; ok {
+		return r, nil
+	}
+	if r, ok := s[pairKey{base: to.Item, quote: from.Item}]; ok && r > 0 {
+		return 1 / r, nil
+	}
+	return 0, fmt.Errorf("%w for %s in %s", errNoRate, from, to)
+}
@@ -0,0 +1,321 @@
+package accounting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var btcusd = currency.NewPair(currency.BTC, currency.USD)
+
+func testFill(id string, side order.Side, amount, price, fee float64, feeAsset currency.Code, ts time.Time) Fill {
+	return Fill{
+		ID:        id,
+		Exchange:  "test",
+		Asset:     asset.Spot,
+		Pair:      btcusd,
+		Side:      side,
+		Amount:    amount,
+		Price:     price,
+		Fee:       fee,
+		FeeAsset:  feeAsset,
+		Timestamp: ts,
+	}
+}
+
+// testFills acquires a lot of 1 BTC costing 101 USD including fees, a lot of
+// 0.99 BTC costing 200 USD after a BTC fee and a lot of 1 BTC costing 150 USD,
+// then sells 1.5 BTC for 447 USD after fees
+func testFills() []Fill {
+	return []Fill{
+		testFill("sell", order.Sell, 1.5, 300, 3, currency.USD, time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC)),
+		testFill("buy1", order.Buy, 1, 100, 1, currency.EMPTYCODE, time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC)),
+		testFill("buy2", order.Bid, 1, 200, 0.01, currency.BTC, time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)),
+		testFill("buy3", order.Buy, 1, 150, 0, currency.EMPTYCODE, time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+func TestParseMethod(t *testing.T) {
+	t.Parallel()
+	m, err := ParseMethod("hifo")
+	require.NoError(t, err, "ParseMethod must not error")
+	assert.Equal(t, HIFO, m, "ParseMethod should be case insensitive")
+	_, err = ParseMethod("meow")
+	assert.ErrorIs(t, err, errInvalidMethod, "ParseMethod should error on an unsupported method")
+}
+
+func TestParseFiscalYearStart(t *testing.T) {
+	t.Parallel()
+	month, day, err := ParseFiscalYearStart("07-01")
+	require.NoError(t, err, "ParseFiscalYearStart must not error")
+	assert.Equal(t, time.July, month, "month should be parsed")
+	assert.Equal(t, 1, day, "day should be parsed")
+	_, _, err = ParseFiscalYearStart("13-01")
+	assert.ErrorIs(t, err, errInvalidFiscalYearStart, "ParseFiscalYearStart should error on an invalid month")
+}
+
+func TestNewReportMethods(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		method    Method
+		lots      []string
+		amounts   []float64
+		costs     []float64
+		openLots  []string
+		openCosts []float64
+	}{
+		{FIFO, []string{"1", "2"}, []float64{1, 0.5}, []float64{101, 200 * 0.5 / 0.99}, []string{"2", "3"}, []float64{200 * 0.49 / 0.99, 150}},
+		{LIFO, []string{"3", "2"}, []float64{1, 0.5}, []float64{150, 200 * 0.5 / 0.99}, []string{"1", "2"}, []float64{101, 200 * 0.49 / 0.99}},
+		{HIFO, []string{"2", "3"}, []float64{0.99, 0.51}, []float64{200, 76.5}, []string{"1", "3"}, []float64{101, 73.5}},
+	} {
+		r, err := NewReport(testFills(), &Options{Method: tc.method})
+		require.NoErrorf(t, err, "NewReport must not error for %s", tc.method)
+		assert.Equal(t, tc.method, r.Method, "Method should be set")
+		require.Lenf(t, r.FiscalYears, 1, "%s must have a single fiscal year", tc.method)
+		disposals := r.FiscalYears[0].Disposals
+		require.Lenf(t, disposals, len(tc.lots), "%s must match the sell against lots", tc.method)
+		var costs float64
+		for i := range disposals {
+			assert.Equalf(t, tc.lots[i], disposals[i].LotID, "%s disposal %d should match lot", tc.method, i)
+			assert.InDeltaf(t, tc.amounts[i], disposals[i].Amount, 1e-9, "%s disposal %d amount should match", tc.method, i)
+			assert.InDeltaf(t, tc.costs[i], disposals[i].CostBasis, 1e-9, "%s disposal %d cost basis should match", tc.method, i)
+			assert.InDeltaf(t, 298*tc.amounts[i], disposals[i].Proceeds, 1e-9, "%s disposal %d proceeds should be net of fees", tc.method, i)
+			assert.InDeltaf(t, disposals[i].Proceeds-disposals[i].CostBasis, disposals[i].Gain, 1e-9, "%s disposal %d gain should match", tc.method, i)
+			assert.Equalf(t, "sell", disposals[i].FillID, "%s disposal %d fill should match", tc.method, i)
+			costs += disposals[i].CostBasis
+		}
+		require.Len(t, r.FiscalYears[0].Totals, 1, "Totals must be summed by cost currency")
+		assert.True(t, r.FiscalYears[0].Totals[0].CostCurrency.Equal(currency.USD), "Totals cost currency should be USD")
+		assert.InDeltaf(t, 447, r.FiscalYears[0].Totals[0].Proceeds, 1e-9, "%s total proceeds should match", tc.method)
+		assert.InDeltaf(t, costs, r.FiscalYears[0].Totals[0].CostBasis, 1e-9, "%s total cost basis should match", tc.method)
+
+		require.Lenf(t, r.OpenLots, len(tc.openLots), "%s must retain unmatched lots", tc.method)
+		for i := range r.OpenLots {
+			assert.Equalf(t, tc.openLots[i], r.OpenLots[i].ID, "%s open lot %d should match", tc.method, i)
+			assert.InDeltaf(t, tc.openCosts[i], r.OpenLots[i].CostBasis, 1e-9, "%s open lot %d cost basis should match", tc.method, i)
+			assert.False(t, r.OpenLots[i].Valued, "open lots should not be valued without prices")
+		}
+		assert.Empty(t, r.UnrealisedTotals, "UnrealisedTotals should be empty without prices")
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	t.Parallel()
+	_, err := NewReport(nil, &Options{Method: "meow"})
+	assert.ErrorIs(t, err, errInvalidMethod, "NewReport should error on an unsupported method")
+	_, err = NewReport(nil, &Options{FiscalYearStartMonth: 13})
+	assert.ErrorIs(t, err, errInvalidFiscalYearStart, "NewReport should error on an invalid fiscal year start")
+
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, f := range []Fill{
+		{ID: "pair", Side: order.Buy, Amount: 1, Timestamp: ts},
+		testFill("side", order.AnySide, 1, 1, 0, currency.EMPTYCODE, ts),
+		testFill("amount", order.Buy, 0, 1, 0, currency.EMPTYCODE, ts),
+		testFill("price", order.Buy, 1, -1, 0, currency.EMPTYCODE, ts),
+		testFill("timestamp", order.Buy, 1, 1, 0, currency.EMPTYCODE, time.Time{}),
+		testFill("fee", order.Buy, 1, 1, 1, currency.BTC, ts),
+	} {
+		_, err = NewReport([]Fill{f}, nil)
+		assert.ErrorIsf(t, err, errInvalidFill, "NewReport should error on invalid %s", f.ID)
+	}
+
+	r, err := NewReport(nil, nil)
+	require.NoError(t, err, "NewReport must not error without fills")
+	assert.Equal(t, FIFO, r.Method, "Method should default to FIFO")
+	assert.False(t, r.Time.IsZero(), "Time should default to now")
+}
+
+func TestNewReportFiscalYears(t *testing.T) {
+	t.Parallel()
+	fills := []Fill{
+		testFill("buy", order.Buy, 3, 100, 0, currency.EMPTYCODE, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		testFill("sell1", order.Sell, 1, 110, 0, currency.EMPTYCODE, time.Date(2021, 6, 30, 23, 0, 0, 0, time.UTC)),
+		testFill("sell2", order.Sell, 1, 120, 0, currency.EMPTYCODE, time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)),
+		testFill("sell3", order.Sell, 1, 130, 0, currency.EMPTYCODE, time.Date(2022, 6, 30, 0, 0, 0, 0, time.UTC)),
+	}
+	r, err := NewReport(fills, &Options{FiscalYearStartMonth: time.July, FiscalYearStartDay: 1})
+	require.NoError(t, err, "NewReport must not error")
+	require.Len(t, r.FiscalYears, 2, "disposals must be grouped by fiscal year")
+	assert.Equal(t, 2020, r.FiscalYears[0].Year, "Year should be the calendar year the fiscal year starts in")
+	assert.Equal(t, time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC), r.FiscalYears[0].Start, "Start should match")
+	assert.Equal(t, time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), r.FiscalYears[0].End, "End should match")
+	require.Len(t, r.FiscalYears[0].Disposals, 1, "first fiscal year must contain one disposal")
+	require.Len(t, r.FiscalYears[1].Disposals, 2, "second fiscal year must contain two disposals")
+	assert.Equal(t, 50.0, r.FiscalYears[1].Totals[0].Gain, "second fiscal year gain should match")
+
+	r, err = NewReport(fills, &Options{FiscalYearStartMonth: time.July, FiscalYearStartDay: 1, Location: time.FixedZone("AEST", 10*60*60)})
+	require.NoError(t, err, "NewReport must not error")
+	require.Len(t, r.FiscalYears, 1, "disposals must be grouped in the fiscal year time zone")
+	assert.Equal(t, 2021, r.FiscalYears[0].Year, "Year should match")
+	assert.Len(t, r.FiscalYears[0].Disposals, 3, "fiscal year should contain every disposal")
+}
+
+func TestNewReportFees(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	fills := []Fill{
+		testFill("buy", order.Buy, 1, 100, 0.01, currency.BNB, ts),
+		testFill("sell", order.Sell, 1, 200, 0.01, currency.BNB, ts.Add(time.Hour)),
+	}
+	r, err := NewReport(fills, nil)
+	require.NoError(t, err, "NewReport must not error")
+	require.Len(t, r.UnvaluedFees, 2, "fees must be reported as unvalued without fee rates")
+	assert.True(t, r.UnvaluedFees[0].Currency.Equal(currency.BNB), "UnvaluedFees currency should match")
+	assert.Equal(t, 100.0, r.FiscalYears[0].Totals[0].Gain, "unvalued fees should be excluded")
+
+	rates, err := ParseStaticRates([]string{"USD-BNB:0.002"})
+	require.NoError(t, err, "ParseStaticRates must not error")
+	r, err = NewReport(fills, &Options{Rates: rates.Rate})
+	require.NoError(t, err, "NewReport must not error")
+	assert.Empty(t, r.UnvaluedFees, "UnvaluedFees should be empty when fees are valued")
+	disposals := r.FiscalYears[0].Disposals
+	require.Len(t, disposals, 3, "sell and both fees must be disposals")
+	assert.True(t, disposals[1].Currency.Equal(currency.BTC), "sell must match the lot")
+	assert.InDelta(t, 105, disposals[1].CostBasis, 1e-9, "buy fee should be added to the cost basis")
+	assert.InDelta(t, 195, disposals[1].Proceeds, 1e-9, "sell fee should be deducted from proceeds")
+}
+
+func TestNewReportOtherCurrencyFee(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	fills := []Fill{
+		{ID: "bnb", Exchange: "test", Pair: currency.NewPair(currency.BNB, currency.USD), Side: order.Buy, Amount: 1, Price: 400, Timestamp: ts},
+		testFill("buy", order.Buy, 1, 100, 0.01, currency.BNB, ts.Add(time.Hour)),
+	}
+	rates, err := ParseStaticRates([]string{"USD-BNB:0.002"})
+	require.NoError(t, err, "ParseStaticRates must not error")
+	prices := StaticRates{}
+	prices.Set(currency.BNB, currency.USD, 500)
+	prices.Set(currency.BTC, currency.USD, 100)
+	r, err := NewReport(fills, &Options{Rates: rates.Rate, Prices: prices.Rate, Time: ts.Add(2 * time.Hour)})
+	require.NoError(t, err, "NewReport must not error")
+	assert.Empty(t, r.UnmatchedDisposals, "fee must be matched against the BNB lot")
+
+	disposals := r.FiscalYears[0].Disposals
+	require.Len(t, disposals, 1, "paying the fee must dispose of BNB")
+	assert.Equal(t, "buy", disposals[0].FillID, "FillID should match")
+	assert.True(t, disposals[0].Currency.Equal(currency.BNB), "Currency should be the fee asset")
+	assert.InDelta(t, 0.01, disposals[0].Amount, 1e-9, "Amount should be the fee")
+	assert.InDelta(t, 4, disposals[0].CostBasis, 1e-9, "CostBasis should be taken from the BNB lot")
+	assert.InDelta(t, 5, disposals[0].Proceeds, 1e-9, "Proceeds should be the fee value")
+	assert.InDelta(t, 1, disposals[0].Gain, 1e-9, "Gain should be realised on the fee")
+
+	require.Len(t, r.OpenLots, 2, "OpenLots must contain the BNB and BTC lots")
+	assert.InDelta(t, 0.99, r.OpenLots[0].Amount, 1e-9, "BNB lot should be reduced by the fee")
+	assert.InDelta(t, 396, r.OpenLots[0].CostBasis, 1e-9, "BNB cost basis should be reduced by the fee")
+	assert.InDelta(t, 105, r.OpenLots[1].CostBasis, 1e-9, "fee should be added to the BTC cost basis")
+}
+
+func TestNewReportUnmatchedDisposal(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	r, err := NewReport([]Fill{
+		testFill("buy", order.Buy, 1, 100, 0, currency.EMPTYCODE, ts),
+		testFill("sell", order.Sell, 1.5, 200, 0.1, currency.BTC, ts.Add(time.Hour)),
+	}, nil)
+	require.NoError(t, err, "NewReport must not error")
+	disposals := r.FiscalYears[0].Disposals
+	require.Len(t, disposals, 2, "sell must be split between the lot and the unmatched amount")
+	assert.Equal(t, "1", disposals[0].LotID, "first disposal should match the lot")
+	assert.InDelta(t, 0.6, disposals[1].Amount, 1e-9, "base fee should be disposed of alongside the sell")
+	assert.Empty(t, disposals[1].LotID, "unmatched disposal should not have a lot")
+	assert.Zero(t, disposals[1].CostBasis, "unmatched disposal should not have a cost basis")
+	assert.InDelta(t, 300.0*0.6/1.6, disposals[1].Gain, 1e-9, "unmatched disposal gain should equal its proceeds")
+	assert.Empty(t, r.OpenLots, "OpenLots should be empty")
+	require.Len(t, r.UnmatchedDisposals, 1, "unmatched amount must be reported")
+	assert.Equal(t, "sell", r.UnmatchedDisposals[0].FillID, "UnmatchedDisposals fill should match")
+	assert.InDelta(t, 0.6, r.UnmatchedDisposals[0].Amount, 1e-9, "UnmatchedDisposals amount should match")
+}
+
+func TestNewReportUnrealised(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ethbtc := currency.NewPair(currency.ETH, currency.BTC)
+	fills := []Fill{
+		testFill("buy1", order.Buy, 1, 100, 0, currency.EMPTYCODE, ts),
+		testFill("buy2", order.Buy, 2, 150, 0, currency.EMPTYCODE, ts.Add(time.Hour)),
+		{ID: "buy3", Pair: ethbtc, Side: order.Buy, Amount: 1, Price: 0.05, Timestamp: ts.Add(2 * time.Hour)},
+	}
+	prices := StaticRates{}
+	prices.Set(currency.BTC, currency.USD, 200)
+	_, err := NewReport(fills, &Options{Prices: prices.Rate, Time: ts.Add(3 * time.Hour)})
+	assert.ErrorIs(t, err, errNoRate, "NewReport should error when a quote currency cannot be valued")
+
+	r, err := NewReport(fills, &Options{Rates: prices.Rate, Prices: prices.Rate, Time: ts.Add(3 * time.Hour)})
+	require.NoError(t, err, "NewReport must not error")
+	assert.Equal(t, ts.Add(3*time.Hour), r.Time, "Time should be set")
+	require.Len(t, r.OpenLots, 3, "OpenLots must contain every lot")
+	assert.InDelta(t, 0.95, r.OpenLots[0].Amount, 1e-9, "BTC spent on ETH should be disposed of")
+	assert.True(t, r.OpenLots[1].Valued, "lot should be valued")
+	assert.Equal(t, 400.0, r.OpenLots[1].MarketValue, "MarketValue should match")
+	assert.Equal(t, 100.0, r.OpenLots[1].UnrealisedGain, "UnrealisedGain should match")
+	assert.InDelta(t, 10, r.OpenLots[2].CostBasis, 1e-9, "ETH cost basis should be valued in the reporting currency")
+	assert.False(t, r.OpenLots[2].Valued, "lot without a price should not be valued")
+	require.Len(t, r.UnrealisedTotals, 1, "UnrealisedTotals must only include valued lots")
+	assert.True(t, r.UnrealisedTotals[0].CostCurrency.Equal(currency.USD), "CostCurrency should match")
+	assert.InDelta(t, 590, r.UnrealisedTotals[0].MarketValue, 1e-9, "MarketValue should match")
+	assert.InDelta(t, 395, r.UnrealisedTotals[0].CostBasis, 1e-9, "CostBasis should match")
+	assert.InDelta(t, 195, r.UnrealisedTotals[0].Gain, 1e-9, "Gain should match")
+}
+
+func TestNewReportAcrossPairs(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	btcusdt := currency.NewPair(currency.BTC, currency.USDT)
+	ethbtc := currency.NewPair(currency.ETH, currency.BTC)
+	fills := []Fill{
+		{ID: "fund", Pair: currency.NewPair(currency.USDT, currency.USD), Side: order.Buy, Amount: 100, Price: 1, Timestamp: ts},
+		{ID: "buy", Pair: btcusdt, Side: order.Buy, Amount: 1, Price: 100, Timestamp: ts},
+		{ID: "rotate", Pair: ethbtc, Side: order.Buy, Amount: 10, Price: 0.05, Timestamp: ts.Add(time.Hour)},
+		testFill("sell", order.Sell, 0.5, 300, 0, currency.EMPTYCODE, ts.Add(2*time.Hour)),
+	}
+	rates := StaticRates{}
+	rates.Set(currency.USDT, currency.USD, 1)
+	rates.Set(currency.BTC, currency.USD, 200)
+	r, err := NewReport(fills, &Options{Rates: rates.Rate})
+	require.NoError(t, err, "NewReport must not error")
+	assert.True(t, r.ReportingCurrency.Equal(currency.USD), "ReportingCurrency should default to USD")
+	assert.Empty(t, r.UnmatchedDisposals, "BTC bought with USDT must be matched when sold for USD")
+
+	disposals := r.FiscalYears[0].Disposals
+	require.Len(t, disposals, 3, "spending USDT, spending BTC on ETH and selling BTC must all be disposals")
+	assert.Equal(t, "buy", disposals[0].FillID, "quote leg of the BTC purchase should be disposed of")
+	assert.InDelta(t, 0, disposals[0].Gain, 1e-9, "spent USDT gain should match")
+	assert.Equal(t, "rotate", disposals[1].FillID, "quote leg of the ETH purchase should be disposed of")
+	assert.InDelta(t, 0.5, disposals[1].Amount, 1e-9, "spent BTC amount should match")
+	assert.InDelta(t, 50, disposals[1].CostBasis, 1e-9, "spent BTC cost basis should match")
+	assert.InDelta(t, 100, disposals[1].Proceeds, 1e-9, "spent BTC should be valued at its rate")
+	assert.Equal(t, "sell", disposals[2].FillID, "BTC sale should be disposed of")
+	assert.Equal(t, "2", disposals[2].LotID, "BTC sale should match the lot acquired with USDT")
+	assert.InDelta(t, 100, disposals[2].Gain, 1e-9, "BTC sale gain should match")
+
+	require.Len(t, r.OpenLots, 1, "only the ETH lot must remain")
+	assert.True(t, r.OpenLots[0].Currency.Equal(currency.ETH), "open lot should be ETH")
+	assert.InDelta(t, 100, r.OpenLots[0].CostBasis, 1e-9, "ETH cost basis should be valued in USD")
+}
+
+func TestStaticRates(t *testing.T) {
+	t.Parallel()
+	for _, arg := range []string{"btcusd", "btc-usd", "btc-usd:meow", "btc-usd:0"} {
+		_, err := ParseStaticRates([]string{arg})
+		assert.ErrorIsf(t, err, errInvalidRate, "ParseStaticRates should error on %q", arg)
+	}
+	rates, err := ParseStaticRates([]string{"btc-usd:20000"})
+	require.NoError(t, err, "ParseStaticRates must not error")
+	r, err := rates.Rate(currency.BTC, currency.USD, time.Time{})
+	require.NoError(t, err, "Rate must not error")
+	assert.Equal(t, 20000.0, r, "Rate should match")
+	r, err = rates.Rate(currency.USD, currency.BTC, time.Time{})
+	require.NoError(t, err, "Rate must not error")
+	assert.Equal(t, 0.00005, r, "Rate should use the inverse rate")
+	r, err = rates.Rate(currency.ETH, currency.ETH, time.Time{})
+	require.NoError(t, err, "Rate must not error")
+	assert.Equal(t, 1.0, r, "Rate should be one for the same currency")
+	_, err = rates.Rate(currency.ETH, currency.USD, time.Time{})
+	assert.ErrorIs(t, err, errNoRate, "Rate should error when no rate is set")
+}
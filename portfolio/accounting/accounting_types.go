@@ -0,0 +1,201 @@
+package accounting
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Method defines which tax lots are matched against a disposal first
+type Method string
+
+// Supported lot matching methods
+const (
+	// FIFO disposes of the earliest acquired lots first
+	FIFO Method = "FIFO"
+	// LIFO disposes of the latest acquired lots first
+	LIFO Method = "LIFO"
+	// HIFO disposes of the lots with the highest unit cost first
+	HIFO Method = "HIFO"
+)
+
+const fillsCSVHeader = "id,exchange,asset,pair,side,amount,price,fee,fee_asset,timestamp"
+
+var (
+	errInvalidMethod          = errors.New("lot matching method is not supported, supported methods: FIFO, LIFO, HIFO")
+	errInvalidFill            = errors.New("invalid fill")
+	errInvalidFiscalYearStart = errors.New("fiscal year start must be formatted as MM-DD")
+	errInvalidRate            = errors.New("rate must be formatted as base-quote:rate")
+	errNoRate                 = errors.New("no rate available")
+	errInvalidCSVHeader       = errors.New("invalid fills csv header")
+)
+
+// RateFunc returns the value of one unit of a currency in another currency
+// at a point in time
+type RateFunc func(from, to currency.Code, at time.Time) (float64, error)
+
+// Fill is an executed trade. Fills are the input to tax lot matching and
+// can be derived from order details or read from exported trade files
+type Fill struct {
+	ID       string        `json:"id"`
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+	Side     order.Side    `json:"side"`
+	Amount   float64       `json:"amount"`
+	Price    float64       `json:"price"`
+	Fee      float64       `json:"fee"`
+	// FeeAsset is the currency the fee was paid in. When empty the fee is
+	// assumed to be paid in the quote currency
+	FeeAsset  currency.Code `json:"feeAsset"`
+	Timestamp time.Time     `json:"timestamp"`
+}
+
+// Options defines how a report is built
+type Options struct {
+	Method Method
+	// FiscalYearStartMonth and FiscalYearStartDay define the first day of
+	// each fiscal year and default to the first of January
+	FiscalYearStartMonth time.Month
+	FiscalYearStartDay   int
+	// Location is the time zone fiscal years are defined in and defaults to
+	// UTC
+	Location *time.Location
+	// ReportingCurrency is the currency cost basis, proceeds and gains are
+	// measured in and defaults to USD
+	ReportingCurrency currency.Code
+	// Rates values currencies in the reporting currency at the time of each
+	// fill. It is required for fills quoted in a currency other than the
+	// reporting currency and values fees paid in a currency other than the
+	// pair's base or quote currency. Fees which cannot be valued are reported
+	// as unvalued
+	Rates RateFunc
+	// Prices values open lots to calculate unrealised gains. Lots which
+	// cannot be valued are reported without a market value
+	Prices RateFunc
+	// Time is when open lots are valued and defaults to now
+	Time time.Time
+}
+
+// Lot is an acquisition of a currency which has not been fully disposed of.
+// Lots are pooled across exchanges and trading pairs by currency
+type Lot struct {
+	ID       string        `json:"id"`
+	FillID   string        `json:"fillId"`
+	Exchange string        `json:"exchange"`
+	Currency currency.Code `json:"currency"`
+	// CostCurrency is the reporting currency the lot's cost basis and gains
+	// are measured in
+	CostCurrency currency.Code `json:"costCurrency"`
+	Amount       float64       `json:"amount"`
+	// CostBasis is the cost of the remaining amount including fees
+	CostBasis float64   `json:"costBasis"`
+	Acquired  time.Time `json:"acquired"`
+	// Price, MarketValue and UnrealisedGain are set when the lot was valued
+	Price          float64 `json:"price"`
+	MarketValue    float64 `json:"marketValue"`
+	UnrealisedGain float64 `json:"unrealisedGain"`
+	Valued         bool    `json:"valued"`
+	seq            int
+}
+
+// Disposal is the portion of a sell matched against a single lot
+type Disposal struct {
+	// LotID is empty when the sell exceeded all held lots, in which case the
+	// unmatched amount has no cost basis
+	LotID        string        `json:"lotId"`
+	FillID       string        `json:"fillId"`
+	Exchange     string        `json:"exchange"`
+	Currency     currency.Code `json:"currency"`
+	CostCurrency currency.Code `json:"costCurrency"`
+	Amount       float64       `json:"amount"`
+	Acquired     time.Time     `json:"acquired"`
+	Disposed     time.Time     `json:"disposed"`
+	Proceeds     float64       `json:"proceeds"`
+	CostBasis    float64       `json:"costBasis"`
+	Gain         float64       `json:"gain"`
+}
+
+// UnmatchedDisposal is an amount disposed of without any held lots, usually
+// because the fills acquiring it are missing. It is reported as a gain with no
+// cost basis
+type UnmatchedDisposal struct {
+	FillID   string        `json:"fillId"`
+	Exchange string        `json:"exchange"`
+	Currency currency.Code `json:"currency"`
+	Amount   float64       `json:"amount"`
+	Disposed time.Time     `json:"disposed"`
+}
+
+// UnvaluedFee is a fee which could not be valued in the reporting currency
+// and is excluded from cost basis and proceeds
+type UnvaluedFee struct {
+	FillID   string        `json:"fillId"`
+	Currency currency.Code `json:"currency"`
+	Amount   float64       `json:"amount"`
+	Reason   string        `json:"reason"`
+}
+
+// FiscalYear holds the disposals made within a fiscal year. Year is the
+// calendar year the fiscal year starts in and End is exclusive
+type FiscalYear struct {
+	Year      int             `json:"year"`
+	Start     time.Time       `json:"start"`
+	End       time.Time       `json:"end"`
+	Totals    []RealisedTotal `json:"totals"`
+	Disposals []Disposal      `json:"disposals"`
+}
+
+// RealisedTotal is the sum of disposals measured in a cost currency
+type RealisedTotal struct {
+	CostCurrency currency.Code `json:"costCurrency"`
+	Proceeds     float64       `json:"proceeds"`
+	CostBasis    float64       `json:"costBasis"`
+	Gain         float64       `json:"gain"`
+}
+
+// UnrealisedTotal is the sum of valued open lots measured in a cost currency
+type UnrealisedTotal struct {
+	CostCurrency currency.Code `json:"costCurrency"`
+	MarketValue  float64       `json:"marketValue"`
+	CostBasis    float64       `json:"costBasis"`
+	Gain         float64       `json:"gain"`
+}
+
+// Report is the realised gains of each fiscal year and the unrealised gains
+// of lots held at the report time
+type Report struct {
+	Method             Method              `json:"method"`
+	ReportingCurrency  currency.Code       `json:"reportingCurrency"`
+	Time               time.Time           `json:"time"`
+	FiscalYears        []FiscalYear        `json:"fiscalYears"`
+	OpenLots           []Lot               `json:"openLots"`
+	UnrealisedTotals   []UnrealisedTotal   `json:"unrealisedTotals"`
+	UnvaluedFees       []UnvaluedFee       `json:"unvaluedFees"`
+	UnmatchedDisposals []UnmatchedDisposal `json:"unmatchedDisposals"`
+}
+
+// pairKey identifies a currency measured in another currency
+type pairKey struct {
+	base  *currency.Item
+	quote *currency.Item
+}
+
+// ledger matches fills against tax lots
+type ledger struct {
+	method       Method
+	reporting    currency.Code
+	rates        RateFunc
+	lots         map[*currency.Item][]*Lot
+	lotSeq       int
+	disposals    []Disposal
+	unvaluedFees []UnvaluedFee
+	unmatched    []UnmatchedDisposal
+}
+
+// StaticRates are fixed rates between currency pairs, used to value fees and
+// open lots when live or historical rates are unavailable
+type StaticRates map[pairKey]float64
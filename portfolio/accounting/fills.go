@@ -0,0 +1,319 @@
+package accounting
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// FillsFromOrder returns the fills of an order. An order's trades are used
+// when available, otherwise its executed amount and average price form a
+// single fill. Orders without executions return no fills
+func FillsFromOrder(d *order.Detail) []Fill {
+	if d == nil {
+		return nil
+	}
+	if len(d.Trades) > 0 {
+		resp := make([]Fill, 0, len(d.Trades))
+		for i := range d.Trades {
+			t := &d.Trades[i]
+			if t.Amount <= 0 {
+				continue
+			}
+			f := Fill{
+				ID:        d.OrderID + "-" + t.TID,
+				Exchange:  d.Exchange,
+				Asset:     d.AssetType,
+				Pair:      d.Pair,
+				Side:      t.Side,
+				Amount:    t.Amount,
+				Price:     t.Price,
+				Fee:       t.Fee,
+				FeeAsset:  d.FeeAsset,
+				Timestamp: t.Timestamp,
+			}
+			if t.TID == "" {
+				f.ID = d.OrderID + "-" + strconv.Itoa(i)
+			}
+			if f.Side == order.UnknownSide || f.Side == order.AnySide {
+				f.Side = d.Side
+			}
+			if t.FeeAsset != "" {
+				f.FeeAsset = currency.NewCode(t.FeeAsset)
+			}
+			if f.Timestamp.IsZero() {
+				f.Timestamp = orderTime(d)
+			}
+			resp = append(resp, f)
+		}
+		return resp
+	}
+	amount := d.ExecutedAmount
+	if amount == 0 && d.Status == order.Filled {
+		amount = d.Amount
+	}
+	price := d.AverageExecutedPrice
+	if price == 0 {
+		price = d.Price
+	}
+	if amount <= 0 || price <= 0 {
+		return nil
+	}
+	return []Fill{{
+		ID:        d.OrderID,
+		Exchange:  d.Exchange,
+		Asset:     d.AssetType,
+		Pair:      d.Pair,
+		Side:      d.Side,
+		Amount:    amount,
+		Price:     price,
+		Fee:       d.Fee,
+		FeeAsset:  d.FeeAsset,
+		Timestamp: orderTime(d),
+	}}
+}
+
+// orderTime returns when an order last executed
+func orderTime(d *order.Detail) time.Time {
+	if !d.LastUpdated.IsZero() {
+		return d.LastUpdated
+	}
+	return d.Date
+}
+
+// MarshalJSON encodes a fill with its side as a string and its pair with a
+// delimiter so that both can be decoded
+func (f Fill) MarshalJSON() ([]byte, error) {
+	type alias Fill
+	return json.Marshal(&struct {
+		alias
+		Pair string `json:"pair"`
+		Side string `json:"side"`
+	}{
+		alias: alias(f),
+		Pair:  delimitedPair(f.Pair),
+		Side:  f.Side.String(),
+	})
+}
+
+// delimitedPair formats a pair so that it can be parsed without knowing its
+// exchange's pair format
+func delimitedPair(p currency.Pair) string {
+	return p.Format(currency.PairFormat{Delimiter: currency.DashDelimiter, Uppercase: true}).String()
+}
+
+// ReadFillsCSV reads fills from a CSV file with the header
+// id,exchange,asset,pair,side,amount,price,fee,fee_asset,timestamp where
+// timestamps are RFC3339 formatted
+func ReadFillsCSV(r io.Reader) ([]Fill, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(strings.Split(fillsCSVHeader, ","))
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if strings.Join(header, ",") != fillsCSVHeader {
+		return nil, fmt.Errorf("%w, expected %q", errInvalidCSVHeader, fillsCSVHeader)
+	}
+	var resp []Fill
+	for {
+		var row []string
+		row, err = reader.Read()
+		if errors.Is(err, io.EOF) {
+			return resp, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		var f Fill
+		f, err = parseFillRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		resp = append(resp, f)
+	}
+}
+
+// parseFillRow converts a CSV row to a fill
+func parseFillRow(row []string) (Fill, error) {
+	f := Fill{
+		ID:       row[0],
+		Exchange: row[1],
+		FeeAsset: currency.NewCode(row[8]),
+	}
+	var err error
+	if row[2] != "" {
+		if f.Asset, err = asset.New(row[2]); err != nil {
+			return Fill{}, err
+		}
+	}
+	if f.Pair, err = currency.NewPairFromString(row[3]); err != nil {
+		return Fill{}, err
+	}
+	if f.Side, err = order.StringToOrderSide(row[4]); err != nil {
+		return Fill{}, err
+	}
+	if f.Amount, err = strconv.ParseFloat(row[5], 64); err != nil {
+		return Fill{}, fmt.Errorf("amount: %w", err)
+	}
+	if f.Price, err = strconv.ParseFloat(row[6], 64); err != nil {
+		return Fill{}, fmt.Errorf("price: %w", err)
+	}
+	if row[7] != "" {
+		if f.Fee, err = strconv.ParseFloat(row[7], 64); err != nil {
+			return Fill{}, fmt.Errorf("fee: %w", err)
+		}
+	}
+	if f.Timestamp, err = time.Parse(time.RFC3339, row[9]); err != nil {
+		return Fill{}, fmt.Errorf("timestamp: %w", err)
+	}
+	return f, nil
+}
+
+// WriteFillsCSV writes fills in the format read by ReadFillsCSV
+func WriteFillsCSV(w io.Writer, fills []Fill) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(strings.Split(fillsCSVHeader, ",")); err != nil {
+		return err
+	}
+	for i := range fills {
+		var assetType string
+		if fills[i].Asset != asset.Empty {
+			assetType = fills[i].Asset.String()
+		}
+		if err := writer.Write([]string{
+			fills[i].ID,
+			fills[i].Exchange,
+			assetType,
+			delimitedPair(fills[i].Pair),
+			fills[i].Side.String(),
+			formatFloat(fills[i].Amount),
+			formatFloat(fills[i].Price),
+			formatFloat(fills[i].Fee),
+			fills[i].FeeAsset.String(),
+			fills[i].Timestamp.Format(time.RFC3339Nano),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadFillsJSON reads fills from a JSON array in the format written by
+// WriteFillsJSON
+func ReadFillsJSON(r io.Reader) ([]Fill, error) {
+	var resp []Fill
+	if err := json.NewDecoder(r).Decode(&resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WriteFillsJSON writes fills as an indented JSON array
+func WriteFillsJSON(w io.Writer, fills []Fill) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(fills)
+}
+
+// WriteJSON writes the report as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV writes the realised disposals followed by a blank line and the
+// open lots
+func (r *Report) WriteCSV(w io.Writer) error {
+	if err := r.WriteRealisedCSV(w); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return err
+	}
+	return r.WriteUnrealisedCSV(w)
+}
+
+// WriteRealisedCSV writes every disposal with the fiscal year it was made in
+func (r *Report) WriteRealisedCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"fiscal_year", "exchange", "currency", "cost_currency", "amount", "acquired", "disposed", "proceeds", "cost_basis", "gain", "lot_id", "fill_id"}); err != nil {
+		return err
+	}
+	for i := range r.FiscalYears {
+		for j := range r.FiscalYears[i].Disposals {
+			d := &r.FiscalYears[i].Disposals[j]
+			var acquired string
+			if !d.Acquired.IsZero() {
+				acquired = d.Acquired.Format(time.RFC3339)
+			}
+			if err := writer.Write([]string{
+				strconv.Itoa(r.FiscalYears[i].Year),
+				d.Exchange,
+				d.Currency.String(),
+				d.CostCurrency.String(),
+				formatFloat(d.Amount),
+				acquired,
+				d.Disposed.Format(time.RFC3339),
+				formatFloat(d.Proceeds),
+				formatFloat(d.CostBasis),
+				formatFloat(d.Gain),
+				d.LotID,
+				d.FillID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteUnrealisedCSV writes every open lot. Market value and gain are empty
+// for lots which could not be valued
+func (r *Report) WriteUnrealisedCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"exchange", "currency", "cost_currency", "amount", "acquired", "cost_basis", "price", "market_value", "unrealised_gain", "lot_id", "fill_id"}); err != nil {
+		return err
+	}
+	for i := range r.OpenLots {
+		l := &r.OpenLots[i]
+		var price, value, gain string
+		if l.Valued {
+			price, value, gain = formatFloat(l.Price), formatFloat(l.MarketValue), formatFloat(l.UnrealisedGain)
+		}
+		if err := writer.Write([]string{
+			l.Exchange,
+			l.Currency.String(),
+			l.CostCurrency.String(),
+			formatFloat(l.Amount),
+			l.Acquired.Format(time.RFC3339),
+			formatFloat(l.CostBasis),
+			price,
+			value,
+			gain,
+			l.ID,
+			l.FillID,
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
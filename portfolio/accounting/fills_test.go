@@ -0,0 +1,154 @@
+package accounting
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestFillsFromOrder(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, FillsFromOrder(nil), "FillsFromOrder should return nil on nil order")
+
+	ts := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := &order.Detail{
+		Exchange:    "test",
+		OrderID:     "1337",
+		AssetType:   asset.Spot,
+		Pair:        btcusd,
+		Side:        order.Buy,
+		Status:      order.Filled,
+		Amount:      2,
+		Price:       100,
+		Fee:         0.5,
+		FeeAsset:    currency.USD,
+		LastUpdated: ts,
+	}
+	fills := FillsFromOrder(d)
+	require.Len(t, fills, 1, "filled order without trades must return a single fill")
+	assert.Equal(t, "1337", fills[0].ID, "ID should match")
+	assert.Equal(t, 2.0, fills[0].Amount, "Amount should default to the order amount")
+	assert.Equal(t, 100.0, fills[0].Price, "Price should default to the order price")
+	assert.Equal(t, ts, fills[0].Timestamp, "Timestamp should match")
+
+	d.ExecutedAmount = 1
+	d.AverageExecutedPrice = 101
+	fills = FillsFromOrder(d)
+	require.Len(t, fills, 1, "FillsFromOrder must return a single fill")
+	assert.Equal(t, 1.0, fills[0].Amount, "Amount should be the executed amount")
+	assert.Equal(t, 101.0, fills[0].Price, "Price should be the average executed price")
+
+	d.Trades = []order.TradeHistory{
+		{TID: "a", Amount: 0.5, Price: 99, Fee: 0.001, FeeAsset: "bnb", Timestamp: ts.Add(time.Minute)},
+		{Amount: 0.5, Price: 101, Fee: 0.25, Side: order.Buy},
+		{TID: "c"},
+	}
+	fills = FillsFromOrder(d)
+	require.Len(t, fills, 2, "trades without an amount must be skipped")
+	assert.Equal(t, "1337-a", fills[0].ID, "ID should include the trade ID")
+	assert.Equal(t, order.Buy, fills[0].Side, "Side should default to the order side")
+	assert.True(t, fills[0].FeeAsset.Equal(currency.BNB), "FeeAsset should use the trade fee asset")
+	assert.Equal(t, ts.Add(time.Minute), fills[0].Timestamp, "Timestamp should use the trade time")
+	assert.Equal(t, "1337-1", fills[1].ID, "ID should include the trade index without a trade ID")
+	assert.True(t, fills[1].FeeAsset.Equal(currency.USD), "FeeAsset should default to the order fee asset")
+	assert.Equal(t, ts, fills[1].Timestamp, "Timestamp should default to the order time")
+
+	assert.Empty(t, FillsFromOrder(&order.Detail{Status: order.Cancelled, Amount: 1, Price: 1}), "unexecuted order should return no fills")
+}
+
+func TestFillsCSV(t *testing.T) {
+	t.Parallel()
+	fills := testFills()
+	fills[0].Pair = currency.NewPair(currency.BTC, currency.USD).Format(currency.PairFormat{Uppercase: true})
+	var buf bytes.Buffer
+	require.NoError(t, WriteFillsCSV(&buf, fills), "WriteFillsCSV must not error")
+	read, err := ReadFillsCSV(&buf)
+	require.NoError(t, err, "ReadFillsCSV must not error")
+	require.Len(t, read, len(fills), "ReadFillsCSV must return every fill")
+	for i := range fills {
+		assert.Equal(t, fills[i].ID, read[i].ID, "ID should match")
+		assert.True(t, fills[i].Pair.Equal(read[i].Pair), "Pair should match")
+		assert.Equal(t, fills[i].Side, read[i].Side, "Side should match")
+		assert.Equal(t, fills[i].Amount, read[i].Amount, "Amount should match")
+		assert.Equal(t, fills[i].Fee, read[i].Fee, "Fee should match")
+		assert.True(t, fills[i].FeeAsset.Equal(read[i].FeeAsset), "FeeAsset should match")
+		assert.True(t, fills[i].Timestamp.Equal(read[i].Timestamp), "Timestamp should match")
+	}
+
+	_, err = ReadFillsCSV(strings.NewReader("id,exchange\n"))
+	assert.Error(t, err, "ReadFillsCSV should error on a short header")
+	_, err = ReadFillsCSV(strings.NewReader(strings.ReplaceAll(fillsCSVHeader, "id", "meow") + "\n"))
+	assert.ErrorIs(t, err, errInvalidCSVHeader, "ReadFillsCSV should error on an invalid header")
+	_, err = ReadFillsCSV(strings.NewReader(fillsCSVHeader + "\n1,test,spot,btc-usd,buy,meow,1,,,2022-01-01T00:00:00Z\n"))
+	assert.ErrorContains(t, err, "line 2", "ReadFillsCSV should error with the line of an invalid row")
+}
+
+func TestFillJSON(t *testing.T) {
+	t.Parallel()
+	fills := testFills()
+	fills[0].Pair = fills[0].Pair.Format(currency.PairFormat{Uppercase: true})
+	b, err := json.Marshal(fills)
+	require.NoError(t, err, "Marshal must not error")
+	var read []Fill
+	require.NoError(t, json.Unmarshal(b, &read), "Unmarshal must not error")
+	require.Len(t, read, len(fills), "Unmarshal must return every fill")
+	assert.Equal(t, order.Sell, read[0].Side, "Side should match")
+	assert.True(t, read[0].Pair.Equal(btcusd), "Pair should match")
+	assert.Equal(t, asset.Spot, read[0].Asset, "Asset should match")
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteFillsJSON(&buf, fills), "WriteFillsJSON must not error")
+	read, err = ReadFillsJSON(&buf)
+	require.NoError(t, err, "ReadFillsJSON must not error")
+	assert.Len(t, read, len(fills), "ReadFillsJSON should return every fill")
+	_, err = ReadFillsJSON(strings.NewReader("meow"))
+	assert.Error(t, err, "ReadFillsJSON should error on invalid JSON")
+}
+
+func TestReportWriters(t *testing.T) {
+	t.Parallel()
+	prices := StaticRates{}
+	prices.Set(currency.BTC, currency.USD, 200)
+	r, err := NewReport(append(testFills(),
+		testFill("sell2", order.Sell, 5, 100, 0, currency.EMPTYCODE, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+		testFill("buy4", order.Buy, 1, 100, 0, currency.EMPTYCODE, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)),
+	), &Options{Prices: prices.Rate})
+	require.NoError(t, err, "NewReport must not error")
+
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteRealisedCSV(&buf), "WriteRealisedCSV must not error")
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 6, "WriteRealisedCSV must write a header and every disposal")
+	assert.True(t, strings.HasPrefix(lines[1], "2022,test,BTC,USD,1,2022-01-10T00:00:00Z,2022-08-01T00:00:00Z,298,101,197,1,sell"), "disposal row should match")
+	assert.True(t, strings.HasPrefix(lines[5], "2023,test,BTC,USD,"), "unmatched disposal row should be in its fiscal year")
+	assert.True(t, strings.HasSuffix(lines[5], ",,sell2"), "unmatched disposal row should not have a lot")
+
+	buf.Reset()
+	require.NoError(t, r.WriteUnrealisedCSV(&buf), "WriteUnrealisedCSV must not error")
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2, "WriteUnrealisedCSV must write a header and every open lot")
+	assert.Equal(t, "test,BTC,USD,1,2023-02-01T00:00:00Z,100,200,200,100,4,buy4", lines[1], "open lot row should match")
+
+	buf.Reset()
+	require.NoError(t, r.WriteCSV(&buf), "WriteCSV must not error")
+	tables := strings.Split(strings.TrimSpace(buf.String()), "\n\n")
+	require.Len(t, tables, 2, "WriteCSV must write the realised and unrealised tables")
+	assert.Len(t, strings.Split(tables[0], "\n"), 6, "WriteCSV should write every disposal")
+	assert.Len(t, strings.Split(tables[1], "\n"), 2, "WriteCSV should write every open lot")
+
+	buf.Reset()
+	require.NoError(t, r.WriteJSON(&buf), "WriteJSON must not error")
+	var decoded Report
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded), "Unmarshal must not error")
+	assert.Equal(t, FIFO, decoded.Method, "Method should match")
+	assert.Len(t, decoded.FiscalYears, 2, "FiscalYears should match")
+	assert.Len(t, decoded.OpenLots, 1, "OpenLots should match")
+}